@@ -322,6 +322,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	)
 	appKeepers.GAMMKeeper.SetPoolManager(appKeepers.PoolManagerKeeper)
 	appKeepers.ConcentratedLiquidityKeeper.SetPoolManagerKeeper(appKeepers.PoolManagerKeeper)
+	appKeepers.ConcentratedLiquidityKeeper.SetAuthzKeeper(appKeepers.AuthzKeeper)
 
 	appKeepers.TwapKeeper = twap.NewKeeper(
 		appKeepers.keys[twaptypes.StoreKey],
@@ -340,6 +341,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 
 	protorevKeeper := protorevkeeper.NewKeeper(
 		appCodec, appKeepers.keys[protorevtypes.StoreKey],
+		appKeepers.tkeys[protorevtypes.TransientStoreKey],
 		appKeepers.GetSubspace(protorevtypes.ModuleName),
 		appKeepers.AccountKeeper, appKeepers.BankKeeper, appKeepers.GAMMKeeper, appKeepers.EpochsKeeper, appKeepers.PoolManagerKeeper)
 	appKeepers.ProtoRevKeeper = &protorevKeeper
@@ -664,6 +666,13 @@ func (appKeepers *AppKeepers) SetupHooks() {
 		),
 	)
 
+	appKeepers.PoolManagerKeeper.SetPoolCreationListeners(
+		poolmanagertypes.NewPoolCreationListeners(
+			// insert pool creation listeners here
+			appKeepers.ProtoRevKeeper.PoolCreationListener(),
+		),
+	)
+
 	appKeepers.LockupKeeper.SetHooks(
 		lockuptypes.NewMultiLockupHooks(
 			// insert lockup hooks receivers here
@@ -0,0 +1,81 @@
+package v16_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/osmosis-labs/osmosis/v15/app/apptesting"
+	v16 "github.com/osmosis-labs/osmosis/v15/app/upgrades/v16"
+	protorevtypes "github.com/osmosis-labs/osmosis/v15/x/protorev/types"
+)
+
+type UpgradeTestSuite struct {
+	apptesting.KeeperTestHelper
+}
+
+func (suite *UpgradeTestSuite) SetupTest() {
+	suite.Setup()
+}
+
+func TestUpgradeTestSuite(t *testing.T) {
+	suite.Run(t, new(UpgradeTestSuite))
+}
+
+const dummyUpgradeHeight = 5
+
+func dummyUpgrade(suite *UpgradeTestSuite) {
+	suite.Ctx = suite.Ctx.WithBlockHeight(dummyUpgradeHeight - 1)
+	plan := upgradetypes.Plan{Name: v16.UpgradeName, Height: dummyUpgradeHeight}
+	err := suite.App.UpgradeKeeper.ScheduleUpgrade(suite.Ctx, plan)
+	suite.Require().NoError(err)
+	_, exists := suite.App.UpgradeKeeper.GetUpgradePlan(suite.Ctx)
+	suite.Require().True(exists)
+
+	suite.Ctx = suite.Ctx.WithBlockHeight(dummyUpgradeHeight)
+	suite.Require().NotPanics(func() {
+		beginBlockRequest := abci.RequestBeginBlock{}
+		suite.App.BeginBlocker(suite.Ctx, beginBlockRequest)
+	})
+}
+
+// protoRevParamsAddedInV16 are the store keys of the ProtoRev params that did not exist in v15.
+var protoRevParamsAddedInV16 = [][]byte{
+	protorevtypes.ParamStoreKeyMaxTradesPerBlock,
+	protorevtypes.ParamStoreKeySearcherRewardFraction,
+	protorevtypes.ParamStoreKeyDisabledUntilHeight,
+	protorevtypes.ParamStoreKeyMaxBackrunsPerPoolPerBlock,
+	protorevtypes.ParamStoreKeyMinPoolAgeBlocks,
+	protorevtypes.ParamStoreKeyWeightRoutesByTVL,
+}
+
+func (suite *UpgradeTestSuite) TestSetProtoRevParams() {
+	suite.SetupTest()
+
+	// The params set in v15 must be preserved.
+	adminAccount := suite.TestAccs[0].String()
+	suite.App.ProtoRevKeeper.SetAdminAccount(suite.Ctx, suite.TestAccs[0])
+
+	// Remove the params added in v16 from state, as they are on chain before the upgrade.
+	paramsStore := suite.Ctx.KVStore(suite.App.AppKeepers.GetKey(paramstypes.StoreKey))
+	protoRevParamsStore := prefix.NewStore(paramsStore, []byte(protorevtypes.ModuleName+"/"))
+	for _, key := range protoRevParamsAddedInV16 {
+		protoRevParamsStore.Delete(key)
+	}
+	suite.Require().Panics(func() { suite.App.ProtoRevKeeper.GetParams(suite.Ctx) })
+
+	dummyUpgrade(suite)
+
+	params := suite.App.ProtoRevKeeper.GetParams(suite.Ctx)
+	suite.Require().Equal(adminAccount, params.Admin)
+	suite.Require().Equal(protorevtypes.DefaultMaxTradesPerBlock, params.MaxTradesPerBlock)
+	suite.Require().True(protorevtypes.DefaultSearcherRewardFraction.Equal(params.SearcherRewardFraction))
+	suite.Require().Equal(protorevtypes.DefaultDisabledUntilHeight, params.DisabledUntilHeight)
+	suite.Require().Equal(protorevtypes.DefaultMaxBackrunsPerPoolPerBlock, params.MaxBackrunsPerPoolPerBlock)
+	suite.Require().Equal(protorevtypes.DefaultMinPoolAgeBlocks, params.MinPoolAgeBlocks)
+	suite.Require().Equal(protorevtypes.DefaultWeightRoutesByTVL, params.WeightRoutesByTVL)
+}
@@ -1,12 +1,16 @@
 package v16
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	paramskeeper "github.com/cosmos/cosmos-sdk/x/params/keeper"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
 	"github.com/osmosis-labs/osmosis/v15/app/keepers"
 	"github.com/osmosis-labs/osmosis/v15/app/upgrades"
+	protorevtypes "github.com/osmosis-labs/osmosis/v15/x/protorev/types"
 )
 
 func CreateUpgradeHandler(
@@ -23,6 +27,29 @@ func CreateUpgradeHandler(
 			return nil, err
 		}
 
+		// ProtoRev params added since v15 are missing from state, so they must be set before the
+		// module reads its params.
+		if err := setProtoRevParams(ctx, keepers.ParamsKeeper); err != nil {
+			return nil, err
+		}
+
 		return migrations, nil
 	}
 }
+
+// setProtoRevParams sets the ProtoRev params added in v16 to their defaults. The params set on
+// chain in v15 are left untouched.
+func setProtoRevParams(ctx sdk.Context, paramsKeeper *paramskeeper.Keeper) error {
+	paramSpace, ok := paramsKeeper.GetSubspace(protorevtypes.ModuleName)
+	if !ok {
+		return fmt.Errorf("param subspace for %s not found", protorevtypes.ModuleName)
+	}
+
+	paramSpace.Set(ctx, protorevtypes.ParamStoreKeyMaxTradesPerBlock, protorevtypes.DefaultMaxTradesPerBlock)
+	paramSpace.Set(ctx, protorevtypes.ParamStoreKeySearcherRewardFraction, protorevtypes.DefaultSearcherRewardFraction)
+	paramSpace.Set(ctx, protorevtypes.ParamStoreKeyDisabledUntilHeight, protorevtypes.DefaultDisabledUntilHeight)
+	paramSpace.Set(ctx, protorevtypes.ParamStoreKeyMaxBackrunsPerPoolPerBlock, protorevtypes.DefaultMaxBackrunsPerPoolPerBlock)
+	paramSpace.Set(ctx, protorevtypes.ParamStoreKeyMinPoolAgeBlocks, protorevtypes.DefaultMinPoolAgeBlocks)
+	paramSpace.Set(ctx, protorevtypes.ParamStoreKeyWeightRoutesByTVL, protorevtypes.DefaultWeightRoutesByTVL)
+	return nil
+}
@@ -3,6 +3,7 @@ package accum
 import (
 	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/cosmos/cosmos-sdk/store"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,6 +13,12 @@ import (
 
 // We keep this object as a way to interface with the methods, even though
 // only the Accumulator inside is stored in state
+//
+// The object caches the accumulator's value and total shares. Only the methods with
+// a pointer receiver (AddToAccumulator and Rescale) mutate this cache, and read methods
+// never do, nor do they return values sharing memory with it. An object that may be
+// mutated must not be shared between goroutines; use Clone to hand an independent
+// snapshot to e.g. a query goroutine instead.
 type AccumulatorObject struct {
 	// Store where accumulator is stored
 	store store.KVStore
@@ -24,6 +31,17 @@ type AccumulatorObject struct {
 
 	// Accumulator's total shares across all positions
 	totalShares sdk.Dec
+
+	// Shares that are owned by no position and that only dilute DistributeRewards.
+	// See MakeAccumulatorWithVirtualShares.
+	virtualShares sdk.Dec
+
+	// Factor by which the accumulator value is scaled internally. See MakeAccumulatorWithScalingFactor.
+	scalingFactor sdk.Dec
+
+	// Optional callback that receives the events emitted by the accumulator.
+	// Not stored in state; nil means that no events are emitted.
+	eventEmitter EventEmitter
 }
 
 // Makes a new accumulator at store/accum/{accumName}
@@ -38,7 +56,7 @@ func MakeAccumulator(accumStore store.KVStore, accumName string) error {
 	initAccumValue := sdk.NewDecCoins()
 	initTotalShares := sdk.ZeroDec()
 
-	newAccum := AccumulatorObject{accumStore, accumName, initAccumValue, initTotalShares}
+	newAccum := AccumulatorObject{accumStore, accumName, initAccumValue, initTotalShares, sdk.ZeroDec(), sdk.OneDec(), nil}
 
 	// Stores accumulator in state
 	setAccumulator(newAccum, initAccumValue, initTotalShares)
@@ -53,7 +71,7 @@ func MakeAccumulatorWithValueAndShare(accumStore store.KVStore, accumName string
 		return errors.New("Accumulator with given name already exists in store")
 	}
 
-	newAccum := AccumulatorObject{accumStore, accumName, accumValue, totalShares}
+	newAccum := AccumulatorObject{accumStore, accumName, accumValue, totalShares, sdk.ZeroDec(), sdk.OneDec(), nil}
 
 	// Stores accumulator in state
 	setAccumulator(newAccum, accumValue, totalShares)
@@ -61,7 +79,77 @@ func MakeAccumulatorWithValueAndShare(accumStore store.KVStore, accumName string
 	return nil
 }
 
-// Gets the current value of the accumulator corresponding to accumName in accumStore
+// MakeAccumulatorWithVirtualShares makes a new accumulator at store/accum/{accumName} whose
+// reward distribution is diluted by virtualShares shares that are owned by no position.
+//
+// Virtual shares only affect DistributeRewards, which spreads rewards over the total shares
+// plus the virtual shares. This bounds the reward per share that a single deposit of
+// dust can capture, which mitigates inflation attacks where an attacker who is alone in
+// an accumulator, or who front-runs its first real depositor, holds a tiny number of shares
+// and collects rewards distributed over them at a wildly inflated rate.
+//
+// The tradeoff is that the fraction virtualShares / (totalShares + virtualShares) of every
+// distribution is attributed to nobody and can never be claimed. Callers should pick a floor
+// that is negligible next to the share counts they expect from honest positions.
+// Returns error if already exists / theres some overlapping keys or if virtualShares is negative.
+func MakeAccumulatorWithVirtualShares(accumStore store.KVStore, accumName string, virtualShares sdk.Dec) error {
+	if accumStore.Has(formatAccumPrefixKey(accumName)) {
+		return errors.New("Accumulator with given name already exists in store")
+	}
+	if virtualShares.IsNil() || virtualShares.IsNegative() {
+		return NegativeVirtualSharesError{VirtualShares: virtualShares}
+	}
+
+	initAccumValue := sdk.NewDecCoins()
+	initTotalShares := sdk.ZeroDec()
+
+	newAccum := AccumulatorObject{accumStore, accumName, initAccumValue, initTotalShares, virtualShares, sdk.OneDec(), nil}
+
+	// Stores accumulator in state
+	setAccumulator(newAccum, initAccumValue, initTotalShares)
+
+	return nil
+}
+
+// MakeAccumulatorWithScalingFactor makes a new accumulator at store/accum/{accumName} whose
+// value is kept multiplied by scalingFactor.
+//
+// The accumulator value is growth per share, so reward programs with tiny rewards spread over
+// many shares lose most of their precision to sdk.Dec truncation, while programs with huge rewards
+// spread over few shares approach overflow. A scaling factor above one preserves precision for
+// the former and one below one defers overflow for the latter.
+//
+// Scaling is transparent to callers: amounts passed to AddToAccumulator and DistributeRewards are
+// scaled in, and rewards computed for positions are scaled back out, so reward amounts are the same
+// as for an unscaled accumulator up to rounding. GetValue and the position snapshots, however, are in
+// scaled units, so custom accumulator values must be derived from GetValue of the same accumulator.
+// Returns error if already exists / theres some overlapping keys or if scalingFactor is not positive.
+func MakeAccumulatorWithScalingFactor(accumStore store.KVStore, accumName string, scalingFactor sdk.Dec) error {
+	if accumStore.Has(formatAccumPrefixKey(accumName)) {
+		return errors.New("Accumulator with given name already exists in store")
+	}
+	if scalingFactor.IsNil() || !scalingFactor.IsPositive() {
+		return NonPositiveScalingFactorError{ScalingFactor: scalingFactor}
+	}
+
+	initAccumValue := sdk.NewDecCoins()
+	initTotalShares := sdk.ZeroDec()
+
+	newAccum := AccumulatorObject{accumStore, accumName, initAccumValue, initTotalShares, sdk.ZeroDec(), scalingFactor, nil}
+
+	// Stores accumulator in state
+	setAccumulator(newAccum, initAccumValue, initTotalShares)
+
+	return nil
+}
+
+// HasAccumulator returns true if an accumulator with accumName exists in accumStore.
+func HasAccumulator(accumStore store.KVStore, accumName string) bool {
+	return accumStore.Has(formatAccumPrefixKey(accumName))
+}
+
+// Gets the current value of the accumulator corresponding to accumName in accumStore.
+// Returns AccumDoesNotExistError if no such accumulator has been created.
 func GetAccumulator(accumStore store.KVStore, accumName string) (AccumulatorObject, error) {
 	accumContent := AccumulatorContent{}
 	found, err := osmoutils.Get(accumStore, formatAccumPrefixKey(accumName), &accumContent)
@@ -72,11 +160,38 @@ func GetAccumulator(accumStore store.KVStore, accumName string) (AccumulatorObje
 		return AccumulatorObject{}, AccumDoesNotExistError{AccumName: accumName}
 	}
 
-	accum := AccumulatorObject{accumStore, accumName, accumContent.AccumValue, accumContent.TotalShares}
+	// Accumulators created before virtual shares were introduced have none.
+	virtualShares := accumContent.VirtualShares
+	if virtualShares.IsNil() {
+		virtualShares = sdk.ZeroDec()
+	}
+
+	// Accumulators created before scaling factors were introduced are unscaled.
+	scalingFactor := accumContent.ScalingFactor
+	if scalingFactor.IsNil() {
+		scalingFactor = sdk.OneDec()
+	}
+
+	accum := AccumulatorObject{accumStore, accumName, accumContent.AccumValue, accumContent.TotalShares, virtualShares, scalingFactor, nil}
 
 	return accum, nil
 }
 
+// Clone returns a snapshot of the accumulator that does not share its cached value
+// or total shares with the original, so that it can be used from another goroutine.
+// The snapshot reads from the same store as the original, which must therefore be safe
+// for concurrent reads. The event emitter is not carried over to the snapshot.
+func (accum AccumulatorObject) Clone() AccumulatorObject {
+	return AccumulatorObject{
+		store:         accum.store,
+		name:          accum.name,
+		value:         cloneDecCoins(accum.value),
+		totalShares:   cloneDec(accum.totalShares),
+		virtualShares: cloneDec(accum.virtualShares),
+		scalingFactor: cloneDec(accum.scalingFactor),
+	}
+}
+
 // MustGetPosition returns the position associated with the given address. No errors in position retrieval are allowed.
 func (accum AccumulatorObject) MustGetPosition(name string) Record {
 	position := Record{}
@@ -98,17 +213,131 @@ func (accum AccumulatorObject) GetPosition(name string) (Record, error) {
 	return position, nil
 }
 
+// GetPositions returns the positions associated with the given names, keyed by name. Each distinct record is
+// read with a single point lookup, rather than by iterating over the accumulator's positions, since position
+// names are generally not contiguous in key order. Unlike GetPosition, it does not stop at the first missing
+// position. If any positions do not exist, the positions that do exist are returned alongside a
+// NoPositionsError listing all of the missing names. Any other error is returned immediately.
+func (accum AccumulatorObject) GetPositions(names []string) (map[string]Record, error) {
+	positions := make(map[string]Record, len(names))
+	missingNames := []string{}
+	seen := make(map[string]struct{}, len(names))
+	for _, name := range names {
+		if _, ok := seen[name]; ok {
+			continue
+		}
+		seen[name] = struct{}{}
+
+		position := Record{}
+		found, err := osmoutils.Get(accum.store, formatPositionPrefixKey(accum.name, name), &position)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			missingNames = append(missingNames, name)
+			continue
+		}
+
+		positions[name] = position
+	}
+
+	if len(missingNames) > 0 {
+		return positions, NoPositionsError{Names: missingNames}
+	}
+	return positions, nil
+}
+
 func setAccumulator(accum AccumulatorObject, value sdk.DecCoins, shares sdk.Dec) {
-	newAccum := AccumulatorContent{value, shares}
+	newAccum := AccumulatorContent{AccumValue: value, TotalShares: shares, VirtualShares: accum.virtualShares, ScalingFactor: accum.getScalingFactor()}
 	osmoutils.MustSet(accum.store, formatAccumPrefixKey(accum.name), &newAccum)
 }
 
 // AddToAccumulator updates the accumulator's value by amt.
 // It does so by increasing the value of the accumulator by
-// the given amount. Persists to store. Mutates the receiver.
+// the given amount, multiplied by the accumulator's scaling factor.
+// Persists to store. Mutates the receiver.
 func (accum *AccumulatorObject) AddToAccumulator(amt sdk.DecCoins) {
-	accum.value = accum.value.Add(amt...)
+	accum.addScaledToAccumulator(accum.scaleIn(amt))
+}
+
+// addScaledToAccumulator increases the value of the accumulator by the given
+// amount, which is already in scaled units. Persists to store. Mutates the receiver.
+func (accum *AccumulatorObject) addScaledToAccumulator(scaledAmt sdk.DecCoins) {
+	accum.value = accum.value.Add(scaledAmt...)
+	setAccumulator(*accum, accum.value, accum.totalShares)
+}
+
+// DistributeRewards spreads rewards over all of the accumulator's shares, increasing the
+// accumulator's value by rewards / (totalShares + virtualShares). The portion of rewards
+// attributable to virtual shares is never claimable by any position.
+// Total shares are re-fetched from state, since position updates do not mutate the receiver.
+// Persists to store. Mutates the receiver.
+// Returns ZeroSharesError if the accumulator has neither total shares nor virtual shares, e.g. because
+// every position has been removed. In that case nothing is written, so the caller still holds the
+// rewards and may distribute them again once positions exist.
+func (accum *AccumulatorObject) DistributeRewards(rewards sdk.DecCoins) error {
+	totalShares, err := accum.GetTotalShares()
+	if err != nil {
+		return err
+	}
+	accum.totalShares = totalShares
+
+	effectiveShares := totalShares.Add(accum.getVirtualShares())
+	if !effectiveShares.IsPositive() {
+		return ZeroSharesError
+	}
+
+	// Rewards are scaled before they are divided so that the scaling factor preserves the precision
+	// of small per-share amounts.
+	accum.addScaledToAccumulator(accum.scaleIn(rewards).QuoDecTruncate(effectiveShares))
+	return nil
+}
+
+// Rescale shrinks the magnitude of the accumulator if its value for the given denom
+// exceeds RescaleThreshold. Since the accumulator value is unbounded and only ever grows,
+// long-lived accumulators may otherwise eventually overflow sdk.Dec.
+//
+// Rewards owed to a position are computed as (value - initAccumValue) * numShares.
+// Rescaling divides the accumulator value and every position's accumulator snapshot by
+// rescaleFactor while multiplying the total shares and every position's shares by the
+// same factor. Shares are common to all denoms so the rescale is applied to every denom.
+// As a result, rewards owed are preserved up to rounding while magnitudes shrink.
+// Unclaimed rewards are left untouched.
+//
+// Returns the factor that shares were multiplied by. It is one if no rescale was necessary.
+// Callers that track share counts outside of the accumulator, or that derive accumulator
+// increments from such external share counts, must scale them by the returned factor.
+//
+// Callers must not rescale accumulators whose values are also stored outside of the accumulator
+// or whose shares must stay equal to an external quantity. The fee and uptime accumulators of
+// concentrated liquidity are such accumulators: their shares are position liquidity and their
+// values are also stored in per-tick growth trackers, so they cannot be rescaled.
+// Returns error if any database errors occur.
+func (accum *AccumulatorObject) Rescale(denom string) (sdk.Dec, error) {
+	if accum.value.AmountOf(denom).LTE(RescaleThreshold) {
+		return sdk.OneDec(), nil
+	}
+
+	positions, err := getAllPositionsWithKeys(*accum)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	for _, position := range positions {
+		position.record.InitAccumValue = position.record.InitAccumValue.QuoDec(rescaleFactor)
+		position.record.NumShares = position.record.NumShares.Mul(rescaleFactor)
+		if !position.record.InitialShares.IsNil() {
+			position.record.InitialShares = position.record.InitialShares.Mul(rescaleFactor)
+		}
+		osmoutils.MustSet(accum.store, position.key, &position.record)
+	}
+
+	accum.value = accum.value.QuoDec(rescaleFactor)
+	accum.totalShares = accum.totalShares.Mul(rescaleFactor)
+	accum.virtualShares = accum.getVirtualShares().Mul(rescaleFactor)
 	setAccumulator(*accum, accum.value, accum.totalShares)
+
+	return rescaleFactor, nil
 }
 
 // NewPosition creates a new position for the given name, with the given number of share units.
@@ -127,15 +356,11 @@ func (accum AccumulatorObject) NewPosition(name string, numShareUnits sdk.Dec, o
 // The position is initialized with empty unclaimed rewards
 // If there is an existing position for the given address, it is overwritten.
 func (accum AccumulatorObject) NewPositionCustomAcc(name string, numShareUnits sdk.Dec, customAccumulatorValue sdk.DecCoins, options *Options) error {
-	if customAccumulatorValue.IsAnyNegative() {
-		return NegativeCustomAccError{customAccumulatorValue}
-	}
-
-	if err := options.validate(); err != nil {
+	if err := validatePositionFields(customAccumulatorValue, sdk.NewDecCoins(), options); err != nil {
 		return err
 	}
 
-	initOrUpdatePosition(accum, customAccumulatorValue, name, numShareUnits, sdk.NewDecCoins(), options)
+	initOrUpdatePosition(accum, customAccumulatorValue, name, numShareUnits, numShareUnits, sdk.NewDecCoins(), nil, nil, options)
 
 	// Update total shares in accum (re-fetch accum from state to ensure it's up to date)
 	accum, err := GetAccumulator(accum.store, accum.name)
@@ -147,6 +372,57 @@ func (accum AccumulatorObject) NewPositionCustomAcc(name string, numShareUnits s
 	return nil
 }
 
+// PositionInput describes a position to be created by CreatePositionsBatch.
+type PositionInput struct {
+	Name                   string
+	NumShares              sdk.Dec
+	CustomAccumulatorValue sdk.DecCoins
+	Options                *Options
+}
+
+// CreatePositionsBatch creates a position for every given input, as NewPositionCustomAcc would,
+// but updates the accumulator's total shares in a single write rather than once per position.
+// All inputs are validated before anything is written, so either every position is created or
+// none are. Each input must have a non-negative custom accumulator value, valid options and
+// a name that is unique within the batch.
+// If there is an existing position for a given name, it is overwritten.
+// Returns the joined per-input validation errors, if any.
+func (accum AccumulatorObject) CreatePositionsBatch(positions []PositionInput) error {
+	validationErrs := []error{}
+	seenNames := make(map[string]bool, len(positions))
+	for i, position := range positions {
+		var err error
+		if seenNames[position.Name] {
+			err = DuplicatePositionNameError{Name: position.Name}
+		} else {
+			err = validatePositionFields(position.CustomAccumulatorValue, sdk.NewDecCoins(), position.Options)
+		}
+		seenNames[position.Name] = true
+
+		if err != nil {
+			validationErrs = append(validationErrs, InvalidPositionInputError{Index: i, Name: position.Name, Err: err})
+		}
+	}
+	if len(validationErrs) > 0 {
+		return errors.Join(validationErrs...)
+	}
+
+	// Re-fetch accum from state to ensure total shares are up to date.
+	accum, err := GetAccumulator(accum.store, accum.name)
+	if err != nil {
+		return err
+	}
+
+	totalShares := accum.totalShares
+	for _, position := range positions {
+		initOrUpdatePosition(accum, position.CustomAccumulatorValue, position.Name, position.NumShares, position.NumShares, sdk.NewDecCoins(), nil, nil, position.Options)
+		totalShares = totalShares.Add(position.NumShares)
+	}
+	setAccumulator(accum, accum.value, totalShares)
+
+	return nil
+}
+
 // AddToPosition adds newShares of shares to an existing position with the given name.
 // This is functionally equivalent to claiming rewards, closing down the position, and
 // opening a fresh one with the new number of shares. We can represent this behavior by
@@ -201,7 +477,7 @@ func (accum AccumulatorObject) AddToPositionCustomAcc(name string, newShares sdk
 
 	// Update user's position with new number of shares while moving its unaccrued rewards
 	// into UnclaimedRewards. Starting accumulator value is moved up to accum'scurrent value
-	initOrUpdatePosition(accum, customAccumulatorValue, name, oldNumShares.Add(newShares), unclaimedRewards, position.Options)
+	initOrUpdatePosition(accum, customAccumulatorValue, name, oldNumShares.Add(newShares), position.InitialShares, unclaimedRewards, position.ClaimedRewards, position.CarriedRewards, position.Options)
 
 	// Update total shares in accum (re-fetch accum from state to ensure it's up to date)
 	accum, err = GetAccumulator(accum.store, accum.name)
@@ -252,7 +528,7 @@ func (accum AccumulatorObject) RemoveFromPositionCustomAcc(name string, numShare
 	}
 
 	// Update user's position with new number of shares
-	initOrUpdatePosition(accum, customAccumulatorValue, name, oldNumShares.Sub(numSharesToRemove), unclaimedRewards, position.Options)
+	initOrUpdatePosition(accum, customAccumulatorValue, name, oldNumShares.Sub(numSharesToRemove), position.InitialShares, unclaimedRewards, position.ClaimedRewards, position.CarriedRewards, position.Options)
 
 	// Update total shares in accum (re-fetch accum from state to ensure it's up to date)
 	accum, err = GetAccumulator(accum.store, accum.name)
@@ -292,6 +568,37 @@ func (accum AccumulatorObject) UpdatePositionCustomAcc(name string, numShares sd
 	return accum.AddToPositionCustomAcc(name, numShares, customAccumulatorValue)
 }
 
+// PreviewUpdate returns the record that the position with the given name would have after
+// UpdatePosition(name, shareDelta), without writing anything to state. The returned record
+// holds the position's new number of shares, its accumulator snapshot moved up to the current
+// accumulator value and its unclaimed rewards including all rewards accrued so far.
+// Fails with the same errors as UpdatePosition.
+func (accum AccumulatorObject) PreviewUpdate(name string, shareDelta sdk.Dec) (Record, error) {
+	if shareDelta.Equal(sdk.ZeroDec()) {
+		return Record{}, ZeroSharesError
+	}
+
+	position, err := GetPosition(accum, name)
+	if err != nil {
+		return Record{}, err
+	}
+
+	// Ensure not removing more shares than exist
+	if shareDelta.IsNegative() && shareDelta.Neg().GT(position.NumShares) {
+		return Record{}, fmt.Errorf("Attempted to remove more shares (%s) than exist in the position (%s)", shareDelta.Neg(), position.NumShares)
+	}
+
+	return Record{
+		NumShares:        position.NumShares.Add(shareDelta),
+		InitAccumValue:   accum.value,
+		UnclaimedRewards: getTotalRewards(accum, position),
+		Options:          position.Options,
+		ClaimedRewards:   position.ClaimedRewards,
+		InitialShares:    position.InitialShares,
+		CarriedRewards:   position.CarriedRewards,
+	}, nil
+}
+
 // SetPositionCustomAcc sets the position's accumulator to the given value.
 // Does not update shares or attempt to claim rewards.
 // The new accumulator value must be greater than or equal to the old accumulator value.
@@ -305,7 +612,31 @@ func (accum AccumulatorObject) SetPositionCustomAcc(name string, customAccumulat
 
 	// Update the user's position with the new accumulator value. The unclaimed rewards, options, and
 	// the number of shares stays the same as in the original position.
-	initOrUpdatePosition(accum, customAccumulatorValue, name, position.NumShares, position.UnclaimedRewards, position.Options)
+	initOrUpdatePosition(accum, customAccumulatorValue, name, position.NumShares, position.InitialShares, position.UnclaimedRewards, position.ClaimedRewards, position.CarriedRewards, position.Options)
+
+	return nil
+}
+
+// RenamePosition moves the position stored under `oldName` to `newName`, keeping its shares,
+// accumulator snapshot, unclaimed rewards and options intact so that no rewards are lost or claimed.
+// Returns error if no position exists under `oldName` or a position already exists under `newName`.
+// No state is modified on error.
+func (accum AccumulatorObject) RenamePosition(oldName, newName string) error {
+	position, err := GetPosition(accum, oldName)
+	if err != nil {
+		return err
+	}
+
+	hasNewPosition, err := accum.HasPosition(newName)
+	if err != nil {
+		return err
+	}
+	if hasNewPosition {
+		return PositionAlreadyExistsError{Name: newName}
+	}
+
+	osmoutils.MustSet(accum.store, formatPositionPrefixKey(accum.name, newName), &position)
+	accum.deletePosition(oldName)
 
 	return nil
 }
@@ -325,6 +656,60 @@ func (accum AccumulatorObject) GetPositionSize(name string) (sdk.Dec, error) {
 	return position.NumShares, nil
 }
 
+// GetPositionInitialShares returns the number of shares the position corresponding to `name`
+// in accumulator `accum` was created with, or an error if no position exists. Unlike GetPositionSize,
+// this is not affected by adding to, removing from or updating the position. Positions created
+// before initial shares were tracked report zero.
+func (accum AccumulatorObject) GetPositionInitialShares(name string) (sdk.Dec, error) {
+	position, err := GetPosition(accum, name)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	if position.InitialShares.IsNil() {
+		return sdk.ZeroDec(), nil
+	}
+	return position.InitialShares, nil
+}
+
+// GetPositionAccumSnapshot returns the accumulator value stored on the position corresponding to `name`
+// in accumulator `accum`, or an error if no position exists. This is the accumulator value at the time
+// the position was last created, updated or claimed from. Comparing it against GetValue() shows the
+// growth per share accrued since.
+func (accum AccumulatorObject) GetPositionAccumSnapshot(name string) (sdk.DecCoins, error) {
+	position, err := GetPosition(accum, name)
+	if err != nil {
+		return sdk.DecCoins{}, err
+	}
+
+	return position.InitAccumValue, nil
+}
+
+// GetPositionRewards returns the total rewards owed to the position corresponding to `name`
+// in accumulator `accum` without claiming them, or an error if no position exists.
+// This is (value - initAccumValue) * shares / scalingFactor + unclaimedRewards, before any claimable
+// fraction from the position's options is applied and before truncation.
+func (accum AccumulatorObject) GetPositionRewards(name string) (sdk.DecCoins, error) {
+	position, err := GetPosition(accum, name)
+	if err != nil {
+		return sdk.DecCoins{}, err
+	}
+
+	return getTotalRewards(accum, position), nil
+}
+
+// GetPositionCarriedRewards returns the sub-unit remainder carried by the position corresponding to `name`
+// in accumulator `accum` from its last claim, or an error if no position exists. The remainder is added to the
+// claimable rewards at the next claim, after the claimable fraction and the max reward have been applied.
+func (accum AccumulatorObject) GetPositionCarriedRewards(name string) (sdk.DecCoins, error) {
+	position, err := GetPosition(accum, name)
+	if err != nil {
+		return sdk.DecCoins{}, err
+	}
+
+	return position.CarriedRewards, nil
+}
+
 // HasPosition returns true if a position with the given name exists,
 // false otherwise. Returns error if internal database error occurs.
 func (accum AccumulatorObject) HasPosition(name string) (bool, error) {
@@ -346,37 +731,130 @@ func (accum AccumulatorObject) GetName() string {
 	return accum.name
 }
 
-// GetValue returns the current value of the accumulator.
+// GetValue returns a copy of the current value of the accumulator.
+// The value is in scaled units, i.e. multiplied by the accumulator's scaling factor.
 func (accum AccumulatorObject) GetValue() sdk.DecCoins {
-	return accum.value
+	return cloneDecCoins(accum.value)
+}
+
+// GetRewardDenoms returns the denoms the accumulator's value currently holds, sorted.
+// It is never nil, so that an accumulator without any rewards yet returns an empty list.
+func (accum AccumulatorObject) GetRewardDenoms() []string {
+	denoms := make([]string, 0, len(accum.value))
+	for _, coin := range accum.value {
+		denoms = append(denoms, coin.Denom)
+	}
+	sort.Strings(denoms)
+	return denoms
+}
+
+// ClaimRewards claims the rewards for the given address, and returns the amount of rewards claimed
+// alongside the amount of rewards forfeited. It behaves exactly like ClaimRewardsDetailed, but does not
+// return the amount of rewards capped by the position's max reward. Callers that set a max reward
+// should use ClaimRewardsDetailed instead.
+// Returns error if no position exists for the given address. Returns error if any
+// database errors occur.
+func (accum AccumulatorObject) ClaimRewards(positionName string) (sdk.Coins, sdk.DecCoins, error) {
+	claimed, forfeited, _, err := accum.ClaimRewardsDetailed(positionName)
+	return claimed, forfeited, err
 }
 
-// ClaimRewards claims the rewards for the given address, and returns the amount of rewards claimed.
+// ClaimRewardsDetailed claims the rewards for the given address, and returns the amount of rewards claimed
+// alongside the amount of rewards forfeited and the amount of rewards capped.
+// If the position's options carry a claimable fraction, only that fraction of the total rewards
+// is claimable and the rest is forfeited. Otherwise, all rewards are claimable and nothing is forfeited.
+// If the position's options carry a max reward, the claimable rewards are clamped so that the position
+// never claims more than the max reward over its lifetime, and the overflow is returned as capped.
+// It is up to the caller to decide what to do with the forfeited and capped rewards.
+// Only integer coins are returned, rounded down. The sub-unit remainder of the claimable rewards is carried in the
+// position and added back to the claimable rewards at the next claim, so that positions with very few
+// shares, whose rewards truncate to zero at every claim, eventually receive them instead of losing them.
+// The carried rewards are neither forfeited nor capped again, and are dropped if the position is removed.
 // Upon claiming the rewards, the position at the current address is reset to have no
 // unclaimed rewards. The position's accumulator is also set to the current accumulator value.
+// If the accumulator has an event emitter, an accumulator_claim event is emitted for non-zero claims.
 // Returns error if no position exists for the given address. Returns error if any
 // database errors occur.
-func (accum AccumulatorObject) ClaimRewards(positionName string) (sdk.Coins, error) {
+func (accum AccumulatorObject) ClaimRewardsDetailed(positionName string) (sdk.Coins, sdk.DecCoins, sdk.DecCoins, error) {
 	position, err := GetPosition(accum, positionName)
 	if err != nil {
-		return sdk.Coins{}, NoPositionError{positionName}
+		return sdk.Coins{}, sdk.DecCoins{}, sdk.DecCoins{}, NoPositionError{positionName}
 	}
 
 	totalRewards := getTotalRewards(accum, position)
 
+	// Split the rewards into the claimable and forfeited portions.
+	claimableRewards := totalRewards.MulDecTruncate(position.Options.claimableFraction())
+	forfeitedRewards := totalRewards.Sub(claimableRewards)
+
+	// Clamp the claimable rewards to what remains of the position's max reward.
+	claimableRewards, cappedRewards := position.Options.capRewards(claimableRewards, position.ClaimedRewards)
+
+	// The remainder carried from the last claim was already claimable, so it is added after
+	// the claimable fraction and the max reward have been applied.
+	claimableRewards = claimableRewards.Add(position.CarriedRewards...)
+
 	// Return the integer coins to the user
-	// The remaining change is thrown away.
-	// This is acceptable because we round in favour of the protocol.
-	truncatedRewards, _ := totalRewards.TruncateDecimal()
+	// The remaining change is carried to the next claim.
+	truncatedRewards, carriedRewards := claimableRewards.TruncateDecimal()
+
+	// Claimed rewards are only tracked when they are needed to enforce a max reward.
+	claimedRewards := position.ClaimedRewards
+	if position.Options.hasMaxReward() {
+		claimedRewards = claimedRewards.Add(truncatedRewards...)
+	}
 
 	// remove the position from state entirely if numShares = zero
 	if position.NumShares.Equal(sdk.ZeroDec()) {
 		accum.deletePosition(positionName)
 	} else { // else, create a completely new position, with no rewards
-		initOrUpdatePosition(accum, accum.value, positionName, position.NumShares, sdk.NewDecCoins(), position.Options)
+		initOrUpdatePosition(accum, accum.value, positionName, position.NumShares, position.InitialShares, sdk.NewDecCoins(), claimedRewards, carriedRewards, position.Options)
 	}
 
-	return truncatedRewards, nil
+	accum.emitClaimEvent(positionName, truncatedRewards)
+
+	return truncatedRewards, forfeitedRewards, cappedRewards, nil
+}
+
+// ClaimAndRestake claims the rewards of the given position exactly like ClaimRewardsDetailed, and then adds the claimed
+// rewards in the target accumulator's reward denoms directly to the target accumulator's growth rather than
+// returning them, so that they never have to leave the module holding both accumulators.
+// The target accumulator's reward denoms are the denoms its value already tracks. The restaked rewards are
+// spread over the target's shares with DistributeRewards, since the accumulator value is growth per share.
+// Claimed rewards in other denoms, or all claimed rewards if the target has no shares, are returned to the
+// caller instead. The forfeited and capped rewards are returned as by ClaimRewardsDetailed.
+// Returns the rewards moved to the target accumulator, the rewards returned to the caller, and the forfeited
+// and capped rewards.
+// Returns error if no position exists for the given name. Returns error if any database errors occur.
+func (accum AccumulatorObject) ClaimAndRestake(positionName string, targetAccum *AccumulatorObject) (restaked sdk.Coins, returned sdk.Coins, forfeited sdk.DecCoins, capped sdk.DecCoins, err error) {
+	claimed, forfeited, capped, err := accum.ClaimRewardsDetailed(positionName)
+	if err != nil {
+		return sdk.Coins{}, sdk.Coins{}, sdk.DecCoins{}, sdk.DecCoins{}, err
+	}
+
+	restaked = sdk.NewCoins()
+	returned = sdk.NewCoins()
+	targetValue := targetAccum.GetValue()
+	for _, coin := range claimed {
+		if targetValue.AmountOf(coin.Denom).IsPositive() {
+			restaked = restaked.Add(coin)
+		} else {
+			returned = returned.Add(coin)
+		}
+	}
+
+	if restaked.IsZero() {
+		return restaked, returned, forfeited, capped, nil
+	}
+
+	err = targetAccum.DistributeRewards(sdk.NewDecCoinsFromCoins(restaked...))
+	if errors.Is(err, ZeroSharesError) {
+		return sdk.NewCoins(), claimed, forfeited, capped, nil
+	} else if err != nil {
+		return sdk.Coins{}, sdk.Coins{}, sdk.DecCoins{}, sdk.DecCoins{}, err
+	}
+
+	return restaked, returned, forfeited, capped, nil
 }
 
 // GetTotalShares returns the total number of shares in the accumulator
@@ -384,3 +862,107 @@ func (accum AccumulatorObject) GetTotalShares() (sdk.Dec, error) {
 	accum, err := GetAccumulator(accum.store, accum.name)
 	return accum.totalShares, err
 }
+
+// RecomputeTotalShares sums the shares of every position of the accumulator and returns both the
+// total shares currently stored and the recomputed sum. The two are expected to agree, so a
+// difference indicates that the stored total has drifted from the positions.
+// If isOrphaned is non-nil, positions whose name it reports as orphaned, e.g. because whatever they
+// were tracking no longer exists, are left out of the recomputed sum.
+// If repair is true, the records of orphaned positions are deleted and, if the two totals disagree,
+// the stored total shares are overwritten with the recomputed sum and the receiver is updated accordingly.
+// Returns error if any database errors occur.
+func (accum *AccumulatorObject) RecomputeTotalShares(repair bool, isOrphaned func(name string) bool) (storedTotalShares sdk.Dec, recomputedTotalShares sdk.Dec, err error) {
+	storedAccum, err := GetAccumulator(accum.store, accum.name)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	storedTotalShares = storedAccum.totalShares
+
+	positions, err := getAllPositionsWithKeys(*accum)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	positionPrefix := formatPositionPrefixKey(accum.name, "")
+	recomputedTotalShares = sdk.ZeroDec()
+	for _, position := range positions {
+		if isOrphaned != nil && isOrphaned(string(position.key[len(positionPrefix):])) {
+			if repair {
+				accum.store.Delete(position.key)
+			}
+			continue
+		}
+		recomputedTotalShares = recomputedTotalShares.Add(position.record.NumShares)
+	}
+
+	if repair && !storedTotalShares.Equal(recomputedTotalShares) {
+		accum.value = storedAccum.value
+		accum.totalShares = recomputedTotalShares
+		accum.virtualShares = storedAccum.virtualShares
+		accum.scalingFactor = storedAccum.scalingFactor
+		setAccumulator(*accum, accum.value, accum.totalShares)
+	}
+
+	return storedTotalShares, recomputedTotalShares, nil
+}
+
+// AssertSolvent returns nil if the escrowed balance covers the rewards owed to all positions of the accumulator.
+// The owed rewards of a position are the rewards it can still claim, as computed by ClaimRewardsDetailed,
+// plus the remainder carried from its last claim. The rewards a position forfeits to its claimable fraction
+// or to its max reward are never paid out to it, so they are not owed.
+// The owed rewards are summed across positions and truncated to integer coins before being compared against
+// the escrowed balance, so that the sub-unit remainders that are never paid out do not make the check fail.
+// Returns InsolventAccumulatorError if the owed rewards exceed the escrowed balance in any denom.
+// Returns error if any database errors occur.
+func (accum AccumulatorObject) AssertSolvent(escrowed sdk.Coins) error {
+	positions, err := getAllPositionsWithKeys(accum)
+	if err != nil {
+		return err
+	}
+
+	owedRewards := sdk.NewDecCoins()
+	for _, position := range positions {
+		record := position.record
+		claimableRewards := getTotalRewards(accum, record).MulDecTruncate(record.Options.claimableFraction())
+		claimableRewards, _ = record.Options.capRewards(claimableRewards, record.ClaimedRewards)
+		owedRewards = owedRewards.Add(claimableRewards...)
+		owedRewards = owedRewards.Add(record.CarriedRewards...)
+	}
+
+	owedCoins, _ := owedRewards.TruncateDecimal()
+	if !escrowed.IsAllGTE(owedCoins) {
+		return InsolventAccumulatorError{AccumName: accum.name, OwedRewards: owedCoins, Escrowed: escrowed}
+	}
+
+	return nil
+}
+
+// GetVirtualShares returns the number of virtual shares in the accumulator.
+// See MakeAccumulatorWithVirtualShares.
+func (accum AccumulatorObject) GetVirtualShares() (sdk.Dec, error) {
+	accum, err := GetAccumulator(accum.store, accum.name)
+	return accum.getVirtualShares(), err
+}
+
+// getVirtualShares returns the cached virtual shares, treating unset ones as zero.
+func (accum AccumulatorObject) getVirtualShares() sdk.Dec {
+	if accum.virtualShares.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return accum.virtualShares
+}
+
+// GetScalingFactor returns the factor by which the accumulator value is scaled.
+// See MakeAccumulatorWithScalingFactor.
+func (accum AccumulatorObject) GetScalingFactor() (sdk.Dec, error) {
+	accum, err := GetAccumulator(accum.store, accum.name)
+	return accum.getScalingFactor(), err
+}
+
+// getScalingFactor returns the cached scaling factor, treating an unset one as one.
+func (accum AccumulatorObject) getScalingFactor() sdk.Dec {
+	if accum.scalingFactor.IsNil() {
+		return sdk.OneDec()
+	}
+	return accum.scalingFactor
+}
@@ -9,15 +9,19 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -28,6 +32,13 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type AccumulatorContent struct {
 	AccumValue  github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=accum_value,json=accumValue,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"accum_value"`
 	TotalShares github_com_cosmos_cosmos_sdk_types.Dec      `protobuf:"bytes,2,opt,name=total_shares,json=totalShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"total_shares"`
+	// virtual_shares are shares owned by no position that dilute reward
+	// distribution to resist inflation attacks.
+	VirtualShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=virtual_shares,json=virtualShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"virtual_shares"`
+	// scaling_factor is the factor by which accum_value is multiplied
+	// internally so that per-share amounts are kept at a magnitude with enough
+	// precision. If unset, the accumulator is unscaled.
+	ScalingFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=scaling_factor,json=scalingFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"scaling_factor"`
 }
 
 func (m *AccumulatorContent) Reset()         { *m = AccumulatorContent{} }
@@ -71,6 +82,14 @@ func (m *AccumulatorContent) GetAccumValue() github_com_cosmos_cosmos_sdk_types.
 }
 
 type Options struct {
+	// claimable_fraction is the fraction of a position's rewards that can be
+	// claimed, in [0, 1]. The rest is forfeited upon claiming. If unset, all
+	// rewards are claimable.
+	ClaimableFraction *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=claimable_fraction,json=claimableFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"claimable_fraction,omitempty"`
+	// max_reward is the maximum amount of each denom that the position may
+	// claim over its lifetime. Claimable rewards above the cap are returned
+	// separately as capped upon claiming. Denoms not present are uncapped.
+	MaxReward github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=max_reward,json=maxReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_reward"`
 }
 
 func (m *Options) Reset()         { *m = Options{} }
@@ -106,11 +125,30 @@ func (m *Options) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Options proto.InternalMessageInfo
 
+func (m *Options) GetMaxReward() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxReward
+	}
+	return nil
+}
+
 type Record struct {
 	NumShares        github_com_cosmos_cosmos_sdk_types.Dec      `protobuf:"bytes,1,opt,name=num_shares,json=numShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"num_shares"`
 	InitAccumValue   github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=init_accum_value,json=initAccumValue,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"init_accum_value"`
 	UnclaimedRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=unclaimed_rewards,json=unclaimedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"unclaimed_rewards"`
 	Options          *Options                                    `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	// claimed_rewards is the total amount of rewards claimed by the position.
+	// It is only tracked for positions with a max_reward set in their options.
+	ClaimedRewards github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=claimed_rewards,json=claimedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"claimed_rewards"`
+	// initial_shares is the number of shares the position was created with.
+	// Unlike num_shares, it is not changed by subsequent updates to the
+	// position, other than being rescaled alongside num_shares.
+	InitialShares github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=initial_shares,json=initialShares,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"initial_shares"`
+	// carried_rewards is the sub-unit remainder of the rewards claimable at the
+	// last claim that could not be paid out as integer coins. It is added back
+	// to the claimable rewards at the next claim so that small positions do not
+	// lose their rewards to truncation.
+	CarriedRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,7,rep,name=carried_rewards,json=carriedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"carried_rewards"`
 }
 
 func (m *Record) Reset()         { *m = Record{} }
@@ -167,42 +205,145 @@ func (m *Record) GetOptions() *Options {
 	return nil
 }
 
+func (m *Record) GetClaimedRewards() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ClaimedRewards
+	}
+	return nil
+}
+
+func (m *Record) GetCarriedRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.CarriedRewards
+	}
+	return nil
+}
+
+// RewardStream linearly streams total_reward into an accumulator between
+// start_time and end_time.
+type RewardStream struct {
+	TotalReward github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=total_reward,json=totalReward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"total_reward"`
+	StartTime   time.Time                                   `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime     time.Time                                   `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// last_accrued_time is the time up to which rewards have been added to the
+	// accumulator. It starts out at start_time.
+	LastAccruedTime time.Time `protobuf:"bytes,4,opt,name=last_accrued_time,json=lastAccruedTime,proto3,stdtime" json:"last_accrued_time"`
+}
+
+func (m *RewardStream) Reset()         { *m = RewardStream{} }
+func (m *RewardStream) String() string { return proto.CompactTextString(m) }
+func (*RewardStream) ProtoMessage()    {}
+func (*RewardStream) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4866f7c74a169dc2, []int{3}
+}
+func (m *RewardStream) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardStream) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardStream.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardStream) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardStream.Merge(m, src)
+}
+func (m *RewardStream) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardStream) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardStream.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardStream proto.InternalMessageInfo
+
+func (m *RewardStream) GetTotalReward() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.TotalReward
+	}
+	return nil
+}
+
+func (m *RewardStream) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *RewardStream) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *RewardStream) GetLastAccruedTime() time.Time {
+	if m != nil {
+		return m.LastAccruedTime
+	}
+	return time.Time{}
+}
+
 func init() {
 	proto.RegisterType((*AccumulatorContent)(nil), "osmosis.accum.v1beta1.AccumulatorContent")
 	proto.RegisterType((*Options)(nil), "osmosis.accum.v1beta1.Options")
 	proto.RegisterType((*Record)(nil), "osmosis.accum.v1beta1.Record")
+	proto.RegisterType((*RewardStream)(nil), "osmosis.accum.v1beta1.RewardStream")
 }
 
 func init() { proto.RegisterFile("osmosis/accum/v1beta1/accum.proto", fileDescriptor_4866f7c74a169dc2) }
 
 var fileDescriptor_4866f7c74a169dc2 = []byte{
-	// 401 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x41, 0xcb, 0xd3, 0x30,
-	0x1c, 0xc6, 0x9b, 0x77, 0xf2, 0xbe, 0x2c, 0x15, 0x99, 0x45, 0xa1, 0x0c, 0xc9, 0xe6, 0x0e, 0x32,
-	0x90, 0xa5, 0x6c, 0xbb, 0x78, 0xdd, 0xe6, 0xc5, 0x83, 0x88, 0x15, 0x3c, 0x78, 0x29, 0x69, 0x1a,
-	0xb6, 0x60, 0x9b, 0x8c, 0x26, 0x99, 0x88, 0xe0, 0x47, 0x10, 0x3f, 0x87, 0x9f, 0x64, 0xc7, 0x1d,
-	0x45, 0x61, 0xca, 0xf6, 0x45, 0xa4, 0x49, 0x3b, 0x77, 0xf0, 0x20, 0x2f, 0xec, 0x94, 0xa6, 0x7d,
-	0xfa, 0xfb, 0x95, 0xa7, 0xff, 0xc0, 0xc7, 0x52, 0x15, 0x52, 0x71, 0x15, 0x11, 0x4a, 0x4d, 0x11,
-	0x6d, 0xc6, 0x29, 0xd3, 0x64, 0xec, 0x76, 0x78, 0x5d, 0x4a, 0x2d, 0x83, 0x87, 0x75, 0x04, 0xbb,
-	0x9b, 0x75, 0xa4, 0xfb, 0x60, 0x29, 0x97, 0xd2, 0x26, 0xa2, 0xea, 0xca, 0x85, 0xbb, 0x88, 0xda,
-	0x74, 0x94, 0x12, 0xc5, 0x4e, 0x34, 0x2a, 0xb9, 0x70, 0xcf, 0x07, 0x3f, 0x01, 0x0c, 0x66, 0x15,
-	0xc7, 0xe4, 0x44, 0xcb, 0x72, 0x21, 0x85, 0x66, 0x42, 0x07, 0x25, 0xf4, 0x2d, 0x3d, 0xd9, 0x90,
-	0xdc, 0xb0, 0x10, 0xf4, 0x5b, 0x43, 0x7f, 0xf2, 0x08, 0x3b, 0x18, 0xae, 0x60, 0x8d, 0x17, 0x3f,
-	0x67, 0x74, 0x21, 0xb9, 0x98, 0x4f, 0xb7, 0xfb, 0x9e, 0xf7, 0xed, 0x57, 0xef, 0xe9, 0x92, 0xeb,
-	0x95, 0x49, 0x31, 0x95, 0x45, 0x54, 0xcb, 0xdd, 0x32, 0x52, 0xd9, 0xfb, 0x48, 0x7f, 0x5c, 0x33,
-	0xd5, 0xbc, 0xa3, 0x62, 0x68, 0x2d, 0x6f, 0x2b, 0x49, 0xf0, 0x1a, 0xde, 0xd5, 0x52, 0x93, 0x3c,
-	0x51, 0x2b, 0x52, 0x32, 0x15, 0x5e, 0xf5, 0xc1, 0xb0, 0x3d, 0xc7, 0x15, 0xf6, 0xc7, 0xbe, 0xf7,
-	0xe4, 0xff, 0xb0, 0xb1, 0x6f, 0x19, 0x6f, 0x2c, 0x62, 0xd0, 0x86, 0x37, 0xaf, 0xd6, 0x9a, 0x4b,
-	0xa1, 0x06, 0x5f, 0x5a, 0xf0, 0x3a, 0x66, 0x54, 0x96, 0x59, 0xf0, 0x12, 0x42, 0x61, 0x8a, 0x46,
-	0x03, 0x6e, 0xa5, 0x69, 0x0b, 0x53, 0x38, 0x49, 0xf0, 0x09, 0x76, 0xb8, 0xe0, 0x3a, 0x39, 0x2f,
-	0xec, 0xea, 0x52, 0x85, 0xdd, 0xab, 0x54, 0xb3, 0xbf, 0xa5, 0x7d, 0x86, 0xf7, 0x8d, 0xa0, 0x39,
-	0xe1, 0x05, 0xcb, 0x92, 0x92, 0x7d, 0x20, 0x65, 0xa6, 0xc2, 0xd6, 0xa5, 0xec, 0x9d, 0x93, 0x2b,
-	0x76, 0xaa, 0xe0, 0x19, 0xbc, 0x91, 0xae, 0xe1, 0xf0, 0x4e, 0x1f, 0x0c, 0xfd, 0x09, 0xc2, 0xff,
-	0x1c, 0x4f, 0x5c, 0xff, 0x87, 0xb8, 0x89, 0xcf, 0x5f, 0x6c, 0x0f, 0x08, 0xec, 0x0e, 0x08, 0xfc,
-	0x3e, 0x20, 0xf0, 0xf5, 0x88, 0xbc, 0xdd, 0x11, 0x79, 0xdf, 0x8f, 0xc8, 0x7b, 0x17, 0x9d, 0x7d,
-	0x52, 0x0d, 0x1b, 0xe5, 0x24, 0x55, 0xcd, 0xc6, 0xae, 0x46, 0xf3, 0xbc, 0x3e, 0x25, 0xe9, 0xb5,
-	0x9d, 0xe5, 0xe9, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf3, 0xc0, 0x03, 0x44, 0x3d, 0x03, 0x00,
-	0x00,
+	// 659 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0xcf, 0x4f, 0x13, 0x41,
+	0x14, 0xc7, 0xbb, 0x2d, 0x50, 0xfa, 0x8a, 0xfc, 0x98, 0x68, 0x52, 0x89, 0xd9, 0x62, 0x0f, 0x86,
+	0xc4, 0xb0, 0x2b, 0x70, 0xf1, 0x66, 0x28, 0x86, 0xc4, 0x83, 0x51, 0x8b, 0x78, 0x30, 0x31, 0x9b,
+	0xd9, 0xed, 0x50, 0x46, 0x77, 0x67, 0x9a, 0x99, 0x59, 0x44, 0x4d, 0xbc, 0x7b, 0xe3, 0xef, 0xf0,
+	0x2f, 0xe1, 0xc8, 0xc1, 0x44, 0x63, 0x0c, 0x18, 0xf8, 0x47, 0xcc, 0xfc, 0xd8, 0x96, 0x10, 0x0e,
+	0xd0, 0xd8, 0xd3, 0xee, 0xec, 0xbc, 0xf9, 0x7c, 0x67, 0xdf, 0xfb, 0xce, 0x1b, 0xb8, 0xcf, 0x65,
+	0xc6, 0x25, 0x95, 0x21, 0x4e, 0x92, 0x3c, 0x0b, 0xf7, 0x57, 0x63, 0xa2, 0xf0, 0xaa, 0x1d, 0x05,
+	0x7d, 0xc1, 0x15, 0x47, 0x77, 0x5c, 0x48, 0x60, 0x3f, 0xba, 0x90, 0xc5, 0xdb, 0x3d, 0xde, 0xe3,
+	0x26, 0x22, 0xd4, 0x6f, 0x36, 0x78, 0xb1, 0xd9, 0xe3, 0xbc, 0x97, 0x92, 0xd0, 0x8c, 0xe2, 0x7c,
+	0x37, 0x54, 0x34, 0x23, 0x52, 0xe1, 0xac, 0xef, 0x02, 0xfc, 0xc4, 0xe0, 0xc2, 0x18, 0x4b, 0x32,
+	0x90, 0x4b, 0x38, 0x65, 0x76, 0xbe, 0xf5, 0xad, 0x02, 0x68, 0x43, 0x0b, 0xe5, 0x29, 0x56, 0x5c,
+	0x6c, 0x72, 0xa6, 0x08, 0x53, 0x48, 0x40, 0xdd, 0xc8, 0x47, 0xfb, 0x38, 0xcd, 0x49, 0xc3, 0x5b,
+	0xaa, 0x2c, 0xd7, 0xd7, 0xee, 0x05, 0x16, 0x16, 0x68, 0x58, 0xb1, 0xb1, 0xe0, 0x29, 0x49, 0x36,
+	0x39, 0x65, 0xed, 0xf5, 0xa3, 0x93, 0x66, 0xe9, 0xfb, 0x69, 0xf3, 0x61, 0x8f, 0xaa, 0xbd, 0x3c,
+	0x0e, 0x12, 0x9e, 0x85, 0x4e, 0xdc, 0x3e, 0x56, 0x64, 0xf7, 0x43, 0xa8, 0x3e, 0xf5, 0x89, 0x2c,
+	0xd6, 0xc8, 0x0e, 0x18, 0x95, 0x37, 0x5a, 0x04, 0xbd, 0x82, 0x19, 0xc5, 0x15, 0x4e, 0x23, 0xb9,
+	0x87, 0x05, 0x91, 0x8d, 0xf2, 0x92, 0xb7, 0x5c, 0x6b, 0x07, 0x1a, 0xfb, 0xfb, 0xa4, 0xf9, 0xe0,
+	0x7a, 0xd8, 0x4e, 0xdd, 0x30, 0xb6, 0x0d, 0x02, 0xed, 0xc0, 0xec, 0x3e, 0x15, 0x2a, 0x1f, 0x42,
+	0x2b, 0x23, 0x41, 0x6f, 0x39, 0xca, 0x10, 0x2b, 0x13, 0x9c, 0x52, 0xd6, 0x8b, 0x76, 0x71, 0xa2,
+	0xb8, 0x68, 0x4c, 0x8c, 0x86, 0x75, 0x94, 0x2d, 0x03, 0x69, 0xfd, 0xf0, 0xa0, 0xfa, 0xa2, 0xaf,
+	0x28, 0x67, 0x12, 0xbd, 0x03, 0x94, 0xa4, 0x98, 0x66, 0x38, 0x4e, 0x49, 0xb4, 0x2b, 0x70, 0xa2,
+	0x3f, 0x37, 0xbc, 0x81, 0x8c, 0x77, 0x03, 0x99, 0x85, 0x01, 0x69, 0xcb, 0x81, 0xd0, 0x7b, 0x80,
+	0x0c, 0x1f, 0x44, 0x82, 0x7c, 0xc4, 0xa2, 0xdb, 0x28, 0x9b, 0xf2, 0xde, 0xbd, 0xb2, 0xbc, 0xa6,
+	0xb6, 0x8f, 0x5c, 0x6d, 0x97, 0xaf, 0xa1, 0x68, 0x0b, 0x5b, 0xcb, 0xf0, 0x41, 0xc7, 0xd0, 0x5b,
+	0x3f, 0x27, 0x61, 0xaa, 0x43, 0x12, 0x2e, 0xba, 0xe8, 0x39, 0x00, 0xcb, 0xb3, 0xa2, 0x16, 0xde,
+	0x48, 0x49, 0xab, 0xb1, 0x3c, 0x73, 0x75, 0xf8, 0x02, 0xf3, 0x94, 0x51, 0x15, 0x5d, 0xb4, 0x6a,
+	0x79, 0x5c, 0x56, 0x9d, 0xd5, 0x52, 0x1b, 0x43, 0xbb, 0x7e, 0x85, 0x85, 0x9c, 0x99, 0xcc, 0x92,
+	0xae, 0x4b, 0xa4, 0xb6, 0xd7, 0x98, 0xd4, 0xe7, 0x07, 0x5a, 0x36, 0xab, 0x12, 0x3d, 0x86, 0x2a,
+	0xb7, 0x66, 0x31, 0xee, 0xab, 0xaf, 0xf9, 0xc1, 0x95, 0x9d, 0x23, 0x70, 0x96, 0xea, 0x14, 0xe1,
+	0x48, 0xc1, 0xdc, 0xe5, 0x7d, 0x4f, 0xfe, 0x7f, 0x07, 0xcc, 0x5e, 0xda, 0xef, 0x0e, 0x98, 0x0c,
+	0xd2, 0xe1, 0x59, 0x9c, 0x1a, 0xed, 0xd0, 0x38, 0x8a, 0xf3, 0xc0, 0x67, 0x98, 0x4b, 0xb0, 0x10,
+	0xf4, 0xc2, 0xcf, 0x54, 0xc7, 0x66, 0x01, 0xa7, 0xe4, 0x7e, 0xa9, 0xf5, 0xa7, 0x0c, 0x33, 0xf6,
+	0x7d, 0x5b, 0x09, 0x82, 0x33, 0xa4, 0x8a, 0x16, 0xe6, 0x0e, 0xd6, 0xd8, 0xfa, 0xa6, 0xed, 0x72,
+	0x56, 0x1b, 0x6d, 0x02, 0x48, 0x85, 0x85, 0x8a, 0x74, 0xf3, 0x37, 0x6d, 0xb3, 0xbe, 0xb6, 0x18,
+	0xd8, 0x9b, 0x21, 0x28, 0x6e, 0x86, 0xe0, 0x75, 0x71, 0x33, 0xb4, 0xa7, 0xb5, 0xe2, 0xe1, 0x69,
+	0xd3, 0xeb, 0xd4, 0xcc, 0x3a, 0x3d, 0x83, 0x9e, 0xc0, 0x34, 0x61, 0x5d, 0x8b, 0xa8, 0xdc, 0x00,
+	0x51, 0x25, 0xac, 0x6b, 0x00, 0x2f, 0x61, 0x21, 0xc5, 0xd2, 0x1c, 0x46, 0x91, 0x13, 0x47, 0x9a,
+	0xb8, 0x01, 0x69, 0x4e, 0x2f, 0xdf, 0xb0, 0xab, 0xf5, 0x7c, 0xfb, 0xd9, 0xd1, 0x99, 0xef, 0x1d,
+	0x9f, 0xf9, 0xde, 0xdf, 0x33, 0xdf, 0x3b, 0x3c, 0xf7, 0x4b, 0xc7, 0xe7, 0x7e, 0xe9, 0xd7, 0xb9,
+	0x5f, 0x7a, 0x1b, 0x5e, 0xc8, 0x95, 0x33, 0xfd, 0x4a, 0x8a, 0x63, 0x59, 0x0c, 0xcc, 0x33, 0x57,
+	0x34, 0x75, 0x17, 0x6d, 0x3c, 0x65, 0x94, 0xd7, 0xff, 0x0d, 0x00, 0x65, 0x0d, 0xf4, 0x67, 0x80,
+	0x07, 0x00, 0x00,
 }
 
 func (m *AccumulatorContent) Marshal() (dAtA []byte, err error) {
@@ -225,6 +366,26 @@ func (m *AccumulatorContent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.ScalingFactor.Size()
+		i -= size
+		if _, err := m.ScalingFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAccum(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.VirtualShares.Size()
+		i -= size
+		if _, err := m.VirtualShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAccum(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	{
 		size := m.TotalShares.Size()
 		i -= size
@@ -272,6 +433,32 @@ func (m *Options) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MaxReward) > 0 {
+		for iNdEx := len(m.MaxReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccum(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.ClaimableFraction != nil {
+		{
+			size := m.ClaimableFraction.Size()
+			i -= size
+			if _, err := m.ClaimableFraction.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintAccum(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -295,6 +482,44 @@ func (m *Record) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.CarriedRewards) > 0 {
+		for iNdEx := len(m.CarriedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CarriedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccum(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size := m.InitialShares.Size()
+		i -= size
+		if _, err := m.InitialShares.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAccum(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.ClaimedRewards) > 0 {
+		for iNdEx := len(m.ClaimedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccum(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.Options != nil {
 		{
 			size, err := m.Options.MarshalToSizedBuffer(dAtA[:i])
@@ -348,6 +573,67 @@ func (m *Record) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *RewardStream) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardStream) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardStream) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastAccruedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastAccruedTime):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintAccum(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintAccum(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1a
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintAccum(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x12
+	if len(m.TotalReward) > 0 {
+		for iNdEx := len(m.TotalReward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalReward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAccum(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAccum(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAccum(v)
 	base := offset
@@ -373,6 +659,10 @@ func (m *AccumulatorContent) Size() (n int) {
 	}
 	l = m.TotalShares.Size()
 	n += 1 + l + sovAccum(uint64(l))
+	l = m.VirtualShares.Size()
+	n += 1 + l + sovAccum(uint64(l))
+	l = m.ScalingFactor.Size()
+	n += 1 + l + sovAccum(uint64(l))
 	return n
 }
 
@@ -382,6 +672,16 @@ func (m *Options) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.ClaimableFraction != nil {
+		l = m.ClaimableFraction.Size()
+		n += 1 + l + sovAccum(uint64(l))
+	}
+	if len(m.MaxReward) > 0 {
+		for _, e := range m.MaxReward {
+			l = e.Size()
+			n += 1 + l + sovAccum(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -409,6 +709,41 @@ func (m *Record) Size() (n int) {
 		l = m.Options.Size()
 		n += 1 + l + sovAccum(uint64(l))
 	}
+	if len(m.ClaimedRewards) > 0 {
+		for _, e := range m.ClaimedRewards {
+			l = e.Size()
+			n += 1 + l + sovAccum(uint64(l))
+		}
+	}
+	l = m.InitialShares.Size()
+	n += 1 + l + sovAccum(uint64(l))
+	if len(m.CarriedRewards) > 0 {
+		for _, e := range m.CarriedRewards {
+			l = e.Size()
+			n += 1 + l + sovAccum(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardStream) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalReward) > 0 {
+		for _, e := range m.TotalReward {
+			l = e.Size()
+			n += 1 + l + sovAccum(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovAccum(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovAccum(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastAccruedTime)
+	n += 1 + l + sovAccum(uint64(l))
 	return n
 }
 
@@ -515,6 +850,74 @@ func (m *AccumulatorContent) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VirtualShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccum
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VirtualShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccum
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScalingFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAccum(dAtA[iNdEx:])
@@ -565,14 +968,84 @@ func (m *Options) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Options: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAccum(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableFraction", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthAccum
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccum
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.ClaimableFraction = &v
+			if err := m.ClaimableFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxReward = append(m.MaxReward, types.Coin{})
+			if err := m.MaxReward[len(m.MaxReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccum(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccum
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -753,6 +1226,291 @@ func (m *Record) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimedRewards = append(m.ClaimedRewards, types.Coin{})
+			if err := m.ClaimedRewards[len(m.ClaimedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialShares", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAccum
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialShares.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CarriedRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CarriedRewards = append(m.CarriedRewards, types.DecCoin{})
+			if err := m.CarriedRewards[len(m.CarriedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAccum(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAccum
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardStream) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAccum
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardStream: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardStream: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalReward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalReward = append(m.TotalReward, types.DecCoin{})
+			if err := m.TotalReward[len(m.TotalReward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAccruedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAccum
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAccum
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAccum
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastAccruedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAccum(dAtA[iNdEx:])
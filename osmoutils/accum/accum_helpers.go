@@ -1,6 +1,8 @@
 package accum
 
 import (
+	"github.com/gogo/protobuf/proto"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
@@ -8,20 +10,52 @@ import (
 
 var (
 	minusOne = sdk.NewDec(-1)
+
+	// RescaleThreshold is the per-denom accumulator value above which Rescale
+	// shrinks the accumulator's magnitudes.
+	RescaleThreshold = sdk.NewDec(10).Power(30)
+	// rescaleFactor is the factor by which Rescale divides accumulator values
+	// and multiplies shares.
+	rescaleFactor = sdk.NewDec(10).Power(9)
 )
 
+// positionWithKey is a position record alongside the store key it is stored under.
+type positionWithKey struct {
+	key    []byte
+	record Record
+}
+
 // Creates a new position or override an existing position
-// at accumulator's current value with a specific number of shares and unclaimed rewards
-func initOrUpdatePosition(accum AccumulatorObject, accumulatorValue sdk.DecCoins, index string, numShareUnits sdk.Dec, unclaimedRewards sdk.DecCoins, options *Options) {
+// at accumulator's current value with a specific number of shares, initial shares, unclaimed rewards, claimed rewards
+// and carried rewards
+func initOrUpdatePosition(accum AccumulatorObject, accumulatorValue sdk.DecCoins, index string, numShareUnits sdk.Dec, initialShares sdk.Dec, unclaimedRewards sdk.DecCoins, claimedRewards sdk.Coins, carriedRewards sdk.DecCoins, options *Options) {
 	position := Record{
 		NumShares:        numShareUnits,
 		InitAccumValue:   accumulatorValue,
 		UnclaimedRewards: unclaimedRewards,
 		Options:          options,
+		ClaimedRewards:   claimedRewards,
+		InitialShares:    initialShares,
+		CarriedRewards:   carriedRewards,
 	}
 	osmoutils.MustSet(accum.store, formatPositionPrefixKey(accum.name, index), &position)
 }
 
+// getAllPositionsWithKeys returns all positions of the given accumulator alongside their store keys.
+// Returns error if any database errors occur.
+func getAllPositionsWithKeys(accum AccumulatorObject) ([]positionWithKey, error) {
+	return osmoutils.GatherValuesFromStorePrefixWithKeyParser(accum.store, formatPositionPrefixKey(accum.name, ""), func(key []byte, value []byte) (positionWithKey, error) {
+		record := Record{}
+		if err := proto.Unmarshal(value, &record); err != nil {
+			return positionWithKey{}, err
+		}
+		// The iterator may reuse the key slice, so we copy it.
+		keyCopy := make([]byte, len(key))
+		copy(keyCopy, key)
+		return positionWithKey{key: keyCopy, record: record}, nil
+	})
+}
+
 // Gets addr's current position from store
 func GetPosition(accum AccumulatorObject, name string) (Record, error) {
 	position := Record{}
@@ -41,12 +75,47 @@ func getTotalRewards(accum AccumulatorObject, position Record) sdk.DecCoins {
 	totalRewards := position.UnclaimedRewards
 
 	// TODO: add a check that accum.value is greater than position.InitAccumValue
+	// Denoms missing from the position's snapshot, e.g. reward denoms first added to the accumulator after the
+	// position was created, are treated as zero, so the position accrues their full growth.
 	accumulatorRewards := accum.value.Sub(position.InitAccumValue).MulDec(position.NumShares)
-	totalRewards = totalRewards.Add(accumulatorRewards...)
+	totalRewards = totalRewards.Add(accum.scaleOut(accumulatorRewards)...)
 
 	return totalRewards
 }
 
+// scaleIn converts the given amounts into the accumulator's scaled units.
+func (accum AccumulatorObject) scaleIn(amt sdk.DecCoins) sdk.DecCoins {
+	scalingFactor := accum.getScalingFactor()
+	if scalingFactor.Equal(sdk.OneDec()) {
+		return amt
+	}
+	return amt.MulDec(scalingFactor)
+}
+
+// scaleOut converts the given amounts from the accumulator's scaled units back into reward units.
+// The result is truncated so that rounding never grants more rewards than were added.
+func (accum AccumulatorObject) scaleOut(scaledAmt sdk.DecCoins) sdk.DecCoins {
+	scalingFactor := accum.getScalingFactor()
+	if scalingFactor.Equal(sdk.OneDec()) {
+		return scaledAmt
+	}
+	return scaledAmt.QuoDecTruncate(scalingFactor)
+}
+
+// validatePositionFields returns nil if the given fields of a new position are valid.
+// The custom accumulator value and the unclaimed rewards must be non-negative,
+// and the options must be valid.
+// Returns a typed error naming the offending field otherwise.
+func validatePositionFields(customAccumulatorValue, unclaimedRewards sdk.DecCoins, options *Options) error {
+	if customAccumulatorValue.IsAnyNegative() {
+		return NegativeCustomAccError{customAccumulatorValue}
+	}
+	if unclaimedRewards.IsAnyNegative() {
+		return NegativeUnclaimedRewardsError{UnclaimedRewards: unclaimedRewards}
+	}
+	return options.validate()
+}
+
 // validateAccumulatorValue validates the provided accumulator.
 // All coins in custom accumulator value must be non-negative.
 // Custom accumulator value must be a superset of the old accumulator value.
@@ -61,3 +130,24 @@ func validateAccumulatorValue(customAccumulatorValue, oldPositionAccumulatorValu
 	}
 	return nil
 }
+
+// cloneDecCoins returns a deep copy of the given coins, so that the copy
+// shares no memory with the original. Nil coins are returned as nil.
+func cloneDecCoins(coins sdk.DecCoins) sdk.DecCoins {
+	if coins == nil {
+		return nil
+	}
+	clone := make(sdk.DecCoins, len(coins))
+	for i, coin := range coins {
+		clone[i] = sdk.DecCoin{Denom: coin.Denom, Amount: cloneDec(coin.Amount)}
+	}
+	return clone
+}
+
+// cloneDec returns a deep copy of the given decimal. Nil decimals are returned as is.
+func cloneDec(d sdk.Dec) sdk.Dec {
+	if d.IsNil() {
+		return d
+	}
+	return d.Clone()
+}
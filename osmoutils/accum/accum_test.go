@@ -2,6 +2,7 @@ package accum_test
 
 import (
 	"math/rand"
+	"sync"
 	"testing"
 
 	"github.com/cosmos/cosmos-sdk/store"
@@ -146,6 +147,49 @@ func (suite *AccumTestSuite) TestMakeAndGetAccum() {
 	}
 }
 
+func (suite *AccumTestSuite) TestHasAndGetAccumulator() {
+	tests := []struct {
+		name           string
+		preCreateAccum bool
+		expectedHas    bool
+		expectedGetErr error
+	}{
+		{
+			name:           "accumulator does not exist",
+			preCreateAccum: false,
+			expectedGetErr: accumPackage.AccumDoesNotExistError{AccumName: testNameOne},
+		},
+		{
+			name:           "accumulator exists",
+			preCreateAccum: true,
+			expectedHas:    true,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			if tc.preCreateAccum {
+				err := accumPackage.MakeAccumulator(suite.store, testNameOne)
+				suite.Require().NoError(err)
+			}
+
+			suite.Require().Equal(tc.expectedHas, accumPackage.HasAccumulator(suite.store, testNameOne))
+
+			accObject, err := accumPackage.GetAccumulator(suite.store, testNameOne)
+			if tc.expectedGetErr != nil {
+				suite.Require().ErrorIs(err, tc.expectedGetErr)
+				suite.Require().Equal(accumPackage.AccumulatorObject{}, accObject)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(testNameOne, accObject.GetName())
+		})
+	}
+}
+
 func (suite *AccumTestSuite) TestMakeAccumulatorWithValueAndShares() {
 	// We set up store once at beginning so we can test duplicates
 	suite.SetupTest()
@@ -353,6 +397,102 @@ func (suite *AccumTestSuite) TestNewPositionCustomAcc() {
 	}
 }
 
+func (suite *AccumTestSuite) TestCreatePositionsBatch() {
+	validInputs := func(accObject accumPackage.AccumulatorObject) []accumPackage.PositionInput {
+		return []accumPackage.PositionInput{
+			{Name: testAddressOne, NumShares: positionOne.NumShares, CustomAccumulatorValue: accObject.GetValue()},
+			{Name: testAddressTwo, NumShares: positionTwo.NumShares, CustomAccumulatorValue: accObject.GetValue().MulDec(sdk.NewDec(2)), Options: &emptyPositionOptions},
+			{Name: testAddressThree, NumShares: positionThree.NumShares, CustomAccumulatorValue: sdk.NewDecCoins()},
+		}
+	}
+
+	tests := map[string]struct {
+		modifyInputs  func(inputs []accumPackage.PositionInput) []accumPackage.PositionInput
+		expectedError error
+	}{
+		"valid batch": {},
+		"empty batch": {
+			modifyInputs: func(inputs []accumPackage.PositionInput) []accumPackage.PositionInput {
+				return nil
+			},
+		},
+		"negative custom acc value - error": {
+			modifyInputs: func(inputs []accumPackage.PositionInput) []accumPackage.PositionInput {
+				inputs[1].CustomAccumulatorValue = inputs[1].CustomAccumulatorValue.MulDec(sdk.NewDec(-1))
+				return inputs
+			},
+			expectedError: accumPackage.NegativeCustomAccError{},
+		},
+		"invalid options - error": {
+			modifyInputs: func(inputs []accumPackage.PositionInput) []accumPackage.PositionInput {
+				inputs[2].Options = &accumPackage.Options{ClaimableFraction: decPtr(sdk.NewDec(2))}
+				return inputs
+			},
+			expectedError: accumPackage.InvalidClaimableFractionError{},
+		},
+		"duplicate name - error": {
+			modifyInputs: func(inputs []accumPackage.PositionInput) []accumPackage.PositionInput {
+				inputs[2].Name = testAddressOne
+				return inputs
+			},
+			expectedError: accumPackage.DuplicatePositionNameError{},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		suite.Run(name, func() {
+			suite.SetupTest()
+			accObject := accumPackage.MakeTestAccumulator(suite.store, testNameOne, initialCoinsDenomOne, emptyDec)
+
+			inputs := validInputs(accObject)
+			if tc.modifyInputs != nil {
+				inputs = tc.modifyInputs(inputs)
+			}
+
+			// System under test.
+			err := accObject.CreatePositionsBatch(inputs)
+
+			if tc.expectedError != nil {
+				suite.Require().Error(err)
+				inputErr := accumPackage.InvalidPositionInputError{}
+				suite.Require().ErrorAs(err, &inputErr)
+				suite.Require().IsType(tc.expectedError, inputErr.Err)
+
+				// Nothing was written.
+				positions, err := accObject.GetAllPositions()
+				suite.Require().NoError(err)
+				suite.Require().Empty(positions)
+				totalShares, err := accObject.GetTotalShares()
+				suite.Require().NoError(err)
+				suite.Require().Equal(emptyDec, totalShares)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// Every position is created as NewPositionCustomAcc would.
+			expectedTotalShares := emptyDec
+			for _, input := range inputs {
+				position := accObject.MustGetPosition(input.Name)
+				suite.Require().Equal(input.NumShares, position.NumShares)
+				suite.Require().Equal(input.CustomAccumulatorValue.String(), position.InitAccumValue.String())
+				suite.Require().Equal(emptyCoins, position.UnclaimedRewards)
+				if input.Options == nil {
+					suite.Require().Nil(position.Options)
+				} else {
+					suite.Require().Equal(*input.Options, *position.Options)
+				}
+				expectedTotalShares = expectedTotalShares.Add(input.NumShares)
+			}
+
+			// Total shares are updated once for the whole batch.
+			totalShares, err := accObject.GetTotalShares()
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedTotalShares, totalShares)
+		})
+	}
+}
+
 func (suite *AccumTestSuite) TestClaimRewards() {
 	var (
 		doubleCoinsDenomOne = sdk.NewDecCoinFromDec(denomOne, initialValueOne.MulInt64(2))
@@ -479,7 +619,7 @@ func (suite *AccumTestSuite) TestClaimRewards() {
 				suite.Require().NoError(err)
 			}
 			// System under test.
-			actualResult, err := tc.accObject.ClaimRewards(tc.accName)
+			actualResult, forfeitedResult, cappedResult, err := tc.accObject.ClaimRewardsDetailed(tc.accName)
 
 			// Assertions.
 
@@ -492,6 +632,12 @@ func (suite *AccumTestSuite) TestClaimRewards() {
 			suite.Require().NoError(err)
 			suite.Require().Equal(tc.expectedResult.String(), actualResult.String())
 
+			// Positions without a claimable fraction never forfeit rewards.
+			suite.Require().True(forfeitedResult.IsZero())
+
+			// Positions without a max reward are never capped.
+			suite.Require().True(cappedResult.IsZero())
+
 			osmoassert.ConditionalPanic(suite.T(), tc.updateNumSharesToZero, func() {
 				finalPosition := tc.accObject.MustGetPosition(tc.accName)
 				suite.Require().NoError(err)
@@ -503,6 +649,222 @@ func (suite *AccumTestSuite) TestClaimRewards() {
 	}
 }
 
+func (suite *AccumTestSuite) TestClaimRewards_ClaimableFraction() {
+	tests := map[string]struct {
+		claimableFraction sdk.Dec
+
+		expectedClaimed   sdk.Coins
+		expectedForfeited sdk.DecCoins
+	}{
+		"fully claimable": {
+			claimableFraction: sdk.OneDec(),
+			// 100.1 * 100 = 10010
+			expectedClaimed:   sdk.NewCoins(sdk.NewCoin(denomOne, sdk.NewInt(10010))),
+			expectedForfeited: sdk.NewDecCoins(),
+		},
+		"partially claimable": {
+			claimableFraction: sdk.MustNewDecFromStr("0.25"),
+			// 10010 * 0.25 = 2502.5, truncated to 2502
+			expectedClaimed: sdk.NewCoins(sdk.NewCoin(denomOne, sdk.NewInt(2502))),
+			// 10010 - 2502.5 = 7507.5
+			expectedForfeited: sdk.NewDecCoins(sdk.NewDecCoinFromDec(denomOne, sdk.MustNewDecFromStr("7507.5"))),
+		},
+		"fully forfeited": {
+			claimableFraction: sdk.ZeroDec(),
+			expectedClaimed:   sdk.NewCoins(),
+			expectedForfeited: sdk.NewDecCoins(sdk.NewDecCoinFromDec(denomOne, sdk.NewDec(10010))),
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		suite.Run(name, func() {
+			suite.SetupTest()
+
+			err := accumPackage.MakeAccumulator(suite.store, testNameOne)
+			suite.Require().NoError(err)
+			accObject, err := accumPackage.GetAccumulator(suite.store, testNameOne)
+			suite.Require().NoError(err)
+
+			claimableFraction := tc.claimableFraction
+			err = accObject.NewPosition(testAddressOne, positionOne.NumShares, &accumPackage.Options{ClaimableFraction: &claimableFraction})
+			suite.Require().NoError(err)
+
+			accObject.AddToAccumulator(initialCoinsDenomOne)
+
+			// System under test.
+			claimed, forfeited, err := accObject.ClaimRewards(testAddressOne)
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(tc.expectedClaimed.String(), claimed.String())
+			suite.Require().Equal(tc.expectedForfeited.String(), forfeited.String())
+
+			// Both claimed and forfeited rewards are cleared from the position.
+			finalPosition := accObject.MustGetPosition(testAddressOne)
+			suite.Require().Equal(emptyCoins, finalPosition.UnclaimedRewards)
+			suite.Require().Equal(accObject.GetValue(), finalPosition.InitAccumValue)
+		})
+	}
+}
+
+func (suite *AccumTestSuite) TestClaimRewards_MaxReward() {
+	tests := map[string]struct {
+		maxReward         sdk.Coins
+		claimableFraction *sdk.Dec
+
+		// Each claim follows growing the accumulator by initialCoinsDenomOne,
+		// which accrues 100.1 * 100 = 10010 to the position.
+		expectedClaimed []sdk.Coins
+		expectedCapped  []sdk.DecCoins
+	}{
+		"cap above rewards - nothing capped": {
+			maxReward:       sdk.NewCoins(sdk.NewCoin(denomOne, sdk.NewInt(20000))),
+			expectedClaimed: []sdk.Coins{sdk.NewCoins(sdk.NewCoin(denomOne, sdk.NewInt(10010)))},
+			expectedCapped:  []sdk.DecCoins{sdk.NewDecCoins()},
+		},
+		"cap below rewards - overflow capped": {
+			maxReward:       sdk.NewCoins(sdk.NewCoin(denomOne, sdk.NewInt(6000))),
+			expectedClaimed: []sdk.Coins{sdk.NewCoins(sdk.NewCoin(denomOne, sdk.NewInt(6000)))},
+			expectedCapped:  []sdk.DecCoins{sdk.NewDecCoins(sdk.NewDecCoin(denomOne, sdk.NewInt(4010)))},
+		},
+		"cap spans multiple claims": {
+			maxReward: sdk.NewCoins(sdk.NewCoin(denomOne, sdk.NewInt(15000))),
+			expectedClaimed: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(denomOne, sdk.NewInt(10010))),
+				sdk.NewCoins(sdk.NewCoin(denomOne, sdk.NewInt(4990))),
+				sdk.NewCoins(),
+			},
+			expectedCapped: []sdk.DecCoins{
+				sdk.NewDecCoins(),
+				sdk.NewDecCoins(sdk.NewDecCoin(denomOne, sdk.NewInt(5020))),
+				sdk.NewDecCoins(sdk.NewDecCoin(denomOne, sdk.NewInt(10010))),
+			},
+		},
+		"cap on other denom - nothing capped": {
+			maxReward:       sdk.NewCoins(sdk.NewCoin(denomTwo, sdk.NewInt(1))),
+			expectedClaimed: []sdk.Coins{sdk.NewCoins(sdk.NewCoin(denomOne, sdk.NewInt(10010)))},
+			expectedCapped:  []sdk.DecCoins{sdk.NewDecCoins()},
+		},
+		"cap applies after claimable fraction": {
+			maxReward:         sdk.NewCoins(sdk.NewCoin(denomOne, sdk.NewInt(2000))),
+			claimableFraction: decPtr(sdk.MustNewDecFromStr("0.25")),
+			// 10010 * 0.25 = 2502.5, of which 502.5 is above the cap
+			expectedClaimed: []sdk.Coins{sdk.NewCoins(sdk.NewCoin(denomOne, sdk.NewInt(2000)))},
+			expectedCapped:  []sdk.DecCoins{sdk.NewDecCoins(sdk.NewDecCoinFromDec(denomOne, sdk.MustNewDecFromStr("502.5")))},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		suite.Run(name, func() {
+			suite.SetupTest()
+
+			err := accumPackage.MakeAccumulator(suite.store, testNameOne)
+			suite.Require().NoError(err)
+			accObject, err := accumPackage.GetAccumulator(suite.store, testNameOne)
+			suite.Require().NoError(err)
+
+			err = accObject.NewPosition(testAddressOne, positionOne.NumShares, &accumPackage.Options{MaxReward: tc.maxReward, ClaimableFraction: tc.claimableFraction})
+			suite.Require().NoError(err)
+
+			totalClaimed := sdk.NewCoins()
+			for i := range tc.expectedClaimed {
+				accObject.AddToAccumulator(initialCoinsDenomOne)
+
+				// System under test.
+				claimed, _, capped, err := accObject.ClaimRewardsDetailed(testAddressOne)
+				suite.Require().NoError(err)
+
+				suite.Require().Equal(tc.expectedClaimed[i].String(), claimed.String())
+				suite.Require().Equal(tc.expectedCapped[i].String(), capped.String())
+
+				// Claimed rewards are tracked on the position.
+				totalClaimed = totalClaimed.Add(claimed...)
+				finalPosition := accObject.MustGetPosition(testAddressOne)
+				suite.Require().Equal(totalClaimed.String(), finalPosition.ClaimedRewards.String())
+				suite.Require().Equal(emptyCoins, finalPosition.UnclaimedRewards)
+			}
+		})
+	}
+}
+
+func (suite *AccumTestSuite) TestClaimRewards_EmitsEvent() {
+	err := accumPackage.MakeAccumulator(suite.store, testNameOne)
+	suite.Require().NoError(err)
+	accObject, err := accumPackage.GetAccumulator(suite.store, testNameOne)
+	suite.Require().NoError(err)
+
+	eventManager := sdk.NewEventManager()
+	accObject = accObject.WithEventEmitter(eventManager.EmitEvent)
+
+	err = accObject.NewPosition(testAddressOne, positionOne.NumShares, nil)
+	suite.Require().NoError(err)
+	accObject.AddToAccumulator(initialCoinsDenomOne)
+
+	// System under test.
+	claimed, _, err := accObject.ClaimRewards(testAddressOne)
+	suite.Require().NoError(err)
+	suite.Require().False(claimed.IsZero())
+
+	expectedEvent := sdk.NewEvent(
+		accumPackage.TypeEvtClaim,
+		sdk.NewAttribute(accumPackage.AttributeKeyAccumulator, testNameOne),
+		sdk.NewAttribute(accumPackage.AttributeKeyPosition, testAddressOne),
+		sdk.NewAttribute(accumPackage.AttributeKeyClaimed, claimed.String()),
+	)
+	suite.Require().Equal(sdk.Events{expectedEvent}, eventManager.Events())
+
+	// Claiming again without any accumulator growth claims nothing, so no event is emitted.
+	claimed, _, err = accObject.ClaimRewards(testAddressOne)
+	suite.Require().NoError(err)
+	suite.Require().True(claimed.IsZero())
+	suite.Require().Len(eventManager.Events(), 1)
+}
+
+// TestClaimRewards_CarriesTruncatedRewards tests that a tiny position whose rewards truncate to zero at every
+// claim eventually receives them, rather than losing them all to truncation.
+func (suite *AccumTestSuite) TestClaimRewards_CarriesTruncatedRewards() {
+	err := accumPackage.MakeAccumulator(suite.store, testNameOne)
+	suite.Require().NoError(err)
+	accObject, err := accumPackage.GetAccumulator(suite.store, testNameOne)
+	suite.Require().NoError(err)
+
+	// Each reward addition accrues 100 * 0.003 = 0.3 to the position.
+	err = accObject.NewPosition(testAddressOne, sdk.MustNewDecFromStr("0.003"), nil)
+	suite.Require().NoError(err)
+	growth := sdk.NewDecCoins(sdk.NewInt64DecCoin(denomOne, 100))
+
+	totalClaimed := sdk.NewCoins()
+	for i := 1; i <= 10; i++ {
+		accObject.AddToAccumulator(growth)
+
+		claimed, _, err := accObject.ClaimRewards(testAddressOne)
+		suite.Require().NoError(err)
+		totalClaimed = totalClaimed.Add(claimed...)
+
+		// Everything accrued so far is either claimed or carried to the next claim.
+		accrued := sdk.MustNewDecFromStr("0.3").MulInt64(int64(i))
+		finalPosition := accObject.MustGetPosition(testAddressOne)
+		suite.Require().Equal(accrued, sdk.NewDecCoinsFromCoins(totalClaimed...).Add(finalPosition.CarriedRewards...).AmountOf(denomOne))
+		suite.Require().True(finalPosition.CarriedRewards.AmountOf(denomOne).LT(sdk.OneDec()))
+
+		carriedRewards, err := accObject.GetPositionCarriedRewards(testAddressOne)
+		suite.Require().NoError(err)
+		suite.Require().Equal(finalPosition.CarriedRewards, carriedRewards)
+	}
+
+	// 10 * 0.3 = 3 rewards are paid out even though no single claim accrued a whole unit.
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denomOne, 3)).String(), totalClaimed.String())
+
+	// The carried rewards survive share updates.
+	accObject.AddToAccumulator(growth)
+	_, _, err = accObject.ClaimRewards(testAddressOne)
+	suite.Require().NoError(err)
+	err = accObject.AddToPosition(testAddressOne, sdk.MustNewDecFromStr("0.003"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.3"), accObject.MustGetPosition(testAddressOne).CarriedRewards.AmountOf(denomOne))
+}
+
 func (suite *AccumTestSuite) TestAddToPosition() {
 	type testcase struct {
 		startingNumShares        sdk.Dec
@@ -1567,3 +1929,915 @@ func (suite *AccumTestSuite) TestGetTotalShares() {
 	suite.Require().Equal(expectedShares[0], accumOneShares)
 	suite.Require().Equal(expectedShares[1], accumTwoShares)
 }
+
+func (suite *AccumTestSuite) TestRescale() {
+	largeValue := accumPackage.RescaleThreshold.MulInt64(2)
+
+	tests := map[string]struct {
+		accumGrowth sdk.DecCoins
+
+		expectedFactor sdk.Dec
+	}{
+		"below threshold - no-op": {
+			accumGrowth: initialCoinsDenomOne,
+
+			expectedFactor: sdk.OneDec(),
+		},
+		"above threshold - rescaled": {
+			accumGrowth: sdk.NewDecCoins(sdk.NewDecCoinFromDec(denomOne, largeValue), initialCoinDenomTwo),
+
+			expectedFactor: sdk.NewDec(10).Power(9),
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		suite.Run(name, func() {
+			suite.SetupTest()
+
+			err := accumPackage.MakeAccumulator(suite.store, testNameOne)
+			suite.Require().NoError(err)
+			accum, err := accumPackage.GetAccumulator(suite.store, testNameOne)
+			suite.Require().NoError(err)
+
+			// Position one joins before any growth, position two joins halfway through.
+			err = accum.NewPosition(testAddressOne, positionOne.NumShares, &emptyPositionOptions)
+			suite.Require().NoError(err)
+			accum.AddToAccumulator(tc.accumGrowth)
+			err = accum.NewPosition(testAddressTwo, positionTwo.NumShares, &emptyPositionOptions)
+			suite.Require().NoError(err)
+			accum.AddToAccumulator(tc.accumGrowth)
+
+			accum, err = accumPackage.GetAccumulator(suite.store, testNameOne)
+			suite.Require().NoError(err)
+
+			positionsBefore := []accumPackage.Record{accum.MustGetPosition(testAddressOne), accum.MustGetPosition(testAddressTwo)}
+			rewardsBefore := []sdk.DecCoins{}
+			for _, position := range positionsBefore {
+				rewardsBefore = append(rewardsBefore, accumPackage.GetTotalRewards(accum, position))
+			}
+			totalSharesBefore, err := accum.GetTotalShares()
+			suite.Require().NoError(err)
+
+			// System under test.
+			factor, err := accum.Rescale(denomOne)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedFactor, factor)
+
+			// Validate that the receiver matches state.
+			accumFromStore, err := accumPackage.GetAccumulator(suite.store, testNameOne)
+			suite.Require().NoError(err)
+			suite.Require().Equal(accum.GetValue(), accumFromStore.GetValue())
+
+			totalSharesAfter, err := accumFromStore.GetTotalShares()
+			suite.Require().NoError(err)
+			suite.Require().Equal(totalSharesBefore.Mul(tc.expectedFactor), totalSharesAfter)
+
+			// Validate that the rewards owed to each position are unchanged, up to rounding.
+			for i, name := range []string{testAddressOne, testAddressTwo} {
+				position := accumFromStore.MustGetPosition(name)
+				suite.Require().Equal(positionsBefore[i].NumShares.Mul(tc.expectedFactor), position.NumShares)
+				suite.Require().Equal(positionsBefore[i].InitialShares.Mul(tc.expectedFactor), position.InitialShares)
+
+				rewardsAfter := accumPackage.GetTotalRewards(accumFromStore, position)
+				for _, expected := range rewardsBefore[i] {
+					actual := rewardsAfter.AmountOf(expected.Denom)
+					tolerance := expected.Amount.Quo(sdk.NewDec(10).Power(12))
+					suite.Require().True(expected.Amount.Sub(actual).Abs().LTE(tolerance),
+						"expected %s, actual %s", expected.Amount, actual)
+				}
+			}
+		})
+	}
+}
+
+func (suite *AccumTestSuite) TestGetPositionAccumSnapshot() {
+	suite.SetupTest()
+
+	accObject := accumPackage.MakeTestAccumulator(suite.store, testNameOne, initialCoinsDenomOne, emptyDec)
+
+	// Create a position at the initial accumulator value
+	err := accObject.NewPosition(testAddressOne, positionOne.NumShares, nil)
+	suite.Require().NoError(err)
+
+	// Grow the accumulator after the position was created
+	accObject.AddToAccumulator(initialCoinsDenomOne)
+
+	snapshot, err := accObject.GetPositionAccumSnapshot(testAddressOne)
+	suite.Require().NoError(err)
+	suite.Require().Equal(initialCoinsDenomOne, snapshot)
+
+	// The growth since the position was created is the current value minus the snapshot
+	suite.Require().Equal(initialCoinsDenomOne, accObject.GetValue().Sub(snapshot))
+
+	// Position that does not exist
+	_, err = accObject.GetPositionAccumSnapshot(testAddressTwo)
+	suite.Require().ErrorIs(err, accumPackage.NoPositionError{Name: testAddressTwo})
+}
+
+func (suite *AccumTestSuite) TestGetPositions() {
+	suite.SetupTest()
+
+	accObject := accumPackage.MakeTestAccumulator(suite.store, testNameOne, initialCoinsDenomOne, emptyDec)
+
+	err := accObject.NewPosition(testAddressOne, positionOne.NumShares, nil)
+	suite.Require().NoError(err)
+	err = accObject.NewPosition(testAddressTwo, positionTwo.NumShares, nil)
+	suite.Require().NoError(err)
+
+	expectedOne, err := accObject.GetPosition(testAddressOne)
+	suite.Require().NoError(err)
+	expectedTwo, err := accObject.GetPosition(testAddressTwo)
+	suite.Require().NoError(err)
+
+	// All positions exist. Duplicate names are returned once.
+	positions, err := accObject.GetPositions([]string{testAddressOne, testAddressTwo, testAddressOne})
+	suite.Require().NoError(err)
+	suite.Require().Equal(map[string]accumPackage.Record{testAddressOne: expectedOne, testAddressTwo: expectedTwo}, positions)
+
+	// No names.
+	positions, err = accObject.GetPositions(nil)
+	suite.Require().NoError(err)
+	suite.Require().Empty(positions)
+
+	// Missing positions are all listed, and the existing ones are still returned.
+	positions, err = accObject.GetPositions([]string{testAddressThree, testAddressOne, "missing", testAddressThree})
+	suite.Require().Error(err)
+	suite.Require().Equal(accumPackage.NoPositionsError{Names: []string{testAddressThree, "missing"}}, err)
+	suite.Require().Equal(map[string]accumPackage.Record{testAddressOne: expectedOne}, positions)
+}
+
+func (suite *AccumTestSuite) TestGetRewardDenoms() {
+	suite.SetupTest()
+
+	// No rewards yet.
+	accObject := accumPackage.MakeTestAccumulator(suite.store, testNameOne, emptyCoins, emptyDec)
+	suite.Require().Equal([]string{}, accObject.GetRewardDenoms())
+
+	// Denoms are sorted regardless of the order they were added in.
+	accObject.AddToAccumulator(sdk.NewDecCoins(initialCoinDenomTwo))
+	accObject.AddToAccumulator(sdk.NewDecCoins(initialCoinDenomOne))
+	suite.Require().Equal([]string{denomOne, denomTwo}, accObject.GetRewardDenoms())
+
+	// The denoms persist across reloads of the accumulator.
+	accObject, err := accumPackage.GetAccumulator(suite.store, testNameOne)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{denomOne, denomTwo}, accObject.GetRewardDenoms())
+}
+
+func (suite *AccumTestSuite) TestGetPositionInitialShares() {
+	suite.SetupTest()
+
+	accObject := accumPackage.MakeTestAccumulator(suite.store, testNameOne, initialCoinsDenomOne, emptyDec)
+
+	err := accObject.NewPosition(testAddressOne, positionOne.NumShares, nil)
+	suite.Require().NoError(err)
+
+	initialShares, err := accObject.GetPositionInitialShares(testAddressOne)
+	suite.Require().NoError(err)
+	suite.Require().Equal(positionOne.NumShares, initialShares)
+
+	// Top up, remove from, update and claim from the position. Only its current shares change.
+	suite.Require().NoError(accObject.AddToPosition(testAddressOne, positionTwo.NumShares))
+	suite.Require().NoError(accObject.RemoveFromPosition(testAddressOne, positionOne.NumShares))
+	suite.Require().NoError(accObject.UpdatePosition(testAddressOne, positionThree.NumShares))
+	accObject.AddToAccumulator(initialCoinsDenomOne)
+	_, _, err = accObject.ClaimRewards(testAddressOne)
+	suite.Require().NoError(err)
+
+	initialShares, err = accObject.GetPositionInitialShares(testAddressOne)
+	suite.Require().NoError(err)
+	suite.Require().Equal(positionOne.NumShares, initialShares)
+
+	currentShares, err := accObject.GetPositionSize(testAddressOne)
+	suite.Require().NoError(err)
+	suite.Require().Equal(positionTwo.NumShares.Add(positionThree.NumShares), currentShares)
+
+	// Position that does not exist
+	_, err = accObject.GetPositionInitialShares(testAddressTwo)
+	suite.Require().ErrorIs(err, accumPackage.NoPositionError{Name: testAddressTwo})
+}
+
+func (suite *AccumTestSuite) TestGetPositionRewards() {
+	suite.SetupTest()
+
+	accObject := accumPackage.MakeTestAccumulator(suite.store, testNameOne, emptyCoins, emptyDec)
+
+	// Create a position at the initial accumulator value
+	err := accObject.NewPosition(testAddressOne, positionOne.NumShares, nil)
+	suite.Require().NoError(err)
+
+	// No growth yet, so nothing is owed
+	rewards, err := accObject.GetPositionRewards(testAddressOne)
+	suite.Require().NoError(err)
+	suite.Require().True(rewards.IsZero())
+
+	// Grow the accumulator after the position was created
+	accObject.AddToAccumulator(initialCoinsDenomOne)
+
+	expectedRewards := initialCoinsDenomOne.MulDec(positionOne.NumShares)
+	rewards, err = accObject.GetPositionRewards(testAddressOne)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedRewards, rewards)
+
+	// Previewing rewards does not modify the position
+	rewards, err = accObject.GetPositionRewards(testAddressOne)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedRewards, rewards)
+
+	// Previewed rewards match what is claimed
+	claimed, _, err := accObject.ClaimRewards(testAddressOne)
+	suite.Require().NoError(err)
+	expectedClaimed, _ := expectedRewards.TruncateDecimal()
+	suite.Require().Equal(expectedClaimed, claimed)
+
+	// Position that does not exist
+	_, err = accObject.GetPositionRewards(testAddressTwo)
+	suite.Require().ErrorIs(err, accumPackage.NoPositionError{Name: testAddressTwo})
+}
+
+func (suite *AccumTestSuite) TestClone() {
+	suite.SetupTest()
+
+	accObject := accumPackage.MakeTestAccumulator(suite.store, testNameOne, initialCoinsDenomOne, sdk.NewDec(10))
+
+	clone := accObject.Clone()
+	suite.Require().Equal(accObject.GetName(), clone.GetName())
+	suite.Require().Equal(accObject.GetValue(), clone.GetValue())
+
+	// Mutating the original does not affect the clone
+	accObject.AddToAccumulator(initialCoinsDenomOne)
+	suite.Require().Equal(initialCoinsDenomOne, clone.GetValue())
+	suite.Require().Equal(initialCoinsDenomOne.MulDec(sdk.NewDec(2)), accObject.GetValue())
+
+	// Mutating a returned value does not affect the accumulator
+	value := clone.GetValue()
+	value[0] = sdk.NewDecCoinFromDec(denomOne, sdk.ZeroDec())
+	suite.Require().Equal(initialCoinsDenomOne, clone.GetValue())
+}
+
+func (suite *AccumTestSuite) TestGetPositionRewards_Concurrent() {
+	suite.SetupTest()
+
+	accObject := accumPackage.MakeTestAccumulator(suite.store, testNameOne, emptyCoins, emptyDec)
+	err := accObject.NewPosition(testAddressOne, positionOne.NumShares, nil)
+	suite.Require().NoError(err)
+	accObject.AddToAccumulator(initialCoinsDenomOne)
+
+	expectedRewards := initialCoinsDenomOne.MulDec(positionOne.NumShares)
+
+	// Each goroutine queries rewards on its own snapshot of the accumulator.
+	const numQueriers = 10
+	results := make([]sdk.DecCoins, numQueriers)
+	errs := make([]error, numQueriers)
+	var wg sync.WaitGroup
+	for i := 0; i < numQueriers; i++ {
+		wg.Add(1)
+		go func(i int, snapshot accumPackage.AccumulatorObject) {
+			defer wg.Done()
+			results[i], errs[i] = snapshot.GetPositionRewards(testAddressOne)
+		}(i, accObject.Clone())
+	}
+	wg.Wait()
+
+	for i := 0; i < numQueriers; i++ {
+		suite.Require().NoError(errs[i])
+		suite.Require().Equal(expectedRewards, results[i])
+	}
+}
+
+func (suite *AccumTestSuite) TestMakeAccumulatorWithVirtualShares() {
+	suite.SetupTest()
+
+	err := accumPackage.MakeAccumulatorWithVirtualShares(suite.store, testNameOne, sdk.NewDec(10))
+	suite.Require().NoError(err)
+
+	accObject, err := accumPackage.GetAccumulator(suite.store, testNameOne)
+	suite.Require().NoError(err)
+	virtualShares, err := accObject.GetVirtualShares()
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(10), virtualShares)
+	totalShares, err := accObject.GetTotalShares()
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.ZeroDec(), totalShares)
+
+	// Duplicate accumulator
+	err = accumPackage.MakeAccumulatorWithVirtualShares(suite.store, testNameOne, sdk.NewDec(10))
+	suite.Require().Error(err)
+
+	// Negative virtual shares
+	err = accumPackage.MakeAccumulatorWithVirtualShares(suite.store, testNameTwo, sdk.NewDec(-1))
+	suite.Require().ErrorContains(err, accumPackage.NegativeVirtualSharesError{VirtualShares: sdk.NewDec(-1)}.Error())
+
+	// Accumulators made without virtual shares have none
+	err = accumPackage.MakeAccumulator(suite.store, testNameThree)
+	suite.Require().NoError(err)
+	accObject, err = accumPackage.GetAccumulator(suite.store, testNameThree)
+	suite.Require().NoError(err)
+	virtualShares, err = accObject.GetVirtualShares()
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.ZeroDec(), virtualShares)
+}
+
+func (suite *AccumTestSuite) TestDistributeRewards() {
+	suite.SetupTest()
+
+	// No shares at all
+	err := accumPackage.MakeAccumulator(suite.store, testNameOne)
+	suite.Require().NoError(err)
+	accObject, err := accumPackage.GetAccumulator(suite.store, testNameOne)
+	suite.Require().NoError(err)
+	err = accObject.DistributeRewards(initialCoinsDenomOne)
+	suite.Require().ErrorIs(err, accumPackage.ZeroSharesError)
+
+	// Rewards are spread over total shares plus virtual shares
+	err = accumPackage.MakeAccumulatorWithVirtualShares(suite.store, testNameTwo, sdk.NewDec(2))
+	suite.Require().NoError(err)
+	accObject, err = accumPackage.GetAccumulator(suite.store, testNameTwo)
+	suite.Require().NoError(err)
+	err = accObject.NewPosition(testAddressOne, sdk.NewDec(8), nil)
+	suite.Require().NoError(err)
+
+	err = accObject.DistributeRewards(sdk.NewDecCoins(sdk.NewDecCoin(denomOne, sdk.NewInt(100))))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoin(denomOne, sdk.NewInt(10))), accObject.GetValue())
+
+	rewards, err := accObject.GetPositionRewards(testAddressOne)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoin(denomOne, sdk.NewInt(80))), rewards)
+
+	// The receiver matches state
+	accumFromStore, err := accumPackage.GetAccumulator(suite.store, testNameTwo)
+	suite.Require().NoError(err)
+	suite.Require().Equal(accObject.GetValue(), accumFromStore.GetValue())
+}
+
+// TestDistributeRewards_ZeroTotalShares tests that distributing rewards to an accumulator whose
+// total shares have dropped to zero errors without writing anything, and that a position created
+// afterwards only receives rewards distributed after it exists.
+func (suite *AccumTestSuite) TestDistributeRewards_ZeroTotalShares() {
+	suite.SetupTest()
+	rewards := sdk.NewDecCoins(sdk.NewDecCoin(denomOne, sdk.NewInt(100)))
+
+	err := accumPackage.MakeAccumulator(suite.store, testNameOne)
+	suite.Require().NoError(err)
+	accObject, err := accumPackage.GetAccumulator(suite.store, testNameOne)
+	suite.Require().NoError(err)
+
+	// Drain the accumulator's only position so that its total shares reach zero.
+	err = accObject.NewPosition(testAddressOne, sdk.NewDec(10), nil)
+	suite.Require().NoError(err)
+	err = accObject.RemoveFromPosition(testAddressOne, sdk.NewDec(10))
+	suite.Require().NoError(err)
+	totalShares, err := accObject.GetTotalShares()
+	suite.Require().NoError(err)
+	suite.Require().True(totalShares.IsZero())
+
+	// Adding rewards errors clearly instead of dividing by zero, and leaves the value untouched.
+	err = accObject.DistributeRewards(rewards)
+	suite.Require().ErrorIs(err, accumPackage.ZeroSharesError)
+	suite.Require().True(accObject.GetValue().IsZero())
+	accumFromStore, err := accumPackage.GetAccumulator(suite.store, testNameOne)
+	suite.Require().NoError(err)
+	suite.Require().True(accumFromStore.GetValue().IsZero())
+
+	// A position created afterwards has no rewards from the failed distribution.
+	err = accObject.NewPosition(testAddressTwo, sdk.NewDec(4), nil)
+	suite.Require().NoError(err)
+	positionRewards, err := accObject.GetPositionRewards(testAddressTwo)
+	suite.Require().NoError(err)
+	suite.Require().True(positionRewards.IsZero())
+
+	// Once shares exist the same rewards can be distributed, all of them to the new position.
+	err = accObject.DistributeRewards(rewards)
+	suite.Require().NoError(err)
+	positionRewards, err = accObject.GetPositionRewards(testAddressTwo)
+	suite.Require().NoError(err)
+	suite.Require().Equal(rewards, positionRewards)
+}
+
+// TestDistributeRewards_VirtualSharesMitigateInflation shows that an attacker holding a
+// dust position in an otherwise empty accumulator captures the entire distribution
+// without a virtual shares floor, but only a negligible fraction of it with one.
+// TestNewRewardDenom tests that a position created before a reward denom is first added to the accumulator
+// accrues the full growth of that denom, since denoms missing from its snapshot are treated as zero.
+func (suite *AccumTestSuite) TestNewRewardDenom() {
+	suite.SetupTest()
+
+	accObject := accumPackage.MakeTestAccumulator(suite.store, testNameOne, initialCoinsDenomOne, emptyDec)
+	err := accObject.NewPosition(testAddressOne, sdk.NewDec(2), nil)
+	suite.Require().NoError(err)
+
+	// The position's snapshot only has denomOne.
+	position, err := accumPackage.GetPosition(accObject, testAddressOne)
+	suite.Require().NoError(err)
+	suite.Require().Equal(initialCoinsDenomOne, position.InitAccumValue)
+
+	// Add growth in both the existing denom and a brand-new denom.
+	existingDenomGrowth := sdk.NewDecCoinFromDec(denomOne, sdk.NewDec(5))
+	newDenomGrowth := sdk.NewDecCoinFromDec(denomTwo, sdk.NewDec(10))
+	accObject.AddToAccumulator(sdk.NewDecCoins(existingDenomGrowth, newDenomGrowth))
+
+	// The position accrues the full growth per share of the new denom.
+	expectedRewards := sdk.NewDecCoins(existingDenomGrowth, newDenomGrowth).MulDec(sdk.NewDec(2))
+	positionRewards, err := accObject.GetPositionRewards(testAddressOne)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedRewards, positionRewards)
+
+	// Adding to the position keeps the accrued new-denom rewards and snapshots the new denom.
+	err = accObject.AddToPosition(testAddressOne, sdk.NewDec(2))
+	suite.Require().NoError(err)
+	position, err = accumPackage.GetPosition(accObject, testAddressOne)
+	suite.Require().NoError(err)
+	suite.Require().Equal(accObject.GetValue(), position.InitAccumValue)
+
+	// Further growth in the new denom accrues on the new share count only from the new snapshot.
+	accObject.AddToAccumulator(sdk.NewDecCoins(newDenomGrowth))
+	expectedRewards = expectedRewards.Add(sdk.NewDecCoins(newDenomGrowth).MulDec(sdk.NewDec(4))...)
+
+	claimed, _, err := accObject.ClaimRewards(testAddressOne)
+	suite.Require().NoError(err)
+	expectedClaimed, _ := expectedRewards.TruncateDecimal()
+	suite.Require().Equal(expectedClaimed, claimed)
+}
+
+func (suite *AccumTestSuite) TestRenamePosition() {
+	const (
+		oldName = "oldname"
+		newName = "newname"
+	)
+
+	tests := []struct {
+		name          string
+		createOld     bool
+		createNew     bool
+		expectedError error
+	}{
+		{
+			name:      "rename to unused name",
+			createOld: true,
+		},
+		{
+			name:          "old position does not exist",
+			expectedError: accumPackage.NoPositionError{Name: oldName},
+		},
+		{
+			name:          "new name already exists",
+			createOld:     true,
+			createNew:     true,
+			expectedError: accumPackage.PositionAlreadyExistsError{Name: newName},
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			accObject := accumPackage.MakeTestAccumulator(suite.store, testNameOne, initialCoinsDenomOne, emptyDec)
+
+			if tc.createOld {
+				suite.Require().NoError(accObject.NewPosition(oldName, sdk.NewDec(2), nil))
+			}
+			if tc.createNew {
+				suite.Require().NoError(accObject.NewPosition(newName, sdk.NewDec(3), nil))
+			}
+
+			// Accrue rewards so that they must carry over to the renamed position.
+			accObject.AddToAccumulator(sdk.NewDecCoins(sdk.NewDecCoinFromDec(denomOne, sdk.NewDec(5))))
+			oldPosition, _ := accumPackage.GetPosition(accObject, oldName)
+			newPositionBefore, _ := accumPackage.GetPosition(accObject, newName)
+
+			err := accObject.RenamePosition(oldName, newName)
+			if tc.expectedError != nil {
+				suite.Require().ErrorIs(err, tc.expectedError)
+
+				// Neither position is modified.
+				hasOld, err := accObject.HasPosition(oldName)
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.createOld, hasOld)
+				newPositionAfter, _ := accumPackage.GetPosition(accObject, newName)
+				suite.Require().Equal(newPositionBefore, newPositionAfter)
+				return
+			}
+			suite.Require().NoError(err)
+
+			hasOld, err := accObject.HasPosition(oldName)
+			suite.Require().NoError(err)
+			suite.Require().False(hasOld)
+
+			newPosition, err := accumPackage.GetPosition(accObject, newName)
+			suite.Require().NoError(err)
+			suite.Require().Equal(oldPosition, newPosition)
+
+			// The renamed position keeps its accrued rewards.
+			claimed, _, err := accObject.ClaimRewards(newName)
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(denomOne, sdk.NewInt(10))), claimed)
+		})
+	}
+}
+
+func (suite *AccumTestSuite) TestDistributeRewards_VirtualSharesMitigateInflation() {
+	var (
+		dustShares = sdk.MustNewDecFromStr("0.000001")
+		rewards    = sdk.NewDecCoins(sdk.NewDecCoin(denomOne, sdk.NewInt(1000)))
+	)
+
+	attackerRewards := func(makeAccum func() error) sdk.Coins {
+		suite.SetupTest()
+		suite.Require().NoError(makeAccum())
+
+		accObject, err := accumPackage.GetAccumulator(suite.store, testNameOne)
+		suite.Require().NoError(err)
+		err = accObject.NewPosition(testAddressOne, dustShares, nil)
+		suite.Require().NoError(err)
+
+		err = accObject.DistributeRewards(rewards)
+		suite.Require().NoError(err)
+
+		claimed, _, err := accObject.ClaimRewards(testAddressOne)
+		suite.Require().NoError(err)
+		return claimed
+	}
+
+	withoutFloor := attackerRewards(func() error {
+		return accumPackage.MakeAccumulator(suite.store, testNameOne)
+	})
+	withFloor := attackerRewards(func() error {
+		return accumPackage.MakeAccumulatorWithVirtualShares(suite.store, testNameOne, sdk.OneDec())
+	})
+
+	// Without a floor, the dust position claims the whole distribution.
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(denomOne, sdk.NewInt(1000))), withoutFloor)
+	// With a floor, its claim truncates to nothing.
+	suite.Require().True(withFloor.IsZero())
+}
+
+func (suite *AccumTestSuite) TestRecomputeTotalShares() {
+	suite.SetupTest()
+
+	err := accumPackage.MakeAccumulator(suite.store, testNameOne)
+	suite.Require().NoError(err)
+	accObject, err := accumPackage.GetAccumulator(suite.store, testNameOne)
+	suite.Require().NoError(err)
+
+	// Empty accumulator
+	stored, recomputed, err := accObject.RecomputeTotalShares(false, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.ZeroDec(), stored)
+	suite.Require().Equal(sdk.ZeroDec(), recomputed)
+
+	// Positions created through the accumulator keep the total in sync
+	err = accObject.NewPosition(testAddressOne, sdk.NewDec(5), nil)
+	suite.Require().NoError(err)
+	err = accObject.NewPosition(testAddressTwo, sdk.NewDec(7), nil)
+	suite.Require().NoError(err)
+
+	stored, recomputed, err = accObject.RecomputeTotalShares(false, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(12), stored)
+	suite.Require().Equal(sdk.NewDec(12), recomputed)
+
+	// A position written directly to the store makes the total drift
+	accObject = accumPackage.WithPosition(accObject, testAddressThree, accumPackage.Record{NumShares: sdk.NewDec(3), InitAccumValue: emptyCoins, UnclaimedRewards: emptyCoins})
+
+	stored, recomputed, err = accObject.RecomputeTotalShares(false, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(12), stored)
+	suite.Require().Equal(sdk.NewDec(15), recomputed)
+
+	// Without the repair flag, state is untouched
+	totalShares, err := accObject.GetTotalShares()
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(12), totalShares)
+
+	// With the repair flag, the stored total is overwritten
+	stored, recomputed, err = accObject.RecomputeTotalShares(true, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(12), stored)
+	suite.Require().Equal(sdk.NewDec(15), recomputed)
+
+	totalShares, err = accObject.GetTotalShares()
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(15), totalShares)
+
+	stored, recomputed, err = accObject.RecomputeTotalShares(false, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(stored, recomputed)
+
+	// Orphaned positions are left out of the recomputed sum
+	isOrphaned := func(name string) bool { return name == testAddressThree }
+	stored, recomputed, err = accObject.RecomputeTotalShares(false, isOrphaned)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(15), stored)
+	suite.Require().Equal(sdk.NewDec(12), recomputed)
+
+	hasPosition, err := accObject.HasPosition(testAddressThree)
+	suite.Require().NoError(err)
+	suite.Require().True(hasPosition)
+
+	// With the repair flag, orphaned positions are deleted and the stored total is overwritten
+	stored, recomputed, err = accObject.RecomputeTotalShares(true, isOrphaned)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(15), stored)
+	suite.Require().Equal(sdk.NewDec(12), recomputed)
+
+	hasPosition, err = accObject.HasPosition(testAddressThree)
+	suite.Require().NoError(err)
+	suite.Require().False(hasPosition)
+	hasPosition, err = accObject.HasPosition(testAddressOne)
+	suite.Require().NoError(err)
+	suite.Require().True(hasPosition)
+
+	stored, recomputed, err = accObject.RecomputeTotalShares(false, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(12), stored)
+	suite.Require().Equal(sdk.NewDec(12), recomputed)
+}
+
+func (suite *AccumTestSuite) TestAssertSolvent() {
+	suite.SetupTest()
+
+	err := accumPackage.MakeAccumulator(suite.store, testNameOne)
+	suite.Require().NoError(err)
+	accObject, err := accumPackage.GetAccumulator(suite.store, testNameOne)
+	suite.Require().NoError(err)
+
+	// No positions owe anything.
+	suite.Require().NoError(accObject.AssertSolvent(sdk.NewCoins()))
+
+	err = accObject.NewPosition(testAddressOne, sdk.NewDec(1), nil)
+	suite.Require().NoError(err)
+	err = accObject.NewPosition(testAddressTwo, sdk.NewDec(3), nil)
+	suite.Require().NoError(err)
+
+	// 10 denomOne and 2 denomTwo per share are owed across 4 shares.
+	accObject.AddToAccumulator(sdk.NewDecCoins(sdk.NewDecCoin(denomOne, sdk.NewInt(10)), sdk.NewDecCoin(denomTwo, sdk.NewInt(2))))
+	owed := sdk.NewCoins(sdk.NewCoin(denomOne, sdk.NewInt(40)), sdk.NewCoin(denomTwo, sdk.NewInt(8)))
+
+	// Exactly funded and over-funded balances are solvent.
+	suite.Require().NoError(accObject.AssertSolvent(owed))
+	suite.Require().NoError(accObject.AssertSolvent(owed.Add(sdk.NewCoin(denomThree, sdk.NewInt(1)))))
+
+	// Under-funding any single denom is insolvent.
+	underFunded := sdk.NewCoins(sdk.NewCoin(denomOne, sdk.NewInt(40)), sdk.NewCoin(denomTwo, sdk.NewInt(7)))
+	err = accObject.AssertSolvent(underFunded)
+	suite.Require().EqualError(err, accumPackage.InsolventAccumulatorError{AccumName: testNameOne, OwedRewards: owed, Escrowed: underFunded}.Error())
+
+	// A missing denom is insolvent.
+	err = accObject.AssertSolvent(sdk.NewCoins(sdk.NewCoin(denomOne, sdk.NewInt(40))))
+	suite.Require().Error(err)
+
+	// Claimed rewards are no longer owed.
+	claimed, _, err := accObject.ClaimRewards(testAddressTwo)
+	suite.Require().NoError(err)
+	suite.Require().NoError(accObject.AssertSolvent(owed.Sub(claimed)))
+	suite.Require().Error(accObject.AssertSolvent(owed.Sub(claimed).Sub(sdk.NewCoins(sdk.NewCoin(denomOne, sdk.NewInt(1))))))
+}
+
+func (suite *AccumTestSuite) TestAssertSolvent_ForfeitedRewards() {
+	suite.SetupTest()
+
+	err := accumPackage.MakeAccumulator(suite.store, testNameOne)
+	suite.Require().NoError(err)
+	accObject, err := accumPackage.GetAccumulator(suite.store, testNameOne)
+	suite.Require().NoError(err)
+
+	// One position can only claim half of its rewards, the other at most 15 denomOne over its lifetime.
+	halfClaimable := sdk.MustNewDecFromStr("0.5")
+	err = accObject.NewPosition(testAddressOne, sdk.NewDec(1), &accumPackage.Options{ClaimableFraction: &halfClaimable})
+	suite.Require().NoError(err)
+	err = accObject.NewPosition(testAddressTwo, sdk.NewDec(3), &accumPackage.Options{MaxReward: sdk.NewCoins(sdk.NewCoin(denomOne, sdk.NewInt(15)))})
+	suite.Require().NoError(err)
+
+	// 40 denomOne accrue across 4 shares, but only 10 * 0.5 + min(30, 15) = 20 are claimable.
+	accObject.AddToAccumulator(sdk.NewDecCoins(sdk.NewDecCoin(denomOne, sdk.NewInt(10))))
+	owed := sdk.NewCoins(sdk.NewCoin(denomOne, sdk.NewInt(20)))
+
+	// The forfeited and capped rewards do not need to be escrowed.
+	suite.Require().NoError(accObject.AssertSolvent(owed))
+	underFunded := owed.Sub(sdk.NewCoins(sdk.NewCoin(denomOne, sdk.NewInt(1))))
+	err = accObject.AssertSolvent(underFunded)
+	suite.Require().EqualError(err, accumPackage.InsolventAccumulatorError{AccumName: testNameOne, OwedRewards: owed, Escrowed: underFunded}.Error())
+
+	// Once the max reward has been claimed, the capped position is owed nothing more.
+	claimed, _, _, err := accObject.ClaimRewardsDetailed(testAddressTwo)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(denomOne, sdk.NewInt(15))).String(), claimed.String())
+	accObject.AddToAccumulator(sdk.NewDecCoins(sdk.NewDecCoin(denomOne, sdk.NewInt(10))))
+	suite.Require().NoError(accObject.AssertSolvent(sdk.NewCoins(sdk.NewCoin(denomOne, sdk.NewInt(10)))))
+	suite.Require().Error(accObject.AssertSolvent(sdk.NewCoins(sdk.NewCoin(denomOne, sdk.NewInt(9)))))
+}
+
+func (suite *AccumTestSuite) TestClaimAndRestake() {
+	suite.SetupTest()
+
+	// Source accumulator with a position owed rewards in two denoms
+	err := accumPackage.MakeAccumulator(suite.store, testNameOne)
+	suite.Require().NoError(err)
+	source, err := accumPackage.GetAccumulator(suite.store, testNameOne)
+	suite.Require().NoError(err)
+	err = source.NewPosition(testAddressOne, sdk.NewDec(10), nil)
+	suite.Require().NoError(err)
+	source.AddToAccumulator(sdk.NewDecCoins(sdk.NewInt64DecCoin(denomOne, 5), sdk.NewInt64DecCoin(denomTwo, 3)))
+
+	// Target accumulator whose reward denom is denomOne
+	err = accumPackage.MakeAccumulator(suite.store, testNameTwo)
+	suite.Require().NoError(err)
+	target, err := accumPackage.GetAccumulator(suite.store, testNameTwo)
+	suite.Require().NoError(err)
+	err = target.NewPosition(testAddressTwo, sdk.NewDec(20), nil)
+	suite.Require().NoError(err)
+	target.AddToAccumulator(sdk.NewDecCoins(sdk.NewInt64DecCoin(denomOne, 1)))
+
+	restaked, returned, forfeited, capped, err := source.ClaimAndRestake(testAddressOne, &target)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denomOne, 50)), restaked)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denomTwo, 30)), returned)
+	suite.Require().True(forfeited.IsZero())
+	suite.Require().True(capped.IsZero())
+
+	// The restaked rewards are spread over the target's shares
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoinFromDec(denomOne, sdk.MustNewDecFromStr("3.5"))), target.GetValue())
+	rewards, err := target.GetPositionRewards(testAddressTwo)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin(denomOne, 70)), rewards)
+
+	// The source position has been claimed
+	rewards, err = source.GetPositionRewards(testAddressOne)
+	suite.Require().NoError(err)
+	suite.Require().True(rewards.IsZero())
+
+	// A target without shares cannot be restaked into, so everything is returned
+	err = accumPackage.MakeAccumulatorWithValueAndShare(suite.store, testNameThree, sdk.NewDecCoins(sdk.NewInt64DecCoin(denomOne, 1)), sdk.ZeroDec())
+	suite.Require().NoError(err)
+	emptyTarget, err := accumPackage.GetAccumulator(suite.store, testNameThree)
+	suite.Require().NoError(err)
+	source.AddToAccumulator(sdk.NewDecCoins(sdk.NewInt64DecCoin(denomOne, 1)))
+
+	restaked, returned, _, _, err = source.ClaimAndRestake(testAddressOne, &emptyTarget)
+	suite.Require().NoError(err)
+	suite.Require().True(restaked.IsZero())
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denomOne, 10)), returned)
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin(denomOne, 1)), emptyTarget.GetValue())
+
+	// Non-existent position
+	_, _, _, _, err = source.ClaimAndRestake(testAddressThree, &target)
+	suite.Require().ErrorIs(err, accumPackage.NoPositionError{Name: testAddressThree})
+}
+
+func (suite *AccumTestSuite) TestPreviewUpdate() {
+	suite.SetupTest()
+
+	err := accumPackage.MakeAccumulator(suite.store, testNameOne)
+	suite.Require().NoError(err)
+	accum, err := accumPackage.GetAccumulator(suite.store, testNameOne)
+	suite.Require().NoError(err)
+	err = accum.NewPosition(testAddressOne, sdk.NewDec(10), nil)
+	suite.Require().NoError(err)
+	accum.AddToAccumulator(sdk.NewDecCoins(sdk.NewInt64DecCoin(denomOne, 2)))
+
+	positionBefore := accum.MustGetPosition(testAddressOne)
+
+	// Adding shares moves the snapshot up and folds the accrued rewards into the unclaimed rewards
+	preview, err := accum.PreviewUpdate(testAddressOne, sdk.NewDec(5))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(15), preview.NumShares)
+	suite.Require().Equal(accum.GetValue(), preview.InitAccumValue)
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin(denomOne, 20)), preview.UnclaimedRewards)
+
+	// The preview does not write to state
+	suite.Require().Equal(positionBefore, accum.MustGetPosition(testAddressOne))
+	totalShares, err := accum.GetTotalShares()
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(10), totalShares)
+
+	// Removing shares previews the same record that the update writes
+	preview, err = accum.PreviewUpdate(testAddressOne, sdk.NewDec(-4))
+	suite.Require().NoError(err)
+	err = accum.UpdatePosition(testAddressOne, sdk.NewDec(-4))
+	suite.Require().NoError(err)
+	suite.Require().Equal(accum.MustGetPosition(testAddressOne), preview)
+
+	// Zero share delta
+	_, err = accum.PreviewUpdate(testAddressOne, sdk.ZeroDec())
+	suite.Require().ErrorIs(err, accumPackage.ZeroSharesError)
+
+	// Removing more shares than exist
+	_, err = accum.PreviewUpdate(testAddressOne, sdk.NewDec(-7))
+	suite.Require().Error(err)
+
+	// Non-existent position
+	_, err = accum.PreviewUpdate(testAddressTwo, sdk.OneDec())
+	suite.Require().ErrorIs(err, accumPackage.NoPositionError{Name: testAddressTwo})
+}
+
+func (suite *AccumTestSuite) TestMakeAccumulatorWithScalingFactor() {
+	suite.SetupTest()
+
+	err := accumPackage.MakeAccumulatorWithScalingFactor(suite.store, testNameOne, sdk.NewDec(1000))
+	suite.Require().NoError(err)
+
+	accObject, err := accumPackage.GetAccumulator(suite.store, testNameOne)
+	suite.Require().NoError(err)
+	scalingFactor, err := accObject.GetScalingFactor()
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(1000), scalingFactor)
+
+	// The value is kept in scaled units
+	accObject.AddToAccumulator(sdk.NewDecCoins(sdk.NewInt64DecCoin(denomOne, 2)))
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin(denomOne, 2000)), accObject.GetValue())
+
+	// Duplicate accumulator
+	err = accumPackage.MakeAccumulatorWithScalingFactor(suite.store, testNameOne, sdk.NewDec(1000))
+	suite.Require().Error(err)
+
+	// Non-positive scaling factors
+	err = accumPackage.MakeAccumulatorWithScalingFactor(suite.store, testNameTwo, sdk.ZeroDec())
+	suite.Require().ErrorIs(err, accumPackage.NonPositiveScalingFactorError{ScalingFactor: sdk.ZeroDec()})
+	err = accumPackage.MakeAccumulatorWithScalingFactor(suite.store, testNameTwo, sdk.NewDec(-1))
+	suite.Require().ErrorContains(err, accumPackage.NonPositiveScalingFactorError{ScalingFactor: sdk.NewDec(-1)}.Error())
+
+	// Accumulators made without a scaling factor are unscaled
+	err = accumPackage.MakeAccumulator(suite.store, testNameThree)
+	suite.Require().NoError(err)
+	accObject, err = accumPackage.GetAccumulator(suite.store, testNameThree)
+	suite.Require().NoError(err)
+	scalingFactor, err = accObject.GetScalingFactor()
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.OneDec(), scalingFactor)
+}
+
+// TestScalingFactor_RewardsUnchanged tests that the same sequence of operations pays out the same
+// rewards regardless of the accumulator's scaling factor.
+func (suite *AccumTestSuite) TestScalingFactor_RewardsUnchanged() {
+	runScenario := func(accumName string, scalingFactor sdk.Dec) (claimedOne, claimedTwo sdk.Coins) {
+		err := accumPackage.MakeAccumulatorWithScalingFactor(suite.store, accumName, scalingFactor)
+		suite.Require().NoError(err)
+		accObject, err := accumPackage.GetAccumulator(suite.store, accumName)
+		suite.Require().NoError(err)
+
+		err = accObject.NewPosition(testAddressOne, sdk.NewDec(100), nil)
+		suite.Require().NoError(err)
+		accObject.AddToAccumulator(sdk.NewDecCoins(sdk.NewDecCoinFromDec(denomOne, sdk.MustNewDecFromStr("1.5"))))
+
+		err = accObject.NewPosition(testAddressTwo, sdk.NewDec(300), nil)
+		suite.Require().NoError(err)
+		err = accObject.DistributeRewards(sdk.NewDecCoins(sdk.NewInt64DecCoin(denomOne, 1000), sdk.NewInt64DecCoin(denomTwo, 7)))
+		suite.Require().NoError(err)
+
+		err = accObject.UpdatePosition(testAddressOne, sdk.NewDec(-50))
+		suite.Require().NoError(err)
+		accObject.AddToAccumulator(sdk.NewDecCoins(sdk.NewInt64DecCoin(denomTwo, 3)))
+
+		claimedOne, _, err = accObject.ClaimRewards(testAddressOne)
+		suite.Require().NoError(err)
+		claimedTwo, _, err = accObject.ClaimRewards(testAddressTwo)
+		suite.Require().NoError(err)
+		return claimedOne, claimedTwo
+	}
+
+	suite.SetupTest()
+	unscaledOne, unscaledTwo := runScenario(testNameOne, sdk.OneDec())
+	scaledOne, scaledTwo := runScenario(testNameTwo, sdk.NewDec(10).Power(6))
+
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denomOne, 400), sdk.NewInt64Coin(denomTwo, 151)), unscaledOne)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(denomOne, 750), sdk.NewInt64Coin(denomTwo, 905)), unscaledTwo)
+	suite.Require().Equal(unscaledOne, scaledOne)
+	suite.Require().Equal(unscaledTwo, scaledTwo)
+}
+
+// Accumulators created before scaling factors were introduced have none stored.
+// They must behave exactly as if their factor were one.
+func (suite *AccumTestSuite) TestGetScalingFactor_Unset() {
+	suite.SetupTest()
+
+	err := accumPackage.MakeAccumulator(suite.store, testNameOne)
+	suite.Require().NoError(err)
+	accObject, err := accumPackage.GetAccumulator(suite.store, testNameOne)
+	suite.Require().NoError(err)
+	err = accObject.NewPosition(testAddressOne, sdk.NewDec(10), nil)
+	suite.Require().NoError(err)
+	accObject.AddToAccumulator(sdk.NewDecCoins(sdk.NewInt64DecCoin(denomOne, 2)))
+
+	// Simulate an accumulator created before scaling factors were introduced
+	accumPackage.WithoutScalingFactor(suite.store, testNameOne)
+	accObject, err = accumPackage.GetAccumulator(suite.store, testNameOne)
+	suite.Require().NoError(err)
+
+	scalingFactor, err := accObject.GetScalingFactor()
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.OneDec(), scalingFactor)
+	rewards, err := accObject.GetPositionRewards(testAddressOne)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin(denomOne, 20)), rewards)
+
+	// Writes to the accumulator keep working with the unset factor
+	err = accObject.AddToPosition(testAddressOne, sdk.NewDec(5))
+	suite.Require().NoError(err)
+	accObject, err = accumPackage.GetAccumulator(suite.store, testNameOne)
+	suite.Require().NoError(err)
+	totalShares, err := accObject.GetTotalShares()
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(15), totalShares)
+}
@@ -3,6 +3,8 @@ package accum
 import (
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -19,6 +21,14 @@ func (e NoPositionError) Error() string {
 	return fmt.Sprintf("no position found for position key (%s)", e.Name)
 }
 
+type NoPositionsError struct {
+	Names []string
+}
+
+func (e NoPositionsError) Error() string {
+	return fmt.Sprintf("no positions found for position keys (%s)", strings.Join(e.Names, ", "))
+}
+
 type NegativeCustomAccError struct {
 	CustomAccumulatorValue sdk.DecCoins
 }
@@ -35,6 +45,14 @@ func (e NegativeAccDifferenceError) Error() string {
 	return fmt.Sprintf("difference (%s) between the old and the new accumulator value is negative", e.AccumulatorDifference)
 }
 
+type NegativeUnclaimedRewardsError struct {
+	UnclaimedRewards sdk.DecCoins
+}
+
+func (e NegativeUnclaimedRewardsError) Error() string {
+	return fmt.Sprintf("unclaimedRewards must be non-negative, was (%s)", e.UnclaimedRewards)
+}
+
 type AccumDoesNotExistError struct {
 	AccumName string
 }
@@ -42,3 +60,93 @@ type AccumDoesNotExistError struct {
 func (e AccumDoesNotExistError) Error() string {
 	return fmt.Sprintf("Accumulator name %s does not exist in store", e.AccumName)
 }
+
+type InvalidClaimableFractionError struct {
+	ClaimableFraction sdk.Dec
+}
+
+func (e InvalidClaimableFractionError) Error() string {
+	return fmt.Sprintf("claimable fraction must be in [0, 1], was (%s)", e.ClaimableFraction)
+}
+
+type InvalidMaxRewardError struct {
+	MaxReward sdk.Coins
+}
+
+func (e InvalidMaxRewardError) Error() string {
+	return fmt.Sprintf("max reward must be valid coins, was (%s)", e.MaxReward)
+}
+
+type DuplicatePositionNameError struct {
+	Name string
+}
+
+func (e DuplicatePositionNameError) Error() string {
+	return fmt.Sprintf("position name (%s) appears more than once in batch", e.Name)
+}
+
+type InvalidPositionInputError struct {
+	Index int
+	Name  string
+	Err   error
+}
+
+func (e InvalidPositionInputError) Error() string {
+	return fmt.Sprintf("invalid position input at index %d (%s): %s", e.Index, e.Name, e.Err)
+}
+
+func (e InvalidPositionInputError) Unwrap() error {
+	return e.Err
+}
+
+type NegativeVirtualSharesError struct {
+	VirtualShares sdk.Dec
+}
+
+func (e NegativeVirtualSharesError) Error() string {
+	return fmt.Sprintf("virtual shares must be non-negative, was (%s)", e.VirtualShares)
+}
+
+type NonPositiveScalingFactorError struct {
+	ScalingFactor sdk.Dec
+}
+
+func (e NonPositiveScalingFactorError) Error() string {
+	return fmt.Sprintf("scaling factor must be positive, was (%s)", e.ScalingFactor)
+}
+
+type NoRewardStreamError struct {
+	AccumName string
+}
+
+func (e NoRewardStreamError) Error() string {
+	return fmt.Sprintf("accumulator (%s) has no reward stream", e.AccumName)
+}
+
+type InvalidRewardStreamError struct {
+	TotalReward sdk.DecCoins
+	StartTime   time.Time
+	EndTime     time.Time
+}
+
+func (e InvalidRewardStreamError) Error() string {
+	return fmt.Sprintf("reward stream must have a valid total reward and end after it starts, was (%s) from (%s) to (%s)", e.TotalReward, e.StartTime, e.EndTime)
+}
+
+type PositionAlreadyExistsError struct {
+	Name string
+}
+
+func (e PositionAlreadyExistsError) Error() string {
+	return fmt.Sprintf("position already exists for position key (%s)", e.Name)
+}
+
+type InsolventAccumulatorError struct {
+	AccumName   string
+	OwedRewards sdk.Coins
+	Escrowed    sdk.Coins
+}
+
+func (e InsolventAccumulatorError) Error() string {
+	return fmt.Sprintf("accumulator (%s) owes (%s) in rewards, which exceeds the escrowed balance (%s)", e.AccumName, e.OwedRewards, e.Escrowed)
+}
@@ -0,0 +1,37 @@
+package accum
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	TypeEvtClaim = "accumulator_claim"
+
+	AttributeKeyAccumulator = "accumulator"
+	AttributeKeyPosition    = "position"
+	AttributeKeyClaimed     = "claimed"
+)
+
+// EventEmitter receives the events emitted by an accumulator, e.g. sdk.EventManager.EmitEvent.
+type EventEmitter func(event sdk.Event)
+
+// WithEventEmitter returns a copy of the accumulator that emits its events through the given emitter.
+func (accum AccumulatorObject) WithEventEmitter(emitter EventEmitter) AccumulatorObject {
+	accum.eventEmitter = emitter
+	return accum
+}
+
+// emitClaimEvent emits an accumulator_claim event for the given position and claimed coins.
+// Nothing is emitted if the accumulator has no event emitter or nothing was claimed.
+func (accum AccumulatorObject) emitClaimEvent(positionName string, claimed sdk.Coins) {
+	if accum.eventEmitter == nil || claimed.IsZero() {
+		return
+	}
+
+	accum.eventEmitter(sdk.NewEvent(
+		TypeEvtClaim,
+		sdk.NewAttribute(AttributeKeyAccumulator, accum.name),
+		sdk.NewAttribute(AttributeKeyPosition, positionName),
+		sdk.NewAttribute(AttributeKeyClaimed, claimed.String()),
+	))
+}
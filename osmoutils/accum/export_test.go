@@ -26,15 +26,22 @@ func MakeTestAccumulator(store store.KVStore, name string, value sdk.DecCoins, t
 	// because position operations still require GetAccumulator to work
 	_ = MakeAccumulator(store, name)
 	return AccumulatorObject{
-		store:       store,
-		name:        name,
-		value:       value,
-		totalShares: totalShares,
+		store:         store,
+		name:          name,
+		value:         value,
+		totalShares:   totalShares,
+		virtualShares: sdk.ZeroDec(),
+		scalingFactor: sdk.OneDec(),
 	}
 }
 
+// CreateRawPosition writes a position with the given fields directly to the store.
+// Panics if the fields are invalid.
 func CreateRawPosition(accum AccumulatorObject, name string, numShareUnits sdk.Dec, unclaimedRewards sdk.DecCoins, options *Options) {
-	initOrUpdatePosition(accum, accum.value, name, numShareUnits, unclaimedRewards, options)
+	if err := validatePositionFields(accum.value, unclaimedRewards, options); err != nil {
+		panic(err)
+	}
+	initOrUpdatePosition(accum, accum.value, name, numShareUnits, numShareUnits, unclaimedRewards, nil, nil, options)
 }
 
 // Gets store from accumulator for testing purposes
@@ -72,6 +79,23 @@ func (accum *AccumulatorObject) SetValue(value sdk.DecCoins) {
 	accum.value = value
 }
 
+func ValidatePositionFields(customAccumulatorValue, unclaimedRewards sdk.DecCoins, options *Options) error {
+	return validatePositionFields(customAccumulatorValue, unclaimedRewards, options)
+}
+
 func (o *Options) Validate() error {
 	return o.validate()
 }
+
+func GetTotalRewards(accum AccumulatorObject, position Record) sdk.DecCoins {
+	return getTotalRewards(accum, position)
+}
+
+// WithoutScalingFactor is a test helper that clears the scaling factor stored on the given accumulator,
+// as for accumulators created before scaling factors were introduced.
+func WithoutScalingFactor(accumStore store.KVStore, accumName string) {
+	accumContent := AccumulatorContent{}
+	osmoutils.MustGet(accumStore, formatAccumPrefixKey(accumName), &accumContent)
+	accumContent.ScalingFactor = sdk.Dec{}
+	osmoutils.MustSet(accumStore, formatAccumPrefixKey(accumName), &accumContent)
+}
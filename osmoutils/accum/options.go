@@ -7,14 +7,61 @@ import (
 var one = sdk.OneDec()
 
 // validate returns nil if Options are valid.
-// Error otherwise. Note, that, currently,
-// options do not contain any fields. We
-// create them to be able to extend in the
-// future with auto-compounding logic.
-// As a result, this always returns nil.
+// Error otherwise. Nil options are always valid.
+// If set, the claimable fraction must be a non-nil decimal in [0, 1].
+// If set, the max reward must be valid coins: sorted by denom, without
+// duplicates and with positive amounts.
+// The returned error names the offending field.
 func (o *Options) validate() error {
 	if o == nil {
 		return nil
 	}
+	if o.ClaimableFraction != nil && (o.ClaimableFraction.IsNil() || o.ClaimableFraction.IsNegative() || o.ClaimableFraction.GT(one)) {
+		return InvalidClaimableFractionError{ClaimableFraction: *o.ClaimableFraction}
+	}
+	if o.hasMaxReward() && !o.MaxReward.IsValid() {
+		return InvalidMaxRewardError{MaxReward: o.MaxReward}
+	}
 	return nil
 }
+
+// claimableFraction returns the fraction of a position's rewards that
+// may be claimed. Positions with no claimable fraction set may claim
+// all of their rewards.
+func (o *Options) claimableFraction() sdk.Dec {
+	if o == nil || o.ClaimableFraction == nil {
+		return one
+	}
+	return *o.ClaimableFraction
+}
+
+// hasMaxReward returns true if the options cap the rewards
+// that a position may claim.
+func (o *Options) hasMaxReward() bool {
+	return o != nil && len(o.MaxReward) > 0
+}
+
+// capRewards clamps rewards to what remains of the max reward given
+// the rewards that have already been claimed. Returns the rewards within
+// the cap alongside the overflow above it. Denoms without a max reward
+// are not capped.
+func (o *Options) capRewards(rewards sdk.DecCoins, claimedRewards sdk.Coins) (sdk.DecCoins, sdk.DecCoins) {
+	if !o.hasMaxReward() {
+		return rewards, sdk.NewDecCoins()
+	}
+
+	cappedRewards := sdk.NewDecCoins()
+	for _, reward := range rewards {
+		maxReward := o.MaxReward.AmountOf(reward.Denom)
+		if maxReward.IsZero() {
+			continue
+		}
+
+		remaining := sdk.MaxInt(maxReward.Sub(claimedRewards.AmountOf(reward.Denom)), sdk.ZeroInt()).ToDec()
+		if reward.Amount.GT(remaining) {
+			cappedRewards = cappedRewards.Add(sdk.NewDecCoinFromDec(reward.Denom, reward.Amount.Sub(remaining)))
+		}
+	}
+
+	return rewards.Sub(cappedRewards), cappedRewards
+}
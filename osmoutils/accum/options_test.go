@@ -1,6 +1,10 @@
 package accum_test
 
-import "github.com/osmosis-labs/osmosis/osmoutils/accum"
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils/accum"
+)
 
 // TestOptionsValidate tests that the options are validated correctly.
 func (suite *AccumTestSuite) TestOptionsValidate() {
@@ -15,6 +19,42 @@ func (suite *AccumTestSuite) TestOptionsValidate() {
 		"non-nil options - success": {
 			options: &accum.Options{},
 		},
+		"claimable fraction of zero - success": {
+			options: &accum.Options{ClaimableFraction: decPtr(sdk.ZeroDec())},
+		},
+		"claimable fraction of one - success": {
+			options: &accum.Options{ClaimableFraction: decPtr(sdk.OneDec())},
+		},
+		"claimable fraction in between - success": {
+			options: &accum.Options{ClaimableFraction: decPtr(sdk.MustNewDecFromStr("0.5"))},
+		},
+		"negative claimable fraction - error": {
+			options:     &accum.Options{ClaimableFraction: decPtr(sdk.NewDec(-1))},
+			expectError: accum.InvalidClaimableFractionError{ClaimableFraction: sdk.NewDec(-1)},
+		},
+		"claimable fraction greater than one - error": {
+			options:     &accum.Options{ClaimableFraction: decPtr(sdk.MustNewDecFromStr("1.1"))},
+			expectError: accum.InvalidClaimableFractionError{ClaimableFraction: sdk.MustNewDecFromStr("1.1")},
+		},
+		"max reward - success": {
+			options: &accum.Options{MaxReward: sdk.NewCoins(sdk.NewCoin("foo", sdk.NewInt(100)))},
+		},
+		"nil claimable fraction decimal - error": {
+			options:     &accum.Options{ClaimableFraction: &sdk.Dec{}},
+			expectError: accum.InvalidClaimableFractionError{ClaimableFraction: sdk.Dec{}},
+		},
+		"duplicate max reward denoms - error": {
+			options:     &accum.Options{MaxReward: sdk.Coins{sdk.NewCoin("foo", sdk.NewInt(1)), sdk.NewCoin("foo", sdk.NewInt(2))}},
+			expectError: accum.InvalidMaxRewardError{MaxReward: sdk.Coins{sdk.NewCoin("foo", sdk.NewInt(1)), sdk.NewCoin("foo", sdk.NewInt(2))}},
+		},
+		"zero max reward - error": {
+			options:     &accum.Options{MaxReward: sdk.Coins{{Denom: "foo", Amount: sdk.ZeroInt()}}},
+			expectError: accum.InvalidMaxRewardError{MaxReward: sdk.Coins{{Denom: "foo", Amount: sdk.ZeroInt()}}},
+		},
+		"invalid max reward - error": {
+			options:     &accum.Options{MaxReward: sdk.Coins{{Denom: "foo", Amount: sdk.NewInt(-1)}}},
+			expectError: accum.InvalidMaxRewardError{MaxReward: sdk.Coins{{Denom: "foo", Amount: sdk.NewInt(-1)}}},
+		},
 	}
 
 	for name, tc := range tests {
@@ -25,9 +65,58 @@ func (suite *AccumTestSuite) TestOptionsValidate() {
 
 			if tc.expectError != nil {
 				suite.Require().Error(err)
+				suite.Require().Equal(tc.expectError, err)
+				return
+			}
+			suite.Require().NoError(err)
+		})
+	}
+}
+
+// TestValidatePositionFields tests that the fields of a new position are validated correctly.
+func (suite *AccumTestSuite) TestValidatePositionFields() {
+	negativeCoins := sdk.DecCoins{{Denom: "foo", Amount: sdk.NewDec(-1)}}
+
+	tests := map[string]struct {
+		customAccumulatorValue sdk.DecCoins
+		unclaimedRewards       sdk.DecCoins
+		options                *accum.Options
+		expectError            error
+	}{
+		"empty fields - success": {},
+		"positive fields with options - success": {
+			customAccumulatorValue: sdk.NewDecCoins(sdk.NewDecCoin("foo", sdk.NewInt(10))),
+			unclaimedRewards:       sdk.NewDecCoins(sdk.NewDecCoin("foo", sdk.NewInt(1))),
+			options:                &accum.Options{ClaimableFraction: decPtr(sdk.MustNewDecFromStr("0.5"))},
+		},
+		"negative custom accumulator value - error": {
+			customAccumulatorValue: negativeCoins,
+			expectError:            accum.NegativeCustomAccError{CustomAccumulatorValue: negativeCoins},
+		},
+		"negative unclaimed rewards - error": {
+			unclaimedRewards: negativeCoins,
+			expectError:      accum.NegativeUnclaimedRewardsError{UnclaimedRewards: negativeCoins},
+		},
+		"invalid options - error": {
+			options:     &accum.Options{ClaimableFraction: decPtr(sdk.NewDec(2))},
+			expectError: accum.InvalidClaimableFractionError{ClaimableFraction: sdk.NewDec(2)},
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			err := accum.ValidatePositionFields(tc.customAccumulatorValue, tc.unclaimedRewards, tc.options)
+
+			if tc.expectError != nil {
+				suite.Require().Error(err)
+				suite.Require().Equal(tc.expectError, err)
 				return
 			}
 			suite.Require().NoError(err)
 		})
 	}
 }
+
+func decPtr(d sdk.Dec) *sdk.Dec {
+	return &d
+}
@@ -6,6 +6,7 @@ const (
 	modulePrefix      = "accum"
 	accumulatorPrefix = "acc"
 	positionPrefix    = "pos"
+	streamPrefix      = "stream"
 )
 
 // formatAccumPrefix returns the key prefix used for any
@@ -28,3 +29,10 @@ func formatAccumPrefixKey(name string) []byte {
 func formatPositionPrefixKey(accumName, name string) []byte {
 	return formatAccumPrefixKey(fmt.Sprintf("%s/%s/%s", positionPrefix, accumName, name))
 }
+
+// formatRewardStreamKey returns the key used
+// specifically for an accumulator's reward stream in the KVStore.
+// Returns "accum/stream/{accumName}" as bytes.
+func formatRewardStreamKey(accumName string) []byte {
+	return formatModulePrefixKey(fmt.Sprintf("%s/%s", streamPrefix, accumName))
+}
@@ -0,0 +1,90 @@
+package accum
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+)
+
+// SetRewardStream configures the accumulator to linearly stream totalReward to its shares
+// between startTime and endTime, replacing any existing stream. Rewards are only added once
+// AddFromStream is called.
+// The stream is stored separately from the accumulator's value and shares so that accumulator
+// objects fetched before the stream was set cannot overwrite it.
+// Returns error if totalReward is invalid or endTime is not after startTime.
+func (accum AccumulatorObject) SetRewardStream(totalReward sdk.DecCoins, startTime, endTime time.Time) error {
+	if totalReward.Validate() != nil || !endTime.After(startTime) {
+		return InvalidRewardStreamError{TotalReward: totalReward, StartTime: startTime, EndTime: endTime}
+	}
+
+	stream := RewardStream{
+		TotalReward:     totalReward,
+		StartTime:       startTime,
+		EndTime:         endTime,
+		LastAccruedTime: startTime,
+	}
+	osmoutils.MustSet(accum.store, formatRewardStreamKey(accum.name), &stream)
+	return nil
+}
+
+// GetRewardStream returns the accumulator's reward stream.
+// Returns NoRewardStreamError if the accumulator has none.
+func (accum AccumulatorObject) GetRewardStream() (RewardStream, error) {
+	stream := RewardStream{}
+	found, err := osmoutils.Get(accum.store, formatRewardStreamKey(accum.name), &stream)
+	if err != nil {
+		return RewardStream{}, err
+	}
+	if !found {
+		return RewardStream{}, NoRewardStreamError{AccumName: accum.name}
+	}
+	return stream, nil
+}
+
+// AddFromStream distributes the portion of the reward stream that elapsed between the stream's
+// last accrued time and now over the accumulator's shares, as DistributeRewards does, and moves
+// the last accrued time up to now. Times outside of the stream's start and end are clamped to them,
+// so calling it repeatedly never distributes more than the stream's total reward.
+//
+// The amount is computed as the difference between the cumulative rewards due at now and at the
+// last accrued time, so rounding never accumulates across calls and the total reward is
+// distributed in full once the stream ends.
+// Persists to store. Mutates the receiver.
+// Returns the distributed rewards. Returns error if the accumulator has no reward stream or if
+// rewards are due but the accumulator has no shares to distribute them to. In the latter case the
+// last accrued time is left unchanged, so the rewards are distributed by a later call instead.
+func (accum *AccumulatorObject) AddFromStream(now time.Time) (sdk.DecCoins, error) {
+	stream, err := accum.GetRewardStream()
+	if err != nil {
+		return nil, err
+	}
+
+	if now.After(stream.EndTime) {
+		now = stream.EndTime
+	}
+	if !now.After(stream.LastAccruedTime) {
+		return sdk.NewDecCoins(), nil
+	}
+
+	rewards := stream.rewardsDueAt(now).Sub(stream.rewardsDueAt(stream.LastAccruedTime))
+	if !rewards.IsZero() {
+		if err := accum.DistributeRewards(rewards); err != nil {
+			return nil, err
+		}
+	}
+
+	stream.LastAccruedTime = now
+	osmoutils.MustSet(accum.store, formatRewardStreamKey(accum.name), &stream)
+
+	return rewards, nil
+}
+
+// rewardsDueAt returns the cumulative rewards that the stream is due to have distributed by the
+// given time, which must be between the stream's start and end times.
+func (stream RewardStream) rewardsDueAt(t time.Time) sdk.DecCoins {
+	elapsed := sdk.NewDec(t.Sub(stream.StartTime).Nanoseconds())
+	duration := sdk.NewDec(stream.EndTime.Sub(stream.StartTime).Nanoseconds())
+	return stream.TotalReward.MulDecTruncate(elapsed.Quo(duration))
+}
@@ -0,0 +1,84 @@
+package accum_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	accumPackage "github.com/osmosis-labs/osmosis/osmoutils/accum"
+)
+
+// TestAddFromStream tests that the reward stream distributes its total reward linearly over time,
+// never more than once for the same period and in full once it ends.
+func (suite *AccumTestSuite) TestAddFromStream() {
+	suite.SetupTest()
+
+	var (
+		startTime   = time.Unix(1_000_000, 0).UTC()
+		endTime     = startTime.Add(100 * time.Second)
+		totalReward = sdk.NewDecCoins(sdk.NewDecCoin(denomOne, sdk.NewInt(1000)))
+	)
+
+	err := accumPackage.MakeAccumulator(suite.store, testNameOne)
+	suite.Require().NoError(err)
+	accObject, err := accumPackage.GetAccumulator(suite.store, testNameOne)
+	suite.Require().NoError(err)
+
+	// No stream configured.
+	_, err = accObject.AddFromStream(startTime)
+	suite.Require().ErrorIs(err, accumPackage.NoRewardStreamError{AccumName: testNameOne})
+
+	// Stream must end after it starts.
+	err = accObject.SetRewardStream(totalReward, startTime, startTime)
+	suite.Require().ErrorIs(err, accumPackage.InvalidRewardStreamError{TotalReward: totalReward, StartTime: startTime, EndTime: startTime})
+
+	err = accObject.SetRewardStream(totalReward, startTime, endTime)
+	suite.Require().NoError(err)
+
+	// Nothing is due before the stream starts.
+	rewards, err := accObject.AddFromStream(startTime.Add(-10 * time.Second))
+	suite.Require().NoError(err)
+	suite.Require().True(rewards.IsZero())
+
+	// Rewards are due but there are no shares, so the last accrued time does not move.
+	_, err = accObject.AddFromStream(startTime.Add(10 * time.Second))
+	suite.Require().ErrorIs(err, accumPackage.ZeroSharesError)
+	stream, err := accObject.GetRewardStream()
+	suite.Require().NoError(err)
+	suite.Require().Equal(startTime, stream.LastAccruedTime)
+
+	err = accObject.NewPosition(testAddressOne, sdk.NewDec(10), nil)
+	suite.Require().NoError(err)
+
+	// The first quarter of the stream, including the period without shares, is distributed.
+	rewards, err = accObject.AddFromStream(startTime.Add(25 * time.Second))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoin(denomOne, sdk.NewInt(250))), rewards)
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoin(denomOne, sdk.NewInt(25))), accObject.GetValue())
+
+	// Calling again at the same time distributes nothing.
+	rewards, err = accObject.AddFromStream(startTime.Add(25 * time.Second))
+	suite.Require().NoError(err)
+	suite.Require().True(rewards.IsZero())
+
+	rewards, err = accObject.AddFromStream(startTime.Add(50 * time.Second))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoin(denomOne, sdk.NewInt(250))), rewards)
+
+	// Times past the end are clamped, so exactly the remainder of the stream is distributed.
+	rewards, err = accObject.AddFromStream(endTime.Add(time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoin(denomOne, sdk.NewInt(500))), rewards)
+
+	positionRewards, err := accObject.GetPositionRewards(testAddressOne)
+	suite.Require().NoError(err)
+	suite.Require().Equal(totalReward, positionRewards)
+
+	stream, err = accObject.GetRewardStream()
+	suite.Require().NoError(err)
+	suite.Require().Equal(endTime, stream.LastAccruedTime)
+
+	rewards, err = accObject.AddFromStream(endTime.Add(2 * time.Hour))
+	suite.Require().NoError(err)
+	suite.Require().True(rewards.IsZero())
+}
@@ -207,6 +207,13 @@ func ParseFieldFromArg(fVal reflect.Value, fType reflect.StructField, arg string
 		}
 		fVal.SetFloat(f)
 		return nil
+	case reflect.Bool:
+		b, err := strconv.ParseBool(arg)
+		if err != nil {
+			return fmt.Errorf("could not parse %s as bool for field %s: %w", arg, fType.Name, err)
+		}
+		fVal.SetBool(b)
+		return nil
 	case reflect.String:
 		s, err := ParseDenom(arg, fType.Name)
 		if err != nil {
@@ -9,6 +9,7 @@ import (
 
 	types "github.com/cosmos/cosmos-sdk/types"
 	gomock "github.com/golang/mock/gomock"
+	osmomath "github.com/osmosis-labs/osmosis/osmomath"
 	types0 "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 )
 
@@ -50,18 +51,18 @@ func (mr *MockConcentratedPoolExtensionMockRecorder) ApplySwap(newLiquidity, new
 }
 
 // CalcActualAmounts mocks base method.
-func (m *MockConcentratedPoolExtension) CalcActualAmounts(ctx types.Context, lowerTick, upperTick int64, sqrtRatioLowerTick, sqrtRatioUpperTick, liquidityDelta types.Dec) (types.Dec, types.Dec) {
+func (m *MockConcentratedPoolExtension) CalcActualAmounts(ctx types.Context, lowerTick, upperTick int64, sqrtRatioLowerTick, sqrtRatioUpperTick, liquidityDelta types.Dec, roundingDir osmomath.RoundingDirection) (types.Dec, types.Dec) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CalcActualAmounts", ctx, lowerTick, upperTick, sqrtRatioLowerTick, sqrtRatioUpperTick, liquidityDelta)
+	ret := m.ctrl.Call(m, "CalcActualAmounts", ctx, lowerTick, upperTick, sqrtRatioLowerTick, sqrtRatioUpperTick, liquidityDelta, roundingDir)
 	ret0, _ := ret[0].(types.Dec)
 	ret1, _ := ret[1].(types.Dec)
 	return ret0, ret1
 }
 
 // CalcActualAmounts indicates an expected call of CalcActualAmounts.
-func (mr *MockConcentratedPoolExtensionMockRecorder) CalcActualAmounts(ctx, lowerTick, upperTick, sqrtRatioLowerTick, sqrtRatioUpperTick, liquidityDelta interface{}) *gomock.Call {
+func (mr *MockConcentratedPoolExtensionMockRecorder) CalcActualAmounts(ctx, lowerTick, upperTick, sqrtRatioLowerTick, sqrtRatioUpperTick, liquidityDelta, roundingDir interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalcActualAmounts", reflect.TypeOf((*MockConcentratedPoolExtension)(nil).CalcActualAmounts), ctx, lowerTick, upperTick, sqrtRatioLowerTick, sqrtRatioUpperTick, liquidityDelta)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CalcActualAmounts", reflect.TypeOf((*MockConcentratedPoolExtension)(nil).CalcActualAmounts), ctx, lowerTick, upperTick, sqrtRatioLowerTick, sqrtRatioUpperTick, liquidityDelta, roundingDir)
 }
 
 // GetAddress mocks base method.
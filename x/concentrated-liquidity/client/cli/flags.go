@@ -6,6 +6,16 @@ import (
 
 const (
 	FlagPoolId = "pool-id"
+	FlagOwner  = "owner"
+
+	FlagStrictSlippage = "strict-slippage"
+
+	FlagEarlyExitFee    = "early-exit-fee"
+	FlagMinHoldDuration = "min-hold-duration"
+
+	FlagProtocolFeeFraction = "protocol-fee-fraction"
+
+	FlagInitialPrice = "initial-price"
 )
 
 func FlagSetJustPoolId() *flag.FlagSet {
@@ -13,3 +23,34 @@ func FlagSetJustPoolId() *flag.FlagSet {
 	fs.Uint64(FlagPoolId, 0, "The id of pool")
 	return fs
 }
+
+func FlagSetOwner() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagOwner, "", "The owner of the position, if different from the sender. The owner must have granted the sender an authz authorization for MsgCreatePosition")
+	return fs
+}
+
+func FlagSetEarlyExitFee() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagEarlyExitFee, "0", "The fraction of withdrawn amounts charged when withdrawing before the minimum hold duration")
+	fs.String(FlagMinHoldDuration, "0s", "The duration since a position was joined before which withdrawals are charged the early exit fee")
+	return fs
+}
+
+func FlagSetProtocolFeeFraction() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagProtocolFeeFraction, "0", "The fraction of the swap fee routed to the protocol rather than to liquidity providers")
+	return fs
+}
+
+func FlagSetInitialPrice() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagInitialPrice, "0", "The spot price the pool starts at once its first position is created. If zero, it is derived from the token ratio of the first position")
+	return fs
+}
+
+func FlagSetStrictSlippage() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagStrictSlippage, "false", "Reject the position if both token minimum amounts are zero, as that disables slippage protection")
+	return fs
+}
@@ -13,8 +13,34 @@ import (
 func GetQueryCmd() *cobra.Command {
 	cmd := osmocli.QueryIndexCmd(types.ModuleName)
 	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetCmdPools)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetCmdPoolsByLiquidity)
 	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetUserPositions)
 	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetClaimableFees)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetClaimableIncentives)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetPositionIdsForRange)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetPriceAtTick)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetSqrtPriceForTicks)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetRequiredAmountForDeposit)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetNextInitializedTick)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetFeeRevenue)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetPoolFeeAccumulator)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetPoolParams)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetPositionSummary)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetPositionApr)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetPoolIncentiveRecords)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetPoolsForDenomPair)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetPoolsForPairWithFees)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetLiquidityWeightedTick)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetSimulateSwapExactAmountIn)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetProtocolFees)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetPoolStats)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetIsPositionInRange)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetPositionConversionBounds)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetPositionsByJoinTimeRange)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetPositionAccruedExceeds)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetAllTicks)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetPositionImpermanentLoss)
+	osmocli.AddQueryCmd(cmd, query.NewQueryClient, GetLiquidityToReachPrice)
 	cmd.AddCommand(
 		osmocli.GetParams[*query.QueryParamsRequest](
 			types.ModuleName, query.NewQueryClient),
@@ -41,6 +67,14 @@ func GetCmdPools() (*osmocli.QueryDescriptor, *query.QueryPoolsRequest) {
 {{.CommandPrefix}} pools`}, &query.QueryPoolsRequest{}
 }
 
+func GetCmdPoolsByLiquidity() (*osmocli.QueryDescriptor, *query.QueryPoolsByLiquidityRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pools-by-liquidity",
+		Short: "Query pools sorted by current active liquidity in descending order",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pools-by-liquidity --offset 10 --limit 10`}, &query.QueryPoolsByLiquidityRequest{}
+}
+
 func GetClaimableFees() (*osmocli.QueryDescriptor, *query.QueryClaimableFeesRequest) {
 	return &osmocli.QueryDescriptor{
 		Use:   "claimable-fees [poolID] [address] [lowerTick] [upperTick]",
@@ -48,3 +82,203 @@ func GetClaimableFees() (*osmocli.QueryDescriptor, *query.QueryClaimableFeesRequ
 		Long: `{{.Short}}{{.ExampleHeader}}
 {{.CommandPrefix}} claimable-fees 1 osmo12smx2wdlyttvyzvzg54y2vnqwq2qjateuf7thj [-100] 100`}, &query.QueryClaimableFeesRequest{}
 }
+
+func GetClaimableIncentives() (*osmocli.QueryDescriptor, *query.QueryClaimableIncentivesRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "claimable-incentives [positionID]",
+		Short: "Query claimable and forfeitable incentives of a position",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} claimable-incentives 1`}, &query.QueryClaimableIncentivesRequest{}
+}
+
+func GetPositionIdsForRange() (*osmocli.QueryDescriptor, *query.QueryPositionIdsForRangeRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "position-ids-for-range [address] [poolID] [lowerTick] [upperTick]",
+		Short: "Query the ids of an address's positions in a pool with the given tick range",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} position-ids-for-range osmo12smx2wdlyttvyzvzg54y2vnqwq2qjateuf7thj 1 [-100] 100`}, &query.QueryPositionIdsForRangeRequest{}
+}
+
+func GetPriceAtTick() (*osmocli.QueryDescriptor, *query.QueryPriceAtTickRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "price-at-tick [poolID] [tick]",
+		Short: "Query the sqrt price and spot price of a pool at the given tick",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} price-at-tick 1 [-100]`}, &query.QueryPriceAtTickRequest{}
+}
+
+func GetSqrtPriceForTicks() (*osmocli.QueryDescriptor, *query.QuerySqrtPriceForTicksRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "sqrt-price-for-ticks [poolID] [lowerTick] [upperTick]",
+		Short: "Query the sqrt prices at the lower and upper ticks of a range of a pool",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} sqrt-price-for-ticks 1 [-100] 100`}, &query.QuerySqrtPriceForTicksRequest{}
+}
+
+func GetRequiredAmountForDeposit() (*osmocli.QueryDescriptor, *query.QueryRequiredAmountForDepositRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "required-amount-for-deposit [poolID] [lowerTick] [upperTick] [knownDenom] [knownAmount]",
+		Short: "Query the amount of a pool's other denom needed to deposit the known amount in a range, and the resulting liquidity",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} required-amount-for-deposit 1 [-100] 100 uosmo 1000000`}, &query.QueryRequiredAmountForDepositRequest{}
+}
+
+func GetNextInitializedTick() (*osmocli.QueryDescriptor, *query.QueryNextInitializedTickRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "next-initialized-tick [poolID] [startTick] [zeroForOne]",
+		Short: "Query the next initialized tick of a pool below (zeroForOne true) or above (zeroForOne false) the start tick",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} next-initialized-tick 1 [-100] true`}, &query.QueryNextInitializedTickRequest{}
+}
+
+func GetFeeRevenue() (*osmocli.QueryDescriptor, *query.QueryFeeRevenueRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "fee-revenue [poolID] [startTime]",
+		Short: "Query the swap fees collected by a pool since the given unix start time",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} fee-revenue 1 1681000000`}, &query.QueryFeeRevenueRequest{}
+}
+
+func GetPoolFeeAccumulator() (*osmocli.QueryDescriptor, *query.QueryPoolFeeAccumulatorRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pool-fee-accumulator [poolID]",
+		Short: "Query the global fee growth per unit of liquidity of a pool",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pool-fee-accumulator 1`}, &query.QueryPoolFeeAccumulatorRequest{}
+}
+
+func GetPoolParams() (*osmocli.QueryDescriptor, *query.QueryPoolParamsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pool-params [poolID]",
+		Short: "Query a pool's tick spacing, exponent at price one, swap fee, current tick and current sqrt price",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pool-params 1`}, &query.QueryPoolParamsRequest{}
+}
+
+func GetPositionSummary() (*osmocli.QueryDescriptor, *query.QueryPositionSummaryRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "position-summary [positionID]",
+		Short: "Query a position alongside its underlying assets, claimable fees and claimable incentives",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} position-summary 1`}, &query.QueryPositionSummaryRequest{}
+}
+
+func GetPositionApr() (*osmocli.QueryDescriptor, *query.QueryPositionAprRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "position-apr [positionID]",
+		Short: "Query an estimate of a position's annualized return from fees and incentives at current rates",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} position-apr 1`}, &query.QueryPositionAprRequest{}
+}
+
+func GetPoolIncentiveRecords() (*osmocli.QueryDescriptor, *query.QueryPoolIncentiveRecordsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pool-incentive-records [poolID]",
+		Short: "Query a pool's incentive records with incentives remaining and the time each one will be exhausted",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pool-incentive-records 1`}, &query.QueryPoolIncentiveRecordsRequest{}
+}
+
+func GetPoolsForDenomPair() (*osmocli.QueryDescriptor, *query.QueryPoolsForDenomPairRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pools-for-denom-pair [denomA] [denomB]",
+		Short: "Query the ids of the concentrated liquidity pools trading the given denoms, in either order",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pools-for-denom-pair uosmo uion`}, &query.QueryPoolsForDenomPairRequest{}
+}
+
+func GetPoolsForPairWithFees() (*osmocli.QueryDescriptor, *query.QueryPoolsForPairWithFeesRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pools-for-pair-with-fees [denomA] [denomB]",
+		Short: "Query the concentrated liquidity pools trading the given denoms, in either order, with their swap fees and current liquidity",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pools-for-pair-with-fees uosmo uion`}, &query.QueryPoolsForPairWithFeesRequest{}
+}
+
+func GetLiquidityWeightedTick() (*osmocli.QueryDescriptor, *query.QueryLiquidityWeightedTickRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "liquidity-weighted-tick [poolID]",
+		Short: "Query the average of a pool's initialized ticks weighted by gross liquidity, and the spot price at that tick",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} liquidity-weighted-tick 1`}, &query.QueryLiquidityWeightedTickRequest{}
+}
+
+func GetSimulateSwapExactAmountIn() (*osmocli.QueryDescriptor, *query.QuerySimulateSwapExactAmountInRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "simulate-swap-exact-amount-in [poolID] [tokenIn] [tokenOutDenom]",
+		Short: "Simulate swapping an exact amount in, returning the amount out, the swap fee charged and the amount in after fee",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} simulate-swap-exact-amount-in 1 1000000uosmo uion`}, &query.QuerySimulateSwapExactAmountInRequest{}
+}
+
+func GetProtocolFees() (*osmocli.QueryDescriptor, *query.QueryProtocolFeesRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "protocol-fees [poolID]",
+		Short: "Query the protocol fees accrued by a pool that can be withdrawn by the protocol fee recipient",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} protocol-fees 1`}, &query.QueryProtocolFeesRequest{}
+}
+
+func GetPoolStats() (*osmocli.QueryDescriptor, *query.QueryPoolStatsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pool-stats [poolID]",
+		Short: "Query the number of open positions, distinct liquidity providers and active liquidity of a pool",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pool-stats 1`}, &query.QueryPoolStatsRequest{}
+}
+
+func GetIsPositionInRange() (*osmocli.QueryDescriptor, *query.QueryIsPositionInRangeRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "is-position-in-range [positionID]",
+		Short: "Query whether the current tick of a position's pool is within the position's range",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} is-position-in-range 1`}, &query.QueryIsPositionInRangeRequest{}
+}
+
+func GetPositionConversionBounds() (*osmocli.QueryDescriptor, *query.QueryPositionConversionBoundsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "position-conversion-bounds [positionID]",
+		Short: "Query the ticks and prices at which a position is entirely converted to token0 or token1",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} position-conversion-bounds 1`}, &query.QueryPositionConversionBoundsRequest{}
+}
+
+func GetPositionsByJoinTimeRange() (*osmocli.QueryDescriptor, *query.QueryPositionsByJoinTimeRangeRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "positions-by-join-time-range [startTime] [endTime]",
+		Short: "Query all positions with a join time within the given inclusive range, ordered by join time",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} positions-by-join-time-range 1681000000 1682000000`}, &query.QueryPositionsByJoinTimeRangeRequest{}
+}
+
+func GetPositionAccruedExceeds() (*osmocli.QueryDescriptor, *query.QueryPositionAccruedExceedsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "position-accrued-exceeds [positionID] [threshold]",
+		Short: "Query whether the fees accrued by a position exceed a threshold in any of its denoms",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} position-accrued-exceeds 53 1000uosmo,1000uion`}, &query.QueryPositionAccruedExceedsRequest{}
+}
+
+func GetAllTicks() (*osmocli.QueryDescriptor, *query.QueryAllTicksRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "all-ticks [poolID]",
+		Short: "Query all initialized ticks of a pool with their full tick info",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} all-ticks 1 --limit 100`}, &query.QueryAllTicksRequest{}
+}
+
+func GetPositionImpermanentLoss() (*osmocli.QueryDescriptor, *query.QueryPositionImpermanentLossRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "position-impermanent-loss [positionID]",
+		Short: "Query an estimate of a position's impermanent loss relative to holding its original deposit",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} position-impermanent-loss 1`}, &query.QueryPositionImpermanentLossRequest{}
+}
+
+func GetLiquidityToReachPrice() (*osmocli.QueryDescriptor, *query.QueryLiquidityToReachPriceRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "liquidity-to-reach-price [poolID] [targetPrice]",
+		Short: "Query the amount that would have to be swapped into a pool to move its price to the target price, and the ticks crossed",
+		Long: `{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} liquidity-to-reach-price 1 4500`}, &query.QueryLiquidityToReachPriceRequest{}
+}
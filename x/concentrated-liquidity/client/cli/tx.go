@@ -13,11 +13,19 @@ import (
 func NewTxCmd() *cobra.Command {
 	txCmd := osmocli.TxIndexCmd(types.ModuleName)
 	osmocli.AddTxCmd(txCmd, NewCreatePositionCmd)
+	osmocli.AddTxCmd(txCmd, NewCreatePositionByPriceCmd)
+	osmocli.AddTxCmd(txCmd, NewCreatePositionRelativeCmd)
 	osmocli.AddTxCmd(txCmd, NewWithdrawPositionCmd)
+	osmocli.AddTxCmd(txCmd, NewEmergencyWithdrawCmd)
 	osmocli.AddTxCmd(txCmd, NewCreateConcentratedPoolCmd)
 	osmocli.AddTxCmd(txCmd, NewCollectFeesCmd)
 	osmocli.AddTxCmd(txCmd, NewCollectIncentivesCmd)
 	osmocli.AddTxCmd(txCmd, NewCreateIncentiveCmd)
+	osmocli.AddTxCmd(txCmd, NewWithdrawProtocolFeesCmd)
+	osmocli.AddTxCmd(txCmd, NewUpdateTickSpacingCmd)
+	osmocli.AddTxCmd(txCmd, NewPruneEmptyTicksCmd)
+	osmocli.AddTxCmd(txCmd, NewSwapExactAmountInWithPriceLimitCmd)
+	osmocli.AddTxCmd(txCmd, NewCreatePositionSingleAssetCmd)
 	return txCmd
 }
 
@@ -29,7 +37,14 @@ func NewCreateConcentratedPoolCmd() (*osmocli.TxCliDesc, *clmodel.MsgCreateConce
 	return &osmocli.TxCliDesc{
 		Use:     "create-concentrated-pool [denom-0] [denom-1] [tick-spacing] [exponent-at-price-one] [swap-fee]",
 		Short:   "create a concentrated liquidity pool with the given tick spacing",
-		Example: "create-concentrated-pool uion uosmo 1 \"[-1]\" 0.01 --from val --chain-id osmosis-1",
+		Example: "create-concentrated-pool uion uosmo 1 \"[-1]\" 0.01 --early-exit-fee 0.005 --min-hold-duration 24h --protocol-fee-fraction 0.1 --initial-price 1.5 --from val --chain-id osmosis-1",
+		CustomFlagOverrides: map[string]string{
+			"earlyexitfee":        FlagEarlyExitFee,
+			"minholdduration":     FlagMinHoldDuration,
+			"protocolfeefraction": FlagProtocolFeeFraction,
+			"initialprice":        FlagInitialPrice,
+		},
+		Flags: osmocli.FlagDesc{OptionalFlags: []*flag.FlagSet{FlagSetEarlyExitFee(), FlagSetProtocolFeeFraction(), FlagSetInitialPrice()}},
 	}, &clmodel.MsgCreateConcentratedPool{}
 }
 
@@ -38,9 +53,29 @@ func NewCreatePositionCmd() (*osmocli.TxCliDesc, *types.MsgCreatePosition) {
 		Use:                 "create-position [lower-tick] [upper-tick] [token-0] [token-1] [token-0-min-amount] [token-1-min-amount]",
 		Short:               "create or add to existing concentrated liquidity position",
 		Example:             "create-position [-69082] 69082 1000000000uosmo 10000000uion 0 0 --pool-id 1 --from val --chain-id osmosis-1",
+		CustomFlagOverrides: map[string]string{"poolid": FlagPoolId, "owner": FlagOwner, "strictslippage": FlagStrictSlippage},
+		Flags:               osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetJustPoolId()}, OptionalFlags: []*flag.FlagSet{FlagSetOwner(), FlagSetStrictSlippage()}},
+	}, &types.MsgCreatePosition{}
+}
+
+func NewCreatePositionByPriceCmd() (*osmocli.TxCliDesc, *types.MsgCreatePositionByPrice) {
+	return &osmocli.TxCliDesc{
+		Use:                 "create-position-by-price [lower-price] [upper-price] [token-0] [token-1] [token-0-min-amount] [token-1-min-amount]",
+		Short:               "create a concentrated liquidity position with the range given by prices, converted to the nearest ticks aligned with the pool's tick spacing",
+		Example:             "create-position-by-price 0.5 2 1000000000uosmo 10000000uion 0 0 --pool-id 1 --from val --chain-id osmosis-1",
 		CustomFlagOverrides: poolIdFlagOverride,
 		Flags:               osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetJustPoolId()}},
-	}, &types.MsgCreatePosition{}
+	}, &types.MsgCreatePositionByPrice{}
+}
+
+func NewCreatePositionRelativeCmd() (*osmocli.TxCliDesc, *types.MsgCreatePositionRelative) {
+	return &osmocli.TxCliDesc{
+		Use:                 "create-position-relative [lower-tick-offset] [upper-tick-offset] [token-0] [token-1] [token-0-min-amount] [token-1-min-amount]",
+		Short:               "create a concentrated liquidity position with the range given as offsets from the pool's current tick, rounded to the nearest ticks aligned with the pool's tick spacing",
+		Example:             "create-position-relative [-1000] 1000 1000000000uosmo 10000000uion 0 0 --pool-id 1 --from val --chain-id osmosis-1",
+		CustomFlagOverrides: poolIdFlagOverride,
+		Flags:               osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetJustPoolId()}},
+	}, &types.MsgCreatePositionRelative{}
 }
 
 func NewWithdrawPositionCmd() (*osmocli.TxCliDesc, *types.MsgWithdrawPosition) {
@@ -51,6 +86,14 @@ func NewWithdrawPositionCmd() (*osmocli.TxCliDesc, *types.MsgWithdrawPosition) {
 	}, &types.MsgWithdrawPosition{}
 }
 
+func NewEmergencyWithdrawCmd() (*osmocli.TxCliDesc, *types.MsgEmergencyWithdraw) {
+	return &osmocli.TxCliDesc{
+		Use:     "emergency-withdraw [position-id]",
+		Short:   "withdraw all liquidity from a position, forfeiting fees and incentives (only while enabled by governance)",
+		Example: "emergency-withdraw 1 --from val --chain-id osmosis-1",
+	}, &types.MsgEmergencyWithdraw{}
+}
+
 func NewCollectFeesCmd() (*osmocli.TxCliDesc, *types.MsgCollectFees) {
 	return &osmocli.TxCliDesc{
 		Use:     "collect-fees [position-ids]",
@@ -76,3 +119,45 @@ func NewCreateIncentiveCmd() (*osmocli.TxCliDesc, *types.MsgCreateIncentive) {
 		Flags:               osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetJustPoolId()}},
 	}, &types.MsgCreateIncentive{}
 }
+
+func NewWithdrawProtocolFeesCmd() (*osmocli.TxCliDesc, *types.MsgWithdrawProtocolFees) {
+	return &osmocli.TxCliDesc{
+		Use:     "withdraw-protocol-fees [pool-id]",
+		Short:   "withdraw the protocol fees accrued by a pool (only by the protocol fee recipient set by governance)",
+		Example: "withdraw-protocol-fees 1 --from val --chain-id osmosis-1",
+	}, &types.MsgWithdrawProtocolFees{}
+}
+
+func NewUpdateTickSpacingCmd() (*osmocli.TxCliDesc, *types.MsgUpdateTickSpacing) {
+	return &osmocli.TxCliDesc{
+		Use:     "update-tick-spacing [pool-id] [new-tick-spacing]",
+		Short:   "change the tick spacing of a pool (only by the tick spacing authority set by governance)",
+		Example: "update-tick-spacing 1 10 --from val --chain-id osmosis-1",
+	}, &types.MsgUpdateTickSpacing{}
+}
+
+func NewPruneEmptyTicksCmd() (*osmocli.TxCliDesc, *types.MsgPruneEmptyTicks) {
+	return &osmocli.TxCliDesc{
+		Use:     "prune-empty-ticks [pool-id]",
+		Short:   "delete the ticks of a pool left with zero liquidity (only by the tick spacing authority set by governance)",
+		Example: "prune-empty-ticks 1 --from val --chain-id osmosis-1",
+	}, &types.MsgPruneEmptyTicks{}
+}
+
+func NewSwapExactAmountInWithPriceLimitCmd() (*osmocli.TxCliDesc, *types.MsgSwapExactAmountInWithPriceLimit) {
+	return &osmocli.TxCliDesc{
+		Use:     "swap-exact-amount-in-with-price-limit [pool-id] [token-in] [token-out-denom] [token-out-min-amount] [price-limit]",
+		Short:   "swap up to the given token in, stopping once the pool's spot price reaches the price limit",
+		Example: "swap-exact-amount-in-with-price-limit 1 1000000uosmo uion 1 0.95 --from val --chain-id osmosis-1",
+	}, &types.MsgSwapExactAmountInWithPriceLimit{}
+}
+
+func NewCreatePositionSingleAssetCmd() (*osmocli.TxCliDesc, *types.MsgCreatePositionSingleAsset) {
+	return &osmocli.TxCliDesc{
+		Use:                 "create-position-single-asset [lower-tick] [upper-tick] [token-in] [max-slippage]",
+		Short:               "create a concentrated liquidity position from a single asset, swapping the portion the range requires in the other asset through the same pool",
+		Example:             "create-position-single-asset [-69082] 69082 1000000000uosmo 0.01 --pool-id 1 --from val --chain-id osmosis-1",
+		CustomFlagOverrides: poolIdFlagOverride,
+		Flags:               osmocli.FlagDesc{RequiredFlags: []*flag.FlagSet{FlagSetJustPoolId()}},
+	}, &types.MsgCreatePositionSingleAsset{}
+}
@@ -98,7 +98,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	query.RegisterQueryServer(cfg.QueryServer(), clkeeper.NewQuerier(am.keeper))
 }
 
+// RegisterInvariants registers the concentrated liquidity module invariants.
 func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	clkeeper.RegisterInvariants(ir, am.keeper)
 }
 
 func (am AppModule) Route() sdk.Route {
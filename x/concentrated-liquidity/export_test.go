@@ -4,11 +4,13 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/osmoutils/accum"
 	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
 	cltypes "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types/genesis"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 )
 
@@ -33,6 +35,10 @@ func (k Keeper) SetPool(ctx sdk.Context, pool types.ConcentratedPoolExtension) e
 	return k.setPool(ctx, pool)
 }
 
+func LiquidityWithinDesiredAmounts(ctx sdk.Context, pool types.ConcentratedPoolExtension, lowerTick, upperTick int64, sqrtPriceLowerTick, sqrtPriceUpperTick, liquidityDelta sdk.Dec, amount0Desired, amount1Desired sdk.Int) (sdk.Dec, error) {
+	return liquidityWithinDesiredAmounts(ctx, pool, lowerTick, upperTick, sqrtPriceLowerTick, sqrtPriceUpperTick, liquidityDelta, amount0Desired, amount1Desired)
+}
+
 func (k Keeper) HasFullPosition(ctx sdk.Context, positionId uint64) bool {
 	return k.hasFullPosition(ctx, positionId)
 }
@@ -41,6 +47,10 @@ func (k Keeper) DeletePosition(ctx sdk.Context, positionId uint64, owner sdk.Acc
 	return k.deletePosition(ctx, positionId, owner, poolId)
 }
 
+func (k Keeper) GetPoolsSortedByLiquidity(ctx sdk.Context) ([]types.ConcentratedPoolExtension, error) {
+	return k.getPoolsSortedByLiquidity(ctx)
+}
+
 func (k Keeper) GetPoolById(ctx sdk.Context, poolId uint64) (types.ConcentratedPoolExtension, error) {
 	return k.getPoolById(ctx, poolId)
 }
@@ -62,7 +72,12 @@ func (k Keeper) CalcInAmtGivenOutInternal(ctx sdk.Context, desiredTokenOut sdk.C
 }
 
 func (k Keeper) CalcOutAmtGivenInInternal(ctx sdk.Context, tokenInMin sdk.Coin, tokenOutDenom string, swapFee sdk.Dec, priceLimit sdk.Dec, poolId uint64) (writeCtx func(), tokenIn, tokenOut sdk.Coin, updatedTick sdk.Int, updatedLiquidity, updatedSqrtPrice sdk.Dec, err error) {
-	return k.calcOutAmtGivenIn(ctx, tokenInMin, tokenOutDenom, swapFee, priceLimit, poolId)
+	writeCtx, tokenIn, tokenOut, updatedTick, updatedLiquidity, updatedSqrtPrice, _, err = k.calcOutAmtGivenIn(ctx, tokenInMin, tokenOutDenom, swapFee, priceLimit, poolId)
+	return writeCtx, tokenIn, tokenOut, updatedTick, updatedLiquidity, updatedSqrtPrice, err
+}
+
+func (k Keeper) SimulateSwapExactAmountIn(ctx sdk.Context, poolId uint64, tokenIn sdk.Coin, tokenOutDenom string) (sdk.Coin, sdk.Coin, sdk.Coin, error) {
+	return k.simulateSwapExactAmountIn(ctx, poolId, tokenIn, tokenOutDenom)
 }
 
 func (k Keeper) SwapOutAmtGivenIn(ctx sdk.Context, sender sdk.AccAddress, pool types.ConcentratedPoolExtension, tokenIn sdk.Coin, tokenOutDenom string, swapFee sdk.Dec, priceLimit sdk.Dec) (calcTokenIn, calcTokenOut sdk.Coin, currentTick sdk.Int, liquidity, sqrtPrice sdk.Dec, err error) {
@@ -93,18 +108,30 @@ func (k Keeper) IsInitialPositionForPool(initialSqrtPrice sdk.Dec, initialTick s
 	return k.isInitialPositionForPool(initialSqrtPrice, initialTick)
 }
 
-func (k Keeper) InitializeInitialPositionForPool(ctx sdk.Context, pool types.ConcentratedPoolExtension, amount0Desired, amount1Desired sdk.Int) error {
-	return k.initializeInitialPositionForPool(ctx, pool, amount0Desired, amount1Desired)
+func (k Keeper) InitializeInitialPositionForPool(ctx sdk.Context, pool types.ConcentratedPoolExtension, positionId uint64, amount0Desired, amount1Desired sdk.Int, sqrtPriceLowerTick, sqrtPriceUpperTick sdk.Dec) error {
+	return k.initializeInitialPositionForPool(ctx, pool, positionId, amount0Desired, amount1Desired, sqrtPriceLowerTick, sqrtPriceUpperTick)
 }
 
 func (k Keeper) CollectFees(ctx sdk.Context, owner sdk.AccAddress, positionId uint64) (sdk.Coins, error) {
 	return k.collectFees(ctx, owner, positionId)
 }
 
+func (k Keeper) PositionAccruedFeesExceed(ctx sdk.Context, positionId uint64, threshold sdk.Coins) (bool, sdk.Coins, error) {
+	return k.positionAccruedFeesExceed(ctx, positionId, threshold)
+}
+
 func (k Keeper) QueryClaimableFees(ctx sdk.Context, positionId uint64) (sdk.Coins, error) {
 	return k.queryClaimableFees(ctx, positionId)
 }
 
+func (k Keeper) QueryClaimableIncentives(ctx sdk.Context, positionId uint64) (sdk.Coins, sdk.Coins, error) {
+	return k.queryClaimableIncentives(ctx, positionId)
+}
+
+func (k Keeper) QueryIncentivesPreview(ctx sdk.Context, positionId uint64) (sdk.Coins, sdk.Coins, time.Time, error) {
+	return k.queryIncentivesPreview(ctx, positionId)
+}
+
 func ConvertConcentratedToPoolInterface(concentratedPool types.ConcentratedPoolExtension) (poolmanagertypes.PoolI, error) {
 	return convertConcentratedToPoolInterface(concentratedPool)
 }
@@ -138,6 +165,18 @@ func (k Keeper) UpdateFeeAccumulatorPosition(ctx sdk.Context, liquidityDelta sdk
 	return k.updateFeeAccumulatorPosition(ctx, liquidityDelta, positionId)
 }
 
+func (k Keeper) UpdateTickSpacing(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, newTickSpacing uint64) error {
+	return k.updateTickSpacing(ctx, sender, poolId, newTickSpacing)
+}
+
+func (k Keeper) PruneEmptyTicksAsAuthority(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) (uint64, error) {
+	return k.pruneEmptyTicksAsAuthority(ctx, sender, poolId)
+}
+
+func (k Keeper) WithdrawProtocolFees(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) (sdk.Coins, error) {
+	return k.withdrawProtocolFees(ctx, sender, poolId)
+}
+
 func (k Keeper) GetFeeGrowthOutside(ctx sdk.Context, poolId uint64, lowerTick, upperTick int64) (sdk.DecCoins, error) {
 	return k.getFeeGrowthOutside(ctx, poolId, lowerTick, upperTick)
 }
@@ -166,10 +205,22 @@ func (k Keeper) CreatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 	return k.createPosition(ctx, poolId, owner, amount0Desired, amount1Desired, amount0Min, amount1Min, lowerTick, upperTick)
 }
 
+func (k Keeper) CreatePositionForOwner(ctx sdk.Context, poolId uint64, sender, owner sdk.AccAddress, amount0Desired, amount1Desired, amount0Min, amount1Min sdk.Int, lowerTick, upperTick int64) (uint64, sdk.Int, sdk.Int, sdk.Dec, time.Time, error) {
+	return k.createPositionForOwner(ctx, poolId, sender, owner, amount0Desired, amount1Desired, amount0Min, amount1Min, lowerTick, upperTick)
+}
+
 func (k Keeper) WithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, requestedLiquidityAmountToWithdraw sdk.Dec) (amtDenom0, amtDenom1 sdk.Int, err error) {
 	return k.withdrawPosition(ctx, owner, positionId, requestedLiquidityAmountToWithdraw)
 }
 
+func (k Keeper) WithdrawPositions(ctx sdk.Context, owner sdk.AccAddress, withdrawals []types.PositionWithdrawal) (sdk.Coins, error) {
+	return k.withdrawPositions(ctx, owner, withdrawals)
+}
+
+func (k Keeper) EmergencyWithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64) (amtDenom0, amtDenom1 sdk.Int, err error) {
+	return k.emergencyWithdrawPosition(ctx, owner, positionId)
+}
+
 func (ss *SwapState) UpdateFeeGrowthGlobal(feeChargeTotal sdk.Dec) {
 	ss.updateFeeGrowthGlobal(feeChargeTotal)
 }
@@ -187,6 +238,14 @@ func (ss *SwapState) GetFeeGrowthGlobal() sdk.Dec {
 	return ss.feeGrowthGlobal
 }
 
+func (ss *SwapState) SetFeesCollected(feesCollected sdk.Dec) {
+	ss.feesCollected = feesCollected
+}
+
+func (ss *SwapState) GetFeesCollected() sdk.Dec {
+	return ss.feesCollected
+}
+
 // incentive methods
 func (k Keeper) CreateUptimeAccumulators(ctx sdk.Context, poolId uint64) error {
 	return k.createUptimeAccumulators(ctx, poolId)
@@ -224,7 +283,7 @@ func (k Keeper) InitOrUpdatePositionUptime(ctx sdk.Context, poolId uint64, posit
 	return k.initOrUpdatePositionUptime(ctx, poolId, position, owner, lowerTick, upperTick, liquidityDelta, joinTime, positionId)
 }
 
-func (k Keeper) CollectIncentives(ctx sdk.Context, owner sdk.AccAddress, positionId uint64) (sdk.Coins, error) {
+func (k Keeper) CollectIncentives(ctx sdk.Context, owner sdk.AccAddress, positionId uint64) (sdk.Coins, sdk.Coins, error) {
 	return k.collectIncentives(ctx, owner, positionId)
 }
 
@@ -255,3 +314,71 @@ func (k Keeper) GetAllPositions(ctx sdk.Context) ([]model.Position, error) {
 func (k Keeper) UpdatePoolForSwap(ctx sdk.Context, pool types.ConcentratedPoolExtension, sender sdk.AccAddress, tokenIn sdk.Coin, tokenOut sdk.Coin, newCurrentTick sdk.Int, newLiquidity sdk.Dec, newSqrtPrice sdk.Dec) error {
 	return k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOut, newCurrentTick, newLiquidity, newSqrtPrice)
 }
+
+func (k Keeper) RequiredAmountForDeposit(ctx sdk.Context, poolId uint64, lowerTick, upperTick int64, knownDenom string, knownAmount sdk.Int) (sdk.Int, sdk.Dec, error) {
+	return k.requiredAmountForDeposit(ctx, poolId, lowerTick, upperTick, knownDenom, knownAmount)
+}
+
+func (k Keeper) CreatePositionSingleAsset(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64, tokenIn sdk.Coin, maxSlippage sdk.Dec) (uint64, sdk.Int, sdk.Int, sdk.Dec, time.Time, sdk.Coin, sdk.Coin, sdk.Coins, error) {
+	return k.createPositionSingleAsset(ctx, poolId, owner, lowerTick, upperTick, tokenIn, maxSlippage)
+}
+
+func (k Keeper) LiquidityToReachPrice(ctx sdk.Context, poolId uint64, targetPrice sdk.Dec) (sdk.Coin, []int64, error) {
+	return k.liquidityToReachPrice(ctx, poolId, targetPrice)
+}
+
+func (k Keeper) SqrtPriceForTicks(ctx sdk.Context, poolId uint64, lowerTick, upperTick int64) (sdk.Dec, sdk.Dec, error) {
+	return k.sqrtPriceForTicks(ctx, poolId, lowerTick, upperTick)
+}
+
+func (k Keeper) PriceAtTick(ctx sdk.Context, poolId uint64, tickIndex int64) (sdk.Dec, sdk.Dec, error) {
+	return k.priceAtTick(ctx, poolId, tickIndex)
+}
+
+func (k Keeper) GetPositionsByJoinTimeRange(ctx sdk.Context, startTime, endTime time.Time, pageReq *query.PageRequest) ([]model.Position, *query.PageResponse, error) {
+	return k.getPositionsByJoinTimeRange(ctx, startTime, endTime, pageReq)
+}
+
+func (k Keeper) PoolStats(ctx sdk.Context, poolId uint64) (uint64, uint64, sdk.Dec, error) {
+	return k.poolStats(ctx, poolId)
+}
+
+func (k Keeper) IsPositionInRange(ctx sdk.Context, positionId uint64) (bool, int64, int64, int64, error) {
+	return k.isPositionInRange(ctx, positionId)
+}
+
+func (k Keeper) PositionConversionBounds(ctx sdk.Context, positionId uint64) (sdk.Dec, sdk.Dec, bool, bool, error) {
+	return k.positionConversionBounds(ctx, positionId)
+}
+
+func (k Keeper) LiquidityWeightedTick(ctx sdk.Context, poolId uint64) (int64, sdk.Dec, error) {
+	return k.liquidityWeightedTick(ctx, poolId)
+}
+
+func PriceToAlignedTick(price sdk.Dec, tickSpacing uint64, exponentAtPriceOne sdk.Int) (int64, error) {
+	return priceToAlignedTick(price, tickSpacing, exponentAtPriceOne)
+}
+
+func (k Keeper) TicksFromPrices(ctx sdk.Context, poolId uint64, lowerPrice, upperPrice sdk.Dec) (int64, int64, error) {
+	return k.ticksFromPrices(ctx, poolId, lowerPrice, upperPrice)
+}
+
+func AlignTickToSpacing(tick int64, tickSpacing uint64) int64 {
+	return alignTickToSpacing(tick, tickSpacing)
+}
+
+func (k Keeper) TicksFromCurrentTickOffsets(ctx sdk.Context, poolId uint64, lowerTickOffset, upperTickOffset int64) (int64, int64, error) {
+	return k.ticksFromCurrentTickOffsets(ctx, poolId, lowerTickOffset, upperTickOffset)
+}
+
+func (k Keeper) GetPositionEntrySqrtPrice(ctx sdk.Context, positionId uint64) (sdk.Dec, error) {
+	return k.getPositionEntrySqrtPrice(ctx, positionId)
+}
+
+func (k Keeper) PositionImpermanentLoss(ctx sdk.Context, positionId uint64) (sdk.Dec, sdk.Dec, sdk.DecCoins, sdk.DecCoins, error) {
+	return k.positionImpermanentLoss(ctx, positionId)
+}
+
+func (k Keeper) GetAllTicksPaginated(ctx sdk.Context, poolId uint64, pageReq *query.PageRequest) ([]genesis.FullTick, *query.PageResponse, error) {
+	return k.getAllTicksPaginated(ctx, poolId, pageReq)
+}
@@ -3,10 +3,15 @@ package concentrated_liquidity
 import (
 	"fmt"
 	"strconv"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/accum"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
 )
 
@@ -24,13 +29,14 @@ func (k Keeper) createFeeAccumulator(ctx sdk.Context, poolId uint64) error {
 
 // getFeeAccumulator gets the fee accumulator object using the given poolOd
 // returns error if accumulator for the given poolId does not exist.
+// The accumulator emits its claim events through the context's event manager.
 func (k Keeper) getFeeAccumulator(ctx sdk.Context, poolId uint64) (accum.AccumulatorObject, error) {
 	acc, err := accum.GetAccumulator(ctx.KVStore(k.storeKey), types.KeyFeePoolAccumulator(poolId))
 	if err != nil {
 		return accum.AccumulatorObject{}, err
 	}
 
-	return acc, nil
+	return acc.WithEventEmitter(ctx.EventManager().EmitEvent), nil
 }
 
 // chargeFee charges the given fee on the pool with the given id by updating
@@ -47,6 +53,112 @@ func (k Keeper) chargeFee(ctx sdk.Context, poolId uint64, feeUpdate sdk.DecCoin)
 	return nil
 }
 
+// recordFeeRevenue adds the given fees collected by a swap to the pool's cumulative
+// fee revenue and stores the result as a snapshot keyed by the current block time.
+// Snapshots older than types.FeeRevenueRetentionPeriod are pruned, except for the latest
+// one preceding the retention window, which is kept as the baseline for queries starting
+// at the edge of the window. No-op if the fees are zero.
+func (k Keeper) recordFeeRevenue(ctx sdk.Context, poolId uint64, fees sdk.DecCoin) error {
+	if fees.IsZero() {
+		return nil
+	}
+
+	blockTime := ctx.BlockTime()
+	snapshot, err := k.getFeeRevenueSnapshotAtOrBefore(ctx, poolId, blockTime)
+	if err != nil {
+		return err
+	}
+	snapshot.CumulativeFees = snapshot.CumulativeFees.Add(fees)
+
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyFeeRevenueSnapshot(poolId, blockTime), &snapshot)
+
+	k.pruneFeeRevenueSnapshots(ctx, poolId, blockTime.Add(-types.FeeRevenueRetentionPeriod))
+	return nil
+}
+
+// pruneFeeRevenueSnapshots deletes all fee revenue snapshots of the given pool strictly
+// before the cutoff time, except for the latest one among them.
+func (k Keeper) pruneFeeRevenueSnapshots(ctx sdk.Context, poolId uint64, cutoff time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.Iterator(types.KeyPoolFeeRevenueSnapshots(poolId), types.KeyFeeRevenueSnapshot(poolId, cutoff))
+	keys := [][]byte{}
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	if len(keys) <= 1 {
+		return
+	}
+	for _, key := range keys[:len(keys)-1] {
+		store.Delete(key)
+	}
+}
+
+// getFeeRevenueSnapshotAtOrBefore returns the latest fee revenue snapshot of the given pool
+// recorded at or before the given time. Returns an empty snapshot if there is none.
+// Returns error if fails to unmarshal the snapshot.
+func (k Keeper) getFeeRevenueSnapshotAtOrBefore(ctx sdk.Context, poolId uint64, t time.Time) (model.FeeRevenueSnapshot, error) {
+	store := ctx.KVStore(k.storeKey)
+	iter := store.ReverseIterator(types.KeyPoolFeeRevenueSnapshots(poolId), sdk.InclusiveEndBytes(types.KeyFeeRevenueSnapshot(poolId, t)))
+	defer iter.Close()
+
+	snapshot := model.FeeRevenueSnapshot{}
+	if !iter.Valid() {
+		return snapshot, nil
+	}
+	if err := proto.Unmarshal(iter.Value(), &snapshot); err != nil {
+		return model.FeeRevenueSnapshot{}, err
+	}
+	return snapshot, nil
+}
+
+// FeeRevenue returns the swap fees collected by the pool with the given id between the
+// given start time and the current block time.
+// Returns error if:
+// - the pool does not exist
+// - the start time is older than types.FeeRevenueRetentionPeriod before the current block time
+// - fails to read a fee revenue snapshot
+func (k Keeper) FeeRevenue(ctx sdk.Context, poolId uint64, startTime time.Time) (sdk.DecCoins, error) {
+	if _, err := k.getPoolById(ctx, poolId); err != nil {
+		return nil, err
+	}
+
+	blockTime := ctx.BlockTime()
+	earliestTime := blockTime.Add(-types.FeeRevenueRetentionPeriod)
+	if startTime.Before(earliestTime) {
+		return nil, types.FeeRevenueStartTimeTooOldError{StartTime: startTime, EarliestTime: earliestTime}
+	}
+
+	current, err := k.getFeeRevenueSnapshotAtOrBefore(ctx, poolId, blockTime)
+	if err != nil {
+		return nil, err
+	}
+	start, err := k.getFeeRevenueSnapshotAtOrBefore(ctx, poolId, startTime)
+	if err != nil {
+		return nil, err
+	}
+
+	return current.CumulativeFees.Sub(start.CumulativeFees), nil
+}
+
+// PoolFeeAccumulator returns the current value of the given pool's global fee accumulator, i.e. the total
+// fee growth per unit of liquidity since the pool was created. Fees owed to every position are derived from it.
+// Returns error if the pool does not exist or if fails to get the fee accumulator.
+func (k Keeper) PoolFeeAccumulator(ctx sdk.Context, poolId uint64) (sdk.DecCoins, error) {
+	if _, err := k.getPoolById(ctx, poolId); err != nil {
+		return nil, err
+	}
+
+	feeAccumulator, err := k.getFeeAccumulator(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	return feeAccumulator.GetValue(), nil
+}
+
 // initializeFeeAccumulatorPosition initializes the fee accumulator for a given position in a pool
 // by creating a new accumulator for the position with zero liquidity and an accumulator value
 // equal to the difference between the current fee accumulator value and the fee growth outside of the tick range.
@@ -301,13 +413,144 @@ func (k Keeper) queryClaimableFees(ctx sdk.Context, positionId uint64) (sdk.Coin
 		return nil, err
 	}
 
-	// Claim the position's fees.
-	feesClaimed, err := feeAccumulator.ClaimRewards(positionKey)
+	// Compute the position's fees without claiming them.
+	feesOwed, err := feeAccumulator.GetPositionRewards(positionKey)
 	if err != nil {
 		return nil, err
 	}
 
-	return feesClaimed, nil
+	// Include the remainder carried from the last claim, which the next claim pays out alongside the fees owed.
+	carriedFees, err := feeAccumulator.GetPositionCarriedRewards(positionKey)
+	if err != nil {
+		return nil, err
+	}
+	feesOwed = feesOwed.Add(carriedFees...)
+
+	// Return the integer coins rounded down, matching what a claim would pay out.
+	claimableFees, _ := feesOwed.TruncateDecimal()
+	return claimableFees, nil
+}
+
+// positionAccruedFeesExceed returns whether the claimable fees of the position with the given id exceed the threshold,
+// along with the claimable fees themselves. The threshold is exceeded if the accrued amount of any denom in the
+// threshold is strictly greater than the threshold amount for that denom. Like queryClaimableFees, state is not mutated.
+//
+// Returns error if:
+// - the position does not exist
+// - other internal database or math errors.
+func (k Keeper) positionAccruedFeesExceed(ctx sdk.Context, positionId uint64, threshold sdk.Coins) (bool, sdk.Coins, error) {
+	accrued, err := k.queryClaimableFees(ctx, positionId)
+	if err != nil {
+		return false, nil, err
+	}
+
+	for _, coin := range threshold {
+		if accrued.AmountOf(coin.Denom).GT(coin.Amount) {
+			return true, accrued, nil
+		}
+	}
+	return false, accrued, nil
+}
+
+// accrueProtocolFees adds the protocol's share of a swap's fees to the pending protocol fees of the pool
+// with the given id. They are routed to the pool's protocol fees address by routeProtocolFees once the swap
+// has settled. No-op if the fees are zero.
+func (k Keeper) accrueProtocolFees(ctx sdk.Context, poolId uint64, fees sdk.DecCoin) error {
+	if fees.IsZero() {
+		return nil
+	}
+
+	pendingFees, err := k.getPendingProtocolFees(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	k.setPendingProtocolFees(ctx, poolId, pendingFees.Add(fees))
+	return nil
+}
+
+// routeProtocolFees sends the whole units of the given pool's pending protocol fees from the pool to its
+// protocol fees address. The fractional remainder stays pending until it adds up to a whole unit, so that
+// no protocol fees are lost to rounding.
+func (k Keeper) routeProtocolFees(ctx sdk.Context, pool types.ConcentratedPoolExtension) error {
+	pendingFees, err := k.getPendingProtocolFees(ctx, pool.GetId())
+	if err != nil {
+		return err
+	}
+
+	routedFees, remainder := pendingFees.TruncateDecimal()
+	if routedFees.IsZero() {
+		return nil
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), pool.GetProtocolFeesAddress(), routedFees); err != nil {
+		return err
+	}
+
+	k.setPendingProtocolFees(ctx, pool.GetId(), remainder)
+	return nil
+}
+
+// getPendingProtocolFees returns the protocol fees of the given pool that are pending routing.
+// Returns error if fails to unmarshal them.
+func (k Keeper) getPendingProtocolFees(ctx sdk.Context, poolId uint64) (sdk.DecCoins, error) {
+	pendingFees := model.PendingProtocolFees{}
+	if _, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyPendingProtocolFees(poolId), &pendingFees); err != nil {
+		return nil, err
+	}
+	return pendingFees.Fees, nil
+}
+
+// setPendingProtocolFees stores the protocol fees of the given pool that are pending routing.
+func (k Keeper) setPendingProtocolFees(ctx sdk.Context, poolId uint64, fees sdk.DecCoins) {
+	osmoutils.MustSet(ctx.KVStore(k.storeKey), types.KeyPendingProtocolFees(poolId), &model.PendingProtocolFees{Fees: fees})
+}
+
+// GetProtocolFees returns the protocol fees routed from the pool with the given id that can be withdrawn.
+// Returns error if the pool does not exist.
+func (k Keeper) GetProtocolFees(ctx sdk.Context, poolId uint64) (sdk.Coins, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	return k.bankKeeper.GetAllBalances(ctx, pool.GetProtocolFeesAddress()), nil
+}
+
+// withdrawProtocolFees sends all of the protocol fees routed from the pool with the given id to the sender.
+// Returns the withdrawn fees. Returns error if:
+// - no protocol fee recipient has been set by governance
+// - the sender is not the protocol fee recipient
+// - the pool does not exist
+func (k Keeper) withdrawProtocolFees(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) (sdk.Coins, error) {
+	recipient := k.GetParams(ctx).ProtocolFeeRecipient
+	if recipient == "" {
+		return nil, types.ProtocolFeeWithdrawalDisabledError{}
+	}
+	if sender.String() != recipient {
+		return nil, types.NotProtocolFeeRecipientError{Sender: sender.String(), Recipient: recipient}
+	}
+
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+
+	protocolFees := k.bankKeeper.GetAllBalances(ctx, pool.GetProtocolFeesAddress())
+	if !protocolFees.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, pool.GetProtocolFeesAddress(), sender, protocolFees); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtWithdrawProtocolFees,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyTokensOut, protocolFees.String()),
+	))
+
+	return protocolFees, nil
 }
 
 // calculateFeeGrowth for the given targetTicks.
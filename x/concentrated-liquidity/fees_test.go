@@ -14,6 +14,7 @@ import (
 	clmodel "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
 	cltypes "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+	clquery "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types/query"
 )
 
 const (
@@ -1054,6 +1055,184 @@ func (s *KeeperTestSuite) TestFunctionalFees() {
 	s.CollectAndAssertFees(s.Ctx, clPool.GetId(), totalFeesExpected, positionIds, ticksActivatedAfterEachSwapTest, denomsExpected, positions)
 }
 
+func (s *KeeperTestSuite) TestFeeRevenue() {
+	s.Setup()
+	s.TestAccs = apptesting.CreateRandomAccounts(5)
+
+	clPool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, DefaultExponentAtPriceOne, sdk.MustNewDecFromStr("0.003"))
+	s.SetupFullRangePositionAcc(clPool.GetId(), s.TestAccs[0])
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	// swapAndAssertRevenue swaps once and checks that the revenue since the given start time
+	// grew by the fee charged on the swap.
+	swapAndAssertRevenue := func(startTime time.Time, revenueBefore sdk.DecCoins) sdk.DecCoins {
+		s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Minute))
+		_, totalFees := s.swapAndTrackXTimesInARow(clPool.GetId(), DefaultCoin1, ETH, cltypes.MaxSpotPrice, 1)
+
+		revenue, err := clKeeper.FeeRevenue(s.Ctx, clPool.GetId(), startTime)
+		s.Require().NoError(err)
+		// The fee charged by the swap steps may differ from the naive amount in * swap fee by rounding.
+		errTolerance := osmomath.ErrTolerance{AdditiveTolerance: sdk.OneDec()}
+		s.Require().Equal(0, errTolerance.Compare(totalFees.AmountOf(USDC), revenue.Sub(revenueBefore).AmountOf(USDC).TruncateInt()))
+		return revenue
+	}
+
+	// No swaps yet, so no revenue.
+	startTime := s.Ctx.BlockTime()
+	revenue, err := clKeeper.FeeRevenue(s.Ctx, clPool.GetId(), startTime)
+	s.Require().NoError(err)
+	s.Require().True(revenue.IsZero())
+
+	// Revenue accumulates across swaps in different blocks.
+	revenue = swapAndAssertRevenue(startTime, revenue)
+	revenue = swapAndAssertRevenue(startTime, revenue)
+	s.Require().False(revenue.IsZero())
+
+	// A window starting after the last swap has no revenue.
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Minute))
+	revenue, err = clKeeper.FeeRevenue(s.Ctx, clPool.GetId(), s.Ctx.BlockTime())
+	s.Require().NoError(err)
+	s.Require().True(revenue.IsZero())
+
+	// Move past the retention period; the window's baseline snapshot is retained after pruning.
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(types.FeeRevenueRetentionPeriod))
+	earliestTime := s.Ctx.BlockTime().Add(time.Minute - types.FeeRevenueRetentionPeriod)
+	swapAndAssertRevenue(earliestTime, sdk.DecCoins{})
+
+	// Start time older than the retention period.
+	tooOldTime := s.Ctx.BlockTime().Add(-types.FeeRevenueRetentionPeriod - time.Second)
+	_, err = clKeeper.FeeRevenue(s.Ctx, clPool.GetId(), tooOldTime)
+	s.Require().ErrorIs(err, types.FeeRevenueStartTimeTooOldError{StartTime: tooOldTime, EarliestTime: s.Ctx.BlockTime().Add(-types.FeeRevenueRetentionPeriod)})
+
+	// Non-existent pool.
+	_, err = clKeeper.FeeRevenue(s.Ctx, clPool.GetId()+1, s.Ctx.BlockTime())
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestPoolFeeAccumulator() {
+	s.Setup()
+	s.TestAccs = apptesting.CreateRandomAccounts(5)
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	clPool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, DefaultExponentAtPriceOne, sdk.MustNewDecFromStr("0.003"))
+	s.SetupFullRangePositionAcc(clPool.GetId(), s.TestAccs[0])
+
+	// No swaps yet, so no fee growth.
+	feeGrowthGlobal, err := clKeeper.PoolFeeAccumulator(s.Ctx, clPool.GetId())
+	s.Require().NoError(err)
+	s.Require().True(feeGrowthGlobal.IsZero())
+
+	// Swapping grows the global fee accumulator by the fee per unit of liquidity.
+	s.swapAndTrackXTimesInARow(clPool.GetId(), DefaultCoin1, ETH, cltypes.MaxSpotPrice, 1)
+	feeGrowthGlobal, err = clKeeper.PoolFeeAccumulator(s.Ctx, clPool.GetId())
+	s.Require().NoError(err)
+	s.Require().False(feeGrowthGlobal.AmountOf(USDC).IsZero())
+
+	// The value matches the one held by the underlying accumulator.
+	feeAccumulator, err := clKeeper.GetFeeAccumulator(s.Ctx, clPool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(feeAccumulator.GetValue(), feeGrowthGlobal)
+
+	// Non-existent pool.
+	_, err = clKeeper.PoolFeeAccumulator(s.Ctx, clPool.GetId()+1)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestCollectFees_CarriesTruncatedFees() {
+	s.Setup()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	clPool := s.PrepareConcentratedPool()
+	liquidity, positionId := s.SetupPosition(clPool.GetId(), s.TestAccs[0], DefaultCoin0, DefaultCoin1, DefaultMinTick, DefaultMaxTick, s.Ctx.BlockTime())
+
+	// Each charge grows the position's fees by just under 0.3 USDC, which truncates to zero on its own.
+	feeGrowthPerCharge := sdk.NewDecCoinFromDec(USDC, sdk.NewDecWithPrec(3, 1).QuoTruncate(liquidity))
+
+	totalCollected := sdk.NewCoins()
+	for i := 0; i < 10; i++ {
+		s.Require().NoError(clKeeper.ChargeFee(s.Ctx, clPool.GetId(), feeGrowthPerCharge))
+
+		// The claimable fees account for the remainder carried from the previous claims.
+		claimableFees, err := clKeeper.QueryClaimableFees(s.Ctx, positionId)
+		s.Require().NoError(err)
+
+		collected, err := clKeeper.CollectFees(s.Ctx, s.TestAccs[0], positionId)
+		s.Require().NoError(err)
+		s.Require().Equal(claimableFees.String(), collected.String())
+
+		totalCollected = totalCollected.Add(collected...)
+	}
+
+	// Just under 3 USDC accrued in total, of which the whole units are paid out across the claims.
+	s.Require().Equal(sdk.NewCoins(sdk.NewCoin(USDC, sdk.NewInt(2))).String(), totalCollected.String())
+}
+
+func (s *KeeperTestSuite) TestProtocolFees() {
+	s.Setup()
+	s.TestAccs = apptesting.CreateRandomAccounts(5)
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	// Route half of the swap fee to the protocol.
+	clPool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, DefaultExponentAtPriceOne, sdk.MustNewDecFromStr("0.003"))
+	concentratedPool, ok := clPool.(*clmodel.Pool)
+	s.Require().True(ok)
+	concentratedPool.ProtocolFeeFraction = sdk.MustNewDecFromStr("0.5")
+	s.Require().NoError(clKeeper.SetPool(s.Ctx, concentratedPool))
+	positionId := s.SetupFullRangePositionAcc(clPool.GetId(), s.TestAccs[0])
+
+	// No swaps yet, so no protocol fees.
+	protocolFees, err := clKeeper.GetProtocolFees(s.Ctx, clPool.GetId())
+	s.Require().NoError(err)
+	s.Require().True(protocolFees.IsZero())
+
+	_, totalFees := s.swapAndTrackXTimesInARow(clPool.GetId(), DefaultCoin1, ETH, cltypes.MaxSpotPrice, 1)
+
+	// The protocol and the LPs each receive half of the fees, up to rounding.
+	errTolerance := osmomath.ErrTolerance{AdditiveTolerance: sdk.NewDec(2)}
+	expectedShare := totalFees.AmountOf(USDC).QuoRaw(2)
+	protocolFees, err = clKeeper.GetProtocolFees(s.Ctx, clPool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(0, errTolerance.Compare(expectedShare, protocolFees.AmountOf(USDC)))
+
+	lpFees, err := clKeeper.CollectFees(s.Ctx, s.TestAccs[0], positionId)
+	s.Require().NoError(err)
+	s.Require().Equal(0, errTolerance.Compare(expectedShare, lpFees.AmountOf(USDC)))
+
+	// The querier returns the same protocol fees.
+	querier := cl.NewQuerier(*clKeeper)
+	resp, err := querier.ProtocolFees(sdk.WrapSDKContext(s.Ctx), &clquery.QueryProtocolFeesRequest{PoolId: clPool.GetId()})
+	s.Require().NoError(err)
+	s.Require().Equal(protocolFees, sdk.NewCoins(resp.ProtocolFees...))
+	_, err = querier.ProtocolFees(sdk.WrapSDKContext(s.Ctx), nil)
+	s.Require().Error(err)
+
+	// Withdrawal is disabled until governance sets a recipient.
+	recipient := s.TestAccs[1]
+	_, err = clKeeper.WithdrawProtocolFees(s.Ctx, recipient, clPool.GetId())
+	s.Require().ErrorIs(err, types.ProtocolFeeWithdrawalDisabledError{})
+
+	params := clKeeper.GetParams(s.Ctx)
+	params.ProtocolFeeRecipient = recipient.String()
+	clKeeper.SetParams(s.Ctx, params)
+
+	// Only the recipient may withdraw.
+	_, err = clKeeper.WithdrawProtocolFees(s.Ctx, s.TestAccs[2], clPool.GetId())
+	s.Require().ErrorIs(err, types.NotProtocolFeeRecipientError{Sender: s.TestAccs[2].String(), Recipient: recipient.String()})
+
+	// Non-existent pool.
+	_, err = clKeeper.WithdrawProtocolFees(s.Ctx, recipient, clPool.GetId()+1)
+	s.Require().Error(err)
+
+	withdrawnFees, err := clKeeper.WithdrawProtocolFees(s.Ctx, recipient, clPool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(protocolFees, withdrawnFees)
+	s.Require().Equal(protocolFees, s.App.BankKeeper.GetAllBalances(s.Ctx, recipient))
+
+	protocolFees, err = clKeeper.GetProtocolFees(s.Ctx, clPool.GetId())
+	s.Require().NoError(err)
+	s.Require().True(protocolFees.IsZero())
+}
+
 // CollectAndAssertFees collects fees from a given pool for all positions and verifies that the total fees collected match the expected total fees.
 // The method also checks that if the ticks that were active during the swap lie within the range of a position, then the position's fee accumulators
 // are not empty. The total fees collected are compared to the expected total fees within an additive tolerance defined by an error tolerance struct.
@@ -1151,3 +1330,72 @@ func (s *KeeperTestSuite) collectFeesAndCheckInvariance(ctx sdk.Context, account
 	s.tickStatusInvariance(activeTicks, minTick, maxTick, coins, expectedFeeDenoms)
 	return totalFeesCollected
 }
+
+func (s *KeeperTestSuite) TestPositionAccruedFeesExceed() {
+	s.SetupTest()
+	s.TestAccs = apptesting.CreateRandomAccounts(5)
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	// Position does not exist.
+	_, _, err := clKeeper.PositionAccruedFeesExceed(s.Ctx, 1, sdk.NewCoins())
+	s.Require().Error(err)
+
+	clPool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, DefaultExponentAtPriceOne, sdk.MustNewDecFromStr("0.003"))
+	positionId := s.SetupFullRangePositionAcc(clPool.GetId(), s.TestAccs[0])
+	s.swapAndTrackXTimesInARow(clPool.GetId(), DefaultCoin1, ETH, cltypes.MaxSpotPrice, 1)
+
+	accruedFees, err := clKeeper.QueryClaimableFees(s.Ctx, positionId)
+	s.Require().NoError(err)
+	accruedUsdc := accruedFees.AmountOf(USDC)
+	s.Require().True(accruedUsdc.IsPositive())
+
+	tests := map[string]struct {
+		threshold       sdk.Coins
+		expectedExceeds bool
+	}{
+		"empty threshold": {
+			threshold: sdk.NewCoins(),
+		},
+		"threshold below accrued": {
+			threshold:       sdk.NewCoins(sdk.NewCoin(USDC, accruedUsdc.SubRaw(1))),
+			expectedExceeds: true,
+		},
+		"threshold equal to accrued": {
+			threshold: sdk.NewCoins(sdk.NewCoin(USDC, accruedUsdc)),
+		},
+		"threshold above accrued": {
+			threshold: sdk.NewCoins(sdk.NewCoin(USDC, accruedUsdc.AddRaw(1))),
+		},
+		"only one denom exceeded": {
+			threshold:       sdk.NewCoins(sdk.NewCoin(ETH, sdk.NewInt(1_000_000)), sdk.NewCoin(USDC, accruedUsdc.SubRaw(1))),
+			expectedExceeds: true,
+		},
+		"denom without accrued fees": {
+			threshold: sdk.NewCoins(sdk.NewCoin(ETH, sdk.NewInt(1))),
+		},
+	}
+
+	querier := cl.NewQuerier(*clKeeper)
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			exceeds, accrued, err := clKeeper.PositionAccruedFeesExceed(s.Ctx, positionId, tc.threshold)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedExceeds, exceeds)
+			s.Require().Equal(accruedFees, accrued)
+
+			resp, err := querier.PositionAccruedExceeds(sdk.WrapSDKContext(s.Ctx), &clquery.QueryPositionAccruedExceedsRequest{PositionId: positionId, Threshold: tc.threshold})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedExceeds, resp.Exceeds)
+			s.Require().Equal(accruedFees, resp.Accrued)
+		})
+	}
+
+	// The query does not claim the fees.
+	feesAfterQuery, err := clKeeper.QueryClaimableFees(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().Equal(accruedFees, feesAfterQuery)
+
+	_, err = querier.PositionAccruedExceeds(sdk.WrapSDKContext(s.Ctx), nil)
+	s.Require().Error(err)
+}
@@ -59,11 +59,21 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState genesis.GenesisState) {
 	}
 
 	// set positions for pool
+	maxPositionId := uint64(0)
 	for _, position := range genState.Positions {
 		if _, ok := seenPoolIds[position.PoolId]; !ok {
 			panic(fmt.Sprintf("found position with pool id (%d) but there is no pool with such id that exists", position.PoolId))
 		}
 		k.setPosition(ctx, position.PoolId, sdk.MustAccAddressFromBech32(position.Address), position.LowerTick, position.UpperTick, position.JoinTime, position.Liquidity, position.PositionId)
+		if position.PositionId > maxPositionId {
+			maxPositionId = position.PositionId
+		}
+	}
+
+	// Ensure that positions created after import never collide with imported ones,
+	// even if the imported next position id is stale.
+	if len(genState.Positions) > 0 && genState.NextPositionId <= maxPositionId {
+		k.SetNextPositionId(ctx, maxPositionId+1)
 	}
 }
 
@@ -31,8 +31,10 @@ type singlePoolGenesisEntry struct {
 var (
 	baseGenesis = genesis.GenesisState{
 		Params: types.Params{
-			AuthorizedTickSpacing: []uint64{1, 10, 50},
-			AuthorizedSwapFees:    []sdk.Dec{sdk.MustNewDecFromStr("0.0001"), sdk.MustNewDecFromStr("0.0003"), sdk.MustNewDecFromStr("0.0005")}},
+			AuthorizedTickSpacing:  []uint64{1, 10, 50},
+			AuthorizedSwapFees:     []sdk.Dec{sdk.MustNewDecFromStr("0.0001"), sdk.MustNewDecFromStr("0.0003"), sdk.MustNewDecFromStr("0.0005")},
+			MinInitialLiquidity:    sdk.ZeroDec(),
+			MaxTicksCrossedPerSwap: types.DefaultMaxTicksCrossedPerSwap},
 		PoolData: []genesis.PoolData{},
 	}
 	testCoins    = sdk.NewDecCoins(cl.HundredFooCoins)
@@ -138,8 +140,14 @@ func setupGenesis(baseGenesis genesis.GenesisState, poolGenesisEntries []singleP
 			IncentiveRecords:       poolGenesisEntry.incentiveRecords,
 		})
 		baseGenesis.Positions = append(baseGenesis.Positions, poolGenesisEntry.positions...)
-		baseGenesis.NextPositionId = uint64(len(poolGenesisEntry.positions))
+	}
 
+	// The next position id follows the highest position id, as it would in an exported genesis.
+	baseGenesis.NextPositionId = 1
+	for _, position := range baseGenesis.Positions {
+		if position.PositionId >= baseGenesis.NextPositionId {
+			baseGenesis.NextPositionId = position.PositionId + 1
+		}
 	}
 	return baseGenesis
 }
@@ -469,6 +477,75 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 	}
 }
 
+// TestInitGenesis_NextPositionId tests that InitGenesis sets the next position id above
+// every imported position id, so that new positions never collide with imported ones.
+func (s *KeeperTestSuite) TestInitGenesis_NextPositionId() {
+	s.Setup()
+	poolE := s.PrepareConcentratedPool()
+	pool, ok := poolE.(*model.Pool)
+	s.Require().True(ok)
+
+	tests := map[string]struct {
+		positionIds            []uint64
+		genesisNextPositionId  uint64
+		expectedNextPositionId uint64
+	}{
+		"positions with gaps, stale next position id": {
+			positionIds:            []uint64{1, 5, 9},
+			genesisNextPositionId:  2,
+			expectedNextPositionId: 10,
+		},
+		"positions with gaps, next position id equal to max position id": {
+			positionIds:            []uint64{9, 3},
+			genesisNextPositionId:  9,
+			expectedNextPositionId: 10,
+		},
+		"next position id above max position id is kept": {
+			positionIds:            []uint64{1, 5, 9},
+			genesisNextPositionId:  20,
+			expectedNextPositionId: 20,
+		},
+		"no positions": {
+			genesisNextPositionId:  3,
+			expectedNextPositionId: 3,
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			// This erases previously created pools.
+			s.Setup()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+
+			positions := []model.Position{}
+			for _, positionId := range tc.positionIds {
+				positions = append(positions, withPositionId(testPositionModel, positionId))
+			}
+
+			genState := setupGenesis(baseGenesis, []singlePoolGenesisEntry{
+				{
+					pool:      *pool,
+					positions: positions,
+					feeAccumValues: genesis.AccumObject{
+						Name: types.KeyFeePoolAccumulator(pool.Id),
+						AccumContent: &accum.AccumulatorContent{
+							AccumValue:  sdk.NewDecCoins(),
+							TotalShares: sdk.ZeroDec(),
+						},
+					},
+					incentiveAccumulators: incentiveAccumsWithPoolId(pool.Id),
+				},
+			})
+			genState.NextPositionId = tc.genesisNextPositionId
+
+			clKeeper.InitGenesis(s.Ctx, genState)
+
+			s.Require().Equal(tc.expectedNextPositionId, clKeeper.GetNextPositionId(s.Ctx))
+		})
+	}
+}
+
 // TestExportGenesis tests the ExportGenesis function of the ConcentratedLiquidityKeeper.
 // It checks that the correct genesis state is returned.
 func (s *KeeperTestSuite) TestExportGenesis() {
@@ -96,12 +96,227 @@ func (q Querier) PositionById(ctx context.Context, req *clquery.QueryPositionByI
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
+	collectableNow, pendingUntilUptime, uptimeReachedAt, err := q.Keeper.queryIncentivesPreview(sdkCtx, req.PositionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &clquery.QueryPositionByIdResponse{
 		Position: model.PositionWithUnderlyingAssetBreakdown{
 			Position: position,
 			Asset0:   asset0,
 			Asset1:   asset1,
 		},
+		CollectableNow:     collectableNow,
+		PendingUntilUptime: pendingUntilUptime,
+		UptimeReachedAt:    uptimeReachedAt,
+	}, nil
+}
+
+// PositionSummary returns a position with the specified id alongside its underlying assets, claimable fees
+// and claimable incentives. It reuses the computations of the PositionById, ClaimableFees and ClaimableIncentives
+// queries so that the returned values match theirs.
+func (q Querier) PositionSummary(ctx context.Context, req *clquery.QueryPositionSummaryRequest) (*clquery.QueryPositionSummaryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	position, err := q.Keeper.GetPosition(sdkCtx, req.PositionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	positionPool, err := q.Keeper.getPoolById(sdkCtx, position.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	asset0, asset1, err := CalculateUnderlyingAssetsFromPosition(sdkCtx, position, positionPool)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	claimableFees, err := q.Keeper.queryClaimableFees(sdkCtx, req.PositionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	claimableIncentives, forfeitedIncentives, err := q.Keeper.queryClaimableIncentives(sdkCtx, req.PositionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.QueryPositionSummaryResponse{
+		Position: model.PositionWithUnderlyingAssetBreakdown{
+			Position: position,
+			Asset0:   asset0,
+			Asset1:   asset1,
+		},
+		ClaimableFees:       claimableFees,
+		ClaimableIncentives: claimableIncentives,
+		ForfeitedIncentives: forfeitedIncentives,
+	}, nil
+}
+
+// PositionIdsForRange returns the ids of all positions an owner has in a pool with the given tick range.
+func (q Querier) PositionIdsForRange(ctx context.Context, req *clquery.QueryPositionIdsForRangeRequest) (*clquery.QueryPositionIdsForRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkAddr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	positionIds, err := q.Keeper.GetPositionIdsForRange(sdkCtx, sdkAddr, req.PoolId, req.LowerTick, req.UpperTick)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.QueryPositionIdsForRangeResponse{
+		PositionIds: positionIds,
+	}, nil
+}
+
+// PriceAtTick returns the sqrt price and spot price at the given tick of the given pool.
+func (q Querier) PriceAtTick(ctx context.Context, req *clquery.QueryPriceAtTickRequest) (*clquery.QueryPriceAtTickResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	sqrtPrice, price, err := q.Keeper.priceAtTick(sdkCtx, req.PoolId, req.Tick)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.QueryPriceAtTickResponse{
+		SqrtPrice: sqrtPrice,
+		SpotPrice: price,
+	}, nil
+}
+
+// SqrtPriceForTicks returns the sqrt prices at the lower and upper ticks of the given range of the given pool.
+func (q Querier) SqrtPriceForTicks(ctx context.Context, req *clquery.QuerySqrtPriceForTicksRequest) (*clquery.QuerySqrtPriceForTicksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	sqrtPriceLowerTick, sqrtPriceUpperTick, err := q.Keeper.sqrtPriceForTicks(sdkCtx, req.PoolId, req.LowerTick, req.UpperTick)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.QuerySqrtPriceForTicksResponse{
+		SqrtPriceLowerTick: sqrtPriceLowerTick,
+		SqrtPriceUpperTick: sqrtPriceUpperTick,
+	}, nil
+}
+
+// RequiredAmountForDeposit returns the amount of the pool's other denom that must be deposited alongside the known
+// amount to create a position in the given range at the current price, as well as the resulting liquidity.
+func (q Querier) RequiredAmountForDeposit(ctx context.Context, req *clquery.QueryRequiredAmountForDepositRequest) (*clquery.QueryRequiredAmountForDepositResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	otherAmount, liquidity, err := q.Keeper.requiredAmountForDeposit(sdkCtx, req.PoolId, req.LowerTick, req.UpperTick, req.KnownDenom, req.KnownAmount)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.QueryRequiredAmountForDepositResponse{
+		OtherAmount: otherAmount,
+		Liquidity:   liquidity,
+	}, nil
+}
+
+// NextInitializedTick returns the next tick with non-zero liquidity gross in the given direction
+// from the start tick, alongside its liquidity net.
+func (q Querier) NextInitializedTick(ctx context.Context, req *clquery.QueryNextInitializedTickRequest) (*clquery.QueryNextInitializedTickResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	tickIndex, liquidityNet, found, err := q.Keeper.NextInitializedTick(sdkCtx, req.PoolId, req.StartTick, req.ZeroForOne)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	if !found {
+		liquidityNet = sdk.ZeroDec()
+	}
+
+	return &clquery.QueryNextInitializedTickResponse{
+		TickIndex:    tickIndex,
+		LiquidityNet: liquidityNet,
+		Found:        found,
+	}, nil
+}
+
+// FeeRevenue returns the swap fees collected by the given pool since the start time.
+func (q Querier) FeeRevenue(ctx context.Context, req *clquery.QueryFeeRevenueRequest) (*clquery.QueryFeeRevenueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	feeRevenue, err := q.Keeper.FeeRevenue(sdkCtx, req.PoolId, req.StartTime)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.QueryFeeRevenueResponse{FeeRevenue: feeRevenue}, nil
+}
+
+// PoolFeeAccumulator returns the current value of the given pool's global fee accumulator.
+func (q Querier) PoolFeeAccumulator(ctx context.Context, req *clquery.QueryPoolFeeAccumulatorRequest) (*clquery.QueryPoolFeeAccumulatorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	feeGrowthGlobal, err := q.Keeper.PoolFeeAccumulator(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.QueryPoolFeeAccumulatorResponse{FeeGrowthGlobal: feeGrowthGlobal}, nil
+}
+
+// PoolParams returns the tick spacing, exponent at price one, swap fee, current tick and
+// current sqrt price of the given pool.
+func (q Querier) PoolParams(ctx context.Context, req *clquery.QueryPoolParamsRequest) (*clquery.QueryPoolParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pool, err := q.Keeper.getPoolById(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.QueryPoolParamsResponse{
+		TickSpacing:        pool.GetTickSpacing(),
+		ExponentAtPriceOne: pool.GetExponentAtPriceOne(),
+		SwapFee:            pool.GetSwapFee(sdkCtx),
+		CurrentTick:        pool.GetCurrentTick(),
+		CurrentSqrtPrice:   pool.GetCurrentSqrtPrice(),
 	}, nil
 }
 
@@ -151,6 +366,64 @@ func (q Querier) Pools(
 	}, nil
 }
 
+// PoolsByLiquidity returns concentrated liquidity pools sorted by current active liquidity in descending order.
+// Since this order differs from the store order, only offset-based pagination is supported.
+func (q Querier) PoolsByLiquidity(
+	ctx context.Context,
+	req *clquery.QueryPoolsByLiquidityRequest,
+) (*clquery.QueryPoolsByLiquidityResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	offset, limit, countTotal := uint64(0), uint64(query.DefaultLimit), false
+	if req.Pagination != nil {
+		if len(req.Pagination.Key) > 0 {
+			return nil, status.Error(codes.InvalidArgument, "key-based pagination is not supported, use offset instead")
+		}
+		offset = req.Pagination.Offset
+		if req.Pagination.Limit > 0 {
+			limit = req.Pagination.Limit
+		}
+		countTotal = req.Pagination.CountTotal
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pools, err := q.Keeper.getPoolsSortedByLiquidity(sdkCtx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	total := uint64(len(pools))
+	start, end := total, total
+	if offset < total {
+		start = offset
+		if limit < total-start {
+			end = start + limit
+		}
+	}
+
+	anys := make([]*codectypes.Any, 0, end-start)
+	for _, pool := range pools[start:end] {
+		any, err := codectypes.NewAnyWithValue(pool)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		anys = append(anys, any)
+	}
+
+	pageRes := &query.PageResponse{}
+	if countTotal {
+		pageRes.Total = total
+	}
+
+	return &clquery.QueryPoolsByLiquidityResponse{
+		Pools:      anys,
+		Pagination: pageRes,
+	}, nil
+}
+
 // Params returns module params
 func (q Querier) Params(goCtx context.Context, req *clquery.QueryParamsRequest) (*clquery.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -228,3 +501,337 @@ func (q Querier) ClaimableFees(ctx context.Context, req *clquery.QueryClaimableF
 		ClaimableFees: claimableFees,
 	}, nil
 }
+
+func (q Querier) ClaimableIncentives(ctx context.Context, req *clquery.QueryClaimableIncentivesRequest) (*clquery.QueryClaimableIncentivesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	claimableIncentives, forfeitedIncentives, err := q.Keeper.queryClaimableIncentives(sdkCtx, req.PositionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.QueryClaimableIncentivesResponse{
+		ClaimableIncentives: claimableIncentives,
+		ForfeitedIncentives: forfeitedIncentives,
+	}, nil
+}
+
+// PositionApr returns an estimate of the annualized return of a position with the specified id from fees
+// and incentives at current rates. See positionApr for the assumptions behind the estimate.
+func (q Querier) PositionApr(ctx context.Context, req *clquery.QueryPositionAprRequest) (*clquery.QueryPositionAprResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	apr, annualizedFees, annualizedIncentives, err := q.Keeper.positionApr(sdkCtx, req.PositionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.QueryPositionAprResponse{
+		Apr:                  apr,
+		AnnualizedFees:       annualizedFees,
+		AnnualizedIncentives: annualizedIncentives,
+	}, nil
+}
+
+// PoolIncentiveRecords returns the incentive records of a pool that have incentives remaining, alongside the
+// time each one will be exhausted at its emission rate.
+func (q Querier) PoolIncentiveRecords(ctx context.Context, req *clquery.QueryPoolIncentiveRecordsRequest) (*clquery.QueryPoolIncentiveRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	incentiveRecords, exhaustedAt, err := q.Keeper.poolIncentiveRecords(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	poolIncentiveRecords := make([]clquery.PoolIncentiveRecord, 0, len(incentiveRecords))
+	for i, incentiveRecord := range incentiveRecords {
+		poolIncentiveRecords = append(poolIncentiveRecords, clquery.PoolIncentiveRecord{
+			IncentiveRecord: incentiveRecord,
+			ExhaustedAt:     exhaustedAt[i],
+		})
+	}
+
+	return &clquery.QueryPoolIncentiveRecordsResponse{IncentiveRecords: poolIncentiveRecords}, nil
+}
+
+// PoolsForDenomPair returns the ids of all concentrated liquidity pools whose token0 and token1 match the
+// requested denoms, in either order.
+func (q Querier) PoolsForDenomPair(ctx context.Context, req *clquery.QueryPoolsForDenomPairRequest) (*clquery.QueryPoolsForDenomPairResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	poolIds, err := q.Keeper.PoolsForDenomPair(sdkCtx, req.DenomA, req.DenomB)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.QueryPoolsForDenomPairResponse{PoolIds: poolIds}, nil
+}
+
+// PoolsForPairWithFees returns every concentrated liquidity pool whose token0 and token1 match the requested denoms,
+// in either order, alongside its swap fee and current liquidity.
+func (q Querier) PoolsForPairWithFees(ctx context.Context, req *clquery.QueryPoolsForPairWithFeesRequest) (*clquery.QueryPoolsForPairWithFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pools, err := q.Keeper.PoolsForPairWithFees(sdkCtx, req.DenomA, req.DenomB)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	feeTiers := make([]clquery.PoolFeeTier, 0, len(pools))
+	for _, pool := range pools {
+		feeTiers = append(feeTiers, clquery.PoolFeeTier{
+			PoolId:    pool.GetId(),
+			SwapFee:   pool.GetSwapFee(sdkCtx),
+			Liquidity: pool.GetLiquidity(),
+		})
+	}
+
+	return &clquery.QueryPoolsForPairWithFeesResponse{Pools: feeTiers}, nil
+}
+
+// LiquidityWeightedTick returns the average of the pool's initialized ticks weighted by their gross liquidity,
+// alongside the spot price at that tick.
+func (q Querier) LiquidityWeightedTick(ctx context.Context, req *clquery.QueryLiquidityWeightedTickRequest) (*clquery.QueryLiquidityWeightedTickResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	tick, price, err := q.Keeper.liquidityWeightedTick(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.QueryLiquidityWeightedTickResponse{
+		Tick:      tick,
+		SpotPrice: price,
+	}, nil
+}
+
+// SimulateSwapExactAmountIn simulates swapping an exact amount in at the pool's swap fee without changing state,
+// returning the swap fee charged separately from the amount out.
+func (q Querier) SimulateSwapExactAmountIn(ctx context.Context, req *clquery.QuerySimulateSwapExactAmountInRequest) (*clquery.QuerySimulateSwapExactAmountInResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	amountOut, feeCharged, amountInAfterFee, err := q.Keeper.simulateSwapExactAmountIn(sdkCtx, req.PoolId, req.TokenIn, req.TokenOutDenom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.QuerySimulateSwapExactAmountInResponse{
+		AmountOut:        amountOut,
+		FeeCharged:       feeCharged,
+		AmountInAfterFee: amountInAfterFee,
+	}, nil
+}
+
+// ProtocolFees returns the protocol fees accrued by the pool that can be withdrawn by the protocol fee recipient.
+func (q Querier) ProtocolFees(ctx context.Context, req *clquery.QueryProtocolFeesRequest) (*clquery.QueryProtocolFeesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	protocolFees, err := q.Keeper.GetProtocolFees(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.QueryProtocolFeesResponse{ProtocolFees: protocolFees}, nil
+}
+
+// PoolStats returns the number of open positions in the given pool, the number of distinct addresses owning them
+// and the pool's active liquidity.
+func (q Querier) PoolStats(ctx context.Context, req *clquery.QueryPoolStatsRequest) (*clquery.QueryPoolStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	numPositions, numOwners, activeLiquidity, err := q.Keeper.poolStats(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.QueryPoolStatsResponse{
+		NumPositions:    numPositions,
+		NumOwners:       numOwners,
+		ActiveLiquidity: activeLiquidity,
+	}, nil
+}
+
+// IsPositionInRange returns whether the current tick of the given position's pool is within the position's range,
+// alongside the current tick and the position's lower and upper ticks.
+func (q Querier) IsPositionInRange(ctx context.Context, req *clquery.QueryIsPositionInRangeRequest) (*clquery.QueryIsPositionInRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	inRange, currentTick, lowerTick, upperTick, err := q.Keeper.isPositionInRange(sdkCtx, req.PositionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.QueryIsPositionInRangeResponse{
+		InRange:     inRange,
+		CurrentTick: currentTick,
+		LowerTick:   lowerTick,
+		UpperTick:   upperTick,
+	}, nil
+}
+
+// PositionConversionBounds returns the ticks and spot prices at which the given position is entirely converted to
+// token0 (its lower tick) or token1 (its upper tick), and whether the current price is already outside its range.
+func (q Querier) PositionConversionBounds(ctx context.Context, req *clquery.QueryPositionConversionBoundsRequest) (*clquery.QueryPositionConversionBoundsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	position, err := q.Keeper.GetPosition(sdkCtx, req.PositionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	lowerPrice, upperPrice, belowRange, aboveRange, err := q.Keeper.positionConversionBounds(sdkCtx, req.PositionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.QueryPositionConversionBoundsResponse{
+		LowerTick:  position.LowerTick,
+		UpperTick:  position.UpperTick,
+		LowerPrice: lowerPrice,
+		UpperPrice: upperPrice,
+		BelowRange: belowRange,
+		AboveRange: aboveRange,
+	}, nil
+}
+
+// PositionsByJoinTimeRange returns all positions with a join time within the given inclusive range, ordered by join time.
+func (q Querier) PositionsByJoinTimeRange(ctx context.Context, req *clquery.QueryPositionsByJoinTimeRangeRequest) (*clquery.QueryPositionsByJoinTimeRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	positions, pageRes, err := q.Keeper.getPositionsByJoinTimeRange(sdkCtx, req.StartTime, req.EndTime, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.QueryPositionsByJoinTimeRangeResponse{
+		Positions:  positions,
+		Pagination: pageRes,
+	}, nil
+}
+
+// PositionAccruedExceeds returns whether the fees accrued by a position exceed the given threshold, along with the
+// accrued fees, so that off-chain keepers can decide whether claiming is worthwhile.
+func (q Querier) PositionAccruedExceeds(ctx context.Context, req *clquery.QueryPositionAccruedExceedsRequest) (*clquery.QueryPositionAccruedExceedsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	exceeds, accrued, err := q.Keeper.positionAccruedFeesExceed(sdkCtx, req.PositionId, req.Threshold)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.QueryPositionAccruedExceedsResponse{
+		Exceeds: exceeds,
+		Accrued: accrued,
+	}, nil
+}
+
+// AllTicks returns a page of the initialized ticks of the given pool with their full tick info, ordered by
+// ascending tick index, so that indexers can maintain a full replica of the pool's state.
+func (q Querier) AllTicks(ctx context.Context, req *clquery.QueryAllTicksRequest) (*clquery.QueryAllTicksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	ticks, pageRes, err := q.Keeper.getAllTicksPaginated(sdkCtx, req.PoolId, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.QueryAllTicksResponse{
+		Ticks:      ticks,
+		Pagination: pageRes,
+	}, nil
+}
+
+// PositionImpermanentLoss returns an estimate of the impermanent loss of the position with the specified id.
+// See positionImpermanentLoss for the assumptions behind the estimate.
+func (q Querier) PositionImpermanentLoss(ctx context.Context, req *clquery.QueryPositionImpermanentLossRequest) (*clquery.QueryPositionImpermanentLossResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	impermanentLoss, valueDifference, currentAmounts, heldAmounts, err := q.Keeper.positionImpermanentLoss(sdkCtx, req.PositionId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.QueryPositionImpermanentLossResponse{
+		ImpermanentLoss: impermanentLoss,
+		ValueDifference: valueDifference,
+		CurrentAmounts:  currentAmounts,
+		HeldAmounts:     heldAmounts,
+	}, nil
+}
+
+// LiquidityToReachPrice returns the amount that would have to be swapped into the pool with the specified id to move its
+// spot price to the target price, alongside the initialized ticks the swap would cross.
+func (q Querier) LiquidityToReachPrice(ctx context.Context, req *clquery.QueryLiquidityToReachPriceRequest) (*clquery.QueryLiquidityToReachPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	tokenIn, crossedTicks, err := q.Keeper.liquidityToReachPrice(sdkCtx, req.PoolId, req.TargetPrice)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &clquery.QueryLiquidityToReachPriceResponse{TokenIn: tokenIn, CrossedTicks: crossedTicks}, nil
+}
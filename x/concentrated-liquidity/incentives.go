@@ -40,6 +40,7 @@ func getUptimeTrackerValues(uptimeTrackers []model.UptimeTracker) []sdk.DecCoins
 // nolint: unused
 // getUptimeAccumulators gets the uptime accumulator objects for the given poolId
 // Returns error if accumulator for the given poolId does not exist.
+// The accumulators emit their claim events through the context's event manager.
 func (k Keeper) getUptimeAccumulators(ctx sdk.Context, poolId uint64) ([]accum.AccumulatorObject, error) {
 	accums := make([]accum.AccumulatorObject, len(types.SupportedUptimes))
 	for uptimeIndex := range types.SupportedUptimes {
@@ -48,7 +49,7 @@ func (k Keeper) getUptimeAccumulators(ctx sdk.Context, poolId uint64) ([]accum.A
 			return []accum.AccumulatorObject{}, err
 		}
 
-		accums[uptimeIndex] = acc
+		accums[uptimeIndex] = acc.WithEventEmitter(ctx.EventManager().EmitEvent)
 	}
 
 	return accums, nil
@@ -478,7 +479,7 @@ func prepareAccumAndClaimRewards(accum accum.AccumulatorObject, positionKey stri
 	}
 
 	// Claim incentives
-	incentivesClaimedCurrAccum, err := accum.ClaimRewards(positionKey)
+	incentivesClaimedCurrAccum, _, err := accum.ClaimRewards(positionKey)
 	if err != nil {
 		return sdk.Coins{}, err
 	}
@@ -571,40 +572,207 @@ func (k Keeper) claimAllIncentivesForPosition(ctx sdk.Context, positionId uint64
 	return collectedIncentivesForPosition, forfeitedIncentivesForPosition, nil
 }
 
-// collectIncentives collects incentives for all uptime accumulators for the specified position id.
+// queryClaimableIncentives returns the incentives a position with the given id would collect and forfeit
+// if it claimed at the current block time, without modifying state.
 //
-// Upon successful collection, it bank sends the incentives from the pool address to the owner and returns the collected coins.
+// Returns error if the position/uptime accumulators don't exist.
+func (k Keeper) queryClaimableIncentives(ctx sdk.Context, positionId uint64) (sdk.Coins, sdk.Coins, error) {
+	claimableIncentives, forfeitedIncentives, _, err := k.queryIncentivesPreview(ctx, positionId)
+	return claimableIncentives, forfeitedIncentives, err
+}
+
+// queryIncentivesPreview returns the incentives a position with the given id can collect at the current block time
+// alongside the incentives that are pending until the position reaches the uptimes they were accrued for, without
+// modifying state. Pending incentives are forfeited if the position claims before reaching those uptimes.
+// It also returns the time at which all pending incentives become collectable, derived from the position's join
+// time and the supported uptimes. If no incentives are pending, this is the current block time.
+//
+// Returns error if the position/uptime accumulators don't exist.
+func (k Keeper) queryIncentivesPreview(ctx sdk.Context, positionId uint64) (sdk.Coins, sdk.Coins, time.Time, error) {
+	// Since this is a query, we don't want to modify the state and therefore use a cache context.
+	cacheCtx, _ := ctx.CacheContext()
+
+	// Retrieve the position with the given ID.
+	position, err := k.GetPosition(cacheCtx, positionId)
+	if err != nil {
+		return sdk.Coins{}, sdk.Coins{}, time.Time{}, err
+	}
+
+	// Compute the age of the position.
+	positionAge := cacheCtx.BlockTime().Sub(position.JoinTime)
+	if positionAge < 0 {
+		return sdk.Coins{}, sdk.Coins{}, time.Time{}, types.NegativeDurationError{Duration: positionAge}
+	}
+
+	// Bring the uptime accumulators up to the current block time so that the query
+	// includes incentives emitted since the last update.
+	if err := k.updateUptimeAccumulatorsToNow(cacheCtx, position.PoolId); err != nil {
+		return sdk.Coins{}, sdk.Coins{}, time.Time{}, err
+	}
+
+	// Retrieve the uptime accumulators for the position's pool.
+	uptimeAccumulators, err := k.getUptimeAccumulators(cacheCtx, position.PoolId)
+	if err != nil {
+		return sdk.Coins{}, sdk.Coins{}, time.Time{}, err
+	}
+
+	// Compute uptime growth outside of the range between lower tick and upper tick
+	uptimeGrowthOutside, err := k.GetUptimeGrowthOutsideRange(cacheCtx, position.PoolId, position.LowerTick, position.UpperTick)
+	if err != nil {
+		return sdk.Coins{}, sdk.Coins{}, time.Time{}, err
+	}
+
+	positionName := string(types.KeyPositionId(positionId))
+
+	claimableIncentives := sdk.Coins{}
+	forfeitedIncentives := sdk.Coins{}
+	uptimeReachedAt := cacheCtx.BlockTime()
+
+	supportedUptimes := types.SupportedUptimes
+
+	for uptimeIndex, uptimeAccum := range uptimeAccumulators {
+		hasPosition, err := uptimeAccum.HasPosition(positionName)
+		if err != nil {
+			return sdk.Coins{}, sdk.Coins{}, time.Time{}, err
+		}
+
+		if !hasPosition {
+			continue
+		}
+
+		// Replace the position's accumulator before computing the rewards owed.
+		err = preparePositionAccumulator(uptimeAccum, positionName, uptimeGrowthOutside[uptimeIndex])
+		if err != nil {
+			return sdk.Coins{}, sdk.Coins{}, time.Time{}, err
+		}
+
+		incentivesOwed, err := uptimeAccum.GetPositionRewards(positionName)
+		if err != nil {
+			return sdk.Coins{}, sdk.Coins{}, time.Time{}, err
+		}
+		carriedIncentives, err := uptimeAccum.GetPositionCarriedRewards(positionName)
+		if err != nil {
+			return sdk.Coins{}, sdk.Coins{}, time.Time{}, err
+		}
+		incentivesForUptime, _ := incentivesOwed.Add(carriedIncentives...).TruncateDecimal()
+
+		// Incentives for uptimes the position has not yet reached would be forfeited on claim.
+		if positionAge < supportedUptimes[uptimeIndex] {
+			forfeitedIncentives = forfeitedIncentives.Add(incentivesForUptime...)
+			if !incentivesForUptime.IsZero() {
+				if reachedAt := position.JoinTime.Add(supportedUptimes[uptimeIndex]); reachedAt.After(uptimeReachedAt) {
+					uptimeReachedAt = reachedAt
+				}
+			}
+			continue
+		}
+
+		claimableIncentives = claimableIncentives.Add(incentivesForUptime...)
+	}
+
+	return claimableIncentives, forfeitedIncentives, uptimeReachedAt, nil
+}
+
+// poolIncentiveRecords returns the incentive records of the given pool that have incentives remaining as of the
+// current block time, alongside the time at which each one will be exhausted, without modifying state.
+// A record is assumed to keep emitting at its emission rate from the later of its start time and the current
+// block time, which holds as long as its uptime accumulator has qualifying liquidity. If exhausting a record
+// would take longer than the maximum time.Duration, its exhaustion time is capped at that duration.
+//
+// Returns error if the pool does not exist or if it fails to bring the uptime accumulators up to date.
+func (k Keeper) poolIncentiveRecords(ctx sdk.Context, poolId uint64) ([]types.IncentiveRecord, []time.Time, error) {
+	// Since this is a query, we don't want to modify the state and therefore use a cache context.
+	cacheCtx, _ := ctx.CacheContext()
+
+	// Bring the incentive records up to the current block time so that the remaining amounts
+	// exclude incentives emitted since the last update.
+	if err := k.updateUptimeAccumulatorsToNow(cacheCtx, poolId); err != nil {
+		return nil, nil, err
+	}
+
+	incentiveRecords, err := k.GetAllIncentiveRecordsForPool(cacheCtx, poolId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	maxDurationNanoSec := sdk.NewDec(int64(time.Duration(1<<63 - 1)))
+	activeRecords := []types.IncentiveRecord{}
+	exhaustedAt := []time.Time{}
+	for _, incentiveRecord := range incentiveRecords {
+		recordBody := incentiveRecord.IncentiveRecordBody
+		if !recordBody.RemainingAmount.IsPositive() || !recordBody.EmissionRate.IsPositive() {
+			continue
+		}
+
+		emissionStart := recordBody.StartTime
+		if emissionStart.Before(cacheCtx.BlockTime()) {
+			emissionStart = cacheCtx.BlockTime()
+		}
+
+		remainingNanoSec := recordBody.RemainingAmount.Quo(recordBody.EmissionRate).MulInt64(int64(time.Second)).Ceil()
+		remainingNanoSec = sdk.MinDec(remainingNanoSec, maxDurationNanoSec)
+
+		activeRecords = append(activeRecords, incentiveRecord)
+		exhaustedAt = append(exhaustedAt, emissionStart.Add(time.Duration(remainingNanoSec.TruncateInt64())))
+	}
+
+	return activeRecords, exhaustedAt, nil
+}
+
+// collectIncentives collects incentives for all uptime accumulators for the specified position id, leaving the position's
+// liquidity untouched. Incentives accrued for uptimes the position has not yet reached are forfeited and redistributed
+// to the other positions of the pool, as in claimAllIncentivesForPosition.
+//
+// Upon successful collection, it bank sends the incentives from the pool address to the owner and returns the collected
+// and forfeited coins. Emits a collect incentives event if any incentives were collected, and a forfeit incentives event
+// if any were forfeited.
 // Returns error if:
 // - position with the given id does not exist
+// - the owner is not the owner of the position
 // - other internal database or math errors.
-func (k Keeper) collectIncentives(ctx sdk.Context, owner sdk.AccAddress, positionId uint64) (sdk.Coins, error) {
+func (k Keeper) collectIncentives(ctx sdk.Context, owner sdk.AccAddress, positionId uint64) (sdk.Coins, sdk.Coins, error) {
 	// Retrieve the position with the given ID.
 	position, err := k.GetPosition(ctx, positionId)
 	if err != nil {
-		return sdk.Coins{}, err
+		return sdk.Coins{}, sdk.Coins{}, err
+	}
+
+	if position.Address != owner.String() {
+		return sdk.Coins{}, sdk.Coins{}, types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()}
 	}
 
 	// Claim all incentives for the position.
-	// TODO: consider returning forfeited rewards as well
-	collectedIncentivesForPosition, _, err := k.claimAllIncentivesForPosition(ctx, position.PositionId)
+	collectedIncentivesForPosition, forfeitedIncentivesForPosition, err := k.claimAllIncentivesForPosition(ctx, position.PositionId)
 	if err != nil {
-		return sdk.Coins{}, err
+		return sdk.Coins{}, sdk.Coins{}, err
+	}
+
+	// Emit an event indicating that incentives were forfeited.
+	if !forfeitedIncentivesForPosition.IsZero() {
+		ctx.EventManager().EmitEvents(sdk.Events{
+			sdk.NewEvent(
+				types.TypeEvtForfeitIncentives,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+				sdk.NewAttribute(types.AttributeForfeitedIncentives, forfeitedIncentivesForPosition.String()),
+			),
+		})
 	}
 
 	// If no incentives were collected, return an empty coin set.
 	if collectedIncentivesForPosition.IsZero() {
-		return collectedIncentivesForPosition, nil
+		return collectedIncentivesForPosition, forfeitedIncentivesForPosition, nil
 	}
 
 	// Send the collected incentives to the position's owner.
 	pool, err := k.getPoolById(ctx, position.PoolId)
 	if err != nil {
-		return sdk.Coins{}, err
+		return sdk.Coins{}, sdk.Coins{}, err
 	}
 
 	// Send the collected incentives to the position's owner from the pool's address.
 	if err := k.bankKeeper.SendCoins(ctx, pool.GetIncentivesAddress(), owner, collectedIncentivesForPosition); err != nil {
-		return sdk.Coins{}, err
+		return sdk.Coins{}, sdk.Coins{}, err
 	}
 
 	// Emit an event indicating that incentives were collected.
@@ -617,7 +785,7 @@ func (k Keeper) collectIncentives(ctx sdk.Context, owner sdk.AccAddress, positio
 		),
 	})
 
-	return collectedIncentivesForPosition, nil
+	return collectedIncentivesForPosition, forfeitedIncentivesForPosition, nil
 }
 
 // createIncentive creates an incentive record in state for the given pool
@@ -1893,6 +1893,7 @@ func (s *KeeperTestSuite) TestCollectIncentives() {
 		// inputs parameters
 		positionParams positionParameters
 		timeInPosition time.Duration
+		sender         sdk.AccAddress
 
 		// expectations
 		expectedIncentivesClaimed sdk.Coins
@@ -2606,6 +2607,25 @@ func (s *KeeperTestSuite) TestCollectIncentives() {
 
 		// Error catching
 
+		"sender is not the position owner": {
+			currentTick:             1,
+			addedUptimeGrowthInside: uptimeHelper.hundredTokensMultiDenom,
+			positionParams: positionParameters{
+				owner:       ownerWithValidPosition,
+				lowerTick:   0,
+				upperTick:   2,
+				liquidity:   DefaultLiquidityAmt,
+				joinTime:    defaultJoinTime,
+				positionId:  DefaultPositionId,
+				collectTime: defaultJoinTime.Add(100),
+			},
+			numPositions:   1,
+			timeInPosition: oneDay,
+			sender:         s.TestAccs[1],
+
+			expectedIncentivesClaimed: sdk.Coins{},
+			expectedError:             cltypes.NotPositionOwnerError{PositionId: DefaultPositionId, Address: s.TestAccs[1].String()},
+		},
 		"position does not exist": {
 			currentTick: 1,
 
@@ -2673,8 +2693,16 @@ func (s *KeeperTestSuite) TestCollectIncentives() {
 				sutPoolId = sutPoolId + 1
 			}
 
+			// The forfeited incentives are expected to match the preview of the claim.
+			_, expectedIncentivesForfeited, _ := clKeeper.QueryClaimableIncentives(ctx, DefaultPositionId)
+
+			sender := ownerWithValidPosition
+			if tc.sender != nil {
+				sender = tc.sender
+			}
+
 			// System under test
-			actualIncentivesClaimed, err := clKeeper.CollectIncentives(ctx, ownerWithValidPosition, DefaultPositionId)
+			actualIncentivesClaimed, actualIncentivesForfeited, err := clKeeper.CollectIncentives(ctx, sender, DefaultPositionId)
 
 			// Assertions
 
@@ -2699,6 +2727,14 @@ func (s *KeeperTestSuite) TestCollectIncentives() {
 			// Ensure claimed amount is correct
 			s.Require().NoError(err)
 			s.Require().Equal(tc.expectedIncentivesClaimed.String(), actualIncentivesClaimed.String())
+			s.Require().Equal(expectedIncentivesForfeited.String(), actualIncentivesForfeited.String())
+
+			// Forfeited incentives are reported in their own event.
+			if expectedIncentivesForfeited.IsZero() {
+				s.AssertEventEmitted(ctx, cltypes.TypeEvtForfeitIncentives, 0)
+			} else {
+				s.AssertEventEmitted(ctx, cltypes.TypeEvtForfeitIncentives, 1)
+			}
 
 			// Ensure balances are updated by the correct amounts
 			s.Require().Equal(tc.expectedIncentivesClaimed.String(), (incentivesBalanceBeforeCollect.Sub(incentivesBalanceAfterCollect)).String())
@@ -3156,6 +3192,10 @@ func (s *KeeperTestSuite) TestClaimAllIncentives() {
 				s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(types.SupportedUptimes[len(types.SupportedUptimes)-1]))
 			}
 
+			// Query the incentives before claiming so the preview can be compared against the claim.
+			amountClaimable, amountToForfeit, queryErr := clKeeper.QueryClaimableIncentives(s.Ctx, tc.positionIdClaim)
+			collectableNow, pendingUntilUptime, uptimeReachedAt, previewErr := clKeeper.QueryIncentivesPreview(s.Ctx, tc.positionIdClaim)
+
 			// --- System under test ---
 
 			amountClaimed, amountForfeited, err := clKeeper.ClaimAllIncentivesForPosition(s.Ctx, tc.positionIdClaim)
@@ -3169,6 +3209,7 @@ func (s *KeeperTestSuite) TestClaimAllIncentives() {
 			if tc.expectedError != nil {
 				s.Require().Error(err)
 				s.Require().ErrorIs(err, tc.expectedError)
+				s.Require().ErrorIs(queryErr, tc.expectedError)
 
 				// Ensure balances have not been mutated
 				s.Require().Equal(initSenderBalances, newSenderBalances)
@@ -3176,6 +3217,22 @@ func (s *KeeperTestSuite) TestClaimAllIncentives() {
 				return
 			}
 			s.Require().NoError(err)
+			s.Require().NoError(queryErr)
+			s.Require().NoError(previewErr)
+
+			// The query should preview exactly what was claimed and forfeited
+			s.Require().Equal(amountClaimed.String(), amountClaimable.String())
+			s.Require().Equal(amountForfeited.String(), amountToForfeit.String())
+
+			// Incentives that would be forfeited now are pending until the position reaches their uptimes
+			s.Require().Equal(amountClaimable.String(), collectableNow.String())
+			s.Require().Equal(amountToForfeit.String(), pendingUntilUptime.String())
+			if pendingUntilUptime.IsZero() {
+				s.Require().Equal(s.Ctx.BlockTime(), uptimeReachedAt)
+			} else {
+				s.Require().True(uptimeReachedAt.After(s.Ctx.BlockTime()))
+				s.Require().False(uptimeReachedAt.After(joinTime.Add(types.SupportedUptimes[len(types.SupportedUptimes)-1])))
+			}
 
 			// Ensure that forfeited incentives were properly added to their respective accumulators
 			if tc.forfeitIncentives {
@@ -0,0 +1,148 @@
+package concentrated_liquidity
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils/accum"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/internal/math"
+	types "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+)
+
+const (
+	positionLiquidityInvariantName = "position-liquidity-matches-pool-balance"
+	accumulatorSharesInvariantName = "accumulator-total-shares-match-positions"
+)
+
+// accumulatorSharesTolerance is the largest difference allowed between an accumulator's stored
+// total shares and the sum of its positions' shares. Shares are only ever added and subtracted
+// exactly, but rescaling an accumulator rounds every position's shares separately from the total.
+var accumulatorSharesTolerance = sdk.NewDecWithPrec(1, 12)
+
+// RegisterInvariants registers all concentrated liquidity invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper) {
+	ir.RegisterRoute(types.ModuleName, positionLiquidityInvariantName, PositionLiquidityMatchesPoolBalance(keeper))
+	ir.RegisterRoute(types.ModuleName, accumulatorSharesInvariantName, AccumulatorTotalSharesMatchPositions(keeper))
+}
+
+// AllInvariants runs all invariants of the concentrated liquidity module.
+func AllInvariants(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		msg, broken := PositionLiquidityMatchesPoolBalance(keeper)(ctx)
+		if broken {
+			return msg, broken
+		}
+
+		return AccumulatorTotalSharesMatchPositions(keeper)(ctx)
+	}
+}
+
+// PositionLiquidityMatchesPoolBalance checks that, for every pool, the pool account
+// holds at least the token0 and token1 amounts implied by all of its positions at the
+// current price. The pool account may hold more than that since accrued fees are
+// kept there until claimed.
+func PositionLiquidityMatchesPoolBalance(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		positions, err := keeper.getAllPositions(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, positionLiquidityInvariantName,
+				fmt.Sprintf("\tconcentrated liquidity position retrieval failed: %s\n", err)), true
+		}
+
+		// Sum the amounts implied by each position, grouped by pool.
+		expectedByPool := make(map[uint64]sdk.Coins)
+		poolIds := []uint64{}
+		for _, position := range positions {
+			pool, err := keeper.getPoolById(ctx, position.PoolId)
+			if err != nil {
+				return sdk.FormatInvariant(types.ModuleName, positionLiquidityInvariantName,
+					fmt.Sprintf("\tpool id %d for position id %d retrieval failed: %s\n", position.PoolId, position.PositionId, err)), true
+			}
+
+			sqrtPriceLowerTick, sqrtPriceUpperTick, err := math.TicksToSqrtPrice(position.LowerTick, position.UpperTick, pool.GetExponentAtPriceOne())
+			if err != nil {
+				return sdk.FormatInvariant(types.ModuleName, positionLiquidityInvariantName,
+					fmt.Sprintf("\tposition id %d sqrt price conversion failed: %s\n", position.PositionId, err)), true
+			}
+
+			amount0, amount1 := pool.CalcActualAmounts(ctx, position.LowerTick, position.UpperTick, sqrtPriceLowerTick, sqrtPriceUpperTick, position.Liquidity, osmomath.RoundDown)
+			positionCoins := sdk.NewCoins(
+				sdk.NewCoin(pool.GetToken0(), amount0.TruncateInt()),
+				sdk.NewCoin(pool.GetToken1(), amount1.TruncateInt()),
+			)
+
+			if _, ok := expectedByPool[position.PoolId]; !ok {
+				poolIds = append(poolIds, position.PoolId)
+			}
+			expectedByPool[position.PoolId] = expectedByPool[position.PoolId].Add(positionCoins...)
+		}
+
+		for _, poolId := range poolIds {
+			pool, err := keeper.getPoolById(ctx, poolId)
+			if err != nil {
+				return sdk.FormatInvariant(types.ModuleName, positionLiquidityInvariantName,
+					fmt.Sprintf("\tpool id %d retrieval failed: %s\n", poolId, err)), true
+			}
+
+			expectedCoins := expectedByPool[poolId]
+			actualCoins := keeper.bankKeeper.GetAllBalances(ctx, pool.GetAddress())
+			if !actualCoins.IsAllGTE(expectedCoins) {
+				return sdk.FormatInvariant(types.ModuleName, positionLiquidityInvariantName,
+					fmt.Sprintf("\tconcentrated liquidity pool id %d\n\t position-implied coins: %s\n\t account coins: %s\n",
+						poolId, expectedCoins, actualCoins)), true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, positionLiquidityInvariantName,
+			"\tall concentrated liquidity pool balances cover their positions\n"), false
+	}
+}
+
+// AccumulatorTotalSharesMatchPositions checks that, for every pool, the total shares stored in
+// its fee and uptime accumulators match the sum of the shares of their positions, up to rounding.
+// Accumulator records of positions that no longer exist are left out of the sum, so shares that
+// outlive their position break the invariant.
+func AccumulatorTotalSharesMatchPositions(keeper Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		pools, err := keeper.GetPools(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, accumulatorSharesInvariantName,
+				fmt.Sprintf("\tconcentrated liquidity pool retrieval failed: %s\n", err)), true
+		}
+
+		for _, pool := range pools {
+			poolId := pool.GetId()
+
+			feeAccumulator, err := keeper.getFeeAccumulator(ctx, poolId)
+			if err != nil {
+				return sdk.FormatInvariant(types.ModuleName, accumulatorSharesInvariantName,
+					fmt.Sprintf("\tpool id %d fee accumulator retrieval failed: %s\n", poolId, err)), true
+			}
+
+			uptimeAccumulators, err := keeper.getUptimeAccumulators(ctx, poolId)
+			if err != nil {
+				return sdk.FormatInvariant(types.ModuleName, accumulatorSharesInvariantName,
+					fmt.Sprintf("\tpool id %d uptime accumulators retrieval failed: %s\n", poolId, err)), true
+			}
+
+			for _, accumulator := range append([]accum.AccumulatorObject{feeAccumulator}, uptimeAccumulators...) {
+				storedTotalShares, recomputedTotalShares, err := accumulator.RecomputeTotalShares(false, keeper.isOrphanedAccumulatorPosition(ctx))
+				if err != nil {
+					return sdk.FormatInvariant(types.ModuleName, accumulatorSharesInvariantName,
+						fmt.Sprintf("\taccumulator %s total shares recomputation failed: %s\n", accumulator.GetName(), err)), true
+				}
+
+				if storedTotalShares.Sub(recomputedTotalShares).Abs().GT(accumulatorSharesTolerance) {
+					return sdk.FormatInvariant(types.ModuleName, accumulatorSharesInvariantName,
+						fmt.Sprintf("\tconcentrated liquidity pool id %d accumulator %s\n\t stored total shares: %s\n\t sum of position shares: %s\n",
+							poolId, accumulator.GetName(), storedTotalShares, recomputedTotalShares)), true
+				}
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, accumulatorSharesInvariantName,
+			"\tall concentrated liquidity accumulator total shares match their positions\n"), false
+	}
+}
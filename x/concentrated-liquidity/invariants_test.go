@@ -0,0 +1,68 @@
+package concentrated_liquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/osmoutils/accum"
+	cl "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestPositionLiquidityMatchesPoolBalance() {
+	s.Setup()
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	s.SetupDefaultPosition(pool.GetId())
+
+	invariant := cl.PositionLiquidityMatchesPoolBalance(*s.App.ConcentratedLiquidityKeeper)
+
+	// Pool balance covers all positions.
+	_, broken := invariant(s.Ctx)
+	s.Require().False(broken)
+
+	// Drain the pool of token0 so that it no longer covers its positions.
+	poolBalance := s.App.BankKeeper.GetBalance(s.Ctx, pool.GetAddress(), pool.GetToken0())
+	err := s.App.BankKeeper.SendCoins(s.Ctx, pool.GetAddress(), s.TestAccs[1], sdk.NewCoins(poolBalance))
+	s.Require().NoError(err)
+
+	_, broken = invariant(s.Ctx)
+	s.Require().True(broken)
+}
+
+func (s *KeeperTestSuite) TestAccumulatorTotalSharesMatchPositions() {
+	s.Setup()
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[1])
+
+	invariant := cl.AccumulatorTotalSharesMatchPositions(*s.App.ConcentratedLiquidityKeeper)
+
+	// Accumulator total shares match their positions.
+	_, broken := invariant(s.Ctx)
+	s.Require().False(broken)
+
+	// Overwrite the stored total shares of the fee accumulator so that they drift from its positions.
+	feeAccumulator, err := s.App.ConcentratedLiquidityKeeper.GetFeeAccumulator(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	totalShares, err := feeAccumulator.GetTotalShares()
+	s.Require().NoError(err)
+	accumKey := []byte("accum/acc/" + types.KeyFeePoolAccumulator(pool.GetId()))
+	osmoutils.MustSet(s.Ctx.KVStore(s.App.GetKey(types.StoreKey)), accumKey, &accum.AccumulatorContent{
+		AccumValue:    feeAccumulator.GetValue(),
+		TotalShares:   totalShares.Add(sdk.OneDec()),
+		VirtualShares: sdk.ZeroDec(),
+	})
+
+	_, broken = invariant(s.Ctx)
+	s.Require().True(broken)
+
+	// Repairing the accumulator restores the invariant.
+	feeAccumulator, err = s.App.ConcentratedLiquidityKeeper.GetFeeAccumulator(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	_, _, err = feeAccumulator.RecomputeTotalShares(true, nil)
+	s.Require().NoError(err)
+
+	_, broken = invariant(s.Ctx)
+	s.Require().False(broken)
+}
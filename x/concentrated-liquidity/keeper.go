@@ -20,6 +20,7 @@ type Keeper struct {
 	// keepers
 	poolmanagerKeeper types.PoolManagerKeeper
 	bankKeeper        types.BankKeeper
+	authzKeeper       types.AuthzKeeper
 }
 
 func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, bankKeeper types.BankKeeper, paramSpace paramtypes.Subspace) *Keeper {
@@ -51,6 +52,11 @@ func (k *Keeper) SetPoolManagerKeeper(poolmanagerKeeper types.PoolManagerKeeper)
 	k.poolmanagerKeeper = poolmanagerKeeper
 }
 
+// Set the authz keeper.
+func (k *Keeper) SetAuthzKeeper(authzKeeper types.AuthzKeeper) {
+	k.authzKeeper = authzKeeper
+}
+
 // GetNextPositionId returns the next position id.
 func (k Keeper) GetNextPositionId(ctx sdk.Context) uint64 {
 	store := ctx.KVStore(k.storeKey)
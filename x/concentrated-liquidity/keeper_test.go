@@ -35,7 +35,8 @@ var (
 	DefaultJoinTime                                = time.Unix(0, 0)
 	ETH                                            = "eth"
 	DefaultAmt0                                    = sdk.NewInt(1000000)
-	DefaultAmt0Expected                            = sdk.NewInt(998976)
+	DefaultAmt0Expected                            = sdk.NewInt(998977) // 998976.618 rounded up in the pool's favor on deposit
+	DefaultAmt0WithdrawnExpected                   = sdk.NewInt(998976) // 998976.618 rounded down in the pool's favor on withdrawal
 	DefaultCoin0                                   = sdk.NewCoin(ETH, DefaultAmt0)
 	USDC                                           = "usdc"
 	DefaultAmt1                                    = sdk.NewInt(5000000000)
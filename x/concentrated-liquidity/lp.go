@@ -8,6 +8,9 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/osmoutils/accum"
 	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/internal/math"
 	types "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
 )
@@ -25,6 +28,15 @@ import (
 // - the amount0 or amount1 returned from the position update is less than the given minimums
 // - the pool or user does not have enough tokens to satisfy the requested amount
 func (k Keeper) createPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, amount0Desired, amount1Desired, amount0Min, amount1Min sdk.Int, lowerTick, upperTick int64) (uint64, sdk.Int, sdk.Int, sdk.Dec, time.Time, error) {
+	return k.createPositionForOwner(ctx, poolId, owner, owner, amount0Desired, amount1Desired, amount0Min, amount1Min, lowerTick, upperTick)
+}
+
+// createPositionForOwner creates a position exactly like createPosition, except that the tokens are provided
+// by sender while the position is owned by owner. Fees and incentives accrued by the position are therefore
+// collectable by owner only.
+// Returns error if sender and owner differ and owner has not granted sender an authz authorization
+// for MsgCreatePosition.
+func (k Keeper) createPositionForOwner(ctx sdk.Context, poolId uint64, sender, owner sdk.AccAddress, amount0Desired, amount1Desired, amount0Min, amount1Min sdk.Int, lowerTick, upperTick int64) (uint64, sdk.Int, sdk.Int, sdk.Dec, time.Time, error) {
 	// get current blockTime that user joins the position
 	joinTime := ctx.BlockTime()
 
@@ -33,6 +45,23 @@ func (k Keeper) createPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 	if err != nil {
 		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, err
 	}
+
+	if !sender.Equals(owner) {
+		// The owner authorizes the position as if it had sent the MsgCreatePosition itself.
+		authorizedMsg := &types.MsgCreatePosition{
+			PoolId:          poolId,
+			Sender:          owner.String(),
+			LowerTick:       lowerTick,
+			UpperTick:       upperTick,
+			TokenDesired0:   sdk.NewCoin(pool.GetToken0(), amount0Desired),
+			TokenDesired1:   sdk.NewCoin(pool.GetToken1(), amount1Desired),
+			TokenMinAmount0: amount0Min,
+			TokenMinAmount1: amount1Min,
+		}
+		if err := k.acceptPositionCreatorAuthorization(ctx, sender, owner, authorizedMsg); err != nil {
+			return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, err
+		}
+	}
 	// Check if the provided tick range is valid according to the pool's tick spacing and module parameters.
 	if err := validateTickRangeIsValid(pool.GetTickSpacing(), pool.GetExponentAtPriceOne(), lowerTick, upperTick); err != nil {
 		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, err
@@ -51,12 +80,15 @@ func (k Keeper) createPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 	cacheCtx, writeCacheCtx := ctx.CacheContext()
 	initialSqrtPrice := pool.GetCurrentSqrtPrice()
 	initialTick := pool.GetCurrentTick()
-	positionId := k.getNextPositionIdAndIncrement(ctx)
+
+	// The position id is only allocated once the tick range has been validated, and is allocated on the
+	// cache context so that it is not consumed if creating the position fails.
+	positionId := k.getNextPositionIdAndIncrement(cacheCtx)
 
 	// If the current square root price and current tick are zero, then this is the first position to be created for this pool.
 	// In this case, we calculate the square root price and current tick based on the inputs of this position.
 	if k.isInitialPositionForPool(initialSqrtPrice, initialTick) {
-		err := k.initializeInitialPositionForPool(cacheCtx, pool, amount0Desired, amount1Desired)
+		err := k.initializeInitialPositionForPool(cacheCtx, pool, positionId, amount0Desired, amount1Desired, sqrtPriceLowerTick, sqrtPriceUpperTick)
 		if err != nil {
 			return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, err
 		}
@@ -68,6 +100,12 @@ func (k Keeper) createPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, errors.New("liquidityDelta calculated equals zero")
 	}
 
+	// Deposits round the required amounts up, which may exceed the desired amounts by precision error.
+	liquidityDelta, err = liquidityWithinDesiredAmounts(cacheCtx, pool, lowerTick, upperTick, sqrtPriceLowerTick, sqrtPriceUpperTick, liquidityDelta, amount0Desired, amount1Desired)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, err
+	}
+
 	if err := k.initializeFeeAccumulatorPosition(cacheCtx, poolId, lowerTick, upperTick, positionId); err != nil {
 		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, err
 	}
@@ -78,6 +116,9 @@ func (k Keeper) createPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, err
 	}
 
+	// Record the price the position was created at so that it can later be compared against holding its deposit.
+	k.setPositionEntrySqrtPrice(cacheCtx, positionId, pool.GetCurrentSqrtPrice())
+
 	// Check if the actual amounts of tokens 0 and 1 are greater than or equal to the given minimum amounts.
 	if actualAmount0.LT(amount0Min) {
 		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, types.InsufficientLiquidityCreatedError{Actual: actualAmount0, Minimum: amount0Min, IsTokenZero: true}
@@ -86,8 +127,8 @@ func (k Keeper) createPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, types.InsufficientLiquidityCreatedError{Actual: actualAmount1, Minimum: amount1Min}
 	}
 
-	// Transfer the actual amounts of tokens 0 and 1 from the position owner to the pool.
-	err = k.sendCoinsBetweenPoolAndUser(cacheCtx, pool.GetToken0(), pool.GetToken1(), actualAmount0, actualAmount1, owner, pool.GetAddress())
+	// Transfer the actual amounts of tokens 0 and 1 from the sender to the pool.
+	err = k.sendCoinsBetweenPoolAndUser(cacheCtx, pool.GetToken0(), pool.GetToken1(), actualAmount0, actualAmount1, sender, pool.GetAddress())
 	if err != nil {
 		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, err
 	}
@@ -100,6 +141,67 @@ func (k Keeper) createPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 	return positionId, actualAmount0, actualAmount1, liquidityDelta, joinTime, nil
 }
 
+// liquidityWithinDesiredAmounts returns liquidityDelta if the amounts required to deposit it, rounded up in the pool's
+// favor, do not exceed the desired amounts. Otherwise, the liquidity is derived again from the desired amounts less
+// the excess, so that the position is charged the full rounded up amounts without taking more than was desired.
+// Returns DepositExceedsDesiredAmountError if the reduced liquidity still requires more than the desired amounts.
+func liquidityWithinDesiredAmounts(ctx sdk.Context, pool types.ConcentratedPoolExtension, lowerTick, upperTick int64, sqrtPriceLowerTick, sqrtPriceUpperTick, liquidityDelta sdk.Dec, amount0Desired, amount1Desired sdk.Int) (sdk.Dec, error) {
+	amount0, amount1 := pool.CalcActualAmounts(ctx, lowerTick, upperTick, sqrtPriceLowerTick, sqrtPriceUpperTick, liquidityDelta, osmomath.RoundUp)
+	excess0 := amount0.Ceil().TruncateInt().Sub(amount0Desired)
+	excess1 := amount1.Ceil().TruncateInt().Sub(amount1Desired)
+	if !excess0.IsPositive() && !excess1.IsPositive() {
+		return liquidityDelta, nil
+	}
+
+	reducedAmount0 := amount0Desired.Sub(sdk.MaxInt(excess0, sdk.ZeroInt()))
+	reducedAmount1 := amount1Desired.Sub(sdk.MaxInt(excess1, sdk.ZeroInt()))
+	liquidityDelta = math.GetLiquidityFromAmounts(pool.GetCurrentSqrtPrice(), sqrtPriceLowerTick, sqrtPriceUpperTick, reducedAmount0, reducedAmount1)
+
+	amount0, amount1 = pool.CalcActualAmounts(ctx, lowerTick, upperTick, sqrtPriceLowerTick, sqrtPriceUpperTick, liquidityDelta, osmomath.RoundUp)
+	if amount0.Ceil().TruncateInt().GT(amount0Desired) || amount1.Ceil().TruncateInt().GT(amount1Desired) || !liquidityDelta.IsPositive() {
+		return sdk.Dec{}, types.DepositExceedsDesiredAmountError{Amount0: amount0.Ceil().TruncateInt(), Amount1: amount1.Ceil().TruncateInt(), Amount0Desired: amount0Desired, Amount1Desired: amount1Desired}
+	}
+	return liquidityDelta, nil
+}
+
+// acceptPositionCreatorAuthorization checks that owner has granted sender an unexpired authz authorization
+// for MsgCreatePosition that accepts msg, allowing sender to create the position owned by owner.
+// The grant is updated or deleted as requested by the authorization, exactly as authz does when
+// executing a message, so that spend limits and one-shot grants are enforced.
+// Returns UnauthorizedPositionCreatorError if there is no such grant or it does not accept msg, and
+// the authorization's error if it fails to evaluate msg.
+func (k Keeper) acceptPositionCreatorAuthorization(ctx sdk.Context, sender, owner sdk.AccAddress, msg *types.MsgCreatePosition) error {
+	unauthorizedErr := types.UnauthorizedPositionCreatorError{Sender: sender.String(), Owner: owner.String()}
+	if k.authzKeeper == nil {
+		return unauthorizedErr
+	}
+
+	msgTypeURL := sdk.MsgTypeURL(msg)
+	authorization, expiration := k.authzKeeper.GetCleanAuthorization(ctx, sender, owner, msgTypeURL)
+	if authorization == nil {
+		return unauthorizedErr
+	}
+
+	resp, err := authorization.Accept(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	if resp.Delete {
+		err = k.authzKeeper.DeleteGrant(ctx, sender, owner, msgTypeURL)
+	} else if resp.Updated != nil {
+		err = k.authzKeeper.SaveGrant(ctx, sender, owner, resp.Updated, expiration)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !resp.Accept {
+		return unauthorizedErr
+	}
+	return nil
+}
+
 // withdrawPosition attempts to withdraw liquidityAmount from a position with the given pool id in the given tick range.
 // On success, returns a positive amount of each token withdrawn.
 // Returns error if
@@ -107,37 +209,112 @@ func (k Keeper) createPosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 // - if tick ranges are invalid
 // - if attempts to withdraw an amount higher than originally provided in createPosition for a given range.
 func (k Keeper) withdrawPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, requestedLiquidityAmountToWithdraw sdk.Dec) (amtDenom0, amtDenom1 sdk.Int, err error) {
-	position, err := k.GetPosition(ctx, positionId)
+	pool, amount0, amount1, err := k.withdrawPositionWithoutTransfer(ctx, owner, positionId, requestedLiquidityAmountToWithdraw)
 	if err != nil {
 		return sdk.Int{}, sdk.Int{}, err
 	}
 
+	// Transfer the actual amounts of tokens 0 and 1 from the pool to the position owner.
+	err = k.sendCoinsFromPoolToUser(ctx, pool, amount0, amount1, owner)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	return amount0, amount1, nil
+}
+
+// withdrawPositions withdraws liquidity from each of the given positions on behalf of owner.
+// Every position must be owned by owner. Fees and incentives are collected as in withdrawPosition.
+// Rather than transferring tokens once per position, the withdrawn amounts are aggregated and sent
+// to the owner with a single bank send per pool, which fails with PoolInsufficientBalanceError like
+// withdrawPosition if the pool does not hold them.
+// The withdrawals are atomic: if any of them fails, none of them are persisted.
+// On success, returns the total amount of tokens withdrawn.
+func (k Keeper) withdrawPositions(ctx sdk.Context, owner sdk.AccAddress, withdrawals []types.PositionWithdrawal) (sdk.Coins, error) {
+	// Create a cache context so that the withdrawals are only persisted if all of them succeed.
+	cacheCtx, writeCacheCtx := ctx.CacheContext()
+
+	// Pool ids are tracked in order of first appearance so that bank sends are deterministic.
+	poolIds := []uint64{}
+	pools := map[uint64]types.ConcentratedPoolExtension{}
+	amount0ByPool := map[uint64]sdk.Int{}
+	amount1ByPool := map[uint64]sdk.Int{}
+
+	for _, withdrawal := range withdrawals {
+		position, err := k.GetPosition(cacheCtx, withdrawal.PositionId)
+		if err != nil {
+			return nil, err
+		}
+
+		if position.Address != owner.String() {
+			return nil, types.NotPositionOwnerError{PositionId: withdrawal.PositionId, Address: owner.String()}
+		}
+
+		pool, amount0, amount1, err := k.withdrawPositionWithoutTransfer(cacheCtx, owner, withdrawal.PositionId, withdrawal.LiquidityAmount)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := pools[pool.GetId()]; !ok {
+			poolIds = append(poolIds, pool.GetId())
+			pools[pool.GetId()] = pool
+			amount0ByPool[pool.GetId()] = sdk.ZeroInt()
+			amount1ByPool[pool.GetId()] = sdk.ZeroInt()
+		}
+		amount0ByPool[pool.GetId()] = amount0ByPool[pool.GetId()].Add(amount0)
+		amount1ByPool[pool.GetId()] = amount1ByPool[pool.GetId()].Add(amount1)
+	}
+
+	totalWithdrawn := sdk.NewCoins()
+	for _, poolId := range poolIds {
+		pool, amount0, amount1 := pools[poolId], amount0ByPool[poolId], amount1ByPool[poolId]
+		if err := k.sendCoinsFromPoolToUser(cacheCtx, pool, amount0, amount1, owner); err != nil {
+			return nil, err
+		}
+		totalWithdrawn = totalWithdrawn.Add(sdk.NewCoin(pool.GetToken0(), amount0), sdk.NewCoin(pool.GetToken1(), amount1))
+	}
+
+	writeCacheCtx()
+
+	return totalWithdrawn, nil
+}
+
+// withdrawPositionWithoutTransfer updates state to withdraw liquidityAmount from the position with the given id,
+// collecting fees and incentives as needed, but does not transfer the withdrawn tokens to the owner.
+// Returns the position's pool and the positive amount of each token that must be sent from the pool to the owner.
+// Errors under the same conditions as withdrawPosition.
+func (k Keeper) withdrawPositionWithoutTransfer(ctx sdk.Context, owner sdk.AccAddress, positionId uint64, requestedLiquidityAmountToWithdraw sdk.Dec) (types.ConcentratedPoolExtension, sdk.Int, sdk.Int, error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return nil, sdk.Int{}, sdk.Int{}, err
+	}
+
 	// Retrieve the pool associated with the given pool ID.
 	pool, err := k.getPoolById(ctx, position.PoolId)
 	if err != nil {
-		return sdk.Int{}, sdk.Int{}, err
+		return nil, sdk.Int{}, sdk.Int{}, err
 	}
 
 	// Check if the provided tick range is valid according to the pool's tick spacing and module parameters.
 	if err := validateTickRangeIsValid(pool.GetTickSpacing(), pool.GetExponentAtPriceOne(), position.LowerTick, position.UpperTick); err != nil {
-		return sdk.Int{}, sdk.Int{}, err
+		return nil, sdk.Int{}, sdk.Int{}, err
 	}
 
 	// Retrieve the position in the pool for the provided owner and tick range.
 	availableLiquidity, err := k.GetPositionLiquidity(ctx, positionId)
 	if err != nil {
-		return sdk.Int{}, sdk.Int{}, err
+		return nil, sdk.Int{}, sdk.Int{}, err
 	}
 
-	_, err = k.collectIncentives(ctx, owner, positionId)
+	incentivesCollected, incentivesForfeited, err := k.collectIncentives(ctx, owner, positionId)
 	if err != nil {
-		return sdk.Int{}, sdk.Int{}, err
+		return nil, sdk.Int{}, sdk.Int{}, err
 	}
 
 	// Check if the requested liquidity amount to withdraw is less than or equal to the available liquidity for the position.
 	// If it is greater than the available liquidity, return an error.
 	if requestedLiquidityAmountToWithdraw.GT(availableLiquidity) {
-		return sdk.Int{}, sdk.Int{}, types.InsufficientLiquidityError{Actual: requestedLiquidityAmountToWithdraw, Available: availableLiquidity}
+		return nil, sdk.Int{}, sdk.Int{}, types.InsufficientLiquidityError{Actual: requestedLiquidityAmountToWithdraw, Available: availableLiquidity}
 	}
 
 	// Calculate the change in liquidity for the pool based on the requested amount to withdraw.
@@ -147,35 +324,303 @@ func (k Keeper) withdrawPosition(ctx sdk.Context, owner sdk.AccAddress, position
 	// Update the position in the pool based on the provided tick range and liquidity delta.
 	actualAmount0, actualAmount1, err := k.updatePosition(ctx, position.PoolId, owner, position.LowerTick, position.UpperTick, liquidityDelta, position.JoinTime, positionId)
 	if err != nil {
-		return sdk.Int{}, sdk.Int{}, err
-	}
-
-	// Transfer the actual amounts of tokens 0 and 1 from the pool to the position owner.
-	err = k.sendCoinsBetweenPoolAndUser(ctx, pool.GetToken0(), pool.GetToken1(), actualAmount0.Abs(), actualAmount1.Abs(), pool.GetAddress(), owner)
-	if err != nil {
-		return sdk.Int{}, sdk.Int{}, err
+		return nil, sdk.Int{}, sdk.Int{}, err
 	}
 
 	// If the requested liquidity amount to withdraw is equal to the available liquidity, delete the position from state.
 	// Ensure we collect any outstanding fees and incentives prior to deleting the position from state. This claiming
 	// process also clears position records from fee and incentive accumulators.
+	// The collected fees and incentives are reported in the withdraw event so that it reflects the full value withdrawn.
+	feesCollected := sdk.NewCoins()
 	if requestedLiquidityAmountToWithdraw.Equal(availableLiquidity) {
-		if _, err := k.collectFees(ctx, owner, positionId); err != nil {
-			return sdk.Int{}, sdk.Int{}, err
+		feesCollected, err = k.collectFees(ctx, owner, positionId)
+		if err != nil {
+			return nil, sdk.Int{}, sdk.Int{}, err
 		}
 
-		if _, err := k.collectIncentives(ctx, owner, positionId); err != nil {
-			return sdk.Int{}, sdk.Int{}, err
+		finalIncentivesCollected, finalIncentivesForfeited, err := k.collectIncentives(ctx, owner, positionId)
+		if err != nil {
+			return nil, sdk.Int{}, sdk.Int{}, err
 		}
+		incentivesCollected = incentivesCollected.Add(finalIncentivesCollected...)
+		incentivesForfeited = incentivesForfeited.Add(finalIncentivesForfeited...)
 
 		if err := k.deletePosition(ctx, positionId, owner, position.PoolId); err != nil {
+			return nil, sdk.Int{}, sdk.Int{}, err
+		}
+
+		// Now that the position's rewards are collected, remove its ticks if no other position references them.
+		if err := k.removeTickIfEmpty(ctx, position.PoolId, position.LowerTick); err != nil {
+			return nil, sdk.Int{}, sdk.Int{}, err
+		}
+		if err := k.removeTickIfEmpty(ctx, position.PoolId, position.UpperTick); err != nil {
+			return nil, sdk.Int{}, sdk.Int{}, err
+		}
+	}
+
+	// Withdrawing before the pool's minimum hold duration has elapsed is charged the early exit fee.
+	// The fee remains in the pool and is distributed to the remaining in-range liquidity.
+	earlyExitFee0, earlyExitFee1, err := k.chargeEarlyExitFee(ctx, position.PoolId, position.JoinTime, actualAmount0.Neg(), actualAmount1.Neg())
+	if err != nil {
+		return nil, sdk.Int{}, sdk.Int{}, err
+	}
+
+	emitLiquidityChangeEvent(ctx, types.TypeEvtWithdrawPosition, positionId, owner, position.PoolId, position.LowerTick, position.UpperTick, position.JoinTime, liquidityDelta, actualAmount0, actualAmount1,
+		sdk.NewAttribute(types.AttributeEarlyExitFee0, earlyExitFee0.String()),
+		sdk.NewAttribute(types.AttributeEarlyExitFee1, earlyExitFee1.String()),
+		sdk.NewAttribute(types.AttributeFeesCollected, feesCollected.String()),
+		sdk.NewAttribute(types.AttributeIncentivesCollected, incentivesCollected.String()),
+		sdk.NewAttribute(types.AttributeForfeitedIncentives, incentivesForfeited.String()),
+	)
+
+	return pool, actualAmount0.Neg().Sub(earlyExitFee0), actualAmount1.Neg().Sub(earlyExitFee1), nil
+}
+
+// chargeEarlyExitFee computes the early exit fee owed on the given withdrawn amounts of a position
+// joined at joinTime. The fee is only charged if the pool has a positive early exit fee and less than
+// the pool's minimum hold duration has elapsed since joinTime. The charged amounts are added to the pool's
+// fee accumulator so that they are claimable by the remaining in-range liquidity.
+// If there is no remaining in-range liquidity to distribute the fee to, no fee is charged.
+// Returns the amount of each token charged.
+func (k Keeper) chargeEarlyExitFee(ctx sdk.Context, poolId uint64, joinTime time.Time, amount0, amount1 sdk.Int) (sdk.Int, sdk.Int, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	earlyExitFee := pool.GetEarlyExitFee()
+	if !earlyExitFee.IsPositive() || ctx.BlockTime().Sub(joinTime) >= pool.GetMinHoldDuration() {
+		return sdk.ZeroInt(), sdk.ZeroInt(), nil
+	}
+
+	// The pool's liquidity has already been updated to exclude the withdrawn liquidity.
+	remainingLiquidity := pool.GetLiquidity()
+	if !remainingLiquidity.IsPositive() {
+		return sdk.ZeroInt(), sdk.ZeroInt(), nil
+	}
+
+	fee0 := amount0.ToDec().Mul(earlyExitFee).TruncateInt()
+	fee1 := amount1.ToDec().Mul(earlyExitFee).TruncateInt()
+
+	for _, fee := range []sdk.Coin{sdk.NewCoin(pool.GetToken0(), fee0), sdk.NewCoin(pool.GetToken1(), fee1)} {
+		if !fee.IsPositive() {
+			continue
+		}
+		feeGrowth := fee.Amount.ToDec().QuoTruncate(remainingLiquidity)
+		if err := k.chargeFee(ctx, poolId, sdk.NewDecCoinFromDec(fee.Denom, feeGrowth)); err != nil {
 			return sdk.Int{}, sdk.Int{}, err
 		}
 	}
 
-	emitLiquidityChangeEvent(ctx, types.TypeEvtWithdrawPosition, positionId, owner, position.PoolId, position.LowerTick, position.UpperTick, position.JoinTime, liquidityDelta, actualAmount0, actualAmount1)
+	return fee0, fee1, nil
+}
+
+// emergencyWithdrawPosition withdraws all of the liquidity from the position with the given id and sends the
+// principal to the owner without collecting the position's fees or incentives. Any unclaimed fees and incentives
+// are forfeited. It is only available while governance has enabled the EmergencyWithdrawEnabled param, so that
+// funds are never trapped behind a faulty fee or incentive accumulator.
+//
+// The forfeited amounts are estimated on a best-effort basis and recorded in an emergency withdraw event.
+// On success, returns the amount of each token withdrawn.
+// Returns error if:
+// - emergency withdrawals are disabled
+// - the position does not exist or is not owned by owner
+// - the pool does not exist
+func (k Keeper) emergencyWithdrawPosition(ctx sdk.Context, owner sdk.AccAddress, positionId uint64) (amtDenom0, amtDenom1 sdk.Int, err error) {
+	if !k.GetParams(ctx).EmergencyWithdrawEnabled {
+		return sdk.Int{}, sdk.Int{}, types.EmergencyWithdrawDisabledError{}
+	}
+
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	if position.Address != owner.String() {
+		return sdk.Int{}, sdk.Int{}, types.NotPositionOwnerError{PositionId: positionId, Address: owner.String()}
+	}
+
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	// Estimate the rewards being forfeited. Since the accumulators may be in a bad state,
+	// failures here are tolerated and simply leave the estimate empty.
+	forfeitedFees := sdk.Coins{}
+	_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+		fees, err := k.queryClaimableFees(cacheCtx, positionId)
+		if err != nil {
+			return err
+		}
+		forfeitedFees = fees
+		return nil
+	})
+
+	forfeitedIncentives := sdk.Coins{}
+	_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+		claimable, forfeited, err := k.queryClaimableIncentives(cacheCtx, positionId)
+		if err != nil {
+			return err
+		}
+		forfeitedIncentives = claimable.Add(forfeited...)
+		return nil
+	})
+
+	liquidityDelta := position.Liquidity.Neg()
+	currentTick := pool.GetCurrentTick().Int64()
+
+	// The position's ticks are already initialized with non-zero gross liquidity,
+	// so updating them does not read from the fee or uptime accumulators.
+	if err := k.initOrUpdateTick(ctx, position.PoolId, currentTick, position.LowerTick, liquidityDelta, false); err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	if err := k.initOrUpdateTick(ctx, position.PoolId, currentTick, position.UpperTick, liquidityDelta, true); err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	sqrtPriceLowerTick, sqrtPriceUpperTick, err := math.TicksToSqrtPrice(position.LowerTick, position.UpperTick, pool.GetExponentAtPriceOne())
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	// Withdrawals round down so that the pool never pays out more than the position is entitled to.
+	actualAmount0, actualAmount1 := pool.CalcActualAmounts(ctx, position.LowerTick, position.UpperTick, sqrtPriceLowerTick, sqrtPriceUpperTick, liquidityDelta, osmomath.RoundDown)
+
+	pool.UpdateLiquidityIfActivePosition(ctx, position.LowerTick, position.UpperTick, liquidityDelta)
+
+	if err := k.setPool(ctx, pool); err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	// Remove the position's shares from the fee and uptime accumulators so that they no longer dilute
+	// other positions. Failures are tolerated so that a faulty accumulator cannot block the withdrawal.
+	accumulatorRemovalErr := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+		return k.removePositionFromAccumulators(cacheCtx, position.PoolId, positionId)
+	})
+
+	if err := k.deletePosition(ctx, positionId, owner, position.PoolId); err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	// Remove the position's ticks if no other position references them.
+	if err := k.removeTickIfEmpty(ctx, position.PoolId, position.LowerTick); err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+	if err := k.removeTickIfEmpty(ctx, position.PoolId, position.UpperTick); err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	// If the shares could not be removed, the position's accumulator records are now orphaned.
+	// Sweep them out of the pool's accumulators instead, which does not require their rewards to be computable.
+	if accumulatorRemovalErr != nil {
+		ctx.Logger().Error(fmt.Sprintf("emergency withdraw failed to remove position id %d from the accumulators of pool id %d: %s", positionId, position.PoolId, accumulatorRemovalErr))
+		_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			return k.sweepOrphanedAccumulatorPositions(cacheCtx, position.PoolId)
+		})
+	}
+
+	// The amounts have already been rounded down, so truncation is exact.
+	amount0 := actualAmount0.TruncateInt().Neg()
+	amount1 := actualAmount1.TruncateInt().Neg()
+
+	err = k.sendCoinsFromPoolToUser(ctx, pool, amount0, amount1, owner)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+		sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(position.PoolId, 10)),
+		sdk.NewAttribute(types.AttributeLiquidity, liquidityDelta.String()),
+		sdk.NewAttribute(types.AttributeAmount0, amount0.String()),
+		sdk.NewAttribute(types.AttributeAmount1, amount1.String()),
+		sdk.NewAttribute(types.AttributeForfeitedFees, forfeitedFees.String()),
+		sdk.NewAttribute(types.AttributeForfeitedIncentives, forfeitedIncentives.String()),
+	}
+	if accumulatorRemovalErr != nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeAccumRemovalError, accumulatorRemovalErr.Error()))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.TypeEvtEmergencyWithdraw, attributes...))
+
+	return amount0, amount1, nil
+}
+
+// removePositionFromAccumulators removes all of the position's shares from the pool's fee and uptime
+// accumulators without claiming. Any rewards still recorded on the position are left unclaimable.
+func (k Keeper) removePositionFromAccumulators(ctx sdk.Context, poolId uint64, positionId uint64) error {
+	feeAccumulator, err := k.getFeeAccumulator(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	if err := removeAllSharesFromAccumulator(feeAccumulator, types.KeyFeePositionAccumulator(positionId)); err != nil {
+		return err
+	}
+
+	uptimeAccumulators, err := k.getUptimeAccumulators(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	positionName := string(types.KeyPositionId(positionId))
+	for _, uptimeAccum := range uptimeAccumulators {
+		if err := removeAllSharesFromAccumulator(uptimeAccum, positionName); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sweepOrphanedAccumulatorPositions deletes the records of positions that no longer exist from the pool's
+// fee and uptime accumulators and recomputes the accumulators' total shares from the remaining positions.
+// Unlike removePositionFromAccumulators, it never computes rewards, so it succeeds even if an orphaned
+// record can no longer be claimed from. It iterates over every position of the pool's accumulators.
+func (k Keeper) sweepOrphanedAccumulatorPositions(ctx sdk.Context, poolId uint64) error {
+	feeAccumulator, err := k.getFeeAccumulator(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	uptimeAccumulators, err := k.getUptimeAccumulators(ctx, poolId)
+	if err != nil {
+		return err
+	}
 
-	return actualAmount0.Neg(), actualAmount1.Neg(), nil
+	isOrphaned := k.isOrphanedAccumulatorPosition(ctx)
+	for _, accumulator := range append([]accum.AccumulatorObject{feeAccumulator}, uptimeAccumulators...) {
+		if _, _, err := accumulator.RecomputeTotalShares(true, isOrphaned); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeAllSharesFromAccumulator removes all shares of the given position from the accumulator.
+// It is a no-op if the accumulator has no record of the position or the position has no shares.
+func removeAllSharesFromAccumulator(accumulator accum.AccumulatorObject, positionKey string) error {
+	hasPosition, err := accumulator.HasPosition(positionKey)
+	if err != nil {
+		return err
+	}
+	if !hasPosition {
+		return nil
+	}
+
+	numShares, err := accumulator.GetPositionSize(positionKey)
+	if err != nil {
+		return err
+	}
+	if !numShares.IsPositive() {
+		return nil
+	}
+
+	return accumulator.RemoveFromPosition(positionKey, numShares)
 }
 
 // updatePosition updates the position in the given pool id and in the given tick range and liquidityAmount.
@@ -194,20 +639,17 @@ func (k Keeper) updatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 	currentTick := pool.GetCurrentTick().Int64()
 
 	// update tickInfo state
-	// TODO: come back to sdk.Int vs sdk.Dec state & truncation
 	err = k.initOrUpdateTick(ctx, poolId, currentTick, lowerTick, liquidityDelta, false)
 	if err != nil {
 		return sdk.Int{}, sdk.Int{}, err
 	}
 
-	// TODO: come back to sdk.Int vs sdk.Dec state & truncation
 	err = k.initOrUpdateTick(ctx, poolId, currentTick, upperTick, liquidityDelta, true)
 	if err != nil {
 		return sdk.Int{}, sdk.Int{}, err
 	}
 
 	// update position state
-	// TODO: come back to sdk.Int vs sdk.Dec state & truncation
 	err = k.initOrUpdatePosition(ctx, poolId, owner, lowerTick, upperTick, liquidityDelta, joinTime, positionId)
 	if err != nil {
 		return sdk.Int{}, sdk.Int{}, err
@@ -219,10 +661,13 @@ func (k Keeper) updatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 		return sdk.Int{}, sdk.Int{}, err
 	}
 
-	actualAmount0, actualAmount1 := pool.CalcActualAmounts(ctx, lowerTick, upperTick, sqrtPriceLowerTick, sqrtPriceUpperTick, liquidityDelta)
-	if err != nil {
-		return sdk.Int{}, sdk.Int{}, err
+	// Deposits round the required input up and withdrawals round the output down so that rounding always
+	// favors the pool.
+	roundingDir := osmomath.RoundUp
+	if liquidityDelta.IsNegative() {
+		roundingDir = osmomath.RoundDown
 	}
+	actualAmount0, actualAmount1 := pool.CalcActualAmounts(ctx, lowerTick, upperTick, sqrtPriceLowerTick, sqrtPriceUpperTick, liquidityDelta, roundingDir)
 
 	pool.UpdateLiquidityIfActivePosition(ctx, lowerTick, upperTick, liquidityDelta)
 
@@ -235,7 +680,7 @@ func (k Keeper) updatePosition(ctx sdk.Context, poolId uint64, owner sdk.AccAddr
 		return sdk.Int{}, sdk.Int{}, err
 	}
 
-	// The returned amounts are rounded down to avoid returning more to clients than they actually deposited.
+	// The amounts have already been rounded in the pool's favor, so truncation is exact.
 	return actualAmount0.TruncateInt(), actualAmount1.TruncateInt(), nil
 }
 
@@ -257,6 +702,23 @@ func (k Keeper) sendCoinsBetweenPoolAndUser(ctx sdk.Context, denom0, denom1 stri
 	return nil
 }
 
+// sendCoinsFromPoolToUser sends the amounts calculated from an exit position from the pool to the user.
+// If the send fails because the pool account holds less than the amounts owed, which means that the pool's
+// accounting is broken, PoolInsufficientBalanceError is returned instead of the bank error.
+func (k Keeper) sendCoinsFromPoolToUser(ctx sdk.Context, pool types.ConcentratedPoolExtension, amount0, amount1 sdk.Int, receiver sdk.AccAddress) error {
+	err := k.sendCoinsBetweenPoolAndUser(ctx, pool.GetToken0(), pool.GetToken1(), amount0, amount1, pool.GetAddress(), receiver)
+	if err == nil {
+		return nil
+	}
+
+	requested := sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), amount0), sdk.NewCoin(pool.GetToken1(), amount1))
+	poolBalance := k.bankKeeper.GetAllBalances(ctx, pool.GetAddress())
+	if !poolBalance.IsAllGTE(requested) {
+		return types.PoolInsufficientBalanceError{PoolId: pool.GetId(), Requested: requested, Balance: poolBalance}
+	}
+	return err
+}
+
 // isInitialPositionForPool checks if the initial sqrtPrice and initial tick are equal to zero.
 // If so, this is the first position to be created for this pool, and we return true.
 // If not, we return false.
@@ -267,21 +729,230 @@ func (k Keeper) isInitialPositionForPool(initialSqrtPrice sdk.Dec, initialTick s
 	return false
 }
 
+// requiredAmountForDeposit returns the amount of the pool's other denom that must be deposited alongside the given
+// amount of the known denom to create a position in the given tick range at the pool's current price, as well as
+// the liquidity such a position would hold. The other amount is rounded up so that depositing it alongside the
+// known amount is always sufficient.
+// Returns error if:
+// - the pool does not exist or has no price yet
+// - the known amount is not positive or the known denom is not one of the pool's assets
+// - the tick range is invalid
+// - the range does not hold any of the known denom at the current price
+func (k Keeper) requiredAmountForDeposit(ctx sdk.Context, poolId uint64, lowerTick, upperTick int64, knownDenom string, knownAmount sdk.Int) (otherAmount sdk.Int, liquidity sdk.Dec, err error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, sdk.Dec{}, err
+	}
+
+	if !knownAmount.IsPositive() {
+		return sdk.Int{}, sdk.Dec{}, types.NotPositiveRequireAmountError{Amount: knownAmount.String()}
+	}
+
+	isKnownToken0 := knownDenom == pool.GetToken0()
+	if !isKnownToken0 && knownDenom != pool.GetToken1() {
+		return sdk.Int{}, sdk.Dec{}, types.DenomNotInPoolError{PoolId: poolId, Denom: knownDenom}
+	}
+
+	// The deposit ratio is derived from the current price, which is only set once the first position is created.
+	currentSqrtPrice := pool.GetCurrentSqrtPrice()
+	if k.isInitialPositionForPool(currentSqrtPrice, pool.GetCurrentTick()) {
+		return sdk.Int{}, sdk.Dec{}, types.PoolNotInitializedError{PoolId: poolId}
+	}
+
+	if err := validateTickRangeIsValid(pool.GetTickSpacing(), pool.GetExponentAtPriceOne(), lowerTick, upperTick); err != nil {
+		return sdk.Int{}, sdk.Dec{}, err
+	}
+
+	sqrtPriceLowerTick, sqrtPriceUpperTick, err := math.TicksToSqrtPrice(lowerTick, upperTick, pool.GetExponentAtPriceOne())
+	if err != nil {
+		return sdk.Int{}, sdk.Dec{}, err
+	}
+
+	// Derive the liquidity from the known amount alone. A range below the current price holds only asset1
+	// and a range above it holds only asset0, in which case the known denom must be the one held.
+	currentTick := pool.GetCurrentTick()
+	switch {
+	case currentTick.LT(sdk.NewInt(lowerTick)):
+		if !isKnownToken0 {
+			return sdk.Int{}, sdk.Dec{}, types.DenomNotDepositedInRangeError{Denom: knownDenom, LowerTick: lowerTick, UpperTick: upperTick}
+		}
+		liquidity = math.Liquidity0(knownAmount, sqrtPriceLowerTick, sqrtPriceUpperTick)
+	case currentTick.GTE(sdk.NewInt(upperTick)):
+		if isKnownToken0 {
+			return sdk.Int{}, sdk.Dec{}, types.DenomNotDepositedInRangeError{Denom: knownDenom, LowerTick: lowerTick, UpperTick: upperTick}
+		}
+		liquidity = math.Liquidity1(knownAmount, sqrtPriceLowerTick, sqrtPriceUpperTick)
+	case isKnownToken0:
+		liquidity = math.Liquidity0(knownAmount, currentSqrtPrice, sqrtPriceUpperTick)
+	default:
+		liquidity = math.Liquidity1(knownAmount, currentSqrtPrice, sqrtPriceLowerTick)
+	}
+
+	amount0, amount1 := pool.CalcActualAmounts(ctx, lowerTick, upperTick, sqrtPriceLowerTick, sqrtPriceUpperTick, liquidity, osmomath.RoundUp)
+	if isKnownToken0 {
+		return amount1.Ceil().TruncateInt(), liquidity, nil
+	}
+	return amount0.Ceil().TruncateInt(), liquidity, nil
+}
+
+// createPositionSingleAsset creates a position in the given tick range from tokenIn alone. The portion of tokenIn
+// that the range requires in the pool's other asset is first swapped through the same pool, and the position is
+// then created from the remainder of tokenIn and the proceeds of the swap. Whatever the position cannot absorb,
+// due to rounding and the swap's price impact, is left with the owner and returned as dust.
+// The swap must produce at least (1 - maxSlippage) of the amount expected at the pool's spot price net of the swap fee.
+// Returns error if:
+// - the pool does not exist or has no price yet
+// - tokenIn is not one of the pool's assets
+// - the swap produces less than the slippage bound allows
+// - the position cannot be created from the resulting amounts
+func (k Keeper) createPositionSingleAsset(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64, tokenIn sdk.Coin, maxSlippage sdk.Dec) (positionId uint64, actualAmount0, actualAmount1 sdk.Int, liquidityCreated sdk.Dec, joinTime time.Time, swappedIn, swappedOut sdk.Coin, dust sdk.Coins, err error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, sdk.Coin{}, sdk.Coin{}, nil, err
+	}
+
+	isTokenInToken0 := tokenIn.Denom == pool.GetToken0()
+	tokenOutDenom := pool.GetToken1()
+	if !isTokenInToken0 {
+		if tokenIn.Denom != pool.GetToken1() {
+			return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, sdk.Coin{}, sdk.Coin{}, nil, types.DenomNotInPoolError{PoolId: poolId, Denom: tokenIn.Denom}
+		}
+		tokenOutDenom = pool.GetToken0()
+	}
+
+	// The deposit ratio is derived from the current price, which is only set once the first position is created.
+	if k.isInitialPositionForPool(pool.GetCurrentSqrtPrice(), pool.GetCurrentTick()) {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, sdk.Coin{}, sdk.Coin{}, nil, types.PoolNotInitializedError{PoolId: poolId}
+	}
+
+	if err := validateTickRangeIsValid(pool.GetTickSpacing(), pool.GetExponentAtPriceOne(), lowerTick, upperTick); err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, sdk.Coin{}, sdk.Coin{}, nil, err
+	}
+
+	swapAmount, spotRate, err := k.singleAssetDepositSwapAmount(ctx, pool, lowerTick, upperTick, tokenIn, tokenOutDenom)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, sdk.Coin{}, sdk.Coin{}, nil, err
+	}
+
+	swappedIn = sdk.NewCoin(tokenIn.Denom, swapAmount)
+	swappedOut = sdk.NewCoin(tokenOutDenom, sdk.ZeroInt())
+	if swapAmount.IsPositive() {
+		tokenOutMinAmount := spotRate.MulInt(swapAmount).Mul(sdk.OneDec().Sub(maxSlippage)).TruncateInt()
+		tokenOutAmount, err := k.SwapExactAmountIn(ctx, owner, pool, swappedIn, tokenOutDenom, tokenOutMinAmount, pool.GetSwapFee(ctx))
+		if err != nil {
+			return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, sdk.Coin{}, sdk.Coin{}, nil, err
+		}
+		swappedOut.Amount = tokenOutAmount
+	}
+
+	amount0, amount1 := tokenIn.Amount.Sub(swapAmount), swappedOut.Amount
+	if !isTokenInToken0 {
+		amount0, amount1 = amount1, amount0
+	}
+
+	positionId, actualAmount0, actualAmount1, liquidityCreated, joinTime, err = k.createPosition(ctx, poolId, owner, amount0, amount1, sdk.ZeroInt(), sdk.ZeroInt(), lowerTick, upperTick)
+	if err != nil {
+		return 0, sdk.Int{}, sdk.Int{}, sdk.Dec{}, time.Time{}, sdk.Coin{}, sdk.Coin{}, nil, err
+	}
+
+	dust = sdk.NewCoins(
+		sdk.NewCoin(pool.GetToken0(), amount0.Sub(actualAmount0)),
+		sdk.NewCoin(pool.GetToken1(), amount1.Sub(actualAmount1)),
+	)
+
+	return positionId, actualAmount0, actualAmount1, liquidityCreated, joinTime, swappedIn, swappedOut, dust, nil
+}
+
+// singleAssetDepositSwapAmount returns the amount of tokenIn to swap for tokenOutDenom so that the remainder of
+// tokenIn and the proceeds of the swap are in the ratio the given tick range requires at the price after the swap.
+// It also returns the rate, net of the swap fee, at which tokenIn exchanges for tokenOutDenom at the current spot price.
+// The amount is first estimated at the spot price and then re-estimated against the simulated outcome of swapping it,
+// which accounts for the swap's price impact.
+func (k Keeper) singleAssetDepositSwapAmount(ctx sdk.Context, pool types.ConcentratedPoolExtension, lowerTick, upperTick int64, tokenIn sdk.Coin, tokenOutDenom string) (swapAmount sdk.Int, spotRate sdk.Dec, err error) {
+	sqrtPriceLowerTick, sqrtPriceUpperTick, err := math.TicksToSqrtPrice(lowerTick, upperTick, pool.GetExponentAtPriceOne())
+	if err != nil {
+		return sdk.Int{}, sdk.Dec{}, err
+	}
+
+	isTokenInToken0 := tokenIn.Denom == pool.GetToken0()
+	swapFee := pool.GetSwapFee(ctx)
+	sqrtPrice := pool.GetCurrentSqrtPrice()
+
+	// The spot price is quoted in asset1 per asset0, so it is inverted when swapping asset1 in.
+	spotRate = sdk.OneDec().Sub(swapFee)
+	if isTokenInToken0 {
+		spotRate = spotRate.Mul(sqrtPrice).Mul(sqrtPrice)
+	} else {
+		spotRate = spotRate.Quo(sqrtPrice).Quo(sqrtPrice)
+	}
+
+	rate := spotRate
+	for i := 0; ; i++ {
+		// Clamping the price to the range makes a range entirely above or below it hold a single asset.
+		clampedSqrtPrice := sdk.MinDec(sdk.MaxDec(sqrtPrice, sqrtPriceLowerTick), sqrtPriceUpperTick)
+		amount0PerLiquidity := math.CalcAmount0Delta(sdk.OneDec(), clampedSqrtPrice, sqrtPriceUpperTick, false)
+		amount1PerLiquidity := math.CalcAmount1Delta(sdk.OneDec(), sqrtPriceLowerTick, clampedSqrtPrice, false)
+		inPerLiquidity, outPerLiquidity := amount0PerLiquidity, amount1PerLiquidity
+		if !isTokenInToken0 {
+			inPerLiquidity, outPerLiquidity = outPerLiquidity, inPerLiquidity
+		}
+
+		// Swapping s leaves (tokenIn - s) of the input asset and s * rate of the other, which are in the range's
+		// ratio when (tokenIn - s) * outPerLiquidity = s * rate * inPerLiquidity.
+		swapAmount = tokenIn.Amount.ToDec().Mul(outPerLiquidity).Quo(outPerLiquidity.Add(rate.Mul(inPerLiquidity))).TruncateInt()
+		if i == types.SingleAssetDepositSwapRefinements || !swapAmount.IsPositive() {
+			return swapAmount, spotRate, nil
+		}
+
+		// The cached context is never written, so simulating the swap leaves no trace in state.
+		_, _, tokenOut, _, _, updatedSqrtPrice, _, err := k.calcOutAmtGivenIn(ctx, sdk.NewCoin(tokenIn.Denom, swapAmount), tokenOutDenom, swapFee, sdk.ZeroDec(), pool.GetId())
+		if err != nil {
+			return sdk.Int{}, sdk.Dec{}, err
+		}
+		if !tokenOut.Amount.IsPositive() {
+			return swapAmount, spotRate, nil
+		}
+		rate = tokenOut.Amount.ToDec().QuoInt(swapAmount)
+		sqrtPrice = updatedSqrtPrice
+	}
+}
+
 // createInitialPosition ensures that the first position created on this pool includes both asset0 and asset1
 // This is required so we can set the pool's sqrtPrice and calculate it's initial tick from this
-func (k Keeper) initializeInitialPositionForPool(ctx sdk.Context, pool types.ConcentratedPoolExtension, amount0Desired, amount1Desired sdk.Int) error {
-	// Check that the position includes some amount of both asset0 and asset1
-	if !amount0Desired.GT(sdk.ZeroInt()) || !amount1Desired.GT(sdk.ZeroInt()) {
-		return types.InitialLiquidityZeroError{Amount0: amount0Desired, Amount1: amount1Desired}
+// It also ensures that the first position creates at least the MinInitialLiquidity module parameter worth of
+// liquidity in the position's range, so that pools cannot be seeded with dust and a trivially manipulable price.
+// Emits a pool initialized event with the initial sqrt price and tick, and the id of the seeding position.
+func (k Keeper) initializeInitialPositionForPool(ctx sdk.Context, pool types.ConcentratedPoolExtension, positionId uint64, amount0Desired, amount1Desired sdk.Int, sqrtPriceLowerTick, sqrtPriceUpperTick sdk.Dec) error {
+	initialSpotPrice := pool.GetInitialPrice()
+	if initialSpotPrice.IsPositive() {
+		// The pool starts at the price configured at creation, so the first position may be single-sided
+		// but must still include some amount of asset0 or asset1
+		if !amount0Desired.IsPositive() && !amount1Desired.IsPositive() {
+			return types.InitialLiquidityZeroError{Amount0: amount0Desired, Amount1: amount1Desired}
+		}
+	} else {
+		// Check that the position includes some amount of both asset0 and asset1
+		if !amount0Desired.GT(sdk.ZeroInt()) || !amount1Desired.GT(sdk.ZeroInt()) {
+			return types.InitialLiquidityZeroError{Amount0: amount0Desired, Amount1: amount1Desired}
+		}
+
+		// Calculate the spot price from the amount provided
+		initialSpotPrice = amount1Desired.ToDec().Quo(amount0Desired.ToDec())
 	}
 
-	// Calculate the spot price and sqrt price from the amount provided
-	initialSpotPrice := amount1Desired.ToDec().Quo(amount0Desired.ToDec())
+	// Calculate the sqrt price from the spot price
 	initialSqrtPrice, err := initialSpotPrice.ApproxSqrt()
 	if err != nil {
 		return err
 	}
 
+	// Check that the first position creates enough liquidity at the initial price
+	initialLiquidity := math.GetLiquidityFromAmounts(initialSqrtPrice, sqrtPriceLowerTick, sqrtPriceUpperTick, amount0Desired, amount1Desired)
+	minInitialLiquidity := k.GetParams(ctx).MinInitialLiquidity
+	if initialLiquidity.LT(minInitialLiquidity) {
+		return types.InitialLiquidityTooLowError{Liquidity: initialLiquidity, MinInitialLiquidity: minInitialLiquidity}
+	}
+
 	// Calculate the initial tick from the initial spot price
 	initialTick, err := math.PriceToTick(initialSpotPrice, pool.GetExponentAtPriceOne())
 	if err != nil {
@@ -295,6 +966,16 @@ func (k Keeper) initializeInitialPositionForPool(ctx sdk.Context, pool types.Con
 	if err != nil {
 		return err
 	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtPoolInitialized,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.GetId(), 10)),
+		sdk.NewAttribute(types.AttributeInitialSqrtPrice, initialSqrtPrice.String()),
+		sdk.NewAttribute(types.AttributeInitialTick, initialTick.String()),
+		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
+	))
+
 	return nil
 }
 
@@ -307,8 +988,9 @@ func (k Keeper) initializeInitialPositionForPool(ctx sdk.Context, pool types.Con
 // - lower tick
 // - upper tick
 // It also emits additional attributes for the liquidity added or removed and the actual amounts of asset0 and asset1 it translates to.
-func emitLiquidityChangeEvent(ctx sdk.Context, eventType string, positionId uint64, sender sdk.AccAddress, poolId uint64, lowerTick, upperTick int64, joinTime time.Time, liquidityDelta sdk.Dec, actualAmount0, actualAmount1 sdk.Int) {
-	ctx.EventManager().EmitEvent(sdk.NewEvent(
+// Any extraAttributes are appended to the event.
+func emitLiquidityChangeEvent(ctx sdk.Context, eventType string, positionId uint64, sender sdk.AccAddress, poolId uint64, lowerTick, upperTick int64, joinTime time.Time, liquidityDelta sdk.Dec, actualAmount0, actualAmount1 sdk.Int, extraAttributes ...sdk.Attribute) {
+	event := sdk.NewEvent(
 		eventType,
 		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 		sdk.NewAttribute(types.AttributeKeyPositionId, strconv.FormatUint(positionId, 10)),
@@ -320,5 +1002,6 @@ func emitLiquidityChangeEvent(ctx sdk.Context, eventType string, positionId uint
 		sdk.NewAttribute(types.AttributeLiquidity, liquidityDelta.String()),
 		sdk.NewAttribute(types.AttributeAmount0, actualAmount0.String()),
 		sdk.NewAttribute(types.AttributeAmount1, actualAmount1.String()),
-	))
+	)
+	ctx.EventManager().EmitEvent(event.AppendAttributes(extraAttributes...))
 }
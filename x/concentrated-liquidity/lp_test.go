@@ -2,11 +2,18 @@ package concentrated_liquidity_test
 
 import (
 	"errors"
+	"math/rand"
+	"strconv"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
+	"github.com/osmosis-labs/osmosis/osmoutils"
+	"github.com/osmosis-labs/osmosis/v15/app/apptesting"
 	cl "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/internal/math"
 	clmodel "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/model"
 	types "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
 )
@@ -239,6 +246,7 @@ func (s *KeeperTestSuite) TestCreatePosition() {
 			// Note user and pool account balances before create position is called
 			userBalancePrePositionCreation := s.App.BankKeeper.GetAllBalances(s.Ctx, s.TestAccs[0])
 			poolBalancePrePositionCreation := s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetAddress())
+			nextPositionIdPrePositionCreation := clKeeper.GetNextPositionId(s.Ctx)
 
 			// System under test.
 			positionId, asset0, asset1, liquidityCreated, joinTime, err := clKeeper.CreatePosition(s.Ctx, tc.poolId, s.TestAccs[0], tc.amount0Desired, tc.amount1Desired, tc.amount0Minimum, tc.amount1Minimum, tc.lowerTick, tc.upperTick)
@@ -262,6 +270,9 @@ func (s *KeeperTestSuite) TestCreatePosition() {
 				s.Require().Equal(userBalancePrePositionCreation.String(), userBalancePostPositionCreation.String())
 				s.Require().Equal(poolBalancePrePositionCreation.String(), poolBalancePostPositionCreation.String())
 
+				// No position id was consumed
+				s.Require().Equal(nextPositionIdPrePositionCreation, clKeeper.GetNextPositionId(s.Ctx))
+
 				// Redundantly ensure that liquidity was not created
 				liquidity, err := clKeeper.GetPositionLiquidity(s.Ctx, positionId)
 				s.Require().Error(err)
@@ -321,8 +332,8 @@ func (s *KeeperTestSuite) TestWithdrawPosition() {
 			// system under test parameters
 			// for withdrawing a position.
 			sutConfigOverwrite: &lpTest{
-				amount0Expected: baseCase.amount0Expected, // 0.998976 eth
-				amount1Expected: baseCase.amount1Expected, // 5000 usdc
+				amount0Expected: DefaultAmt0WithdrawnExpected, // 0.998976 eth
+				amount1Expected: baseCase.amount1Expected,     // 5000 usdc
 			},
 			timeElapsed: defaultTimeElapsed,
 		},
@@ -334,8 +345,8 @@ func (s *KeeperTestSuite) TestWithdrawPosition() {
 			// for withdrawing a position.
 			sutConfigOverwrite: &lpTest{
 				liquidityAmount: baseCase.liquidityAmount.QuoRoundUp(sdk.NewDec(2)),
-				amount0Expected: baseCase.amount0Expected.QuoRaw(2), // 0.499488
-				amount1Expected: baseCase.amount1Expected.QuoRaw(2), // 2500 usdc
+				amount0Expected: DefaultAmt0WithdrawnExpected.QuoRaw(2), // 0.499488
+				amount1Expected: baseCase.amount1Expected.QuoRaw(2),     // 2500 usdc
 			},
 			timeElapsed: defaultTimeElapsed,
 		},
@@ -346,8 +357,8 @@ func (s *KeeperTestSuite) TestWithdrawPosition() {
 			// system under test parameters
 			// for withdrawing a position.
 			sutConfigOverwrite: &lpTest{
-				amount0Expected: baseCase.amount0Expected, // 0.998976 eth
-				amount1Expected: baseCase.amount1Expected, // 5000 usdc
+				amount0Expected: DefaultAmt0WithdrawnExpected, // 0.998976 eth
+				amount1Expected: baseCase.amount1Expected,     // 5000 usdc
 			},
 			timeElapsed: 0,
 		},
@@ -485,6 +496,598 @@ func (s *KeeperTestSuite) TestWithdrawPosition() {
 	}
 }
 
+func (s *KeeperTestSuite) TestCreatePositionForOwner() {
+	tests := map[string]struct {
+		sameOwner      bool
+		grant          bool
+		acceptResponse *authz.AcceptResponse
+		expectedErr    error
+	}{
+		"sender is the owner": {
+			sameOwner: true,
+		},
+		"owner granted sender authorization": {
+			grant: true,
+		},
+		"owner did not grant sender authorization": {
+			expectedErr: types.UnauthorizedPositionCreatorError{},
+		},
+		"authorization accepts and requests the grant be deleted": {
+			acceptResponse: &authz.AcceptResponse{Accept: true, Delete: true},
+		},
+		"authorization accepts and updates the grant": {
+			acceptResponse: &authz.AcceptResponse{Accept: true, Updated: &mockAuthorization{}},
+		},
+		"authorization does not accept the position": {
+			acceptResponse: &authz.AcceptResponse{Accept: false},
+			expectedErr:    types.UnauthorizedPositionCreatorError{},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.Setup()
+			pool := s.PrepareConcentratedPool()
+			sender := s.TestAccs[0]
+			owner := s.TestAccs[1]
+			if tc.sameOwner {
+				owner = sender
+			}
+
+			if tc.grant {
+				authorization := authz.NewGenericAuthorization(sdk.MsgTypeURL(&types.MsgCreatePosition{}))
+				err := s.App.AuthzKeeper.SaveGrant(s.Ctx, sender, owner, authorization, s.Ctx.BlockTime().Add(time.Hour))
+				s.Require().NoError(err)
+			}
+
+			var authzKeeper *mockAuthzKeeper
+			if tc.acceptResponse != nil {
+				authzKeeper = &mockAuthzKeeper{authorization: &mockAuthorization{response: *tc.acceptResponse}}
+				s.App.ConcentratedLiquidityKeeper.SetAuthzKeeper(authzKeeper)
+			}
+
+			s.FundAcc(sender, sdk.NewCoins(DefaultCoin0, DefaultCoin1))
+			senderBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, sender)
+			ownerBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+
+			// System under test.
+			positionId, amount0, amount1, _, _, err := s.App.ConcentratedLiquidityKeeper.CreatePositionForOwner(s.Ctx, pool.GetId(), sender, owner, DefaultAmt0, DefaultAmt1, sdk.ZeroInt(), sdk.ZeroInt(), DefaultLowerTick, DefaultUpperTick)
+
+			if authzKeeper != nil {
+				// The authorization was asked to accept the position as if the owner created it.
+				acceptedMsg := authzKeeper.authorization.acceptedMsg
+				s.Require().NotNil(acceptedMsg)
+				s.Require().Equal(owner.String(), acceptedMsg.Sender)
+				s.Require().Equal(pool.GetId(), acceptedMsg.PoolId)
+				s.Require().Equal(DefaultAmt0, acceptedMsg.TokenDesired0.Amount)
+				s.Require().Equal(DefaultAmt1, acceptedMsg.TokenDesired1.Amount)
+
+				// The grant was deleted or updated as requested by the authorization.
+				s.Require().Equal(tc.acceptResponse.Delete, authzKeeper.deleted)
+				s.Require().Equal(tc.acceptResponse.Updated, authzKeeper.saved)
+			}
+
+			if tc.expectedErr != nil {
+				s.Require().Error(err)
+				s.Require().IsType(tc.expectedErr, err)
+				s.Require().Equal(senderBalanceBefore, s.App.BankKeeper.GetAllBalances(s.Ctx, sender))
+				return
+			}
+			s.Require().NoError(err)
+
+			// The position is owned by the owner.
+			position, err := s.App.ConcentratedLiquidityKeeper.GetPosition(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().Equal(owner.String(), position.Address)
+
+			// The tokens were provided by the sender.
+			if !tc.sameOwner {
+				s.Require().Equal(ownerBalanceBefore, s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+			}
+			expectedSenderBalance := senderBalanceBefore.Sub(sdk.NewCoins(sdk.NewCoin(pool.GetToken0(), amount0), sdk.NewCoin(pool.GetToken1(), amount1)))
+			s.Require().Equal(expectedSenderBalance, s.App.BankKeeper.GetAllBalances(s.Ctx, sender))
+		})
+	}
+}
+
+// mockAuthzKeeper holds a single authorization and records the grant updates made to it.
+type mockAuthzKeeper struct {
+	authorization *mockAuthorization
+	saved         authz.Authorization
+	deleted       bool
+}
+
+func (m *mockAuthzKeeper) GetCleanAuthorization(ctx sdk.Context, grantee, granter sdk.AccAddress, msgType string) (authz.Authorization, time.Time) {
+	return m.authorization, ctx.BlockTime().Add(time.Hour)
+}
+
+func (m *mockAuthzKeeper) SaveGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration time.Time) error {
+	m.saved = authorization
+	return nil
+}
+
+func (m *mockAuthzKeeper) DeleteGrant(ctx sdk.Context, grantee, granter sdk.AccAddress, msgType string) error {
+	m.deleted = true
+	return nil
+}
+
+// mockAuthorization returns a fixed response and records the message it was asked to accept.
+type mockAuthorization struct {
+	response    authz.AcceptResponse
+	acceptedMsg *types.MsgCreatePosition
+}
+
+func (m *mockAuthorization) Reset()         {}
+func (m *mockAuthorization) String() string { return "mockAuthorization" }
+func (m *mockAuthorization) ProtoMessage()  {}
+
+func (m *mockAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&types.MsgCreatePosition{})
+}
+
+func (m *mockAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	m.acceptedMsg = msg.(*types.MsgCreatePosition)
+	return m.response, nil
+}
+
+func (m *mockAuthorization) ValidateBasic() error {
+	return nil
+}
+
+func (s *KeeperTestSuite) TestWithdrawPositions() {
+	tests := map[string]struct {
+		withdrawFromOther bool
+		overWithdraw      bool
+		drainPool         bool
+		expectedErr       error
+	}{
+		"withdraw from multiple positions across pools": {},
+		"position owned by another address - rolls back all": {
+			withdrawFromOther: true,
+			expectedErr:       types.NotPositionOwnerError{},
+		},
+		"insufficient liquidity in last position - rolls back all": {
+			overWithdraw: true,
+			expectedErr:  types.InsufficientLiquidityError{},
+		},
+		"pool balance does not cover the withdrawal - rolls back all": {
+			drainPool:   true,
+			expectedErr: types.PoolInsufficientBalanceError{},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.Setup()
+			s.PrepareMultipleConcentratedPools(2)
+			owner := s.TestAccs[0]
+			other := s.TestAccs[1]
+
+			liquidityOne, positionIdOne := s.SetupPosition(1, owner, DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime())
+			liquidityTwo, positionIdTwo := s.SetupPosition(1, owner, DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime())
+			liquidityThree, positionIdThree := s.SetupPosition(2, owner, DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime())
+			_, otherPositionId := s.SetupPosition(1, other, DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime())
+
+			withdrawals := []types.PositionWithdrawal{
+				{PositionId: positionIdOne, LiquidityAmount: liquidityOne},
+				{PositionId: positionIdTwo, LiquidityAmount: liquidityTwo.QuoInt64(2)},
+				{PositionId: positionIdThree, LiquidityAmount: liquidityThree},
+			}
+			if tc.withdrawFromOther {
+				withdrawals = append(withdrawals, types.PositionWithdrawal{PositionId: otherPositionId, LiquidityAmount: sdk.OneDec()})
+			}
+			if tc.overWithdraw {
+				withdrawals[2].LiquidityAmount = liquidityThree.Add(sdk.OneDec())
+			}
+			if tc.drainPool {
+				// Drain the second pool's account to simulate broken pool accounting.
+				pool, err := s.App.ConcentratedLiquidityKeeper.GetPoolById(s.Ctx, 2)
+				s.Require().NoError(err)
+				poolBalance := s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetAddress())
+				err = s.App.BankKeeper.SendCoins(s.Ctx, pool.GetAddress(), s.TestAccs[2], poolBalance)
+				s.Require().NoError(err)
+			}
+
+			ownerBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+
+			// System under test.
+			tokensOut, err := s.App.ConcentratedLiquidityKeeper.WithdrawPositions(s.Ctx, owner, withdrawals)
+
+			if tc.expectedErr != nil {
+				s.Require().Error(err)
+				s.Require().IsType(tc.expectedErr, err)
+
+				// No position was modified and no tokens were sent.
+				s.Require().Equal(ownerBalanceBefore, s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+				liquidity, err := s.App.ConcentratedLiquidityKeeper.GetPositionLiquidity(s.Ctx, positionIdOne)
+				s.Require().NoError(err)
+				s.Require().Equal(liquidityOne, liquidity)
+				return
+			}
+			s.Require().NoError(err)
+
+			// The owner received exactly the reported amounts.
+			s.Require().Equal(ownerBalanceBefore.Add(tokensOut...), s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+
+			// Fully withdrawn positions are deleted, partially withdrawn ones are updated.
+			s.Require().False(s.App.ConcentratedLiquidityKeeper.HasFullPosition(s.Ctx, positionIdOne))
+			s.Require().False(s.App.ConcentratedLiquidityKeeper.HasFullPosition(s.Ctx, positionIdThree))
+			liquidity, err := s.App.ConcentratedLiquidityKeeper.GetPositionLiquidity(s.Ctx, positionIdTwo)
+			s.Require().NoError(err)
+			s.Require().Equal(liquidityTwo.Sub(liquidityTwo.QuoInt64(2)), liquidity)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestWithdrawPositionEarlyExitFee() {
+	earlyExitFee := sdk.MustNewDecFromStr("0.01")
+	minHoldDuration := time.Hour
+
+	tests := map[string]struct {
+		earlyExitFee    sdk.Dec
+		timeElapsed     time.Duration
+		expectFeeCharge bool
+	}{
+		"withdraw before min hold duration - fee charged": {
+			earlyExitFee:    earlyExitFee,
+			timeElapsed:     minHoldDuration - time.Second,
+			expectFeeCharge: true,
+		},
+		"withdraw at min hold duration - no fee": {
+			earlyExitFee: earlyExitFee,
+			timeElapsed:  minHoldDuration,
+		},
+		"early exit fee disabled - no fee": {
+			earlyExitFee: sdk.ZeroDec(),
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.Setup()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			pool := s.PrepareConcentratedPool()
+			owner := s.TestAccs[0]
+
+			clPool, ok := pool.(*clmodel.Pool)
+			s.Require().True(ok)
+			clPool.EarlyExitFee = tc.earlyExitFee
+			clPool.MinHoldDuration = minHoldDuration
+			s.Require().NoError(clKeeper.SetPool(s.Ctx, clPool))
+
+			// A second position remains in the pool to receive the early exit fee.
+			s.SetupDefaultPosition(pool.GetId())
+			liquidity, positionId := s.SetupPosition(pool.GetId(), owner, DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime())
+
+			s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(tc.timeElapsed))
+
+			// Compute the amounts the position would pay out without an early exit fee.
+			cacheCtx, _ := s.Ctx.CacheContext()
+			clPool.EarlyExitFee = sdk.ZeroDec()
+			s.Require().NoError(clKeeper.SetPool(cacheCtx, clPool))
+			expectedAmount0, expectedAmount1, err := clKeeper.WithdrawPosition(cacheCtx, owner, positionId, liquidity)
+			s.Require().NoError(err)
+
+			expectedFee0, expectedFee1 := sdk.ZeroInt(), sdk.ZeroInt()
+			if tc.expectFeeCharge {
+				expectedFee0 = expectedAmount0.ToDec().Mul(tc.earlyExitFee).TruncateInt()
+				expectedFee1 = expectedAmount1.ToDec().Mul(tc.earlyExitFee).TruncateInt()
+				s.Require().True(expectedFee0.IsPositive())
+				s.Require().True(expectedFee1.IsPositive())
+			}
+
+			feeAccumBefore, err := clKeeper.GetFeeAccumulator(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+
+			// System under test.
+			amount0, amount1, err := clKeeper.WithdrawPosition(s.Ctx, owner, positionId, liquidity)
+			s.Require().NoError(err)
+
+			// The owner's payout is reduced by the early exit fee.
+			s.Require().Equal(expectedAmount0.Sub(expectedFee0), amount0)
+			s.Require().Equal(expectedAmount1.Sub(expectedFee1), amount1)
+
+			// The fee is distributed to the remaining liquidity through the fee accumulator.
+			feeAccumAfter, err := clKeeper.GetFeeAccumulator(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectFeeCharge, !feeAccumAfter.GetValue().IsEqual(feeAccumBefore.GetValue()))
+
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtWithdrawPosition, 1)
+			for _, event := range s.Ctx.EventManager().Events() {
+				if event.Type != types.TypeEvtWithdrawPosition {
+					continue
+				}
+				for _, attr := range event.Attributes {
+					if string(attr.Key) == types.AttributeEarlyExitFee0 {
+						s.Require().Equal(expectedFee0.String(), string(attr.Value))
+					}
+					if string(attr.Key) == types.AttributeEarlyExitFee1 {
+						s.Require().Equal(expectedFee1.String(), string(attr.Value))
+					}
+				}
+			}
+		})
+	}
+}
+
+// TestWithdrawPositionEventReportsCollectedRewards tests that the withdraw event reports the fees and incentives
+// collected by the withdrawal alongside the principal returned.
+func (s *KeeperTestSuite) TestWithdrawPositionEventReportsCollectedRewards() {
+	s.SetupTest()
+	s.TestAccs = apptesting.CreateRandomAccounts(5)
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	owner := s.TestAccs[0]
+
+	pool := s.PrepareCustomConcentratedPool(owner, ETH, USDC, DefaultTickSpacing, DefaultExponentAtPriceOne, sdk.MustNewDecFromStr("0.003"))
+	positionId := s.SetupFullRangePositionAcc(pool.GetId(), owner)
+
+	// Accrue fees to the position.
+	s.swapAndTrackXTimesInARow(pool.GetId(), DefaultCoin1, ETH, types.MaxSpotPrice, 1)
+	expectedFees, err := clKeeper.QueryClaimableFees(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().False(expectedFees.IsZero())
+
+	liquidity, err := clKeeper.GetPositionLiquidity(s.Ctx, positionId)
+	s.Require().NoError(err)
+
+	// getWithdrawEventAttribute returns the value of the given attribute of the last withdraw event.
+	getWithdrawEventAttribute := func(key string) string {
+		value := ""
+		for _, event := range s.Ctx.EventManager().Events() {
+			if event.Type != types.TypeEvtWithdrawPosition {
+				continue
+			}
+			for _, attr := range event.Attributes {
+				if string(attr.Key) == key {
+					value = string(attr.Value)
+				}
+			}
+		}
+		return value
+	}
+
+	// A partial withdrawal does not collect fees.
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, _, err = clKeeper.WithdrawPosition(s.Ctx, owner, positionId, liquidity.QuoInt64(2))
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtWithdrawPosition, 1)
+	s.Require().Equal(sdk.NewCoins().String(), getWithdrawEventAttribute(types.AttributeFeesCollected))
+	s.Require().Equal(sdk.NewCoins().String(), getWithdrawEventAttribute(types.AttributeIncentivesCollected))
+
+	// A full withdrawal collects the position's fees and reports them in the event.
+	expectedFees, err = clKeeper.QueryClaimableFees(s.Ctx, positionId)
+	s.Require().NoError(err)
+	remainingLiquidity, err := clKeeper.GetPositionLiquidity(s.Ctx, positionId)
+	s.Require().NoError(err)
+
+	s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+	_, _, err = clKeeper.WithdrawPosition(s.Ctx, owner, positionId, remainingLiquidity)
+	s.Require().NoError(err)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtWithdrawPosition, 1)
+	s.Require().Equal(expectedFees.String(), getWithdrawEventAttribute(types.AttributeFeesCollected))
+	s.Require().Equal(sdk.NewCoins().String(), getWithdrawEventAttribute(types.AttributeIncentivesCollected))
+}
+
+// TestWithdrawPositionCollectsIncentivesBeforeLiquidityChange is a regression test ensuring that a partial withdrawal
+// pays out the incentives accrued by the position's full pre-withdrawal liquidity rather than by the liquidity that
+// remains after the withdrawal.
+func (s *KeeperTestSuite) TestWithdrawPositionCollectsIncentivesBeforeLiquidityChange() {
+	s.SetupTest()
+	s.Ctx = s.Ctx.WithBlockTime(DefaultJoinTime)
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	owner := s.TestAccs[0]
+	timeElapsed := time.Hour * 24
+	uptimeGrowth := uptimeHelper.hundredTokensMultiDenom
+
+	pool := s.PrepareConcentratedPool()
+	liquidity, positionId := s.SetupPosition(pool.GetId(), owner, DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime())
+
+	// Accrue incentives for the position.
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(timeElapsed))
+	err := addToUptimeAccums(s.Ctx, pool.GetId(), clKeeper, uptimeGrowth)
+	s.Require().NoError(err)
+
+	liquidityToWithdraw := liquidity.QuoInt64(2)
+	expectedIncentives := expectedIncentivesFromUptimeGrowth(uptimeGrowth, liquidity, timeElapsed, sdk.OneInt())
+	incentivesAfterLiquidityChange := expectedIncentivesFromUptimeGrowth(uptimeGrowth, liquidity.Sub(liquidityToWithdraw), timeElapsed, sdk.OneInt())
+	s.Require().False(expectedIncentives.IsEqual(incentivesAfterLiquidityChange))
+	s.FundAcc(pool.GetIncentivesAddress(), expectedIncentives)
+
+	incentivesBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetIncentivesAddress())
+
+	// System under test.
+	_, _, err = clKeeper.WithdrawPosition(s.Ctx, owner, positionId, liquidityToWithdraw)
+	s.Require().NoError(err)
+
+	// The incentives claimed reflect the full pre-withdrawal liquidity.
+	incentivesBalanceAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetIncentivesAddress())
+	s.Require().Equal(expectedIncentives.String(), incentivesBalanceBefore.Sub(incentivesBalanceAfter).String())
+
+	// Nothing accrued before the withdrawal is left to claim.
+	claimableIncentives, _, err := clKeeper.QueryClaimableIncentives(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().True(claimableIncentives.IsZero())
+}
+
+func (s *KeeperTestSuite) TestWithdrawPositionRemovesEmptyTicks() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	owner := s.TestAccs[0]
+
+	pool := s.PrepareConcentratedPool()
+	poolId := pool.GetId()
+	liquidityOne, positionIdOne := s.SetupPosition(poolId, owner, DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime())
+	liquidityTwo, positionIdTwo := s.SetupPosition(poolId, owner, DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick+100, s.Ctx.BlockTime())
+
+	tickIndexes := func() []int64 {
+		ticks, err := clKeeper.GetAllInitializedTicksForPool(s.Ctx, poolId)
+		s.Require().NoError(err)
+		indexes := []int64{}
+		for _, tick := range ticks {
+			indexes = append(indexes, tick.TickIndex)
+		}
+		return indexes
+	}
+	s.Require().Equal([]int64{DefaultLowerTick, DefaultUpperTick, DefaultUpperTick + 100}, tickIndexes())
+
+	// A partial withdrawal keeps the position's ticks.
+	_, _, err := clKeeper.WithdrawPosition(s.Ctx, owner, positionIdOne, liquidityOne.QuoInt64(2))
+	s.Require().NoError(err)
+	s.Require().Equal([]int64{DefaultLowerTick, DefaultUpperTick, DefaultUpperTick + 100}, tickIndexes())
+
+	// Fully withdrawing the first position removes its upper tick, while its lower tick is still
+	// referenced by the second position.
+	_, _, err = clKeeper.WithdrawPosition(s.Ctx, owner, positionIdOne, liquidityOne.Sub(liquidityOne.QuoInt64(2)))
+	s.Require().NoError(err)
+	s.Require().Equal([]int64{DefaultLowerTick, DefaultUpperTick + 100}, tickIndexes())
+
+	// Fully withdrawing the last position leaves no tick records behind.
+	_, _, err = clKeeper.WithdrawPosition(s.Ctx, owner, positionIdTwo, liquidityTwo)
+	s.Require().NoError(err)
+	s.Require().Empty(tickIndexes())
+}
+
+func (s *KeeperTestSuite) TestWithdrawPositionPoolInsufficientBalance() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	owner := s.TestAccs[0]
+
+	pool := s.PrepareConcentratedPool()
+	liquidity, positionId := s.SetupPosition(pool.GetId(), owner, DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime())
+
+	// Drain the pool account to simulate broken pool accounting.
+	poolBalance := s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetAddress())
+	err := s.App.BankKeeper.SendCoins(s.Ctx, pool.GetAddress(), s.TestAccs[1], poolBalance)
+	s.Require().NoError(err)
+
+	// System under test.
+	_, _, err = clKeeper.WithdrawPosition(s.Ctx, owner, positionId, liquidity)
+	s.Require().Error(err)
+
+	var insufficientBalanceErr types.PoolInsufficientBalanceError
+	s.Require().ErrorAs(err, &insufficientBalanceErr)
+	s.Require().Equal(pool.GetId(), insufficientBalanceErr.PoolId)
+	s.Require().True(insufficientBalanceErr.Balance.IsZero())
+	s.Require().False(insufficientBalanceErr.Requested.IsZero())
+}
+
+func (s *KeeperTestSuite) TestEmergencyWithdrawPosition() {
+	tests := map[string]struct {
+		emergencyWithdrawDisabled bool
+		withdrawFromOther         bool
+		accumulatorRemovalFails   bool
+		expectedErr               error
+	}{
+		"withdraw principal and forfeit fees": {},
+		"accumulator removal fails": {
+			accumulatorRemovalFails: true,
+		},
+		"emergency withdrawals disabled": {
+			emergencyWithdrawDisabled: true,
+			expectedErr:               types.EmergencyWithdrawDisabledError{},
+		},
+		"position owned by another address": {
+			withdrawFromOther: true,
+			expectedErr:       types.NotPositionOwnerError{},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.Setup()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			pool := s.PrepareConcentratedPool()
+			owner := s.TestAccs[0]
+			other := s.TestAccs[1]
+
+			params := clKeeper.GetParams(s.Ctx)
+			params.EmergencyWithdrawEnabled = !tc.emergencyWithdrawDisabled
+			clKeeper.SetParams(s.Ctx, params)
+
+			liquidity, positionId := s.SetupPosition(pool.GetId(), owner, DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime())
+
+			// Accrue fees to the position so there is something to forfeit.
+			feeAccum, err := clKeeper.GetFeeAccumulator(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			feeAccum.AddToAccumulator(sdk.NewDecCoins(sdk.NewDecCoin(ETH, sdk.NewInt(10))))
+
+			if tc.accumulatorRemovalFails {
+				// Corrupt the position's fee accumulator record so that its rewards can no longer be computed.
+				feePositionKey := types.KeyFeePositionAccumulator(positionId)
+				record, err := feeAccum.GetPosition(feePositionKey)
+				s.Require().NoError(err)
+				record.InitAccumValue = sdk.NewDecCoins(sdk.NewDecCoin(ETH, sdk.NewInt(1000)))
+				recordKey := []byte("accum/acc/pos/" + feeAccum.GetName() + "/" + feePositionKey)
+				osmoutils.MustSet(s.Ctx.KVStore(s.App.GetKey(types.StoreKey)), recordKey, &record)
+			}
+
+			sender := owner
+			if tc.withdrawFromOther {
+				sender = other
+			}
+			senderBalanceBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, sender)
+			poolBefore, err := clKeeper.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+
+			// System under test.
+			amount0, amount1, err := clKeeper.EmergencyWithdrawPosition(s.Ctx, sender, positionId)
+
+			if tc.expectedErr != nil {
+				s.Require().Error(err)
+				s.Require().IsType(tc.expectedErr, err)
+
+				// The position is untouched and no tokens were sent.
+				s.Require().Equal(senderBalanceBefore, s.App.BankKeeper.GetAllBalances(s.Ctx, sender))
+				positionLiquidity, err := clKeeper.GetPositionLiquidity(s.Ctx, positionId)
+				s.Require().NoError(err)
+				s.Require().Equal(liquidity, positionLiquidity)
+				return
+			}
+			s.Require().NoError(err)
+
+			// The owner received only the principal, not the fees.
+			expectedBalance := senderBalanceBefore.Add(sdk.NewCoin(ETH, amount0), sdk.NewCoin(USDC, amount1))
+			s.Require().Equal(expectedBalance, s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+			s.Require().True(amount0.IsPositive())
+			s.Require().True(amount1.IsPositive())
+
+			// The position is deleted and its liquidity removed from the pool.
+			s.Require().False(clKeeper.HasFullPosition(s.Ctx, positionId))
+			poolAfter, err := clKeeper.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(poolBefore.GetLiquidity().Sub(liquidity), poolAfter.GetLiquidity())
+
+			// The position's shares no longer count toward the fee accumulator.
+			feeAccum, err = clKeeper.GetFeeAccumulator(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			if tc.accumulatorRemovalFails {
+				// The orphaned record is swept out of the accumulator instead.
+				hasFeePosition, err := feeAccum.HasPosition(types.KeyFeePositionAccumulator(positionId))
+				s.Require().NoError(err)
+				s.Require().False(hasFeePosition)
+			} else {
+				feePositionSize, err := feeAccum.GetPositionSize(types.KeyFeePositionAccumulator(positionId))
+				s.Require().NoError(err)
+				s.Require().True(feePositionSize.IsZero())
+			}
+			_, broken := cl.AccumulatorTotalSharesMatchPositions(*clKeeper)(s.Ctx)
+			s.Require().False(broken)
+
+			// The position's ticks are no longer referenced and are removed.
+			ticks, err := clKeeper.GetAllInitializedTicksForPool(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Empty(ticks)
+
+			// An emergency withdraw event records the forfeited fees and any failure to remove the position's shares.
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtEmergencyWithdraw, 1)
+			attributes := s.ExtractAttributes(s.FindEvent(s.Ctx.EventManager().Events(), types.TypeEvtEmergencyWithdraw))
+			_, hasRemovalErr := attributes[types.AttributeAccumRemovalError]
+			s.Require().Equal(tc.accumulatorRemovalFails, hasRemovalErr)
+		})
+	}
+}
+
 // mergeConfigs merges every desired non-zero field from overwrite
 // into dst. dst is mutated due to being a pointer.
 func mergeConfigs(dst *lpTest, overwrite *lpTest) {
@@ -678,6 +1281,55 @@ func (s *KeeperTestSuite) TestSendCoinsBetweenPoolAndUser() {
 	}
 }
 
+func (s *KeeperTestSuite) TestLiquidityWithinDesiredAmounts() {
+	tests := map[string]struct {
+		liquidityDelta  sdk.Dec
+		amount0Desired  sdk.Int
+		amount1Desired  sdk.Int
+		expectUnchanged bool
+	}{
+		"amounts required by the liquidity are within the desired amounts": {
+			liquidityDelta:  DefaultLiquidityAmt,
+			amount0Desired:  DefaultAmt0.MulRaw(2),
+			amount1Desired:  DefaultAmt1.MulRaw(2),
+			expectUnchanged: true,
+		},
+		"amounts required by the liquidity exceed the desired amounts": {
+			liquidityDelta: DefaultLiquidityAmt.Mul(sdk.MustNewDecFromStr("1.01")),
+			amount0Desired: DefaultAmt0,
+			amount1Desired: DefaultAmt1,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.Setup()
+			poolId := s.PrepareConcentratedPool().GetId()
+			s.SetupDefaultPosition(poolId)
+			pool, err := s.App.ConcentratedLiquidityKeeper.GetPoolById(s.Ctx, poolId)
+			s.Require().NoError(err)
+
+			sqrtPriceLowerTick, sqrtPriceUpperTick, err := math.TicksToSqrtPrice(DefaultLowerTick, DefaultUpperTick, pool.GetExponentAtPriceOne())
+			s.Require().NoError(err)
+
+			// System under test.
+			liquidity, err := cl.LiquidityWithinDesiredAmounts(s.Ctx, pool, DefaultLowerTick, DefaultUpperTick, sqrtPriceLowerTick, sqrtPriceUpperTick, tc.liquidityDelta, tc.amount0Desired, tc.amount1Desired)
+			s.Require().NoError(err)
+
+			if tc.expectUnchanged {
+				s.Require().Equal(tc.liquidityDelta, liquidity)
+			} else {
+				s.Require().True(liquidity.LT(tc.liquidityDelta))
+			}
+
+			// The rounded up amounts required by the returned liquidity never exceed the desired amounts.
+			amount0, amount1 := pool.CalcActualAmounts(s.Ctx, DefaultLowerTick, DefaultUpperTick, sqrtPriceLowerTick, sqrtPriceUpperTick, liquidity, osmomath.RoundUp)
+			s.Require().True(amount0.Ceil().TruncateInt().LTE(tc.amount0Desired))
+			s.Require().True(amount1.Ceil().TruncateInt().LTE(tc.amount1Desired))
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestisInitialPositionForPool() {
 	type sendTest struct {
 		initialSqrtPrice sdk.Dec
@@ -721,6 +1373,370 @@ func (s *KeeperTestSuite) TestisInitialPositionForPool() {
 	}
 }
 
+// TestRequiredAmountForDeposit tests that the amount of the other denom required for a deposit matches what creating
+// a position with the known amount consumes, and that ranges which cannot hold the known denom are rejected.
+func (s *KeeperTestSuite) TestRequiredAmountForDeposit() {
+	tests := map[string]struct {
+		initializePool  bool
+		poolId          uint64
+		lowerTick       int64
+		upperTick       int64
+		knownDenom      string
+		knownAmount     sdk.Int
+		expectZeroOther bool
+		expectedError   error
+	}{
+		"in range, known token0": {
+			initializePool: true,
+			poolId:         validPoolId,
+			lowerTick:      DefaultLowerTick,
+			upperTick:      DefaultUpperTick,
+			knownDenom:     ETH,
+			knownAmount:    DefaultAmt0,
+		},
+		"in range, known token1": {
+			initializePool: true,
+			poolId:         validPoolId,
+			lowerTick:      DefaultLowerTick,
+			upperTick:      DefaultUpperTick,
+			knownDenom:     USDC,
+			knownAmount:    DefaultAmt1,
+		},
+		"range above current price holds only token0": {
+			initializePool:  true,
+			poolId:          validPoolId,
+			lowerTick:       DefaultUpperTick,
+			upperTick:       DefaultUpperTick + 1000,
+			knownDenom:      ETH,
+			knownAmount:     DefaultAmt0,
+			expectZeroOther: true,
+		},
+		"range below current price holds only token1": {
+			initializePool:  true,
+			poolId:          validPoolId,
+			lowerTick:       DefaultLowerTick - 1000,
+			upperTick:       DefaultLowerTick,
+			knownDenom:      USDC,
+			knownAmount:     DefaultAmt1,
+			expectZeroOther: true,
+		},
+		"error: range above current price does not hold token1": {
+			initializePool: true,
+			poolId:         validPoolId,
+			lowerTick:      DefaultUpperTick,
+			upperTick:      DefaultUpperTick + 1000,
+			knownDenom:     USDC,
+			knownAmount:    DefaultAmt1,
+			expectedError:  types.DenomNotDepositedInRangeError{Denom: USDC, LowerTick: DefaultUpperTick, UpperTick: DefaultUpperTick + 1000},
+		},
+		"error: range below current price does not hold token0": {
+			initializePool: true,
+			poolId:         validPoolId,
+			lowerTick:      DefaultLowerTick - 1000,
+			upperTick:      DefaultLowerTick,
+			knownDenom:     ETH,
+			knownAmount:    DefaultAmt0,
+			expectedError:  types.DenomNotDepositedInRangeError{Denom: ETH, LowerTick: DefaultLowerTick - 1000, UpperTick: DefaultLowerTick},
+		},
+		"error: denom not in pool": {
+			initializePool: true,
+			poolId:         validPoolId,
+			lowerTick:      DefaultLowerTick,
+			upperTick:      DefaultUpperTick,
+			knownDenom:     "uosmo",
+			knownAmount:    DefaultAmt0,
+			expectedError:  types.DenomNotInPoolError{PoolId: validPoolId, Denom: "uosmo"},
+		},
+		"error: known amount is not positive": {
+			initializePool: true,
+			poolId:         validPoolId,
+			lowerTick:      DefaultLowerTick,
+			upperTick:      DefaultUpperTick,
+			knownDenom:     ETH,
+			knownAmount:    sdk.ZeroInt(),
+			expectedError:  types.NotPositiveRequireAmountError{Amount: sdk.ZeroInt().String()},
+		},
+		"error: lower tick equal to upper tick": {
+			initializePool: true,
+			poolId:         validPoolId,
+			lowerTick:      DefaultUpperTick,
+			upperTick:      DefaultUpperTick,
+			knownDenom:     ETH,
+			knownAmount:    DefaultAmt0,
+			expectedError:  types.InvalidLowerUpperTickError{LowerTick: DefaultUpperTick, UpperTick: DefaultUpperTick},
+		},
+		"error: pool has no price yet": {
+			poolId:        validPoolId,
+			lowerTick:     DefaultLowerTick,
+			upperTick:     DefaultUpperTick,
+			knownDenom:    ETH,
+			knownAmount:   DefaultAmt0,
+			expectedError: types.PoolNotInitializedError{PoolId: validPoolId},
+		},
+		"error: pool does not exist": {
+			poolId:        validPoolId + 1,
+			lowerTick:     DefaultLowerTick,
+			upperTick:     DefaultUpperTick,
+			knownDenom:    ETH,
+			knownAmount:   DefaultAmt0,
+			expectedError: types.PoolNotFoundError{PoolId: validPoolId + 1},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.PrepareConcentratedPool()
+			if tc.initializePool {
+				s.SetupDefaultPosition(pool.GetId())
+			}
+
+			otherAmount, liquidity, err := s.App.ConcentratedLiquidityKeeper.RequiredAmountForDeposit(s.Ctx, tc.poolId, tc.lowerTick, tc.upperTick, tc.knownDenom, tc.knownAmount)
+			if tc.expectedError != nil {
+				s.Require().ErrorContains(err, tc.expectedError.Error())
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().True(liquidity.IsPositive())
+			if tc.expectZeroOther {
+				s.Require().Equal(sdk.ZeroInt(), otherAmount)
+			}
+
+			// Creating a position with the known amount and the required other amount consumes the known amount
+			// and creates the reported liquidity, up to rounding.
+			amount0Desired, amount1Desired := tc.knownAmount, otherAmount
+			if tc.knownDenom == USDC {
+				amount0Desired, amount1Desired = otherAmount, tc.knownAmount
+			}
+			s.FundAcc(s.TestAccs[1], sdk.NewCoins(sdk.NewCoin(ETH, amount0Desired), sdk.NewCoin(USDC, amount1Desired)))
+			_, actualAmount0, actualAmount1, liquidityCreated, _, err := s.App.ConcentratedLiquidityKeeper.CreatePosition(s.Ctx, tc.poolId, s.TestAccs[1], amount0Desired, amount1Desired, sdk.ZeroInt(), sdk.ZeroInt(), tc.lowerTick, tc.upperTick)
+			s.Require().NoError(err)
+
+			actualKnownAmount := actualAmount0
+			if tc.knownDenom == USDC {
+				actualKnownAmount = actualAmount1
+			}
+			s.Require().True(tc.knownAmount.Sub(actualKnownAmount).Abs().LTE(sdk.OneInt()))
+			s.Require().True(liquidity.Sub(liquidityCreated).Abs().LTE(sdk.OneDec()))
+		})
+	}
+}
+
+// TestCreatePositionSingleAsset tests that a position can be created from a single asset, with the portion the range
+// requires in the other asset swapped through the pool, and that only what the position cannot absorb is left over.
+func (s *KeeperTestSuite) TestCreatePositionSingleAsset() {
+	tests := map[string]struct {
+		initializePool bool
+		poolId         uint64
+		lowerTick      int64
+		upperTick      int64
+		tokenIn        sdk.Coin
+		maxSlippage    sdk.Dec
+		expectNoSwap   bool
+		expectSwapAll  bool
+		expectedError  error
+	}{
+		"in range, token0 in": {
+			initializePool: true,
+			poolId:         validPoolId,
+			lowerTick:      DefaultLowerTick,
+			upperTick:      DefaultUpperTick,
+			tokenIn:        sdk.NewCoin(ETH, DefaultAmt0.QuoRaw(100)),
+			maxSlippage:    sdk.NewDecWithPrec(5, 2),
+		},
+		"in range, token1 in": {
+			initializePool: true,
+			poolId:         validPoolId,
+			lowerTick:      DefaultLowerTick,
+			upperTick:      DefaultUpperTick,
+			tokenIn:        sdk.NewCoin(USDC, DefaultAmt1.QuoRaw(100)),
+			maxSlippage:    sdk.NewDecWithPrec(5, 2),
+		},
+		"range above current price, token0 in is not swapped": {
+			initializePool: true,
+			poolId:         validPoolId,
+			lowerTick:      DefaultUpperTick,
+			upperTick:      DefaultUpperTick + 1000,
+			tokenIn:        sdk.NewCoin(ETH, DefaultAmt0.QuoRaw(100)),
+			maxSlippage:    sdk.NewDecWithPrec(5, 2),
+			expectNoSwap:   true,
+		},
+		"range above current price, token1 in is swapped entirely": {
+			initializePool: true,
+			poolId:         validPoolId,
+			lowerTick:      DefaultUpperTick,
+			upperTick:      DefaultUpperTick + 1000,
+			tokenIn:        sdk.NewCoin(USDC, DefaultAmt1.QuoRaw(100)),
+			maxSlippage:    sdk.NewDecWithPrec(5, 2),
+			expectSwapAll:  true,
+		},
+		"error: swap exceeds max slippage": {
+			initializePool: true,
+			poolId:         validPoolId,
+			lowerTick:      DefaultLowerTick,
+			upperTick:      DefaultUpperTick,
+			tokenIn:        sdk.NewCoin(ETH, DefaultAmt0.QuoRaw(10)),
+			maxSlippage:    sdk.ZeroDec(),
+			expectedError:  types.AmountLessThanMinError{},
+		},
+		"error: denom not in pool": {
+			initializePool: true,
+			poolId:         validPoolId,
+			lowerTick:      DefaultLowerTick,
+			upperTick:      DefaultUpperTick,
+			tokenIn:        sdk.NewCoin("uosmo", DefaultAmt0),
+			maxSlippage:    sdk.NewDecWithPrec(5, 2),
+			expectedError:  types.DenomNotInPoolError{PoolId: validPoolId, Denom: "uosmo"},
+		},
+		"error: pool has no price yet": {
+			poolId:        validPoolId,
+			lowerTick:     DefaultLowerTick,
+			upperTick:     DefaultUpperTick,
+			tokenIn:       sdk.NewCoin(ETH, DefaultAmt0),
+			maxSlippage:   sdk.NewDecWithPrec(5, 2),
+			expectedError: types.PoolNotInitializedError{PoolId: validPoolId},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			pool := s.PrepareConcentratedPool()
+			if tc.initializePool {
+				s.SetupDefaultPosition(pool.GetId())
+			}
+
+			owner := s.TestAccs[1]
+			s.FundAcc(owner, sdk.NewCoins(tc.tokenIn))
+			balancesBefore := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+
+			positionId, actualAmount0, actualAmount1, liquidityCreated, _, swappedIn, swappedOut, dust, err := s.App.ConcentratedLiquidityKeeper.CreatePositionSingleAsset(s.Ctx, tc.poolId, owner, tc.lowerTick, tc.upperTick, tc.tokenIn, tc.maxSlippage)
+			if tc.expectedError != nil {
+				s.Require().Error(err)
+				s.Require().IsType(tc.expectedError, err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().True(liquidityCreated.IsPositive())
+
+			liquidity, err := s.App.ConcentratedLiquidityKeeper.GetPositionLiquidity(s.Ctx, positionId)
+			s.Require().NoError(err)
+			s.Require().Equal(liquidityCreated, liquidity)
+
+			s.Require().Equal(tc.tokenIn.Denom, swappedIn.Denom)
+			if tc.expectNoSwap {
+				s.Require().True(swappedIn.IsZero())
+				s.Require().True(swappedOut.IsZero())
+			}
+			if tc.expectSwapAll {
+				s.Require().Equal(tc.tokenIn, swappedIn)
+			}
+
+			// The position absorbs all but a small fraction of each asset.
+			tokenOutDenom := USDC
+			if tc.tokenIn.Denom == USDC {
+				tokenOutDenom = ETH
+			}
+			s.Require().True(dust.AmountOf(tc.tokenIn.Denom).LTE(tc.tokenIn.Amount.QuoRaw(100)))
+			s.Require().True(dust.AmountOf(tokenOutDenom).LTE(swappedOut.Amount.QuoRaw(100)))
+
+			// Each asset the owner holds afterwards is exactly the dust.
+			expectedSpent0, expectedSpent1 := actualAmount0, actualAmount1
+			if tc.tokenIn.Denom == ETH {
+				expectedSpent0 = expectedSpent0.Add(swappedIn.Amount)
+				expectedSpent1 = expectedSpent1.Sub(swappedOut.Amount)
+			} else {
+				expectedSpent0 = expectedSpent0.Sub(swappedOut.Amount)
+				expectedSpent1 = expectedSpent1.Add(swappedIn.Amount)
+			}
+			balancesAfter := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+			s.Require().Equal(balancesBefore.AmountOf(ETH).Sub(expectedSpent0), balancesAfter.AmountOf(ETH))
+			s.Require().Equal(balancesBefore.AmountOf(USDC).Sub(expectedSpent1), balancesAfter.AmountOf(USDC))
+			s.Require().Equal(dust, sdk.NewCoins(sdk.NewCoin(ETH, balancesAfter.AmountOf(ETH)), sdk.NewCoin(USDC, balancesAfter.AmountOf(USDC))))
+		})
+	}
+}
+
+// TestCreateWithdrawRoundingFavorsPool creates and withdraws random positions and asserts that rounding never
+// lets the pool pay out more than was deposited into it.
+func (s *KeeperTestSuite) TestCreateWithdrawRoundingFavorsPool() {
+	s.Setup()
+	r := rand.New(rand.NewSource(12345))
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+
+	// The default position initializes the pool's price and is never withdrawn, so the invariant
+	// below also catches withdrawals paying out of other positions' deposits.
+	s.SetupDefaultPosition(pool.GetId())
+	invariant := cl.PositionLiquidityMatchesPoolBalance(*clKeeper)
+
+	type openPosition struct {
+		owner      sdk.AccAddress
+		positionId uint64
+		liquidity  sdk.Dec
+	}
+
+	var (
+		openPositions []openPosition
+		deposited     = sdk.NewCoins()
+		withdrawn     = sdk.NewCoins()
+	)
+
+	withdraw := func(index int, liquidity sdk.Dec) {
+		position := openPositions[index]
+		amount0, amount1, err := clKeeper.WithdrawPosition(s.Ctx, position.owner, position.positionId, liquidity)
+		s.Require().NoError(err)
+		withdrawn = withdrawn.Add(sdk.NewCoin(ETH, amount0), sdk.NewCoin(USDC, amount1))
+
+		if liquidity.Equal(position.liquidity) {
+			openPositions = append(openPositions[:index], openPositions[index+1:]...)
+		} else {
+			openPositions[index].liquidity = position.liquidity.Sub(liquidity)
+		}
+	}
+
+	for i := 0; i < 50; i++ {
+		if len(openPositions) == 0 || r.Intn(2) == 0 {
+			owner := s.TestAccs[r.Intn(len(s.TestAccs))]
+			amount0 := sdk.NewInt(r.Int63n(DefaultAmt0.Int64()) + 1)
+			amount1 := sdk.NewInt(r.Int63n(DefaultAmt1.Int64()) + 1)
+			lowerTick := DefaultLowerTick - r.Int63n(10000)
+			upperTick := DefaultUpperTick + r.Int63n(10000)
+
+			s.FundAcc(owner, sdk.NewCoins(sdk.NewCoin(ETH, amount0), sdk.NewCoin(USDC, amount1)))
+			positionId, asset0, asset1, liquidity, _, err := clKeeper.CreatePosition(s.Ctx, pool.GetId(), owner, amount0, amount1, sdk.ZeroInt(), sdk.ZeroInt(), lowerTick, upperTick)
+			s.Require().NoError(err)
+			s.Require().True(asset0.LTE(amount0))
+			s.Require().True(asset1.LTE(amount1))
+
+			deposited = deposited.Add(sdk.NewCoin(ETH, asset0), sdk.NewCoin(USDC, asset1))
+			openPositions = append(openPositions, openPosition{owner: owner, positionId: positionId, liquidity: liquidity})
+		} else {
+			// Withdraw either the full position or a random fraction of it.
+			index := r.Intn(len(openPositions))
+			liquidity := openPositions[index].liquidity
+			if r.Intn(2) == 0 {
+				liquidity = liquidity.Mul(sdk.NewDecWithPrec(r.Int63n(99)+1, 2))
+			}
+			withdraw(index, liquidity)
+		}
+
+		s.Require().True(deposited.IsAllGTE(withdrawn), "deposited %s, withdrawn %s", deposited, withdrawn)
+		_, broken := invariant(s.Ctx)
+		s.Require().False(broken)
+	}
+
+	// Withdrawing everything that remains still pays out no more than was deposited.
+	for len(openPositions) > 0 {
+		withdraw(0, openPositions[0].liquidity)
+	}
+	s.Require().True(deposited.IsAllGTE(withdrawn), "deposited %s, withdrawn %s", deposited, withdrawn)
+	_, broken := invariant(s.Ctx)
+	s.Require().False(broken)
+}
+
 func (s *KeeperTestSuite) TestUpdatePosition() {
 	type updatePositionTest struct {
 		poolId                    uint64
@@ -763,7 +1779,7 @@ func (s *KeeperTestSuite) TestUpdatePosition() {
 			upperTick:       DefaultUpperTick,
 			joinTime:        DefaultJoinTime,
 			liquidityDelta:  DefaultLiquidityAmt.Neg(),
-			amount0Expected: DefaultAmt0Expected.Neg(),
+			amount0Expected: DefaultAmt0WithdrawnExpected.Neg(),
 			amount1Expected: DefaultAmt1Expected.Neg(),
 			numPositions:    2,
 			expectedError:   true,
@@ -868,15 +1884,28 @@ func (s *KeeperTestSuite) TestUpdatePosition() {
 
 func (s *KeeperTestSuite) TestinitializeInitialPositionForPool() {
 	type sendTest struct {
-		amount0Desired sdk.Int
-		amount1Desired sdk.Int
-		expectedError  error
+		amount0Desired      sdk.Int
+		amount1Desired      sdk.Int
+		minInitialLiquidity sdk.Dec
+		initialPrice        sdk.Dec
+		expectedError       error
 	}
 	tests := map[string]sendTest{
 		"happy path": {
 			amount0Desired: DefaultAmt0,
 			amount1Desired: DefaultAmt1,
 		},
+		"happy path: liquidity above min initial liquidity": {
+			amount0Desired:      DefaultAmt0,
+			amount1Desired:      DefaultAmt1,
+			minInitialLiquidity: DefaultLiquidityAmt.Sub(sdk.OneDec()),
+		},
+		"error: liquidity below min initial liquidity": {
+			amount0Desired:      DefaultAmt0,
+			amount1Desired:      DefaultAmt1,
+			minInitialLiquidity: DefaultLiquidityAmt.Add(sdk.OneDec()),
+			expectedError:       types.InitialLiquidityTooLowError{},
+		},
 		"error: amount0Desired is zero": {
 			amount0Desired: sdk.ZeroInt(),
 			amount1Desired: DefaultAmt1,
@@ -887,6 +1916,28 @@ func (s *KeeperTestSuite) TestinitializeInitialPositionForPool() {
 			amount1Desired: sdk.ZeroInt(),
 			expectedError:  types.InitialLiquidityZeroError{Amount0: DefaultAmt0, Amount1: sdk.ZeroInt()},
 		},
+		"happy path: initial price set at creation": {
+			amount0Desired: DefaultAmt0,
+			amount1Desired: DefaultAmt1,
+			initialPrice:   sdk.NewDec(4600),
+		},
+		"happy path: initial price set at creation, single sided asset0 below the range": {
+			amount0Desired: DefaultAmt0,
+			amount1Desired: sdk.ZeroInt(),
+			initialPrice:   sdk.NewDec(4000),
+		},
+		"error: initial price set at creation, single sided asset1 below the range": {
+			amount0Desired: sdk.ZeroInt(),
+			amount1Desired: DefaultAmt1,
+			initialPrice:   sdk.NewDec(4000),
+			expectedError:  types.InitialLiquidityTooLowError{},
+		},
+		"error: initial price set at creation, both amounts zero": {
+			amount0Desired: sdk.ZeroInt(),
+			amount1Desired: sdk.ZeroInt(),
+			initialPrice:   sdk.NewDec(4000),
+			expectedError:  types.InitialLiquidityZeroError{Amount0: sdk.ZeroInt(), Amount1: sdk.ZeroInt()},
+		},
 		"error: both amount0Desired and amount01Desired is zero": {
 			amount0Desired: sdk.ZeroInt(),
 			amount1Desired: sdk.ZeroInt(),
@@ -902,14 +1953,58 @@ func (s *KeeperTestSuite) TestinitializeInitialPositionForPool() {
 			// create a CL pool
 			pool := s.PrepareConcentratedPool()
 
+			if !tc.minInitialLiquidity.IsNil() {
+				params := s.App.ConcentratedLiquidityKeeper.GetParams(s.Ctx)
+				params.MinInitialLiquidity = tc.minInitialLiquidity
+				s.App.ConcentratedLiquidityKeeper.SetParams(s.Ctx, params)
+			}
+
+			if !tc.initialPrice.IsNil() {
+				clPool, ok := pool.(*clmodel.Pool)
+				s.Require().True(ok)
+				clPool.InitialPrice = tc.initialPrice
+				err := s.App.ConcentratedLiquidityKeeper.SetPool(s.Ctx, clPool)
+				s.Require().NoError(err)
+				pool = clPool
+			}
+
+			sqrtPriceLowerTick, sqrtPriceUpperTick, err := math.TicksToSqrtPrice(DefaultLowerTick, DefaultUpperTick, pool.GetExponentAtPriceOne())
+			s.Require().NoError(err)
+
 			// System under test
-			err := s.App.ConcentratedLiquidityKeeper.InitializeInitialPositionForPool(s.Ctx, pool, tc.amount0Desired, tc.amount1Desired)
+			s.Ctx = s.Ctx.WithEventManager(sdk.NewEventManager())
+			err = s.App.ConcentratedLiquidityKeeper.InitializeInitialPositionForPool(s.Ctx, pool, DefaultPositionId, tc.amount0Desired, tc.amount1Desired, sqrtPriceLowerTick, sqrtPriceUpperTick)
 
 			if tc.expectedError != nil {
 				s.Require().Error(err)
 				s.Require().ErrorAs(err, &tc.expectedError)
+				s.AssertEventEmitted(s.Ctx, types.TypeEvtPoolInitialized, 0)
 			} else {
 				s.Require().NoError(err)
+
+				// The pool initialized event carries the initial price and the seeding position.
+				s.AssertEventEmitted(s.Ctx, types.TypeEvtPoolInitialized, 1)
+				initializedPool, err := s.App.ConcentratedLiquidityKeeper.GetPoolById(s.Ctx, pool.GetId())
+				s.Require().NoError(err)
+
+				// A price set at creation takes precedence over the ratio of the seeding amounts.
+				if !tc.initialPrice.IsNil() {
+					expectedSqrtPrice, err := tc.initialPrice.ApproxSqrt()
+					s.Require().NoError(err)
+					s.Require().Equal(expectedSqrtPrice, initializedPool.GetCurrentSqrtPrice())
+				}
+				event := s.FindEvent(s.Ctx.EventManager().Events(), types.TypeEvtPoolInitialized)
+				expectedAttributes := map[string]string{
+					types.AttributeKeyPoolId:        strconv.FormatUint(initializedPool.GetId(), 10),
+					types.AttributeInitialSqrtPrice: initializedPool.GetCurrentSqrtPrice().String(),
+					types.AttributeInitialTick:      initializedPool.GetCurrentTick().String(),
+					types.AttributeKeyPositionId:    strconv.FormatUint(DefaultPositionId, 10),
+				}
+				for _, attribute := range event.Attributes {
+					if expected, ok := expectedAttributes[string(attribute.Key)]; ok {
+						s.Require().Equal(expected, string(attribute.Value))
+					}
+				}
 			}
 		})
 	}
@@ -72,6 +72,27 @@ func (msg MsgCreateConcentratedPool) ValidateBasic() error {
 		return cltypes.InvalidSwapFeeError{ActualFee: swapFee}
 	}
 
+	if !msg.EarlyExitFee.IsNil() && (msg.EarlyExitFee.IsNegative() || msg.EarlyExitFee.GTE(one)) {
+		return cltypes.InvalidEarlyExitFeeError{ActualFee: msg.EarlyExitFee}
+	}
+
+	if msg.MinHoldDuration < 0 {
+		return cltypes.NegativeDurationError{Duration: msg.MinHoldDuration}
+	}
+
+	if !msg.ProtocolFeeFraction.IsNil() && (msg.ProtocolFeeFraction.IsNegative() || msg.ProtocolFeeFraction.GT(one)) {
+		return cltypes.InvalidProtocolFeeFractionError{ActualFraction: msg.ProtocolFeeFraction}
+	}
+
+	if !msg.InitialPrice.IsNil() && !msg.InitialPrice.IsZero() {
+		if msg.InitialPrice.IsNegative() {
+			return cltypes.SpotPriceNegativeError{ProvidedPrice: msg.InitialPrice}
+		}
+		if msg.InitialPrice.LT(cltypes.MinSpotPrice) || msg.InitialPrice.GT(cltypes.MaxSpotPrice) {
+			return cltypes.PriceBoundError{ProvidedPrice: msg.InitialPrice, MinSpotPrice: cltypes.MinSpotPrice, MaxSpotPrice: cltypes.MaxSpotPrice}
+		}
+	}
+
 	return nil
 }
 
@@ -108,7 +129,27 @@ func (msg MsgCreateConcentratedPool) InitialLiquidity() sdk.Coins {
 
 func (msg MsgCreateConcentratedPool) CreatePool(ctx sdk.Context, poolID uint64) (poolmanagertypes.PoolI, error) {
 	poolI, err := NewConcentratedLiquidityPool(poolID, msg.Denom0, msg.Denom1, msg.TickSpacing, msg.ExponentAtPriceOne, msg.SwapFee)
-	return &poolI, err
+	if err != nil {
+		return &poolI, err
+	}
+
+	// The early exit fee is optional and disabled unless set.
+	if !msg.EarlyExitFee.IsNil() {
+		poolI.EarlyExitFee = msg.EarlyExitFee
+	}
+	poolI.MinHoldDuration = msg.MinHoldDuration
+
+	// The protocol fee is optional and none of the swap fee is routed to the protocol unless set.
+	if !msg.ProtocolFeeFraction.IsNil() {
+		poolI.ProtocolFeeFraction = msg.ProtocolFeeFraction
+	}
+
+	// The initial price is optional and derived from the first position's token ratio unless set.
+	if !msg.InitialPrice.IsNil() {
+		poolI.InitialPrice = msg.InitialPrice
+	}
+
+	return &poolI, nil
 }
 
 func (msg MsgCreateConcentratedPool) GetPoolType() poolmanagertypes.PoolType {
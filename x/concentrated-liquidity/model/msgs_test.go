@@ -2,6 +2,7 @@ package model_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -127,6 +128,124 @@ func TestMsgCreateConcentratedPool(t *testing.T) {
 			},
 			expectPass: false,
 		},
+		{
+			name: "with early exit fee",
+			msg: clmodel.MsgCreateConcentratedPool{
+				Sender:             addr1,
+				Denom0:             ETH,
+				Denom1:             USDC,
+				TickSpacing:        DefaultTickSpacing,
+				ExponentAtPriceOne: DefaultExponentAtPriceOne,
+				SwapFee:            DefaultSwapFee,
+				EarlyExitFee:       sdk.MustNewDecFromStr("0.01"),
+				MinHoldDuration:    time.Hour,
+			},
+			expectPass: true,
+		},
+		{
+			name: "negative early exit fee",
+			msg: clmodel.MsgCreateConcentratedPool{
+				Sender:             addr1,
+				Denom0:             ETH,
+				Denom1:             USDC,
+				TickSpacing:        DefaultTickSpacing,
+				ExponentAtPriceOne: DefaultExponentAtPriceOne,
+				SwapFee:            DefaultSwapFee,
+				EarlyExitFee:       sdk.ZeroDec().Sub(sdk.SmallestDec()),
+			},
+			expectPass: false,
+		},
+		{
+			name: "early exit fee == 1",
+			msg: clmodel.MsgCreateConcentratedPool{
+				Sender:             addr1,
+				Denom0:             ETH,
+				Denom1:             USDC,
+				TickSpacing:        DefaultTickSpacing,
+				ExponentAtPriceOne: DefaultExponentAtPriceOne,
+				SwapFee:            DefaultSwapFee,
+				EarlyExitFee:       sdk.OneDec(),
+			},
+			expectPass: false,
+		},
+		{
+			name: "with protocol fee fraction",
+			msg: clmodel.MsgCreateConcentratedPool{
+				Sender:              addr1,
+				Denom0:              ETH,
+				Denom1:              USDC,
+				TickSpacing:         DefaultTickSpacing,
+				ExponentAtPriceOne:  DefaultExponentAtPriceOne,
+				SwapFee:             DefaultSwapFee,
+				ProtocolFeeFraction: sdk.OneDec(),
+			},
+			expectPass: true,
+		},
+		{
+			name: "protocol fee fraction > 1",
+			msg: clmodel.MsgCreateConcentratedPool{
+				Sender:              addr1,
+				Denom0:              ETH,
+				Denom1:              USDC,
+				TickSpacing:         DefaultTickSpacing,
+				ExponentAtPriceOne:  DefaultExponentAtPriceOne,
+				SwapFee:             DefaultSwapFee,
+				ProtocolFeeFraction: sdk.OneDec().Add(sdk.SmallestDec()),
+			},
+			expectPass: false,
+		},
+		{
+			name: "with initial price",
+			msg: clmodel.MsgCreateConcentratedPool{
+				Sender:             addr1,
+				Denom0:             ETH,
+				Denom1:             USDC,
+				TickSpacing:        DefaultTickSpacing,
+				ExponentAtPriceOne: DefaultExponentAtPriceOne,
+				SwapFee:            DefaultSwapFee,
+				InitialPrice:       sdk.NewDec(5000),
+			},
+			expectPass: true,
+		},
+		{
+			name: "negative initial price",
+			msg: clmodel.MsgCreateConcentratedPool{
+				Sender:             addr1,
+				Denom0:             ETH,
+				Denom1:             USDC,
+				TickSpacing:        DefaultTickSpacing,
+				ExponentAtPriceOne: DefaultExponentAtPriceOne,
+				SwapFee:            DefaultSwapFee,
+				InitialPrice:       sdk.NewDec(-5000),
+			},
+			expectPass: false,
+		},
+		{
+			name: "initial price above max spot price",
+			msg: clmodel.MsgCreateConcentratedPool{
+				Sender:             addr1,
+				Denom0:             ETH,
+				Denom1:             USDC,
+				TickSpacing:        DefaultTickSpacing,
+				ExponentAtPriceOne: DefaultExponentAtPriceOne,
+				SwapFee:            DefaultSwapFee,
+				InitialPrice:       cltypes.MaxSpotPrice.Add(sdk.OneDec()),
+			},
+			expectPass: false,
+		},
+		{
+			name: "negative min hold duration",
+			msg: clmodel.MsgCreateConcentratedPool{
+				Sender:             addr1,
+				Denom0:             ETH,
+				Denom1:             USDC,
+				TickSpacing:        DefaultTickSpacing,
+				ExponentAtPriceOne: DefaultExponentAtPriceOne,
+				SwapFee:            DefaultSwapFee,
+				MinHoldDuration:    -time.Second,
+			},
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
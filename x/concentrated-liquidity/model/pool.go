@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/internal/math"
 	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
@@ -14,7 +15,8 @@ import (
 )
 
 const (
-	incentivesAddressPrefix = "incentives"
+	incentivesAddressPrefix   = "incentives"
+	protocolFeesAddressPrefix = "protocolfees"
 )
 
 var (
@@ -53,6 +55,9 @@ func NewConcentratedLiquidityPool(poolId uint64, denom0, denom1 string, tickSpac
 		TickSpacing:          tickSpacing,
 		ExponentAtPriceOne:   exponentAtPriceOne,
 		SwapFee:              swapFee,
+		EarlyExitFee:         sdk.ZeroDec(),
+		ProtocolFeeFraction:  sdk.ZeroDec(),
+		InitialPrice:         sdk.ZeroDec(),
 	}
 
 	return pool, nil
@@ -76,6 +81,12 @@ func (p Pool) GetIncentivesAddress() sdk.AccAddress {
 	return addr
 }
 
+// GetProtocolFeesAddress returns the address that the protocol's share of the pool's swap fees is routed to.
+// It is derived from the pool id rather than stored, so that it also exists for pools created before protocol fees.
+func (p Pool) GetProtocolFeesAddress() sdk.AccAddress {
+	return osmoutils.NewModuleAddressWithPrefix(types.ModuleName, protocolFeesAddressPrefix, sdk.Uint64ToBigEndian(p.GetId()))
+}
+
 // GetId returns the id of the concentrated liquidity pool
 func (p Pool) GetId() uint64 {
 	return p.Id
@@ -154,6 +165,38 @@ func (p Pool) GetLiquidity() sdk.Dec {
 	return p.CurrentTickLiquidity
 }
 
+// GetEarlyExitFee returns the fraction of withdrawn amounts charged for withdrawing before the minimum hold duration.
+// Pools created before the early exit fee existed have no fee.
+func (p Pool) GetEarlyExitFee() sdk.Dec {
+	if p.EarlyExitFee.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return p.EarlyExitFee
+}
+
+// GetProtocolFeeFraction returns the fraction of the swap fee that is routed to the protocol rather than to liquidity providers.
+// Pools created before protocol fees existed route none of it.
+func (p Pool) GetProtocolFeeFraction() sdk.Dec {
+	if p.ProtocolFeeFraction.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return p.ProtocolFeeFraction
+}
+
+// GetInitialPrice returns the spot price the pool is initialized at when its first position is created.
+// Zero means that the initial price is derived from the token ratio of the first position.
+func (p Pool) GetInitialPrice() sdk.Dec {
+	if p.InitialPrice.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return p.InitialPrice
+}
+
+// GetMinHoldDuration returns the duration since join time before which withdrawals are charged the early exit fee.
+func (p Pool) GetMinHoldDuration() time.Duration {
+	return p.MinHoldDuration
+}
+
 // GetLastLiquidityUpdate returns the last time there was a change in pool liquidity or active tick.
 func (p Pool) GetLastLiquidityUpdate() time.Time {
 	return p.LastLiquidityUpdate
@@ -178,6 +221,11 @@ func (p *Pool) SetCurrentTick(newTick sdk.Int) {
 	p.CurrentTick = newTick
 }
 
+// SetTickSpacing updates the tick spacing of the pool. Existing positions are not re-aligned.
+func (p *Pool) SetTickSpacing(newTickSpacing uint64) {
+	p.TickSpacing = newTickSpacing
+}
+
 // SetLastLiquidityUpdate updates the pool's LastLiquidityUpdate to newTime.
 func (p *Pool) SetLastLiquidityUpdate(newTime time.Time) {
 	p.LastLiquidityUpdate = newTime
@@ -208,27 +256,43 @@ func (p *Pool) UpdateLiquidityIfActivePosition(ctx sdk.Context, lowerTick, upper
 // - Current tick is above the position ( p.CurrentTick >= p.upperTick ).
 //   - The provided liquidity is distributed in token1 only.
 //
+// The rounding direction applies to the magnitude of the returned amounts, which carry the sign of liquidityDelta.
+// Deposits should round up so that the pool receives at least the amount implied by the liquidity, and
+// withdrawals should round down so that the pool never pays out more than it holds. osmomath.RoundUnconstrained
+// returns the amounts without rounding.
+//
 // TODO: add tests.
-func (p Pool) CalcActualAmounts(ctx sdk.Context, lowerTick, upperTick int64, sqrtRatioLowerTick, sqrtRatioUpperTick sdk.Dec, liquidityDelta sdk.Dec) (actualAmountDenom0 sdk.Dec, actualAmountDenom1 sdk.Dec) {
+func (p Pool) CalcActualAmounts(ctx sdk.Context, lowerTick, upperTick int64, sqrtRatioLowerTick, sqrtRatioUpperTick sdk.Dec, liquidityDelta sdk.Dec, roundingDir osmomath.RoundingDirection) (actualAmountDenom0 sdk.Dec, actualAmountDenom1 sdk.Dec) {
+	liquidity := liquidityDelta.Abs()
+	roundUp := roundingDir == osmomath.RoundUp
+
 	if p.isCurrentTickInRange(lowerTick, upperTick) {
 		// outcome one: the current price falls within the position
 		// if this is the case, we attempt to provide liquidity evenly between asset0 and asset1
 		// we also update the pool liquidity since the virtual liquidity is modified by this position's creation
 		currentSqrtPrice := p.CurrentSqrtPrice
-		actualAmountDenom0 = math.CalcAmount0Delta(liquidityDelta, currentSqrtPrice, sqrtRatioUpperTick, false)
-		actualAmountDenom1 = math.CalcAmount1Delta(liquidityDelta, currentSqrtPrice, sqrtRatioLowerTick, false)
+		actualAmountDenom0 = math.CalcAmount0Delta(liquidity, currentSqrtPrice, sqrtRatioUpperTick, roundUp)
+		actualAmountDenom1 = math.CalcAmount1Delta(liquidity, currentSqrtPrice, sqrtRatioLowerTick, roundUp)
 	} else if p.CurrentTick.LT(sdk.NewInt(lowerTick)) {
 		// outcome two: position is below current price
 		// this means position is solely made up of asset0
 		actualAmountDenom1 = sdk.ZeroDec()
-		actualAmountDenom0 = math.CalcAmount0Delta(liquidityDelta, sqrtRatioLowerTick, sqrtRatioUpperTick, false)
+		actualAmountDenom0 = math.CalcAmount0Delta(liquidity, sqrtRatioLowerTick, sqrtRatioUpperTick, roundUp)
 	} else {
 		// outcome three: position is above current price
 		// this means position is solely made up of asset1
 		actualAmountDenom0 = sdk.ZeroDec()
-		actualAmountDenom1 = math.CalcAmount1Delta(liquidityDelta, sqrtRatioLowerTick, sqrtRatioUpperTick, false)
+		actualAmountDenom1 = math.CalcAmount1Delta(liquidity, sqrtRatioLowerTick, sqrtRatioUpperTick, roundUp)
 	}
 
+	if roundingDir == osmomath.RoundDown {
+		actualAmountDenom0 = actualAmountDenom0.TruncateDec()
+		actualAmountDenom1 = actualAmountDenom1.TruncateDec()
+	}
+
+	if liquidityDelta.IsNegative() {
+		return actualAmountDenom0.Neg(), actualAmountDenom1.Neg()
+	}
 	return actualAmountDenom0, actualAmountDenom1
 }
 
@@ -12,6 +12,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
@@ -55,6 +56,22 @@ type Pool struct {
 	// last_liquidity_update is the last time either the pool liquidity or the
 	// active tick changed
 	LastLiquidityUpdate time.Time `protobuf:"bytes,12,opt,name=last_liquidity_update,json=lastLiquidityUpdate,proto3,stdtime" json:"last_liquidity_update" yaml:"last_liquidity_update"`
+	// early_exit_fee is the fraction of the withdrawn amounts charged when a
+	// position is withdrawn before min_hold_duration has elapsed since it was
+	// joined. The fee is distributed to the pool's remaining in-range liquidity.
+	// Zero disables the fee.
+	EarlyExitFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=early_exit_fee,json=earlyExitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"early_exit_fee" yaml:"early_exit_fee"`
+	// min_hold_duration is the duration since a position's join time before
+	// which withdrawals are charged the early_exit_fee.
+	MinHoldDuration time.Duration `protobuf:"bytes,14,opt,name=min_hold_duration,json=minHoldDuration,proto3,stdduration" json:"min_hold_duration" yaml:"min_hold_duration"`
+	// protocol_fee_fraction is the fraction of the swap fee that is routed to
+	// the pool's protocol fees address rather than to liquidity providers. Zero
+	// routes the whole swap fee to liquidity providers.
+	ProtocolFeeFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=protocol_fee_fraction,json=protocolFeeFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"protocol_fee_fraction" yaml:"protocol_fee_fraction"`
+	// initial_price is the spot price the pool is initialized at when its first
+	// position is created. Zero derives the initial price from the token ratio
+	// of the first position instead.
+	InitialPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=initial_price,json=initialPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"initial_price" yaml:"initial_price"`
 }
 
 func (m *Pool) Reset()      { *m = Pool{} }
@@ -89,8 +106,103 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// FeeRevenueSnapshot records the cumulative swap fees collected by a pool as
+// of the block time it is stored under.
+type FeeRevenueSnapshot struct {
+	CumulativeFees github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=cumulative_fees,json=cumulativeFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"cumulative_fees" yaml:"cumulative_fees"`
+}
+
+func (m *FeeRevenueSnapshot) Reset()         { *m = FeeRevenueSnapshot{} }
+func (m *FeeRevenueSnapshot) String() string { return proto.CompactTextString(m) }
+func (*FeeRevenueSnapshot) ProtoMessage()    {}
+func (*FeeRevenueSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3526ea5373d96c9a, []int{1}
+}
+func (m *FeeRevenueSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeRevenueSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeRevenueSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeRevenueSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeRevenueSnapshot.Merge(m, src)
+}
+func (m *FeeRevenueSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeRevenueSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeRevenueSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeRevenueSnapshot proto.InternalMessageInfo
+
+func (m *FeeRevenueSnapshot) GetCumulativeFees() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.CumulativeFees
+	}
+	return nil
+}
+
+// PendingProtocolFees records the protocol fees accrued by a pool that have
+// not yet been routed to its protocol fees address because they are smaller
+// than a whole unit.
+type PendingProtocolFees struct {
+	Fees github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"fees" yaml:"fees"`
+}
+
+func (m *PendingProtocolFees) Reset()         { *m = PendingProtocolFees{} }
+func (m *PendingProtocolFees) String() string { return proto.CompactTextString(m) }
+func (*PendingProtocolFees) ProtoMessage()    {}
+func (*PendingProtocolFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3526ea5373d96c9a, []int{2}
+}
+func (m *PendingProtocolFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingProtocolFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingProtocolFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingProtocolFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingProtocolFees.Merge(m, src)
+}
+func (m *PendingProtocolFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingProtocolFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingProtocolFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingProtocolFees proto.InternalMessageInfo
+
+func (m *PendingProtocolFees) GetFees() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Pool)(nil), "osmosis.concentratedliquidity.v1beta1.Pool")
+	proto.RegisterType((*FeeRevenueSnapshot)(nil), "osmosis.concentratedliquidity.v1beta1.FeeRevenueSnapshot")
+	proto.RegisterType((*PendingProtocolFees)(nil), "osmosis.concentratedliquidity.v1beta1.PendingProtocolFees")
 }
 
 func init() {
@@ -98,47 +210,63 @@ func init() {
 }
 
 var fileDescriptor_3526ea5373d96c9a = []byte{
-	// 626 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x4e, 0xdb, 0x40,
-	0x10, 0xb6, 0x29, 0x10, 0xd8, 0x20, 0x5a, 0x96, 0x9f, 0x1a, 0x54, 0x6c, 0x64, 0xa9, 0x55, 0x2a,
-	0x35, 0x76, 0xd3, 0xaa, 0x17, 0x6e, 0xa4, 0x3f, 0x12, 0x12, 0x2a, 0xc8, 0xd0, 0x4b, 0x85, 0x64,
-	0x39, 0xf6, 0x62, 0x56, 0xb1, 0xbd, 0x8e, 0x77, 0x43, 0xc9, 0xb1, 0x87, 0x4a, 0x3d, 0x72, 0xec,
-	0x91, 0x87, 0xe8, 0x43, 0xa0, 0x9e, 0x50, 0x4f, 0x55, 0x0f, 0x6e, 0x95, 0xbc, 0x41, 0x9e, 0xa0,
-	0xf2, 0x7a, 0x9d, 0x58, 0x22, 0x3d, 0xe4, 0x64, 0xcf, 0x37, 0x33, 0xdf, 0x7c, 0x33, 0xbb, 0xb3,
-	0xe0, 0x29, 0xa1, 0x21, 0xa1, 0x98, 0x9a, 0x2e, 0x89, 0x5c, 0x14, 0xb1, 0xc4, 0x61, 0xc8, 0xab,
-	0x07, 0xb8, 0xd3, 0xc5, 0x1e, 0x66, 0x3d, 0x33, 0x26, 0x24, 0x30, 0xe2, 0x84, 0x30, 0x02, 0x1f,
-	0x8b, 0x50, 0xa3, 0x1c, 0x3a, 0x8a, 0x34, 0x2e, 0x1a, 0x2d, 0xc4, 0x9c, 0xc6, 0xd6, 0xa6, 0xcb,
-	0xe3, 0x6c, 0x9e, 0x64, 0xe6, 0x46, 0xce, 0xb0, 0xb5, 0xe6, 0x13, 0x9f, 0xe4, 0x78, 0xf6, 0x27,
-	0x50, 0xcd, 0x27, 0xc4, 0x0f, 0x90, 0xc9, 0xad, 0x56, 0xf7, 0xcc, 0x64, 0x38, 0x44, 0x94, 0x39,
-	0x61, 0x9c, 0x07, 0xe8, 0x3f, 0x2b, 0x60, 0xf6, 0x88, 0x90, 0x00, 0x3e, 0x03, 0x15, 0xc7, 0xf3,
-	0x12, 0x44, 0xa9, 0x22, 0xef, 0xc8, 0xb5, 0xc5, 0x26, 0x1c, 0xa6, 0xda, 0x72, 0xcf, 0x09, 0x83,
-	0x5d, 0x5d, 0x38, 0x74, 0xab, 0x08, 0x81, 0x07, 0x00, 0x62, 0x2e, 0x14, 0x5f, 0x20, 0x6a, 0x17,
-	0x89, 0x33, 0x3c, 0x71, 0x7b, 0x98, 0x6a, 0x9b, 0x79, 0xe2, 0xdd, 0x18, 0xdd, 0x5a, 0x19, 0x83,
-	0x7b, 0x82, 0x6d, 0x19, 0xcc, 0x60, 0x4f, 0xb9, 0xb7, 0x23, 0xd7, 0x66, 0xad, 0x19, 0xec, 0xc1,
-	0x2f, 0x32, 0xd8, 0x70, 0xbb, 0x49, 0x82, 0x22, 0x66, 0x33, 0xec, 0xb6, 0xed, 0xd1, 0x24, 0x94,
-	0x59, 0x5e, 0xe2, 0xf0, 0x26, 0xd5, 0xa4, 0xdf, 0xa9, 0xf6, 0xc4, 0xc7, 0xec, 0xbc, 0xdb, 0x32,
-	0x5c, 0x12, 0x8a, 0x69, 0x88, 0x4f, 0x9d, 0x7a, 0x6d, 0x93, 0xf5, 0x62, 0x44, 0x8d, 0x37, 0xc8,
-	0x1d, 0xa6, 0xda, 0x76, 0x2e, 0x68, 0x32, 0xab, 0x6e, 0xad, 0x09, 0xc7, 0x09, 0x76, 0xdb, 0x07,
-	0x05, 0x0c, 0x37, 0xc0, 0x3c, 0x23, 0x6d, 0x14, 0x3d, 0x57, 0xe6, 0xb2, 0xb2, 0x96, 0xb0, 0x46,
-	0x78, 0x43, 0x99, 0x2f, 0xe1, 0x0d, 0xd8, 0x01, 0xb0, 0x28, 0x40, 0x3b, 0x09, 0xb3, 0xe3, 0x04,
-	0xbb, 0x48, 0xa9, 0x70, 0xc9, 0xaf, 0xa7, 0x96, 0xbc, 0x92, 0x4b, 0xa6, 0x31, 0x11, 0x4c, 0xba,
-	0xf5, 0x40, 0xd0, 0x1f, 0x77, 0x12, 0x76, 0x94, 0x41, 0xf0, 0x1c, 0x2c, 0x95, 0x7b, 0x52, 0x16,
-	0x78, 0xb1, 0xb7, 0x53, 0x14, 0xdb, 0x8f, 0xd8, 0x30, 0xd5, 0x56, 0xef, 0xce, 0x47, 0xb7, 0xaa,
-	0xa5, 0xa9, 0xc0, 0x5d, 0xb0, 0xc4, 0xa7, 0x46, 0x63, 0xc7, 0xc5, 0x91, 0xaf, 0x2c, 0x66, 0xc7,
-	0xd5, 0x7c, 0x38, 0xce, 0x2d, 0x7b, 0x75, 0xab, 0x9a, 0x99, 0xc7, 0xb9, 0x05, 0x3f, 0xcb, 0x60,
-	0x1d, 0x5d, 0xc6, 0x24, 0xca, 0xb8, 0x1d, 0xd1, 0x8e, 0x4d, 0x22, 0xa4, 0x00, 0xae, 0xf7, 0xfd,
-	0xd4, 0x7a, 0x1f, 0xe5, 0x35, 0x27, 0x92, 0xea, 0x16, 0x2c, 0xf0, 0xbd, 0x7c, 0x4c, 0x87, 0x11,
-	0x82, 0xa7, 0x60, 0x81, 0x7e, 0x72, 0x62, 0xfb, 0x0c, 0x21, 0xa5, 0xca, 0xab, 0xee, 0x4d, 0x7d,
-	0x24, 0xf7, 0xc5, 0x91, 0x08, 0x1e, 0xdd, 0xaa, 0x64, 0xbf, 0xef, 0x10, 0x82, 0x97, 0x60, 0x3d,
-	0x70, 0x28, 0x1b, 0xdf, 0x29, 0xbb, 0x1b, 0x7b, 0x0e, 0x43, 0xca, 0xd2, 0x8e, 0x5c, 0xab, 0xbe,
-	0xd8, 0x32, 0xf2, 0x45, 0x34, 0x8a, 0x45, 0x34, 0x4e, 0x8a, 0x45, 0x6c, 0xd6, 0x32, 0x19, 0xe3,
-	0x96, 0x26, 0xd2, 0xe8, 0x57, 0x7f, 0x34, 0xd9, 0x5a, 0xcd, 0x7c, 0xa3, 0xeb, 0xf9, 0x81, 0x7b,
-	0x76, 0x57, 0xbe, 0x5e, 0x6b, 0xd2, 0xb7, 0x6b, 0x4d, 0xfa, 0xf1, 0xbd, 0x3e, 0x97, 0xad, 0xf2,
-	0x7e, 0xf3, 0xf4, 0xa6, 0xaf, 0xca, 0xb7, 0x7d, 0x55, 0xfe, 0xdb, 0x57, 0xe5, 0xab, 0x81, 0x2a,
-	0xdd, 0x0e, 0x54, 0xe9, 0xd7, 0x40, 0x95, 0x3e, 0x36, 0x4b, 0xad, 0x8a, 0x27, 0xa7, 0x1e, 0x38,
-	0x2d, 0x5a, 0x18, 0xe6, 0x45, 0xe3, 0x95, 0x79, 0xf9, 0xbf, 0x07, 0x2b, 0x24, 0x1e, 0x0a, 0x5a,
-	0xf3, 0xbc, 0x87, 0x97, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xdf, 0xcb, 0xdc, 0x32, 0xdf, 0x04,
-	0x00, 0x00,
+	// 895 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4d, 0x6f, 0xdc, 0x44,
+	0x18, 0x5e, 0xa7, 0x69, 0xd2, 0xce, 0x6e, 0x37, 0xcd, 0xe4, 0x03, 0x27, 0x6a, 0xd7, 0xd1, 0x08,
+	0xd0, 0x22, 0x88, 0xcd, 0x16, 0x71, 0xc9, 0x2d, 0x6e, 0xbb, 0x50, 0xa9, 0xd0, 0xc8, 0x29, 0x17,
+	0x54, 0xc9, 0x9a, 0xb5, 0x27, 0x9b, 0xd1, 0xda, 0x33, 0x8e, 0x67, 0x1c, 0x36, 0x47, 0x90, 0x90,
+	0x90, 0xb8, 0xf4, 0xc0, 0xa1, 0xc7, 0x9c, 0xe1, 0xca, 0x8f, 0xa8, 0x38, 0xf5, 0x88, 0x38, 0x6c,
+	0x51, 0xf2, 0x0f, 0xf2, 0x0b, 0xd0, 0x8c, 0xc7, 0xd9, 0x2d, 0x59, 0xa4, 0x2c, 0x27, 0xfb, 0xfd,
+	0x7a, 0xde, 0xe7, 0x79, 0xe7, 0x0b, 0x7c, 0xc4, 0x45, 0xca, 0x05, 0x15, 0x5e, 0xc4, 0x59, 0x44,
+	0x98, 0xcc, 0xb1, 0x24, 0xf1, 0x76, 0x42, 0x8f, 0x0a, 0x1a, 0x53, 0x79, 0xe2, 0x65, 0x9c, 0x27,
+	0x6e, 0x96, 0x73, 0xc9, 0xe1, 0x07, 0x26, 0xd5, 0x9d, 0x4c, 0xbd, 0xcc, 0x74, 0x8f, 0x3b, 0x3d,
+	0x22, 0x71, 0x67, 0x73, 0x23, 0xd2, 0x79, 0xa1, 0x2e, 0xf2, 0x4a, 0xa3, 0x44, 0xd8, 0x5c, 0xed,
+	0xf3, 0x3e, 0x2f, 0xfd, 0xea, 0xcf, 0x78, 0x5b, 0x65, 0x8e, 0xd7, 0xc3, 0x82, 0x78, 0x06, 0xc5,
+	0x8b, 0x38, 0x65, 0x26, 0xee, 0xf4, 0x39, 0xef, 0x27, 0xc4, 0xd3, 0x56, 0xaf, 0x38, 0xf0, 0x24,
+	0x4d, 0x89, 0x90, 0x38, 0xcd, 0x2a, 0x80, 0x7f, 0x27, 0xc4, 0x45, 0x8e, 0x25, 0xe5, 0x06, 0x00,
+	0x9d, 0xd6, 0xc1, 0xfc, 0x1e, 0xe7, 0x09, 0xfc, 0x04, 0x2c, 0xe2, 0x38, 0xce, 0x89, 0x10, 0xb6,
+	0xb5, 0x65, 0xb5, 0x6f, 0xfb, 0xf0, 0x62, 0xe4, 0x34, 0x4f, 0x70, 0x9a, 0xec, 0x20, 0x13, 0x40,
+	0x41, 0x95, 0x02, 0x9f, 0x02, 0x48, 0xb5, 0x50, 0x7a, 0x4c, 0x44, 0x58, 0x15, 0xce, 0xe9, 0xc2,
+	0xfb, 0x17, 0x23, 0x67, 0xa3, 0x2c, 0xbc, 0x9a, 0x83, 0x82, 0xe5, 0xb1, 0x73, 0xd7, 0xa0, 0x35,
+	0xc1, 0x1c, 0x8d, 0xed, 0x1b, 0x5b, 0x56, 0x7b, 0x3e, 0x98, 0xa3, 0x31, 0xfc, 0xd1, 0x02, 0xeb,
+	0x51, 0x91, 0xe7, 0x84, 0xc9, 0x50, 0xd2, 0x68, 0x10, 0x5e, 0x4e, 0xd2, 0x9e, 0xd7, 0x2d, 0x9e,
+	0xbd, 0x1e, 0x39, 0xb5, 0xbf, 0x46, 0xce, 0x87, 0x7d, 0x2a, 0x0f, 0x8b, 0x9e, 0x1b, 0xf1, 0xd4,
+	0x4c, 0xd3, 0x7c, 0xb6, 0x45, 0x3c, 0xf0, 0xe4, 0x49, 0x46, 0x84, 0xfb, 0x88, 0x44, 0x17, 0x23,
+	0xe7, 0x7e, 0x49, 0x68, 0x3a, 0x2a, 0x0a, 0x56, 0x4d, 0xe0, 0x39, 0x8d, 0x06, 0x4f, 0x2b, 0x37,
+	0x5c, 0x07, 0x0b, 0x92, 0x0f, 0x08, 0xfb, 0xd4, 0xbe, 0xa9, 0xda, 0x06, 0xc6, 0xba, 0xf4, 0x77,
+	0xec, 0x85, 0x09, 0x7f, 0x07, 0x1e, 0x01, 0x58, 0x35, 0x10, 0x47, 0xb9, 0x0c, 0xb3, 0x9c, 0x46,
+	0xc4, 0x5e, 0xd4, 0x94, 0x1f, 0xce, 0x4c, 0x79, 0xb9, 0xa4, 0x2c, 0x32, 0x6e, 0x90, 0x50, 0x70,
+	0xd7, 0xc0, 0xef, 0x1f, 0xe5, 0x72, 0x4f, 0xb9, 0xe0, 0x21, 0x68, 0x4c, 0x6a, 0xb2, 0x6f, 0xe9,
+	0x66, 0x8f, 0x67, 0x68, 0xf6, 0x84, 0xc9, 0x8b, 0x91, 0xb3, 0x72, 0x75, 0x3e, 0x28, 0xa8, 0x4f,
+	0x4c, 0x05, 0xee, 0x80, 0x86, 0x9e, 0x9a, 0xc8, 0x70, 0x44, 0x59, 0xdf, 0xbe, 0xad, 0x96, 0xcb,
+	0x7f, 0x6f, 0x5c, 0x3b, 0x19, 0x45, 0x41, 0x5d, 0x99, 0xfb, 0xa5, 0x05, 0xbf, 0xb7, 0xc0, 0x1a,
+	0x19, 0x66, 0x9c, 0x29, 0x6c, 0x6c, 0xe4, 0x84, 0x9c, 0x11, 0x1b, 0x68, 0xbe, 0x5f, 0xcf, 0xcc,
+	0xf7, 0x5e, 0xd9, 0x73, 0x2a, 0x28, 0x0a, 0x60, 0xe5, 0xdf, 0x2d, 0xc7, 0xf4, 0x8c, 0x11, 0xf8,
+	0x02, 0xdc, 0x12, 0xdf, 0xe1, 0x2c, 0x3c, 0x20, 0xc4, 0xae, 0xeb, 0xae, 0xbb, 0x33, 0x2f, 0xc9,
+	0x92, 0x59, 0x12, 0x83, 0x83, 0x82, 0x45, 0xf5, 0xdb, 0x25, 0x04, 0x0e, 0xc1, 0x5a, 0x82, 0x85,
+	0x1c, 0xef, 0xa9, 0xb0, 0xc8, 0x62, 0x2c, 0x89, 0xdd, 0xd8, 0xb2, 0xda, 0xf5, 0x07, 0x9b, 0x6e,
+	0x79, 0x0e, 0xdd, 0xea, 0x1c, 0xba, 0xcf, 0xab, 0x83, 0xea, 0xb7, 0x15, 0x8d, 0xb1, 0xa4, 0xa9,
+	0x30, 0xe8, 0xe5, 0x5b, 0xc7, 0x0a, 0x56, 0x54, 0xec, 0x72, 0x7b, 0x7e, 0xa3, 0x23, 0x30, 0x05,
+	0x4d, 0x82, 0xf3, 0xe4, 0x24, 0x24, 0x43, 0x2a, 0xb5, 0xba, 0x3b, 0x5a, 0xdd, 0x17, 0x33, 0xab,
+	0x5b, 0x33, 0x33, 0x7d, 0x07, 0x0d, 0x05, 0x0d, 0xed, 0x78, 0x3c, 0xa4, 0x52, 0x09, 0x1d, 0x80,
+	0xe5, 0x94, 0xb2, 0xf0, 0x90, 0x27, 0x71, 0x58, 0xdd, 0x25, 0x76, 0x53, 0x8b, 0xdc, 0xb8, 0x22,
+	0xf2, 0x91, 0x49, 0xf0, 0xdf, 0x37, 0x1a, 0xed, 0xb2, 0xc5, 0x15, 0x04, 0xf4, 0x4a, 0xe9, 0x5b,
+	0x4a, 0x29, 0xfb, 0x92, 0x27, 0x71, 0x55, 0x06, 0x7f, 0xb0, 0xc0, 0x9a, 0x06, 0x8b, 0x78, 0xa2,
+	0xc8, 0x84, 0x07, 0x39, 0x8e, 0x74, 0xc7, 0xa5, 0x99, 0xf7, 0x4d, 0xa9, 0xd1, 0x0c, 0x79, 0x2a,
+	0x28, 0x0a, 0x56, 0x2a, 0x7f, 0x97, 0x90, 0xae, 0xf1, 0xc2, 0x01, 0xb8, 0x43, 0x19, 0x95, 0x14,
+	0x27, 0xe6, 0x40, 0xdf, 0xd5, 0xbd, 0xbb, 0x33, 0xf7, 0x5e, 0xad, 0x2e, 0xc5, 0x09, 0x30, 0x14,
+	0x34, 0x8c, 0xad, 0x37, 0xea, 0xce, 0xf2, 0x4f, 0xa7, 0x4e, 0xed, 0xd5, 0xa9, 0x53, 0xfb, 0xe3,
+	0xf7, 0xed, 0x9b, 0xea, 0x62, 0x7e, 0x82, 0x7e, 0xb3, 0x00, 0xec, 0x12, 0x12, 0x90, 0x63, 0xc2,
+	0x0a, 0xb2, 0xcf, 0x70, 0x26, 0x0e, 0xb9, 0x84, 0xbf, 0x58, 0x60, 0x29, 0x2a, 0xd2, 0x22, 0xc1,
+	0xea, 0x2e, 0x55, 0x42, 0xd4, 0xcd, 0x7d, 0xa3, 0x5d, 0x7f, 0x70, 0xcf, 0x35, 0x2f, 0x8b, 0x7a,
+	0x35, 0xaa, 0xb7, 0x47, 0x71, 0x78, 0xc8, 0x29, 0xf3, 0xbf, 0x32, 0x4b, 0xb1, 0x5e, 0x9d, 0xf8,
+	0x77, 0x20, 0xd0, 0xaf, 0x6f, 0x9d, 0x8f, 0xaf, 0xa7, 0x48, 0xa1, 0x89, 0xa0, 0x39, 0x06, 0xe8,
+	0xaa, 0xfa, 0x9f, 0x2d, 0xb0, 0xb2, 0x47, 0x58, 0x4c, 0x59, 0x7f, 0x6f, 0x3c, 0x4c, 0x01, 0x25,
+	0x98, 0xbf, 0x36, 0x45, 0xdf, 0x50, 0xac, 0x97, 0x14, 0xff, 0x17, 0x2f, 0xdd, 0xcd, 0x7f, 0xf1,
+	0xfa, 0xac, 0x65, 0xbd, 0x39, 0x6b, 0x59, 0x7f, 0x9f, 0xb5, 0xac, 0x97, 0xe7, 0xad, 0xda, 0x9b,
+	0xf3, 0x56, 0xed, 0xcf, 0xf3, 0x56, 0xed, 0x5b, 0x7f, 0x02, 0xcc, 0x3c, 0xde, 0xdb, 0x09, 0xee,
+	0x89, 0xca, 0xf0, 0x8e, 0x3b, 0x9f, 0x7b, 0xc3, 0xff, 0x7a, 0xfa, 0x53, 0x1e, 0x93, 0xa4, 0xb7,
+	0xa0, 0xb7, 0xcb, 0x67, 0xff, 0x0c, 0x00, 0xa8, 0x44, 0x04, 0x4e, 0x29, 0x08, 0x00, 0x00,
 }
 
 func (m *Pool) Marshal() (dAtA []byte, err error) {
@@ -161,13 +289,53 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastLiquidityUpdate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastLiquidityUpdate):])
+	{
+		size := m.InitialPrice.Size()
+		i -= size
+		if _, err := m.InitialPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.ProtocolFeeFraction.Size()
+		i -= size
+		if _, err := m.ProtocolFeeFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinHoldDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinHoldDuration):])
 	if err1 != nil {
 		return 0, err1
 	}
 	i -= n1
 	i = encodeVarintPool(dAtA, i, uint64(n1))
 	i--
+	dAtA[i] = 0x72
+	{
+		size := m.EarlyExitFee.Size()
+		i -= size
+		if _, err := m.EarlyExitFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPool(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastLiquidityUpdate, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastLiquidityUpdate):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintPool(dAtA, i, uint64(n2))
+	i--
 	dAtA[i] = 0x62
 	{
 		size := m.SwapFee.Size()
@@ -260,6 +428,80 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeRevenueSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeRevenueSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeRevenueSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CumulativeFees) > 0 {
+		for iNdEx := len(m.CumulativeFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CumulativeFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingProtocolFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingProtocolFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingProtocolFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPool(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPool(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPool(v)
 	base := offset
@@ -311,6 +553,44 @@ func (m *Pool) Size() (n int) {
 	n += 1 + l + sovPool(uint64(l))
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastLiquidityUpdate)
 	n += 1 + l + sovPool(uint64(l))
+	l = m.EarlyExitFee.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinHoldDuration)
+	n += 1 + l + sovPool(uint64(l))
+	l = m.ProtocolFeeFraction.Size()
+	n += 1 + l + sovPool(uint64(l))
+	l = m.InitialPrice.Size()
+	n += 2 + l + sovPool(uint64(l))
+	return n
+}
+
+func (m *FeeRevenueSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CumulativeFees) > 0 {
+		for _, e := range m.CumulativeFees {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingProtocolFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovPool(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -718,6 +998,309 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyExitFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EarlyExitFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHoldDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinHoldDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolFeeFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeRevenueSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeRevenueSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeRevenueSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CumulativeFees = append(m.CumulativeFees, types1.DecCoin{})
+			if err := m.CumulativeFees[len(m.CumulativeFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingProtocolFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingProtocolFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingProtocolFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types1.DecCoin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPool(dAtA[iNdEx:])
@@ -8,6 +8,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/v15/app/apptesting"
 	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
@@ -138,6 +139,67 @@ func (s *ConcentratedPoolTestSuite) TestUpdateLiquidity() {
 	s.Require().Equal(DefaultLiquidityAmt.Add(sdk.NewDec(10)), mock_pool.CurrentTickLiquidity)
 }
 
+// TestCalcActualAmounts tests that CalcActualAmounts rounds the magnitude of the amounts in the given direction.
+func (s *ConcentratedPoolTestSuite) TestCalcActualAmounts() {
+	s.Setup()
+
+	var (
+		lowerTick          = int64(305450)
+		upperTick          = int64(315000)
+		sqrtPriceLowerTick = sdk.MustNewDecFromStr("67.416615162732695594") // 4545
+		sqrtPriceUpperTick = sdk.MustNewDecFromStr("74.161984870956629487") // 5500
+	)
+
+	pool := model.Pool{
+		CurrentTick:      DefaultCurrTick,
+		CurrentSqrtPrice: DefaultCurrSqrtPrice,
+	}
+
+	tests := []struct {
+		name            string
+		liquidityDelta  sdk.Dec
+		roundingDir     osmomath.RoundingDirection
+		expectedAmount0 sdk.Dec
+		expectedAmount1 sdk.Dec
+	}{
+		{
+			name:            "deposit rounds up",
+			liquidityDelta:  DefaultLiquidityAmt,
+			roundingDir:     osmomath.RoundUp,
+			expectedAmount0: sdk.NewDec(998977),
+			expectedAmount1: sdk.NewDec(5000000000),
+		},
+		{
+			name:            "withdrawal rounds down",
+			liquidityDelta:  DefaultLiquidityAmt.Neg(),
+			roundingDir:     osmomath.RoundDown,
+			expectedAmount0: sdk.NewDec(-998976),
+			expectedAmount1: sdk.NewDec(-5000000000),
+		},
+		{
+			name:            "positive liquidity rounded down",
+			liquidityDelta:  DefaultLiquidityAmt,
+			roundingDir:     osmomath.RoundDown,
+			expectedAmount0: sdk.NewDec(998976),
+			expectedAmount1: sdk.NewDec(5000000000),
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		s.Run(tc.name, func() {
+			amount0, amount1 := pool.CalcActualAmounts(s.Ctx, lowerTick, upperTick, sqrtPriceLowerTick, sqrtPriceUpperTick, tc.liquidityDelta, tc.roundingDir)
+			s.Require().Equal(tc.expectedAmount0.String(), amount0.String())
+			s.Require().Equal(tc.expectedAmount1.String(), amount1.String())
+		})
+	}
+
+	// Unconstrained rounding returns the exact amounts, which lie between the rounded amounts.
+	amount0, _ := pool.CalcActualAmounts(s.Ctx, lowerTick, upperTick, sqrtPriceLowerTick, sqrtPriceUpperTick, DefaultLiquidityAmt, osmomath.RoundUnconstrained)
+	s.Require().True(amount0.GT(sdk.NewDec(998976)))
+	s.Require().True(amount0.LT(sdk.NewDec(998977)))
+}
+
 func (s *ConcentratedPoolTestSuite) TestApplySwap() {
 	// Set up the test suite.
 	s.Setup()
@@ -11,18 +11,22 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -38,6 +42,17 @@ type MsgCreateConcentratedPool struct {
 	TickSpacing        uint64                                 `protobuf:"varint,4,opt,name=tick_spacing,json=tickSpacing,proto3" json:"tick_spacing,omitempty" yaml:"tick_spacing"`
 	ExponentAtPriceOne github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=exponent_at_price_one,json=exponentAtPriceOne,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"exponent_at_price_one" yaml:"exponent_at_price_one"`
 	SwapFee            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
+	// early_exit_fee is the optional fraction of withdrawn amounts charged when
+	// a position is withdrawn before min_hold_duration has elapsed.
+	EarlyExitFee    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,10,opt,name=early_exit_fee,json=earlyExitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"early_exit_fee" yaml:"early_exit_fee"`
+	MinHoldDuration time.Duration                          `protobuf:"bytes,11,opt,name=min_hold_duration,json=minHoldDuration,proto3,stdduration" json:"min_hold_duration" yaml:"min_hold_duration"`
+	// protocol_fee_fraction is the optional fraction of the swap fee routed to
+	// the protocol rather than to liquidity providers.
+	ProtocolFeeFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,12,opt,name=protocol_fee_fraction,json=protocolFeeFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"protocol_fee_fraction" yaml:"protocol_fee_fraction"`
+	// initial_price is the optional spot price the pool starts at once its
+	// first position is created. If unset, the starting price is derived from
+	// the token ratio of the first position.
+	InitialPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=initial_price,json=initialPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"initial_price" yaml:"initial_price"`
 }
 
 func (m *MsgCreateConcentratedPool) Reset()         { *m = MsgCreateConcentratedPool{} }
@@ -101,6 +116,13 @@ func (m *MsgCreateConcentratedPool) GetTickSpacing() uint64 {
 	return 0
 }
 
+func (m *MsgCreateConcentratedPool) GetMinHoldDuration() time.Duration {
+	if m != nil {
+		return m.MinHoldDuration
+	}
+	return 0
+}
+
 // Returns a unique poolID to identify the pool with.
 type MsgCreateConcentratedPoolResponse struct {
 	PoolID uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
@@ -156,38 +178,49 @@ func init() {
 }
 
 var fileDescriptor_6c324e8c9dd2851d = []byte{
-	// 494 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0x69, 0x48, 0xe9, 0x02, 0x42, 0x98, 0x3f, 0x13, 0x21, 0xbb, 0x18, 0x81, 0xca, 0x21,
-	0x5e, 0x5c, 0xc4, 0xa5, 0x27, 0x9a, 0x56, 0xa8, 0x39, 0x00, 0x95, 0xb9, 0xa1, 0x4a, 0x96, 0xbd,
-	0x1e, 0xcc, 0xaa, 0xf6, 0x8e, 0xf1, 0x6e, 0x4b, 0x72, 0xe4, 0x0d, 0x78, 0x08, 0xde, 0x80, 0x1b,
-	0x4f, 0xd0, 0x63, 0x8f, 0x88, 0x83, 0x85, 0x92, 0x37, 0xc8, 0x13, 0x20, 0xff, 0x55, 0x11, 0x6a,
-	0x24, 0x50, 0x4f, 0xde, 0x99, 0xf9, 0x7e, 0xbc, 0xb3, 0x33, 0x64, 0x13, 0x65, 0x8a, 0x92, 0x4b,
-	0xca, 0x50, 0x30, 0x10, 0x2a, 0x0f, 0x14, 0x44, 0x83, 0x84, 0x7f, 0x3a, 0xe2, 0x11, 0x57, 0x13,
-	0x9a, 0x21, 0x26, 0x83, 0x14, 0x23, 0x48, 0xa8, 0x1a, 0x3b, 0x59, 0x8e, 0x0a, 0xf5, 0xc7, 0x0d,
-	0xc7, 0x59, 0xe4, 0x9c, 0x51, 0x9c, 0x63, 0x37, 0x04, 0x15, 0xb8, 0xfd, 0xdb, 0x31, 0xc6, 0x58,
-	0x31, 0x68, 0x79, 0xaa, 0xc9, 0x7d, 0x93, 0x55, 0x6c, 0x1a, 0x06, 0x12, 0x68, 0x03, 0xa5, 0x0c,
-	0xb9, 0xa8, 0xeb, 0xf6, 0x8f, 0x15, 0x72, 0xff, 0xb5, 0x8c, 0x77, 0x72, 0x08, 0x14, 0xec, 0x2c,
-	0x18, 0xec, 0x23, 0x26, 0xfa, 0x53, 0xd2, 0x93, 0x20, 0x22, 0xc8, 0x0d, 0x6d, 0x5d, 0xdb, 0x58,
-	0x1b, 0xde, 0x9c, 0x17, 0xd6, 0xf5, 0x49, 0x90, 0x26, 0x5b, 0x76, 0x9d, 0xb7, 0xbd, 0x06, 0x50,
-	0x42, 0x23, 0x10, 0x98, 0x3e, 0x33, 0x2e, 0xfd, 0x0d, 0xad, 0xf3, 0xb6, 0xd7, 0x00, 0xce, 0xa0,
-	0xae, 0xb1, 0x72, 0x2e, 0xd4, 0x6d, 0xa1, 0xae, 0xbe, 0x45, 0xae, 0x29, 0xce, 0x0e, 0x7d, 0x99,
-	0x05, 0x8c, 0x8b, 0xd8, 0xe8, 0xae, 0x6b, 0x1b, 0xdd, 0xe1, 0xbd, 0x79, 0x61, 0xdd, 0xaa, 0x09,
-	0x8b, 0x55, 0xdb, 0xbb, 0x5a, 0x86, 0xef, 0xea, 0x48, 0xff, 0xa2, 0x91, 0x3b, 0x30, 0xce, 0x50,
-	0x80, 0x50, 0x7e, 0xa0, 0xfc, 0x2c, 0xe7, 0x0c, 0x7c, 0x14, 0x60, 0x5c, 0xae, 0x6c, 0xdf, 0x9c,
-	0x14, 0x56, 0xe7, 0x57, 0x61, 0x3d, 0x89, 0xb9, 0xfa, 0x78, 0x14, 0x3a, 0x0c, 0x53, 0xda, 0x74,
-	0xab, 0xfe, 0x0c, 0x64, 0x74, 0x48, 0xd5, 0x24, 0x03, 0xe9, 0x8c, 0x84, 0x9a, 0x17, 0xd6, 0x83,
-	0xda, 0xf3, 0x5c, 0x51, 0xdb, 0xd3, 0xdb, 0xfc, 0xb6, 0xda, 0x2f, 0xb3, 0x6f, 0x05, 0xe8, 0x07,
-	0xe4, 0x8a, 0xfc, 0x1c, 0x64, 0xfe, 0x07, 0x00, 0x63, 0xad, 0x72, 0xdd, 0xfe, 0x0f, 0xd7, 0x5d,
-	0x60, 0xf3, 0xc2, 0xba, 0xd1, 0x34, 0xbc, 0xd1, 0xb1, 0xbd, 0xd5, 0xf2, 0xf8, 0x0a, 0xc0, 0xde,
-	0x23, 0x0f, 0x97, 0xbe, 0x9d, 0x07, 0x32, 0x43, 0x21, 0x41, 0x7f, 0x44, 0x56, 0xcb, 0xa9, 0xf2,
-	0x79, 0x54, 0x3d, 0x62, 0x77, 0x48, 0xa6, 0x85, 0xd5, 0x2b, 0x21, 0xa3, 0x5d, 0xaf, 0x57, 0x96,
-	0x46, 0xd1, 0xe6, 0x77, 0x8d, 0x90, 0x56, 0x0a, 0x73, 0xfd, 0x9b, 0x46, 0xee, 0x2e, 0x19, 0x89,
-	0x97, 0xce, 0x3f, 0x8d, 0xa3, 0xb3, 0xf4, 0xc7, 0xfa, 0x7b, 0x17, 0x55, 0x68, 0xaf, 0x36, 0x3c,
-	0x38, 0x99, 0x9a, 0xda, 0xe9, 0xd4, 0xd4, 0x7e, 0x4f, 0x4d, 0xed, 0xeb, 0xcc, 0xec, 0x9c, 0xce,
-	0xcc, 0xce, 0xcf, 0x99, 0xd9, 0x79, 0x3f, 0x5c, 0xe8, 0x6e, 0xe3, 0x36, 0x48, 0x82, 0x50, 0xb6,
-	0x01, 0x3d, 0x76, 0x5f, 0xd0, 0xf1, 0xb2, 0x2d, 0xac, 0x16, 0x30, 0xec, 0x55, 0x1b, 0xf2, 0xfc,
-	0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x98, 0x39, 0xd1, 0xdc, 0xb4, 0x03, 0x00, 0x00,
+	// 663 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0x8e, 0xef, 0xed, 0x4d, 0x6f, 0xa7, 0xe9, 0xad, 0xea, 0xb6, 0x17, 0xb7, 0x42, 0x71, 0x31,
+	0x3f, 0x2a, 0x8b, 0xd8, 0xa4, 0x88, 0x4d, 0x57, 0x34, 0x2d, 0xa1, 0x5d, 0x00, 0x95, 0xd9, 0xa1,
+	0x4a, 0x96, 0x63, 0x9f, 0xba, 0xa3, 0xd8, 0x73, 0x8c, 0x3d, 0x29, 0xc9, 0x12, 0x56, 0x2c, 0x59,
+	0xf2, 0x00, 0xbc, 0x01, 0x2f, 0xd1, 0x65, 0x97, 0x88, 0x85, 0x41, 0xe9, 0x1b, 0xe4, 0x09, 0x90,
+	0xc7, 0xe3, 0x2a, 0x85, 0x46, 0x22, 0x62, 0x65, 0x9f, 0x33, 0xdf, 0xcf, 0x99, 0x39, 0x67, 0x86,
+	0x6c, 0x61, 0x1a, 0x61, 0x4a, 0x53, 0xcb, 0x43, 0xe6, 0x01, 0xe3, 0x89, 0xcb, 0xc1, 0x6f, 0x84,
+	0xf4, 0x75, 0x8f, 0xfa, 0x94, 0x0f, 0xac, 0x18, 0x31, 0x6c, 0x44, 0xe8, 0x43, 0x68, 0xf1, 0xbe,
+	0x19, 0x27, 0xc8, 0x51, 0xbd, 0x2b, 0x39, 0xe6, 0x38, 0xe7, 0x92, 0x62, 0x9e, 0x36, 0x3b, 0xc0,
+	0xdd, 0xe6, 0xfa, 0x4a, 0x80, 0x01, 0x0a, 0x86, 0x95, 0xff, 0x15, 0xe4, 0xf5, 0xba, 0x27, 0xd8,
+	0x56, 0xc7, 0x4d, 0xc1, 0x92, 0x50, 0xcb, 0x43, 0xca, 0xca, 0xf5, 0x00, 0x31, 0x08, 0xc1, 0x12,
+	0x51, 0xa7, 0x77, 0x6c, 0xf9, 0xbd, 0xc4, 0xe5, 0x14, 0xe5, 0xba, 0xf1, 0x7e, 0x96, 0xac, 0x3d,
+	0x4b, 0x83, 0xdd, 0x04, 0x5c, 0x0e, 0xbb, 0x63, 0x05, 0x1c, 0x22, 0x86, 0xea, 0x7d, 0x52, 0x4d,
+	0x81, 0xf9, 0x90, 0x68, 0xca, 0x86, 0xb2, 0x39, 0xd7, 0x5a, 0x1a, 0x65, 0xfa, 0xc2, 0xc0, 0x8d,
+	0xc2, 0x6d, 0xa3, 0xc8, 0x1b, 0xb6, 0x04, 0xe4, 0x50, 0x1f, 0x18, 0x46, 0x0f, 0xb4, 0xbf, 0x7e,
+	0x86, 0x16, 0x79, 0xc3, 0x96, 0x80, 0x4b, 0x68, 0x53, 0xfb, 0xfb, 0x5a, 0x68, 0xb3, 0x84, 0x36,
+	0xd5, 0x6d, 0x52, 0xe3, 0xd4, 0xeb, 0x3a, 0x69, 0xec, 0x7a, 0x94, 0x05, 0xda, 0xcc, 0x86, 0xb2,
+	0x39, 0xd3, 0xba, 0x31, 0xca, 0xf4, 0xe5, 0x82, 0x30, 0xbe, 0x6a, 0xd8, 0xf3, 0x79, 0xf8, 0xb2,
+	0x88, 0xd4, 0xb7, 0x0a, 0x59, 0x85, 0x7e, 0x8c, 0x0c, 0x18, 0x77, 0x5c, 0xee, 0xc4, 0x09, 0xf5,
+	0xc0, 0x41, 0x06, 0xda, 0x3f, 0xc2, 0xf6, 0xf9, 0x59, 0xa6, 0x57, 0xbe, 0x66, 0xfa, 0xbd, 0x80,
+	0xf2, 0x93, 0x5e, 0xc7, 0xf4, 0x30, 0xb2, 0xe4, 0x69, 0x16, 0x9f, 0x46, 0xea, 0x77, 0x2d, 0x3e,
+	0x88, 0x21, 0x35, 0x0f, 0x18, 0x1f, 0x65, 0xfa, 0xcd, 0xc2, 0xf3, 0x5a, 0x51, 0xc3, 0x56, 0xcb,
+	0xfc, 0x0e, 0x3f, 0xcc, 0xb3, 0x2f, 0x18, 0xa8, 0x47, 0xe4, 0xdf, 0xf4, 0x8d, 0x1b, 0x3b, 0xc7,
+	0x00, 0xda, 0x9c, 0x70, 0xdd, 0x99, 0xc2, 0x75, 0x0f, 0xbc, 0x51, 0xa6, 0x2f, 0xca, 0x03, 0x97,
+	0x3a, 0x86, 0x3d, 0x9b, 0xff, 0xb6, 0x01, 0xd4, 0x88, 0xfc, 0x07, 0x6e, 0x12, 0x0e, 0x1c, 0xe8,
+	0x53, 0x2e, 0x3c, 0x88, 0xf0, 0x78, 0x3a, 0xb5, 0xc7, 0xaa, 0xdc, 0xd9, 0x15, 0x35, 0xc3, 0xae,
+	0x89, 0xc4, 0x93, 0x3e, 0xe5, 0xb9, 0x5d, 0x97, 0x2c, 0x45, 0x94, 0x39, 0x27, 0x18, 0xfa, 0x4e,
+	0x39, 0x46, 0xda, 0xfc, 0x86, 0xb2, 0x39, 0xbf, 0xb5, 0x66, 0x16, 0x73, 0x66, 0x96, 0x73, 0x66,
+	0xee, 0x49, 0x40, 0xeb, 0x4e, 0x5e, 0xcc, 0x28, 0xd3, 0xb5, 0xc2, 0xe2, 0x17, 0x05, 0xe3, 0xe3,
+	0x37, 0x5d, 0xb1, 0x17, 0x23, 0xca, 0xf6, 0x31, 0xf4, 0x4b, 0x9a, 0xfa, 0x4e, 0x21, 0xab, 0x42,
+	0xcc, 0xc3, 0x30, 0x2f, 0xc6, 0x39, 0x4e, 0x5c, 0x4f, 0x38, 0xd6, 0xa6, 0xee, 0x5e, 0xb1, 0x47,
+	0xd9, 0xbd, 0x6b, 0x45, 0x0d, 0x7b, 0xb9, 0xcc, 0xb7, 0x01, 0xda, 0x32, 0xab, 0x76, 0xc9, 0x02,
+	0x65, 0x94, 0x53, 0x37, 0x2c, 0x1a, 0xad, 0x2d, 0x08, 0xef, 0xf6, 0xd4, 0xde, 0x2b, 0x85, 0xf7,
+	0x15, 0x31, 0xc3, 0xae, 0xc9, 0x58, 0x8c, 0x8b, 0xb1, 0x4f, 0x6e, 0x4d, 0xbc, 0x89, 0x36, 0xa4,
+	0x31, 0xb2, 0x14, 0xd4, 0xdb, 0x64, 0x36, 0x46, 0x0c, 0x1d, 0xea, 0x8b, 0x2b, 0x39, 0xd3, 0x22,
+	0xc3, 0x4c, 0xaf, 0xe6, 0x90, 0x83, 0x3d, 0xbb, 0x9a, 0x2f, 0x1d, 0xf8, 0x5b, 0x9f, 0x15, 0x42,
+	0x4a, 0x29, 0x4c, 0xd4, 0x4f, 0x0a, 0xf9, 0x7f, 0xc2, 0x05, 0x7f, 0x6c, 0xfe, 0xd6, 0xe3, 0x63,
+	0x4e, 0x2c, 0x6c, 0x7d, 0xff, 0x4f, 0x15, 0xca, 0xad, 0xb5, 0x8e, 0xce, 0x86, 0x75, 0xe5, 0x7c,
+	0x58, 0x57, 0xbe, 0x0f, 0xeb, 0xca, 0x87, 0x8b, 0x7a, 0xe5, 0xfc, 0xa2, 0x5e, 0xf9, 0x72, 0x51,
+	0xaf, 0xbc, 0x6a, 0x8d, 0x9d, 0xb3, 0x74, 0x6b, 0x84, 0x6e, 0x27, 0x2d, 0x03, 0xeb, 0xb4, 0xf9,
+	0xc8, 0xea, 0x4f, 0x7a, 0x73, 0xc5, 0x73, 0xdb, 0xa9, 0x8a, 0xfe, 0x3e, 0xfc, 0x31, 0x00, 0x30,
+	0x90, 0x70, 0xfe, 0xa2, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -290,6 +323,44 @@ func (m *MsgCreateConcentratedPool) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.InitialPrice.Size()
+		i -= size
+		if _, err := m.InitialPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.ProtocolFeeFraction.Size()
+		i -= size
+		if _, err := m.ProtocolFeeFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinHoldDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinHoldDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.EarlyExitFee.Size()
+		i -= size
+		if _, err := m.EarlyExitFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
 	{
 		size := m.SwapFee.Size()
 		i -= size
@@ -403,6 +474,14 @@ func (m *MsgCreateConcentratedPool) Size() (n int) {
 	n += 1 + l + sovTx(uint64(l))
 	l = m.SwapFee.Size()
 	n += 1 + l + sovTx(uint64(l))
+	l = m.EarlyExitFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinHoldDuration)
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ProtocolFeeFraction.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.InitialPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
 	return n
 }
 
@@ -636,6 +715,141 @@ func (m *MsgCreateConcentratedPool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyExitFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EarlyExitFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinHoldDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinHoldDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtocolFeeFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
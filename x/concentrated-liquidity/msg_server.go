@@ -65,7 +65,16 @@ func (server msgServer) CreatePosition(goCtx context.Context, msg *types.MsgCrea
 		return nil, err
 	}
 
-	positionId, actualAmount0, actualAmount1, liquidityCreated, joinTime, err := server.keeper.createPosition(ctx, msg.PoolId, sender, msg.TokenDesired0.Amount, msg.TokenDesired1.Amount, msg.TokenMinAmount0, msg.TokenMinAmount1, msg.LowerTick, msg.UpperTick)
+	// The position is owned by the sender unless a different owner is given.
+	owner := sender
+	if msg.Owner != "" {
+		owner, err = sdk.AccAddressFromBech32(msg.Owner)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	positionId, actualAmount0, actualAmount1, liquidityCreated, joinTime, err := server.keeper.createPositionForOwner(ctx, msg.PoolId, sender, owner, msg.TokenDesired0.Amount, msg.TokenDesired1.Amount, msg.TokenMinAmount0, msg.TokenMinAmount1, msg.LowerTick, msg.UpperTick)
 	if err != nil {
 		return nil, err
 	}
@@ -83,6 +92,88 @@ func (server msgServer) CreatePosition(goCtx context.Context, msg *types.MsgCrea
 	return &types.MsgCreatePositionResponse{PositionId: positionId, Amount0: actualAmount0, Amount1: actualAmount1, JoinTime: joinTime, LiquidityCreated: liquidityCreated}, nil
 }
 
+// CreatePositionByPrice creates a position with the tick range given by prices. Each price is converted
+// to the nearest tick aligned with the pool's tick spacing, and the ticks used are returned.
+func (server msgServer) CreatePositionByPrice(goCtx context.Context, msg *types.MsgCreatePositionByPrice) (*types.MsgCreatePositionByPriceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	lowerTick, upperTick, err := server.keeper.ticksFromPrices(ctx, msg.PoolId, msg.LowerPrice, msg.UpperPrice)
+	if err != nil {
+		return nil, err
+	}
+
+	positionId, actualAmount0, actualAmount1, liquidityCreated, joinTime, err := server.keeper.createPosition(ctx, msg.PoolId, sender, msg.TokenDesired0.Amount, msg.TokenDesired1.Amount, msg.TokenMinAmount0, msg.TokenMinAmount1, lowerTick, upperTick)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: create position event is emitted in keeper.createPosition(...)
+
+	return &types.MsgCreatePositionByPriceResponse{
+		PositionId:       positionId,
+		Amount0:          actualAmount0,
+		Amount1:          actualAmount1,
+		JoinTime:         joinTime,
+		LiquidityCreated: liquidityCreated,
+		LowerTick:        lowerTick,
+		UpperTick:        upperTick,
+	}, nil
+}
+
+// CreatePositionRelative creates a position with the tick range given as offsets from the pool's current tick.
+// Each resulting tick is rounded to the nearest tick aligned with the pool's tick spacing, and the ticks used are returned.
+func (server msgServer) CreatePositionRelative(goCtx context.Context, msg *types.MsgCreatePositionRelative) (*types.MsgCreatePositionRelativeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	lowerTick, upperTick, err := server.keeper.ticksFromCurrentTickOffsets(ctx, msg.PoolId, msg.LowerTickOffset, msg.UpperTickOffset)
+	if err != nil {
+		return nil, err
+	}
+
+	positionId, actualAmount0, actualAmount1, liquidityCreated, joinTime, err := server.keeper.createPosition(ctx, msg.PoolId, sender, msg.TokenDesired0.Amount, msg.TokenDesired1.Amount, msg.TokenMinAmount0, msg.TokenMinAmount1, lowerTick, upperTick)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: create position event is emitted in keeper.createPosition(...)
+
+	return &types.MsgCreatePositionRelativeResponse{
+		PositionId:       positionId,
+		Amount0:          actualAmount0,
+		Amount1:          actualAmount1,
+		JoinTime:         joinTime,
+		LiquidityCreated: liquidityCreated,
+		LowerTick:        lowerTick,
+		UpperTick:        upperTick,
+	}, nil
+}
+
 // TODO: tests, including events
 func (server msgServer) WithdrawPosition(goCtx context.Context, msg *types.MsgWithdrawPosition) (*types.MsgWithdrawPositionResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -110,6 +201,62 @@ func (server msgServer) WithdrawPosition(goCtx context.Context, msg *types.MsgWi
 	return &types.MsgWithdrawPositionResponse{Amount0: amount0, Amount1: amount1}, nil
 }
 
+// WithdrawPositions withdraws liquidity from multiple positions owned by the sender, sending the
+// withdrawn tokens in one bank send per pool. If any withdrawal fails, none are applied.
+func (server msgServer) WithdrawPositions(goCtx context.Context, msg *types.MsgWithdrawPositions) (*types.MsgWithdrawPositionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	tokensOut, err := server.keeper.withdrawPositions(ctx, sender, msg.Withdrawals)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: a withdraw position event is emitted per position in keeper.withdrawPositions(...)
+
+	return &types.MsgWithdrawPositionsResponse{TokensOut: tokensOut}, nil
+}
+
+// EmergencyWithdraw withdraws all liquidity from the sender's position without collecting fees or incentives.
+// It only succeeds while emergency withdrawals are enabled by governance.
+func (server msgServer) EmergencyWithdraw(goCtx context.Context, msg *types.MsgEmergencyWithdraw) (*types.MsgEmergencyWithdrawResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	amount0, amount1, err := server.keeper.emergencyWithdrawPosition(ctx, sender, msg.PositionId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: emergency withdraw event is emitted in keeper.emergencyWithdrawPosition(...)
+
+	return &types.MsgEmergencyWithdrawResponse{Amount0: amount0, Amount1: amount1}, nil
+}
+
 func (server msgServer) CollectFees(goCtx context.Context, msg *types.MsgCollectFees) (*types.MsgCollectFeesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -144,7 +291,8 @@ func (server msgServer) CollectFees(goCtx context.Context, msg *types.MsgCollect
 	return &types.MsgCollectFeesResponse{CollectedFees: totalCollectedFees}, nil
 }
 
-// CollectIncentives collects incentives for all positions in given range that belong to sender
+// CollectIncentives collects incentives for the given positions of the sender without withdrawing their liquidity.
+// Incentives for uptimes the positions have not yet reached are forfeited.
 func (server msgServer) CollectIncentives(goCtx context.Context, msg *types.MsgCollectIncentives) (*types.MsgCollectIncentivesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -154,12 +302,14 @@ func (server msgServer) CollectIncentives(goCtx context.Context, msg *types.MsgC
 	}
 
 	totalCollectedIncentives := sdk.NewCoins()
+	totalForfeitedIncentives := sdk.NewCoins()
 	for _, positionId := range msg.PositionIds {
-		collectedIncentives, err := server.keeper.collectIncentives(ctx, sender, positionId)
+		collectedIncentives, forfeitedIncentives, err := server.keeper.collectIncentives(ctx, sender, positionId)
 		if err != nil {
 			return nil, err
 		}
 		totalCollectedIncentives = totalCollectedIncentives.Add(collectedIncentives...)
+		totalForfeitedIncentives = totalForfeitedIncentives.Add(forfeitedIncentives...)
 	}
 
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -173,10 +323,11 @@ func (server msgServer) CollectIncentives(goCtx context.Context, msg *types.MsgC
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
 			sdk.NewAttribute(types.AttributeKeyTokensOut, totalCollectedIncentives.String()),
+			sdk.NewAttribute(types.AttributeForfeitedIncentives, totalForfeitedIncentives.String()),
 		),
 	})
 
-	return &types.MsgCollectIncentivesResponse{CollectedIncentives: totalCollectedIncentives}, nil
+	return &types.MsgCollectIncentivesResponse{CollectedIncentives: totalCollectedIncentives, ForfeitedIncentives: totalForfeitedIncentives}, nil
 }
 
 func (server msgServer) CreateIncentive(goCtx context.Context, msg *types.MsgCreateIncentive) (*types.MsgCreateIncentiveResponse, error) {
@@ -219,3 +370,156 @@ func (server msgServer) CreateIncentive(goCtx context.Context, msg *types.MsgCre
 		MinUptime:       incentiveRecord.MinUptime,
 	}, nil
 }
+
+// WithdrawProtocolFees sends the protocol fees accrued by a pool to the sender.
+// It only succeeds if the sender is the protocol fee recipient set by governance.
+func (server msgServer) WithdrawProtocolFees(goCtx context.Context, msg *types.MsgWithdrawProtocolFees) (*types.MsgWithdrawProtocolFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawnFees, err := server.keeper.withdrawProtocolFees(ctx, sender, msg.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: withdraw protocol fees event is emitted in keeper.withdrawProtocolFees(...)
+
+	return &types.MsgWithdrawProtocolFeesResponse{WithdrawnFees: withdrawnFees}, nil
+}
+
+// UpdateTickSpacing changes the tick spacing of an existing pool.
+// It only succeeds if the sender is the tick spacing authority set by governance.
+func (server msgServer) UpdateTickSpacing(goCtx context.Context, msg *types.MsgUpdateTickSpacing) (*types.MsgUpdateTickSpacingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := server.keeper.updateTickSpacing(ctx, sender, msg.PoolId, msg.NewTickSpacing); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: update tick spacing event is emitted in keeper.updateTickSpacing(...)
+
+	return &types.MsgUpdateTickSpacingResponse{}, nil
+}
+
+// PruneEmptyTicks deletes the ticks of a pool that are left with zero gross liquidity.
+// It only succeeds if the sender is the tick spacing authority set by governance.
+func (server msgServer) PruneEmptyTicks(goCtx context.Context, msg *types.MsgPruneEmptyTicks) (*types.MsgPruneEmptyTicksResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	numPruned, err := server.keeper.pruneEmptyTicksAsAuthority(ctx, sender, msg.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: prune empty ticks event is emitted in keeper.pruneEmptyTicksAsAuthority(...)
+
+	return &types.MsgPruneEmptyTicksResponse{NumPruned: numPruned}, nil
+}
+
+// SwapExactAmountInWithPriceLimit swaps up to the given token in, stopping once the pool's spot price reaches the
+// price limit. The swap may be partially filled, in which case only the consumed token in is taken from the sender.
+func (server msgServer) SwapExactAmountInWithPriceLimit(goCtx context.Context, msg *types.MsgSwapExactAmountInWithPriceLimit) (*types.MsgSwapExactAmountInWithPriceLimitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := server.keeper.getPoolById(ctx, msg.PoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenInAmount, tokenOutAmount, err := server.keeper.SwapExactAmountInWithPriceLimit(ctx, sender, pool, msg.TokenIn, msg.TokenOutDenom, msg.TokenOutMinAmount, msg.PriceLimit, pool.GetSwapFee(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: token swapped event is emitted in keeper.updatePoolForSwap(...)
+
+	return &types.MsgSwapExactAmountInWithPriceLimitResponse{TokenInAmount: tokenInAmount, TokenOutAmount: tokenOutAmount}, nil
+}
+
+// CreatePositionSingleAsset creates a position from a single asset, first swapping the portion of it that the range
+// requires in the pool's other asset through the same pool. Whatever the position cannot absorb is left with the sender.
+func (server msgServer) CreatePositionSingleAsset(goCtx context.Context, msg *types.MsgCreatePositionSingleAsset) (*types.MsgCreatePositionSingleAssetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	positionId, actualAmount0, actualAmount1, liquidityCreated, joinTime, swappedIn, swappedOut, dust, err := server.keeper.createPositionSingleAsset(ctx, msg.PoolId, sender, msg.LowerTick, msg.UpperTick, msg.TokenIn, msg.MaxSlippage)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	// Note: token swapped and create position events are emitted in keeper.updatePoolForSwap(...) and keeper.createPosition(...)
+
+	return &types.MsgCreatePositionSingleAssetResponse{
+		PositionId:       positionId,
+		Amount0:          actualAmount0,
+		Amount1:          actualAmount1,
+		JoinTime:         joinTime,
+		LiquidityCreated: liquidityCreated,
+		TokenSwappedIn:   swappedIn,
+		TokenSwappedOut:  swappedOut,
+		Dust:             dust,
+	}, nil
+}
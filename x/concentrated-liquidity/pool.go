@@ -3,6 +3,8 @@ package concentrated_liquidity
 import (
 	"errors"
 	"fmt"
+	"sort"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -85,6 +87,34 @@ func (k Keeper) GetPools(ctx sdk.Context) ([]poolmanagertypes.PoolI, error) {
 	)
 }
 
+// getPoolsSortedByLiquidity returns all concentrated liquidity pools sorted by their current
+// active liquidity in descending order. Pools with equal liquidity are ordered by ascending pool id.
+func (k Keeper) getPoolsSortedByLiquidity(ctx sdk.Context) ([]types.ConcentratedPoolExtension, error) {
+	pools, err := osmoutils.GatherValuesFromStorePrefix(
+		ctx.KVStore(k.storeKey), types.PoolPrefix, func(value []byte) (types.ConcentratedPoolExtension, error) {
+			pool := model.Pool{}
+			err := k.cdc.Unmarshal(value, &pool)
+			if err != nil {
+				return nil, err
+			}
+			return &pool, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(pools, func(i, j int) bool {
+		liquidityI, liquidityJ := pools[i].GetLiquidity(), pools[j].GetLiquidity()
+		if !liquidityI.Equal(liquidityJ) {
+			return liquidityI.GT(liquidityJ)
+		}
+		return pools[i].GetId() < pools[j].GetId()
+	})
+
+	return pools, nil
+}
+
 // poolExists returns true if a pool with the given id exists. False otherwise.
 func (k Keeper) poolExists(ctx sdk.Context, poolId uint64) bool {
 	store := ctx.KVStore(k.storeKey)
@@ -106,9 +136,49 @@ func (k Keeper) setPool(ctx sdk.Context, pool types.ConcentratedPoolExtension) e
 	store := ctx.KVStore(k.storeKey)
 	key := types.KeyPool(pool.GetId())
 	osmoutils.MustSet(store, key, poolModel)
+
+	// Set the denom pair to pool ID mapping. A pool's denoms never change, so
+	// rewriting the mapping on every pool update is a no-op.
+	key = types.KeyDenomPairPoolId(pool.GetToken0(), pool.GetToken1(), pool.GetId())
+	store.Set(key, sdk.Uint64ToBigEndian(pool.GetId()))
 	return nil
 }
 
+// PoolsForDenomPair returns the ids of all concentrated liquidity pools whose token0 and token1
+// match the given denoms, in either order. The ids are sorted in ascending order.
+func (k Keeper) PoolsForDenomPair(ctx sdk.Context, denomA, denomB string) ([]uint64, error) {
+	prefix := types.KeyDenomPair(denomA, denomB)
+	poolIds, err := osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), prefix, func(value []byte) (uint64, error) {
+		return sdk.BigEndianToUint64(value), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(poolIds, func(i, j int) bool { return poolIds[i] < poolIds[j] })
+	return poolIds, nil
+}
+
+// PoolsForPairWithFees returns all concentrated liquidity pools whose token0 and token1 match the given denoms,
+// in either order, so that callers can compare their swap fees and current liquidity. The pools are sorted by
+// id in ascending order.
+func (k Keeper) PoolsForPairWithFees(ctx sdk.Context, denomA, denomB string) ([]types.ConcentratedPoolExtension, error) {
+	poolIds, err := k.PoolsForDenomPair(ctx, denomA, denomB)
+	if err != nil {
+		return nil, err
+	}
+
+	pools := make([]types.ConcentratedPoolExtension, 0, len(poolIds))
+	for _, poolId := range poolIds {
+		pool, err := k.getPoolById(ctx, poolId)
+		if err != nil {
+			return nil, err
+		}
+		pools = append(pools, pool)
+	}
+	return pools, nil
+}
+
 func (k Keeper) GetPoolDenoms(ctx sdk.Context, poolId uint64) ([]string, error) {
 	concentratedPool, err := k.getPoolById(ctx, poolId)
 	if err != nil {
@@ -198,6 +268,46 @@ func (k Keeper) GetPoolFromPoolIdAndConvertToConcentrated(ctx sdk.Context, poolI
 	return convertPoolInterfaceToConcentrated(poolI)
 }
 
+// updateTickSpacing changes the tick spacing of the pool with the given id to newTickSpacing.
+// Only the tick spacing authority set by governance may change it, and the new spacing must be authorized.
+// Existing positions are grandfathered: positions whose ticks no longer align with the new spacing can still
+// be withdrawn, since withdrawals do not validate tick spacing, but no position can be created at misaligned ticks.
+func (k Keeper) updateTickSpacing(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, newTickSpacing uint64) error {
+	params := k.GetParams(ctx)
+	if params.TickSpacingAuthority == "" {
+		return types.TickSpacingUpdateDisabledError{}
+	}
+	if sender.String() != params.TickSpacingAuthority {
+		return types.NotTickSpacingAuthorityError{Sender: sender.String(), Authority: params.TickSpacingAuthority}
+	}
+
+	if !k.validateTickSpacing(ctx, params, newTickSpacing) {
+		return types.UnauthorizedTickSpacingError{TickSpacing: newTickSpacing}
+	}
+
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	oldTickSpacing := pool.GetTickSpacing()
+	pool.SetTickSpacing(newTickSpacing)
+	if err := k.setPool(ctx, pool); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtUpdateTickSpacing,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeOldTickSpacing, strconv.FormatUint(oldTickSpacing, 10)),
+		sdk.NewAttribute(types.AttributeNewTickSpacing, strconv.FormatUint(newTickSpacing, 10)),
+	))
+
+	return nil
+}
+
 // validateTickSpacing returns true if the given tick spacing is one of the authorized tick spacings set in the
 // params. False otherwise.
 func (k Keeper) validateTickSpacing(ctx sdk.Context, params types.Params, tickSpacing uint64) bool {
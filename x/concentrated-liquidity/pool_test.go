@@ -2,12 +2,14 @@ package concentrated_liquidity_test
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	cl "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity"
 	clmodel "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+	clquery "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types/query"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 )
 
@@ -160,6 +162,27 @@ func (s *KeeperTestSuite) TestGetPoolById() {
 	}
 }
 
+func (s *KeeperTestSuite) TestGetPoolsSortedByLiquidity() {
+	s.SetupTest()
+
+	// Create three pools with no, two and one default positions respectively.
+	poolIds := s.PrepareMultipleConcentratedPools(3)
+	s.SetupDefaultPosition(poolIds[1])
+	s.SetupDefaultPosition(poolIds[1])
+	s.SetupDefaultPosition(poolIds[2])
+
+	pools, err := s.App.ConcentratedLiquidityKeeper.GetPoolsSortedByLiquidity(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(pools, 3)
+
+	// Pools are ordered by current liquidity, descending.
+	s.Require().Equal(poolIds[1], pools[0].GetId())
+	s.Require().Equal(poolIds[2], pools[1].GetId())
+	s.Require().Equal(poolIds[0], pools[2].GetId())
+	s.Require().True(pools[0].GetLiquidity().GT(pools[1].GetLiquidity()))
+	s.Require().True(pools[1].GetLiquidity().GT(pools[2].GetLiquidity()))
+}
+
 func (s *KeeperTestSuite) TestPoolExists() {
 	s.SetupTest()
 
@@ -177,6 +200,279 @@ func (s *KeeperTestSuite) TestPoolExists() {
 	s.Require().False(poolExists)
 }
 
+func (s *KeeperTestSuite) TestPoolParamsQuery() {
+	s.SetupTest()
+
+	// Create a CL pool with a non-zero swap fee and a position so that the current tick and sqrt price are set.
+	swapFee := sdk.MustNewDecFromStr("0.003")
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, DefaultExponentAtPriceOne, swapFee)
+	s.SetupDefaultPosition(pool.GetId())
+	pool, err := s.App.ConcentratedLiquidityKeeper.GetPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+
+	querier := cl.NewQuerier(*s.App.ConcentratedLiquidityKeeper)
+
+	res, err := querier.PoolParams(sdk.WrapSDKContext(s.Ctx), &clquery.QueryPoolParamsRequest{PoolId: pool.GetId()})
+	s.Require().NoError(err)
+	s.Require().Equal(DefaultTickSpacing, res.TickSpacing)
+	s.Require().Equal(DefaultExponentAtPriceOne, res.ExponentAtPriceOne)
+	s.Require().Equal(swapFee, res.SwapFee)
+	s.Require().Equal(pool.GetCurrentTick(), res.CurrentTick)
+	s.Require().Equal(pool.GetCurrentSqrtPrice(), res.CurrentSqrtPrice)
+
+	// Non-existent pool.
+	_, err = querier.PoolParams(sdk.WrapSDKContext(s.Ctx), &clquery.QueryPoolParamsRequest{PoolId: pool.GetId() + 1})
+	s.Require().Error(err)
+
+	// Empty request.
+	_, err = querier.PoolParams(sdk.WrapSDKContext(s.Ctx), nil)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestPositionSummaryQuery() {
+	s.SetupTest()
+
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+
+	// Accrue fees so that the position has claimable fees.
+	feeAccum, err := s.App.ConcentratedLiquidityKeeper.GetFeeAccumulator(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	feeAccum.AddToAccumulator(sdk.NewDecCoins(sdk.NewDecCoin(ETH, sdk.NewInt(10))))
+
+	querier := cl.NewQuerier(*s.App.ConcentratedLiquidityKeeper)
+	goCtx := sdk.WrapSDKContext(s.Ctx)
+
+	res, err := querier.PositionSummary(goCtx, &clquery.QueryPositionSummaryRequest{PositionId: DefaultPositionId})
+	s.Require().NoError(err)
+
+	// The summary must match the granular queries.
+	positionRes, err := querier.PositionById(goCtx, &clquery.QueryPositionByIdRequest{PositionId: DefaultPositionId})
+	s.Require().NoError(err)
+	s.Require().Equal(positionRes.Position, res.Position)
+
+	feesRes, err := querier.ClaimableFees(goCtx, &clquery.QueryClaimableFeesRequest{PositionId: DefaultPositionId})
+	s.Require().NoError(err)
+	s.Require().Equal(feesRes.ClaimableFees, res.ClaimableFees)
+
+	incentivesRes, err := querier.ClaimableIncentives(goCtx, &clquery.QueryClaimableIncentivesRequest{PositionId: DefaultPositionId})
+	s.Require().NoError(err)
+	s.Require().Equal(incentivesRes.ClaimableIncentives, res.ClaimableIncentives)
+	s.Require().Equal(incentivesRes.ForfeitedIncentives, res.ForfeitedIncentives)
+
+	// Non-existent position.
+	_, err = querier.PositionSummary(goCtx, &clquery.QueryPositionSummaryRequest{PositionId: DefaultPositionId + 1})
+	s.Require().Error(err)
+
+	// Empty request.
+	_, err = querier.PositionSummary(goCtx, nil)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestPositionAprQuery() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	pool := s.PrepareConcentratedPool()
+	inRangeLiquidity, inRangePositionId := s.SetupPosition(pool.GetId(), s.TestAccs[0], DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime())
+	outOfRangeLiquidity, outOfRangePositionId := s.SetupPosition(pool.GetId(), s.TestAccs[1], DefaultCoin0, DefaultCoin1, DefaultUpperTick, DefaultUpperTick+100, s.Ctx.BlockTime())
+
+	// Emit one USDC per second to all liquidity with the shortest uptime.
+	clKeeper.SetIncentiveRecord(s.Ctx, types.IncentiveRecord{
+		PoolId:               pool.GetId(),
+		IncentiveDenom:       USDC,
+		IncentiveCreatorAddr: s.TestAccs[0].String(),
+		IncentiveRecordBody: types.IncentiveRecordBody{
+			RemainingAmount: sdk.NewDec(1_000_000_000_000),
+			EmissionRate:    sdk.OneDec(),
+			StartTime:       s.Ctx.BlockTime(),
+		},
+		MinUptime: types.SupportedUptimes[0],
+	})
+	s.Ctx = s.Ctx.WithBlockTime(s.Ctx.BlockTime().Add(time.Second))
+
+	querier := cl.NewQuerier(*clKeeper)
+	goCtx := sdk.WrapSDKContext(s.Ctx)
+
+	// The in range position earns its share of the liquidity in the uptime accumulator.
+	res, err := querier.PositionApr(goCtx, &clquery.QueryPositionAprRequest{PositionId: inRangePositionId})
+	s.Require().NoError(err)
+
+	secondsPerYear := sdk.NewDec(int64(types.AprProjectionPeriod / time.Second))
+	expectedIncentives := secondsPerYear.Mul(inRangeLiquidity).QuoTruncate(inRangeLiquidity.Add(outOfRangeLiquidity))
+	s.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoinFromDec(USDC, expectedIncentives)), res.AnnualizedIncentives)
+	s.Require().True(res.AnnualizedFees.IsZero())
+
+	position, err := clKeeper.GetPosition(s.Ctx, inRangePositionId)
+	s.Require().NoError(err)
+	clPool, err := clKeeper.GetPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	asset0, asset1, err := cl.CalculateUnderlyingAssetsFromPosition(s.Ctx, position, clPool)
+	s.Require().NoError(err)
+	positionValue := asset0.Mul(clPool.GetCurrentSqrtPrice().Power(2)).Add(asset1)
+	s.Require().Equal(expectedIncentives.Quo(positionValue), res.Apr)
+
+	// The out of range position earns nothing.
+	res, err = querier.PositionApr(goCtx, &clquery.QueryPositionAprRequest{PositionId: outOfRangePositionId})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.ZeroDec(), res.Apr)
+	s.Require().True(res.AnnualizedIncentives.IsZero())
+
+	// Non-existent position.
+	_, err = querier.PositionApr(goCtx, &clquery.QueryPositionAprRequest{PositionId: outOfRangePositionId + 1})
+	s.Require().Error(err)
+
+	// Empty request.
+	_, err = querier.PositionApr(goCtx, nil)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestPoolIncentiveRecordsQuery() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	pool := s.PrepareConcentratedPool()
+	s.SetupDefaultPosition(pool.GetId())
+	startTime := s.Ctx.BlockTime()
+
+	// An active record emitting one USDC per second.
+	activeRecord := types.IncentiveRecord{
+		PoolId:               pool.GetId(),
+		IncentiveDenom:       USDC,
+		IncentiveCreatorAddr: s.TestAccs[0].String(),
+		IncentiveRecordBody: types.IncentiveRecordBody{
+			RemainingAmount: sdk.NewDec(1000),
+			EmissionRate:    sdk.OneDec(),
+			StartTime:       startTime,
+		},
+		MinUptime: types.SupportedUptimes[0],
+	}
+	// A record that starts emitting two ETH per second in an hour.
+	futureRecord := types.IncentiveRecord{
+		PoolId:               pool.GetId(),
+		IncentiveDenom:       ETH,
+		IncentiveCreatorAddr: s.TestAccs[0].String(),
+		IncentiveRecordBody: types.IncentiveRecordBody{
+			RemainingAmount: sdk.NewDec(100),
+			EmissionRate:    sdk.NewDec(2),
+			StartTime:       startTime.Add(time.Hour),
+		},
+		MinUptime: types.SupportedUptimes[0],
+	}
+	clKeeper.SetIncentiveRecord(s.Ctx, activeRecord)
+	clKeeper.SetIncentiveRecord(s.Ctx, futureRecord)
+	s.Ctx = s.Ctx.WithBlockTime(startTime.Add(10 * time.Second))
+
+	querier := cl.NewQuerier(*clKeeper)
+	res, err := querier.PoolIncentiveRecords(sdk.WrapSDKContext(s.Ctx), &clquery.QueryPoolIncentiveRecordsRequest{PoolId: pool.GetId()})
+	s.Require().NoError(err)
+	s.Require().Len(res.IncentiveRecords, 2)
+
+	exhaustedAtByDenom := map[string]time.Time{}
+	remainingByDenom := map[string]sdk.Dec{}
+	for _, record := range res.IncentiveRecords {
+		exhaustedAtByDenom[record.IncentiveRecord.IncentiveDenom] = record.ExhaustedAt
+		remainingByDenom[record.IncentiveRecord.IncentiveDenom] = record.IncentiveRecord.IncentiveRecordBody.RemainingAmount
+	}
+
+	// The active record has emitted for ten seconds and emits the rest from now on.
+	s.Require().Equal(sdk.NewDec(990), remainingByDenom[USDC])
+	s.Require().True(s.Ctx.BlockTime().Add(990 * time.Second).Equal(exhaustedAtByDenom[USDC]))
+
+	// The future record emits all of its incentives from its start time.
+	s.Require().Equal(sdk.NewDec(100), remainingByDenom[ETH])
+	s.Require().True(futureRecord.IncentiveRecordBody.StartTime.Add(50 * time.Second).Equal(exhaustedAtByDenom[ETH]))
+
+	// The query does not modify state.
+	storedRecord, err := clKeeper.GetIncentiveRecord(s.Ctx, pool.GetId(), USDC, types.SupportedUptimes[0], s.TestAccs[0])
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewDec(1000), storedRecord.IncentiveRecordBody.RemainingAmount)
+
+	// Non-existent pool.
+	_, err = querier.PoolIncentiveRecords(sdk.WrapSDKContext(s.Ctx), &clquery.QueryPoolIncentiveRecordsRequest{PoolId: pool.GetId() + 1})
+	s.Require().Error(err)
+
+	// Empty request.
+	_, err = querier.PoolIncentiveRecords(sdk.WrapSDKContext(s.Ctx), nil)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestPoolsForDenomPair() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	// Two eth/usdc pools and one eth/uosmo pool.
+	ethUsdcPool := s.PrepareConcentratedPool()
+	ethOsmoPool := s.PrepareConcentratedPoolWithCoins(ETH, "uosmo")
+	secondEthUsdcPool := s.PrepareConcentratedPool()
+
+	// The pair matches regardless of the order of the denoms.
+	poolIds, err := clKeeper.PoolsForDenomPair(s.Ctx, ETH, USDC)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{ethUsdcPool.GetId(), secondEthUsdcPool.GetId()}, poolIds)
+
+	poolIds, err = clKeeper.PoolsForDenomPair(s.Ctx, USDC, ETH)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{ethUsdcPool.GetId(), secondEthUsdcPool.GetId()}, poolIds)
+
+	querier := cl.NewQuerier(*clKeeper)
+	res, err := querier.PoolsForDenomPair(sdk.WrapSDKContext(s.Ctx), &clquery.QueryPoolsForDenomPairRequest{DenomA: "uosmo", DenomB: ETH})
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{ethOsmoPool.GetId()}, res.PoolIds)
+
+	// A pair that no pool trades.
+	res, err = querier.PoolsForDenomPair(sdk.WrapSDKContext(s.Ctx), &clquery.QueryPoolsForDenomPairRequest{DenomA: USDC, DenomB: "uosmo"})
+	s.Require().NoError(err)
+	s.Require().Empty(res.PoolIds)
+
+	// Empty request.
+	_, err = querier.PoolsForDenomPair(sdk.WrapSDKContext(s.Ctx), nil)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestPoolsForPairWithFees() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	// Two eth/usdc pools at different fee tiers, only the second of which has liquidity, and one eth/uosmo pool.
+	zeroFeePool := s.PrepareConcentratedPool()
+	s.PrepareConcentratedPoolWithCoins(ETH, "uosmo")
+	feeTier := sdk.MustNewDecFromStr("0.003")
+	feePool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, DefaultExponentAtPriceOne, feeTier)
+	s.SetupDefaultPosition(feePool.GetId())
+
+	feePool, err := clKeeper.GetPoolById(s.Ctx, feePool.GetId())
+	s.Require().NoError(err)
+	s.Require().True(feePool.GetLiquidity().IsPositive())
+
+	// The pair matches regardless of the order of the denoms.
+	pools, err := clKeeper.PoolsForPairWithFees(s.Ctx, USDC, ETH)
+	s.Require().NoError(err)
+	s.Require().Len(pools, 2)
+	s.Require().Equal(zeroFeePool.GetId(), pools[0].GetId())
+	s.Require().Equal(feePool.GetId(), pools[1].GetId())
+
+	querier := cl.NewQuerier(*clKeeper)
+	res, err := querier.PoolsForPairWithFees(sdk.WrapSDKContext(s.Ctx), &clquery.QueryPoolsForPairWithFeesRequest{DenomA: ETH, DenomB: USDC})
+	s.Require().NoError(err)
+	s.Require().Len(res.Pools, 2)
+	s.Require().Equal(zeroFeePool.GetId(), res.Pools[0].PoolId)
+	s.Require().True(res.Pools[0].SwapFee.IsZero())
+	s.Require().True(res.Pools[0].Liquidity.IsZero())
+	s.Require().Equal(feePool.GetId(), res.Pools[1].PoolId)
+	s.Require().Equal(feeTier.String(), res.Pools[1].SwapFee.String())
+	s.Require().Equal(feePool.GetLiquidity().String(), res.Pools[1].Liquidity.String())
+
+	// A pair that no pool trades.
+	res, err = querier.PoolsForPairWithFees(sdk.WrapSDKContext(s.Ctx), &clquery.QueryPoolsForPairWithFeesRequest{DenomA: USDC, DenomB: "uosmo"})
+	s.Require().NoError(err)
+	s.Require().Empty(res.Pools)
+
+	// Empty request.
+	_, err = querier.PoolsForPairWithFees(sdk.WrapSDKContext(s.Ctx), nil)
+	s.Require().Error(err)
+}
+
 func (s *KeeperTestSuite) TestConvertConcentratedToPoolInterface() {
 	s.SetupTest()
 
@@ -367,3 +663,60 @@ func (s *KeeperTestSuite) TestValidateSwapFee() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestUpdateTickSpacing() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	owner := s.TestAccs[0]
+	authority := s.TestAccs[1]
+
+	pool := s.PrepareConcentratedPool()
+	poolId := pool.GetId()
+	liquidity, positionId := s.SetupPosition(poolId, owner, DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime())
+
+	// The default lower tick is not a multiple of the new tick spacing, so the position is grandfathered.
+	newTickSpacing := uint64(200)
+	s.Require().NotZero(DefaultLowerTick % int64(newTickSpacing))
+
+	// Updates are disabled until governance sets an authority.
+	err := clKeeper.UpdateTickSpacing(s.Ctx, authority, poolId, newTickSpacing)
+	s.Require().ErrorIs(err, types.TickSpacingUpdateDisabledError{})
+
+	params := clKeeper.GetParams(s.Ctx)
+	params.TickSpacingAuthority = authority.String()
+	clKeeper.SetParams(s.Ctx, params)
+
+	// Only the authority may update the tick spacing.
+	err = clKeeper.UpdateTickSpacing(s.Ctx, s.TestAccs[2], poolId, newTickSpacing)
+	s.Require().ErrorIs(err, types.NotTickSpacingAuthorityError{Sender: s.TestAccs[2].String(), Authority: authority.String()})
+
+	// The new tick spacing must be authorized.
+	err = clKeeper.UpdateTickSpacing(s.Ctx, authority, poolId, 7)
+	s.Require().ErrorIs(err, types.UnauthorizedTickSpacingError{TickSpacing: 7})
+
+	// Non-existent pool.
+	err = clKeeper.UpdateTickSpacing(s.Ctx, authority, poolId+1, newTickSpacing)
+	s.Require().ErrorIs(err, types.PoolNotFoundError{PoolId: poolId + 1})
+
+	err = clKeeper.UpdateTickSpacing(s.Ctx, authority, poolId, newTickSpacing)
+	s.Require().NoError(err)
+	updatedPool, err := clKeeper.GetPoolById(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(newTickSpacing, updatedPool.GetTickSpacing())
+
+	// New positions at the grandfathered position's ticks are rejected.
+	s.FundAcc(owner, sdk.NewCoins(DefaultCoin0, DefaultCoin1))
+	_, _, _, _, _, err = clKeeper.CreatePosition(s.Ctx, poolId, owner, DefaultCoin0.Amount, DefaultCoin1.Amount, sdk.ZeroInt(), sdk.ZeroInt(), DefaultLowerTick, DefaultUpperTick)
+	s.Require().ErrorIs(err, types.TickSpacingError{LowerTick: DefaultLowerTick, UpperTick: DefaultUpperTick, TickSpacing: newTickSpacing})
+
+	// New positions at ticks aligned with the new spacing are allowed.
+	alignedLowerTick := DefaultLowerTick - DefaultLowerTick%int64(newTickSpacing)
+	_, _, _, _, _, err = clKeeper.CreatePosition(s.Ctx, poolId, owner, DefaultCoin0.Amount, DefaultCoin1.Amount, sdk.ZeroInt(), sdk.ZeroInt(), alignedLowerTick, DefaultUpperTick)
+	s.Require().NoError(err)
+
+	// The grandfathered position can still be fully withdrawn.
+	_, _, err = clKeeper.WithdrawPosition(s.Ctx, owner, positionId, liquidity)
+	s.Require().NoError(err)
+	_, err = clKeeper.GetPosition(s.Ctx, positionId)
+	s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: positionId})
+}
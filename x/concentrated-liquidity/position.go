@@ -1,10 +1,16 @@
 package concentrated_liquidity
 
 import (
+	"bytes"
+	"errors"
+	"strconv"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/accum"
 	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/internal/math"
@@ -70,6 +76,21 @@ func (k Keeper) hasFullPosition(ctx sdk.Context, positionId uint64) bool {
 	return store.Has(key)
 }
 
+// isOrphanedAccumulatorPosition returns a function reporting whether the fee or uptime accumulator position
+// with the given name belongs to a position that no longer exists. Accumulator position names end in the
+// position id, see types.KeyFeePositionAccumulator and types.KeyPositionId. Names that do not end in a
+// position id are never reported as orphaned.
+func (k Keeper) isOrphanedAccumulatorPosition(ctx sdk.Context) func(name string) bool {
+	return func(name string) bool {
+		positionIdStr := name[strings.LastIndex(name, types.KeySeparator)+1:]
+		positionId, err := strconv.ParseUint(positionIdStr, 10, 64)
+		if err != nil {
+			return false
+		}
+		return !k.hasFullPosition(ctx, positionId)
+	}
+}
+
 // GetPositionLiquidity checks if the provided positionId exists. Returns position liquidity if found. Error otherwise.
 func (k Keeper) GetPositionLiquidity(ctx sdk.Context, positionId uint64) (sdk.Dec, error) {
 	position, err := k.GetPosition(ctx, positionId)
@@ -80,6 +101,32 @@ func (k Keeper) GetPositionLiquidity(ctx sdk.Context, positionId uint64) (sdk.De
 	return position.Liquidity, nil
 }
 
+// GetPositionsLiquidity returns the liquidity of each of the given positions, keyed by position id.
+// Unlike GetPositionLiquidity, it does not stop at the first missing position. If any positions do not exist,
+// the liquidity of the positions that do exist is returned alongside a PositionIdsNotFoundError listing
+// all of the missing ids. Any other error is returned immediately.
+func (k Keeper) GetPositionsLiquidity(ctx sdk.Context, positionIds []uint64) (map[uint64]sdk.Dec, error) {
+	liquidity := make(map[uint64]sdk.Dec, len(positionIds))
+	missingIds := []uint64{}
+	for _, positionId := range positionIds {
+		position, err := k.GetPosition(ctx, positionId)
+		if errors.As(err, &types.PositionIdNotFoundError{}) {
+			missingIds = append(missingIds, positionId)
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		liquidity[positionId] = position.Liquidity
+	}
+
+	if len(missingIds) > 0 {
+		return liquidity, types.PositionIdsNotFoundError{PositionIds: missingIds}
+	}
+	return liquidity, nil
+}
+
 // GetPosition checks if the given position id exists. Returns position if found.
 func (k Keeper) GetPosition(ctx sdk.Context, positionId uint64) (model.Position, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -127,6 +174,83 @@ func (k Keeper) GetUserPositions(ctx sdk.Context, addr sdk.AccAddress, poolId ui
 	return positions, nil
 }
 
+// GetPositionIdsForRange returns the ids of all positions that the given owner has in the given pool
+// with exactly the given lower and upper ticks. Returns an empty slice if there are none.
+func (k Keeper) GetPositionIdsForRange(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, lowerTick, upperTick int64) ([]uint64, error) {
+	prefix := types.KeyAddressPoolIdRange(owner, poolId, lowerTick, upperTick)
+	return osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), prefix, ParsePositionIdFromBz)
+}
+
+// getPositionsByJoinTimeRange returns the positions with a join time within [startTime, endTime], ordered by
+// join time and then by position id. Both key-based and offset-based pagination are supported, though not at
+// the same time. The total is only counted from the start of the page.
+// Returns error if the end time is before the start time.
+func (k Keeper) getPositionsByJoinTimeRange(ctx sdk.Context, startTime, endTime time.Time, pageReq *query.PageRequest) ([]model.Position, *query.PageResponse, error) {
+	if endTime.Before(startTime) {
+		return nil, nil, types.InvalidJoinTimeRangeError{StartTime: startTime, EndTime: endTime}
+	}
+
+	// The end of the range is inclusive, so iterate up to the first time after it.
+	startKey := types.KeyJoinTimePositions(startTime)
+	endKey := types.KeyJoinTimePositions(endTime.Add(time.Nanosecond))
+
+	offset, limit, countTotal := uint64(0), uint64(query.DefaultLimit), false
+	if pageReq != nil {
+		if len(pageReq.Key) > 0 && pageReq.Offset > 0 {
+			return nil, nil, errors.New("invalid request, either offset or key is expected, got both")
+		}
+		// A key outside of the range cannot resume the iteration past its start.
+		if bytes.Compare(pageReq.Key, startKey) > 0 {
+			startKey = pageReq.Key
+		}
+		offset = pageReq.Offset
+		if pageReq.Limit > 0 {
+			limit = pageReq.Limit
+		}
+		countTotal = pageReq.CountTotal
+	}
+
+	positions := []model.Position{}
+	if bytes.Compare(startKey, endKey) >= 0 {
+		return positions, &query.PageResponse{}, nil
+	}
+
+	iterator := ctx.KVStore(k.storeKey).Iterator(startKey, endKey)
+	defer iterator.Close()
+
+	var nextKey []byte
+	count := uint64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+		if count <= offset {
+			continue
+		}
+
+		if uint64(len(positions)) == limit {
+			if nextKey == nil {
+				nextKey = iterator.Key()
+			}
+			if !countTotal {
+				break
+			}
+			continue
+		}
+
+		position, err := k.GetPosition(ctx, sdk.BigEndianToUint64(iterator.Value()))
+		if err != nil {
+			return nil, nil, err
+		}
+		positions = append(positions, position)
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = count
+	}
+
+	return positions, pageRes, nil
+}
+
 // setPosition sets the position information for a given user in a given pool.
 func (k Keeper) setPosition(ctx sdk.Context,
 	poolId uint64,
@@ -160,6 +284,14 @@ func (k Keeper) setPosition(ctx sdk.Context,
 	// Set the pool ID to position ID mapping.
 	key = types.KeyPoolPositionPositionId(poolId, positionId)
 	store.Set(key, sdk.Uint64ToBigEndian(positionId))
+
+	// Set the address-pool-range to position ID mapping.
+	key = types.KeyAddressPoolIdRangePositionId(owner, poolId, lowerTick, upperTick, positionId)
+	store.Set(key, sdk.Uint64ToBigEndian(positionId))
+
+	// Set the join time to position ID mapping. A position's join time never changes once it is created.
+	key = types.KeyJoinTimePositionId(joinTime, positionId)
+	store.Set(key, sdk.Uint64ToBigEndian(positionId))
 }
 
 func (k Keeper) deletePosition(ctx sdk.Context,
@@ -169,11 +301,14 @@ func (k Keeper) deletePosition(ctx sdk.Context,
 ) error {
 	store := ctx.KVStore(k.storeKey)
 
+	// Retrieve the position to learn its tick range before removing it.
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return err
+	}
+
 	// Remove the position ID to position mapping.
 	key := types.KeyPositionId(positionId)
-	if !store.Has(key) {
-		return types.PositionIdNotFoundError{PositionId: positionId}
-	}
 	store.Delete(key)
 
 	// Remove the address-pool-position ID to position mapping.
@@ -190,9 +325,40 @@ func (k Keeper) deletePosition(ctx sdk.Context,
 	}
 	store.Delete(key)
 
+	// Remove the address-pool-range to position ID mapping.
+	key = types.KeyAddressPoolIdRangePositionId(owner, poolId, position.LowerTick, position.UpperTick, positionId)
+	store.Delete(key)
+
+	// Remove the join time to position ID mapping.
+	key = types.KeyJoinTimePositionId(position.JoinTime, positionId)
+	store.Delete(key)
+
+	// Remove the entry sqrt price of the position.
+	key = types.KeyPositionEntrySqrtPrice(positionId)
+	store.Delete(key)
+
 	return nil
 }
 
+// setPositionEntrySqrtPrice records the pool's sqrt price at the time the given position was created.
+func (k Keeper) setPositionEntrySqrtPrice(ctx sdk.Context, positionId uint64, sqrtPrice sdk.Dec) {
+	osmoutils.MustSetDec(ctx.KVStore(k.storeKey), types.KeyPositionEntrySqrtPrice(positionId), sqrtPrice)
+}
+
+// getPositionEntrySqrtPrice returns the pool's sqrt price at the time the given position was created.
+// Returns error if no entry sqrt price was recorded for the position.
+func (k Keeper) getPositionEntrySqrtPrice(ctx sdk.Context, positionId uint64) (sdk.Dec, error) {
+	entrySqrtPrice := sdk.DecProto{}
+	found, err := osmoutils.Get(ctx.KVStore(k.storeKey), types.KeyPositionEntrySqrtPrice(positionId), &entrySqrtPrice)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if !found {
+		return sdk.Dec{}, types.PositionEntrySqrtPriceNotFoundError{PositionId: positionId}
+	}
+	return entrySqrtPrice.Dec, nil
+}
+
 // CreateFullRangePosition creates a full range (min to max tick) concentrated liquidity position for the given pool ID, owner, coins, and frozen until time.
 // The function returns the amounts of token 0 and token 1, and the liquidity created from the position.
 func (k Keeper) CreateFullRangePosition(ctx sdk.Context, concentratedPool types.ConcentratedPoolExtension, owner sdk.AccAddress, coins sdk.Coins) (positionId uint64, amount0, amount1 sdk.Int, liquidity sdk.Dec, joinTime time.Time, err error) {
@@ -216,10 +382,257 @@ func CalculateUnderlyingAssetsFromPosition(ctx sdk.Context, position model.Posit
 	}
 
 	// Calculate the amount of underlying assets in the position
-	asset0, asset1 := pool.CalcActualAmounts(ctx, position.LowerTick, position.UpperTick, sqrtPriceLowerTick, sqrtPriceUpperTick, position.Liquidity)
+	asset0, asset1 := pool.CalcActualAmounts(ctx, position.LowerTick, position.UpperTick, sqrtPriceLowerTick, sqrtPriceUpperTick, position.Liquidity, osmomath.RoundUnconstrained)
 	return asset0, asset1, nil
 }
 
+// positionApr estimates the annualized return of the position with the given id from fees and incentives.
+// It is a forward projection from current rates, not a realized return, and relies on the following assumptions:
+// - The pool's fee revenue over the last types.FeeRevenueRetentionPeriod recurs at the same rate. The position is
+// attributed the share of it that its liquidity represents of the pool's active liquidity. Fee revenue of pools
+// younger than the retention period is underestimated.
+// - Incentive records that have started emitting keep emitting at their current rate until their remaining amount
+// runs out. The position is attributed the share of each record that its liquidity represents of the liquidity in
+// the record's uptime accumulator, and is assumed to stay open long enough to reach every record's min uptime.
+// - The current price does not move. A position out of range earns nothing.
+// - Rewards and the position's value are priced in token1 at the current spot price. Rewards in denoms other than
+// the pool's assets cannot be priced and are left out of the APR, but are still included in the returned
+// annualized fees and incentives.
+//
+// Returns the APR alongside the annualized fees and incentives. The APR is zero if the position has no value.
+// Returns error if:
+// - the position or its pool do not exist
+// - fails to read the pool's fee revenue, incentive records or uptime accumulators
+func (k Keeper) positionApr(ctx sdk.Context, positionId uint64) (sdk.Dec, sdk.DecCoins, sdk.DecCoins, error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return sdk.Dec{}, nil, nil, err
+	}
+
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return sdk.Dec{}, nil, nil, err
+	}
+
+	asset0, asset1, err := CalculateUnderlyingAssetsFromPosition(ctx, position, pool)
+	if err != nil {
+		return sdk.Dec{}, nil, nil, err
+	}
+
+	annualizedFees := sdk.NewDecCoins()
+	annualizedIncentives := sdk.NewDecCoins()
+
+	currentTick := pool.GetCurrentTick()
+	isInRange := currentTick.GTE(sdk.NewInt(position.LowerTick)) && currentTick.LT(sdk.NewInt(position.UpperTick))
+	if isInRange && position.Liquidity.IsPositive() {
+		// Fees are distributed over the pool's active liquidity.
+		if poolLiquidity := pool.GetLiquidity(); poolLiquidity.IsPositive() {
+			feeRevenue, err := k.FeeRevenue(ctx, pool.GetId(), ctx.BlockTime().Add(-types.FeeRevenueRetentionPeriod))
+			if err != nil {
+				return sdk.Dec{}, nil, nil, err
+			}
+
+			periodsPerYear := sdk.NewDec(int64(types.AprProjectionPeriod)).QuoInt64(int64(types.FeeRevenueRetentionPeriod))
+			annualizedFees = feeRevenue.MulDecTruncate(position.Liquidity.Mul(periodsPerYear).QuoTruncate(poolLiquidity))
+		}
+
+		// Incentives are distributed over the liquidity in the uptime accumulator of their min uptime,
+		// mirroring updateUptimeAccumulatorsToNow.
+		incentiveRecords, err := k.GetAllIncentiveRecordsForPool(ctx, pool.GetId())
+		if err != nil {
+			return sdk.Dec{}, nil, nil, err
+		}
+
+		uptimeAccums, err := k.getUptimeAccumulators(ctx, pool.GetId())
+		if err != nil {
+			return sdk.Dec{}, nil, nil, err
+		}
+
+		secondsPerYear := sdk.NewDec(int64(types.AprProjectionPeriod / time.Second))
+		for _, incentiveRecord := range incentiveRecords {
+			if !incentiveRecord.IncentiveRecordBody.StartTime.UTC().Before(ctx.BlockTime().UTC()) {
+				continue
+			}
+
+			uptimeIndex, err := findUptimeIndex(incentiveRecord.MinUptime)
+			if err != nil {
+				return sdk.Dec{}, nil, nil, err
+			}
+
+			qualifyingLiquidity, err := uptimeAccums[uptimeIndex].GetTotalShares()
+			if err != nil {
+				return sdk.Dec{}, nil, nil, err
+			}
+			if !qualifyingLiquidity.IsPositive() {
+				continue
+			}
+
+			annualEmission := sdk.MinDec(incentiveRecord.IncentiveRecordBody.EmissionRate.Mul(secondsPerYear), incentiveRecord.IncentiveRecordBody.RemainingAmount)
+			positionEmission := annualEmission.Mul(position.Liquidity).QuoTruncate(qualifyingLiquidity)
+			annualizedIncentives = annualizedIncentives.Add(sdk.NewDecCoinFromDec(incentiveRecord.IncentiveDenom, positionEmission))
+		}
+	}
+
+	// Price everything in token1.
+	price := pool.GetCurrentSqrtPrice().Power(2)
+	positionValue := asset0.Mul(price).Add(asset1)
+	if !positionValue.IsPositive() {
+		return sdk.ZeroDec(), annualizedFees, annualizedIncentives, nil
+	}
+
+	annualizedRewards := annualizedFees.Add(annualizedIncentives...)
+	rewardsValue := annualizedRewards.AmountOf(pool.GetToken0()).Mul(price).Add(annualizedRewards.AmountOf(pool.GetToken1()))
+
+	return rewardsValue.Quo(positionValue), annualizedFees, annualizedIncentives, nil
+}
+
+// positionConversionBounds returns the spot prices at the lower and upper ticks of the given position. Below its lower
+// tick the position is entirely converted to token0, and at or above its upper tick it is entirely converted to token1.
+// belowRange and aboveRange report whether the pool's current tick is already past the lower or upper tick respectively.
+// Returns error if the position or its pool does not exist.
+func (k Keeper) positionConversionBounds(ctx sdk.Context, positionId uint64) (lowerPrice, upperPrice sdk.Dec, belowRange, aboveRange bool, err error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, false, false, err
+	}
+
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, false, false, err
+	}
+
+	_, lowerPrice, err = k.priceAtTick(ctx, position.PoolId, position.LowerTick)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, false, false, err
+	}
+
+	_, upperPrice, err = k.priceAtTick(ctx, position.PoolId, position.UpperTick)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, false, false, err
+	}
+
+	currentTick := pool.GetCurrentTick()
+	belowRange = currentTick.LT(sdk.NewInt(position.LowerTick))
+	aboveRange = currentTick.GTE(sdk.NewInt(position.UpperTick))
+
+	return lowerPrice, upperPrice, belowRange, aboveRange, nil
+}
+
+// isPositionInRange returns whether the current tick of the given position's pool is within the position's range,
+// that is at or above its lower tick and below its upper tick, alongside the current tick and the position's ticks.
+// Returns error if the position or its pool does not exist.
+func (k Keeper) isPositionInRange(ctx sdk.Context, positionId uint64) (inRange bool, currentTick, lowerTick, upperTick int64, err error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return false, 0, 0, 0, err
+	}
+
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return false, 0, 0, 0, err
+	}
+
+	currentTick = pool.GetCurrentTick().Int64()
+	inRange = currentTick >= position.LowerTick && currentTick < position.UpperTick
+
+	return inRange, currentTick, position.LowerTick, position.UpperTick, nil
+}
+
+// positionImpermanentLoss estimates the impermanent loss of the position with the given id, that is how the current
+// value of the position compares to the value of simply holding the amounts it was created with. It relies on the
+// following assumptions:
+// - The amounts held are those that the position's current liquidity required at the pool's sqrt price when the
+// position was created. A position partially withdrawn from is therefore compared against holding the matching share
+// of its original deposit.
+// - Both values are priced in token1 at the pool's current sqrt price.
+// - Fees and incentives accrued by the position are not part of its value.
+//
+// Returns the impermanent loss as a fraction of the held value, which is zero or negative when the position is worth
+// less than holding, alongside the absolute difference between the two values in token1, the position's current
+// amounts and the amounts held. The fraction is zero if the held amounts have no value.
+// Returns error if:
+// - the position or its pool do not exist
+// - the position has no recorded entry sqrt price
+func (k Keeper) positionImpermanentLoss(ctx sdk.Context, positionId uint64) (impermanentLoss, valueDifference sdk.Dec, currentAmounts, heldAmounts sdk.DecCoins, err error) {
+	position, err := k.GetPosition(ctx, positionId)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, nil, nil, err
+	}
+
+	pool, err := k.getPoolById(ctx, position.PoolId)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, nil, nil, err
+	}
+
+	entrySqrtPrice, err := k.getPositionEntrySqrtPrice(ctx, positionId)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, nil, nil, err
+	}
+
+	sqrtPriceLowerTick, sqrtPriceUpperTick, err := math.TicksToSqrtPrice(position.LowerTick, position.UpperTick, pool.GetExponentAtPriceOne())
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, nil, nil, err
+	}
+
+	current0, current1, err := CalculateUnderlyingAssetsFromPosition(ctx, position, pool)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, nil, nil, err
+	}
+
+	// Mirror CalcActualAmounts with the entry sqrt price in place of the current one.
+	held0, held1 := sdk.ZeroDec(), sdk.ZeroDec()
+	if entrySqrtPrice.LT(sqrtPriceLowerTick) {
+		held0 = math.CalcAmount0Delta(position.Liquidity, sqrtPriceLowerTick, sqrtPriceUpperTick, false)
+	} else if entrySqrtPrice.GTE(sqrtPriceUpperTick) {
+		held1 = math.CalcAmount1Delta(position.Liquidity, sqrtPriceLowerTick, sqrtPriceUpperTick, false)
+	} else {
+		held0 = math.CalcAmount0Delta(position.Liquidity, entrySqrtPrice, sqrtPriceUpperTick, false)
+		held1 = math.CalcAmount1Delta(position.Liquidity, sqrtPriceLowerTick, entrySqrtPrice, false)
+	}
+
+	// Price everything in token1.
+	price := pool.GetCurrentSqrtPrice().Power(2)
+	currentValue := current0.Mul(price).Add(current1)
+	heldValue := held0.Mul(price).Add(held1)
+
+	currentAmounts = sdk.NewDecCoins(sdk.NewDecCoinFromDec(pool.GetToken0(), current0), sdk.NewDecCoinFromDec(pool.GetToken1(), current1))
+	heldAmounts = sdk.NewDecCoins(sdk.NewDecCoinFromDec(pool.GetToken0(), held0), sdk.NewDecCoinFromDec(pool.GetToken1(), held1))
+
+	valueDifference = currentValue.Sub(heldValue)
+	if !heldValue.IsPositive() {
+		return sdk.ZeroDec(), valueDifference, currentAmounts, heldAmounts, nil
+	}
+
+	return valueDifference.Quo(heldValue), valueDifference, currentAmounts, heldAmounts, nil
+}
+
+// poolStats returns the number of open positions in the given pool, the number of distinct addresses owning them
+// and the pool's active liquidity at its current tick.
+// Returns error if the pool does not exist or if fails to read a position.
+func (k Keeper) poolStats(ctx sdk.Context, poolId uint64) (numPositions, numOwners uint64, activeLiquidity sdk.Dec, err error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return 0, 0, sdk.Dec{}, err
+	}
+
+	positionIds, err := osmoutils.GatherValuesFromStorePrefix(ctx.KVStore(k.storeKey), types.KeyPoolPosition(poolId), ParsePositionIdFromBz)
+	if err != nil {
+		return 0, 0, sdk.Dec{}, err
+	}
+
+	// Each position records its owner, so distinct owners are counted from the positions themselves.
+	owners := make(map[string]struct{})
+	for _, positionId := range positionIds {
+		position, err := k.GetPosition(ctx, positionId)
+		if err != nil {
+			return 0, 0, sdk.Dec{}, err
+		}
+		owners[position.Address] = struct{}{}
+	}
+
+	return uint64(len(positionIds)), uint64(len(owners)), pool.GetLiquidity(), nil
+}
+
 // getNextPositionIdAndIncrement returns the next position Id, and increments the corresponding state entry.
 func (k Keeper) getNextPositionIdAndIncrement(ctx sdk.Context) uint64 {
 	nextPositionId := k.GetNextPositionId(ctx)
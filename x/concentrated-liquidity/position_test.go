@@ -4,11 +4,13 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/osmoutils/accum"
 	cl "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity"
 	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/model"
 	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types/query"
 )
 
 func (s *KeeperTestSuite) TestInitOrUpdatePosition() {
@@ -298,6 +300,63 @@ func (s *KeeperTestSuite) TestGetPosition() {
 	}
 }
 
+func (s *KeeperTestSuite) TestGetPositionsLiquidity() {
+	secondLiquidity := DefaultLiquidityAmt.QuoInt64(2)
+
+	tests := []struct {
+		name              string
+		positionIds       []uint64
+		expectedLiquidity map[uint64]sdk.Dec
+		expectedErr       error
+	}{
+		{
+			name:        "all positions exist",
+			positionIds: []uint64{DefaultPositionId, DefaultPositionId + 1},
+			expectedLiquidity: map[uint64]sdk.Dec{
+				DefaultPositionId:     DefaultLiquidityAmt,
+				DefaultPositionId + 1: secondLiquidity,
+			},
+		},
+		{
+			name:              "no position ids",
+			positionIds:       []uint64{},
+			expectedLiquidity: map[uint64]sdk.Dec{},
+		},
+		{
+			name:        "some positions do not exist",
+			positionIds: []uint64{DefaultPositionId + 2, DefaultPositionId, DefaultPositionId + 3},
+			expectedLiquidity: map[uint64]sdk.Dec{
+				DefaultPositionId: DefaultLiquidityAmt,
+			},
+			expectedErr: types.PositionIdsNotFoundError{PositionIds: []uint64{DefaultPositionId + 2, DefaultPositionId + 3}},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.Setup()
+			s.Ctx = s.Ctx.WithBlockTime(DefaultJoinTime)
+			s.PrepareConcentratedPool()
+
+			// Set up two initialized positions
+			err := s.App.ConcentratedLiquidityKeeper.InitOrUpdatePosition(s.Ctx, validPoolId, s.TestAccs[0], DefaultLowerTick, DefaultUpperTick, DefaultLiquidityAmt, DefaultJoinTime, DefaultPositionId)
+			s.Require().NoError(err)
+			err = s.App.ConcentratedLiquidityKeeper.InitOrUpdatePosition(s.Ctx, validPoolId, s.TestAccs[0], DefaultLowerTick, DefaultUpperTick, secondLiquidity, DefaultJoinTime, DefaultPositionId+1)
+			s.Require().NoError(err)
+
+			// System under test
+			liquidity, err := s.App.ConcentratedLiquidityKeeper.GetPositionsLiquidity(s.Ctx, test.positionIds)
+			if test.expectedErr != nil {
+				s.Require().Error(err)
+				s.Require().Equal(test.expectedErr, err)
+			} else {
+				s.Require().NoError(err)
+			}
+			s.Require().Equal(test.expectedLiquidity, liquidity)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestGetAllUserPositions() {
 	s.Setup()
 	defaultAddress := s.TestAccs[0]
@@ -496,3 +555,409 @@ func (s *KeeperTestSuite) TestCalculateUnderlyingAssetsFromPosition() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestGetPositionIdsForRange() {
+	s.Setup()
+	s.PrepareConcentratedPool()
+	defaultAddress := s.TestAccs[0]
+	secondAddress := s.TestAccs[1]
+
+	// Two positions on the default range, one on a different range and one owned by another address.
+	_, positionIdOne := s.SetupPosition(1, defaultAddress, DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime())
+	_, positionIdTwo := s.SetupPosition(1, defaultAddress, DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime())
+	_, positionIdThree := s.SetupPosition(1, defaultAddress, DefaultCoin0, DefaultCoin1, DefaultLowerTick+1, DefaultUpperTick, s.Ctx.BlockTime())
+	_, positionIdFour := s.SetupPosition(1, secondAddress, DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime())
+
+	tests := []struct {
+		name                string
+		owner               sdk.AccAddress
+		poolId              uint64
+		lowerTick           int64
+		upperTick           int64
+		expectedPositionIds []uint64
+	}{
+		{
+			name:                "multiple positions on the same range",
+			owner:               defaultAddress,
+			poolId:              1,
+			lowerTick:           DefaultLowerTick,
+			upperTick:           DefaultUpperTick,
+			expectedPositionIds: []uint64{positionIdOne, positionIdTwo},
+		},
+		{
+			name:                "single position on a different range",
+			owner:               defaultAddress,
+			poolId:              1,
+			lowerTick:           DefaultLowerTick + 1,
+			upperTick:           DefaultUpperTick,
+			expectedPositionIds: []uint64{positionIdThree},
+		},
+		{
+			name:                "same range, different owner",
+			owner:               secondAddress,
+			poolId:              1,
+			lowerTick:           DefaultLowerTick,
+			upperTick:           DefaultUpperTick,
+			expectedPositionIds: []uint64{positionIdFour},
+		},
+		{
+			name:                "no positions on range",
+			owner:               defaultAddress,
+			poolId:              1,
+			lowerTick:           DefaultLowerTick,
+			upperTick:           DefaultUpperTick + 1,
+			expectedPositionIds: []uint64{},
+		},
+		{
+			name:                "no positions in pool",
+			owner:               defaultAddress,
+			poolId:              2,
+			lowerTick:           DefaultLowerTick,
+			upperTick:           DefaultUpperTick,
+			expectedPositionIds: []uint64{},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			// System under test
+			positionIds, err := s.App.ConcentratedLiquidityKeeper.GetPositionIdsForRange(s.Ctx, test.owner, test.poolId, test.lowerTick, test.upperTick)
+			s.Require().NoError(err)
+			s.Require().Equal(test.expectedPositionIds, positionIds)
+		})
+	}
+
+	// Deleting a position removes it from the range index.
+	err := s.App.ConcentratedLiquidityKeeper.DeletePosition(s.Ctx, positionIdOne, defaultAddress, 1)
+	s.Require().NoError(err)
+	positionIds, err := s.App.ConcentratedLiquidityKeeper.GetPositionIdsForRange(s.Ctx, defaultAddress, 1, DefaultLowerTick, DefaultUpperTick)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{positionIdTwo}, positionIds)
+}
+
+func (s *KeeperTestSuite) TestIsPositionInRange() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	poolId := pool.GetId()
+
+	// Position does not exist.
+	_, _, _, _, err := clKeeper.IsPositionInRange(s.Ctx, 1)
+	s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: 1})
+
+	_, inRangePositionId := s.SetupPosition(poolId, s.TestAccs[0], DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime())
+	_, aboveCurrentPositionId := s.SetupPosition(poolId, s.TestAccs[0], DefaultCoin0, DefaultCoin1, DefaultUpperTick+100, DefaultUpperTick+200, s.Ctx.BlockTime())
+	_, belowCurrentPositionId := s.SetupPosition(poolId, s.TestAccs[0], DefaultCoin0, DefaultCoin1, DefaultLowerTick-200, DefaultLowerTick-100, s.Ctx.BlockTime())
+
+	pool, err = clKeeper.GetPoolById(s.Ctx, poolId)
+	s.Require().NoError(err)
+	currentTick := pool.GetCurrentTick().Int64()
+	_, currentTickPositionId := s.SetupPosition(poolId, s.TestAccs[0], DefaultCoin0, DefaultCoin1, currentTick, DefaultUpperTick, s.Ctx.BlockTime())
+
+	tests := map[string]struct {
+		positionId      uint64
+		lowerTick       int64
+		upperTick       int64
+		expectedInRange bool
+	}{
+		"current tick within range": {
+			positionId:      inRangePositionId,
+			lowerTick:       DefaultLowerTick,
+			upperTick:       DefaultUpperTick,
+			expectedInRange: true,
+		},
+		"current tick at lower tick": {
+			positionId:      currentTickPositionId,
+			lowerTick:       currentTick,
+			upperTick:       DefaultUpperTick,
+			expectedInRange: true,
+		},
+		"current tick below range": {
+			positionId: aboveCurrentPositionId,
+			lowerTick:  DefaultUpperTick + 100,
+			upperTick:  DefaultUpperTick + 200,
+		},
+		"current tick above range": {
+			positionId: belowCurrentPositionId,
+			lowerTick:  DefaultLowerTick - 200,
+			upperTick:  DefaultLowerTick - 100,
+		},
+	}
+
+	querier := cl.NewQuerier(*clKeeper)
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			inRange, actualCurrentTick, lowerTick, upperTick, err := clKeeper.IsPositionInRange(s.Ctx, tc.positionId)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedInRange, inRange)
+			s.Require().Equal(currentTick, actualCurrentTick)
+			s.Require().Equal(tc.lowerTick, lowerTick)
+			s.Require().Equal(tc.upperTick, upperTick)
+
+			res, err := querier.IsPositionInRange(sdk.WrapSDKContext(s.Ctx), &query.QueryIsPositionInRangeRequest{PositionId: tc.positionId})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedInRange, res.InRange)
+			s.Require().Equal(currentTick, res.CurrentTick)
+			s.Require().Equal(tc.lowerTick, res.LowerTick)
+			s.Require().Equal(tc.upperTick, res.UpperTick)
+		})
+	}
+
+	_, err = querier.IsPositionInRange(sdk.WrapSDKContext(s.Ctx), nil)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestPositionConversionBounds() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	poolId := pool.GetId()
+
+	// Position does not exist.
+	_, _, _, _, err := clKeeper.PositionConversionBounds(s.Ctx, 1)
+	s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: 1})
+
+	_, inRangePositionId := s.SetupPosition(poolId, s.TestAccs[0], DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime())
+	_, token0PositionId := s.SetupPosition(poolId, s.TestAccs[0], DefaultCoin0, DefaultCoin1, DefaultUpperTick+100, DefaultUpperTick+200, s.Ctx.BlockTime())
+	_, token1PositionId := s.SetupPosition(poolId, s.TestAccs[0], DefaultCoin0, DefaultCoin1, DefaultLowerTick-200, DefaultLowerTick-100, s.Ctx.BlockTime())
+
+	tests := map[string]struct {
+		positionId         uint64
+		lowerTick          int64
+		upperTick          int64
+		expectedBelowRange bool
+		expectedAboveRange bool
+	}{
+		"current price within range": {
+			positionId: inRangePositionId,
+			lowerTick:  DefaultLowerTick,
+			upperTick:  DefaultUpperTick,
+		},
+		"current price below range, position entirely token0": {
+			positionId:         token0PositionId,
+			lowerTick:          DefaultUpperTick + 100,
+			upperTick:          DefaultUpperTick + 200,
+			expectedBelowRange: true,
+		},
+		"current price above range, position entirely token1": {
+			positionId:         token1PositionId,
+			lowerTick:          DefaultLowerTick - 200,
+			upperTick:          DefaultLowerTick - 100,
+			expectedAboveRange: true,
+		},
+	}
+
+	querier := cl.NewQuerier(*clKeeper)
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			_, expectedLowerPrice, err := clKeeper.PriceAtTick(s.Ctx, poolId, tc.lowerTick)
+			s.Require().NoError(err)
+			_, expectedUpperPrice, err := clKeeper.PriceAtTick(s.Ctx, poolId, tc.upperTick)
+			s.Require().NoError(err)
+
+			lowerPrice, upperPrice, belowRange, aboveRange, err := clKeeper.PositionConversionBounds(s.Ctx, tc.positionId)
+			s.Require().NoError(err)
+			s.Require().Equal(expectedLowerPrice, lowerPrice)
+			s.Require().Equal(expectedUpperPrice, upperPrice)
+			s.Require().Equal(tc.expectedBelowRange, belowRange)
+			s.Require().Equal(tc.expectedAboveRange, aboveRange)
+
+			// The querier also returns the position's ticks.
+			res, err := querier.PositionConversionBounds(sdk.WrapSDKContext(s.Ctx), &query.QueryPositionConversionBoundsRequest{PositionId: tc.positionId})
+			s.Require().NoError(err)
+			s.Require().Equal(tc.lowerTick, res.LowerTick)
+			s.Require().Equal(tc.upperTick, res.UpperTick)
+			s.Require().Equal(expectedLowerPrice, res.LowerPrice)
+			s.Require().Equal(expectedUpperPrice, res.UpperPrice)
+			s.Require().Equal(tc.expectedBelowRange, res.BelowRange)
+			s.Require().Equal(tc.expectedAboveRange, res.AboveRange)
+		})
+	}
+
+	_, err = querier.PositionConversionBounds(sdk.WrapSDKContext(s.Ctx), nil)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestGetPositionsByJoinTimeRange() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	poolId := pool.GetId()
+
+	// Create one position per block, each an hour apart.
+	baseTime := s.Ctx.BlockTime()
+	positionIds := make([]uint64, 4)
+	for i := range positionIds {
+		s.Ctx = s.Ctx.WithBlockTime(baseTime.Add(time.Duration(i) * time.Hour))
+		_, positionIds[i] = s.SetupPosition(poolId, s.TestAccs[0], DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime())
+	}
+
+	getIds := func(positions []model.Position) []uint64 {
+		ids := []uint64{}
+		for _, position := range positions {
+			ids = append(ids, position.PositionId)
+		}
+		return ids
+	}
+
+	// The range is inclusive on both ends.
+	positions, _, err := clKeeper.GetPositionsByJoinTimeRange(s.Ctx, baseTime.Add(time.Hour), baseTime.Add(2*time.Hour), nil)
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{positionIds[1], positionIds[2]}, getIds(positions))
+
+	// A range containing no join time returns no positions.
+	positions, _, err = clKeeper.GetPositionsByJoinTimeRange(s.Ctx, baseTime.Add(time.Minute), baseTime.Add(time.Hour-time.Minute), nil)
+	s.Require().NoError(err)
+	s.Require().Empty(positions)
+
+	// An end before the start is rejected.
+	_, _, err = clKeeper.GetPositionsByJoinTimeRange(s.Ctx, baseTime.Add(time.Hour), baseTime, nil)
+	s.Require().ErrorIs(err, types.InvalidJoinTimeRangeError{StartTime: baseTime.Add(time.Hour), EndTime: baseTime})
+
+	// Paginate through the whole range two positions at a time.
+	endTime := baseTime.Add(3 * time.Hour)
+	positions, pageRes, err := clKeeper.GetPositionsByJoinTimeRange(s.Ctx, baseTime, endTime, &sdkquery.PageRequest{Limit: 2, CountTotal: true})
+	s.Require().NoError(err)
+	s.Require().Equal(positionIds[:2], getIds(positions))
+	s.Require().Equal(uint64(4), pageRes.Total)
+	s.Require().NotNil(pageRes.NextKey)
+
+	positions, pageRes, err = clKeeper.GetPositionsByJoinTimeRange(s.Ctx, baseTime, endTime, &sdkquery.PageRequest{Key: pageRes.NextKey, Limit: 2})
+	s.Require().NoError(err)
+	s.Require().Equal(positionIds[2:], getIds(positions))
+	s.Require().Nil(pageRes.NextKey)
+
+	// A deleted position is removed from the join time index.
+	err = clKeeper.DeletePosition(s.Ctx, positionIds[1], s.TestAccs[0], poolId)
+	s.Require().NoError(err)
+
+	querier := cl.NewQuerier(*clKeeper)
+	res, err := querier.PositionsByJoinTimeRange(sdk.WrapSDKContext(s.Ctx), &query.QueryPositionsByJoinTimeRangeRequest{StartTime: baseTime, EndTime: endTime})
+	s.Require().NoError(err)
+	s.Require().Equal([]uint64{positionIds[0], positionIds[2], positionIds[3]}, getIds(res.Positions))
+
+	_, err = querier.PositionsByJoinTimeRange(sdk.WrapSDKContext(s.Ctx), nil)
+	s.Require().Error(err)
+}
+
+func (s *KeeperTestSuite) TestPoolStats() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	poolId := s.PrepareConcentratedPool().GetId()
+
+	// Pool does not exist.
+	_, _, _, err := clKeeper.PoolStats(s.Ctx, poolId+1)
+	s.Require().Error(err)
+
+	// No positions yet.
+	numPositions, numOwners, activeLiquidity, err := clKeeper.PoolStats(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), numPositions)
+	s.Require().Equal(uint64(0), numOwners)
+	s.Require().Equal(sdk.ZeroDec(), activeLiquidity)
+
+	// Two positions owned by the same address and one by another.
+	s.SetupPosition(poolId, s.TestAccs[0], DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime())
+	_, positionId := s.SetupPosition(poolId, s.TestAccs[0], DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime())
+	s.SetupPosition(poolId, s.TestAccs[1], DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime())
+
+	// Positions in other pools are not counted.
+	otherPoolId := s.PrepareConcentratedPool().GetId()
+	s.SetupPosition(otherPoolId, s.TestAccs[2], DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime())
+
+	pool, err := clKeeper.GetPoolById(s.Ctx, poolId)
+	s.Require().NoError(err)
+	numPositions, numOwners, activeLiquidity, err = clKeeper.PoolStats(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(3), numPositions)
+	s.Require().Equal(uint64(2), numOwners)
+	s.Require().Equal(pool.GetLiquidity(), activeLiquidity)
+	s.Require().True(activeLiquidity.IsPositive())
+
+	// Deleted positions are no longer counted.
+	err = clKeeper.DeletePosition(s.Ctx, positionId, s.TestAccs[0], poolId)
+	s.Require().NoError(err)
+	numPositions, numOwners, _, err = clKeeper.PoolStats(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), numPositions)
+	s.Require().Equal(uint64(2), numOwners)
+
+	// The query returns the same stats.
+	querier := cl.NewQuerier(*clKeeper)
+	res, err := querier.PoolStats(sdk.WrapSDKContext(s.Ctx), &query.QueryPoolStatsRequest{PoolId: poolId})
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), res.NumPositions)
+	s.Require().Equal(uint64(2), res.NumOwners)
+}
+
+func (s *KeeperTestSuite) TestPositionImpermanentLoss() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	poolId := pool.GetId()
+
+	// Position does not exist.
+	_, _, _, _, err := clKeeper.PositionImpermanentLoss(s.Ctx, 1)
+	s.Require().ErrorIs(err, types.PositionIdNotFoundError{PositionId: 1})
+
+	_, positionId := s.SetupPosition(poolId, s.TestAccs[0], DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime())
+
+	// The entry sqrt price is the price the position was created at.
+	pool, err = clKeeper.GetPoolById(s.Ctx, poolId)
+	s.Require().NoError(err)
+	entrySqrtPrice, err := clKeeper.GetPositionEntrySqrtPrice(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().Equal(pool.GetCurrentSqrtPrice(), entrySqrtPrice)
+
+	// Without any price movement the position is worth exactly its deposit.
+	impermanentLoss, valueDifference, currentAmounts, heldAmounts, err := clKeeper.PositionImpermanentLoss(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.ZeroDec(), impermanentLoss)
+	s.Require().Equal(sdk.ZeroDec(), valueDifference)
+	s.Require().Equal(heldAmounts, currentAmounts)
+
+	// Move the price up while staying within the position's range. The tick is left unchanged since it only
+	// determines whether the position is in range.
+	newSqrtPrice := entrySqrtPrice.Mul(sdk.NewDecWithPrec(102, 2))
+	pool.SetCurrentSqrtPrice(newSqrtPrice)
+	err = clKeeper.SetPool(s.Ctx, pool)
+	s.Require().NoError(err)
+
+	impermanentLoss, valueDifference, currentAmounts, newHeldAmounts, err := clKeeper.PositionImpermanentLoss(s.Ctx, positionId)
+	s.Require().NoError(err)
+	s.Require().Equal(heldAmounts, newHeldAmounts)
+
+	// Providing liquidity sells token0 as its price rises, so the position is worth less than holding.
+	price := newSqrtPrice.Power(2)
+	heldValue := heldAmounts.AmountOf(ETH).Mul(price).Add(heldAmounts.AmountOf(USDC))
+	currentValue := currentAmounts.AmountOf(ETH).Mul(price).Add(currentAmounts.AmountOf(USDC))
+	s.Require().True(currentAmounts.AmountOf(ETH).LT(heldAmounts.AmountOf(ETH)))
+	s.Require().Equal(currentValue.Sub(heldValue), valueDifference)
+	s.Require().True(valueDifference.IsNegative())
+	s.Require().Equal(valueDifference.Quo(heldValue), impermanentLoss)
+
+	// The query returns the same estimate.
+	querier := cl.NewQuerier(*clKeeper)
+	res, err := querier.PositionImpermanentLoss(sdk.WrapSDKContext(s.Ctx), &query.QueryPositionImpermanentLossRequest{PositionId: positionId})
+	s.Require().NoError(err)
+	s.Require().Equal(impermanentLoss, res.ImpermanentLoss)
+	s.Require().Equal(valueDifference, res.ValueDifference)
+	s.Require().Equal(currentAmounts, res.CurrentAmounts)
+	s.Require().Equal(heldAmounts, res.HeldAmounts)
+
+	_, err = querier.PositionImpermanentLoss(sdk.WrapSDKContext(s.Ctx), nil)
+	s.Require().Error(err)
+
+	// Deleting the position removes its entry sqrt price.
+	err = clKeeper.DeletePosition(s.Ctx, positionId, s.TestAccs[0], poolId)
+	s.Require().NoError(err)
+	_, err = clKeeper.GetPositionEntrySqrtPrice(s.Ctx, positionId)
+	s.Require().ErrorIs(err, types.PositionEntrySqrtPriceNotFoundError{PositionId: positionId})
+
+	// Positions without a recorded entry sqrt price, such as those imported from genesis, cannot be estimated.
+	clKeeper.SetPosition(s.Ctx, poolId, s.TestAccs[0], DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime(), sdk.OneDec(), positionId)
+	_, _, _, _, err = clKeeper.PositionImpermanentLoss(s.Ctx, positionId)
+	s.Require().ErrorIs(err, types.PositionEntrySqrtPriceNotFoundError{PositionId: positionId})
+}
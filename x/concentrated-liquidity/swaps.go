@@ -2,6 +2,8 @@ package concentrated_liquidity
 
 import (
 	fmt "fmt"
+	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -52,10 +54,20 @@ type SwapState struct {
 	// Initialized to zero.
 	// Updated after every swap step.
 	feeGrowthGlobal sdk.Dec
+
+	// Total fees charged to the liquidity in the swap's path.
+	// Initialized to zero.
+	// Updated after every swap step alongside feeGrowthGlobal.
+	feesCollected sdk.Dec
+
+	// Total fees routed to the protocol rather than to the liquidity in the swap's path.
+	// Initialized to zero.
+	// Updated after every swap step.
+	protocolFeesCollected sdk.Dec
 }
 
 // updateFeeGrowthGlobal updates the swap state's fee growth global per unit of liquidity
-// when liquidity is positive.
+// and the total fees collected when liquidity is positive.
 //
 // If the liquidity is zero, this is a no-op. This case may occur when there is no liquidity
 // between the ticks.This is possible when there are only 2 positions with no overlapping ranges.
@@ -66,6 +78,7 @@ func (ss *SwapState) updateFeeGrowthGlobal(feeChargeTotal sdk.Dec) {
 	if !ss.liquidity.IsZero() {
 		feeChargePerUnitOfLiquidity := feeChargeTotal.Quo(ss.liquidity)
 		ss.feeGrowthGlobal = ss.feeGrowthGlobal.Add(feeChargePerUnitOfLiquidity)
+		ss.feesCollected = ss.feesCollected.Add(feeChargeTotal)
 		return
 	}
 }
@@ -108,6 +121,48 @@ func (k Keeper) SwapExactAmountIn(
 	return tokenOutAmount, nil
 }
 
+// SwapExactAmountInWithPriceLimit swaps up to the given tokenIn for tokenOutDenom, stopping once the pool's spot price
+// reaches priceLimit. If the limit is reached before all of tokenIn is consumed, the swap is partially filled and only
+// the consumed amount is taken from the sender. Returns the amount of tokenIn consumed and the amount of tokenOut produced.
+// Returns error if the price limit is not positive or lies on the wrong side of the current price for the swap direction,
+// or if the amount produced is less than tokenOutMinAmount.
+func (k Keeper) SwapExactAmountInWithPriceLimit(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolI poolmanagertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	tokenOutMinAmount sdk.Int,
+	priceLimit sdk.Dec,
+	swapFee sdk.Dec,
+) (tokenInAmount, tokenOutAmount sdk.Int, err error) {
+	if tokenIn.Denom == tokenOutDenom {
+		return sdk.Int{}, sdk.Int{}, types.DenomDuplicatedError{TokenInDenom: tokenIn.Denom, TokenOutDenom: tokenOutDenom}
+	}
+
+	// A zero price limit means no limit to the swap loop, so it must be rejected here.
+	if !priceLimit.IsPositive() {
+		return sdk.Int{}, sdk.Int{}, types.NonPositivePriceLimitError{PriceLimit: priceLimit}
+	}
+
+	pool, err := convertPoolInterfaceToConcentrated(poolI)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	tokenIn, tokenOut, _, _, _, err := k.swapOutAmtGivenIn(ctx, sender, pool, tokenIn, tokenOutDenom, swapFee, priceLimit)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	// price impact protection.
+	if tokenOut.Amount.LT(tokenOutMinAmount) {
+		return sdk.Int{}, sdk.Int{}, types.AmountLessThanMinError{TokenAmount: tokenOut.Amount, TokenMin: tokenOutMinAmount}
+	}
+
+	return tokenIn.Amount, tokenOut.Amount, nil
+}
+
 func (k Keeper) SwapExactAmountOut(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
@@ -158,7 +213,7 @@ func (k Keeper) swapOutAmtGivenIn(
 	swapFee sdk.Dec,
 	priceLimit sdk.Dec,
 ) (calcTokenIn, calcTokenOut sdk.Coin, currentTick sdk.Int, liquidity, sqrtPrice sdk.Dec, err error) {
-	writeCtx, tokenIn, tokenOut, newCurrentTick, newLiquidity, newSqrtPrice, err := k.calcOutAmtGivenIn(ctx, tokenIn, tokenOutDenom, swapFee, priceLimit, pool.GetId())
+	writeCtx, tokenIn, tokenOut, newCurrentTick, newLiquidity, newSqrtPrice, _, err := k.calcOutAmtGivenIn(ctx, tokenIn, tokenOutDenom, swapFee, priceLimit, pool.GetId())
 	if err != nil {
 		return sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, err
 	}
@@ -178,6 +233,11 @@ func (k Keeper) swapOutAmtGivenIn(
 		return sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, err
 	}
 
+	// Now that the token in has been settled in the pool, move the protocol's share of the fees out of it.
+	if err := k.routeProtocolFees(ctx, pool); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, err
+	}
+
 	return tokenIn, tokenOut, newCurrentTick, newLiquidity, newSqrtPrice, nil
 }
 
@@ -211,6 +271,11 @@ func (k *Keeper) swapInAmtGivenOut(
 		return sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, err
 	}
 
+	// Now that the token in has been settled in the pool, move the protocol's share of the fees out of it.
+	if err := k.routeProtocolFees(ctx, pool); err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, err
+	}
+
 	return tokenIn, tokenOut, newCurrentTick, newLiquidity, newSqrtPrice, nil
 }
 
@@ -221,7 +286,7 @@ func (k Keeper) CalcOutAmtGivenIn(
 	tokenOutDenom string,
 	swapFee sdk.Dec,
 ) (tokenOut sdk.Coin, err error) {
-	_, _, tokenOut, _, _, _, err = k.calcOutAmtGivenIn(ctx, tokenIn, tokenOutDenom, swapFee, sdk.ZeroDec(), poolI.GetId())
+	_, _, tokenOut, _, _, _, _, err = k.calcOutAmtGivenIn(ctx, tokenIn, tokenOutDenom, swapFee, sdk.ZeroDec(), poolI.GetId())
 	if err != nil {
 		return sdk.Coin{}, err
 	}
@@ -242,8 +307,113 @@ func (k Keeper) CalcInAmtGivenOut(
 	return tokenIn, nil
 }
 
+// simulateSwapExactAmountIn simulates swapping tokenIn for tokenOutDenom in the given pool at the pool's swap fee
+// without writing to state. It returns the amount out alongside the portion of the amount in that is charged as
+// a swap fee and the remainder that is actually swapped, such that feeCharged plus amountInAfterFee equals the
+// amount in consumed by the swap.
+func (k Keeper) simulateSwapExactAmountIn(ctx sdk.Context, poolId uint64, tokenIn sdk.Coin, tokenOutDenom string) (amountOut, feeCharged, amountInAfterFee sdk.Coin, err error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	// the cached context is never written, so the simulation leaves no trace in state
+	_, tokenInConsumed, tokenOut, _, _, _, totalFee, err := k.calcOutAmtGivenIn(ctx, tokenIn, tokenOutDenom, pool.GetSwapFee(ctx), sdk.ZeroDec(), poolId)
+	if err != nil {
+		return sdk.Coin{}, sdk.Coin{}, sdk.Coin{}, err
+	}
+
+	// round the fee the same way as the amount in so that it never exceeds it
+	feeCharged = sdk.NewCoin(tokenIn.Denom, totalFee.RoundInt())
+	amountInAfterFee = tokenInConsumed.Sub(feeCharged)
+
+	return tokenOut, feeCharged, amountInAfterFee, nil
+}
+
+// liquidityToReachPrice returns the amount of token in, including the swap fee, that would have to be swapped into the
+// given pool to move its spot price from the current price to targetPrice, alongside the initialized ticks such a swap
+// would cross in the order it crosses them. A target below the current price is reached by swapping in token0 and a
+// target above it by swapping in token1. The pool is walked the same way a swap walks it, but nothing is written to state.
+// Returns error if:
+// - the pool does not exist or has no price yet
+// - the target price is outside of the supported spot price range
+// - the pool runs out of initialized ticks before the target price is reached
+func (k Keeper) liquidityToReachPrice(ctx sdk.Context, poolId uint64, targetPrice sdk.Dec) (tokenIn sdk.Coin, crossedTicks []int64, err error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+
+	currentSqrtPrice := pool.GetCurrentSqrtPrice()
+	if k.isInitialPositionForPool(currentSqrtPrice, pool.GetCurrentTick()) {
+		return sdk.Coin{}, nil, types.PoolNotInitializedError{PoolId: poolId}
+	}
+
+	if targetPrice.IsNil() || targetPrice.LT(types.MinSpotPrice) || targetPrice.GT(types.MaxSpotPrice) {
+		return sdk.Coin{}, nil, types.PriceBoundError{ProvidedPrice: targetPrice, MinSpotPrice: types.MinSpotPrice, MaxSpotPrice: types.MaxSpotPrice}
+	}
+
+	targetSqrtPrice, err := targetPrice.ApproxSqrt()
+	if err != nil {
+		return sdk.Coin{}, nil, err
+	}
+
+	// the price decreases when swapping asset0 in and increases when swapping asset1 in
+	zeroForOne := targetSqrtPrice.LT(currentSqrtPrice)
+	tokenInDenom := pool.GetToken1()
+	if zeroForOne {
+		tokenInDenom = pool.GetToken0()
+	}
+	swapStrategy := swapstrategy.New(zeroForOne, targetSqrtPrice, k.storeKey, sdk.ZeroDec())
+	swapFee := pool.GetSwapFee(ctx)
+
+	sqrtPrice := currentSqrtPrice
+	tick := swapStrategy.InitializeTickValue(pool.GetCurrentTick())
+	liquidity := pool.GetLiquidity()
+	amountIn := sdk.ZeroDec()
+	crossedTicks = []int64{}
+
+	// each step moves the price up to the next initialized tick or the target price, whichever is closer
+	for !sqrtPrice.Equal(targetSqrtPrice) {
+		nextTick, ok := swapStrategy.NextInitializedTick(ctx, poolId, tick.Int64())
+		if !ok {
+			return sdk.Coin{}, nil, fmt.Errorf("there are no more ticks initialized to reach price (%s) in pool (%d)", targetPrice, poolId)
+		}
+
+		nextTickSqrtPrice, err := math.TickToSqrtPrice(nextTick, pool.GetExponentAtPriceOne())
+		if err != nil {
+			return sdk.Coin{}, nil, fmt.Errorf("could not convert next tick (%v) to nextSqrtPrice", nextTick)
+		}
+		sqrtPriceTarget := swapStrategy.GetSqrtTargetPrice(nextTickSqrtPrice)
+
+		// the amount in is rounded up and the fee is charged on top of it, as a swap does
+		var stepAmountIn sdk.Dec
+		if zeroForOne {
+			stepAmountIn = math.CalcAmount0Delta(liquidity, sqrtPriceTarget, sqrtPrice, true)
+		} else {
+			stepAmountIn = math.CalcAmount1Delta(liquidity, sqrtPriceTarget, sqrtPrice, true)
+		}
+		stepFee := stepAmountIn.MulRoundUp(swapFee).QuoRoundUp(sdk.OneDec().Sub(swapFee))
+		amountIn = amountIn.Add(stepAmountIn).Add(stepFee)
+		sqrtPrice = sqrtPriceTarget
+
+		if nextTickSqrtPrice.Equal(sqrtPrice) {
+			tickInfo, err := k.getTickInfo(ctx, poolId, nextTick.Int64())
+			if err != nil {
+				return sdk.Coin{}, nil, err
+			}
+			liquidity = math.AddLiquidity(liquidity, swapStrategy.SetLiquidityDeltaSign(tickInfo.LiquidityNet))
+			tick = nextTick
+			crossedTicks = append(crossedTicks, nextTick.Int64())
+		}
+	}
+
+	return sdk.NewCoin(tokenInDenom, amountIn.Ceil().TruncateInt()), crossedTicks, nil
+}
+
 // calcOutAmtGivenIn calculates tokens to be swapped out given the provided amount and fee deducted. It also returns
-// what the updated tick, liquidity, and currentSqrtPrice for the pool would be after this swap.
+// what the updated tick, liquidity, and currentSqrtPrice for the pool would be after this swap, alongside the
+// total swap fee charged on the token in.
 // Note this method is non-mutative, so the values returned by CalcOutAmtGivenIn do not get stored
 // Instead, we return writeCtx function so that the caller of this method can decide to write the cached ctx to store or not.
 func (k Keeper) calcOutAmtGivenIn(ctx sdk.Context,
@@ -252,11 +422,11 @@ func (k Keeper) calcOutAmtGivenIn(ctx sdk.Context,
 	swapFee sdk.Dec,
 	priceLimit sdk.Dec,
 	poolId uint64,
-) (writeCtx func(), tokenIn, tokenOut sdk.Coin, updatedTick sdk.Int, updatedLiquidity, updatedSqrtPrice sdk.Dec, err error) {
-	ctx, writeCtx = ctx.CacheContext()
+) (writeCtx func(), tokenIn, tokenOut sdk.Coin, updatedTick sdk.Int, updatedLiquidity, updatedSqrtPrice, feeCharged sdk.Dec, err error) {
+	ctx, writeCtx = cacheCtxWithEvents(ctx)
 	p, err := k.getPoolById(ctx, poolId)
 	if err != nil {
-		return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, err
+		return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, err
 	}
 	asset0 := p.GetToken0()
 	asset1 := p.GetToken1()
@@ -275,7 +445,7 @@ func (k Keeper) calcOutAmtGivenIn(ctx sdk.Context,
 	// take provided price limit and turn this into a sqrt price limit since formulas use sqrtPrice
 	sqrtPriceLimit, err := priceLimit.ApproxSqrt()
 	if err != nil {
-		return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, fmt.Errorf("issue calculating square root of price limit")
+		return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, fmt.Errorf("issue calculating square root of price limit")
 	}
 
 	// set the swap strategy
@@ -284,20 +454,20 @@ func (k Keeper) calcOutAmtGivenIn(ctx sdk.Context,
 	// get current sqrt price from pool
 	curSqrtPrice := p.GetCurrentSqrtPrice()
 	if err := swapStrategy.ValidateSqrtPrice(sqrtPriceLimit, curSqrtPrice); err != nil {
-		return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, err
+		return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, err
 	}
 
 	// check that the specified tokenIn matches one of the assets in the specified pool
 	if tokenInMin.Denom != asset0 && tokenInMin.Denom != asset1 {
-		return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, types.TokenInDenomNotInPoolError{TokenInDenom: tokenInMin.Denom}
+		return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, types.TokenInDenomNotInPoolError{TokenInDenom: tokenInMin.Denom}
 	}
 	// check that the specified tokenOut matches one of the assets in the specified pool
 	if tokenOutDenom != asset0 && tokenOutDenom != asset1 {
-		return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, types.TokenOutDenomNotInPoolError{TokenOutDenom: tokenOutDenom}
+		return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, types.TokenOutDenomNotInPoolError{TokenOutDenom: tokenOutDenom}
 	}
 	// check that token in and token out are different denominations
 	if tokenInMin.Denom == tokenOutDenom {
-		return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, types.DenomDuplicatedError{TokenInDenom: tokenInMin.Denom, TokenOutDenom: tokenOutDenom}
+		return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, types.DenomDuplicatedError{TokenInDenom: tokenInMin.Denom, TokenOutDenom: tokenOutDenom}
 	}
 
 	// initialize swap state with the following parameters:
@@ -309,8 +479,16 @@ func (k Keeper) calcOutAmtGivenIn(ctx sdk.Context,
 		tick:                     swapStrategy.InitializeTickValue(p.GetCurrentTick()),
 		liquidity:                p.GetLiquidity(),
 		feeGrowthGlobal:          sdk.ZeroDec(),
+		feesCollected:            sdk.ZeroDec(),
+		protocolFeesCollected:    sdk.ZeroDec(),
 	}
 
+	// track the ticks crossed during the swap and the liquidity active in each segment between them
+	crossedTicks := []int64{}
+	segmentLiquidity := []sdk.Dec{swapState.liquidity}
+	maxTicksCrossed := k.GetParams(ctx).MaxTicksCrossedPerSwap
+	protocolFeeFraction := p.GetProtocolFeeFraction()
+
 	// iterate and update swapState until we swap all tokenIn or we reach the specific sqrtPriceLimit
 	// TODO: for now, we check if amountSpecifiedRemaining is GT 0.0000001. This is because there are times when the remaining
 	// amount may be extremely small, and that small amount cannot generate and amountIn/amountOut and we are therefore left
@@ -325,13 +503,13 @@ func (k Keeper) calcOutAmtGivenIn(ctx sdk.Context,
 		// if no ticks are initialized (no users have created liquidity positions) then we return an error
 		nextTick, ok := swapStrategy.NextInitializedTick(ctx, poolId, swapState.tick.Int64())
 		if !ok {
-			return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, fmt.Errorf("there are no more ticks initialized to fill the swap")
+			return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, fmt.Errorf("there are no more ticks initialized to fill the swap")
 		}
 
 		// utilizing the next initialized tick, we find the corresponding nextPrice (the target price)
 		nextTickSqrtPrice, err := math.TickToSqrtPrice(nextTick, p.GetExponentAtPriceOne())
 		if err != nil {
-			return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, fmt.Errorf("could not convert next tick (%v) to nextSqrtPrice", nextTick)
+			return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, fmt.Errorf("could not convert next tick (%v) to nextSqrtPrice", nextTick)
 		}
 
 		sqrtPriceTarget := swapStrategy.GetSqrtTargetPrice(nextTickSqrtPrice)
@@ -345,8 +523,10 @@ func (k Keeper) calcOutAmtGivenIn(ctx sdk.Context,
 			swapState.amountSpecifiedRemaining,
 		)
 
-		// Update the fee growth for the entire swap using the total fees charged.
-		swapState.updateFeeGrowthGlobal(feeCharge)
+		// Update the fee growth for the entire swap using the fees charged less the protocol's share.
+		protocolFee := feeCharge.Mul(protocolFeeFraction)
+		swapState.updateFeeGrowthGlobal(feeCharge.Sub(protocolFee))
+		swapState.protocolFeesCollected = swapState.protocolFeesCollected.Add(protocolFee)
 
 		ctx.Logger().Debug("cl calc out given in")
 		ctx.Logger().Debug("start sqrt price", swapState.sqrtPrice)
@@ -369,7 +549,7 @@ func (k Keeper) calcOutAmtGivenIn(ctx sdk.Context,
 			// retrieve the liquidity held in the next closest initialized tick
 			liquidityNet, err := k.crossTick(ctx, p.GetId(), nextTick.Int64(), sdk.NewDecCoinFromDec(tokenInMin.Denom, swapState.feeGrowthGlobal))
 			if err != nil {
-				return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, err
+				return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, err
 			}
 			liquidityNet = swapStrategy.SetLiquidityDeltaSign(liquidityNet)
 			// update the swapState's liquidity with the new tick's liquidity
@@ -378,20 +558,38 @@ func (k Keeper) calcOutAmtGivenIn(ctx sdk.Context,
 
 			// update the swapState's tick with the tick we retrieved liquidity from
 			swapState.tick = nextTick
+
+			crossedTicks = append(crossedTicks, nextTick.Int64())
+			segmentLiquidity = append(segmentLiquidity, swapState.liquidity)
+
+			// abort the swap rather than letting its gas consumption grow with the number of ticks crossed
+			if uint64(len(crossedTicks)) > maxTicksCrossed {
+				return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, types.TooManyTicksCrossedError{PoolId: poolId, MaxTicksCrossed: maxTicksCrossed}
+			}
 		} else if !sqrtPriceStart.Equal(sqrtPrice) {
 			// otherwise if the sqrtPrice calculated from computeSwapStep does not equal the sqrtPrice we started with at the
 			// beginning of this iteration, we set the swapState tick to the corresponding tick of the sqrtPrice calculated from computeSwapStep
 			swapState.tick, err = math.PriceToTick(sqrtPrice.Power(2), p.GetExponentAtPriceOne())
 			if err != nil {
-				return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, err
+				return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, err
 			}
 		}
 	}
 
 	if err := k.chargeFee(ctx, poolId, sdk.NewDecCoinFromDec(tokenInMin.Denom, swapState.feeGrowthGlobal)); err != nil {
-		return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, err
+		return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	if err := k.recordFeeRevenue(ctx, poolId, sdk.NewDecCoinFromDec(tokenInMin.Denom, swapState.feesCollected)); err != nil {
+		return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	if err := k.accrueProtocolFees(ctx, poolId, sdk.NewDecCoinFromDec(tokenInMin.Denom, swapState.protocolFeesCollected)); err != nil {
+		return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, sdk.Dec{}, err
 	}
 
+	emitSwapTickCrossingsEvent(ctx, poolId, crossedTicks, segmentLiquidity)
+
 	// coin amounts require int values
 	// round amountIn up to avoid under charging
 	amt0 := tokenAmountInSpecified.Sub(swapState.amountSpecifiedRemaining).RoundInt()
@@ -403,7 +601,7 @@ func (k Keeper) calcOutAmtGivenIn(ctx sdk.Context,
 	tokenIn = sdk.NewCoin(tokenInMin.Denom, amt0)
 	tokenOut = sdk.NewCoin(tokenOutDenom, amt1)
 
-	return writeCtx, tokenIn, tokenOut, swapState.tick, swapState.liquidity, swapState.sqrtPrice, nil
+	return writeCtx, tokenIn, tokenOut, swapState.tick, swapState.liquidity, swapState.sqrtPrice, swapState.feesCollected.Add(swapState.protocolFeesCollected), nil
 }
 
 // calcInAmtGivenOut calculates tokens to be swapped in given the desired token out and fee deducted. It also returns
@@ -418,7 +616,7 @@ func (k Keeper) calcInAmtGivenOut(
 	priceLimit sdk.Dec,
 	poolId uint64,
 ) (writeCtx func(), tokenIn, tokenOut sdk.Coin, updatedTick sdk.Int, updatedLiquidity, updatedSqrtPrice sdk.Dec, err error) {
-	ctx, writeCtx = ctx.CacheContext()
+	ctx, writeCtx = cacheCtxWithEvents(ctx)
 	p, err := k.getPoolById(ctx, poolId)
 	if err != nil {
 		return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, err
@@ -474,8 +672,16 @@ func (k Keeper) calcInAmtGivenOut(
 		tick:                     swapStrategy.InitializeTickValue(p.GetCurrentTick()),
 		liquidity:                p.GetLiquidity(),
 		feeGrowthGlobal:          sdk.ZeroDec(),
+		feesCollected:            sdk.ZeroDec(),
+		protocolFeesCollected:    sdk.ZeroDec(),
 	}
 
+	// track the ticks crossed during the swap and the liquidity active in each segment between them
+	crossedTicks := []int64{}
+	segmentLiquidity := []sdk.Dec{swapState.liquidity}
+	maxTicksCrossed := k.GetParams(ctx).MaxTicksCrossedPerSwap
+	protocolFeeFraction := p.GetProtocolFeeFraction()
+
 	// TODO: This should be GT 0 but some instances have very small remainder
 	// need to look into fixing this
 	for swapState.amountSpecifiedRemaining.GT(sdk.SmallestDec()) && !swapState.sqrtPrice.Equal(sqrtPriceLimit) {
@@ -508,7 +714,10 @@ func (k Keeper) calcInAmtGivenOut(
 			swapState.amountSpecifiedRemaining,
 		)
 
-		swapState.updateFeeGrowthGlobal(feeChargeTotal)
+		// Update the fee growth for the entire swap using the fees charged less the protocol's share.
+		protocolFee := feeChargeTotal.Mul(protocolFeeFraction)
+		swapState.updateFeeGrowthGlobal(feeChargeTotal.Sub(protocolFee))
+		swapState.protocolFeesCollected = swapState.protocolFeesCollected.Add(protocolFee)
 
 		ctx.Logger().Debug("cl calc in given out")
 		ctx.Logger().Debug("start sqrt price", swapState.sqrtPrice)
@@ -538,6 +747,14 @@ func (k Keeper) calcInAmtGivenOut(
 
 			// update the swapState's tick with the tick we retrieved liquidity from
 			swapState.tick = nextTick
+
+			crossedTicks = append(crossedTicks, nextTick.Int64())
+			segmentLiquidity = append(segmentLiquidity, swapState.liquidity)
+
+			// abort the swap rather than letting its gas consumption grow with the number of ticks crossed
+			if uint64(len(crossedTicks)) > maxTicksCrossed {
+				return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, types.TooManyTicksCrossedError{PoolId: poolId, MaxTicksCrossed: maxTicksCrossed}
+			}
 		} else if !sqrtPriceStart.Equal(sqrtPrice) {
 			// otherwise if the sqrtPrice calculated from computeSwapStep does not equal the sqrtPrice we started with at the
 			// beginning of this iteration, we set the swapState tick to the corresponding tick of the sqrtPrice calculated from computeSwapStep
@@ -552,6 +769,16 @@ func (k Keeper) calcInAmtGivenOut(
 		return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, err
 	}
 
+	if err := k.recordFeeRevenue(ctx, poolId, sdk.NewDecCoinFromDec(tokenInDenom, swapState.feesCollected)); err != nil {
+		return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	if err := k.accrueProtocolFees(ctx, poolId, sdk.NewDecCoinFromDec(tokenInDenom, swapState.protocolFeesCollected)); err != nil {
+		return writeCtx, sdk.Coin{}, sdk.Coin{}, sdk.Int{}, sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	emitSwapTickCrossingsEvent(ctx, poolId, crossedTicks, segmentLiquidity)
+
 	// coin amounts require int values
 	// Round amount in up to avoid under charging the user.
 	// Round amount out down to avoid over charging the pool.
@@ -567,6 +794,42 @@ func (k Keeper) calcInAmtGivenOut(
 	return writeCtx, tokenIn, tokenOut, swapState.tick, swapState.liquidity, swapState.sqrtPrice, nil
 }
 
+// cacheCtxWithEvents returns a cache context of ctx with its own event manager, alongside a function
+// that writes the cached state to ctx and emits the cached events on ctx's event manager.
+// This way, events emitted while calculating a swap are only surfaced if the swap is committed.
+func cacheCtxWithEvents(ctx sdk.Context) (sdk.Context, func()) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+	return cacheCtx, func() {
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	}
+}
+
+// emitSwapTickCrossingsEvent emits an event listing the ticks crossed by a swap in order, and the
+// liquidity active in each segment of the swap. There is always one more segment than crossed ticks:
+// the first segment is the liquidity active before crossing any tick, and each following segment
+// is the liquidity active after crossing the corresponding tick.
+func emitSwapTickCrossingsEvent(ctx sdk.Context, poolId uint64, crossedTicks []int64, segmentLiquidity []sdk.Dec) {
+	crossedTickStrs := make([]string, 0, len(crossedTicks))
+	for _, tick := range crossedTicks {
+		crossedTickStrs = append(crossedTickStrs, strconv.FormatInt(tick, 10))
+	}
+
+	segmentLiquidityStrs := make([]string, 0, len(segmentLiquidity))
+	for _, liquidity := range segmentLiquidity {
+		segmentLiquidityStrs = append(segmentLiquidityStrs, liquidity.String())
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtSwapTickCrossings,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeCrossedTicks, strings.Join(crossedTickStrs, ",")),
+		sdk.NewAttribute(types.AttributeSegmentLiquidity, strings.Join(segmentLiquidityStrs, ",")),
+	))
+}
+
 // updatePoolForSwap updates the given pool object with the results of a swap operation.
 //
 // The method consumes a fixed amount of gas per swap to prevent spam. It applies the swap operation to the given
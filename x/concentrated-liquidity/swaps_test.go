@@ -12,6 +12,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/internal/math"
 	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
 	cltypes "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types/query"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 )
 
@@ -1671,6 +1672,111 @@ func (s *KeeperTestSuite) TestSwapOutAmtGivenIn_TickUpdates() {
 	}
 }
 
+func (s *KeeperTestSuite) TestSwapOutAmtGivenIn_MaxTicksCrossed() {
+	tests := map[string]struct {
+		maxTicksCrossed uint64
+		expectedErr     error
+	}{
+		"crossing as many ticks as allowed": {
+			maxTicksCrossed: 2,
+		},
+		"crossing more ticks than allowed": {
+			maxTicksCrossed: 1,
+			expectedErr:     cltypes.TooManyTicksCrossedError{PoolId: 1, MaxTicksCrossed: 1},
+		},
+	}
+
+	for name, tc := range tests {
+		tc := tc
+		s.Run(name, func() {
+			s.SetupTest()
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+
+			params := clKeeper.GetParams(s.Ctx)
+			params.MaxTicksCrossedPerSwap = tc.maxTicksCrossed
+			clKeeper.SetParams(s.Ctx, params)
+
+			// A swap down to a price of 4000 crosses the default position's lower tick and the
+			// lower tick of a position starting at a price of 4200, ending in the full range position.
+			pool := s.PrepareConcentratedPool()
+			s.SetupDefaultPosition(pool.GetId())
+			s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[1])
+			lowerTick, err := math.PriceToTick(sdk.NewDec(4200), DefaultExponentAtPriceOne)
+			s.Require().NoError(err)
+			s.SetupPosition(pool.GetId(), s.TestAccs[2], DefaultCoin0, DefaultCoin1, lowerTick.Int64(), DefaultUpperTick, s.Ctx.BlockTime())
+
+			tokenIn := sdk.NewCoin(ETH, sdk.NewInt(10_000_000))
+			s.FundAcc(s.TestAccs[0], sdk.NewCoins(tokenIn))
+
+			poolBeforeSwap, err := clKeeper.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			balanceBeforeSwap := s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[0], ETH)
+
+			_, _, _, _, _, err = clKeeper.SwapOutAmtGivenIn(s.Ctx, s.TestAccs[0], poolBeforeSwap, tokenIn, USDC, sdk.ZeroDec(), sdk.NewDec(4000))
+
+			poolAfterSwap, getErr := clKeeper.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(getErr)
+
+			if tc.expectedErr != nil {
+				s.Require().ErrorIs(err, tc.expectedErr)
+
+				// The whole swap is reverted.
+				s.Require().Equal(poolBeforeSwap.GetCurrentTick(), poolAfterSwap.GetCurrentTick())
+				s.Require().Equal(poolBeforeSwap.GetLiquidity(), poolAfterSwap.GetLiquidity())
+				s.Require().Equal(balanceBeforeSwap, s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[0], ETH))
+				return
+			}
+
+			s.Require().NoError(err)
+			s.Require().True(poolAfterSwap.GetCurrentTick().LT(lowerTick))
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSimulateSwapExactAmountIn() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	swapFee := sdk.MustNewDecFromStr("0.003")
+
+	pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, DefaultTickSpacing, DefaultExponentAtPriceOne, swapFee)
+	s.SetupDefaultPosition(pool.GetId())
+	poolBeforeSwap, err := clKeeper.GetPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+
+	tokenIn := sdk.NewCoin(ETH, sdk.NewInt(10_000))
+	amountOut, feeCharged, amountInAfterFee, err := clKeeper.SimulateSwapExactAmountIn(s.Ctx, pool.GetId(), tokenIn, USDC)
+	s.Require().NoError(err)
+
+	// The fee is split out of the amount in rather than folded into the amount out.
+	s.Require().Equal(sdk.NewCoin(ETH, tokenIn.Amount.ToDec().Mul(swapFee).RoundInt()), feeCharged)
+	s.Require().Equal(tokenIn, feeCharged.Add(amountInAfterFee))
+
+	expectedAmountOut, err := clKeeper.CalcOutAmtGivenIn(s.Ctx, poolBeforeSwap, tokenIn, USDC, swapFee)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedAmountOut, amountOut)
+
+	// The simulation does not change state.
+	poolAfterSwap, err := clKeeper.GetPoolById(s.Ctx, pool.GetId())
+	s.Require().NoError(err)
+	s.Require().Equal(poolBeforeSwap.GetCurrentSqrtPrice(), poolAfterSwap.GetCurrentSqrtPrice())
+	s.Require().Equal(poolBeforeSwap.GetCurrentTick(), poolAfterSwap.GetCurrentTick())
+
+	// The querier returns the same amounts.
+	querier := cl.NewQuerier(*clKeeper)
+	res, err := querier.SimulateSwapExactAmountIn(sdk.WrapSDKContext(s.Ctx), &query.QuerySimulateSwapExactAmountInRequest{
+		PoolId:        pool.GetId(),
+		TokenIn:       tokenIn,
+		TokenOutDenom: USDC,
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(amountOut, res.AmountOut)
+	s.Require().Equal(feeCharged, res.FeeCharged)
+	s.Require().Equal(amountInAfterFee, res.AmountInAfterFee)
+
+	_, _, _, err = clKeeper.SimulateSwapExactAmountIn(s.Ctx, pool.GetId()+1, tokenIn, USDC)
+	s.Require().ErrorIs(err, types.PoolNotFoundError{PoolId: pool.GetId() + 1})
+}
+
 func (s *KeeperTestSuite) TestCalcAndSwapInAmtGivenOut() {
 
 	tests := make(map[string]SwapTest, len(swapInGivenOutTestCases)+len(swapInGivenOutFeeTestCases)+len(swapInGivenOutErrorTestCases))
@@ -1918,6 +2024,215 @@ func (s *KeeperTestSuite) TestSwapInAmtGivenOut_TickUpdates() {
 	}
 }
 
+// TestSwapExactAmountInWithPriceLimit tests that the swap stops at the price limit, only taking the consumed
+// token in from the sender, and behaves like an unlimited swap when the limit is not reached.
+func (s *KeeperTestSuite) TestSwapExactAmountInWithPriceLimit() {
+	tokenIn := sdk.NewCoin(USDC, sdk.NewInt(42000000))
+
+	tests := []struct {
+		name              string
+		tokenIn           sdk.Coin
+		tokenOutDenom     string
+		priceLimit        sdk.Dec
+		expectPartialFill bool
+		expectedTokenOut  sdk.Int
+		expectedErr       error
+	}{
+		{
+			// The full swap moves the spot price from 5000 to ~5003.91, so the limit is reached first.
+			name:              "limit reached: partial fill",
+			tokenIn:           tokenIn,
+			tokenOutDenom:     ETH,
+			priceLimit:        sdk.NewDec(5002),
+			expectPartialFill: true,
+		},
+		{
+			// Matches the unlimited usdc > eth swap in TestSwapExactAmountIn.
+			name:             "limit not reached: full fill",
+			tokenIn:          tokenIn,
+			tokenOutDenom:    ETH,
+			priceLimit:       sdk.NewDec(5005),
+			expectedTokenOut: sdk.NewInt(8396),
+		},
+		{
+			name:          "limit on the wrong side of the current price",
+			tokenIn:       tokenIn,
+			tokenOutDenom: ETH,
+			priceLimit:    sdk.NewDec(4999),
+			expectedErr:   &types.InvalidPriceLimitError{},
+		},
+		{
+			name:          "zero price limit",
+			tokenIn:       tokenIn,
+			tokenOutDenom: ETH,
+			priceLimit:    sdk.ZeroDec(),
+			expectedErr:   &types.NonPositivePriceLimitError{},
+		},
+		{
+			name:          "in and out denom are same",
+			tokenIn:       tokenIn,
+			tokenOutDenom: USDC,
+			priceLimit:    sdk.NewDec(5002),
+			expectedErr:   &types.DenomDuplicatedError{},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.Setup()
+			pool := s.PrepareConcentratedPool()
+			s.SetupDefaultPosition(pool.GetId())
+			// Swap from an account without leftover funds from setting up the position.
+			s.FundAcc(s.TestAccs[1], sdk.NewCoins(test.tokenIn))
+
+			pool, err := s.App.ConcentratedLiquidityKeeper.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+
+			tokenInAmount, tokenOutAmount, err := s.App.ConcentratedLiquidityKeeper.SwapExactAmountInWithPriceLimit(s.Ctx, s.TestAccs[1], pool.(poolmanagertypes.PoolI), test.tokenIn, test.tokenOutDenom, sdk.ZeroInt(), test.priceLimit, DefaultZeroSwapFee)
+			if test.expectedErr != nil {
+				s.Require().ErrorAs(err, test.expectedErr)
+				return
+			}
+			s.Require().NoError(err)
+
+			pool, err = s.App.ConcentratedLiquidityKeeper.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+
+			if test.expectPartialFill {
+				// The swap halts exactly at the limit and only part of the token in is consumed.
+				expectedSqrtPrice, err := test.priceLimit.ApproxSqrt()
+				s.Require().NoError(err)
+				s.Require().Equal(expectedSqrtPrice, pool.GetCurrentSqrtPrice())
+				s.Require().True(tokenInAmount.LT(test.tokenIn.Amount))
+				s.Require().True(tokenOutAmount.IsPositive())
+			} else {
+				s.Require().Equal(test.tokenIn.Amount, tokenInAmount)
+				s.Require().Equal(test.expectedTokenOut, tokenOutAmount)
+			}
+
+			// Only the consumed token in is taken from the sender.
+			s.Require().Equal(test.tokenIn.Amount.Sub(tokenInAmount), s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[1], USDC).Amount)
+			s.Require().Equal(tokenOutAmount, s.App.BankKeeper.GetBalance(s.Ctx, s.TestAccs[1], ETH).Amount)
+		})
+	}
+}
+
+// TestLiquidityToReachPrice tests that the estimated amount in moves the pool's price exactly to the target price
+// when swapped in with the target as the price limit, and that the initialized ticks on the way are reported.
+func (s *KeeperTestSuite) TestLiquidityToReachPrice() {
+	tests := []struct {
+		name                 string
+		initializePool       bool
+		poolId               uint64
+		targetPrice          sdk.Dec
+		expectedDenom        string
+		expectedCrossedTicks []int64
+		expectedErr          error
+	}{
+		{
+			name:                 "target below current price within the default range",
+			initializePool:       true,
+			poolId:               validPoolId,
+			targetPrice:          sdk.NewDec(4800),
+			expectedDenom:        ETH,
+			expectedCrossedTicks: []int64{},
+		},
+		{
+			name:                 "target below the default range crosses its lower tick",
+			initializePool:       true,
+			poolId:               validPoolId,
+			targetPrice:          sdk.NewDec(4000),
+			expectedDenom:        ETH,
+			expectedCrossedTicks: []int64{DefaultLowerTick},
+		},
+		{
+			name:                 "target above the default range crosses its upper tick",
+			initializePool:       true,
+			poolId:               validPoolId,
+			targetPrice:          sdk.NewDec(6000),
+			expectedDenom:        USDC,
+			expectedCrossedTicks: []int64{DefaultUpperTick},
+		},
+		{
+			name:                 "target at the current price",
+			initializePool:       true,
+			poolId:               validPoolId,
+			targetPrice:          DefaultCurrPrice,
+			expectedDenom:        USDC,
+			expectedCrossedTicks: []int64{},
+		},
+		{
+			name:           "target price out of bounds",
+			initializePool: true,
+			poolId:         validPoolId,
+			targetPrice:    sdk.ZeroDec(),
+			expectedErr:    types.PriceBoundError{ProvidedPrice: sdk.ZeroDec(), MinSpotPrice: types.MinSpotPrice, MaxSpotPrice: types.MaxSpotPrice},
+		},
+		{
+			name:        "pool has no price yet",
+			poolId:      validPoolId,
+			targetPrice: sdk.NewDec(4800),
+			expectedErr: types.PoolNotInitializedError{PoolId: validPoolId},
+		},
+		{
+			name:           "pool does not exist",
+			initializePool: true,
+			poolId:         validPoolId + 1,
+			targetPrice:    sdk.NewDec(4800),
+			expectedErr:    types.PoolNotFoundError{PoolId: validPoolId + 1},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.Setup()
+			pool := s.PrepareConcentratedPool()
+			if test.initializePool {
+				s.SetupDefaultPosition(pool.GetId())
+				s.SetupFullRangePositionAcc(pool.GetId(), s.TestAccs[1])
+			}
+			pool, err := s.App.ConcentratedLiquidityKeeper.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			sqrtPriceBefore := pool.GetCurrentSqrtPrice()
+
+			tokenIn, crossedTicks, err := s.App.ConcentratedLiquidityKeeper.LiquidityToReachPrice(s.Ctx, test.poolId, test.targetPrice)
+			if test.expectedErr != nil {
+				s.Require().ErrorContains(err, test.expectedErr.Error())
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(test.expectedDenom, tokenIn.Denom)
+			s.Require().Equal(test.expectedCrossedTicks, crossedTicks)
+
+			// The estimate leaves the pool untouched.
+			pool, err = s.App.ConcentratedLiquidityKeeper.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			s.Require().Equal(sqrtPriceBefore, pool.GetCurrentSqrtPrice())
+
+			if tokenIn.IsZero() {
+				return
+			}
+
+			// Swapping the estimate in with the target as the price limit moves the price exactly to the target,
+			// consuming the estimate up to rounding.
+			tokenOutDenom := USDC
+			if tokenIn.Denom == USDC {
+				tokenOutDenom = ETH
+			}
+			s.FundAcc(s.TestAccs[2], sdk.NewCoins(tokenIn))
+			tokenInAmount, _, err := s.App.ConcentratedLiquidityKeeper.SwapExactAmountInWithPriceLimit(s.Ctx, s.TestAccs[2], pool.(poolmanagertypes.PoolI), tokenIn, tokenOutDenom, sdk.ZeroInt(), test.targetPrice, pool.GetSwapFee(s.Ctx))
+			s.Require().NoError(err)
+			s.Require().True(tokenIn.Amount.Sub(tokenInAmount).LTE(sdk.OneInt()))
+
+			pool, err = s.App.ConcentratedLiquidityKeeper.GetPoolById(s.Ctx, pool.GetId())
+			s.Require().NoError(err)
+			expectedSqrtPrice, err := test.targetPrice.ApproxSqrt()
+			s.Require().NoError(err)
+			s.Require().Equal(expectedSqrtPrice, pool.GetCurrentSqrtPrice())
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestSwapExactAmountIn() {
 	type param struct {
 		tokenIn           sdk.Coin
@@ -2070,6 +2385,7 @@ func (s *KeeperTestSuite) TestSwapExactAmountIn() {
 
 				// Assert events
 				s.AssertEventEmitted(s.Ctx, cltypes.TypeEvtTokenSwapped, 1)
+				s.AssertEventEmitted(s.Ctx, cltypes.TypeEvtSwapTickCrossings, 1)
 
 				// Retrieve pool again post swap
 				pool, err = s.App.ConcentratedLiquidityKeeper.GetPoolById(s.Ctx, pool.GetId())
@@ -2241,6 +2557,7 @@ func (s *KeeperTestSuite) TestSwapExactAmountOut() {
 
 				// Assert events
 				s.AssertEventEmitted(s.Ctx, cltypes.TypeEvtTokenSwapped, 1)
+				s.AssertEventEmitted(s.Ctx, cltypes.TypeEvtSwapTickCrossings, 1)
 
 				// Retrieve pool again post swap
 				pool, err = s.App.ConcentratedLiquidityKeeper.GetPoolById(s.Ctx, pool.GetId())
@@ -2332,6 +2649,9 @@ func (s *KeeperTestSuite) TestCalcOutAmtGivenInWriteCtx() {
 				),
 			)
 
+			// the tick crossings event is only emitted once the calculation is written
+			s.AssertEventEmitted(s.Ctx, cltypes.TypeEvtSwapTickCrossings, 0)
+
 			// System under test
 			writeCtx()
 
@@ -2347,6 +2667,8 @@ func (s *KeeperTestSuite) TestCalcOutAmtGivenInWriteCtx() {
 					osmomath.BigDecFromSDKDec(feeAccum.GetValue().AmountOf(test.tokenIn.Denom)),
 				),
 			)
+
+			s.AssertEventEmitted(s.Ctx, cltypes.TypeEvtSwapTickCrossings, 1)
 		})
 	}
 }
@@ -2494,17 +2816,20 @@ func (suite *KeeperTestSuite) TestUpdateFeeGrowthGlobal() {
 		liquidity               sdk.Dec
 		feeChargeTotal          sdk.Dec
 		expectedFeeGrowthGlobal sdk.Dec
+		expectedFeesCollected   sdk.Dec
 	}{
 		"zero liquidity -> no-op": {
 			liquidity:               sdk.ZeroDec(),
 			feeChargeTotal:          ten,
 			expectedFeeGrowthGlobal: sdk.ZeroDec(),
+			expectedFeesCollected:   sdk.ZeroDec(),
 		},
 		"non-zero liquidity -> updated": {
 			liquidity:      ten,
 			feeChargeTotal: ten,
 			// 10 / 10 = 1
 			expectedFeeGrowthGlobal: sdk.OneDec(),
+			expectedFeesCollected:   ten,
 		},
 	}
 
@@ -2517,12 +2842,14 @@ func (suite *KeeperTestSuite) TestUpdateFeeGrowthGlobal() {
 			swapState := cl.SwapState{}
 			swapState.SetLiquidity(tc.liquidity)
 			swapState.SetFeeGrowthGlobal(sdk.ZeroDec())
+			swapState.SetFeesCollected(sdk.ZeroDec())
 
 			// System under test.
 			swapState.UpdateFeeGrowthGlobal(tc.feeChargeTotal)
 
 			// Assertion.
 			suite.Require().Equal(tc.expectedFeeGrowthGlobal, swapState.GetFeeGrowthGlobal())
+			suite.Require().Equal(tc.expectedFeesCollected, swapState.GetFeesCollected())
 		})
 	}
 }
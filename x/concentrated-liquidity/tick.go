@@ -1,7 +1,11 @@
 package concentrated_liquidity
 
 import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/internal/math"
@@ -144,10 +148,114 @@ func (k Keeper) SetTickInfo(ctx sdk.Context, poolId uint64, tickIndex int64, tic
 	osmoutils.MustSet(store, key, &tickInfo)
 }
 
+// removeTickIfEmpty deletes the given tick from state once no position references it anymore, that is once its
+// gross liquidity is zero, rather than leaving a zero liquidity record behind. Ticks that are not initialized are
+// left untouched.
+// Must only be called after the fees and incentives of the positions that referenced the tick have been collected,
+// since they are computed from the tick's growth outside trackers.
+func (k Keeper) removeTickIfEmpty(ctx sdk.Context, poolId uint64, tickIndex int64) error {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyTick(poolId, tickIndex)
+
+	tickInfo := model.TickInfo{}
+	found, err := osmoutils.Get(store, key, &tickInfo)
+	if err != nil {
+		return err
+	}
+
+	if found && tickInfo.LiquidityGross.IsZero() {
+		store.Delete(key)
+	}
+	return nil
+}
+
+// PruneEmptyTicks deletes every initialized tick of the given pool whose gross liquidity is zero. Such ticks are no
+// longer referenced by any position and could be left behind by withdrawals made before empty ticks were removed.
+// Returns the number of ticks deleted.
+// Returns error if fails to read the pool's ticks.
+func (k Keeper) PruneEmptyTicks(ctx sdk.Context, poolId uint64) (uint64, error) {
+	ticks, err := k.GetAllInitializedTicksForPool(ctx, poolId)
+	if err != nil {
+		return 0, err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	numPruned := uint64(0)
+	for _, tick := range ticks {
+		if tick.Info.LiquidityGross.IsZero() {
+			store.Delete(types.KeyTick(poolId, tick.TickIndex))
+			numPruned++
+		}
+	}
+	return numPruned, nil
+}
+
+// pruneEmptyTicksAsAuthority prunes the empty ticks of the pool with the given id, as PruneEmptyTicks does.
+// Only the tick spacing authority set by governance may prune ticks.
+// Returns the number of ticks deleted.
+// Returns error if the sender is not the tick spacing authority or if the pool does not exist.
+func (k Keeper) pruneEmptyTicksAsAuthority(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) (uint64, error) {
+	params := k.GetParams(ctx)
+	if params.TickSpacingAuthority == "" {
+		return 0, types.TickPruningDisabledError{}
+	}
+	if sender.String() != params.TickSpacingAuthority {
+		return 0, types.NotTickSpacingAuthorityError{Sender: sender.String(), Authority: params.TickSpacingAuthority}
+	}
+
+	if _, err := k.getPoolById(ctx, poolId); err != nil {
+		return 0, err
+	}
+
+	numPruned, err := k.PruneEmptyTicks(ctx, poolId)
+	if err != nil {
+		return 0, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtPruneEmptyTicks,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeNumPrunedTicks, strconv.FormatUint(numPruned, 10)),
+	))
+
+	return numPruned, nil
+}
+
 func (k Keeper) GetAllInitializedTicksForPool(ctx sdk.Context, poolId uint64) ([]genesis.FullTick, error) {
 	return osmoutils.GatherValuesFromStorePrefixWithKeyParser(ctx.KVStore(k.storeKey), types.KeyTickPrefixByPoolId(poolId), ParseFullTickFromBytes)
 }
 
+// getAllTicksPaginated returns a page of the initialized ticks of the given pool with their full tick info,
+// ordered by ascending tick index.
+// Returns PoolNotFoundError if the pool does not exist.
+func (k Keeper) getAllTicksPaginated(ctx sdk.Context, poolId uint64, pageReq *sdkquery.PageRequest) ([]genesis.FullTick, *sdkquery.PageResponse, error) {
+	if !k.poolExists(ctx, poolId) {
+		return nil, nil, types.PoolNotFoundError{PoolId: poolId}
+	}
+
+	tickPrefix := types.KeyTickPrefixByPoolId(poolId)
+	tickStore := prefix.NewStore(ctx.KVStore(k.storeKey), tickPrefix)
+
+	ticks := []genesis.FullTick{}
+	pageRes, err := sdkquery.Paginate(tickStore, pageReq, func(key, value []byte) error {
+		// The prefix store strips the pool's tick prefix, which the parser expects.
+		fullKey := append(append([]byte{}, tickPrefix...), key...)
+		tick, err := ParseFullTickFromBytes(fullKey, value)
+		if err != nil {
+			return err
+		}
+		ticks = append(ticks, tick)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ticks, pageRes, nil
+}
+
 // validateTickInRangeIsValid validates that given ticks are valid.
 // That is, both lower and upper ticks are within MinTick and MaxTick range for the given exponentAtPriceOne.
 // Also, lower tick must be less than upper tick.
@@ -176,12 +284,150 @@ func validateTickRangeIsValid(tickSpacing uint64, exponentAtPriceOne sdk.Int, lo
 	return nil
 }
 
+// priceToAlignedTick converts the given price to its tick index for the given exponent at price one, and then
+// rounds the tick index to the nearest multiple of the tick spacing. Ties are rounded up.
+// Returns error if the price is outside of the allowed price range.
+func priceToAlignedTick(price sdk.Dec, tickSpacing uint64, exponentAtPriceOne sdk.Int) (int64, error) {
+	tickIndex, err := math.PriceToTick(price, exponentAtPriceOne)
+	if err != nil {
+		return 0, err
+	}
+
+	return alignTickToSpacing(tickIndex.Int64(), tickSpacing), nil
+}
+
+// alignTickToSpacing rounds the given tick index to the nearest multiple of the tick spacing. Ties are rounded up.
+func alignTickToSpacing(tick int64, tickSpacing uint64) int64 {
+	spacing := int64(tickSpacing)
+
+	// Go's remainder takes the sign of the dividend, so we normalize it to round negative ticks correctly.
+	remainder := ((tick % spacing) + spacing) % spacing
+	alignedTick := tick - remainder
+	if remainder*2 >= spacing {
+		alignedTick += spacing
+	}
+
+	return alignedTick
+}
+
+// ticksFromPrices converts the given lower and upper prices to the nearest ticks aligned with the tick spacing of the
+// given pool, as used to create a position by price rather than by tick index.
+// Returns error if the pool does not exist or if either price is outside of the allowed price range.
+func (k Keeper) ticksFromPrices(ctx sdk.Context, poolId uint64, lowerPrice, upperPrice sdk.Dec) (lowerTick int64, upperTick int64, err error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	lowerTick, err = priceToAlignedTick(lowerPrice, pool.GetTickSpacing(), pool.GetExponentAtPriceOne())
+	if err != nil {
+		return 0, 0, err
+	}
+
+	upperTick, err = priceToAlignedTick(upperPrice, pool.GetTickSpacing(), pool.GetExponentAtPriceOne())
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return lowerTick, upperTick, nil
+}
+
+// ticksFromCurrentTickOffsets computes the lower and upper ticks of a position from the given pool's current tick
+// plus the given offsets, each rounded to the nearest tick aligned with the pool's tick spacing. Since the
+// current tick is read at execution time, the resulting range is centered on the price at that time.
+// Returns error if the pool does not exist.
+func (k Keeper) ticksFromCurrentTickOffsets(ctx sdk.Context, poolId uint64, lowerTickOffset, upperTickOffset int64) (lowerTick int64, upperTick int64, err error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	currentTick := pool.GetCurrentTick().Int64()
+	lowerTick = alignTickToSpacing(currentTick+lowerTickOffset, pool.GetTickSpacing())
+	upperTick = alignTickToSpacing(currentTick+upperTickOffset, pool.GetTickSpacing())
+
+	return lowerTick, upperTick, nil
+}
+
 // GetMinAndMaxTicksFromExponentAtPriceOne determines min and max ticks allowed for a given exponentAtPriceOne value
 // This allows for a min spot price of 0.000000000000000001 and a max spot price of 100000000000000000000000000000000000000 for every exponentAtPriceOne value
 func GetMinAndMaxTicksFromExponentAtPriceOne(exponentAtPriceOne sdk.Int) (minTick, maxTick int64) {
 	return math.GetMinAndMaxTicksFromExponentAtPriceOneInternal(exponentAtPriceOne)
 }
 
+// priceAtTick returns the sqrt price and the spot price at the given tick, computed with the exponent at price one
+// of the given pool. The pool does not need to currently be at the given tick.
+// Returns error if the pool does not exist or if the tick is outside of the pool's tick bounds.
+func (k Keeper) priceAtTick(ctx sdk.Context, poolId uint64, tickIndex int64) (sqrtPrice sdk.Dec, price sdk.Dec, err error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	price, err = math.TickToPrice(sdk.NewInt(tickIndex), pool.GetExponentAtPriceOne())
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	sqrtPrice, err = price.ApproxSqrt()
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	return sqrtPrice, price, nil
+}
+
+// sqrtPriceForTicks returns the sqrt prices at the lower and upper ticks of the given range, derived from the
+// exponent at price one of the pool with the given id.
+// Returns error if the pool does not exist, the lower tick is not below the upper tick or either tick is out of bounds.
+func (k Keeper) sqrtPriceForTicks(ctx sdk.Context, poolId uint64, lowerTick, upperTick int64) (sqrtPriceLowerTick, sqrtPriceUpperTick sdk.Dec, err error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	if lowerTick >= upperTick {
+		return sdk.Dec{}, sdk.Dec{}, types.InvalidLowerUpperTickError{LowerTick: lowerTick, UpperTick: upperTick}
+	}
+
+	return math.TicksToSqrtPrice(lowerTick, upperTick, pool.GetExponentAtPriceOne())
+}
+
+// liquidityWeightedTick returns the average of the pool's initialized ticks, weighted by each tick's
+// gross liquidity and rounded to the nearest tick, alongside the spot price at that tick.
+// Returns error if the pool does not exist or has no initialized ticks.
+func (k Keeper) liquidityWeightedTick(ctx sdk.Context, poolId uint64) (tick int64, price sdk.Dec, err error) {
+	if !k.poolExists(ctx, poolId) {
+		return 0, sdk.Dec{}, types.PoolNotFoundError{PoolId: poolId}
+	}
+
+	ticks, err := k.GetAllInitializedTicksForPool(ctx, poolId)
+	if err != nil {
+		return 0, sdk.Dec{}, err
+	}
+
+	weightedTickSum := sdk.ZeroDec()
+	totalLiquidity := sdk.ZeroDec()
+	for _, fullTick := range ticks {
+		liquidityGross := fullTick.Info.LiquidityGross
+		weightedTickSum = weightedTickSum.Add(sdk.NewDec(fullTick.TickIndex).Mul(liquidityGross))
+		totalLiquidity = totalLiquidity.Add(liquidityGross)
+	}
+
+	if !totalLiquidity.IsPositive() {
+		return 0, sdk.Dec{}, types.NoInitializedTicksError{PoolId: poolId}
+	}
+
+	tick = weightedTickSum.Quo(totalLiquidity).RoundInt64()
+
+	_, price, err = k.priceAtTick(ctx, poolId, tick)
+	if err != nil {
+		return 0, sdk.Dec{}, err
+	}
+
+	return tick, price, nil
+}
+
 // GetTickLiquidityForRangeInBatches returns an array of liquidity depth within the given range of lower tick and upper tick.
 func (k Keeper) GetTickLiquidityForRange(ctx sdk.Context, poolId uint64) ([]query.LiquidityDepthWithRange, error) {
 	// sanity check that pool exists and upper tick is greater than lower tick
@@ -375,6 +621,46 @@ func (k Keeper) GetTickLiquidityNetInDirection(ctx sdk.Context, poolId uint64, t
 	return liquidityDepths, nil
 }
 
+// NextInitializedTick returns the next tick with non-zero liquidity gross in the given direction
+// from startTick, alongside its liquidity net. The search follows the same semantics as swaps:
+// when zeroForOne is true, ticks at or below startTick are searched. Otherwise, ticks strictly
+// above startTick are searched.
+// Ticks whose liquidity gross has returned to zero after all of their positions were withdrawn
+// remain in state but are skipped.
+// Returns found false if there is no such tick.
+// Returns error if the pool does not exist.
+func (k Keeper) NextInitializedTick(ctx sdk.Context, poolId uint64, startTick int64, zeroForOne bool) (tickIndex int64, liquidityNet sdk.Dec, found bool, err error) {
+	if !k.poolExists(ctx, poolId) {
+		return 0, sdk.Dec{}, false, types.PoolNotFoundError{PoolId: poolId}
+	}
+
+	swapStrategy := swapstrategy.New(zeroForOne, sdk.ZeroDec(), k.storeKey, sdk.ZeroDec())
+
+	searchTick := startTick
+	for {
+		nextTick, ok := swapStrategy.NextInitializedTick(ctx, poolId, searchTick)
+		if !ok {
+			return 0, sdk.Dec{}, false, nil
+		}
+
+		tickInfo, err := k.getTickByTickIndex(ctx, poolId, nextTick)
+		if err != nil {
+			return 0, sdk.Dec{}, false, err
+		}
+
+		if !tickInfo.LiquidityGross.IsZero() {
+			return nextTick.Int64(), tickInfo.LiquidityNet, true, nil
+		}
+
+		// The zero for one search is inclusive of the search tick, so it must
+		// move past the uninitialized tick to avoid finding it again.
+		searchTick = nextTick.Int64()
+		if zeroForOne {
+			searchTick--
+		}
+	}
+}
+
 func (k Keeper) getTickByTickIndex(ctx sdk.Context, poolId uint64, tickIndex sdk.Int) (model.TickInfo, error) {
 	store := ctx.KVStore(k.storeKey)
 	keyTick := types.KeyTick(poolId, tickIndex.Int64())
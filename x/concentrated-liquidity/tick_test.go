@@ -4,6 +4,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkquery "github.com/cosmos/cosmos-sdk/types/query"
 
 	cl "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity"
 	"github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/internal/math"
@@ -1385,3 +1386,530 @@ func (s *KeeperTestSuite) TestGetAllInitializedTicksForPool() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestGetAllTicksPaginated() {
+	s.Setup()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	poolId := s.PrepareConcentratedPool().GetId()
+	poolTick := withPoolId(defaultTick, poolId)
+	for _, tick := range []genesis.FullTick{
+		withTickIndex(poolTick, 1000),
+		withTickIndex(poolTick, -200),
+		withTickIndex(poolTick, 0),
+		withLiquidityNetandTickIndex(poolTick, -999, sdk.NewDec(-5)),
+		withPoolId(withTickIndex(poolTick, 5), poolId+1), // tick of another pool.
+	} {
+		clKeeper.SetTickInfo(s.Ctx, tick.PoolId, tick.TickIndex, tick.Info)
+	}
+
+	// The first page is ordered by ascending tick index and carries the full tick info.
+	ticks, pageRes, err := clKeeper.GetAllTicksPaginated(s.Ctx, poolId, &sdkquery.PageRequest{Limit: 2, CountTotal: true})
+	s.Require().NoError(err)
+	s.Require().Equal([]genesis.FullTick{
+		withLiquidityNetandTickIndex(poolTick, -999, sdk.NewDec(-5)),
+		withTickIndex(poolTick, -200),
+	}, ticks)
+	s.Require().Equal(uint64(4), pageRes.Total)
+	s.Require().NotNil(pageRes.NextKey)
+
+	// The next page resumes from the returned key.
+	ticks, pageRes, err = clKeeper.GetAllTicksPaginated(s.Ctx, poolId, &sdkquery.PageRequest{Key: pageRes.NextKey, Limit: 2})
+	s.Require().NoError(err)
+	s.Require().Equal([]genesis.FullTick{
+		withTickIndex(poolTick, 0),
+		withTickIndex(poolTick, 1000),
+	}, ticks)
+	s.Require().Nil(pageRes.NextKey)
+
+	// Non-existent pool.
+	_, _, err = clKeeper.GetAllTicksPaginated(s.Ctx, poolId+1, nil)
+	s.Require().ErrorIs(err, types.PoolNotFoundError{PoolId: poolId + 1})
+}
+
+func (s *KeeperTestSuite) TestPruneEmptyTicks() {
+	s.Setup()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+
+	poolId := s.PrepareConcentratedPool().GetId()
+	poolTick := withPoolId(defaultTick, poolId)
+	emptyTick := poolTick
+	emptyTick.Info.LiquidityGross = sdk.ZeroDec()
+	emptyTick.Info.LiquidityNet = sdk.ZeroDec()
+	otherPoolEmptyTick := withPoolId(withTickIndex(emptyTick, 5), poolId+1)
+	for _, tick := range []genesis.FullTick{
+		withTickIndex(poolTick, -200),
+		withTickIndex(emptyTick, -100),
+		withTickIndex(poolTick, 0),
+		withTickIndex(emptyTick, 1000),
+		otherPoolEmptyTick,
+	} {
+		clKeeper.SetTickInfo(s.Ctx, tick.PoolId, tick.TickIndex, tick.Info)
+	}
+
+	// Only the pool's ticks with zero gross liquidity are deleted.
+	numPruned, err := clKeeper.PruneEmptyTicks(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(2), numPruned)
+
+	ticks, err := clKeeper.GetAllInitializedTicksForPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal([]genesis.FullTick{
+		withTickIndex(poolTick, -200),
+		withTickIndex(poolTick, 0),
+	}, ticks)
+
+	otherPoolTicks, err := clKeeper.GetAllInitializedTicksForPool(s.Ctx, poolId+1)
+	s.Require().NoError(err)
+	s.Require().Equal([]genesis.FullTick{otherPoolEmptyTick}, otherPoolTicks)
+
+	// Pruning again is a no-op.
+	numPruned, err = clKeeper.PruneEmptyTicks(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(0), numPruned)
+}
+
+func (s *KeeperTestSuite) TestPruneEmptyTicksAsAuthority() {
+	s.Setup()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	authority := s.TestAccs[1]
+
+	poolId := s.PrepareConcentratedPool().GetId()
+	emptyTick := withPoolId(defaultTick, poolId)
+	emptyTick.Info.LiquidityGross = sdk.ZeroDec()
+	emptyTick.Info.LiquidityNet = sdk.ZeroDec()
+	clKeeper.SetTickInfo(s.Ctx, poolId, emptyTick.TickIndex, emptyTick.Info)
+
+	// Pruning is disabled until governance sets an authority.
+	_, err := clKeeper.PruneEmptyTicksAsAuthority(s.Ctx, authority, poolId)
+	s.Require().ErrorIs(err, types.TickPruningDisabledError{})
+
+	params := clKeeper.GetParams(s.Ctx)
+	params.TickSpacingAuthority = authority.String()
+	clKeeper.SetParams(s.Ctx, params)
+
+	// Only the authority may prune ticks.
+	_, err = clKeeper.PruneEmptyTicksAsAuthority(s.Ctx, s.TestAccs[2], poolId)
+	s.Require().ErrorIs(err, types.NotTickSpacingAuthorityError{Sender: s.TestAccs[2].String(), Authority: authority.String()})
+
+	// Non-existent pool.
+	_, err = clKeeper.PruneEmptyTicksAsAuthority(s.Ctx, authority, poolId+1)
+	s.Require().ErrorIs(err, types.PoolNotFoundError{PoolId: poolId + 1})
+
+	numPruned, err := clKeeper.PruneEmptyTicksAsAuthority(s.Ctx, authority, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(uint64(1), numPruned)
+	s.AssertEventEmitted(s.Ctx, types.TypeEvtPruneEmptyTicks, 1)
+
+	ticks, err := clKeeper.GetAllInitializedTicksForPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Empty(ticks)
+}
+
+func (s *KeeperTestSuite) TestPriceAtTick() {
+	_, maxTick := cl.GetMinAndMaxTicksFromExponentAtPriceOne(DefaultExponentAtPriceOne)
+
+	tests := []struct {
+		name          string
+		poolId        uint64
+		tickIndex     int64
+		expectedPrice sdk.Dec
+		expectedError error
+	}{
+		{
+			name:          "tick zero is price one",
+			poolId:        validPoolId,
+			tickIndex:     0,
+			expectedPrice: sdk.OneDec(),
+		},
+		{
+			name:          "positive tick",
+			poolId:        validPoolId,
+			tickIndex:     400000,
+			expectedPrice: sdk.NewDec(50000),
+		},
+		{
+			name:          "negative tick",
+			poolId:        validPoolId,
+			tickIndex:     -90000,
+			expectedPrice: sdk.MustNewDecFromStr("0.1"),
+		},
+		{
+			name:          "tick above max tick",
+			poolId:        validPoolId,
+			tickIndex:     maxTick + 1,
+			expectedError: types.TickIndexMaximumError{MaxTick: maxTick},
+		},
+		{
+			name:          "pool does not exist",
+			poolId:        validPoolId + 1,
+			tickIndex:     0,
+			expectedError: types.PoolNotFoundError{PoolId: validPoolId + 1},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			s.PrepareConcentratedPool()
+
+			sqrtPrice, price, err := s.App.ConcentratedLiquidityKeeper.PriceAtTick(s.Ctx, test.poolId, test.tickIndex)
+			if test.expectedError != nil {
+				s.Require().ErrorIs(err, test.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(test.expectedPrice, price)
+
+			expectedSqrtPrice, err := math.TickToSqrtPrice(sdk.NewInt(test.tickIndex), DefaultExponentAtPriceOne)
+			s.Require().NoError(err)
+			s.Require().Equal(expectedSqrtPrice, sqrtPrice)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestSqrtPriceForTicks() {
+	minTick, maxTick := cl.GetMinAndMaxTicksFromExponentAtPriceOne(DefaultExponentAtPriceOne)
+
+	tests := []struct {
+		name          string
+		poolId        uint64
+		lowerTick     int64
+		upperTick     int64
+		expectedError error
+	}{
+		{
+			name:      "default range",
+			poolId:    validPoolId,
+			lowerTick: DefaultLowerTick,
+			upperTick: DefaultUpperTick,
+		},
+		{
+			name:      "full range",
+			poolId:    validPoolId,
+			lowerTick: minTick,
+			upperTick: maxTick,
+		},
+		{
+			name:          "lower tick equal to upper tick",
+			poolId:        validPoolId,
+			lowerTick:     DefaultUpperTick,
+			upperTick:     DefaultUpperTick,
+			expectedError: types.InvalidLowerUpperTickError{LowerTick: DefaultUpperTick, UpperTick: DefaultUpperTick},
+		},
+		{
+			name:          "upper tick above max tick",
+			poolId:        validPoolId,
+			lowerTick:     DefaultLowerTick,
+			upperTick:     maxTick + 1,
+			expectedError: types.TickIndexMaximumError{MaxTick: maxTick},
+		},
+		{
+			name:          "pool does not exist",
+			poolId:        validPoolId + 1,
+			lowerTick:     DefaultLowerTick,
+			upperTick:     DefaultUpperTick,
+			expectedError: types.PoolNotFoundError{PoolId: validPoolId + 1},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			s.PrepareConcentratedPool()
+
+			sqrtPriceLowerTick, sqrtPriceUpperTick, err := s.App.ConcentratedLiquidityKeeper.SqrtPriceForTicks(s.Ctx, test.poolId, test.lowerTick, test.upperTick)
+			if test.expectedError != nil {
+				s.Require().ErrorIs(err, test.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+
+			expectedSqrtPriceLowerTick, expectedSqrtPriceUpperTick, err := math.TicksToSqrtPrice(test.lowerTick, test.upperTick, DefaultExponentAtPriceOne)
+			s.Require().NoError(err)
+			s.Require().Equal(expectedSqrtPriceLowerTick, sqrtPriceLowerTick)
+			s.Require().Equal(expectedSqrtPriceUpperTick, sqrtPriceUpperTick)
+
+			// The querier returns the same sqrt prices.
+			querier := cl.NewQuerier(*s.App.ConcentratedLiquidityKeeper)
+			res, err := querier.SqrtPriceForTicks(sdk.WrapSDKContext(s.Ctx), &query.QuerySqrtPriceForTicksRequest{PoolId: test.poolId, LowerTick: test.lowerTick, UpperTick: test.upperTick})
+			s.Require().NoError(err)
+			s.Require().Equal(sqrtPriceLowerTick, res.SqrtPriceLowerTick)
+			s.Require().Equal(sqrtPriceUpperTick, res.SqrtPriceUpperTick)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestPriceToAlignedTick() {
+	tests := []struct {
+		name          string
+		price         sdk.Dec
+		tickSpacing   uint64
+		expectedTick  int64
+		expectedError error
+	}{
+		{
+			name:         "price one is tick zero",
+			price:        sdk.OneDec(),
+			tickSpacing:  DefaultTickSpacing,
+			expectedTick: 0,
+		},
+		{
+			name:         "exact positive tick",
+			price:        sdk.NewDec(50000),
+			tickSpacing:  DefaultTickSpacing,
+			expectedTick: 400000,
+		},
+		{
+			name:         "exact negative tick",
+			price:        sdk.MustNewDecFromStr("0.1"),
+			tickSpacing:  DefaultTickSpacing,
+			expectedTick: -90000,
+		},
+		{
+			name:         "positive tick rounds down to spacing",
+			price:        sdk.MustNewDecFromStr("1.0004"),
+			tickSpacing:  10,
+			expectedTick: 0,
+		},
+		{
+			name:         "positive tick halfway between spacing rounds up",
+			price:        sdk.MustNewDecFromStr("1.0005"),
+			tickSpacing:  10,
+			expectedTick: 10,
+		},
+		{
+			name:         "negative tick halfway between spacing rounds up",
+			price:        sdk.MustNewDecFromStr("0.99995"),
+			tickSpacing:  10,
+			expectedTick: 0,
+		},
+		{
+			name:         "negative tick rounds down to spacing",
+			price:        sdk.MustNewDecFromStr("0.99994"),
+			tickSpacing:  10,
+			expectedTick: -10,
+		},
+		{
+			name:          "price above max spot price",
+			price:         types.MaxSpotPrice.Add(sdk.OneDec()),
+			tickSpacing:   DefaultTickSpacing,
+			expectedError: types.PriceBoundError{ProvidedPrice: types.MaxSpotPrice.Add(sdk.OneDec()), MinSpotPrice: types.MinSpotPrice, MaxSpotPrice: types.MaxSpotPrice},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			tick, err := cl.PriceToAlignedTick(test.price, test.tickSpacing, DefaultExponentAtPriceOne)
+			if test.expectedError != nil {
+				s.Require().ErrorContains(err, test.expectedError.Error())
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(test.expectedTick, tick)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestTicksFromPrices() {
+	s.SetupTest()
+	pool := s.PrepareConcentratedPool()
+
+	lowerTick, upperTick, err := s.App.ConcentratedLiquidityKeeper.TicksFromPrices(s.Ctx, pool.GetId(), sdk.MustNewDecFromStr("0.1"), sdk.NewDec(50000))
+	s.Require().NoError(err)
+	s.Require().Equal(int64(-90000), lowerTick)
+	s.Require().Equal(int64(400000), upperTick)
+
+	_, _, err = s.App.ConcentratedLiquidityKeeper.TicksFromPrices(s.Ctx, pool.GetId()+1, sdk.MustNewDecFromStr("0.1"), sdk.NewDec(50000))
+	s.Require().ErrorIs(err, types.PoolNotFoundError{PoolId: pool.GetId() + 1})
+}
+
+func (s *KeeperTestSuite) TestTicksFromCurrentTickOffsets() {
+	tests := []struct {
+		name              string
+		lowerTickOffset   int64
+		upperTickOffset   int64
+		expectedLowerTick int64
+		expectedUpperTick int64
+	}{
+		{
+			name:              "offsets aligned with spacing",
+			lowerTickOffset:   -1000,
+			upperTickOffset:   1000,
+			expectedLowerTick: DefaultCurrTick.Int64() - 1000,
+			expectedUpperTick: DefaultCurrTick.Int64() + 1000,
+		},
+		{
+			name:              "offsets rounded to nearest spacing",
+			lowerTickOffset:   -1004,
+			upperTickOffset:   1005,
+			expectedLowerTick: DefaultCurrTick.Int64() - 1000,
+			expectedUpperTick: DefaultCurrTick.Int64() + 1010,
+		},
+		{
+			name:              "range entirely above current tick",
+			lowerTickOffset:   10,
+			upperTickOffset:   20,
+			expectedLowerTick: DefaultCurrTick.Int64() + 10,
+			expectedUpperTick: DefaultCurrTick.Int64() + 20,
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			pool := s.PrepareCustomConcentratedPool(s.TestAccs[0], ETH, USDC, 10, DefaultExponentAtPriceOne, sdk.ZeroDec())
+			s.SetupDefaultPosition(pool.GetId())
+
+			lowerTick, upperTick, err := s.App.ConcentratedLiquidityKeeper.TicksFromCurrentTickOffsets(s.Ctx, pool.GetId(), test.lowerTickOffset, test.upperTickOffset)
+			s.Require().NoError(err)
+			s.Require().Equal(test.expectedLowerTick, lowerTick)
+			s.Require().Equal(test.expectedUpperTick, upperTick)
+		})
+	}
+
+	_, _, err := s.App.ConcentratedLiquidityKeeper.TicksFromCurrentTickOffsets(s.Ctx, 100, -1000, 1000)
+	s.Require().ErrorIs(err, types.PoolNotFoundError{PoolId: 100})
+}
+
+func (s *KeeperTestSuite) TestNextInitializedTick() {
+	tests := []struct {
+		name                 string
+		poolId               uint64
+		startTick            int64
+		zeroForOne           bool
+		expectedTick         int64
+		expectedLiquidityNet sdk.Dec
+		expectedFound        bool
+		expectedError        error
+	}{
+		{
+			name:                 "zero for one - finds tick below",
+			poolId:               validPoolId,
+			startTick:            200,
+			zeroForOne:           true,
+			expectedTick:         100,
+			expectedLiquidityNet: sdk.NewDec(-10),
+			expectedFound:        true,
+		},
+		{
+			name:                 "zero for one - start tick is included",
+			poolId:               validPoolId,
+			startTick:            100,
+			zeroForOne:           true,
+			expectedTick:         100,
+			expectedLiquidityNet: sdk.NewDec(-10),
+			expectedFound:        true,
+		},
+		{
+			name:                 "zero for one - skips tick with zero liquidity gross",
+			poolId:               validPoolId,
+			startTick:            99,
+			zeroForOne:           true,
+			expectedTick:         -100,
+			expectedLiquidityNet: sdk.NewDec(10),
+			expectedFound:        true,
+		},
+		{
+			name:       "zero for one - no tick below",
+			poolId:     validPoolId,
+			startTick:  -101,
+			zeroForOne: true,
+		},
+		{
+			name:                 "one for zero - skips tick with zero liquidity gross",
+			poolId:               validPoolId,
+			startTick:            -100,
+			expectedTick:         100,
+			expectedLiquidityNet: sdk.NewDec(-10),
+			expectedFound:        true,
+		},
+		{
+			name:      "one for zero - start tick is excluded",
+			poolId:    validPoolId,
+			startTick: 100,
+		},
+		{
+			name:          "pool does not exist",
+			poolId:        validPoolId + 1,
+			expectedError: types.PoolNotFoundError{PoolId: validPoolId + 1},
+		},
+	}
+
+	for _, test := range tests {
+		s.Run(test.name, func() {
+			s.SetupTest()
+			s.PrepareConcentratedPool()
+
+			clKeeper := s.App.ConcentratedLiquidityKeeper
+			clKeeper.SetTickInfo(s.Ctx, validPoolId, -100, model.TickInfo{LiquidityGross: sdk.NewDec(10), LiquidityNet: sdk.NewDec(10)})
+			// A tick whose positions were all withdrawn remains in state with zero liquidity gross.
+			clKeeper.SetTickInfo(s.Ctx, validPoolId, 50, model.TickInfo{LiquidityGross: sdk.ZeroDec(), LiquidityNet: sdk.ZeroDec()})
+			clKeeper.SetTickInfo(s.Ctx, validPoolId, 100, model.TickInfo{LiquidityGross: sdk.NewDec(10), LiquidityNet: sdk.NewDec(-10)})
+
+			tickIndex, liquidityNet, found, err := clKeeper.NextInitializedTick(s.Ctx, test.poolId, test.startTick, test.zeroForOne)
+			if test.expectedError != nil {
+				s.Require().ErrorIs(err, test.expectedError)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(test.expectedFound, found)
+			if !test.expectedFound {
+				return
+			}
+			s.Require().Equal(test.expectedTick, tickIndex)
+			s.Require().Equal(test.expectedLiquidityNet, liquidityNet)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestLiquidityWeightedTick() {
+	s.SetupTest()
+	clKeeper := s.App.ConcentratedLiquidityKeeper
+	pool := s.PrepareConcentratedPool()
+	poolId := pool.GetId()
+
+	// Pool does not exist.
+	_, _, err := clKeeper.LiquidityWeightedTick(s.Ctx, poolId+1)
+	s.Require().ErrorIs(err, types.PoolNotFoundError{PoolId: poolId + 1})
+
+	// Pool without any initialized ticks.
+	_, _, err = clKeeper.LiquidityWeightedTick(s.Ctx, poolId)
+	s.Require().ErrorIs(err, types.NoInitializedTicksError{PoolId: poolId})
+
+	// A single position weighs its lower and upper ticks equally, so the result is their midpoint.
+	liquidityOne, _ := s.SetupPosition(poolId, s.TestAccs[0], DefaultCoin0, DefaultCoin1, DefaultLowerTick, DefaultUpperTick, s.Ctx.BlockTime())
+	tick, price, err := clKeeper.LiquidityWeightedTick(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal((DefaultLowerTick+DefaultUpperTick)/2, tick)
+
+	_, expectedPrice, err := clKeeper.PriceAtTick(s.Ctx, poolId, tick)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedPrice, price)
+
+	// A second position over a different range pulls the result towards its ticks in proportion to its liquidity.
+	lowerTickTwo, upperTickTwo := DefaultLowerTick-10000, DefaultUpperTick-5000
+	liquidityTwo, _ := s.SetupPosition(poolId, s.TestAccs[1], DefaultCoin0, DefaultCoin1, lowerTickTwo, upperTickTwo, s.Ctx.BlockTime())
+
+	expectedTick := liquidityOne.MulInt64(DefaultLowerTick + DefaultUpperTick).
+		Add(liquidityTwo.MulInt64(lowerTickTwo + upperTickTwo)).
+		Quo(liquidityOne.Add(liquidityTwo).MulInt64(2)).
+		RoundInt64()
+
+	tick, _, err = clKeeper.LiquidityWeightedTick(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedTick, tick)
+
+	// The querier returns the same tick alongside its spot price.
+	querier := cl.NewQuerier(*clKeeper)
+	res, err := querier.LiquidityWeightedTick(sdk.WrapSDKContext(s.Ctx), &query.QueryLiquidityWeightedTickRequest{PoolId: poolId})
+	s.Require().NoError(err)
+	s.Require().Equal(expectedTick, res.Tick)
+
+	_, expectedPrice, err = clKeeper.PriceAtTick(s.Ctx, poolId, expectedTick)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedPrice, res.SpotPrice)
+
+	_, err = querier.LiquidityWeightedTick(sdk.WrapSDKContext(s.Ctx), nil)
+	s.Require().Error(err)
+}
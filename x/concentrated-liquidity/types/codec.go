@@ -11,10 +11,19 @@ import (
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterInterface((*ConcentratedPoolExtension)(nil), nil)
 	cdc.RegisterConcrete(&MsgCreatePosition{}, "osmosis/cl-create-position", nil)
+	cdc.RegisterConcrete(&MsgCreatePositionByPrice{}, "osmosis/cl-create-position-by-price", nil)
+	cdc.RegisterConcrete(&MsgCreatePositionRelative{}, "osmosis/cl-create-position-relative", nil)
 	cdc.RegisterConcrete(&MsgWithdrawPosition{}, "osmosis/cl-withdraw-position", nil)
+	cdc.RegisterConcrete(&MsgWithdrawPositions{}, "osmosis/cl-withdraw-positions", nil)
+	cdc.RegisterConcrete(&MsgEmergencyWithdraw{}, "osmosis/cl-emergency-withdraw", nil)
 	cdc.RegisterConcrete(&MsgCollectFees{}, "osmosis/cl-collect-fees", nil)
 	cdc.RegisterConcrete(&MsgCollectIncentives{}, "osmosis/cl-collect-incentives", nil)
 	cdc.RegisterConcrete(&MsgCreateIncentive{}, "osmosis/cl-create-incentive", nil)
+	cdc.RegisterConcrete(&MsgWithdrawProtocolFees{}, "osmosis/cl-withdraw-protocol-fees", nil)
+	cdc.RegisterConcrete(&MsgUpdateTickSpacing{}, "osmosis/cl-update-tick-spacing", nil)
+	cdc.RegisterConcrete(&MsgPruneEmptyTicks{}, "osmosis/cl-prune-empty-ticks", nil)
+	cdc.RegisterConcrete(&MsgSwapExactAmountInWithPriceLimit{}, "osmosis/cl-swap-exact-amount-in-with-price-limit", nil)
+	cdc.RegisterConcrete(&MsgCreatePositionSingleAsset{}, "osmosis/cl-create-position-single-asset", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -26,10 +35,19 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgCreatePosition{},
+		&MsgCreatePositionByPrice{},
+		&MsgCreatePositionRelative{},
 		&MsgWithdrawPosition{},
+		&MsgWithdrawPositions{},
+		&MsgEmergencyWithdraw{},
 		&MsgCollectFees{},
 		&MsgCollectIncentives{},
 		&MsgCreateIncentive{},
+		&MsgWithdrawProtocolFees{},
+		&MsgUpdateTickSpacing{},
+		&MsgPruneEmptyTicks{},
+		&MsgSwapExactAmountInWithPriceLimit{},
+		&MsgCreatePositionSingleAsset{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
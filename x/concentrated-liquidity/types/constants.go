@@ -34,4 +34,20 @@ var (
 	SupportedUptimes          = []time.Duration{time.Nanosecond, time.Minute, time.Hour, time.Hour * 24, time.Hour * 24 * 7}
 	AuthorizedTickSpacing     = []uint64{1, 10, 60, 200}
 	BaseGasFeeForNewIncentive = 10_000
+	// By default, the first position in a pool is not required to create a minimum amount of liquidity.
+	DefaultMinInitialLiquidity = sdk.ZeroDec()
+	// By default, emergency withdrawals are disabled until enabled by governance.
+	DefaultEmergencyWithdrawEnabled = false
+	// By default, a single swap may cross up to 1000 initialized ticks, which is far more than normal swaps cross.
+	DefaultMaxTicksCrossedPerSwap = uint64(1000)
+	// By default, there is no protocol fee recipient, so protocol fees cannot be withdrawn until governance sets one.
+	DefaultProtocolFeeRecipient = ""
+	// By default, there is no tick spacing authority, so tick spacings cannot be changed until governance sets one.
+	DefaultTickSpacingAuthority = ""
+	// Fee revenue snapshots are kept for a week so that fee revenue can be queried over the last day or week.
+	FeeRevenueRetentionPeriod = time.Hour * 24 * 7
+	// Position APRs are projected over a year of 365 days.
+	AprProjectionPeriod = time.Hour * 24 * 365
+	// The swap split of a single asset deposit is re-estimated against the simulated post-swap price this many times.
+	SingleAssetDepositSwapRefinements = 3
 )
@@ -23,6 +23,24 @@ func (e InvalidLowerUpperTickError) Error() string {
 	return fmt.Sprintf("Lower tick must be lesser than upper. Got lower: %d, upper: %d", e.LowerTick, e.UpperTick)
 }
 
+type InvalidLowerUpperPriceError struct {
+	LowerPrice sdk.Dec
+	UpperPrice sdk.Dec
+}
+
+func (e InvalidLowerUpperPriceError) Error() string {
+	return fmt.Sprintf("Lower price must be positive and lesser than upper. Got lower: %s, upper: %s", e.LowerPrice, e.UpperPrice)
+}
+
+type InvalidLowerUpperTickOffsetError struct {
+	LowerTickOffset int64
+	UpperTickOffset int64
+}
+
+func (e InvalidLowerUpperTickOffsetError) Error() string {
+	return fmt.Sprintf("Lower tick offset must be lesser than upper. Got lower: %d, upper: %d", e.LowerTickOffset, e.UpperTickOffset)
+}
+
 type InvalidDirectionError struct {
 	PoolTick   int64
 	TargetTick int64
@@ -41,6 +59,12 @@ func (e NotPositiveRequireAmountError) Error() string {
 	return fmt.Sprintf("Required amount should be positive. Got: %s", e.Amount)
 }
 
+type SlippageProtectionDisabledError struct{}
+
+func (e SlippageProtectionDisabledError) Error() string {
+	return "strict slippage is set but both token minimum amounts are zero, which disables slippage protection; set a non-zero token_min_amount0 or token_min_amount1"
+}
+
 type PositionNotFoundError struct {
 	PoolId    uint64
 	LowerTick int64
@@ -60,6 +84,24 @@ func (e PositionIdNotFoundError) Error() string {
 	return fmt.Sprintf("position not found. position id (%d)", e.PositionId)
 }
 
+type PositionIdsNotFoundError struct {
+	PositionIds []uint64
+}
+
+func (e PositionIdsNotFoundError) Error() string {
+	return fmt.Sprintf("positions not found. position ids (%v)", e.PositionIds)
+}
+
+type PoolInsufficientBalanceError struct {
+	PoolId    uint64
+	Requested sdk.Coins
+	Balance   sdk.Coins
+}
+
+func (e PoolInsufficientBalanceError) Error() string {
+	return fmt.Sprintf("pool (%d) has insufficient balance to send (%s), balance (%s)", e.PoolId, e.Requested, e.Balance)
+}
+
 type PoolNotFoundError struct {
 	PoolId uint64
 }
@@ -106,6 +148,17 @@ func (e InsufficientLiquidityCreatedError) Error() string {
 	return fmt.Sprintf("insufficient amount of token %d created. Actual: (%s). Minimum (%s)", tokenNum, e.Actual, e.Minimum)
 }
 
+type DepositExceedsDesiredAmountError struct {
+	Amount0        sdk.Int
+	Amount1        sdk.Int
+	Amount0Desired sdk.Int
+	Amount1Desired sdk.Int
+}
+
+func (e DepositExceedsDesiredAmountError) Error() string {
+	return fmt.Sprintf("deposit of (%s) token 0 and (%s) token 1 exceeds the desired amounts (%s) and (%s)", e.Amount0, e.Amount1, e.Amount0Desired, e.Amount1Desired)
+}
+
 type NegativeLiquidityError struct {
 	Liquidity sdk.Dec
 }
@@ -196,6 +249,15 @@ func (e InitialLiquidityZeroError) Error() string {
 	return fmt.Sprintf("first position must contain non-zero value of both assets to determine spot price: Amount0 (%s) Amount1 (%s)", e.Amount0, e.Amount1)
 }
 
+type InitialLiquidityTooLowError struct {
+	Liquidity           sdk.Dec
+	MinInitialLiquidity sdk.Dec
+}
+
+func (e InitialLiquidityTooLowError) Error() string {
+	return fmt.Sprintf("first position must create at least the minimum initial liquidity (%s), got (%s)", e.MinInitialLiquidity, e.Liquidity)
+}
+
 type TickIndexMaximumError struct {
 	MaxTick int64
 }
@@ -274,6 +336,22 @@ func (e InvalidSwapFeeError) Error() string {
 	return fmt.Sprintf("invalid swap fee(%s), must be in [0, 1) range", e.ActualFee)
 }
 
+type InvalidEarlyExitFeeError struct {
+	ActualFee sdk.Dec
+}
+
+func (e InvalidEarlyExitFeeError) Error() string {
+	return fmt.Sprintf("invalid early exit fee(%s), must be in [0, 1) range", e.ActualFee)
+}
+
+type InvalidProtocolFeeFractionError struct {
+	ActualFraction sdk.Dec
+}
+
+func (e InvalidProtocolFeeFractionError) Error() string {
+	return fmt.Sprintf("invalid protocol fee fraction(%s), must be in [0, 1] range", e.ActualFraction)
+}
+
 type PositionAlreadyExistsError struct {
 	PoolId    uint64
 	LowerTick int64
@@ -482,3 +560,157 @@ type NegativeDurationError struct {
 func (e NegativeDurationError) Error() string {
 	return fmt.Sprintf("duration cannot be negative (%s)", e.Duration)
 }
+
+type NotPositionOwnerError struct {
+	PositionId uint64
+	Address    string
+}
+
+func (e NotPositionOwnerError) Error() string {
+	return fmt.Sprintf("address (%s) is not the owner of position ID (%d)", e.Address, e.PositionId)
+}
+
+type UnauthorizedPositionCreatorError struct {
+	Sender string
+	Owner  string
+}
+
+func (e UnauthorizedPositionCreatorError) Error() string {
+	return fmt.Sprintf("address (%s) is not authorized to create positions owned by (%s)", e.Sender, e.Owner)
+}
+
+type EmergencyWithdrawDisabledError struct{}
+
+func (e EmergencyWithdrawDisabledError) Error() string {
+	return "emergency withdrawals are disabled; they must be enabled by governance"
+}
+
+type FeeRevenueStartTimeTooOldError struct {
+	StartTime    time.Time
+	EarliestTime time.Time
+}
+
+func (e FeeRevenueStartTimeTooOldError) Error() string {
+	return fmt.Sprintf("fee revenue start time (%s) is before the earliest retained snapshot time (%s)", e.StartTime, e.EarliestTime)
+}
+
+type TooManyTicksCrossedError struct {
+	PoolId          uint64
+	MaxTicksCrossed uint64
+}
+
+func (e TooManyTicksCrossedError) Error() string {
+	return fmt.Sprintf("swap in pool (%d) would cross more than the maximum of (%d) initialized ticks", e.PoolId, e.MaxTicksCrossed)
+}
+
+type NoInitializedTicksError struct {
+	PoolId uint64
+}
+
+func (e NoInitializedTicksError) Error() string {
+	return fmt.Sprintf("pool (%d) has no initialized ticks", e.PoolId)
+}
+
+type InvalidJoinTimeRangeError struct {
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+func (e InvalidJoinTimeRangeError) Error() string {
+	return fmt.Sprintf("join time range end (%s) must not be before its start (%s)", e.EndTime, e.StartTime)
+}
+
+type ProtocolFeeWithdrawalDisabledError struct{}
+
+func (e ProtocolFeeWithdrawalDisabledError) Error() string {
+	return "protocol fee withdrawals are disabled; a protocol fee recipient must be set by governance"
+}
+
+type NotProtocolFeeRecipientError struct {
+	Sender    string
+	Recipient string
+}
+
+func (e NotProtocolFeeRecipientError) Error() string {
+	return fmt.Sprintf("sender (%s) is not the protocol fee recipient (%s)", e.Sender, e.Recipient)
+}
+
+type TickSpacingUpdateDisabledError struct{}
+
+func (e TickSpacingUpdateDisabledError) Error() string {
+	return "tick spacing updates are disabled; a tick spacing authority must be set by governance"
+}
+
+type TickPruningDisabledError struct{}
+
+func (e TickPruningDisabledError) Error() string {
+	return "empty tick pruning is disabled; a tick spacing authority must be set by governance"
+}
+
+type NotTickSpacingAuthorityError struct {
+	Sender    string
+	Authority string
+}
+
+func (e NotTickSpacingAuthorityError) Error() string {
+	return fmt.Sprintf("sender (%s) is not the tick spacing authority (%s)", e.Sender, e.Authority)
+}
+
+type UnauthorizedTickSpacingError struct {
+	TickSpacing uint64
+}
+
+func (e UnauthorizedTickSpacingError) Error() string {
+	return fmt.Sprintf("tick spacing (%d) is not one of the authorized tick spacings", e.TickSpacing)
+}
+
+type DenomNotInPoolError struct {
+	PoolId uint64
+	Denom  string
+}
+
+func (e DenomNotInPoolError) Error() string {
+	return fmt.Sprintf("denom (%s) does not match any asset in pool (%d)", e.Denom, e.PoolId)
+}
+
+type PoolNotInitializedError struct {
+	PoolId uint64
+}
+
+func (e PoolNotInitializedError) Error() string {
+	return fmt.Sprintf("pool (%d) has no price yet; it is set by the first position created", e.PoolId)
+}
+
+type DenomNotDepositedInRangeError struct {
+	Denom     string
+	LowerTick int64
+	UpperTick int64
+}
+
+func (e DenomNotDepositedInRangeError) Error() string {
+	return fmt.Sprintf("range from lower tick (%d) to upper tick (%d) does not hold any (%s) at the current price", e.LowerTick, e.UpperTick, e.Denom)
+}
+
+type NonPositivePriceLimitError struct {
+	PriceLimit sdk.Dec
+}
+
+func (e NonPositivePriceLimitError) Error() string {
+	return fmt.Sprintf("price limit must be positive, was (%s)", e.PriceLimit)
+}
+
+type PositionEntrySqrtPriceNotFoundError struct {
+	PositionId uint64
+}
+
+func (e PositionEntrySqrtPriceNotFoundError) Error() string {
+	return fmt.Sprintf("entry sqrt price not recorded for position id (%d); it is only recorded for positions created after it was introduced", e.PositionId)
+}
+
+type InvalidMaxSlippageError struct {
+	MaxSlippage sdk.Dec
+}
+
+func (e InvalidMaxSlippageError) Error() string {
+	return fmt.Sprintf("max slippage must be at least zero and less than one, was (%s)", e.MaxSlippage)
+}
@@ -3,11 +3,18 @@ package types
 const (
 	TypeEvtCreatePosition         = "create_position"
 	TypeEvtWithdrawPosition       = "withdraw_position"
+	TypeEvtEmergencyWithdraw      = "emergency_withdraw"
+	TypeEvtWithdrawProtocolFees   = "withdraw_protocol_fees"
+	TypeEvtUpdateTickSpacing      = "update_tick_spacing"
+	TypeEvtPruneEmptyTicks        = "prune_empty_ticks"
 	TypeEvtTotalCollectFees       = "total_collect_fees"
 	TypeEvtCollectFees            = "collect_fees"
 	TypeEvtTotalCollectIncentives = "total_collect_incentives"
 	TypeEvtCollectIncentives      = "collect_incentives"
+	TypeEvtForfeitIncentives      = "forfeit_incentives"
 	TypeEvtCreateIncentive        = "create_incentive"
+	TypeEvtSwapTickCrossings      = "swap_tick_crossings"
+	TypeEvtPoolInitialized        = "pool_initialized"
 
 	AttributeValueCategory         = ModuleName
 	AttributeKeyPositionId         = "position_id"
@@ -30,4 +37,18 @@ const (
 	AttributeIncentiveEmissionRate = "incentive_emission_rate"
 	AttributeIncentiveStartTime    = "incentive_start_time"
 	AttributeIncentiveMinUptime    = "incentive_min_uptime"
+	AttributeCrossedTicks          = "crossed_ticks"
+	AttributeSegmentLiquidity      = "segment_liquidity"
+	AttributeForfeitedFees         = "forfeited_fees"
+	AttributeForfeitedIncentives   = "forfeited_incentives"
+	AttributeEarlyExitFee0         = "early_exit_fee0"
+	AttributeEarlyExitFee1         = "early_exit_fee1"
+	AttributeFeesCollected         = "fees_collected"
+	AttributeIncentivesCollected   = "incentives_collected"
+	AttributeInitialSqrtPrice      = "initial_sqrt_price"
+	AttributeInitialTick           = "initial_tick"
+	AttributeOldTickSpacing        = "old_tick_spacing"
+	AttributeNewTickSpacing        = "new_tick_spacing"
+	AttributeNumPrunedTicks        = "num_pruned_ticks"
+	AttributeAccumRemovalError     = "accumulator_removal_error"
 )
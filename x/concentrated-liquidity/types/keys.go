@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/address"
@@ -31,6 +32,12 @@ var (
 	FeePositionAccumulatorPrefix = []byte{0x0A}
 	PoolFeeAccumulatorPrefix     = []byte{0x0B}
 	UptimeAccumulatorPrefix      = []byte{0x0C}
+	PositionRangePrefix          = []byte{0x0D}
+	FeeRevenueSnapshotPrefix     = []byte{0x0E}
+	DenomPairPoolPrefix          = []byte{0x0F}
+	PendingProtocolFeesPrefix    = []byte{0x10}
+	JoinTimePositionPrefix       = []byte{0x11}
+	PositionEntrySqrtPricePrefix = []byte{0x12}
 
 	// n.b. we negative prefix must be less than the positive prefix for proper iteration
 	TickNegativePrefix = []byte{0x05}
@@ -124,6 +131,13 @@ func KeyPositionId(positionId uint64) []byte {
 	return []byte(fmt.Sprintf("%s%s%d", PositionIdPrefix, KeySeparator, positionId))
 }
 
+// Position Entry Sqrt Price Prefix Keys
+// Used to map a position id to the pool's sqrt price when the position was created
+
+func KeyPositionEntrySqrtPrice(positionId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s%d", PositionEntrySqrtPricePrefix, KeySeparator, positionId))
+}
+
 // Position Prefix Keys
 
 func KeyAddressPoolIdPositionId(addr sdk.AccAddress, poolId uint64, positionId uint64) []byte {
@@ -138,15 +152,47 @@ func KeyUserPositions(addr sdk.AccAddress) []byte {
 	return []byte(fmt.Sprintf("%s%s%x", PositionPrefix, KeySeparator, addr.Bytes()))
 }
 
+// Position Range Prefix Keys
+// Used to map an (owner, pool id, lower tick, upper tick) tuple to position ids
+
+func KeyAddressPoolIdRangePositionId(addr sdk.AccAddress, poolId uint64, lowerTick, upperTick int64, positionId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", KeyAddressPoolIdRange(addr, poolId, lowerTick, upperTick), positionId))
+}
+
+// KeyAddressPoolIdRange returns the prefix under which all position ids of the given
+// owner, pool and tick range are stored. The trailing separator ensures that
+// upper tick 1 does not prefix-match upper tick 10.
+func KeyAddressPoolIdRange(addr sdk.AccAddress, poolId uint64, lowerTick, upperTick int64) []byte {
+	return []byte(fmt.Sprintf("%s%s%x%s%d%s%d%s%d%s", PositionRangePrefix, KeySeparator, addr.Bytes(), KeySeparator, poolId, KeySeparator, lowerTick, KeySeparator, upperTick, KeySeparator))
+}
+
 // Pool Position Prefix Keys
 // Used to map a pool id to a position id
 
 func KeyPoolPositionPositionId(poolId uint64, positionId uint64) []byte {
-	return []byte(fmt.Sprintf("%s%d%s%d", PoolPositionPrefix, poolId, KeySeparator, positionId))
+	return []byte(fmt.Sprintf("%s%d", KeyPoolPosition(poolId), positionId))
 }
 
+// KeyPoolPosition returns the prefix under which the ids of all positions in the given pool are stored.
+// The trailing separator ensures that pool 1 does not prefix-match pool 10.
 func KeyPoolPosition(poolId uint64) []byte {
-	return []byte(fmt.Sprintf("%s%d", PoolPositionPrefix, poolId))
+	return []byte(fmt.Sprintf("%s%d%s", PoolPositionPrefix, poolId, KeySeparator))
+}
+
+// Join Time Position Prefix Keys
+// Used to map a (join time, position id) pair to the position id, ordered by join time
+
+func KeyJoinTimePositionId(joinTime time.Time, positionId uint64) []byte {
+	return append(KeyJoinTimePositions(joinTime), sdk.Uint64ToBigEndian(positionId)...)
+}
+
+// KeyJoinTimePositions returns the prefix under which the ids of all positions joined at the given time
+// are stored. Since the time is encoded in a sortable format, iterating from the prefix of one time
+// to that of another visits the positions joined between them in join time order.
+func KeyJoinTimePositions(joinTime time.Time) []byte {
+	key := make([]byte, 0, len(JoinTimePositionPrefix)+len(sdk.SortableTimeFormat)+uint64ByteSize)
+	key = append(key, JoinTimePositionPrefix...)
+	return append(key, sdk.FormatTimeBytes(joinTime)...)
 }
 
 // Pool Prefix Keys
@@ -156,6 +202,24 @@ func KeyPool(poolId uint64) []byte {
 	return []byte(fmt.Sprintf("%s%d", PoolPrefix, poolId))
 }
 
+// Denom Pair Pool Prefix Keys
+// Used to map a denom pair to the ids of the pools trading it
+
+func KeyDenomPairPoolId(denomA, denomB string, poolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%d", KeyDenomPair(denomA, denomB), poolId))
+}
+
+// KeyDenomPair returns the prefix under which the ids of all pools trading the given
+// denoms are stored. The denoms are sorted so that the prefix is the same regardless
+// of their order, and the trailing separator ensures that one denom pair does not
+// prefix-match another.
+func KeyDenomPair(denomA, denomB string) []byte {
+	if denomA > denomB {
+		denomA, denomB = denomB, denomA
+	}
+	return []byte(fmt.Sprintf("%s%s%s%s%s%s", DenomPairPoolPrefix, KeySeparator, denomA, KeySeparator, denomB, KeySeparator))
+}
+
 // Incentive Prefix Keys
 
 func KeyIncentiveRecord(poolId uint64, minUptimeIndex int, denom string, addr sdk.AccAddress) []byte {
@@ -171,6 +235,19 @@ func KeyPoolIncentiveRecords(poolId uint64) []byte {
 	return []byte(fmt.Sprintf("%s%s%d", IncentivePrefix, KeySeparator, poolId))
 }
 
+// Fee Revenue Snapshot Prefix Keys
+// Used to map a (pool id, block time) pair to the pool's cumulative fees as of that time
+
+func KeyFeeRevenueSnapshot(poolId uint64, blockTime time.Time) []byte {
+	return append(KeyPoolFeeRevenueSnapshots(poolId), sdk.FormatTimeBytes(blockTime)...)
+}
+
+func KeyPoolFeeRevenueSnapshots(poolId uint64) []byte {
+	key := make([]byte, 0, len(FeeRevenueSnapshotPrefix)+uint64ByteSize)
+	key = append(key, FeeRevenueSnapshotPrefix...)
+	return append(key, sdk.Uint64ToBigEndian(poolId)...)
+}
+
 // Fee Accumulator Prefix Keys
 
 func KeyFeePositionAccumulator(positionId uint64) string {
@@ -189,3 +266,8 @@ func KeyUptimeAccumulator(poolId uint64, uptimeIndex uint64) string {
 	uptimeIndexStr := strconv.FormatUint(uptimeIndex, uintBase)
 	return strings.Join([]string{string(UptimeAccumulatorPrefix), poolIdStr, uptimeIndexStr}, "/")
 }
+
+// KeyPendingProtocolFees returns the key used to store the protocol fees of a pool that are pending routing.
+func KeyPendingProtocolFees(poolId uint64) []byte {
+	return []byte(fmt.Sprintf("%s%s%d", PendingProtocolFeesPrefix, KeySeparator, poolId))
+}
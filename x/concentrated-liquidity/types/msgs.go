@@ -8,10 +8,19 @@ import (
 
 // constants.
 const (
-	TypeMsgCreatePosition    = "create-position"
-	TypeMsgWithdrawPosition  = "withdraw-position"
-	TypeMsgCollectFees       = "collect-fees"
-	TypeMsgCollectIncentives = "collect-incentives"
+	TypeMsgCreatePosition                  = "create-position"
+	TypeMsgCreatePositionByPrice           = "create-position-by-price"
+	TypeMsgCreatePositionRelative          = "create-position-relative"
+	TypeMsgWithdrawPosition                = "withdraw-position"
+	TypeMsgWithdrawPositions               = "withdraw-positions"
+	TypeMsgEmergencyWithdraw               = "emergency-withdraw"
+	TypeMsgCollectFees                     = "collect-fees"
+	TypeMsgCollectIncentives               = "collect-incentives"
+	TypeMsgWithdrawProtocolFees            = "withdraw-protocol-fees"
+	TypeMsgUpdateTickSpacing               = "update-tick-spacing"
+	TypeMsgPruneEmptyTicks                 = "prune-empty-ticks"
+	TypeMsgSwapExactAmountInWithPriceLimit = "swap-exact-amount-in-with-price-limit"
+	TypeMsgCreatePositionSingleAsset       = "create-position-single-asset"
 )
 
 var _ sdk.Msg = &MsgCreatePosition{}
@@ -24,6 +33,12 @@ func (msg MsgCreatePosition) ValidateBasic() error {
 		return fmt.Errorf("Invalid sender address (%s)", err)
 	}
 
+	if msg.Owner != "" {
+		if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+			return fmt.Errorf("Invalid owner address (%s)", err)
+		}
+	}
+
 	if msg.LowerTick >= msg.UpperTick {
 		return InvalidLowerUpperTickError{LowerTick: msg.LowerTick, UpperTick: msg.UpperTick}
 	}
@@ -44,6 +59,10 @@ func (msg MsgCreatePosition) ValidateBasic() error {
 		return NotPositiveRequireAmountError{Amount: msg.TokenMinAmount1.String()}
 	}
 
+	if msg.StrictSlippage && msg.TokenMinAmount0.IsZero() && msg.TokenMinAmount1.IsZero() {
+		return SlippageProtectionDisabledError{}
+	}
+
 	return nil
 }
 
@@ -59,6 +78,96 @@ func (msg MsgCreatePosition) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+var _ sdk.Msg = &MsgCreatePositionByPrice{}
+
+func (msg MsgCreatePositionByPrice) Route() string { return RouterKey }
+func (msg MsgCreatePositionByPrice) Type() string  { return TypeMsgCreatePositionByPrice }
+func (msg MsgCreatePositionByPrice) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.LowerPrice.IsNil() || msg.UpperPrice.IsNil() || !msg.LowerPrice.IsPositive() || msg.LowerPrice.GTE(msg.UpperPrice) {
+		return InvalidLowerUpperPriceError{LowerPrice: msg.LowerPrice, UpperPrice: msg.UpperPrice}
+	}
+
+	if !msg.TokenDesired0.IsValid() || msg.TokenDesired0.IsZero() {
+		return fmt.Errorf("Invalid coins (%s)", msg.TokenDesired0.String())
+	}
+
+	if !msg.TokenDesired1.IsValid() || msg.TokenDesired1.IsZero() {
+		return fmt.Errorf("Invalid coins (%s)", msg.TokenDesired1.String())
+	}
+
+	if msg.TokenMinAmount0.IsNegative() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenMinAmount0.String()}
+	}
+
+	if msg.TokenMinAmount1.IsNegative() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenMinAmount1.String()}
+	}
+
+	return nil
+}
+
+func (msg MsgCreatePositionByPrice) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreatePositionByPrice) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCreatePositionRelative{}
+
+func (msg MsgCreatePositionRelative) Route() string { return RouterKey }
+func (msg MsgCreatePositionRelative) Type() string  { return TypeMsgCreatePositionRelative }
+func (msg MsgCreatePositionRelative) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.LowerTickOffset >= msg.UpperTickOffset {
+		return InvalidLowerUpperTickOffsetError{LowerTickOffset: msg.LowerTickOffset, UpperTickOffset: msg.UpperTickOffset}
+	}
+
+	if !msg.TokenDesired0.IsValid() || msg.TokenDesired0.IsZero() {
+		return fmt.Errorf("Invalid coins (%s)", msg.TokenDesired0.String())
+	}
+
+	if !msg.TokenDesired1.IsValid() || msg.TokenDesired1.IsZero() {
+		return fmt.Errorf("Invalid coins (%s)", msg.TokenDesired1.String())
+	}
+
+	if msg.TokenMinAmount0.IsNegative() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenMinAmount0.String()}
+	}
+
+	if msg.TokenMinAmount1.IsNegative() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenMinAmount1.String()}
+	}
+
+	return nil
+}
+
+func (msg MsgCreatePositionRelative) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreatePositionRelative) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
 var _ sdk.Msg = &MsgWithdrawPosition{}
 
 func (msg MsgWithdrawPosition) Route() string { return RouterKey }
@@ -88,6 +197,72 @@ func (msg MsgWithdrawPosition) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{sender}
 }
 
+var _ sdk.Msg = &MsgWithdrawPositions{}
+
+func (msg MsgWithdrawPositions) Route() string { return RouterKey }
+func (msg MsgWithdrawPositions) Type() string  { return TypeMsgWithdrawPositions }
+func (msg MsgWithdrawPositions) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if len(msg.Withdrawals) == 0 {
+		return fmt.Errorf("At least one position withdrawal must be provided")
+	}
+
+	seenPositionIds := make(map[uint64]struct{}, len(msg.Withdrawals))
+	for _, withdrawal := range msg.Withdrawals {
+		if _, ok := seenPositionIds[withdrawal.PositionId]; ok {
+			return fmt.Errorf("Duplicate position id (%d)", withdrawal.PositionId)
+		}
+		seenPositionIds[withdrawal.PositionId] = struct{}{}
+
+		if !withdrawal.LiquidityAmount.IsPositive() {
+			return NotPositiveRequireAmountError{Amount: withdrawal.LiquidityAmount.String()}
+		}
+	}
+
+	return nil
+}
+
+func (msg MsgWithdrawPositions) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgWithdrawPositions) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgEmergencyWithdraw{}
+
+func (msg MsgEmergencyWithdraw) Route() string { return RouterKey }
+func (msg MsgEmergencyWithdraw) Type() string  { return TypeMsgEmergencyWithdraw }
+func (msg MsgEmergencyWithdraw) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgEmergencyWithdraw) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgEmergencyWithdraw) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
 var _ sdk.Msg = &MsgCollectFees{}
 
 func (msg MsgCollectFees) Route() string { return RouterKey }
@@ -170,3 +345,166 @@ func (msg MsgCreateIncentive) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgWithdrawProtocolFees{}
+
+func (msg MsgWithdrawProtocolFees) Route() string { return RouterKey }
+func (msg MsgWithdrawProtocolFees) Type() string  { return TypeMsgWithdrawProtocolFees }
+func (msg MsgWithdrawProtocolFees) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgWithdrawProtocolFees) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgWithdrawProtocolFees) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgUpdateTickSpacing{}
+
+func (msg MsgUpdateTickSpacing) Route() string { return RouterKey }
+func (msg MsgUpdateTickSpacing) Type() string  { return TypeMsgUpdateTickSpacing }
+func (msg MsgUpdateTickSpacing) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.NewTickSpacing == 0 {
+		return fmt.Errorf("tick spacing must be positive")
+	}
+
+	return nil
+}
+
+func (msg MsgUpdateTickSpacing) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdateTickSpacing) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgPruneEmptyTicks{}
+
+func (msg MsgPruneEmptyTicks) Route() string { return RouterKey }
+func (msg MsgPruneEmptyTicks) Type() string  { return TypeMsgPruneEmptyTicks }
+func (msg MsgPruneEmptyTicks) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgPruneEmptyTicks) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgPruneEmptyTicks) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSwapExactAmountInWithPriceLimit{}
+
+func (msg MsgSwapExactAmountInWithPriceLimit) Route() string { return RouterKey }
+func (msg MsgSwapExactAmountInWithPriceLimit) Type() string {
+	return TypeMsgSwapExactAmountInWithPriceLimit
+}
+func (msg MsgSwapExactAmountInWithPriceLimit) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if !msg.TokenIn.IsValid() || msg.TokenIn.IsZero() {
+		return fmt.Errorf("Invalid coins (%s)", msg.TokenIn.String())
+	}
+
+	if err := sdk.ValidateDenom(msg.TokenOutDenom); err != nil {
+		return err
+	}
+
+	if msg.TokenIn.Denom == msg.TokenOutDenom {
+		return DenomDuplicatedError{TokenInDenom: msg.TokenIn.Denom, TokenOutDenom: msg.TokenOutDenom}
+	}
+
+	if msg.TokenOutMinAmount.IsNegative() {
+		return NotPositiveRequireAmountError{Amount: msg.TokenOutMinAmount.String()}
+	}
+
+	if !msg.PriceLimit.IsPositive() {
+		return NonPositivePriceLimitError{PriceLimit: msg.PriceLimit}
+	}
+
+	return nil
+}
+
+func (msg MsgSwapExactAmountInWithPriceLimit) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSwapExactAmountInWithPriceLimit) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCreatePositionSingleAsset{}
+
+func (msg MsgCreatePositionSingleAsset) Route() string { return RouterKey }
+func (msg MsgCreatePositionSingleAsset) Type() string  { return TypeMsgCreatePositionSingleAsset }
+func (msg MsgCreatePositionSingleAsset) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return fmt.Errorf("Invalid sender address (%s)", err)
+	}
+
+	if msg.LowerTick >= msg.UpperTick {
+		return InvalidLowerUpperTickError{LowerTick: msg.LowerTick, UpperTick: msg.UpperTick}
+	}
+
+	if !msg.TokenIn.IsValid() || msg.TokenIn.IsZero() {
+		return fmt.Errorf("Invalid coins (%s)", msg.TokenIn.String())
+	}
+
+	if msg.MaxSlippage.IsNil() || msg.MaxSlippage.IsNegative() || msg.MaxSlippage.GTE(sdk.OneDec()) {
+		return InvalidMaxSlippageError{MaxSlippage: msg.MaxSlippage}
+	}
+
+	return nil
+}
+
+func (msg MsgCreatePositionSingleAsset) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreatePositionSingleAsset) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
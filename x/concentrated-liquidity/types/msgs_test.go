@@ -53,6 +53,36 @@ func TestMsgCreatePosition(t *testing.T) {
 			},
 			expectPass: false,
 		},
+		{
+			name: "proper msg with owner",
+			msg: types.MsgCreatePosition{
+				PoolId:          1,
+				Sender:          addr1,
+				LowerTick:       1,
+				UpperTick:       10,
+				TokenDesired0:   sdk.NewCoin("stake", sdk.OneInt()),
+				TokenDesired1:   sdk.NewCoin("osmo", sdk.OneInt()),
+				TokenMinAmount0: sdk.OneInt(),
+				TokenMinAmount1: sdk.OneInt(),
+				Owner:           sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String(),
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid owner",
+			msg: types.MsgCreatePosition{
+				PoolId:          1,
+				Sender:          addr1,
+				LowerTick:       1,
+				UpperTick:       10,
+				TokenDesired0:   sdk.NewCoin("stake", sdk.OneInt()),
+				TokenDesired1:   sdk.NewCoin("osmo", sdk.OneInt()),
+				TokenMinAmount0: sdk.OneInt(),
+				TokenMinAmount1: sdk.OneInt(),
+				Owner:           invalidAddr.String(),
+			},
+			expectPass: false,
+		},
 		{
 			name: "invalid price range, lower tick > upper",
 			msg: types.MsgCreatePosition{
@@ -137,6 +167,36 @@ func TestMsgCreatePosition(t *testing.T) {
 			},
 			expectPass: true,
 		},
+		{
+			name: "zero amount with strict slippage",
+			msg: types.MsgCreatePosition{
+				PoolId:          1,
+				Sender:          addr1,
+				LowerTick:       1,
+				UpperTick:       10,
+				TokenDesired0:   sdk.NewCoin("stake", sdk.OneInt()),
+				TokenDesired1:   sdk.NewCoin("osmo", sdk.OneInt()),
+				TokenMinAmount0: sdk.ZeroInt(),
+				TokenMinAmount1: sdk.ZeroInt(),
+				StrictSlippage:  true,
+			},
+			expectPass: false,
+		},
+		{
+			name: "one non-zero amount with strict slippage",
+			msg: types.MsgCreatePosition{
+				PoolId:          1,
+				Sender:          addr1,
+				LowerTick:       1,
+				UpperTick:       10,
+				TokenDesired0:   sdk.NewCoin("stake", sdk.OneInt()),
+				TokenDesired1:   sdk.NewCoin("osmo", sdk.OneInt()),
+				TokenMinAmount0: sdk.ZeroInt(),
+				TokenMinAmount1: sdk.OneInt(),
+				StrictSlippage:  true,
+			},
+			expectPass: true,
+		},
 	}
 
 	for _, test := range tests {
@@ -155,6 +215,164 @@ func TestMsgCreatePosition(t *testing.T) {
 	}
 }
 
+func TestMsgCreatePositionByPrice(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	validMsg := func(modify func(*types.MsgCreatePositionByPrice)) types.MsgCreatePositionByPrice {
+		msg := types.MsgCreatePositionByPrice{
+			PoolId:          1,
+			Sender:          addr1,
+			LowerPrice:      sdk.MustNewDecFromStr("0.5"),
+			UpperPrice:      sdk.NewDec(2),
+			TokenDesired0:   sdk.NewCoin("stake", sdk.OneInt()),
+			TokenDesired1:   sdk.NewCoin("osmo", sdk.OneInt()),
+			TokenMinAmount0: sdk.OneInt(),
+			TokenMinAmount1: sdk.OneInt(),
+		}
+		modify(&msg)
+		return msg
+	}
+
+	tests := []struct {
+		name       string
+		msg        types.MsgCreatePositionByPrice
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        validMsg(func(msg *types.MsgCreatePositionByPrice) {}),
+			expectPass: true,
+		},
+		{
+			name:       "invalid sender",
+			msg:        validMsg(func(msg *types.MsgCreatePositionByPrice) { msg.Sender = invalidAddr.String() }),
+			expectPass: false,
+		},
+		{
+			name:       "zero lower price",
+			msg:        validMsg(func(msg *types.MsgCreatePositionByPrice) { msg.LowerPrice = sdk.ZeroDec() }),
+			expectPass: false,
+		},
+		{
+			name:       "lower price equal to upper price",
+			msg:        validMsg(func(msg *types.MsgCreatePositionByPrice) { msg.LowerPrice = msg.UpperPrice }),
+			expectPass: false,
+		},
+		{
+			name:       "lower price greater than upper price",
+			msg:        validMsg(func(msg *types.MsgCreatePositionByPrice) { msg.LowerPrice = sdk.NewDec(3) }),
+			expectPass: false,
+		},
+		{
+			name:       "zero token desired",
+			msg:        validMsg(func(msg *types.MsgCreatePositionByPrice) { msg.TokenDesired0 = sdk.NewCoin("stake", sdk.ZeroInt()) }),
+			expectPass: false,
+		},
+		{
+			name:       "negative token min amount",
+			msg:        validMsg(func(msg *types.MsgCreatePositionByPrice) { msg.TokenMinAmount1 = sdk.NewInt(-1) }),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := test.msg
+
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			require.Equal(t, msg.Route(), types.RouterKey)
+			require.Equal(t, msg.Type(), "create-position-by-price")
+			signers := msg.GetSigners()
+			require.Equal(t, len(signers), 1)
+			require.Equal(t, signers[0].String(), addr1)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgCreatePositionRelative(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	validMsg := func(modify func(*types.MsgCreatePositionRelative)) types.MsgCreatePositionRelative {
+		msg := types.MsgCreatePositionRelative{
+			PoolId:          1,
+			Sender:          addr1,
+			LowerTickOffset: -1000,
+			UpperTickOffset: 1000,
+			TokenDesired0:   sdk.NewCoin("stake", sdk.OneInt()),
+			TokenDesired1:   sdk.NewCoin("osmo", sdk.OneInt()),
+			TokenMinAmount0: sdk.OneInt(),
+			TokenMinAmount1: sdk.OneInt(),
+		}
+		modify(&msg)
+		return msg
+	}
+
+	tests := []struct {
+		name       string
+		msg        types.MsgCreatePositionRelative
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        validMsg(func(msg *types.MsgCreatePositionRelative) {}),
+			expectPass: true,
+		},
+		{
+			name:       "range entirely below current tick",
+			msg:        validMsg(func(msg *types.MsgCreatePositionRelative) { msg.UpperTickOffset = -10 }),
+			expectPass: true,
+		},
+		{
+			name:       "invalid sender",
+			msg:        validMsg(func(msg *types.MsgCreatePositionRelative) { msg.Sender = invalidAddr.String() }),
+			expectPass: false,
+		},
+		{
+			name:       "lower offset equal to upper offset",
+			msg:        validMsg(func(msg *types.MsgCreatePositionRelative) { msg.LowerTickOffset = msg.UpperTickOffset }),
+			expectPass: false,
+		},
+		{
+			name:       "lower offset greater than upper offset",
+			msg:        validMsg(func(msg *types.MsgCreatePositionRelative) { msg.LowerTickOffset = 2000 }),
+			expectPass: false,
+		},
+		{
+			name:       "zero token desired",
+			msg:        validMsg(func(msg *types.MsgCreatePositionRelative) { msg.TokenDesired1 = sdk.NewCoin("osmo", sdk.ZeroInt()) }),
+			expectPass: false,
+		},
+		{
+			name:       "negative token min amount",
+			msg:        validMsg(func(msg *types.MsgCreatePositionRelative) { msg.TokenMinAmount0 = sdk.NewInt(-1) }),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := test.msg
+
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			require.Equal(t, msg.Route(), types.RouterKey)
+			require.Equal(t, msg.Type(), "create-position-relative")
+			signers := msg.GetSigners()
+			require.Equal(t, len(signers), 1)
+			require.Equal(t, signers[0].String(), addr1)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
 func TestMsgWithdrawPosition(t *testing.T) {
 	appParams.SetAddressPrefixes()
 	pk1 := ed25519.GenPrivKey().PubKey()
@@ -202,6 +420,411 @@ func TestMsgWithdrawPosition(t *testing.T) {
 	}
 }
 
+func TestMsgWithdrawPositions(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	tests := []struct {
+		name       string
+		msg        types.MsgWithdrawPositions
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgWithdrawPositions{
+				Sender: addr1,
+				Withdrawals: []types.PositionWithdrawal{
+					{PositionId: 1, LiquidityAmount: sdk.OneDec()},
+					{PositionId: 2, LiquidityAmount: sdk.OneDec()},
+				},
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgWithdrawPositions{
+				Sender:      invalidAddr.String(),
+				Withdrawals: []types.PositionWithdrawal{{PositionId: 1, LiquidityAmount: sdk.OneDec()}},
+			},
+			expectPass: false,
+		},
+		{
+			name: "no withdrawals",
+			msg: types.MsgWithdrawPositions{
+				Sender: addr1,
+			},
+			expectPass: false,
+		},
+		{
+			name: "duplicate position id",
+			msg: types.MsgWithdrawPositions{
+				Sender: addr1,
+				Withdrawals: []types.PositionWithdrawal{
+					{PositionId: 1, LiquidityAmount: sdk.OneDec()},
+					{PositionId: 1, LiquidityAmount: sdk.OneDec()},
+				},
+			},
+			expectPass: false,
+		},
+		{
+			name: "non-positive liquidity",
+			msg: types.MsgWithdrawPositions{
+				Sender:      addr1,
+				Withdrawals: []types.PositionWithdrawal{{PositionId: 1, LiquidityAmount: sdk.ZeroDec()}},
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := test.msg
+
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			require.Equal(t, msg.Route(), types.RouterKey)
+			require.Equal(t, msg.Type(), "withdraw-positions")
+			signers := msg.GetSigners()
+			require.Equal(t, len(signers), 1)
+			require.Equal(t, signers[0].String(), addr1)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgEmergencyWithdraw(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	tests := []struct {
+		name       string
+		msg        types.MsgEmergencyWithdraw
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgEmergencyWithdraw{
+				PositionId: 1,
+				Sender:     addr1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgEmergencyWithdraw{
+				PositionId: 1,
+				Sender:     invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := test.msg
+
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			require.Equal(t, msg.Route(), types.RouterKey)
+			require.Equal(t, msg.Type(), "emergency-withdraw")
+			signers := msg.GetSigners()
+			require.Equal(t, len(signers), 1)
+			require.Equal(t, signers[0].String(), addr1)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgUpdateTickSpacing(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	tests := []struct {
+		name       string
+		msg        types.MsgUpdateTickSpacing
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgUpdateTickSpacing{
+				PoolId:         1,
+				Sender:         addr1,
+				NewTickSpacing: 10,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgUpdateTickSpacing{
+				PoolId:         1,
+				Sender:         invalidAddr.String(),
+				NewTickSpacing: 10,
+			},
+			expectPass: false,
+		},
+		{
+			name: "zero tick spacing",
+			msg: types.MsgUpdateTickSpacing{
+				PoolId:         1,
+				Sender:         addr1,
+				NewTickSpacing: 0,
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := test.msg
+
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			require.Equal(t, msg.Route(), types.RouterKey)
+			require.Equal(t, msg.Type(), "update-tick-spacing")
+			signers := msg.GetSigners()
+			require.Equal(t, len(signers), 1)
+			require.Equal(t, signers[0].String(), addr1)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgPruneEmptyTicks(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	tests := []struct {
+		name       string
+		msg        types.MsgPruneEmptyTicks
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: types.MsgPruneEmptyTicks{
+				PoolId: 1,
+				Sender: addr1,
+			},
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: types.MsgPruneEmptyTicks{
+				PoolId: 1,
+				Sender: invalidAddr.String(),
+			},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := test.msg
+
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			require.Equal(t, msg.Route(), types.RouterKey)
+			require.Equal(t, msg.Type(), "prune-empty-ticks")
+			signers := msg.GetSigners()
+			require.Equal(t, len(signers), 1)
+			require.Equal(t, signers[0].String(), addr1)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgSwapExactAmountInWithPriceLimit(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	validMsg := func(modify func(msg *types.MsgSwapExactAmountInWithPriceLimit)) types.MsgSwapExactAmountInWithPriceLimit {
+		msg := types.MsgSwapExactAmountInWithPriceLimit{
+			PoolId:            1,
+			Sender:            addr1,
+			TokenIn:           sdk.NewCoin("usdc", sdk.NewInt(1000)),
+			TokenOutDenom:     "eth",
+			TokenOutMinAmount: sdk.OneInt(),
+			PriceLimit:        sdk.NewDec(5000),
+		}
+		modify(&msg)
+		return msg
+	}
+
+	tests := []struct {
+		name       string
+		msg        types.MsgSwapExactAmountInWithPriceLimit
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        validMsg(func(msg *types.MsgSwapExactAmountInWithPriceLimit) {}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: validMsg(func(msg *types.MsgSwapExactAmountInWithPriceLimit) {
+				msg.Sender = invalidAddr.String()
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero token in",
+			msg: validMsg(func(msg *types.MsgSwapExactAmountInWithPriceLimit) {
+				msg.TokenIn = sdk.NewCoin("usdc", sdk.ZeroInt())
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid token out denom",
+			msg: validMsg(func(msg *types.MsgSwapExactAmountInWithPriceLimit) {
+				msg.TokenOutDenom = "1"
+			}),
+			expectPass: false,
+		},
+		{
+			name: "token in and out denom are the same",
+			msg: validMsg(func(msg *types.MsgSwapExactAmountInWithPriceLimit) {
+				msg.TokenOutDenom = "usdc"
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative token out min amount",
+			msg: validMsg(func(msg *types.MsgSwapExactAmountInWithPriceLimit) {
+				msg.TokenOutMinAmount = sdk.NewInt(-1)
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero price limit",
+			msg: validMsg(func(msg *types.MsgSwapExactAmountInWithPriceLimit) {
+				msg.PriceLimit = sdk.ZeroDec()
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := test.msg
+
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			require.Equal(t, msg.Route(), types.RouterKey)
+			require.Equal(t, msg.Type(), "swap-exact-amount-in-with-price-limit")
+			signers := msg.GetSigners()
+			require.Equal(t, len(signers), 1)
+			require.Equal(t, signers[0].String(), addr1)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgCreatePositionSingleAsset(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	validMsg := func(modify func(msg *types.MsgCreatePositionSingleAsset)) types.MsgCreatePositionSingleAsset {
+		msg := types.MsgCreatePositionSingleAsset{
+			PoolId:      1,
+			Sender:      addr1,
+			LowerTick:   1,
+			UpperTick:   10,
+			TokenIn:     sdk.NewCoin("usdc", sdk.NewInt(1000)),
+			MaxSlippage: sdk.NewDecWithPrec(1, 2),
+		}
+		modify(&msg)
+		return msg
+	}
+
+	tests := []struct {
+		name       string
+		msg        types.MsgCreatePositionSingleAsset
+		expectPass bool
+	}{
+		{
+			name:       "proper msg",
+			msg:        validMsg(func(msg *types.MsgCreatePositionSingleAsset) {}),
+			expectPass: true,
+		},
+		{
+			name: "zero max slippage",
+			msg: validMsg(func(msg *types.MsgCreatePositionSingleAsset) {
+				msg.MaxSlippage = sdk.ZeroDec()
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: validMsg(func(msg *types.MsgCreatePositionSingleAsset) {
+				msg.Sender = invalidAddr.String()
+			}),
+			expectPass: false,
+		},
+		{
+			name: "lower tick not below upper tick",
+			msg: validMsg(func(msg *types.MsgCreatePositionSingleAsset) {
+				msg.LowerTick = 10
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero token in",
+			msg: validMsg(func(msg *types.MsgCreatePositionSingleAsset) {
+				msg.TokenIn = sdk.NewCoin("usdc", sdk.ZeroInt())
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative max slippage",
+			msg: validMsg(func(msg *types.MsgCreatePositionSingleAsset) {
+				msg.MaxSlippage = sdk.NewDecWithPrec(-1, 2)
+			}),
+			expectPass: false,
+		},
+		{
+			name: "max slippage of one",
+			msg: validMsg(func(msg *types.MsgCreatePositionSingleAsset) {
+				msg.MaxSlippage = sdk.OneDec()
+			}),
+			expectPass: false,
+		},
+		{
+			name: "nil max slippage",
+			msg: validMsg(func(msg *types.MsgCreatePositionSingleAsset) {
+				msg.MaxSlippage = sdk.Dec{}
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		msg := test.msg
+
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+			require.Equal(t, msg.Route(), types.RouterKey)
+			require.Equal(t, msg.Type(), "create-position-single-asset")
+			signers := msg.GetSigners()
+			require.Equal(t, len(signers), 1)
+			require.Equal(t, signers[0].String(), addr1)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
 func TestConcentratedLiquiditySerialization(t *testing.T) {
 	pk1 := ed25519.GenPrivKey().PubKey()
 	addr1 := sdk.AccAddress(pk1.Address()).String()
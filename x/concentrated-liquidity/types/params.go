@@ -9,8 +9,13 @@ import (
 
 // Parameter store keys.
 var (
-	KeyAuthorizedTickSpacing = []byte("AuthorizedTickSpacing")
-	KeyAuthorizedSwapFees    = []byte("AuthorizedSwapFees")
+	KeyAuthorizedTickSpacing    = []byte("AuthorizedTickSpacing")
+	KeyAuthorizedSwapFees       = []byte("AuthorizedSwapFees")
+	KeyMinInitialLiquidity      = []byte("MinInitialLiquidity")
+	KeyEmergencyWithdrawEnabled = []byte("EmergencyWithdrawEnabled")
+	KeyMaxTicksCrossedPerSwap   = []byte("MaxTicksCrossedPerSwap")
+	KeyProtocolFeeRecipient     = []byte("ProtocolFeeRecipient")
+	KeyTickSpacingAuthority     = []byte("TickSpacingAuthority")
 
 	_ paramtypes.ParamSet = &Params{}
 )
@@ -20,10 +25,15 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(authorizedTickSpacing []uint64, authorizedSwapFees []sdk.Dec) Params {
+func NewParams(authorizedTickSpacing []uint64, authorizedSwapFees []sdk.Dec, minInitialLiquidity sdk.Dec, emergencyWithdrawEnabled bool, maxTicksCrossedPerSwap uint64, protocolFeeRecipient string, tickSpacingAuthority string) Params {
 	return Params{
-		AuthorizedTickSpacing: authorizedTickSpacing,
-		AuthorizedSwapFees:    authorizedSwapFees,
+		AuthorizedTickSpacing:    authorizedTickSpacing,
+		AuthorizedSwapFees:       authorizedSwapFees,
+		MinInitialLiquidity:      minInitialLiquidity,
+		EmergencyWithdrawEnabled: emergencyWithdrawEnabled,
+		MaxTicksCrossedPerSwap:   maxTicksCrossedPerSwap,
+		ProtocolFeeRecipient:     protocolFeeRecipient,
+		TickSpacingAuthority:     tickSpacingAuthority,
 	}
 }
 
@@ -39,6 +49,11 @@ func DefaultParams() Params {
 			sdk.MustNewDecFromStr("0.0005"),
 			sdk.MustNewDecFromStr("0.003"),
 			sdk.MustNewDecFromStr("0.01")},
+		MinInitialLiquidity:      DefaultMinInitialLiquidity,
+		EmergencyWithdrawEnabled: DefaultEmergencyWithdrawEnabled,
+		MaxTicksCrossedPerSwap:   DefaultMaxTicksCrossedPerSwap,
+		ProtocolFeeRecipient:     DefaultProtocolFeeRecipient,
+		TickSpacingAuthority:     DefaultTickSpacingAuthority,
 	}
 }
 
@@ -50,6 +65,21 @@ func (p Params) Validate() error {
 	if err := validateSwapFees(p.AuthorizedSwapFees); err != nil {
 		return err
 	}
+	if err := validateMinInitialLiquidity(p.MinInitialLiquidity); err != nil {
+		return err
+	}
+	if err := validateEmergencyWithdrawEnabled(p.EmergencyWithdrawEnabled); err != nil {
+		return err
+	}
+	if err := validateMaxTicksCrossedPerSwap(p.MaxTicksCrossedPerSwap); err != nil {
+		return err
+	}
+	if err := validateProtocolFeeRecipient(p.ProtocolFeeRecipient); err != nil {
+		return err
+	}
+	if err := validateTickSpacingAuthority(p.TickSpacingAuthority); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -58,6 +88,11 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyAuthorizedTickSpacing, &p.AuthorizedTickSpacing, validateTicks),
 		paramtypes.NewParamSetPair(KeyAuthorizedSwapFees, &p.AuthorizedSwapFees, validateSwapFees),
+		paramtypes.NewParamSetPair(KeyMinInitialLiquidity, &p.MinInitialLiquidity, validateMinInitialLiquidity),
+		paramtypes.NewParamSetPair(KeyEmergencyWithdrawEnabled, &p.EmergencyWithdrawEnabled, validateEmergencyWithdrawEnabled),
+		paramtypes.NewParamSetPair(KeyMaxTicksCrossedPerSwap, &p.MaxTicksCrossedPerSwap, validateMaxTicksCrossedPerSwap),
+		paramtypes.NewParamSetPair(KeyProtocolFeeRecipient, &p.ProtocolFeeRecipient, validateProtocolFeeRecipient),
+		paramtypes.NewParamSetPair(KeyTickSpacingAuthority, &p.TickSpacingAuthority, validateTickSpacingAuthority),
 	}
 }
 
@@ -84,3 +119,82 @@ func validateSwapFees(i interface{}) error {
 
 	return nil
 }
+
+// validateMinInitialLiquidity validates that the given parameter is a non-negative sdk.Dec.
+// If the parameter is not of the correct type or is negative, an error is returned.
+func validateMinInitialLiquidity(i interface{}) error {
+	minInitialLiquidity, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if minInitialLiquidity.IsNil() || minInitialLiquidity.IsNegative() {
+		return fmt.Errorf("min initial liquidity must be non-negative, got: %s", minInitialLiquidity)
+	}
+
+	return nil
+}
+
+// validateEmergencyWithdrawEnabled validates that the given parameter is a bool.
+// If the parameter is not of the correct type, an error is returned.
+func validateEmergencyWithdrawEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+// validateMaxTicksCrossedPerSwap validates that the given parameter is a positive uint64.
+// If the parameter is not of the correct type or is zero, an error is returned.
+func validateMaxTicksCrossedPerSwap(i interface{}) error {
+	maxTicksCrossedPerSwap, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if maxTicksCrossedPerSwap == 0 {
+		return fmt.Errorf("max ticks crossed per swap must be positive")
+	}
+
+	return nil
+}
+
+// validateProtocolFeeRecipient validates that the given parameter is either empty or a valid bech32 address.
+// If the parameter is not of the correct type or is not a valid address, an error is returned.
+func validateProtocolFeeRecipient(i interface{}) error {
+	protocolFeeRecipient, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if protocolFeeRecipient == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(protocolFeeRecipient); err != nil {
+		return fmt.Errorf("invalid protocol fee recipient (%s): %w", protocolFeeRecipient, err)
+	}
+
+	return nil
+}
+
+// validateTickSpacingAuthority validates that the given parameter is either empty or a valid bech32 address.
+// If the parameter is not of the correct type or is not a valid address, an error is returned.
+func validateTickSpacingAuthority(i interface{}) error {
+	tickSpacingAuthority, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if tickSpacingAuthority == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(tickSpacingAuthority); err != nil {
+		return fmt.Errorf("invalid tick spacing authority (%s): %w", tickSpacingAuthority, err)
+	}
+
+	return nil
+}
@@ -32,6 +32,29 @@ type Params struct {
 	// to be created with tick spacing of 1, 10, or 30.
 	AuthorizedTickSpacing []uint64                                 `protobuf:"varint,1,rep,packed,name=authorized_tick_spacing,json=authorizedTickSpacing,proto3" json:"authorized_tick_spacing,omitempty" yaml:"authorized_tick_spacing"`
 	AuthorizedSwapFees    []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,rep,name=authorized_swap_fees,json=authorizedSwapFees,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"authorized_swap_fees" yaml:"authorized_swap_fees"`
+	// min_initial_liquidity is the minimum amount of liquidity the first
+	// position in a pool must create. It raises the cost of seeding pools with
+	// dust positions that have a trivially manipulable price.
+	MinInitialLiquidity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=min_initial_liquidity,json=minInitialLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_initial_liquidity" yaml:"min_initial_liquidity"`
+	// emergency_withdraw_enabled allows position owners to withdraw their
+	// principal via MsgEmergencyWithdraw, bypassing fee and incentive
+	// collection. It is meant to be turned on by governance only if the fee or
+	// incentive accumulators are in a bad state.
+	EmergencyWithdrawEnabled bool `protobuf:"varint,4,opt,name=emergency_withdraw_enabled,json=emergencyWithdrawEnabled,proto3" json:"emergency_withdraw_enabled,omitempty" yaml:"emergency_withdraw_enabled"`
+	// max_ticks_crossed_per_swap is the maximum number of initialized ticks a
+	// single swap may cross. Swaps that would cross more ticks are reverted, so
+	// that the gas consumed by a swap against thinly provisioned ranges stays
+	// bounded.
+	MaxTicksCrossedPerSwap uint64 `protobuf:"varint,5,opt,name=max_ticks_crossed_per_swap,json=maxTicksCrossedPerSwap,proto3" json:"max_ticks_crossed_per_swap,omitempty" yaml:"max_ticks_crossed_per_swap"`
+	// protocol_fee_recipient is the only address allowed to withdraw the
+	// protocol fees accrued by pools via MsgWithdrawProtocolFees. It is set by
+	// governance. If empty, protocol fees cannot be withdrawn.
+	ProtocolFeeRecipient string `protobuf:"bytes,6,opt,name=protocol_fee_recipient,json=protocolFeeRecipient,proto3" json:"protocol_fee_recipient,omitempty" yaml:"protocol_fee_recipient"`
+	// tick_spacing_authority is the only address allowed to change the tick
+	// spacing of existing pools via MsgUpdateTickSpacing and to prune their
+	// empty ticks via MsgPruneEmptyTicks. It is set by governance. If empty,
+	// tick spacings cannot be changed and empty ticks cannot be pruned.
+	TickSpacingAuthority string `protobuf:"bytes,7,opt,name=tick_spacing_authority,json=tickSpacingAuthority,proto3" json:"tick_spacing_authority,omitempty" yaml:"tick_spacing_authority"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -74,6 +97,34 @@ func (m *Params) GetAuthorizedTickSpacing() []uint64 {
 	return nil
 }
 
+func (m *Params) GetEmergencyWithdrawEnabled() bool {
+	if m != nil {
+		return m.EmergencyWithdrawEnabled
+	}
+	return false
+}
+
+func (m *Params) GetMaxTicksCrossedPerSwap() uint64 {
+	if m != nil {
+		return m.MaxTicksCrossedPerSwap
+	}
+	return 0
+}
+
+func (m *Params) GetProtocolFeeRecipient() string {
+	if m != nil {
+		return m.ProtocolFeeRecipient
+	}
+	return ""
+}
+
+func (m *Params) GetTickSpacingAuthority() string {
+	if m != nil {
+		return m.TickSpacingAuthority
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.concentratedliquidity.Params")
 }
@@ -83,27 +134,39 @@ func init() {
 }
 
 var fileDescriptor_cd3784445b6f6ba7 = []byte{
-	// 308 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xce, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x4f, 0xce, 0xcf, 0x4b, 0x4e, 0xcd, 0x2b, 0x29, 0x4a, 0x2c, 0x49, 0x4d,
-	0xd1, 0xcd, 0xc9, 0x2c, 0x2c, 0xcd, 0x4c, 0xc9, 0x2c, 0xa9, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc,
-	0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x85, 0x2a, 0xd6, 0x43, 0x56, 0x0c, 0x57,
-	0x2b, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xa9, 0x0f, 0x62, 0x41, 0x34, 0x49, 0x49, 0x26,
-	0x83, 0x75, 0xc5, 0x43, 0x24, 0x20, 0x1c, 0x88, 0x94, 0xd2, 0x53, 0x46, 0x2e, 0xb6, 0x00, 0xb0,
-	0x05, 0x42, 0x51, 0x5c, 0xe2, 0x89, 0xa5, 0x25, 0x19, 0xf9, 0x45, 0x99, 0x55, 0xa9, 0x29, 0xf1,
-	0x25, 0x99, 0xc9, 0xd9, 0xf1, 0xc5, 0x05, 0x89, 0xc9, 0x99, 0x79, 0xe9, 0x12, 0x8c, 0x0a, 0xcc,
-	0x1a, 0x2c, 0x4e, 0x4a, 0x9f, 0xee, 0xc9, 0xcb, 0x55, 0x26, 0xe6, 0xe6, 0x58, 0x29, 0xe1, 0x50,
-	0xa8, 0x14, 0x24, 0x8a, 0x90, 0x09, 0xc9, 0x4c, 0xce, 0x0e, 0x86, 0x88, 0x0b, 0xd5, 0x73, 0x89,
-	0x20, 0x69, 0x29, 0x2e, 0x4f, 0x2c, 0x88, 0x4f, 0x4b, 0x4d, 0x2d, 0x96, 0x60, 0x52, 0x60, 0xd6,
-	0xe0, 0x74, 0xf2, 0x3d, 0x71, 0x4f, 0x9e, 0xe1, 0xd6, 0x3d, 0x79, 0xb5, 0xf4, 0xcc, 0x92, 0x8c,
-	0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0xa8, 0x2b, 0xa1, 0x94, 0x6e, 0x71, 0x4a, 0xb6, 0x7e, 0x49,
-	0x65, 0x41, 0x6a, 0xb1, 0x9e, 0x4b, 0x6a, 0xf2, 0xa7, 0x7b, 0xf2, 0xd2, 0x18, 0xce, 0x80, 0x9b,
-	0xa9, 0x14, 0x24, 0x84, 0x10, 0x0e, 0x2e, 0x4f, 0x2c, 0x70, 0x4b, 0x4d, 0x2d, 0x76, 0x8a, 0x39,
-	0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63,
-	0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63, 0x39, 0x86, 0x28, 0x27, 0x24, 0x4b, 0xa1, 0x81, 0xab,
-	0x9b, 0x93, 0x98, 0x54, 0x0c, 0xe3, 0xe8, 0x97, 0x19, 0x9a, 0xea, 0x57, 0xe0, 0x8a, 0x1c, 0xb0,
-	0xa3, 0x92, 0xd8, 0xc0, 0x81, 0x69, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x50, 0x03, 0xe3, 0x73,
-	0xcb, 0x01, 0x00, 0x00,
+	// 509 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x41, 0x8b, 0xd3, 0x40,
+	0x14, 0x6e, 0xdc, 0x6e, 0x75, 0x73, 0x8c, 0xdd, 0x35, 0x56, 0x37, 0xe9, 0x0e, 0x28, 0x05, 0x69,
+	0x83, 0x88, 0x17, 0x6f, 0x46, 0x5d, 0x10, 0x54, 0x96, 0xac, 0xb0, 0xb0, 0x08, 0xc3, 0x74, 0xf2,
+	0x6c, 0x87, 0x26, 0x99, 0x38, 0x33, 0xb5, 0xad, 0x17, 0xc1, 0x5f, 0xe0, 0xcf, 0xda, 0xe3, 0x1e,
+	0xc5, 0x43, 0x90, 0xd6, 0x5f, 0xd0, 0x5f, 0x20, 0xcd, 0x24, 0x6d, 0xd0, 0xf6, 0xe0, 0xa9, 0x9d,
+	0xef, 0xfb, 0xde, 0x37, 0x2f, 0xdf, 0x7b, 0x63, 0x3e, 0xe2, 0x32, 0xe6, 0x92, 0x49, 0x8f, 0xf2,
+	0x84, 0x42, 0xa2, 0x04, 0x51, 0x10, 0x76, 0x23, 0xf6, 0x69, 0xcc, 0x42, 0xa6, 0x66, 0x5e, 0x4a,
+	0x04, 0x89, 0x65, 0x2f, 0x15, 0x5c, 0x71, 0xeb, 0xb8, 0x10, 0xf7, 0xaa, 0xe2, 0xb5, 0xb6, 0xd5,
+	0x1c, 0xf0, 0x01, 0xcf, 0x95, 0xde, 0xea, 0x9f, 0x2e, 0x6a, 0xdd, 0xa5, 0x79, 0x15, 0xd6, 0x84,
+	0x3e, 0x68, 0x0a, 0xfd, 0xde, 0x37, 0x1b, 0x67, 0xf9, 0x05, 0xd6, 0xa5, 0x79, 0x87, 0x8c, 0xd5,
+	0x90, 0x0b, 0xf6, 0x05, 0x42, 0xac, 0x18, 0x1d, 0x61, 0x99, 0x12, 0xca, 0x92, 0x81, 0x6d, 0xb4,
+	0xf7, 0x3a, 0x75, 0x1f, 0x2d, 0x33, 0xd7, 0x99, 0x91, 0x38, 0x7a, 0x86, 0x76, 0x08, 0x51, 0x70,
+	0xb8, 0x61, 0xde, 0x33, 0x3a, 0x3a, 0xd7, 0xb8, 0xf5, 0xd5, 0x6c, 0x56, 0x4a, 0xe4, 0x84, 0xa4,
+	0xf8, 0x23, 0x80, 0xb4, 0x6f, 0xb4, 0xf7, 0x3a, 0x07, 0xfe, 0xdb, 0xab, 0xcc, 0xad, 0xfd, 0xcc,
+	0xdc, 0x87, 0x03, 0xa6, 0x86, 0xe3, 0x7e, 0x8f, 0xf2, 0xb8, 0xe8, 0xb2, 0xf8, 0xe9, 0xca, 0x70,
+	0xe4, 0xa9, 0x59, 0x0a, 0xb2, 0xf7, 0x12, 0xe8, 0x32, 0x73, 0xef, 0xfd, 0xd3, 0xc6, 0xda, 0x13,
+	0x05, 0xd6, 0x06, 0x3e, 0x9f, 0x90, 0xf4, 0x14, 0x40, 0x5a, 0xdf, 0x0c, 0xf3, 0x30, 0x66, 0x09,
+	0x66, 0x09, 0x53, 0x8c, 0x44, 0x78, 0x1d, 0x99, 0xbd, 0xd7, 0x36, 0x3a, 0x07, 0xfe, 0xbb, 0xff,
+	0x6e, 0xe1, 0xbe, 0x6e, 0x61, 0xab, 0x29, 0x0a, 0x6e, 0xc7, 0x2c, 0x79, 0xad, 0xe1, 0x37, 0x25,
+	0x6a, 0x51, 0xb3, 0x05, 0x31, 0x88, 0x01, 0x24, 0x74, 0x86, 0x27, 0x4c, 0x0d, 0x43, 0x41, 0x26,
+	0x18, 0x12, 0xd2, 0x8f, 0x20, 0xb4, 0xeb, 0x6d, 0xa3, 0x73, 0xcb, 0x7f, 0xb0, 0xcc, 0xdc, 0x13,
+	0x6d, 0xbd, 0x5b, 0x8b, 0x02, 0x7b, 0x4d, 0x5e, 0x14, 0xdc, 0x2b, 0x4d, 0x59, 0xc4, 0x6c, 0xc5,
+	0x64, 0x9a, 0x8f, 0x45, 0x62, 0x2a, 0xb8, 0x94, 0x10, 0xe2, 0x14, 0x44, 0x9e, 0x90, 0xbd, 0xdf,
+	0x36, 0x3a, 0xf5, 0xea, 0x25, 0xbb, 0xb5, 0x28, 0x38, 0x8a, 0xc9, 0x74, 0x35, 0x45, 0xf9, 0x42,
+	0x53, 0x67, 0x20, 0x56, 0x81, 0x5a, 0x17, 0xe6, 0x51, 0xbe, 0x3d, 0x94, 0x47, 0xab, 0xc8, 0xb1,
+	0x00, 0xca, 0x52, 0x06, 0x89, 0xb2, 0x1b, 0x79, 0x98, 0x27, 0xcb, 0xcc, 0x3d, 0xd6, 0xf6, 0xdb,
+	0x75, 0x28, 0x68, 0x96, 0xc4, 0x29, 0x40, 0x50, 0xc2, 0x2b, 0xe3, 0xea, 0x3a, 0xe1, 0x62, 0x90,
+	0x6a, 0x66, 0xdf, 0xfc, 0xdb, 0x78, 0xbb, 0x0e, 0x05, 0x4d, 0xb5, 0x59, 0xbb, 0xe7, 0x25, 0xec,
+	0x7f, 0xb8, 0x9a, 0x3b, 0xc6, 0xf5, 0xdc, 0x31, 0x7e, 0xcd, 0x1d, 0xe3, 0xfb, 0xc2, 0xa9, 0x5d,
+	0x2f, 0x9c, 0xda, 0x8f, 0x85, 0x53, 0xbb, 0xf4, 0x2b, 0x03, 0x2f, 0xde, 0x56, 0x37, 0x22, 0x7d,
+	0x59, 0x1e, 0xbc, 0xcf, 0x8f, 0x9f, 0x7a, 0xd3, 0x5d, 0x6f, 0x33, 0x5f, 0x88, 0x7e, 0x23, 0xff,
+	0x98, 0x27, 0x7f, 0x06, 0x00, 0xe5, 0x4e, 0x8d, 0x2f, 0xca, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -126,6 +189,45 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TickSpacingAuthority) > 0 {
+		i -= len(m.TickSpacingAuthority)
+		copy(dAtA[i:], m.TickSpacingAuthority)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.TickSpacingAuthority)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ProtocolFeeRecipient) > 0 {
+		i -= len(m.ProtocolFeeRecipient)
+		copy(dAtA[i:], m.ProtocolFeeRecipient)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ProtocolFeeRecipient)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaxTicksCrossedPerSwap != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTicksCrossedPerSwap))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.EmergencyWithdrawEnabled {
+		i--
+		if m.EmergencyWithdrawEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MinInitialLiquidity.Size()
+		i -= size
+		if _, err := m.MinInitialLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if len(m.AuthorizedSwapFees) > 0 {
 		for iNdEx := len(m.AuthorizedSwapFees) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -191,6 +293,22 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovParams(uint64(l))
 		}
 	}
+	l = m.MinInitialLiquidity.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.EmergencyWithdrawEnabled {
+		n += 2
+	}
+	if m.MaxTicksCrossedPerSwap != 0 {
+		n += 1 + sovParams(uint64(m.MaxTicksCrossedPerSwap))
+	}
+	l = len(m.ProtocolFeeRecipient)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.TickSpacingAuthority)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -341,6 +459,143 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinInitialLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinInitialLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmergencyWithdrawEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EmergencyWithdrawEnabled = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTicksCrossedPerSwap", wireType)
+			}
+			m.MaxTicksCrossedPerSwap = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTicksCrossedPerSwap |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFeeRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolFeeRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSpacingAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TickSpacingAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
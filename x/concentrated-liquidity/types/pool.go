@@ -5,6 +5,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/osmomath"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 )
 
@@ -12,6 +13,7 @@ type ConcentratedPoolExtension interface {
 	poolmanagertypes.PoolI
 
 	GetIncentivesAddress() sdk.AccAddress
+	GetProtocolFeesAddress() sdk.AccAddress
 	GetToken0() string
 	GetToken1() string
 	GetCurrentSqrtPrice() sdk.Dec
@@ -20,12 +22,17 @@ type ConcentratedPoolExtension interface {
 	GetTickSpacing() uint64
 	GetLiquidity() sdk.Dec
 	GetLastLiquidityUpdate() time.Time
+	GetEarlyExitFee() sdk.Dec
+	GetMinHoldDuration() time.Duration
+	GetProtocolFeeFraction() sdk.Dec
+	GetInitialPrice() sdk.Dec
 	SetCurrentSqrtPrice(newSqrtPrice sdk.Dec)
 	SetCurrentTick(newTick sdk.Int)
 	SetLastLiquidityUpdate(newTime time.Time)
+	SetTickSpacing(newTickSpacing uint64)
 
 	UpdateLiquidity(newLiquidity sdk.Dec)
 	ApplySwap(newLiquidity sdk.Dec, newCurrentTick sdk.Int, newCurrentSqrtPrice sdk.Dec) error
-	CalcActualAmounts(ctx sdk.Context, lowerTick, upperTick int64, sqrtRatioLowerTick, sqrtRatioUpperTick sdk.Dec, liquidityDelta sdk.Dec) (actualAmountDenom0 sdk.Dec, actualAmountDenom1 sdk.Dec)
+	CalcActualAmounts(ctx sdk.Context, lowerTick, upperTick int64, sqrtRatioLowerTick, sqrtRatioUpperTick sdk.Dec, liquidityDelta sdk.Dec, roundingDir osmomath.RoundingDirection) (actualAmountDenom0 sdk.Dec, actualAmountDenom1 sdk.Dec)
 	UpdateLiquidityIfActivePosition(ctx sdk.Context, lowerTick, upperTick int64, liquidityDelta sdk.Dec) bool
 }
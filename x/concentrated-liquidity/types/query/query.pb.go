@@ -7,16 +7,18 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
-	types "github.com/cosmos/cosmos-sdk/codec/types"
+	types2 "github.com/cosmos/cosmos-sdk/codec/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	types2 "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	model "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/model"
-	types1 "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+	types3 "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types"
+	genesis "github.com/osmosis-labs/osmosis/v15/x/concentrated-liquidity/types/genesis"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -24,12 +26,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -181,6 +185,16 @@ func (m *QueryPositionByIdRequest) GetPositionId() uint64 {
 
 type QueryPositionByIdResponse struct {
 	Position model.PositionWithUnderlyingAssetBreakdown `protobuf:"bytes,1,opt,name=position,proto3" json:"position"`
+	// collectable_now is the incentives the position can collect at the current
+	// block time.
+	CollectableNow github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=collectable_now,json=collectableNow,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"collectable_now" yaml:"collectable_now"`
+	// pending_until_uptime is the incentives that become collectable once the
+	// position reaches the uptimes they were accrued for. They are forfeited if
+	// the position collects before then.
+	PendingUntilUptime github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=pending_until_uptime,json=pendingUntilUptime,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pending_until_uptime" yaml:"pending_until_uptime"`
+	// uptime_reached_at is the time at which all pending incentives become
+	// collectable. It is the current block time if no incentives are pending.
+	UptimeReachedAt time.Time `protobuf:"bytes,4,opt,name=uptime_reached_at,json=uptimeReachedAt,proto3,stdtime" json:"uptime_reached_at" yaml:"uptime_reached_at"`
 }
 
 func (m *QueryPositionByIdResponse) Reset()         { *m = QueryPositionByIdResponse{} }
@@ -223,24 +237,47 @@ func (m *QueryPositionByIdResponse) GetPosition() model.PositionWithUnderlyingAs
 	return model.PositionWithUnderlyingAssetBreakdown{}
 }
 
-// =============================== Pools
-type QueryPoolsRequest struct {
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryPositionByIdResponse) GetCollectableNow() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CollectableNow
+	}
+	return nil
 }
 
-func (m *QueryPoolsRequest) Reset()         { *m = QueryPoolsRequest{} }
-func (m *QueryPoolsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryPoolsRequest) ProtoMessage()    {}
-func (*QueryPoolsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryPositionByIdResponse) GetPendingUntilUptime() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PendingUntilUptime
+	}
+	return nil
+}
+
+func (m *QueryPositionByIdResponse) GetUptimeReachedAt() time.Time {
+	if m != nil {
+		return m.UptimeReachedAt
+	}
+	return time.Time{}
+}
+
+// =============================== PositionIdsForRange
+type QueryPositionIdsForRangeRequest struct {
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	PoolId    uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	LowerTick int64  `protobuf:"varint,3,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick int64  `protobuf:"varint,4,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+}
+
+func (m *QueryPositionIdsForRangeRequest) Reset()         { *m = QueryPositionIdsForRangeRequest{} }
+func (m *QueryPositionIdsForRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionIdsForRangeRequest) ProtoMessage()    {}
+func (*QueryPositionIdsForRangeRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ce34c1e206115391, []int{4}
 }
-func (m *QueryPoolsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPositionIdsForRangeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPositionIdsForRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPoolsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPositionIdsForRangeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -250,43 +287,62 @@ func (m *QueryPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *QueryPoolsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPoolsRequest.Merge(m, src)
+func (m *QueryPositionIdsForRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionIdsForRangeRequest.Merge(m, src)
 }
-func (m *QueryPoolsRequest) XXX_Size() int {
+func (m *QueryPositionIdsForRangeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPoolsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPoolsRequest.DiscardUnknown(m)
+func (m *QueryPositionIdsForRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionIdsForRangeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPoolsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPositionIdsForRangeRequest proto.InternalMessageInfo
 
-func (m *QueryPoolsRequest) GetPagination() *query.PageRequest {
+func (m *QueryPositionIdsForRangeRequest) GetAddress() string {
 	if m != nil {
-		return m.Pagination
+		return m.Address
 	}
-	return nil
+	return ""
 }
 
-type QueryPoolsResponse struct {
-	Pools []*types.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
-	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+func (m *QueryPositionIdsForRangeRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func (m *QueryPoolsResponse) Reset()         { *m = QueryPoolsResponse{} }
-func (m *QueryPoolsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryPoolsResponse) ProtoMessage()    {}
-func (*QueryPoolsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryPositionIdsForRangeRequest) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *QueryPositionIdsForRangeRequest) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+type QueryPositionIdsForRangeResponse struct {
+	PositionIds []uint64 `protobuf:"varint,1,rep,packed,name=position_ids,json=positionIds,proto3" json:"position_ids,omitempty" yaml:"position_ids"`
+}
+
+func (m *QueryPositionIdsForRangeResponse) Reset()         { *m = QueryPositionIdsForRangeResponse{} }
+func (m *QueryPositionIdsForRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionIdsForRangeResponse) ProtoMessage()    {}
+func (*QueryPositionIdsForRangeResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ce34c1e206115391, []int{5}
 }
-func (m *QueryPoolsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPositionIdsForRangeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPositionIdsForRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryPoolsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPositionIdsForRangeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -296,48 +352,43 @@ func (m *QueryPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryPoolsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryPoolsResponse.Merge(m, src)
+func (m *QueryPositionIdsForRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionIdsForRangeResponse.Merge(m, src)
 }
-func (m *QueryPoolsResponse) XXX_Size() int {
+func (m *QueryPositionIdsForRangeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryPoolsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryPoolsResponse.DiscardUnknown(m)
+func (m *QueryPositionIdsForRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionIdsForRangeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryPoolsResponse proto.InternalMessageInfo
-
-func (m *QueryPoolsResponse) GetPools() []*types.Any {
-	if m != nil {
-		return m.Pools
-	}
-	return nil
-}
+var xxx_messageInfo_QueryPositionIdsForRangeResponse proto.InternalMessageInfo
 
-func (m *QueryPoolsResponse) GetPagination() *query.PageResponse {
+func (m *QueryPositionIdsForRangeResponse) GetPositionIds() []uint64 {
 	if m != nil {
-		return m.Pagination
+		return m.PositionIds
 	}
 	return nil
 }
 
-// =============================== ModuleParams
-type QueryParamsRequest struct {
+// =============================== PriceAtTick
+type QueryPriceAtTickRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Tick   int64  `protobuf:"varint,2,opt,name=tick,proto3" json:"tick,omitempty" yaml:"tick"`
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryPriceAtTickRequest) Reset()         { *m = QueryPriceAtTickRequest{} }
+func (m *QueryPriceAtTickRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceAtTickRequest) ProtoMessage()    {}
+func (*QueryPriceAtTickRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ce34c1e206115391, []int{6}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPriceAtTickRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPriceAtTickRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPriceAtTickRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -347,34 +398,49 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryPriceAtTickRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceAtTickRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryPriceAtTickRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryPriceAtTickRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceAtTickRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPriceAtTickRequest proto.InternalMessageInfo
 
-type QueryParamsResponse struct {
-	Params types1.Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+func (m *QueryPriceAtTickRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryPriceAtTickRequest) GetTick() int64 {
+	if m != nil {
+		return m.Tick
+	}
+	return 0
+}
+
+type QueryPriceAtTickResponse struct {
+	SqrtPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=sqrt_price,json=sqrtPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"sqrt_price" yaml:"sqrt_price"`
+	SpotPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=spot_price,json=spotPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"spot_price" yaml:"spot_price"`
+}
+
+func (m *QueryPriceAtTickResponse) Reset()         { *m = QueryPriceAtTickResponse{} }
+func (m *QueryPriceAtTickResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceAtTickResponse) ProtoMessage()    {}
+func (*QueryPriceAtTickResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ce34c1e206115391, []int{7}
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPriceAtTickResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPriceAtTickResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPriceAtTickResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -384,42 +450,37 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryPriceAtTickResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceAtTickResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryPriceAtTickResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryPriceAtTickResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceAtTickResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
-
-func (m *QueryParamsResponse) GetParams() types1.Params {
-	if m != nil {
-		return m.Params
-	}
-	return types1.Params{}
-}
+var xxx_messageInfo_QueryPriceAtTickResponse proto.InternalMessageInfo
 
-type TickLiquidityNet struct {
-	LiquidityNet github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=liquidity_net,json=liquidityNet,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_net" yaml:"liquidity_net"`
-	TickIndex    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=tick_index,json=tickIndex,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tick_index" yaml:"tick"`
+// =============================== SqrtPriceForTicks
+type QuerySqrtPriceForTicksRequest struct {
+	PoolId    uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	LowerTick int64  `protobuf:"varint,2,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick int64  `protobuf:"varint,3,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
 }
 
-func (m *TickLiquidityNet) Reset()         { *m = TickLiquidityNet{} }
-func (m *TickLiquidityNet) String() string { return proto.CompactTextString(m) }
-func (*TickLiquidityNet) ProtoMessage()    {}
-func (*TickLiquidityNet) Descriptor() ([]byte, []int) {
+func (m *QuerySqrtPriceForTicksRequest) Reset()         { *m = QuerySqrtPriceForTicksRequest{} }
+func (m *QuerySqrtPriceForTicksRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySqrtPriceForTicksRequest) ProtoMessage()    {}
+func (*QuerySqrtPriceForTicksRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ce34c1e206115391, []int{8}
 }
-func (m *TickLiquidityNet) XXX_Unmarshal(b []byte) error {
+func (m *QuerySqrtPriceForTicksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TickLiquidityNet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySqrtPriceForTicksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TickLiquidityNet.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySqrtPriceForTicksRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -429,36 +490,56 @@ func (m *TickLiquidityNet) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *TickLiquidityNet) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TickLiquidityNet.Merge(m, src)
+func (m *QuerySqrtPriceForTicksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySqrtPriceForTicksRequest.Merge(m, src)
 }
-func (m *TickLiquidityNet) XXX_Size() int {
+func (m *QuerySqrtPriceForTicksRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *TickLiquidityNet) XXX_DiscardUnknown() {
-	xxx_messageInfo_TickLiquidityNet.DiscardUnknown(m)
+func (m *QuerySqrtPriceForTicksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySqrtPriceForTicksRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TickLiquidityNet proto.InternalMessageInfo
+var xxx_messageInfo_QuerySqrtPriceForTicksRequest proto.InternalMessageInfo
 
-type LiquidityDepthWithRange struct {
-	LiquidityAmount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=liquidity_amount,json=liquidityAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_amount" yaml:"liquidity_net"`
-	LowerTick       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=lower_tick,json=lowerTick,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"lower_tick" yaml:"tick"`
-	UpperTick       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=upper_tick,json=upperTick,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"upper_tick" yaml:"tick"`
+func (m *QuerySqrtPriceForTicksRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
 }
 
-func (m *LiquidityDepthWithRange) Reset()         { *m = LiquidityDepthWithRange{} }
-func (m *LiquidityDepthWithRange) String() string { return proto.CompactTextString(m) }
-func (*LiquidityDepthWithRange) ProtoMessage()    {}
-func (*LiquidityDepthWithRange) Descriptor() ([]byte, []int) {
+func (m *QuerySqrtPriceForTicksRequest) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *QuerySqrtPriceForTicksRequest) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+type QuerySqrtPriceForTicksResponse struct {
+	SqrtPriceLowerTick github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=sqrt_price_lower_tick,json=sqrtPriceLowerTick,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"sqrt_price_lower_tick" yaml:"sqrt_price_lower_tick"`
+	SqrtPriceUpperTick github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=sqrt_price_upper_tick,json=sqrtPriceUpperTick,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"sqrt_price_upper_tick" yaml:"sqrt_price_upper_tick"`
+}
+
+func (m *QuerySqrtPriceForTicksResponse) Reset()         { *m = QuerySqrtPriceForTicksResponse{} }
+func (m *QuerySqrtPriceForTicksResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySqrtPriceForTicksResponse) ProtoMessage()    {}
+func (*QuerySqrtPriceForTicksResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ce34c1e206115391, []int{9}
 }
-func (m *LiquidityDepthWithRange) XXX_Unmarshal(b []byte) error {
+func (m *QuerySqrtPriceForTicksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *LiquidityDepthWithRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QuerySqrtPriceForTicksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_LiquidityDepthWithRange.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QuerySqrtPriceForTicksResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -468,38 +549,36 @@ func (m *LiquidityDepthWithRange) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *LiquidityDepthWithRange) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LiquidityDepthWithRange.Merge(m, src)
+func (m *QuerySqrtPriceForTicksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySqrtPriceForTicksResponse.Merge(m, src)
 }
-func (m *LiquidityDepthWithRange) XXX_Size() int {
+func (m *QuerySqrtPriceForTicksResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *LiquidityDepthWithRange) XXX_DiscardUnknown() {
-	xxx_messageInfo_LiquidityDepthWithRange.DiscardUnknown(m)
+func (m *QuerySqrtPriceForTicksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySqrtPriceForTicksResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LiquidityDepthWithRange proto.InternalMessageInfo
+var xxx_messageInfo_QuerySqrtPriceForTicksResponse proto.InternalMessageInfo
 
-// =============================== LiquidityNetInDirection
-type QueryLiquidityNetInDirectionRequest struct {
-	PoolId    uint64                                  `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
-	TokenIn   string                                  `protobuf:"bytes,2,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty" yaml:"token_in"`
-	StartTick *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=start_tick,json=startTick,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"start_tick,omitempty" yaml:"start_tick"`
-	BoundTick *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=bound_tick,json=boundTick,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bound_tick,omitempty" yaml:"bound_tick"`
+// =============================== Pools
+type QueryPoolsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryLiquidityNetInDirectionRequest) Reset()         { *m = QueryLiquidityNetInDirectionRequest{} }
-func (m *QueryLiquidityNetInDirectionRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryLiquidityNetInDirectionRequest) ProtoMessage()    {}
-func (*QueryLiquidityNetInDirectionRequest) Descriptor() ([]byte, []int) {
+func (m *QueryPoolsRequest) Reset()         { *m = QueryPoolsRequest{} }
+func (m *QueryPoolsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsRequest) ProtoMessage()    {}
+func (*QueryPoolsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ce34c1e206115391, []int{10}
 }
-func (m *QueryLiquidityNetInDirectionRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPoolsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLiquidityNetInDirectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLiquidityNetInDirectionRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -509,50 +588,43 @@ func (m *QueryLiquidityNetInDirectionRequest) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *QueryLiquidityNetInDirectionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLiquidityNetInDirectionRequest.Merge(m, src)
+func (m *QueryPoolsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsRequest.Merge(m, src)
 }
-func (m *QueryLiquidityNetInDirectionRequest) XXX_Size() int {
+func (m *QueryPoolsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLiquidityNetInDirectionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLiquidityNetInDirectionRequest.DiscardUnknown(m)
+func (m *QueryPoolsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLiquidityNetInDirectionRequest proto.InternalMessageInfo
-
-func (m *QueryLiquidityNetInDirectionRequest) GetPoolId() uint64 {
-	if m != nil {
-		return m.PoolId
-	}
-	return 0
-}
+var xxx_messageInfo_QueryPoolsRequest proto.InternalMessageInfo
 
-func (m *QueryLiquidityNetInDirectionRequest) GetTokenIn() string {
+func (m *QueryPoolsRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.TokenIn
+		return m.Pagination
 	}
-	return ""
+	return nil
 }
 
-type QueryLiquidityNetInDirectionResponse struct {
-	LiquidityDepths  []TickLiquidityNet                     `protobuf:"bytes,1,rep,name=liquidity_depths,json=liquidityDepths,proto3" json:"liquidity_depths"`
-	CurrentTick      int64                                  `protobuf:"varint,2,opt,name=current_tick,json=currentTick,proto3" json:"current_tick,omitempty"`
-	CurrentLiquidity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=current_liquidity,json=currentLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"current_liquidity" yaml:"current_liquidity"`
+type QueryPoolsResponse struct {
+	Pools []*types2.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryLiquidityNetInDirectionResponse) Reset()         { *m = QueryLiquidityNetInDirectionResponse{} }
-func (m *QueryLiquidityNetInDirectionResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryLiquidityNetInDirectionResponse) ProtoMessage()    {}
-func (*QueryLiquidityNetInDirectionResponse) Descriptor() ([]byte, []int) {
+func (m *QueryPoolsResponse) Reset()         { *m = QueryPoolsResponse{} }
+func (m *QueryPoolsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsResponse) ProtoMessage()    {}
+func (*QueryPoolsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ce34c1e206115391, []int{11}
 }
-func (m *QueryLiquidityNetInDirectionResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPoolsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryLiquidityNetInDirectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryLiquidityNetInDirectionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -562,49 +634,51 @@ func (m *QueryLiquidityNetInDirectionResponse) XXX_Marshal(b []byte, determinist
 		return b[:n], nil
 	}
 }
-func (m *QueryLiquidityNetInDirectionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryLiquidityNetInDirectionResponse.Merge(m, src)
+func (m *QueryPoolsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsResponse.Merge(m, src)
 }
-func (m *QueryLiquidityNetInDirectionResponse) XXX_Size() int {
+func (m *QueryPoolsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryLiquidityNetInDirectionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryLiquidityNetInDirectionResponse.DiscardUnknown(m)
+func (m *QueryPoolsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryLiquidityNetInDirectionResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPoolsResponse proto.InternalMessageInfo
 
-func (m *QueryLiquidityNetInDirectionResponse) GetLiquidityDepths() []TickLiquidityNet {
+func (m *QueryPoolsResponse) GetPools() []*types2.Any {
 	if m != nil {
-		return m.LiquidityDepths
+		return m.Pools
 	}
 	return nil
 }
 
-func (m *QueryLiquidityNetInDirectionResponse) GetCurrentTick() int64 {
+func (m *QueryPoolsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
-		return m.CurrentTick
+		return m.Pagination
 	}
-	return 0
+	return nil
 }
 
-// =============================== TotalLiquidityForRange
-type QueryTotalLiquidityForRangeRequest struct {
-	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+// =============================== PoolsByLiquidity
+type QueryPoolsByLiquidityRequest struct {
+	// pagination defines an optional pagination for the request. Only offset,
+	// limit and count_total are supported.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTotalLiquidityForRangeRequest) Reset()         { *m = QueryTotalLiquidityForRangeRequest{} }
-func (m *QueryTotalLiquidityForRangeRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalLiquidityForRangeRequest) ProtoMessage()    {}
-func (*QueryTotalLiquidityForRangeRequest) Descriptor() ([]byte, []int) {
+func (m *QueryPoolsByLiquidityRequest) Reset()         { *m = QueryPoolsByLiquidityRequest{} }
+func (m *QueryPoolsByLiquidityRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsByLiquidityRequest) ProtoMessage()    {}
+func (*QueryPoolsByLiquidityRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ce34c1e206115391, []int{12}
 }
-func (m *QueryTotalLiquidityForRangeRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryPoolsByLiquidityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalLiquidityForRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolsByLiquidityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalLiquidityForRangeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolsByLiquidityRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -614,41 +688,43 @@ func (m *QueryTotalLiquidityForRangeRequest) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalLiquidityForRangeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalLiquidityForRangeRequest.Merge(m, src)
+func (m *QueryPoolsByLiquidityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsByLiquidityRequest.Merge(m, src)
 }
-func (m *QueryTotalLiquidityForRangeRequest) XXX_Size() int {
+func (m *QueryPoolsByLiquidityRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalLiquidityForRangeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalLiquidityForRangeRequest.DiscardUnknown(m)
+func (m *QueryPoolsByLiquidityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsByLiquidityRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalLiquidityForRangeRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryPoolsByLiquidityRequest proto.InternalMessageInfo
 
-func (m *QueryTotalLiquidityForRangeRequest) GetPoolId() uint64 {
+func (m *QueryPoolsByLiquidityRequest) GetPagination() *query.PageRequest {
 	if m != nil {
-		return m.PoolId
+		return m.Pagination
 	}
-	return 0
+	return nil
 }
 
-type QueryTotalLiquidityForRangeResponse struct {
-	Liquidity []LiquidityDepthWithRange `protobuf:"bytes,1,rep,name=liquidity,proto3" json:"liquidity"`
+type QueryPoolsByLiquidityResponse struct {
+	Pools []*types2.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTotalLiquidityForRangeResponse) Reset()         { *m = QueryTotalLiquidityForRangeResponse{} }
-func (m *QueryTotalLiquidityForRangeResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTotalLiquidityForRangeResponse) ProtoMessage()    {}
-func (*QueryTotalLiquidityForRangeResponse) Descriptor() ([]byte, []int) {
+func (m *QueryPoolsByLiquidityResponse) Reset()         { *m = QueryPoolsByLiquidityResponse{} }
+func (m *QueryPoolsByLiquidityResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsByLiquidityResponse) ProtoMessage()    {}
+func (*QueryPoolsByLiquidityResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ce34c1e206115391, []int{13}
 }
-func (m *QueryTotalLiquidityForRangeResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPoolsByLiquidityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTotalLiquidityForRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPoolsByLiquidityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTotalLiquidityForRangeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPoolsByLiquidityResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -658,42 +734,48 @@ func (m *QueryTotalLiquidityForRangeResponse) XXX_Marshal(b []byte, deterministi
 		return b[:n], nil
 	}
 }
-func (m *QueryTotalLiquidityForRangeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTotalLiquidityForRangeResponse.Merge(m, src)
+func (m *QueryPoolsByLiquidityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsByLiquidityResponse.Merge(m, src)
 }
-func (m *QueryTotalLiquidityForRangeResponse) XXX_Size() int {
+func (m *QueryPoolsByLiquidityResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTotalLiquidityForRangeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTotalLiquidityForRangeResponse.DiscardUnknown(m)
+func (m *QueryPoolsByLiquidityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsByLiquidityResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTotalLiquidityForRangeResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPoolsByLiquidityResponse proto.InternalMessageInfo
 
-func (m *QueryTotalLiquidityForRangeResponse) GetLiquidity() []LiquidityDepthWithRange {
+func (m *QueryPoolsByLiquidityResponse) GetPools() []*types2.Any {
 	if m != nil {
-		return m.Liquidity
+		return m.Pools
 	}
 	return nil
 }
 
-// ===================== MsgQueryClaimableFees
-type QueryClaimableFeesRequest struct {
-	PositionId uint64 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+func (m *QueryPoolsByLiquidityResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryClaimableFeesRequest) Reset()         { *m = QueryClaimableFeesRequest{} }
-func (m *QueryClaimableFeesRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryClaimableFeesRequest) ProtoMessage()    {}
-func (*QueryClaimableFeesRequest) Descriptor() ([]byte, []int) {
+// =============================== ModuleParams
+type QueryParamsRequest struct {
+}
+
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ce34c1e206115391, []int{14}
 }
-func (m *QueryClaimableFeesRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryClaimableFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryClaimableFeesRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -703,41 +785,34 @@ func (m *QueryClaimableFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryClaimableFeesRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryClaimableFeesRequest.Merge(m, src)
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
 }
-func (m *QueryClaimableFeesRequest) XXX_Size() int {
+func (m *QueryParamsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryClaimableFeesRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryClaimableFeesRequest.DiscardUnknown(m)
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryClaimableFeesRequest proto.InternalMessageInfo
-
-func (m *QueryClaimableFeesRequest) GetPositionId() uint64 {
-	if m != nil {
-		return m.PositionId
-	}
-	return 0
-}
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
 
-type QueryClaimableFeesResponse struct {
-	ClaimableFees []types2.Coin `protobuf:"bytes,1,rep,name=claimable_fees,json=claimableFees,proto3" json:"claimable_fees" yaml:"claimable_fees"`
+type QueryParamsResponse struct {
+	Params types3.Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (m *QueryClaimableFeesResponse) Reset()         { *m = QueryClaimableFeesResponse{} }
-func (m *QueryClaimableFeesResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryClaimableFeesResponse) ProtoMessage()    {}
-func (*QueryClaimableFeesResponse) Descriptor() ([]byte, []int) {
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_ce34c1e206115391, []int{15}
 }
-func (m *QueryClaimableFeesResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryClaimableFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryClaimableFeesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -747,1311 +822,14175 @@ func (m *QueryClaimableFeesResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryClaimableFeesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryClaimableFeesResponse.Merge(m, src)
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
 }
-func (m *QueryClaimableFeesResponse) XXX_Size() int {
+func (m *QueryParamsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryClaimableFeesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryClaimableFeesResponse.DiscardUnknown(m)
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryClaimableFeesResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
 
-func (m *QueryClaimableFeesResponse) GetClaimableFees() []types2.Coin {
+func (m *QueryParamsResponse) GetParams() types3.Params {
 	if m != nil {
-		return m.ClaimableFees
+		return m.Params
 	}
-	return nil
+	return types3.Params{}
 }
 
-func init() {
-	proto.RegisterType((*QueryUserPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryUserPositionsRequest")
-	proto.RegisterType((*QueryUserPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryUserPositionsResponse")
-	proto.RegisterType((*QueryPositionByIdRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPositionByIdRequest")
-	proto.RegisterType((*QueryPositionByIdResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPositionByIdResponse")
-	proto.RegisterType((*QueryPoolsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPoolsRequest")
-	proto.RegisterType((*QueryPoolsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPoolsResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*TickLiquidityNet)(nil), "osmosis.concentratedliquidity.v1beta1.TickLiquidityNet")
-	proto.RegisterType((*LiquidityDepthWithRange)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityDepthWithRange")
-	proto.RegisterType((*QueryLiquidityNetInDirectionRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryLiquidityNetInDirectionRequest")
-	proto.RegisterType((*QueryLiquidityNetInDirectionResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryLiquidityNetInDirectionResponse")
-	proto.RegisterType((*QueryTotalLiquidityForRangeRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryTotalLiquidityForRangeRequest")
-	proto.RegisterType((*QueryTotalLiquidityForRangeResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryTotalLiquidityForRangeResponse")
-	proto.RegisterType((*QueryClaimableFeesRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryClaimableFeesRequest")
-	proto.RegisterType((*QueryClaimableFeesResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryClaimableFeesResponse")
+type TickLiquidityNet struct {
+	LiquidityNet github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=liquidity_net,json=liquidityNet,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_net" yaml:"liquidity_net"`
+	TickIndex    github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=tick_index,json=tickIndex,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"tick_index" yaml:"tick"`
 }
 
-func init() {
-	proto.RegisterFile("osmosis/concentrated-liquidity/pool-model/query.proto", fileDescriptor_ce34c1e206115391)
+func (m *TickLiquidityNet) Reset()         { *m = TickLiquidityNet{} }
+func (m *TickLiquidityNet) String() string { return proto.CompactTextString(m) }
+func (*TickLiquidityNet) ProtoMessage()    {}
+func (*TickLiquidityNet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{16}
 }
-
-var fileDescriptor_ce34c1e206115391 = []byte{
-	// 1315 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xdf, 0x6e, 0x1b, 0xc5,
-	0x17, 0xce, 0xa6, 0x6d, 0x52, 0x8f, 0x93, 0x36, 0x99, 0xe6, 0xd7, 0x5f, 0x62, 0x81, 0x5d, 0x06,
-	0x5a, 0x22, 0x5a, 0xef, 0x2a, 0x81, 0x28, 0x10, 0x51, 0x48, 0x9c, 0x28, 0xad, 0x5b, 0x04, 0x74,
-	0x49, 0x85, 0x54, 0x90, 0x56, 0xfb, 0x67, 0xe2, 0xac, 0xbc, 0x9e, 0x71, 0x76, 0xc7, 0x49, 0x2d,
-	0xd4, 0x1b, 0xb8, 0x02, 0x09, 0x09, 0x09, 0x1e, 0x83, 0x2b, 0x84, 0x78, 0x86, 0xa8, 0x57, 0x91,
-	0x7a, 0x53, 0x21, 0x61, 0xa1, 0x84, 0x27, 0xf0, 0x1d, 0xe2, 0x06, 0xed, 0xec, 0xcc, 0xee, 0x3a,
-	0x71, 0x12, 0x3b, 0x09, 0x57, 0xf1, 0xee, 0x9c, 0xf3, 0x9d, 0xef, 0x3b, 0xe7, 0xcc, 0x99, 0xd9,
-	0x80, 0x39, 0x1a, 0xd4, 0x68, 0xe0, 0x06, 0x9a, 0x4d, 0x89, 0x8d, 0x09, 0xf3, 0x4d, 0x86, 0x9d,
-	0xa2, 0xe7, 0x6e, 0x36, 0x5c, 0xc7, 0x65, 0x4d, 0xad, 0x4e, 0xa9, 0x57, 0xac, 0x51, 0x07, 0x7b,
-	0xda, 0x66, 0x03, 0xfb, 0x4d, 0xb5, 0xee, 0x53, 0x46, 0xe1, 0x4d, 0xe1, 0xa6, 0xa6, 0xdd, 0x62,
-	0x2f, 0x75, 0x6b, 0xc6, 0xc2, 0xcc, 0x9c, 0xc9, 0x4d, 0x54, 0x68, 0x85, 0x72, 0x0f, 0x2d, 0xfc,
-	0x15, 0x39, 0xe7, 0x6e, 0x9f, 0x14, 0xd3, 0xf4, 0xcd, 0x5a, 0x20, 0x8c, 0xf3, 0x36, 0xb7, 0xd6,
-	0x2c, 0x33, 0xc0, 0x9a, 0xc0, 0xd5, 0x6c, 0xea, 0x12, 0xb1, 0xfe, 0x56, 0x7a, 0x9d, 0x53, 0x8c,
-	0xad, 0xea, 0x66, 0xc5, 0x25, 0x26, 0x73, 0xa9, 0xb4, 0x7d, 0xa5, 0x42, 0x69, 0xc5, 0xc3, 0x9a,
-	0x59, 0x77, 0x35, 0x93, 0x10, 0xca, 0xf8, 0xa2, 0x8c, 0x34, 0x25, 0x56, 0xf9, 0x93, 0xd5, 0x58,
-	0xd7, 0x4c, 0xd2, 0x94, 0x4b, 0x51, 0x10, 0x23, 0x92, 0x12, 0x3d, 0x88, 0xa5, 0xc2, 0x41, 0x2f,
-	0xe6, 0xd6, 0x70, 0xc0, 0xcc, 0x5a, 0x5d, 0x0a, 0x38, 0x68, 0xe0, 0x34, 0xfc, 0x34, 0xa9, 0xe2,
-	0x89, 0x15, 0x08, 0xdc, 0xc4, 0x1c, 0x6d, 0x81, 0xa9, 0x47, 0xa1, 0xca, 0xc7, 0x01, 0xf6, 0x3f,
-	0x15, 0x4b, 0x81, 0x8e, 0x37, 0x1b, 0x38, 0x60, 0xf0, 0x0e, 0x18, 0x36, 0x1d, 0xc7, 0xc7, 0x41,
-	0x30, 0xa9, 0xdc, 0x50, 0xa6, 0x33, 0x25, 0xd8, 0x6e, 0x15, 0xae, 0x34, 0xcd, 0x9a, 0xb7, 0x80,
-	0xc4, 0x02, 0xd2, 0xa5, 0x09, 0xbc, 0x0d, 0x86, 0xc3, 0xf2, 0x1a, 0xae, 0x33, 0x39, 0x78, 0x43,
-	0x99, 0xbe, 0x98, 0xb6, 0x16, 0x0b, 0x48, 0x1f, 0x0a, 0x7f, 0x95, 0x1d, 0xf4, 0xbd, 0x02, 0x72,
-	0xdd, 0x02, 0x07, 0x75, 0x4a, 0x02, 0x0c, 0x29, 0xc8, 0x48, 0xa2, 0x61, 0xec, 0x0b, 0xd3, 0xd9,
-	0xd9, 0x87, 0x6a, 0x4f, 0x4d, 0xa2, 0x4a, 0xb0, 0xcf, 0x5d, 0xb6, 0xf1, 0x98, 0x38, 0xd8, 0xf7,
-	0x9a, 0x2e, 0xa9, 0x2c, 0x05, 0x01, 0x66, 0x25, 0x1f, 0x9b, 0x55, 0x87, 0x6e, 0x93, 0xd2, 0xc5,
-	0x9d, 0x56, 0x61, 0x40, 0x4f, 0x62, 0xa0, 0xcf, 0xc0, 0x24, 0xa7, 0x23, 0xbd, 0x4b, 0xcd, 0xb2,
-	0x23, 0xd3, 0x30, 0x0f, 0xb2, 0xd2, 0x30, 0x14, 0xa7, 0x70, 0x71, 0xd7, 0xdb, 0xad, 0x02, 0x94,
-	0xe2, 0xe2, 0x45, 0xa4, 0x03, 0xf9, 0x54, 0x76, 0xd0, 0x77, 0x8a, 0xc8, 0x6e, 0x27, 0xaa, 0xd0,
-	0x58, 0x03, 0x97, 0xa5, 0x2d, 0xc7, 0xfc, 0x4f, 0x24, 0xc6, 0x21, 0xd0, 0x17, 0x60, 0x5c, 0x70,
-	0xa1, 0x5e, 0x5c, 0xe1, 0x55, 0x00, 0x92, 0xb6, 0xe6, 0x65, 0xcb, 0xce, 0xde, 0x52, 0x45, 0x47,
-	0x86, 0x7b, 0x40, 0x8d, 0xb6, 0x69, 0x1c, 0xd9, 0xac, 0x60, 0xe1, 0xab, 0xa7, 0x3c, 0xd1, 0x4f,
-	0x0a, 0x80, 0x69, 0x74, 0x21, 0x71, 0x0e, 0x5c, 0x0a, 0xeb, 0x2d, 0x4b, 0x38, 0xa1, 0x46, 0xcd,
-	0xab, 0xca, 0xe6, 0x55, 0x97, 0x48, 0xb3, 0x94, 0x79, 0xfe, 0x6b, 0xf1, 0x52, 0xe8, 0x57, 0xd6,
-	0x23, 0x6b, 0x78, 0xaf, 0x0b, 0xab, 0x37, 0x4f, 0x64, 0x15, 0xc5, 0xec, 0xa0, 0x35, 0x21, 0x59,
-	0xf1, 0x11, 0x20, 0x88, 0xa3, 0x27, 0xe0, 0x5a, 0xc7, 0x5b, 0x41, 0x76, 0x19, 0x0c, 0x45, 0xa3,
-	0x42, 0x54, 0xe3, 0xe6, 0x09, 0xd5, 0x88, 0xdc, 0x45, 0x9e, 0x85, 0x2b, 0xfa, 0x43, 0x01, 0x63,
-	0x6b, 0xae, 0x5d, 0xfd, 0x48, 0x9a, 0x7d, 0x8c, 0x19, 0xac, 0x82, 0xd1, 0xd8, 0xcd, 0x20, 0x98,
-	0x89, 0xdd, 0xb4, 0x1a, 0x7a, 0xfe, 0xde, 0x2a, 0xdc, 0xaa, 0xb8, 0x6c, 0xa3, 0x61, 0xa9, 0x36,
-	0xad, 0x89, 0x61, 0x20, 0xfe, 0x14, 0x03, 0xa7, 0xaa, 0xb1, 0x66, 0x1d, 0x07, 0xea, 0x0a, 0xb6,
-	0xdb, 0xad, 0xc2, 0x44, 0xd4, 0x70, 0x1d, 0x60, 0x48, 0x1f, 0xf1, 0xd2, 0xc1, 0xbe, 0x04, 0x80,
-	0xb9, 0x76, 0xd5, 0x70, 0x89, 0x83, 0x9f, 0xf2, 0xe4, 0x65, 0x4a, 0x77, 0xfb, 0x88, 0x54, 0x26,
-	0xac, 0xdd, 0x2a, 0x64, 0xa3, 0x48, 0x21, 0x12, 0xd2, 0x33, 0xe1, 0x9f, 0x72, 0x88, 0x87, 0x76,
-	0x06, 0xc1, 0xff, 0x63, 0x6d, 0x2b, 0xb8, 0xce, 0x36, 0xc2, 0x26, 0xd4, 0x4d, 0x52, 0xc1, 0x70,
-	0x13, 0x8c, 0x25, 0xcc, 0xcc, 0x1a, 0x6d, 0x90, 0xf3, 0x56, 0x7a, 0x35, 0x7e, 0x5e, 0xe2, 0xf0,
-	0xa1, 0x58, 0x8f, 0x6e, 0x63, 0xdf, 0x08, 0x19, 0x9e, 0x93, 0x58, 0x0e, 0x18, 0xd6, 0x30, 0x44,
-	0x6f, 0xd4, 0xeb, 0x12, 0xfd, 0xc2, 0xb9, 0xa0, 0x73, 0xc0, 0x10, 0x1d, 0x3d, 0x1f, 0x04, 0xaf,
-	0xf3, 0x3e, 0x4c, 0xf7, 0x4a, 0x99, 0xac, 0xb8, 0x3e, 0xb6, 0xc3, 0xee, 0x95, 0x7b, 0x34, 0x35,
-	0x57, 0x95, 0x93, 0xe6, 0x2a, 0x54, 0xc1, 0x65, 0x46, 0xab, 0x98, 0x18, 0x2e, 0x11, 0xe9, 0xb8,
-	0xd6, 0x6e, 0x15, 0xae, 0x0a, 0x0a, 0x62, 0x05, 0xe9, 0xc3, 0xfc, 0x67, 0x99, 0x40, 0x0b, 0x80,
-	0x80, 0x99, 0x3e, 0x4b, 0x4b, 0x5c, 0xde, 0x69, 0x15, 0x94, 0xbe, 0x24, 0x8e, 0x47, 0xf8, 0x09,
-	0x12, 0xd2, 0x33, 0xfc, 0x81, 0xa7, 0xd1, 0x02, 0xc0, 0xa2, 0x0d, 0xe2, 0x44, 0x31, 0x2e, 0x9e,
-	0x2d, 0x46, 0x82, 0x84, 0xf4, 0x0c, 0x7f, 0xe0, 0xc9, 0xfc, 0x79, 0x10, 0xbc, 0x71, 0x7c, 0x32,
-	0xc5, 0x2e, 0xdf, 0x48, 0x37, 0xa9, 0x13, 0x36, 0xb0, 0x9c, 0x4e, 0xf3, 0x3d, 0x4e, 0xdf, 0x83,
-	0xdb, 0x5b, 0x4c, 0x80, 0xa4, 0x37, 0xf9, 0xb6, 0x08, 0xe0, 0x6b, 0x60, 0xc4, 0x6e, 0xf8, 0x3e,
-	0x26, 0x2c, 0xe9, 0xce, 0x0b, 0x7a, 0x56, 0xbc, 0xe3, 0x99, 0xd9, 0x06, 0xe3, 0xd2, 0x24, 0xf6,
-	0x16, 0x45, 0x78, 0xd0, 0xf7, 0x96, 0x99, 0x8c, 0x12, 0x74, 0x08, 0x10, 0xe9, 0x63, 0xe2, 0x5d,
-	0xcc, 0x1a, 0x3d, 0x02, 0x88, 0x67, 0x6b, 0x8d, 0x32, 0xd3, 0x8b, 0x5f, 0xaf, 0x52, 0x9f, 0xef,
-	0xe4, 0xd3, 0x74, 0x1e, 0xfa, 0x56, 0x11, 0xed, 0x7c, 0x14, 0xa6, 0x28, 0x80, 0x05, 0x32, 0x89,
-	0xd6, 0x28, 0xf3, 0x1f, 0xf4, 0x98, 0xf9, 0x23, 0x06, 0x8f, 0x3c, 0xcd, 0x13, 0xc5, 0x6b, 0xe2,
-	0xdc, 0x5d, 0xf6, 0x4c, 0xb7, 0x66, 0x5a, 0x1e, 0x5e, 0xc5, 0x38, 0x38, 0xf3, 0x71, 0xfe, 0x4c,
-	0x5c, 0x59, 0x0e, 0xa0, 0x0a, 0x5d, 0x06, 0xb8, 0x62, 0xcb, 0x05, 0x63, 0x1d, 0x63, 0xd9, 0x56,
-	0x53, 0x1d, 0x07, 0x97, 0x94, 0xb2, 0x4c, 0x5d, 0x52, 0x7a, 0x35, 0xe4, 0xdd, 0x6e, 0x15, 0xfe,
-	0x27, 0x2a, 0xd7, 0xe1, 0x8e, 0xf4, 0x51, 0x3b, 0x1d, 0x68, 0xf6, 0x9f, 0x2c, 0xb8, 0xc4, 0xe3,
-	0xc3, 0x5f, 0x14, 0xc0, 0x0f, 0xcc, 0x00, 0xbe, 0xdb, 0x63, 0xe6, 0x0e, 0x9d, 0xfc, 0xb9, 0xf7,
-	0x4e, 0xe1, 0x19, 0x29, 0x45, 0xef, 0x7c, 0xfd, 0xe2, 0xaf, 0x1f, 0x07, 0x55, 0x78, 0x47, 0xeb,
-	0x76, 0xd7, 0x4c, 0xae, 0x9a, 0xf1, 0xc5, 0x99, 0x53, 0xfd, 0x4d, 0x01, 0x43, 0xd1, 0x91, 0x09,
-	0xfb, 0x8b, 0x9d, 0x3e, 0xbb, 0x73, 0x0b, 0xa7, 0x71, 0x15, 0xbc, 0xe7, 0x38, 0x6f, 0x0d, 0x16,
-	0x7b, 0xe5, 0x1d, 0xb1, 0x7d, 0xa9, 0x80, 0xd1, 0x8e, 0x5b, 0x2a, 0x5c, 0xec, 0x87, 0x44, 0xb7,
-	0x9b, 0x75, 0x6e, 0xe9, 0x0c, 0x08, 0x42, 0x4d, 0x89, 0xab, 0x79, 0x1f, 0x2e, 0xf4, 0x5c, 0x05,
-	0x81, 0xa0, 0x7d, 0x25, 0x6e, 0xec, 0xcf, 0xe0, 0xdf, 0x0a, 0xb8, 0xde, 0x7d, 0xbb, 0xc2, 0x72,
-	0x3f, 0x0c, 0x8f, 0x1d, 0x23, 0xb9, 0x07, 0xe7, 0x01, 0x25, 0x54, 0xdf, 0xe7, 0xaa, 0x4b, 0x70,
-	0xb1, 0x47, 0xd5, 0x2c, 0x84, 0x4b, 0x66, 0xa1, 0xb1, 0x4e, 0x7d, 0xc3, 0xe7, 0x02, 0xbf, 0x49,
-	0xdf, 0x64, 0x3a, 0x0f, 0x0b, 0xd8, 0x17, 0xe3, 0xe3, 0x8f, 0xef, 0xdc, 0xc3, 0x73, 0xc1, 0x12,
-	0xf2, 0x3f, 0xe1, 0xf2, 0xcb, 0xf0, 0x5e, 0x8f, 0xf2, 0xf9, 0x3d, 0xd9, 0xe8, 0xb8, 0x45, 0x19,
-	0x2e, 0x31, 0x9c, 0x58, 0xe9, 0x0b, 0x05, 0x8c, 0x76, 0xcc, 0xb3, 0xfe, 0x9a, 0xbb, 0xdb, 0x80,
-	0xed, 0xaf, 0xb9, 0xbb, 0x0e, 0x53, 0x74, 0x97, 0xeb, 0x9c, 0x87, 0x73, 0x3d, 0xea, 0xec, 0x1c,
-	0x9d, 0x70, 0x57, 0x01, 0x23, 0xe9, 0x6f, 0x2e, 0xf8, 0x61, 0x7f, 0xd3, 0xee, 0xd0, 0x37, 0x60,
-	0x6e, 0xf1, 0xf4, 0x00, 0xa7, 0x94, 0x14, 0x1f, 0x43, 0x56, 0xd3, 0x70, 0x9d, 0x92, 0xb5, 0xb3,
-	0x97, 0x57, 0x76, 0xf7, 0xf2, 0xca, 0x9f, 0x7b, 0x79, 0xe5, 0x87, 0xfd, 0xfc, 0xc0, 0xee, 0x7e,
-	0x7e, 0xe0, 0xe5, 0x7e, 0x7e, 0xe0, 0xc9, 0xfd, 0xd4, 0x0d, 0x41, 0x40, 0x17, 0x3d, 0xd3, 0x0a,
-	0xe2, 0x38, 0x5b, 0x33, 0x73, 0xda, 0xd3, 0xa3, 0xfe, 0x1f, 0xc0, 0x6f, 0x10, 0x51, 0x9f, 0x58,
-	0x43, 0xfc, 0xbb, 0xec, 0xed, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x6d, 0x64, 0x2f, 0x52, 0xc6,
-	0x11, 0x00, 0x00,
+func (m *TickLiquidityNet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TickLiquidityNet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TickLiquidityNet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TickLiquidityNet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TickLiquidityNet.Merge(m, src)
+}
+func (m *TickLiquidityNet) XXX_Size() int {
+	return m.Size()
+}
+func (m *TickLiquidityNet) XXX_DiscardUnknown() {
+	xxx_messageInfo_TickLiquidityNet.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_TickLiquidityNet proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Pools returns all concentrated liquidity pools
-	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
-	// Params returns concentrated liquidity module params.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// UserPositions returns all concentrated postitions of some address.
-	UserPositions(ctx context.Context, in *QueryUserPositionsRequest, opts ...grpc.CallOption) (*QueryUserPositionsResponse, error)
-	// TotalLiquidityForRange the amount of liquidity existing within given range.
-	TotalLiquidityForRange(ctx context.Context, in *QueryTotalLiquidityForRangeRequest, opts ...grpc.CallOption) (*QueryTotalLiquidityForRangeResponse, error)
-	// LiquidityNetInDirection returns liquidity net in the direction given.
-	// Uses the bound if specified, if not uses either min tick / max tick
-	// depending on the direction.
-	LiquidityNetInDirection(ctx context.Context, in *QueryLiquidityNetInDirectionRequest, opts ...grpc.CallOption) (*QueryLiquidityNetInDirectionResponse, error)
-	// ClaimableFees returns the amount of fees that can be claimed by a position
-	// with the given id.
-	ClaimableFees(ctx context.Context, in *QueryClaimableFeesRequest, opts ...grpc.CallOption) (*QueryClaimableFeesResponse, error)
-	// PositionById returns a position with the given id.
-	PositionById(ctx context.Context, in *QueryPositionByIdRequest, opts ...grpc.CallOption) (*QueryPositionByIdResponse, error)
+type LiquidityDepthWithRange struct {
+	LiquidityAmount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=liquidity_amount,json=liquidityAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_amount" yaml:"liquidity_net"`
+	LowerTick       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=lower_tick,json=lowerTick,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"lower_tick" yaml:"tick"`
+	UpperTick       github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=upper_tick,json=upperTick,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"upper_tick" yaml:"tick"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *LiquidityDepthWithRange) Reset()         { *m = LiquidityDepthWithRange{} }
+func (m *LiquidityDepthWithRange) String() string { return proto.CompactTextString(m) }
+func (*LiquidityDepthWithRange) ProtoMessage()    {}
+func (*LiquidityDepthWithRange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{17}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *LiquidityDepthWithRange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error) {
-	out := new(QueryPoolsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/Pools", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LiquidityDepthWithRange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LiquidityDepthWithRange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *LiquidityDepthWithRange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidityDepthWithRange.Merge(m, src)
 }
-
-func (c *queryClient) UserPositions(ctx context.Context, in *QueryUserPositionsRequest, opts ...grpc.CallOption) (*QueryUserPositionsResponse, error) {
-	out := new(QueryUserPositionsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/UserPositions", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *LiquidityDepthWithRange) XXX_Size() int {
+	return m.Size()
+}
+func (m *LiquidityDepthWithRange) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidityDepthWithRange.DiscardUnknown(m)
 }
 
-func (c *queryClient) TotalLiquidityForRange(ctx context.Context, in *QueryTotalLiquidityForRangeRequest, opts ...grpc.CallOption) (*QueryTotalLiquidityForRangeResponse, error) {
-	out := new(QueryTotalLiquidityForRangeResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/TotalLiquidityForRange", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_LiquidityDepthWithRange proto.InternalMessageInfo
 
-func (c *queryClient) LiquidityNetInDirection(ctx context.Context, in *QueryLiquidityNetInDirectionRequest, opts ...grpc.CallOption) (*QueryLiquidityNetInDirectionResponse, error) {
-	out := new(QueryLiquidityNetInDirectionResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityNetInDirection", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// =============================== LiquidityNetInDirection
+type QueryLiquidityNetInDirectionRequest struct {
+	PoolId    uint64                                  `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TokenIn   string                                  `protobuf:"bytes,2,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty" yaml:"token_in"`
+	StartTick *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=start_tick,json=startTick,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"start_tick,omitempty" yaml:"start_tick"`
+	BoundTick *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=bound_tick,json=boundTick,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"bound_tick,omitempty" yaml:"bound_tick"`
 }
 
-func (c *queryClient) ClaimableFees(ctx context.Context, in *QueryClaimableFeesRequest, opts ...grpc.CallOption) (*QueryClaimableFeesResponse, error) {
-	out := new(QueryClaimableFeesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableFees", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryLiquidityNetInDirectionRequest) Reset()         { *m = QueryLiquidityNetInDirectionRequest{} }
+func (m *QueryLiquidityNetInDirectionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityNetInDirectionRequest) ProtoMessage()    {}
+func (*QueryLiquidityNetInDirectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{18}
+}
+func (m *QueryLiquidityNetInDirectionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidityNetInDirectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityNetInDirectionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryLiquidityNetInDirectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityNetInDirectionRequest.Merge(m, src)
+}
+func (m *QueryLiquidityNetInDirectionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidityNetInDirectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityNetInDirectionRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) PositionById(ctx context.Context, in *QueryPositionByIdRequest, opts ...grpc.CallOption) (*QueryPositionByIdResponse, error) {
-	out := new(QueryPositionByIdResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PositionById", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLiquidityNetInDirectionRequest proto.InternalMessageInfo
+
+func (m *QueryLiquidityNetInDirectionRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Pools returns all concentrated liquidity pools
-	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
-	// Params returns concentrated liquidity module params.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// UserPositions returns all concentrated postitions of some address.
-	UserPositions(context.Context, *QueryUserPositionsRequest) (*QueryUserPositionsResponse, error)
-	// TotalLiquidityForRange the amount of liquidity existing within given range.
-	TotalLiquidityForRange(context.Context, *QueryTotalLiquidityForRangeRequest) (*QueryTotalLiquidityForRangeResponse, error)
-	// LiquidityNetInDirection returns liquidity net in the direction given.
-	// Uses the bound if specified, if not uses either min tick / max tick
-	// depending on the direction.
-	LiquidityNetInDirection(context.Context, *QueryLiquidityNetInDirectionRequest) (*QueryLiquidityNetInDirectionResponse, error)
-	// ClaimableFees returns the amount of fees that can be claimed by a position
-	// with the given id.
-	ClaimableFees(context.Context, *QueryClaimableFeesRequest) (*QueryClaimableFeesResponse, error)
-	// PositionById returns a position with the given id.
-	PositionById(context.Context, *QueryPositionByIdRequest) (*QueryPositionByIdResponse, error)
+func (m *QueryLiquidityNetInDirectionRequest) GetTokenIn() string {
+	if m != nil {
+		return m.TokenIn
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+type QueryLiquidityNetInDirectionResponse struct {
+	LiquidityDepths  []TickLiquidityNet                     `protobuf:"bytes,1,rep,name=liquidity_depths,json=liquidityDepths,proto3" json:"liquidity_depths"`
+	CurrentTick      int64                                  `protobuf:"varint,2,opt,name=current_tick,json=currentTick,proto3" json:"current_tick,omitempty"`
+	CurrentLiquidity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=current_liquidity,json=currentLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"current_liquidity" yaml:"current_liquidity"`
 }
 
-func (*UnimplementedQueryServer) Pools(ctx context.Context, req *QueryPoolsRequest) (*QueryPoolsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
-}
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryLiquidityNetInDirectionResponse) Reset()         { *m = QueryLiquidityNetInDirectionResponse{} }
+func (m *QueryLiquidityNetInDirectionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityNetInDirectionResponse) ProtoMessage()    {}
+func (*QueryLiquidityNetInDirectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{19}
 }
-func (*UnimplementedQueryServer) UserPositions(ctx context.Context, req *QueryUserPositionsRequest) (*QueryUserPositionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UserPositions not implemented")
+func (m *QueryLiquidityNetInDirectionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) TotalLiquidityForRange(ctx context.Context, req *QueryTotalLiquidityForRangeRequest) (*QueryTotalLiquidityForRangeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidityForRange not implemented")
+func (m *QueryLiquidityNetInDirectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityNetInDirectionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) LiquidityNetInDirection(ctx context.Context, req *QueryLiquidityNetInDirectionRequest) (*QueryLiquidityNetInDirectionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LiquidityNetInDirection not implemented")
+func (m *QueryLiquidityNetInDirectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityNetInDirectionResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ClaimableFees(ctx context.Context, req *QueryClaimableFeesRequest) (*QueryClaimableFeesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimableFees not implemented")
+func (m *QueryLiquidityNetInDirectionResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) PositionById(ctx context.Context, req *QueryPositionByIdRequest) (*QueryPositionByIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PositionById not implemented")
+func (m *QueryLiquidityNetInDirectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityNetInDirectionResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryLiquidityNetInDirectionResponse proto.InternalMessageInfo
 
-func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPoolsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Pools(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/Pools",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Pools(ctx, req.(*QueryPoolsRequest))
+func (m *QueryLiquidityNetInDirectionResponse) GetLiquidityDepths() []TickLiquidityNet {
+	if m != nil {
+		return m.LiquidityDepths
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryLiquidityNetInDirectionResponse) GetCurrentTick() int64 {
+	if m != nil {
+		return m.CurrentTick
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_UserPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryUserPositionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).UserPositions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/UserPositions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).UserPositions(ctx, req.(*QueryUserPositionsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// =============================== TotalLiquidityForRange
+type QueryTotalLiquidityForRangeRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-func _Query_TotalLiquidityForRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTotalLiquidityForRangeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TotalLiquidityForRange(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/TotalLiquidityForRange",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TotalLiquidityForRange(ctx, req.(*QueryTotalLiquidityForRangeRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryTotalLiquidityForRangeRequest) Reset()         { *m = QueryTotalLiquidityForRangeRequest{} }
+func (m *QueryTotalLiquidityForRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalLiquidityForRangeRequest) ProtoMessage()    {}
+func (*QueryTotalLiquidityForRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{20}
 }
-
-func _Query_LiquidityNetInDirection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryLiquidityNetInDirectionRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).LiquidityNetInDirection(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityNetInDirection",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).LiquidityNetInDirection(ctx, req.(*QueryLiquidityNetInDirectionRequest))
+func (m *QueryTotalLiquidityForRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalLiquidityForRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalLiquidityForRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryTotalLiquidityForRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalLiquidityForRangeRequest.Merge(m, src)
+}
+func (m *QueryTotalLiquidityForRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalLiquidityForRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalLiquidityForRangeRequest.DiscardUnknown(m)
 }
 
-func _Query_ClaimableFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClaimableFeesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClaimableFees(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableFees",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClaimableFees(ctx, req.(*QueryClaimableFeesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_QueryTotalLiquidityForRangeRequest proto.InternalMessageInfo
 
-func _Query_PositionById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryPositionByIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).PositionById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PositionById",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PositionById(ctx, req.(*QueryPositionByIdRequest))
+func (m *QueryTotalLiquidityForRangeRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.concentratedliquidity.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Pools",
-			Handler:    _Query_Pools_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "UserPositions",
-			Handler:    _Query_UserPositions_Handler,
-		},
-		{
-			MethodName: "TotalLiquidityForRange",
-			Handler:    _Query_TotalLiquidityForRange_Handler,
-		},
-		{
-			MethodName: "LiquidityNetInDirection",
-			Handler:    _Query_LiquidityNetInDirection_Handler,
-		},
-		{
-			MethodName: "ClaimableFees",
-			Handler:    _Query_ClaimableFees_Handler,
-		},
-		{
-			MethodName: "PositionById",
-			Handler:    _Query_PositionById_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/concentrated-liquidity/pool-model/query.proto",
+type QueryTotalLiquidityForRangeResponse struct {
+	Liquidity []LiquidityDepthWithRange `protobuf:"bytes,1,rep,name=liquidity,proto3" json:"liquidity"`
 }
 
-func (m *QueryUserPositionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryTotalLiquidityForRangeResponse) Reset()         { *m = QueryTotalLiquidityForRangeResponse{} }
+func (m *QueryTotalLiquidityForRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalLiquidityForRangeResponse) ProtoMessage()    {}
+func (*QueryTotalLiquidityForRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{21}
 }
-
-func (m *QueryUserPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryTotalLiquidityForRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryUserPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryTotalLiquidityForRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalLiquidityForRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTotalLiquidityForRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalLiquidityForRangeResponse.Merge(m, src)
+}
+func (m *QueryTotalLiquidityForRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalLiquidityForRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalLiquidityForRangeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryUserPositionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTotalLiquidityForRangeResponse proto.InternalMessageInfo
+
+func (m *QueryTotalLiquidityForRangeResponse) GetLiquidity() []LiquidityDepthWithRange {
+	if m != nil {
+		return m.Liquidity
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryUserPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// =============================== PositionSummary
+type QueryPositionSummaryRequest struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
 }
 
-func (m *QueryUserPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Positions) > 0 {
-		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryPositionSummaryRequest) Reset()         { *m = QueryPositionSummaryRequest{} }
+func (m *QueryPositionSummaryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionSummaryRequest) ProtoMessage()    {}
+func (*QueryPositionSummaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{22}
+}
+func (m *QueryPositionSummaryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionSummaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionSummaryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryPositionByIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryPositionSummaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionSummaryRequest.Merge(m, src)
 }
-
-func (m *QueryPositionByIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPositionSummaryRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryPositionByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PositionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *QueryPositionSummaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionSummaryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPositionByIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPositionSummaryRequest proto.InternalMessageInfo
+
+func (m *QueryPositionSummaryRequest) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryPositionByIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPositionSummaryResponse struct {
+	Position            model.PositionWithUnderlyingAssetBreakdown `protobuf:"bytes,1,opt,name=position,proto3" json:"position"`
+	ClaimableFees       []types.Coin                               `protobuf:"bytes,2,rep,name=claimable_fees,json=claimableFees,proto3" json:"claimable_fees" yaml:"claimable_fees"`
+	ClaimableIncentives []types.Coin                               `protobuf:"bytes,3,rep,name=claimable_incentives,json=claimableIncentives,proto3" json:"claimable_incentives" yaml:"claimable_incentives"`
+	ForfeitedIncentives []types.Coin                               `protobuf:"bytes,4,rep,name=forfeited_incentives,json=forfeitedIncentives,proto3" json:"forfeited_incentives" yaml:"forfeited_incentives"`
 }
 
-func (m *QueryPositionByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryPositionSummaryResponse) Reset()         { *m = QueryPositionSummaryResponse{} }
+func (m *QueryPositionSummaryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionSummaryResponse) ProtoMessage()    {}
+func (*QueryPositionSummaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{23}
+}
+func (m *QueryPositionSummaryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionSummaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionSummaryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryPositionSummaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionSummaryResponse.Merge(m, src)
+}
+func (m *QueryPositionSummaryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionSummaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionSummaryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryPoolsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPositionSummaryResponse proto.InternalMessageInfo
+
+func (m *QueryPositionSummaryResponse) GetPosition() model.PositionWithUnderlyingAssetBreakdown {
+	if m != nil {
+		return m.Position
 	}
-	return dAtA[:n], nil
+	return model.PositionWithUnderlyingAssetBreakdown{}
 }
 
-func (m *QueryPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryPositionSummaryResponse) GetClaimableFees() []types.Coin {
+	if m != nil {
+		return m.ClaimableFees
+	}
+	return nil
 }
 
-func (m *QueryPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func (m *QueryPositionSummaryResponse) GetClaimableIncentives() []types.Coin {
+	if m != nil {
+		return m.ClaimableIncentives
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryPoolsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryPositionSummaryResponse) GetForfeitedIncentives() []types.Coin {
+	if m != nil {
+		return m.ForfeitedIncentives
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ===================== MsgQueryClaimableFees
+type QueryClaimableFeesRequest struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
 }
 
-func (m *QueryPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Pools) > 0 {
-		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryClaimableFeesRequest) Reset()         { *m = QueryClaimableFeesRequest{} }
+func (m *QueryClaimableFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableFeesRequest) ProtoMessage()    {}
+func (*QueryClaimableFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{24}
+}
+func (m *QueryClaimableFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryClaimableFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableFeesRequest.Merge(m, src)
+}
+func (m *QueryClaimableFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableFeesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryClaimableFeesRequest proto.InternalMessageInfo
+
+func (m *QueryClaimableFeesRequest) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryClaimableFeesResponse struct {
+	ClaimableFees []types.Coin `protobuf:"bytes,1,rep,name=claimable_fees,json=claimableFees,proto3" json:"claimable_fees" yaml:"claimable_fees"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryClaimableFeesResponse) Reset()         { *m = QueryClaimableFeesResponse{} }
+func (m *QueryClaimableFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableFeesResponse) ProtoMessage()    {}
+func (*QueryClaimableFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{25}
+}
+func (m *QueryClaimableFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryClaimableFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableFeesResponse.Merge(m, src)
+}
+func (m *QueryClaimableFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableFeesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryClaimableFeesResponse proto.InternalMessageInfo
+
+func (m *QueryClaimableFeesResponse) GetClaimableFees() []types.Coin {
+	if m != nil {
+		return m.ClaimableFees
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ===================== MsgQueryClaimableIncentives
+type QueryClaimableIncentivesRequest struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryClaimableIncentivesRequest) Reset()         { *m = QueryClaimableIncentivesRequest{} }
+func (m *QueryClaimableIncentivesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableIncentivesRequest) ProtoMessage()    {}
+func (*QueryClaimableIncentivesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{26}
+}
+func (m *QueryClaimableIncentivesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableIncentivesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableIncentivesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryClaimableIncentivesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableIncentivesRequest.Merge(m, src)
+}
+func (m *QueryClaimableIncentivesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableIncentivesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableIncentivesRequest.DiscardUnknown(m)
 }
 
-func (m *TickLiquidityNet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryClaimableIncentivesRequest proto.InternalMessageInfo
+
+func (m *QueryClaimableIncentivesRequest) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TickLiquidityNet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryClaimableIncentivesResponse struct {
+	ClaimableIncentives []types.Coin `protobuf:"bytes,1,rep,name=claimable_incentives,json=claimableIncentives,proto3" json:"claimable_incentives" yaml:"claimable_incentives"`
+	ForfeitedIncentives []types.Coin `protobuf:"bytes,2,rep,name=forfeited_incentives,json=forfeitedIncentives,proto3" json:"forfeited_incentives" yaml:"forfeited_incentives"`
 }
 
-func (m *TickLiquidityNet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TickIndex.Size()
-		i -= size
-		if _, err := m.TickIndex.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryClaimableIncentivesResponse) Reset()         { *m = QueryClaimableIncentivesResponse{} }
+func (m *QueryClaimableIncentivesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClaimableIncentivesResponse) ProtoMessage()    {}
+func (*QueryClaimableIncentivesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{27}
+}
+func (m *QueryClaimableIncentivesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClaimableIncentivesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClaimableIncentivesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.LiquidityNet.Size()
-		i -= size
-		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+}
+func (m *QueryClaimableIncentivesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClaimableIncentivesResponse.Merge(m, src)
+}
+func (m *QueryClaimableIncentivesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClaimableIncentivesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClaimableIncentivesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClaimableIncentivesResponse proto.InternalMessageInfo
+
+func (m *QueryClaimableIncentivesResponse) GetClaimableIncentives() []types.Coin {
+	if m != nil {
+		return m.ClaimableIncentives
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *LiquidityDepthWithRange) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryClaimableIncentivesResponse) GetForfeitedIncentives() []types.Coin {
+	if m != nil {
+		return m.ForfeitedIncentives
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *LiquidityDepthWithRange) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// =============================== NextInitializedTick
+type QueryNextInitializedTickRequest struct {
+	PoolId    uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	StartTick int64  `protobuf:"varint,2,opt,name=start_tick,json=startTick,proto3" json:"start_tick,omitempty" yaml:"start_tick"`
+	// zero_for_one searches below the start tick, including the start tick
+	// itself, when true. Otherwise, searches strictly above the start tick.
+	ZeroForOne bool `protobuf:"varint,3,opt,name=zero_for_one,json=zeroForOne,proto3" json:"zero_for_one,omitempty" yaml:"zero_for_one"`
 }
 
-func (m *LiquidityDepthWithRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.UpperTick.Size()
-		i -= size
-		if _, err := m.UpperTick.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryNextInitializedTickRequest) Reset()         { *m = QueryNextInitializedTickRequest{} }
+func (m *QueryNextInitializedTickRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNextInitializedTickRequest) ProtoMessage()    {}
+func (*QueryNextInitializedTickRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{28}
+}
+func (m *QueryNextInitializedTickRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextInitializedTickRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextInitializedTickRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.LowerTick.Size()
-		i -= size
-		if _, err := m.LowerTick.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+}
+func (m *QueryNextInitializedTickRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextInitializedTickRequest.Merge(m, src)
+}
+func (m *QueryNextInitializedTickRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextInitializedTickRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextInitializedTickRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextInitializedTickRequest proto.InternalMessageInfo
+
+func (m *QueryNextInitializedTickRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.LiquidityAmount.Size()
-		i -= size
-		if _, err := m.LiquidityAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *QueryNextInitializedTickRequest) GetStartTick() int64 {
+	if m != nil {
+		return m.StartTick
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryLiquidityNetInDirectionRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryNextInitializedTickRequest) GetZeroForOne() bool {
+	if m != nil {
+		return m.ZeroForOne
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryLiquidityNetInDirectionRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryNextInitializedTickResponse struct {
+	TickIndex    int64                                  `protobuf:"varint,1,opt,name=tick_index,json=tickIndex,proto3" json:"tick_index,omitempty" yaml:"tick_index"`
+	LiquidityNet github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=liquidity_net,json=liquidityNet,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_net" yaml:"liquidity_net"`
+	// found is false if there is no initialized tick in the given direction.
+	Found bool `protobuf:"varint,3,opt,name=found,proto3" json:"found,omitempty" yaml:"found"`
 }
 
-func (m *QueryLiquidityNetInDirectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BoundTick != nil {
-		{
-			size := m.BoundTick.Size()
-			i -= size
-			if _, err := m.BoundTick.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.StartTick != nil {
-		{
-			size := m.StartTick.Size()
-			i -= size
-			if _, err := m.StartTick.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryNextInitializedTickResponse) Reset()         { *m = QueryNextInitializedTickResponse{} }
+func (m *QueryNextInitializedTickResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNextInitializedTickResponse) ProtoMessage()    {}
+func (*QueryNextInitializedTickResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{29}
+}
+func (m *QueryNextInitializedTickResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNextInitializedTickResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNextInitializedTickResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.TokenIn) > 0 {
-		i -= len(m.TokenIn)
-		copy(dAtA[i:], m.TokenIn)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *QueryNextInitializedTickResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNextInitializedTickResponse.Merge(m, src)
+}
+func (m *QueryNextInitializedTickResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNextInitializedTickResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNextInitializedTickResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNextInitializedTickResponse proto.InternalMessageInfo
+
+func (m *QueryNextInitializedTickResponse) GetTickIndex() int64 {
+	if m != nil {
+		return m.TickIndex
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryLiquidityNetInDirectionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryNextInitializedTickResponse) GetFound() bool {
+	if m != nil {
+		return m.Found
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryLiquidityNetInDirectionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// =============================== FeeRevenue
+type QueryFeeRevenueRequest struct {
+	PoolId    uint64    `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	StartTime time.Time `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
 }
 
-func (m *QueryLiquidityNetInDirectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.CurrentLiquidity.Size()
-		i -= size
-		if _, err := m.CurrentLiquidity.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if m.CurrentTick != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentTick))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.LiquidityDepths) > 0 {
-		for iNdEx := len(m.LiquidityDepths) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.LiquidityDepths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryFeeRevenueRequest) Reset()         { *m = QueryFeeRevenueRequest{} }
+func (m *QueryFeeRevenueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeRevenueRequest) ProtoMessage()    {}
+func (*QueryFeeRevenueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{30}
+}
+func (m *QueryFeeRevenueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeRevenueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeRevenueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryTotalLiquidityForRangeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryFeeRevenueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeRevenueRequest.Merge(m, src)
 }
-
-func (m *QueryTotalLiquidityForRangeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryFeeRevenueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeRevenueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeRevenueRequest.DiscardUnknown(m)
 }
 
-func (m *QueryTotalLiquidityForRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryFeeRevenueRequest proto.InternalMessageInfo
+
+func (m *QueryFeeRevenueRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryTotalLiquidityForRangeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryFeeRevenueRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *QueryTotalLiquidityForRangeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryFeeRevenueResponse struct {
+	FeeRevenue github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=fee_revenue,json=feeRevenue,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"fee_revenue" yaml:"fee_revenue"`
 }
 
-func (m *QueryTotalLiquidityForRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Liquidity) > 0 {
-		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryFeeRevenueResponse) Reset()         { *m = QueryFeeRevenueResponse{} }
+func (m *QueryFeeRevenueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeRevenueResponse) ProtoMessage()    {}
+func (*QueryFeeRevenueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{31}
+}
+func (m *QueryFeeRevenueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeRevenueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeRevenueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryFeeRevenueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeRevenueResponse.Merge(m, src)
+}
+func (m *QueryFeeRevenueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeRevenueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeRevenueResponse.DiscardUnknown(m)
 }
 
-func (m *QueryClaimableFeesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFeeRevenueResponse proto.InternalMessageInfo
+
+func (m *QueryFeeRevenueResponse) GetFeeRevenue() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.FeeRevenue
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryClaimableFeesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// =============================== PoolFeeAccumulator
+type QueryPoolFeeAccumulatorRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-func (m *QueryClaimableFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.PositionId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryPoolFeeAccumulatorRequest) Reset()         { *m = QueryPoolFeeAccumulatorRequest{} }
+func (m *QueryPoolFeeAccumulatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolFeeAccumulatorRequest) ProtoMessage()    {}
+func (*QueryPoolFeeAccumulatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{32}
+}
+func (m *QueryPoolFeeAccumulatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolFeeAccumulatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolFeeAccumulatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPoolFeeAccumulatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolFeeAccumulatorRequest.Merge(m, src)
+}
+func (m *QueryPoolFeeAccumulatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolFeeAccumulatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolFeeAccumulatorRequest.DiscardUnknown(m)
 }
 
-func (m *QueryClaimableFeesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPoolFeeAccumulatorRequest proto.InternalMessageInfo
+
+func (m *QueryPoolFeeAccumulatorRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryClaimableFeesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryPoolFeeAccumulatorResponse struct {
+	FeeGrowthGlobal github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=fee_growth_global,json=feeGrowthGlobal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"fee_growth_global" yaml:"fee_growth_global"`
 }
 
-func (m *QueryClaimableFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClaimableFees) > 0 {
-		for iNdEx := len(m.ClaimableFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ClaimableFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryPoolFeeAccumulatorResponse) Reset()         { *m = QueryPoolFeeAccumulatorResponse{} }
+func (m *QueryPoolFeeAccumulatorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolFeeAccumulatorResponse) ProtoMessage()    {}
+func (*QueryPoolFeeAccumulatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{33}
+}
+func (m *QueryPoolFeeAccumulatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolFeeAccumulatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolFeeAccumulatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryPoolFeeAccumulatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolFeeAccumulatorResponse.Merge(m, src)
+}
+func (m *QueryPoolFeeAccumulatorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolFeeAccumulatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolFeeAccumulatorResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryPoolFeeAccumulatorResponse proto.InternalMessageInfo
+
+func (m *QueryPoolFeeAccumulatorResponse) GetFeeGrowthGlobal() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.FeeGrowthGlobal
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryUserPositionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
-	}
-	return n
+
+// =============================== PoolParams
+type QueryPoolParamsRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
-func (m *QueryUserPositionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Positions) > 0 {
-		for _, e := range m.Positions {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPoolParamsRequest) Reset()         { *m = QueryPoolParamsRequest{} }
+func (m *QueryPoolParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolParamsRequest) ProtoMessage()    {}
+func (*QueryPoolParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{34}
+}
+func (m *QueryPoolParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
 }
-
-func (m *QueryPositionByIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PositionId != 0 {
-		n += 1 + sovQuery(uint64(m.PositionId))
-	}
-	return n
+func (m *QueryPoolParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolParamsRequest.Merge(m, src)
+}
+func (m *QueryPoolParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolParamsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryPositionByIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryPoolParamsRequest proto.InternalMessageInfo
+
+func (m *QueryPoolParamsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	var l int
-	_ = l
-	l = m.Position.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryPoolsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryPoolParamsResponse struct {
+	TickSpacing        uint64                                 `protobuf:"varint,1,opt,name=tick_spacing,json=tickSpacing,proto3" json:"tick_spacing,omitempty" yaml:"tick_spacing"`
+	ExponentAtPriceOne github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=exponent_at_price_one,json=exponentAtPriceOne,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"exponent_at_price_one" yaml:"exponent_at_price_one"`
+	SwapFee            github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
+	CurrentTick        github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=current_tick,json=currentTick,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"current_tick" yaml:"current_tick"`
+	CurrentSqrtPrice   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=current_sqrt_price,json=currentSqrtPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"current_sqrt_price" yaml:"current_sqrt_price"`
 }
 
-func (m *QueryPoolsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Pools) > 0 {
-		for _, e := range m.Pools {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPoolParamsResponse) Reset()         { *m = QueryPoolParamsResponse{} }
+func (m *QueryPoolParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolParamsResponse) ProtoMessage()    {}
+func (*QueryPoolParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{35}
+}
+func (m *QueryPoolParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryPoolParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolParamsResponse.Merge(m, src)
+}
+func (m *QueryPoolParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolParamsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryPoolParamsResponse proto.InternalMessageInfo
+
+func (m *QueryPoolParamsResponse) GetTickSpacing() uint64 {
+	if m != nil {
+		return m.TickSpacing
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// =============================== PositionApr
+type QueryPositionAprRequest struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
 }
 
-func (m *TickLiquidityNet) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryPositionAprRequest) Reset()         { *m = QueryPositionAprRequest{} }
+func (m *QueryPositionAprRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionAprRequest) ProtoMessage()    {}
+func (*QueryPositionAprRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{36}
+}
+func (m *QueryPositionAprRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionAprRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionAprRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.LiquidityNet.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.TickIndex.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryPositionAprRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionAprRequest.Merge(m, src)
+}
+func (m *QueryPositionAprRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionAprRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionAprRequest.DiscardUnknown(m)
 }
 
-func (m *LiquidityDepthWithRange) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryPositionAprRequest proto.InternalMessageInfo
+
+func (m *QueryPositionAprRequest) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
 	}
-	var l int
-	_ = l
-	l = m.LiquidityAmount.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.LowerTick.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.UpperTick.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryLiquidityNetInDirectionRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+type QueryPositionAprResponse struct {
+	// apr is the projected annual return over the position's current value,
+	// both priced in token1 at the current spot price.
+	Apr github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=apr,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"apr" yaml:"apr"`
+	// annualized_fees are the fees the position is projected to earn in a year.
+	AnnualizedFees github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=annualized_fees,json=annualizedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"annualized_fees" yaml:"annualized_fees"`
+	// annualized_incentives are the incentives the position is projected to
+	// earn in a year, including those in denoms that cannot be priced.
+	AnnualizedIncentives github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=annualized_incentives,json=annualizedIncentives,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"annualized_incentives" yaml:"annualized_incentives"`
+}
+
+func (m *QueryPositionAprResponse) Reset()         { *m = QueryPositionAprResponse{} }
+func (m *QueryPositionAprResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionAprResponse) ProtoMessage()    {}
+func (*QueryPositionAprResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{37}
+}
+func (m *QueryPositionAprResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionAprResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionAprResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryPositionAprResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionAprResponse.Merge(m, src)
+}
+func (m *QueryPositionAprResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionAprResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionAprResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPositionAprResponse proto.InternalMessageInfo
+
+func (m *QueryPositionAprResponse) GetAnnualizedFees() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.AnnualizedFees
 	}
-	if m.StartTick != nil {
-		l = m.StartTick.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *QueryPositionAprResponse) GetAnnualizedIncentives() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.AnnualizedIncentives
 	}
-	if m.BoundTick != nil {
-		l = m.BoundTick.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// =============================== PoolIncentiveRecords
+type QueryPoolIncentiveRecordsRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *QueryPoolIncentiveRecordsRequest) Reset()         { *m = QueryPoolIncentiveRecordsRequest{} }
+func (m *QueryPoolIncentiveRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolIncentiveRecordsRequest) ProtoMessage()    {}
+func (*QueryPoolIncentiveRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{38}
+}
+func (m *QueryPoolIncentiveRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolIncentiveRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolIncentiveRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryPoolIncentiveRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolIncentiveRecordsRequest.Merge(m, src)
+}
+func (m *QueryPoolIncentiveRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolIncentiveRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolIncentiveRecordsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryLiquidityNetInDirectionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryPoolIncentiveRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryPoolIncentiveRecordsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	var l int
-	_ = l
-	if len(m.LiquidityDepths) > 0 {
-		for _, e := range m.LiquidityDepths {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return 0
+}
+
+type PoolIncentiveRecord struct {
+	// incentive_record holds the record's remaining amount, emission rate per
+	// second, start time and min uptime, as of the current block time.
+	IncentiveRecord types3.IncentiveRecord `protobuf:"bytes,1,opt,name=incentive_record,json=incentiveRecord,proto3" json:"incentive_record" yaml:"incentive_record"`
+	// exhausted_at is the time at which the record's remaining amount will have
+	// been emitted, assuming it keeps emitting at its emission rate.
+	ExhaustedAt time.Time `protobuf:"bytes,2,opt,name=exhausted_at,json=exhaustedAt,proto3,stdtime" json:"exhausted_at" yaml:"exhausted_at"`
+}
+
+func (m *PoolIncentiveRecord) Reset()         { *m = PoolIncentiveRecord{} }
+func (m *PoolIncentiveRecord) String() string { return proto.CompactTextString(m) }
+func (*PoolIncentiveRecord) ProtoMessage()    {}
+func (*PoolIncentiveRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{39}
+}
+func (m *PoolIncentiveRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolIncentiveRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolIncentiveRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.CurrentTick != 0 {
-		n += 1 + sovQuery(uint64(m.CurrentTick))
+}
+func (m *PoolIncentiveRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolIncentiveRecord.Merge(m, src)
+}
+func (m *PoolIncentiveRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolIncentiveRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolIncentiveRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolIncentiveRecord proto.InternalMessageInfo
+
+func (m *PoolIncentiveRecord) GetIncentiveRecord() types3.IncentiveRecord {
+	if m != nil {
+		return m.IncentiveRecord
 	}
-	l = m.CurrentLiquidity.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return types3.IncentiveRecord{}
 }
 
-func (m *QueryTotalLiquidityForRangeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *PoolIncentiveRecord) GetExhaustedAt() time.Time {
+	if m != nil {
+		return m.ExhaustedAt
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovQuery(uint64(m.PoolId))
+	return time.Time{}
+}
+
+type QueryPoolIncentiveRecordsResponse struct {
+	IncentiveRecords []PoolIncentiveRecord `protobuf:"bytes,1,rep,name=incentive_records,json=incentiveRecords,proto3" json:"incentive_records" yaml:"incentive_records"`
+}
+
+func (m *QueryPoolIncentiveRecordsResponse) Reset()         { *m = QueryPoolIncentiveRecordsResponse{} }
+func (m *QueryPoolIncentiveRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolIncentiveRecordsResponse) ProtoMessage()    {}
+func (*QueryPoolIncentiveRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{40}
+}
+func (m *QueryPoolIncentiveRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolIncentiveRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolIncentiveRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryPoolIncentiveRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolIncentiveRecordsResponse.Merge(m, src)
+}
+func (m *QueryPoolIncentiveRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolIncentiveRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolIncentiveRecordsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTotalLiquidityForRangeResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryPoolIncentiveRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryPoolIncentiveRecordsResponse) GetIncentiveRecords() []PoolIncentiveRecord {
+	if m != nil {
+		return m.IncentiveRecords
 	}
-	var l int
-	_ = l
-	if len(m.Liquidity) > 0 {
-		for _, e := range m.Liquidity {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// =============================== PoolsForDenomPair
+type QueryPoolsForDenomPairRequest struct {
+	DenomA string `protobuf:"bytes,1,opt,name=denom_a,json=denomA,proto3" json:"denom_a,omitempty" yaml:"denom_a"`
+	DenomB string `protobuf:"bytes,2,opt,name=denom_b,json=denomB,proto3" json:"denom_b,omitempty" yaml:"denom_b"`
+}
+
+func (m *QueryPoolsForDenomPairRequest) Reset()         { *m = QueryPoolsForDenomPairRequest{} }
+func (m *QueryPoolsForDenomPairRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsForDenomPairRequest) ProtoMessage()    {}
+func (*QueryPoolsForDenomPairRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{41}
+}
+func (m *QueryPoolsForDenomPairRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsForDenomPairRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsForDenomPairRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolsForDenomPairRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsForDenomPairRequest.Merge(m, src)
+}
+func (m *QueryPoolsForDenomPairRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolsForDenomPairRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsForDenomPairRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolsForDenomPairRequest proto.InternalMessageInfo
+
+func (m *QueryPoolsForDenomPairRequest) GetDenomA() string {
+	if m != nil {
+		return m.DenomA
+	}
+	return ""
+}
+
+func (m *QueryPoolsForDenomPairRequest) GetDenomB() string {
+	if m != nil {
+		return m.DenomB
+	}
+	return ""
+}
+
+type QueryPoolsForDenomPairResponse struct {
+	PoolIds []uint64 `protobuf:"varint,1,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty" yaml:"pool_ids"`
+}
+
+func (m *QueryPoolsForDenomPairResponse) Reset()         { *m = QueryPoolsForDenomPairResponse{} }
+func (m *QueryPoolsForDenomPairResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsForDenomPairResponse) ProtoMessage()    {}
+func (*QueryPoolsForDenomPairResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{42}
+}
+func (m *QueryPoolsForDenomPairResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsForDenomPairResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsForDenomPairResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolsForDenomPairResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsForDenomPairResponse.Merge(m, src)
+}
+func (m *QueryPoolsForDenomPairResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolsForDenomPairResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsForDenomPairResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolsForDenomPairResponse proto.InternalMessageInfo
+
+func (m *QueryPoolsForDenomPairResponse) GetPoolIds() []uint64 {
+	if m != nil {
+		return m.PoolIds
+	}
+	return nil
+}
+
+// =============================== PoolsForPairWithFees
+type QueryPoolsForPairWithFeesRequest struct {
+	DenomA string `protobuf:"bytes,1,opt,name=denom_a,json=denomA,proto3" json:"denom_a,omitempty" yaml:"denom_a"`
+	DenomB string `protobuf:"bytes,2,opt,name=denom_b,json=denomB,proto3" json:"denom_b,omitempty" yaml:"denom_b"`
+}
+
+func (m *QueryPoolsForPairWithFeesRequest) Reset()         { *m = QueryPoolsForPairWithFeesRequest{} }
+func (m *QueryPoolsForPairWithFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsForPairWithFeesRequest) ProtoMessage()    {}
+func (*QueryPoolsForPairWithFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{43}
+}
+func (m *QueryPoolsForPairWithFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsForPairWithFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsForPairWithFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolsForPairWithFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsForPairWithFeesRequest.Merge(m, src)
+}
+func (m *QueryPoolsForPairWithFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolsForPairWithFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsForPairWithFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolsForPairWithFeesRequest proto.InternalMessageInfo
+
+func (m *QueryPoolsForPairWithFeesRequest) GetDenomA() string {
+	if m != nil {
+		return m.DenomA
+	}
+	return ""
+}
+
+func (m *QueryPoolsForPairWithFeesRequest) GetDenomB() string {
+	if m != nil {
+		return m.DenomB
+	}
+	return ""
+}
+
+type PoolFeeTier struct {
+	PoolId  uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
+	// liquidity is the liquidity active at the pool's current tick.
+	Liquidity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=liquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity" yaml:"liquidity"`
+}
+
+func (m *PoolFeeTier) Reset()         { *m = PoolFeeTier{} }
+func (m *PoolFeeTier) String() string { return proto.CompactTextString(m) }
+func (*PoolFeeTier) ProtoMessage()    {}
+func (*PoolFeeTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{44}
+}
+func (m *PoolFeeTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolFeeTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolFeeTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolFeeTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolFeeTier.Merge(m, src)
+}
+func (m *PoolFeeTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolFeeTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolFeeTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolFeeTier proto.InternalMessageInfo
+
+func (m *PoolFeeTier) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type QueryPoolsForPairWithFeesResponse struct {
+	Pools []PoolFeeTier `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools" yaml:"pools"`
+}
+
+func (m *QueryPoolsForPairWithFeesResponse) Reset()         { *m = QueryPoolsForPairWithFeesResponse{} }
+func (m *QueryPoolsForPairWithFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolsForPairWithFeesResponse) ProtoMessage()    {}
+func (*QueryPoolsForPairWithFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{45}
+}
+func (m *QueryPoolsForPairWithFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolsForPairWithFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolsForPairWithFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolsForPairWithFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolsForPairWithFeesResponse.Merge(m, src)
+}
+func (m *QueryPoolsForPairWithFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolsForPairWithFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolsForPairWithFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolsForPairWithFeesResponse proto.InternalMessageInfo
+
+func (m *QueryPoolsForPairWithFeesResponse) GetPools() []PoolFeeTier {
+	if m != nil {
+		return m.Pools
+	}
+	return nil
+}
+
+// =============================== LiquidityWeightedTick
+type QueryLiquidityWeightedTickRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *QueryLiquidityWeightedTickRequest) Reset()         { *m = QueryLiquidityWeightedTickRequest{} }
+func (m *QueryLiquidityWeightedTickRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityWeightedTickRequest) ProtoMessage()    {}
+func (*QueryLiquidityWeightedTickRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{46}
+}
+func (m *QueryLiquidityWeightedTickRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidityWeightedTickRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityWeightedTickRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiquidityWeightedTickRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityWeightedTickRequest.Merge(m, src)
+}
+func (m *QueryLiquidityWeightedTickRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidityWeightedTickRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityWeightedTickRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiquidityWeightedTickRequest proto.InternalMessageInfo
+
+func (m *QueryLiquidityWeightedTickRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type QueryLiquidityWeightedTickResponse struct {
+	Tick      int64                                  `protobuf:"varint,1,opt,name=tick,proto3" json:"tick,omitempty" yaml:"tick"`
+	SpotPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=spot_price,json=spotPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"spot_price" yaml:"spot_price"`
+}
+
+func (m *QueryLiquidityWeightedTickResponse) Reset()         { *m = QueryLiquidityWeightedTickResponse{} }
+func (m *QueryLiquidityWeightedTickResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityWeightedTickResponse) ProtoMessage()    {}
+func (*QueryLiquidityWeightedTickResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{47}
+}
+func (m *QueryLiquidityWeightedTickResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidityWeightedTickResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityWeightedTickResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiquidityWeightedTickResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityWeightedTickResponse.Merge(m, src)
+}
+func (m *QueryLiquidityWeightedTickResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidityWeightedTickResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityWeightedTickResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiquidityWeightedTickResponse proto.InternalMessageInfo
+
+func (m *QueryLiquidityWeightedTickResponse) GetTick() int64 {
+	if m != nil {
+		return m.Tick
+	}
+	return 0
+}
+
+// =============================== SimulateSwapExactAmountIn
+type QuerySimulateSwapExactAmountInRequest struct {
+	PoolId        uint64     `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TokenIn       types.Coin `protobuf:"bytes,2,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	TokenOutDenom string     `protobuf:"bytes,3,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+}
+
+func (m *QuerySimulateSwapExactAmountInRequest) Reset()         { *m = QuerySimulateSwapExactAmountInRequest{} }
+func (m *QuerySimulateSwapExactAmountInRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateSwapExactAmountInRequest) ProtoMessage()    {}
+func (*QuerySimulateSwapExactAmountInRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{48}
+}
+func (m *QuerySimulateSwapExactAmountInRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateSwapExactAmountInRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateSwapExactAmountInRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateSwapExactAmountInRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateSwapExactAmountInRequest.Merge(m, src)
+}
+func (m *QuerySimulateSwapExactAmountInRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateSwapExactAmountInRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateSwapExactAmountInRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateSwapExactAmountInRequest proto.InternalMessageInfo
+
+func (m *QuerySimulateSwapExactAmountInRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *QuerySimulateSwapExactAmountInRequest) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *QuerySimulateSwapExactAmountInRequest) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+type QuerySimulateSwapExactAmountInResponse struct {
+	AmountOut types.Coin `protobuf:"bytes,1,opt,name=amount_out,json=amountOut,proto3" json:"amount_out" yaml:"amount_out"`
+	// fee_charged is the portion of the amount in charged as a swap fee.
+	FeeCharged types.Coin `protobuf:"bytes,2,opt,name=fee_charged,json=feeCharged,proto3" json:"fee_charged" yaml:"fee_charged"`
+	// amount_in_after_fee is the portion of the amount in that is swapped. It
+	// adds up to the amount in consumed by the swap with fee_charged.
+	AmountInAfterFee types.Coin `protobuf:"bytes,3,opt,name=amount_in_after_fee,json=amountInAfterFee,proto3" json:"amount_in_after_fee" yaml:"amount_in_after_fee"`
+}
+
+func (m *QuerySimulateSwapExactAmountInResponse) Reset() {
+	*m = QuerySimulateSwapExactAmountInResponse{}
+}
+func (m *QuerySimulateSwapExactAmountInResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySimulateSwapExactAmountInResponse) ProtoMessage()    {}
+func (*QuerySimulateSwapExactAmountInResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{49}
+}
+func (m *QuerySimulateSwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySimulateSwapExactAmountInResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySimulateSwapExactAmountInResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySimulateSwapExactAmountInResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySimulateSwapExactAmountInResponse.Merge(m, src)
+}
+func (m *QuerySimulateSwapExactAmountInResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySimulateSwapExactAmountInResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySimulateSwapExactAmountInResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySimulateSwapExactAmountInResponse proto.InternalMessageInfo
+
+func (m *QuerySimulateSwapExactAmountInResponse) GetAmountOut() types.Coin {
+	if m != nil {
+		return m.AmountOut
+	}
+	return types.Coin{}
+}
+
+func (m *QuerySimulateSwapExactAmountInResponse) GetFeeCharged() types.Coin {
+	if m != nil {
+		return m.FeeCharged
+	}
+	return types.Coin{}
+}
+
+func (m *QuerySimulateSwapExactAmountInResponse) GetAmountInAfterFee() types.Coin {
+	if m != nil {
+		return m.AmountInAfterFee
+	}
+	return types.Coin{}
+}
+
+// =============================== ProtocolFees
+type QueryProtocolFeesRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *QueryProtocolFeesRequest) Reset()         { *m = QueryProtocolFeesRequest{} }
+func (m *QueryProtocolFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProtocolFeesRequest) ProtoMessage()    {}
+func (*QueryProtocolFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{50}
+}
+func (m *QueryProtocolFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProtocolFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProtocolFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProtocolFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProtocolFeesRequest.Merge(m, src)
+}
+func (m *QueryProtocolFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProtocolFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProtocolFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProtocolFeesRequest proto.InternalMessageInfo
+
+func (m *QueryProtocolFeesRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type QueryProtocolFeesResponse struct {
+	ProtocolFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=protocol_fees,json=protocolFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"protocol_fees" yaml:"protocol_fees"`
+}
+
+func (m *QueryProtocolFeesResponse) Reset()         { *m = QueryProtocolFeesResponse{} }
+func (m *QueryProtocolFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProtocolFeesResponse) ProtoMessage()    {}
+func (*QueryProtocolFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{51}
+}
+func (m *QueryProtocolFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProtocolFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProtocolFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProtocolFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProtocolFeesResponse.Merge(m, src)
+}
+func (m *QueryProtocolFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProtocolFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProtocolFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProtocolFeesResponse proto.InternalMessageInfo
+
+func (m *QueryProtocolFeesResponse) GetProtocolFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ProtocolFees
+	}
+	return nil
+}
+
+// =============================== PoolStats
+type QueryPoolStatsRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *QueryPoolStatsRequest) Reset()         { *m = QueryPoolStatsRequest{} }
+func (m *QueryPoolStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolStatsRequest) ProtoMessage()    {}
+func (*QueryPoolStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{52}
+}
+func (m *QueryPoolStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolStatsRequest.Merge(m, src)
+}
+func (m *QueryPoolStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolStatsRequest proto.InternalMessageInfo
+
+func (m *QueryPoolStatsRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type QueryPoolStatsResponse struct {
+	NumPositions    uint64                                 `protobuf:"varint,1,opt,name=num_positions,json=numPositions,proto3" json:"num_positions,omitempty" yaml:"num_positions"`
+	NumOwners       uint64                                 `protobuf:"varint,2,opt,name=num_owners,json=numOwners,proto3" json:"num_owners,omitempty" yaml:"num_owners"`
+	ActiveLiquidity github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=active_liquidity,json=activeLiquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"active_liquidity" yaml:"active_liquidity"`
+}
+
+func (m *QueryPoolStatsResponse) Reset()         { *m = QueryPoolStatsResponse{} }
+func (m *QueryPoolStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolStatsResponse) ProtoMessage()    {}
+func (*QueryPoolStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{53}
+}
+func (m *QueryPoolStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolStatsResponse.Merge(m, src)
+}
+func (m *QueryPoolStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolStatsResponse proto.InternalMessageInfo
+
+func (m *QueryPoolStatsResponse) GetNumPositions() uint64 {
+	if m != nil {
+		return m.NumPositions
+	}
+	return 0
+}
+
+func (m *QueryPoolStatsResponse) GetNumOwners() uint64 {
+	if m != nil {
+		return m.NumOwners
+	}
+	return 0
+}
+
+// =============================== IsPositionInRange
+type QueryIsPositionInRangeRequest struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+}
+
+func (m *QueryIsPositionInRangeRequest) Reset()         { *m = QueryIsPositionInRangeRequest{} }
+func (m *QueryIsPositionInRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIsPositionInRangeRequest) ProtoMessage()    {}
+func (*QueryIsPositionInRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{54}
+}
+func (m *QueryIsPositionInRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsPositionInRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsPositionInRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIsPositionInRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsPositionInRangeRequest.Merge(m, src)
+}
+func (m *QueryIsPositionInRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsPositionInRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsPositionInRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIsPositionInRangeRequest proto.InternalMessageInfo
+
+func (m *QueryIsPositionInRangeRequest) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+type QueryIsPositionInRangeResponse struct {
+	// in_range is true if the current tick is at or above the lower tick and
+	// below the upper tick, so that the position's liquidity is active.
+	InRange     bool  `protobuf:"varint,1,opt,name=in_range,json=inRange,proto3" json:"in_range,omitempty" yaml:"in_range"`
+	CurrentTick int64 `protobuf:"varint,2,opt,name=current_tick,json=currentTick,proto3" json:"current_tick,omitempty" yaml:"current_tick"`
+	LowerTick   int64 `protobuf:"varint,3,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick   int64 `protobuf:"varint,4,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+}
+
+func (m *QueryIsPositionInRangeResponse) Reset()         { *m = QueryIsPositionInRangeResponse{} }
+func (m *QueryIsPositionInRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIsPositionInRangeResponse) ProtoMessage()    {}
+func (*QueryIsPositionInRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{55}
+}
+func (m *QueryIsPositionInRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIsPositionInRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIsPositionInRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIsPositionInRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIsPositionInRangeResponse.Merge(m, src)
+}
+func (m *QueryIsPositionInRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIsPositionInRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIsPositionInRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIsPositionInRangeResponse proto.InternalMessageInfo
+
+func (m *QueryIsPositionInRangeResponse) GetInRange() bool {
+	if m != nil {
+		return m.InRange
+	}
+	return false
+}
+
+func (m *QueryIsPositionInRangeResponse) GetCurrentTick() int64 {
+	if m != nil {
+		return m.CurrentTick
+	}
+	return 0
+}
+
+func (m *QueryIsPositionInRangeResponse) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *QueryIsPositionInRangeResponse) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+// =============================== PositionConversionBounds
+type QueryPositionConversionBoundsRequest struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+}
+
+func (m *QueryPositionConversionBoundsRequest) Reset()         { *m = QueryPositionConversionBoundsRequest{} }
+func (m *QueryPositionConversionBoundsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionConversionBoundsRequest) ProtoMessage()    {}
+func (*QueryPositionConversionBoundsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{56}
+}
+func (m *QueryPositionConversionBoundsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionConversionBoundsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionConversionBoundsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPositionConversionBoundsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionConversionBoundsRequest.Merge(m, src)
+}
+func (m *QueryPositionConversionBoundsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionConversionBoundsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionConversionBoundsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPositionConversionBoundsRequest proto.InternalMessageInfo
+
+func (m *QueryPositionConversionBoundsRequest) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+type QueryPositionConversionBoundsResponse struct {
+	LowerTick int64 `protobuf:"varint,1,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick int64 `protobuf:"varint,2,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+	// lower_price is the spot price at the lower tick. Below it, the position is
+	// entirely converted to token0.
+	LowerPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=lower_price,json=lowerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"lower_price" yaml:"lower_price"`
+	// upper_price is the spot price at the upper tick. At or above it, the
+	// position is entirely converted to token1.
+	UpperPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=upper_price,json=upperPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"upper_price" yaml:"upper_price"`
+	// below_range is true if the current tick is below the lower tick, so the
+	// position is already entirely token0.
+	BelowRange bool `protobuf:"varint,5,opt,name=below_range,json=belowRange,proto3" json:"below_range,omitempty" yaml:"below_range"`
+	// above_range is true if the current tick is at or above the upper tick, so
+	// the position is already entirely token1.
+	AboveRange bool `protobuf:"varint,6,opt,name=above_range,json=aboveRange,proto3" json:"above_range,omitempty" yaml:"above_range"`
+}
+
+func (m *QueryPositionConversionBoundsResponse) Reset()         { *m = QueryPositionConversionBoundsResponse{} }
+func (m *QueryPositionConversionBoundsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionConversionBoundsResponse) ProtoMessage()    {}
+func (*QueryPositionConversionBoundsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{57}
+}
+func (m *QueryPositionConversionBoundsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionConversionBoundsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionConversionBoundsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPositionConversionBoundsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionConversionBoundsResponse.Merge(m, src)
+}
+func (m *QueryPositionConversionBoundsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionConversionBoundsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionConversionBoundsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPositionConversionBoundsResponse proto.InternalMessageInfo
+
+func (m *QueryPositionConversionBoundsResponse) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *QueryPositionConversionBoundsResponse) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+func (m *QueryPositionConversionBoundsResponse) GetBelowRange() bool {
+	if m != nil {
+		return m.BelowRange
+	}
+	return false
+}
+
+func (m *QueryPositionConversionBoundsResponse) GetAboveRange() bool {
+	if m != nil {
+		return m.AboveRange
+	}
+	return false
+}
+
+// =============================== PositionsByJoinTimeRange
+type QueryPositionsByJoinTimeRangeRequest struct {
+	StartTime  time.Time          `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	EndTime    time.Time          `protobuf:"bytes,2,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPositionsByJoinTimeRangeRequest) Reset()         { *m = QueryPositionsByJoinTimeRangeRequest{} }
+func (m *QueryPositionsByJoinTimeRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionsByJoinTimeRangeRequest) ProtoMessage()    {}
+func (*QueryPositionsByJoinTimeRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{58}
+}
+func (m *QueryPositionsByJoinTimeRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionsByJoinTimeRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionsByJoinTimeRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPositionsByJoinTimeRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionsByJoinTimeRangeRequest.Merge(m, src)
+}
+func (m *QueryPositionsByJoinTimeRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionsByJoinTimeRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionsByJoinTimeRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPositionsByJoinTimeRangeRequest proto.InternalMessageInfo
+
+func (m *QueryPositionsByJoinTimeRangeRequest) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryPositionsByJoinTimeRangeRequest) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *QueryPositionsByJoinTimeRangeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryPositionsByJoinTimeRangeResponse struct {
+	Positions  []model.Position    `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPositionsByJoinTimeRangeResponse) Reset()         { *m = QueryPositionsByJoinTimeRangeResponse{} }
+func (m *QueryPositionsByJoinTimeRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionsByJoinTimeRangeResponse) ProtoMessage()    {}
+func (*QueryPositionsByJoinTimeRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{59}
+}
+func (m *QueryPositionsByJoinTimeRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionsByJoinTimeRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionsByJoinTimeRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPositionsByJoinTimeRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionsByJoinTimeRangeResponse.Merge(m, src)
+}
+func (m *QueryPositionsByJoinTimeRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionsByJoinTimeRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionsByJoinTimeRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPositionsByJoinTimeRangeResponse proto.InternalMessageInfo
+
+func (m *QueryPositionsByJoinTimeRangeResponse) GetPositions() []model.Position {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+func (m *QueryPositionsByJoinTimeRangeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// =============================== PositionAccruedExceeds
+type QueryPositionAccruedExceedsRequest struct {
+	PositionId uint64                                   `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	Threshold  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=threshold,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"threshold" yaml:"threshold"`
+}
+
+func (m *QueryPositionAccruedExceedsRequest) Reset()         { *m = QueryPositionAccruedExceedsRequest{} }
+func (m *QueryPositionAccruedExceedsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionAccruedExceedsRequest) ProtoMessage()    {}
+func (*QueryPositionAccruedExceedsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{60}
+}
+func (m *QueryPositionAccruedExceedsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionAccruedExceedsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionAccruedExceedsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPositionAccruedExceedsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionAccruedExceedsRequest.Merge(m, src)
+}
+func (m *QueryPositionAccruedExceedsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionAccruedExceedsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionAccruedExceedsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPositionAccruedExceedsRequest proto.InternalMessageInfo
+
+func (m *QueryPositionAccruedExceedsRequest) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+func (m *QueryPositionAccruedExceedsRequest) GetThreshold() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Threshold
+	}
+	return nil
+}
+
+type QueryPositionAccruedExceedsResponse struct {
+	Exceeds bool                                     `protobuf:"varint,1,opt,name=exceeds,proto3" json:"exceeds,omitempty" yaml:"exceeds"`
+	Accrued github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=accrued,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"accrued" yaml:"accrued"`
+}
+
+func (m *QueryPositionAccruedExceedsResponse) Reset()         { *m = QueryPositionAccruedExceedsResponse{} }
+func (m *QueryPositionAccruedExceedsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionAccruedExceedsResponse) ProtoMessage()    {}
+func (*QueryPositionAccruedExceedsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{61}
+}
+func (m *QueryPositionAccruedExceedsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionAccruedExceedsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionAccruedExceedsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPositionAccruedExceedsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionAccruedExceedsResponse.Merge(m, src)
+}
+func (m *QueryPositionAccruedExceedsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionAccruedExceedsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionAccruedExceedsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPositionAccruedExceedsResponse proto.InternalMessageInfo
+
+func (m *QueryPositionAccruedExceedsResponse) GetExceeds() bool {
+	if m != nil {
+		return m.Exceeds
+	}
+	return false
+}
+
+func (m *QueryPositionAccruedExceedsResponse) GetAccrued() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Accrued
+	}
+	return nil
+}
+
+// =============================== RequiredAmountForDeposit
+type QueryRequiredAmountForDepositRequest struct {
+	PoolId      uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	LowerTick   int64                                  `protobuf:"varint,2,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick   int64                                  `protobuf:"varint,3,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+	KnownDenom  string                                 `protobuf:"bytes,4,opt,name=known_denom,json=knownDenom,proto3" json:"known_denom,omitempty" yaml:"known_denom"`
+	KnownAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=known_amount,json=knownAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"known_amount" yaml:"known_amount"`
+}
+
+func (m *QueryRequiredAmountForDepositRequest) Reset()         { *m = QueryRequiredAmountForDepositRequest{} }
+func (m *QueryRequiredAmountForDepositRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRequiredAmountForDepositRequest) ProtoMessage()    {}
+func (*QueryRequiredAmountForDepositRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{62}
+}
+func (m *QueryRequiredAmountForDepositRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRequiredAmountForDepositRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRequiredAmountForDepositRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRequiredAmountForDepositRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequiredAmountForDepositRequest.Merge(m, src)
+}
+func (m *QueryRequiredAmountForDepositRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRequiredAmountForDepositRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequiredAmountForDepositRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRequiredAmountForDepositRequest proto.InternalMessageInfo
+
+func (m *QueryRequiredAmountForDepositRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *QueryRequiredAmountForDepositRequest) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *QueryRequiredAmountForDepositRequest) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+func (m *QueryRequiredAmountForDepositRequest) GetKnownDenom() string {
+	if m != nil {
+		return m.KnownDenom
+	}
+	return ""
+}
+
+type QueryRequiredAmountForDepositResponse struct {
+	OtherAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=other_amount,json=otherAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"other_amount" yaml:"other_amount"`
+	Liquidity   github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=liquidity,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity" yaml:"liquidity"`
+}
+
+func (m *QueryRequiredAmountForDepositResponse) Reset()         { *m = QueryRequiredAmountForDepositResponse{} }
+func (m *QueryRequiredAmountForDepositResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRequiredAmountForDepositResponse) ProtoMessage()    {}
+func (*QueryRequiredAmountForDepositResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{63}
+}
+func (m *QueryRequiredAmountForDepositResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRequiredAmountForDepositResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRequiredAmountForDepositResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRequiredAmountForDepositResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequiredAmountForDepositResponse.Merge(m, src)
+}
+func (m *QueryRequiredAmountForDepositResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRequiredAmountForDepositResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequiredAmountForDepositResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRequiredAmountForDepositResponse proto.InternalMessageInfo
+
+// =============================== AllTicks
+type QueryAllTicksRequest struct {
+	PoolId     uint64             `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllTicksRequest) Reset()         { *m = QueryAllTicksRequest{} }
+func (m *QueryAllTicksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTicksRequest) ProtoMessage()    {}
+func (*QueryAllTicksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{64}
+}
+func (m *QueryAllTicksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTicksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTicksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllTicksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTicksRequest.Merge(m, src)
+}
+func (m *QueryAllTicksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTicksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTicksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllTicksRequest proto.InternalMessageInfo
+
+func (m *QueryAllTicksRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *QueryAllTicksRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryAllTicksResponse struct {
+	Ticks      []genesis.FullTick  `protobuf:"bytes,1,rep,name=ticks,proto3" json:"ticks"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllTicksResponse) Reset()         { *m = QueryAllTicksResponse{} }
+func (m *QueryAllTicksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTicksResponse) ProtoMessage()    {}
+func (*QueryAllTicksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{65}
+}
+func (m *QueryAllTicksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTicksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTicksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllTicksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTicksResponse.Merge(m, src)
+}
+func (m *QueryAllTicksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTicksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTicksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllTicksResponse proto.InternalMessageInfo
+
+func (m *QueryAllTicksResponse) GetTicks() []genesis.FullTick {
+	if m != nil {
+		return m.Ticks
+	}
+	return nil
+}
+
+func (m *QueryAllTicksResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// =============================== PositionImpermanentLoss
+type QueryPositionImpermanentLossRequest struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+}
+
+func (m *QueryPositionImpermanentLossRequest) Reset()         { *m = QueryPositionImpermanentLossRequest{} }
+func (m *QueryPositionImpermanentLossRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionImpermanentLossRequest) ProtoMessage()    {}
+func (*QueryPositionImpermanentLossRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{66}
+}
+func (m *QueryPositionImpermanentLossRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionImpermanentLossRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionImpermanentLossRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPositionImpermanentLossRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionImpermanentLossRequest.Merge(m, src)
+}
+func (m *QueryPositionImpermanentLossRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionImpermanentLossRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionImpermanentLossRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPositionImpermanentLossRequest proto.InternalMessageInfo
+
+func (m *QueryPositionImpermanentLossRequest) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
+}
+
+type QueryPositionImpermanentLossResponse struct {
+	// impermanent_loss is the difference between the position's current value
+	// and the value of holding its original deposit, as a fraction of the
+	// latter. It is zero or negative when the position is worth less.
+	ImpermanentLoss github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=impermanent_loss,json=impermanentLoss,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"impermanent_loss" yaml:"impermanent_loss"`
+	// value_difference is the same difference in absolute terms, priced in
+	// token1.
+	ValueDifference github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=value_difference,json=valueDifference,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"value_difference" yaml:"value_difference"`
+	// current_amounts are the amounts currently underlying the position.
+	CurrentAmounts github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=current_amounts,json=currentAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"current_amounts" yaml:"current_amounts"`
+	// held_amounts are the amounts the position's liquidity required when it
+	// was created.
+	HeldAmounts github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=held_amounts,json=heldAmounts,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"held_amounts" yaml:"held_amounts"`
+}
+
+func (m *QueryPositionImpermanentLossResponse) Reset()         { *m = QueryPositionImpermanentLossResponse{} }
+func (m *QueryPositionImpermanentLossResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPositionImpermanentLossResponse) ProtoMessage()    {}
+func (*QueryPositionImpermanentLossResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{67}
+}
+func (m *QueryPositionImpermanentLossResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPositionImpermanentLossResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPositionImpermanentLossResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPositionImpermanentLossResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPositionImpermanentLossResponse.Merge(m, src)
+}
+func (m *QueryPositionImpermanentLossResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPositionImpermanentLossResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPositionImpermanentLossResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPositionImpermanentLossResponse proto.InternalMessageInfo
+
+func (m *QueryPositionImpermanentLossResponse) GetCurrentAmounts() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.CurrentAmounts
+	}
+	return nil
+}
+
+func (m *QueryPositionImpermanentLossResponse) GetHeldAmounts() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.HeldAmounts
+	}
+	return nil
+}
+
+// =============================== LiquidityToReachPrice
+type QueryLiquidityToReachPriceRequest struct {
+	PoolId      uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	TargetPrice github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=target_price,json=targetPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"target_price" yaml:"target_price"`
+}
+
+func (m *QueryLiquidityToReachPriceRequest) Reset()         { *m = QueryLiquidityToReachPriceRequest{} }
+func (m *QueryLiquidityToReachPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityToReachPriceRequest) ProtoMessage()    {}
+func (*QueryLiquidityToReachPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{68}
+}
+func (m *QueryLiquidityToReachPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidityToReachPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityToReachPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiquidityToReachPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityToReachPriceRequest.Merge(m, src)
+}
+func (m *QueryLiquidityToReachPriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidityToReachPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityToReachPriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiquidityToReachPriceRequest proto.InternalMessageInfo
+
+func (m *QueryLiquidityToReachPriceRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type QueryLiquidityToReachPriceResponse struct {
+	// token_in is the amount to swap in. It is token0 when the target price is
+	// below the current price and token1 otherwise.
+	TokenIn types.Coin `protobuf:"bytes,1,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	// crossed_ticks are the initialized ticks crossed on the way to the target
+	// price, in the order they are crossed.
+	CrossedTicks []int64 `protobuf:"varint,2,rep,packed,name=crossed_ticks,json=crossedTicks,proto3" json:"crossed_ticks,omitempty" yaml:"crossed_ticks"`
+}
+
+func (m *QueryLiquidityToReachPriceResponse) Reset()         { *m = QueryLiquidityToReachPriceResponse{} }
+func (m *QueryLiquidityToReachPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLiquidityToReachPriceResponse) ProtoMessage()    {}
+func (*QueryLiquidityToReachPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ce34c1e206115391, []int{69}
+}
+func (m *QueryLiquidityToReachPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLiquidityToReachPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLiquidityToReachPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLiquidityToReachPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLiquidityToReachPriceResponse.Merge(m, src)
+}
+func (m *QueryLiquidityToReachPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLiquidityToReachPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLiquidityToReachPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLiquidityToReachPriceResponse proto.InternalMessageInfo
+
+func (m *QueryLiquidityToReachPriceResponse) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
+	}
+	return types.Coin{}
+}
+
+func (m *QueryLiquidityToReachPriceResponse) GetCrossedTicks() []int64 {
+	if m != nil {
+		return m.CrossedTicks
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryUserPositionsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryUserPositionsRequest")
+	proto.RegisterType((*QueryUserPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryUserPositionsResponse")
+	proto.RegisterType((*QueryPositionByIdRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPositionByIdRequest")
+	proto.RegisterType((*QueryPositionByIdResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPositionByIdResponse")
+	proto.RegisterType((*QueryPositionIdsForRangeRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPositionIdsForRangeRequest")
+	proto.RegisterType((*QueryPositionIdsForRangeResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPositionIdsForRangeResponse")
+	proto.RegisterType((*QueryPriceAtTickRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPriceAtTickRequest")
+	proto.RegisterType((*QueryPriceAtTickResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPriceAtTickResponse")
+	proto.RegisterType((*QuerySqrtPriceForTicksRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QuerySqrtPriceForTicksRequest")
+	proto.RegisterType((*QuerySqrtPriceForTicksResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QuerySqrtPriceForTicksResponse")
+	proto.RegisterType((*QueryPoolsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPoolsRequest")
+	proto.RegisterType((*QueryPoolsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPoolsResponse")
+	proto.RegisterType((*QueryPoolsByLiquidityRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPoolsByLiquidityRequest")
+	proto.RegisterType((*QueryPoolsByLiquidityResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPoolsByLiquidityResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*TickLiquidityNet)(nil), "osmosis.concentratedliquidity.v1beta1.TickLiquidityNet")
+	proto.RegisterType((*LiquidityDepthWithRange)(nil), "osmosis.concentratedliquidity.v1beta1.LiquidityDepthWithRange")
+	proto.RegisterType((*QueryLiquidityNetInDirectionRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryLiquidityNetInDirectionRequest")
+	proto.RegisterType((*QueryLiquidityNetInDirectionResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryLiquidityNetInDirectionResponse")
+	proto.RegisterType((*QueryTotalLiquidityForRangeRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryTotalLiquidityForRangeRequest")
+	proto.RegisterType((*QueryTotalLiquidityForRangeResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryTotalLiquidityForRangeResponse")
+	proto.RegisterType((*QueryPositionSummaryRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPositionSummaryRequest")
+	proto.RegisterType((*QueryPositionSummaryResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPositionSummaryResponse")
+	proto.RegisterType((*QueryClaimableFeesRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryClaimableFeesRequest")
+	proto.RegisterType((*QueryClaimableFeesResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryClaimableFeesResponse")
+	proto.RegisterType((*QueryClaimableIncentivesRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryClaimableIncentivesRequest")
+	proto.RegisterType((*QueryClaimableIncentivesResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryClaimableIncentivesResponse")
+	proto.RegisterType((*QueryNextInitializedTickRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryNextInitializedTickRequest")
+	proto.RegisterType((*QueryNextInitializedTickResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryNextInitializedTickResponse")
+	proto.RegisterType((*QueryFeeRevenueRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryFeeRevenueRequest")
+	proto.RegisterType((*QueryFeeRevenueResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryFeeRevenueResponse")
+	proto.RegisterType((*QueryPoolFeeAccumulatorRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPoolFeeAccumulatorRequest")
+	proto.RegisterType((*QueryPoolFeeAccumulatorResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPoolFeeAccumulatorResponse")
+	proto.RegisterType((*QueryPoolParamsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPoolParamsRequest")
+	proto.RegisterType((*QueryPoolParamsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPoolParamsResponse")
+	proto.RegisterType((*QueryPositionAprRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPositionAprRequest")
+	proto.RegisterType((*QueryPositionAprResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPositionAprResponse")
+	proto.RegisterType((*QueryPoolIncentiveRecordsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPoolIncentiveRecordsRequest")
+	proto.RegisterType((*PoolIncentiveRecord)(nil), "osmosis.concentratedliquidity.v1beta1.PoolIncentiveRecord")
+	proto.RegisterType((*QueryPoolIncentiveRecordsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPoolIncentiveRecordsResponse")
+	proto.RegisterType((*QueryPoolsForDenomPairRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPoolsForDenomPairRequest")
+	proto.RegisterType((*QueryPoolsForDenomPairResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPoolsForDenomPairResponse")
+	proto.RegisterType((*QueryPoolsForPairWithFeesRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPoolsForPairWithFeesRequest")
+	proto.RegisterType((*PoolFeeTier)(nil), "osmosis.concentratedliquidity.v1beta1.PoolFeeTier")
+	proto.RegisterType((*QueryPoolsForPairWithFeesResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPoolsForPairWithFeesResponse")
+	proto.RegisterType((*QueryLiquidityWeightedTickRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryLiquidityWeightedTickRequest")
+	proto.RegisterType((*QueryLiquidityWeightedTickResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryLiquidityWeightedTickResponse")
+	proto.RegisterType((*QuerySimulateSwapExactAmountInRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QuerySimulateSwapExactAmountInRequest")
+	proto.RegisterType((*QuerySimulateSwapExactAmountInResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QuerySimulateSwapExactAmountInResponse")
+	proto.RegisterType((*QueryProtocolFeesRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryProtocolFeesRequest")
+	proto.RegisterType((*QueryProtocolFeesResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryProtocolFeesResponse")
+	proto.RegisterType((*QueryPoolStatsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPoolStatsRequest")
+	proto.RegisterType((*QueryPoolStatsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPoolStatsResponse")
+	proto.RegisterType((*QueryIsPositionInRangeRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryIsPositionInRangeRequest")
+	proto.RegisterType((*QueryIsPositionInRangeResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryIsPositionInRangeResponse")
+	proto.RegisterType((*QueryPositionConversionBoundsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPositionConversionBoundsRequest")
+	proto.RegisterType((*QueryPositionConversionBoundsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPositionConversionBoundsResponse")
+	proto.RegisterType((*QueryPositionsByJoinTimeRangeRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPositionsByJoinTimeRangeRequest")
+	proto.RegisterType((*QueryPositionsByJoinTimeRangeResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPositionsByJoinTimeRangeResponse")
+	proto.RegisterType((*QueryPositionAccruedExceedsRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPositionAccruedExceedsRequest")
+	proto.RegisterType((*QueryPositionAccruedExceedsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPositionAccruedExceedsResponse")
+	proto.RegisterType((*QueryRequiredAmountForDepositRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryRequiredAmountForDepositRequest")
+	proto.RegisterType((*QueryRequiredAmountForDepositResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryRequiredAmountForDepositResponse")
+	proto.RegisterType((*QueryAllTicksRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryAllTicksRequest")
+	proto.RegisterType((*QueryAllTicksResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryAllTicksResponse")
+	proto.RegisterType((*QueryPositionImpermanentLossRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPositionImpermanentLossRequest")
+	proto.RegisterType((*QueryPositionImpermanentLossResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryPositionImpermanentLossResponse")
+	proto.RegisterType((*QueryLiquidityToReachPriceRequest)(nil), "osmosis.concentratedliquidity.v1beta1.QueryLiquidityToReachPriceRequest")
+	proto.RegisterType((*QueryLiquidityToReachPriceResponse)(nil), "osmosis.concentratedliquidity.v1beta1.QueryLiquidityToReachPriceResponse")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentrated-liquidity/pool-model/query.proto", fileDescriptor_ce34c1e206115391)
+}
+
+var fileDescriptor_ce34c1e206115391 = []byte{
+	// 4427 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5c, 0x6f, 0x6c, 0x1c, 0xc7,
+	0x75, 0xf7, 0x1e, 0x29, 0x51, 0x1c, 0x52, 0x26, 0x35, 0xa4, 0x24, 0x6a, 0xad, 0x90, 0xca, 0xd8,
+	0x72, 0x85, 0xda, 0x22, 0x6b, 0x59, 0x8a, 0xa2, 0xff, 0xba, 0xe3, 0x3f, 0x9d, 0x24, 0x8b, 0xce,
+	0x4a, 0x4a, 0x02, 0xd7, 0xf0, 0x76, 0xef, 0x76, 0x48, 0x6e, 0xb5, 0xb7, 0x7b, 0xda, 0xdd, 0x13,
+	0xc5, 0xb4, 0x06, 0x1a, 0x1b, 0x28, 0x12, 0x04, 0x2d, 0x02, 0x34, 0x5f, 0x0a, 0xb8, 0xe8, 0x97,
+	0x22, 0x08, 0x82, 0x06, 0x05, 0x82, 0xa2, 0x2d, 0x0a, 0x14, 0xf9, 0x10, 0x14, 0x35, 0xd2, 0x00,
+	0x35, 0xe0, 0x7e, 0x08, 0xfa, 0x87, 0x09, 0xe4, 0x16, 0x0d, 0xd0, 0x06, 0x28, 0xd8, 0x16, 0x48,
+	0x83, 0x7e, 0x28, 0x66, 0xe6, 0xed, 0xee, 0xec, 0xee, 0x1d, 0xef, 0x76, 0xef, 0xe4, 0xe4, 0x13,
+	0x6f, 0x67, 0x76, 0x7e, 0xf3, 0x7e, 0x6f, 0xfe, 0xbd, 0xf7, 0xe6, 0x2d, 0xd1, 0x39, 0xd7, 0x6f,
+	0xb8, 0xbe, 0xe5, 0x2f, 0xd4, 0x5d, 0xa7, 0x4e, 0x9d, 0xc0, 0x33, 0x02, 0x6a, 0x9e, 0xb6, 0xad,
+	0x87, 0x2d, 0xcb, 0xb4, 0x82, 0xed, 0x85, 0xa6, 0xeb, 0xda, 0xa7, 0x1b, 0xae, 0x49, 0xed, 0x85,
+	0x87, 0x2d, 0xea, 0x6d, 0xcf, 0x37, 0x3d, 0x37, 0x70, 0xf1, 0x49, 0x68, 0x36, 0x2f, 0x37, 0x8b,
+	0x5a, 0xcd, 0x3f, 0x7a, 0xa5, 0x46, 0x03, 0xe3, 0x15, 0x75, 0x7a, 0xc3, 0xdd, 0x70, 0x79, 0x8b,
+	0x05, 0xf6, 0x4b, 0x34, 0x56, 0x5f, 0xea, 0xd6, 0xa7, 0xe1, 0x19, 0x0d, 0x1f, 0x5e, 0x9e, 0xad,
+	0xf3, 0xb7, 0x17, 0x6a, 0x86, 0x4f, 0x17, 0x00, 0x77, 0xa1, 0xee, 0x5a, 0x0e, 0xd4, 0xff, 0xb2,
+	0x5c, 0xcf, 0x45, 0x8c, 0xde, 0x6a, 0x1a, 0x1b, 0x96, 0x63, 0x04, 0x96, 0x1b, 0xbe, 0x7b, 0x7c,
+	0xc3, 0x75, 0x37, 0x6c, 0xba, 0x60, 0x34, 0xad, 0x05, 0xc3, 0x71, 0xdc, 0x80, 0x57, 0x86, 0x3d,
+	0x1d, 0x83, 0x5a, 0xfe, 0x54, 0x6b, 0xad, 0x2f, 0x18, 0xce, 0x76, 0x58, 0x25, 0x3a, 0xd1, 0x05,
+	0x15, 0xf1, 0x00, 0x55, 0x73, 0xe9, 0x56, 0x81, 0xd5, 0xa0, 0x7e, 0x60, 0x34, 0x9a, 0x21, 0x81,
+	0xf4, 0x0b, 0x66, 0xcb, 0x93, 0x85, 0xea, 0x36, 0x02, 0x16, 0x2f, 0xb5, 0x1e, 0x51, 0xdd, 0xa3,
+	0x75, 0xd7, 0x33, 0xa1, 0xd9, 0xe9, 0xae, 0x03, 0xe7, 0x5b, 0x52, 0x2f, 0x2f, 0x77, 0x79, 0x7d,
+	0x83, 0x3a, 0x94, 0x8d, 0x27, 0x7f, 0x9b, 0x3c, 0x42, 0xc7, 0x3e, 0xc3, 0x54, 0x79, 0xdf, 0xa7,
+	0xde, 0xeb, 0x00, 0xe4, 0x6b, 0xf4, 0x61, 0x8b, 0xfa, 0x01, 0x7e, 0x19, 0x8d, 0x18, 0xa6, 0xe9,
+	0x51, 0xdf, 0x9f, 0x51, 0x4e, 0x28, 0xa7, 0x46, 0x2b, 0x78, 0x77, 0x67, 0xee, 0xd9, 0x6d, 0xa3,
+	0x61, 0x5f, 0x24, 0x50, 0x41, 0xb4, 0xf0, 0x15, 0xfc, 0x12, 0x1a, 0x61, 0x73, 0x48, 0xb7, 0xcc,
+	0x99, 0xd2, 0x09, 0xe5, 0xd4, 0xb0, 0xfc, 0x36, 0x54, 0x10, 0x6d, 0x3f, 0xfb, 0x55, 0x35, 0xc9,
+	0xef, 0x28, 0x48, 0x6d, 0xd7, 0xb1, 0xdf, 0x74, 0x1d, 0x9f, 0x62, 0x17, 0x8d, 0x86, 0xb4, 0x58,
+	0xdf, 0x43, 0xa7, 0xc6, 0xce, 0xdc, 0x9a, 0xef, 0x69, 0x26, 0xce, 0x87, 0x60, 0x9f, 0xb3, 0x82,
+	0xcd, 0xfb, 0x8e, 0x49, 0x3d, 0x7b, 0xdb, 0x72, 0x36, 0xca, 0xbe, 0x4f, 0x83, 0x8a, 0x47, 0x8d,
+	0x07, 0xa6, 0xbb, 0xe5, 0x54, 0x86, 0xdf, 0xdf, 0x99, 0x7b, 0x46, 0x8b, 0xfb, 0x20, 0x77, 0xd1,
+	0x0c, 0x17, 0x27, 0x6c, 0x5d, 0xd9, 0xae, 0x9a, 0xa1, 0x1a, 0xce, 0xa3, 0xb1, 0xf0, 0x45, 0x46,
+	0x4e, 0xe1, 0xe4, 0x8e, 0xec, 0xee, 0xcc, 0xe1, 0x90, 0x5c, 0x54, 0x49, 0x34, 0x14, 0x3e, 0x55,
+	0x4d, 0xf2, 0x8d, 0x61, 0x74, 0xac, 0x0d, 0x2a, 0x70, 0x6c, 0xa0, 0x03, 0xe1, 0xbb, 0x1c, 0xf3,
+	0xa9, 0x50, 0x8c, 0xba, 0xc0, 0xbf, 0xab, 0xa0, 0x89, 0xba, 0x6b, 0xdb, 0xb4, 0x1e, 0x18, 0x35,
+	0x9b, 0xea, 0x8e, 0xbb, 0x35, 0x53, 0xe2, 0x9a, 0x3d, 0x36, 0x0f, 0xf3, 0x9c, 0xad, 0xac, 0xa8,
+	0x93, 0x45, 0xd7, 0x72, 0x2a, 0x37, 0x19, 0xc8, 0xee, 0xce, 0xdc, 0x11, 0xc1, 0x34, 0xd5, 0x9e,
+	0x7c, 0xf3, 0x87, 0x73, 0xa7, 0x36, 0xac, 0x60, 0xb3, 0x55, 0x9b, 0xaf, 0xbb, 0x0d, 0x58, 0x2e,
+	0xf0, 0xe7, 0xb4, 0x6f, 0x3e, 0x58, 0x08, 0xb6, 0x9b, 0xd4, 0xe7, 0x50, 0xbe, 0xf6, 0xac, 0xd4,
+	0xfa, 0x8e, 0xbb, 0x85, 0xdf, 0x53, 0xd0, 0x74, 0x93, 0x3a, 0xa6, 0xe5, 0x6c, 0xe8, 0x2d, 0x27,
+	0xb0, 0x6c, 0xbd, 0xd5, 0x64, 0x4b, 0x6a, 0x66, 0xa8, 0x9b, 0x54, 0x6b, 0x20, 0xd5, 0x73, 0xa0,
+	0xff, 0x36, 0x20, 0xf9, 0x44, 0xc3, 0x00, 0x71, 0x9f, 0x21, 0xdc, 0xe7, 0x00, 0xd8, 0x46, 0x87,
+	0x04, 0x94, 0xee, 0x51, 0xa3, 0xbe, 0x49, 0x4d, 0xdd, 0x08, 0x66, 0x86, 0xf9, 0x38, 0xa9, 0xf3,
+	0x62, 0xa5, 0xcf, 0x87, 0x2b, 0x7d, 0xfe, 0x5e, 0xb8, 0x15, 0x54, 0x5e, 0x00, 0xd9, 0x66, 0x84,
+	0x6c, 0x19, 0x08, 0xf2, 0xd5, 0x1f, 0xce, 0x29, 0xda, 0x84, 0x28, 0xd7, 0x44, 0x71, 0x39, 0x20,
+	0x3f, 0x56, 0xd0, 0x5c, 0x62, 0xaa, 0x54, 0x4d, 0x7f, 0xc5, 0xf5, 0x34, 0xc3, 0xd9, 0xa0, 0x4f,
+	0x7f, 0x39, 0xe2, 0xb3, 0x08, 0xd9, 0xee, 0x16, 0xf5, 0xf4, 0xc0, 0xaa, 0x3f, 0x98, 0x19, 0x3a,
+	0xa1, 0x9c, 0x1a, 0xaa, 0x1c, 0xde, 0xdd, 0x99, 0x3b, 0x24, 0xde, 0x8f, 0xeb, 0x88, 0x36, 0xca,
+	0x1f, 0xee, 0x59, 0xf5, 0x07, 0xac, 0x55, 0xab, 0xd9, 0x0c, 0x5b, 0x0d, 0xa7, 0x5b, 0xc5, 0x75,
+	0x44, 0x1b, 0xe5, 0x0f, 0xac, 0x15, 0x79, 0x0b, 0x9d, 0xe8, 0xcc, 0x14, 0xd6, 0xc6, 0x45, 0x34,
+	0x2e, 0xad, 0x2a, 0xb1, 0x05, 0x0c, 0x57, 0x8e, 0xee, 0xee, 0xcc, 0x4d, 0x65, 0xd6, 0x9c, 0x4f,
+	0xb4, 0xb1, 0x78, 0xd1, 0xf9, 0xe4, 0x01, 0x3a, 0x2a, 0xf0, 0x3d, 0xab, 0x4e, 0xcb, 0x01, 0xeb,
+	0x33, 0xd4, 0xa0, 0xa4, 0x13, 0xa5, 0xab, 0x4e, 0x9e, 0x47, 0xc3, 0x9c, 0x57, 0x89, 0xf3, 0x9a,
+	0xd8, 0xdd, 0x99, 0x1b, 0x13, 0x6f, 0x0a, 0x46, 0xbc, 0x92, 0x3c, 0x51, 0xd0, 0x4c, 0xb6, 0x37,
+	0x60, 0x51, 0x43, 0xc8, 0x7f, 0xe8, 0x05, 0x7a, 0x93, 0xd5, 0xc1, 0x98, 0x2d, 0xb2, 0xf9, 0xf1,
+	0x0f, 0x3b, 0x73, 0x2f, 0xf6, 0x30, 0x39, 0x97, 0x68, 0x3d, 0xd6, 0x66, 0x8c, 0x44, 0xb4, 0x51,
+	0xf6, 0xc0, 0x7b, 0xe4, 0x7d, 0x34, 0xdd, 0xb0, 0x8f, 0x52, 0x9f, 0x7d, 0x34, 0x5d, 0xa9, 0x8f,
+	0xa6, 0x2b, 0xfa, 0x20, 0x7f, 0xa1, 0xa0, 0x4f, 0x70, 0x92, 0x77, 0xc3, 0x6e, 0x57, 0x5c, 0x3e,
+	0x96, 0x7e, 0x21, 0xc5, 0x26, 0x27, 0x5b, 0xa9, 0xd0, 0x64, 0x1b, 0xea, 0x71, 0xb2, 0x7d, 0xa3,
+	0x84, 0x66, 0x3b, 0x89, 0x0e, 0xa3, 0xf4, 0x45, 0x05, 0x1d, 0x8e, 0x95, 0xab, 0x4b, 0xa2, 0x89,
+	0x11, 0xbb, 0x93, 0x5b, 0x9b, 0xc7, 0xd3, 0x23, 0xa6, 0xcb, 0x9c, 0x70, 0x34, 0x78, 0xb7, 0x23,
+	0x72, 0x29, 0x19, 0x24, 0xa2, 0xa5, 0x81, 0xc9, 0x20, 0x6b, 0x28, 0x96, 0xe1, 0x7e, 0xa4, 0xaa,
+	0x5f, 0x45, 0x87, 0x60, 0x5d, 0xba, 0x76, 0x34, 0xb0, 0x2b, 0x08, 0xc5, 0xc6, 0x15, 0x17, 0x66,
+	0xec, 0xcc, 0x8b, 0x89, 0x9d, 0x59, 0x18, 0x8b, 0xd1, 0xd1, 0x64, 0x44, 0xfb, 0x95, 0x26, 0xb5,
+	0x24, 0x5f, 0x53, 0x10, 0x96, 0xd1, 0x41, 0xf7, 0xe7, 0xd0, 0x3e, 0x36, 0x29, 0xc2, 0x33, 0x7e,
+	0x3a, 0xb3, 0xb1, 0x96, 0x9d, 0xed, 0xca, 0xe8, 0xf7, 0xfe, 0xf4, 0xf4, 0x3e, 0xd6, 0xae, 0xaa,
+	0x89, 0xb7, 0xf1, 0x6a, 0x1b, 0xa9, 0x7e, 0xa9, 0xab, 0x54, 0xa2, 0xcf, 0x84, 0x58, 0xeb, 0xe8,
+	0x78, 0x2c, 0x55, 0x65, 0xfb, 0x76, 0x78, 0xd4, 0xb6, 0xa7, 0xaf, 0x14, 0xa6, 0xff, 0x87, 0xe1,
+	0x0a, 0xca, 0x76, 0xf4, 0x0b, 0xa2, 0x89, 0xe9, 0x70, 0x7c, 0xb8, 0x49, 0x0e, 0x1c, 0xc8, 0x1b,
+	0x68, 0x2a, 0x51, 0x0a, 0xc2, 0x2e, 0xa2, 0xfd, 0xc2, 0x74, 0x07, 0x95, 0x9c, 0xec, 0x62, 0xb8,
+	0x88, 0xe6, 0x60, 0x92, 0x40, 0x53, 0xf2, 0xcf, 0x0a, 0x9a, 0x64, 0x13, 0x2f, 0xd2, 0xc5, 0x1d,
+	0x1a, 0xe0, 0x07, 0xe8, 0x60, 0xd4, 0x4c, 0x77, 0x68, 0x00, 0x6b, 0x70, 0x25, 0xf7, 0xfc, 0x9f,
+	0x86, 0xcd, 0x44, 0x06, 0x23, 0xda, 0xb8, 0x2d, 0x77, 0xf6, 0x26, 0x42, 0x6c, 0x39, 0xe8, 0x96,
+	0x63, 0xd2, 0xc7, 0xb0, 0xd2, 0xae, 0xe4, 0xe8, 0xa9, 0xea, 0x04, 0xe9, 0x53, 0x61, 0x94, 0xfd,
+	0xa9, 0x32, 0x3c, 0xf2, 0x7e, 0x09, 0x1d, 0x8d, 0xb8, 0x2d, 0xd1, 0x66, 0xb0, 0xc9, 0xec, 0x35,
+	0x7e, 0xce, 0xe1, 0x87, 0x68, 0x32, 0x96, 0xcc, 0x68, 0xb8, 0x2d, 0x67, 0xd0, 0x4c, 0x27, 0xa2,
+	0xe7, 0x32, 0x87, 0x67, 0x64, 0x53, 0xbb, 0x6e, 0xff, 0x64, 0xe3, 0xdd, 0xf9, 0xcd, 0xcc, 0xee,
+	0xdc, 0x3f, 0x7a, 0xbc, 0x8b, 0x7f, 0xaf, 0x84, 0x9e, 0xe7, 0xf3, 0x50, 0x9e, 0x2b, 0x55, 0x67,
+	0xc9, 0xf2, 0x68, 0x9d, 0xcd, 0xde, 0x42, 0xc7, 0xd0, 0x3c, 0x3a, 0x10, 0xb8, 0x0f, 0xa8, 0xa3,
+	0x5b, 0x0e, 0xa8, 0x63, 0x6a, 0x77, 0x67, 0x6e, 0x02, 0x44, 0x80, 0x1a, 0xa2, 0x8d, 0xf0, 0x9f,
+	0x55, 0x87, 0x9f, 0xb4, 0x81, 0xe1, 0x05, 0x32, 0x45, 0x76, 0xd2, 0x2a, 0xb9, 0x28, 0x86, 0x27,
+	0x6d, 0x84, 0xc4, 0x4e, 0x5a, 0xf6, 0xc0, 0xd5, 0x58, 0x43, 0xa8, 0xe6, 0xb6, 0x1c, 0x33, 0xb6,
+	0xa8, 0xfa, 0xe8, 0x23, 0x46, 0x22, 0xda, 0x28, 0x7f, 0xe0, 0xca, 0xfc, 0xe3, 0x12, 0x7a, 0x61,
+	0x6f, 0x65, 0xc2, 0x2a, 0xdf, 0x94, 0x27, 0xa9, 0xc9, 0x26, 0x70, 0xb8, 0x3b, 0x9d, 0xef, 0xd1,
+	0x51, 0x49, 0x2f, 0x6f, 0xd8, 0x01, 0x26, 0xec, 0xc4, 0xb2, 0xf0, 0xf1, 0x27, 0xd1, 0x78, 0xbd,
+	0xe5, 0x79, 0xd4, 0x09, 0x24, 0x9b, 0x40, 0x1b, 0x83, 0x32, 0xae, 0x99, 0x2d, 0x74, 0x28, 0x7c,
+	0x25, 0x6a, 0x0d, 0x83, 0x70, 0x33, 0xf7, 0x92, 0x01, 0xe3, 0x3c, 0x03, 0x48, 0xb4, 0x49, 0x28,
+	0x8b, 0xa4, 0x26, 0x9f, 0x41, 0x84, 0x6b, 0xeb, 0x9e, 0x1b, 0x18, 0x76, 0x54, 0x9c, 0xb6, 0xcd,
+	0xf3, 0xcc, 0x3c, 0xf2, 0x65, 0x05, 0x3d, 0xbf, 0x27, 0x66, 0x64, 0x3f, 0x8e, 0xc6, 0x5c, 0x85,
+	0xe6, 0xaf, 0xf6, 0xa8, 0xf9, 0x0e, 0x1b, 0x4f, 0xe8, 0xf8, 0xc6, 0x8c, 0x3f, 0x8b, 0x9e, 0x4b,
+	0x58, 0xe3, 0x77, 0x5b, 0x8d, 0x86, 0xe1, 0x6d, 0xf7, 0xed, 0xfb, 0xfe, 0xfd, 0x50, 0x74, 0xb4,
+	0xa6, 0x80, 0x7f, 0x3e, 0xee, 0xaf, 0x8e, 0x9e, 0xad, 0xdb, 0x86, 0xd5, 0xe0, 0xbe, 0xeb, 0x3a,
+	0xa5, 0x7e, 0x77, 0xe7, 0xf7, 0x13, 0xe0, 0xca, 0x1d, 0x86, 0xd9, 0x92, 0x68, 0x4e, 0xb4, 0x83,
+	0x51, 0xc1, 0x0a, 0xa5, 0x3e, 0x7e, 0x88, 0xa6, 0xe3, 0x37, 0xa2, 0x50, 0x8e, 0xdf, 0xdd, 0x9b,
+	0x7d, 0x3e, 0xe9, 0xcd, 0xb6, 0x03, 0x21, 0xda, 0x54, 0x54, 0x5c, 0x8d, 0x4a, 0x59, 0x97, 0xeb,
+	0xae, 0xb7, 0x4e, 0xad, 0x80, 0x9a, 0x72, 0x97, 0xc3, 0x39, 0xbb, 0x6c, 0x07, 0x42, 0xb4, 0xa9,
+	0xa8, 0x38, 0xee, 0x92, 0xdc, 0x83, 0x88, 0xc6, 0xa2, 0xcc, 0xbd, 0xef, 0xc9, 0xf2, 0x36, 0x52,
+	0xdb, 0xa1, 0xc2, 0x4c, 0xc9, 0x0e, 0x9d, 0x32, 0xd0, 0xa1, 0x23, 0x6f, 0xa0, 0xb9, 0x64, 0xf7,
+	0x31, 0xe1, 0xbe, 0xa9, 0x7d, 0xa9, 0x84, 0x4e, 0x74, 0x06, 0x07, 0x86, 0x9d, 0xe6, 0x8e, 0xf2,
+	0xf1, 0xcf, 0x9d, 0xd2, 0xd3, 0x9b, 0x3b, 0xdf, 0x09, 0x63, 0x1c, 0x77, 0xe8, 0xe3, 0xa0, 0xea,
+	0x58, 0x81, 0x65, 0xd8, 0xd6, 0x17, 0xa8, 0x59, 0xd8, 0x43, 0x3f, 0x9b, 0x38, 0x91, 0x33, 0x8e,
+	0x64, 0x87, 0x33, 0xf6, 0x02, 0x1a, 0xff, 0x02, 0xf5, 0x5c, 0x7d, 0xdd, 0xf5, 0x74, 0xd7, 0xa1,
+	0xfc, 0x10, 0x39, 0x20, 0xc7, 0x16, 0xe4, 0x5a, 0xa2, 0x21, 0xf6, 0xb8, 0xe2, 0x7a, 0x6b, 0x0e,
+	0x25, 0x3f, 0x51, 0xd0, 0x89, 0xce, 0x0c, 0x60, 0x30, 0xcf, 0x26, 0xac, 0x4a, 0x25, 0x2d, 0x55,
+	0x5c, 0x27, 0x5b, 0x8b, 0x59, 0xc3, 0xb7, 0xf4, 0x14, 0x0d, 0xdf, 0x17, 0xd1, 0xbe, 0x75, 0x66,
+	0x0f, 0x00, 0xf7, 0xc9, 0xdd, 0x9d, 0xb9, 0xf1, 0x70, 0x38, 0x5b, 0x8e, 0x49, 0x34, 0x51, 0xcd,
+	0xdc, 0x96, 0x23, 0x9c, 0xef, 0x0a, 0xa5, 0x1a, 0x7d, 0x44, 0x9d, 0x56, 0xa1, 0x03, 0x0f, 0x7f,
+	0x3e, 0x1e, 0xa8, 0x06, 0x9d, 0x29, 0x75, 0x0d, 0xa2, 0x85, 0xcb, 0x37, 0x35, 0x90, 0x0d, 0x2a,
+	0xa2, 0x67, 0xe1, 0x60, 0x36, 0x28, 0xf9, 0x23, 0x05, 0x1d, 0xcd, 0x48, 0x08, 0x03, 0xf1, 0x25,
+	0x05, 0x8d, 0xad, 0x53, 0x16, 0x7c, 0xe3, 0xe5, 0xb0, 0x9a, 0x8e, 0xb7, 0x9d, 0xda, 0x4b, 0xb4,
+	0xce, 0x67, 0x77, 0x15, 0x7a, 0x86, 0x65, 0x2d, 0x35, 0x67, 0x11, 0xc5, 0x97, 0x7a, 0x1b, 0x05,
+	0x11, 0x54, 0x44, 0xeb, 0x91, 0x48, 0xe4, 0x35, 0x88, 0x42, 0x30, 0xdf, 0x6d, 0x85, 0xd2, 0x72,
+	0xbd, 0xde, 0x6a, 0xb4, 0x6c, 0x23, 0x70, 0xbd, 0x42, 0x06, 0xc4, 0x5f, 0xc5, 0xd1, 0xc2, 0x2c,
+	0x1e, 0xb0, 0xff, 0x7d, 0x05, 0x1d, 0x62, 0xe2, 0x6f, 0x78, 0xee, 0x56, 0xb0, 0xa9, 0x6f, 0xd8,
+	0x6e, 0xcd, 0xb0, 0x7b, 0xd2, 0xc1, 0x5a, 0x32, 0x84, 0x99, 0x01, 0xc9, 0xad, 0x89, 0x89, 0x75,
+	0x4a, 0x57, 0x39, 0xc2, 0xaa, 0x00, 0x58, 0x46, 0x47, 0x22, 0xf1, 0x13, 0x0e, 0x67, 0x3e, 0x35,
+	0xbc, 0x37, 0x8c, 0x8e, 0x66, 0x70, 0xe2, 0x08, 0x22, 0x5f, 0x69, 0x7e, 0xd3, 0xa8, 0x5b, 0xce,
+	0x06, 0xa0, 0x49, 0xab, 0x5c, 0xae, 0x25, 0xda, 0x18, 0x7b, 0xbc, 0x2b, 0x9e, 0x78, 0x34, 0x86,
+	0x3e, 0x6e, 0xba, 0x0e, 0x33, 0x0e, 0x8d, 0x30, 0x7e, 0xe2, 0x3a, 0x62, 0xea, 0xe6, 0x8b, 0xc6,
+	0x08, 0x8b, 0x1c, 0xa2, 0x31, 0x6d, 0x41, 0x89, 0x86, 0xc3, 0xf2, 0xb2, 0x88, 0xc9, 0xac, 0x39,
+	0x14, 0xbf, 0x89, 0x0e, 0xf8, 0x5b, 0x46, 0x93, 0x1d, 0x58, 0x60, 0xe6, 0x96, 0x73, 0x6f, 0x05,
+	0xe0, 0xcb, 0x84, 0x38, 0x44, 0x1b, 0x61, 0x3f, 0x57, 0x28, 0x33, 0xed, 0x93, 0x06, 0xb7, 0xf0,
+	0x34, 0x96, 0x73, 0xf3, 0x9a, 0x4a, 0x1a, 0xd2, 0x62, 0xaf, 0x4d, 0xd8, 0xed, 0xdb, 0x08, 0x87,
+	0xb5, 0x52, 0x2c, 0x74, 0x1f, 0xef, 0xef, 0x56, 0x6e, 0x46, 0xc7, 0x92, 0xfd, 0xc9, 0x31, 0xd1,
+	0xd0, 0x72, 0x8f, 0x02, 0x7d, 0x44, 0x8b, 0x66, 0x87, 0x38, 0x8d, 0xcb, 0x4d, 0xaf, 0xef, 0xe3,
+	0xfc, 0x9b, 0x43, 0x68, 0x26, 0x0b, 0x0a, 0x73, 0xee, 0x0e, 0x1a, 0x32, 0x9a, 0x1e, 0x38, 0xf2,
+	0x97, 0x73, 0x93, 0x43, 0xa2, 0x6f, 0xa3, 0xe9, 0x11, 0x8d, 0x01, 0xe1, 0xaf, 0x29, 0x68, 0xc2,
+	0x70, 0x9c, 0x96, 0x38, 0x64, 0x64, 0xab, 0x75, 0xef, 0x05, 0xfc, 0x5a, 0xf2, 0xd6, 0x26, 0x05,
+	0x91, 0x7b, 0xf9, 0x3e, 0x1b, 0x03, 0x70, 0x4b, 0xf7, 0xeb, 0x0a, 0x3a, 0x2c, 0x61, 0x66, 0x6c,
+	0xdd, 0xbd, 0x85, 0xbb, 0x0b, 0xc2, 0x1d, 0xcf, 0x08, 0x17, 0x03, 0xe5, 0x16, 0x71, 0x3a, 0x86,
+	0x91, 0x0c, 0x8e, 0xb5, 0xe8, 0xa6, 0xc1, 0xb5, 0xa3, 0x62, 0x8d, 0xdf, 0xad, 0x16, 0xdb, 0x70,
+	0x7e, 0xa6, 0xa0, 0xa9, 0x36, 0x60, 0xf8, 0x1d, 0x05, 0x4d, 0xa6, 0x6f, 0x6f, 0xc1, 0xa9, 0xf9,
+	0x54, 0x8f, 0x4e, 0x4d, 0x0a, 0xb2, 0x32, 0x07, 0x6a, 0x3a, 0x2a, 0x44, 0x49, 0xa3, 0x13, 0x6d,
+	0xc2, 0x4a, 0x09, 0xf1, 0x16, 0x1a, 0xa7, 0x8f, 0x37, 0x8d, 0x96, 0x1f, 0x88, 0xbb, 0xaa, 0xee,
+	0xc7, 0x6c, 0xd8, 0xc7, 0x54, 0xb8, 0x3b, 0xc5, 0xad, 0xc5, 0x41, 0x3b, 0x16, 0x15, 0x95, 0x03,
+	0xf2, 0x27, 0x0a, 0xfa, 0xe4, 0x1e, 0xea, 0x84, 0x35, 0xf0, 0x65, 0x05, 0x1d, 0x4a, 0x0b, 0x1b,
+	0x1a, 0xb2, 0x17, 0x7b, 0x76, 0xf0, 0x32, 0x1d, 0x54, 0x4e, 0x24, 0x0f, 0xa5, 0x4c, 0x17, 0x44,
+	0x9b, 0x4c, 0x29, 0xc4, 0x27, 0xdb, 0x72, 0xd0, 0x75, 0xc5, 0xf5, 0x96, 0xa8, 0xe3, 0x36, 0x5e,
+	0x37, 0x2c, 0xf9, 0xd0, 0x35, 0x59, 0x99, 0x6e, 0x64, 0x6f, 0xd4, 0xa0, 0x82, 0x68, 0xfb, 0xf9,
+	0xaf, 0x72, 0xfc, 0x72, 0x6d, 0xa6, 0xd4, 0xfe, 0xe5, 0x5a, 0xf8, 0x72, 0x85, 0xbc, 0x8e, 0x66,
+	0x3b, 0x75, 0x0d, 0x8a, 0x9a, 0x47, 0x07, 0x60, 0x7e, 0x85, 0xd7, 0x5b, 0x52, 0xf8, 0x29, 0xac,
+	0x21, 0xda, 0x88, 0x98, 0x7a, 0x3e, 0xf9, 0x4d, 0x74, 0x22, 0x81, 0xc8, 0xc0, 0x98, 0x07, 0x2c,
+	0x3b, 0x60, 0x4f, 0x8f, 0xcf, 0xcf, 0x14, 0x34, 0x06, 0xc6, 0xc6, 0x3d, 0x8b, 0x7a, 0xf9, 0xcc,
+	0x3f, 0xf9, 0x2c, 0x2b, 0x0d, 0xfc, 0x2c, 0xfb, 0x35, 0x39, 0x4a, 0x22, 0x8e, 0xca, 0x4a, 0x6e,
+	0xf8, 0xc9, 0x94, 0xd5, 0x4c, 0xe4, 0x18, 0xc9, 0xbb, 0xf2, 0xcc, 0xcf, 0xea, 0x1e, 0x06, 0xf4,
+	0xad, 0x64, 0x04, 0xff, 0x4c, 0x8e, 0xc9, 0x0e, 0x5a, 0xad, 0x4c, 0xc3, 0x24, 0x1f, 0x8f, 0x15,
+	0xe9, 0x13, 0x08, 0xf5, 0x93, 0xd7, 0x41, 0x88, 0x28, 0xb4, 0xf3, 0x39, 0x6a, 0x6d, 0x6c, 0x06,
+	0xc5, 0xfd, 0x27, 0xf2, 0x2d, 0x05, 0x91, 0xbd, 0x20, 0x81, 0x58, 0x78, 0x11, 0xaa, 0xec, 0x71,
+	0x11, 0xfa, 0xb1, 0xdc, 0x43, 0xfe, 0x9b, 0x82, 0x4e, 0x8a, 0xcb, 0x3c, 0x8b, 0x5b, 0xbb, 0xf4,
+	0xee, 0x96, 0xd1, 0x5c, 0x7e, 0x6c, 0xd4, 0x03, 0x11, 0xe3, 0xae, 0x16, 0x0b, 0x04, 0xbf, 0x96,
+	0x0a, 0x04, 0xef, 0xe9, 0xfe, 0x1e, 0x85, 0x21, 0xea, 0x1c, 0x27, 0xae, 0xa0, 0x09, 0x51, 0xea,
+	0xb6, 0x02, 0x9d, 0x2f, 0x1f, 0x98, 0x95, 0x6a, 0x7c, 0x24, 0xa7, 0x5e, 0x20, 0xda, 0x41, 0x5e,
+	0xb2, 0xd6, 0x0a, 0xf8, 0x46, 0x41, 0xbe, 0x5b, 0x42, 0x2f, 0x76, 0x63, 0x0a, 0xa3, 0x73, 0x17,
+	0x21, 0x71, 0x81, 0xc0, 0xe0, 0x66, 0x94, 0x6e, 0xf2, 0x1f, 0x4b, 0xba, 0x56, 0x71, 0x53, 0xa2,
+	0x8d, 0x8a, 0x87, 0xb5, 0x56, 0x80, 0x3f, 0x2b, 0x3c, 0xa7, 0xfa, 0xa6, 0xe1, 0x6d, 0x50, 0xb3,
+	0xbb, 0x56, 0xd4, 0xac, 0xdb, 0x04, 0x6d, 0x09, 0xf7, 0x83, 0x16, 0xc5, 0x03, 0xb6, 0xd1, 0x14,
+	0xf4, 0x68, 0x39, 0xba, 0xb1, 0x1e, 0x50, 0x2f, 0x32, 0x70, 0xf7, 0xc4, 0x27, 0x80, 0xaf, 0x26,
+	0xa4, 0x96, 0x31, 0x88, 0x36, 0x69, 0x80, 0x6a, 0xca, 0xac, 0x6c, 0x85, 0x52, 0xb2, 0x1a, 0xdd,
+	0xcd, 0xbb, 0x81, 0x5b, 0x77, 0xed, 0xd4, 0x56, 0xd9, 0xfb, 0x42, 0xf9, 0xba, 0x82, 0x8e, 0xb5,
+	0x41, 0x8a, 0xfd, 0xcc, 0x83, 0x4d, 0xa8, 0xe8, 0x31, 0x3e, 0x75, 0x03, 0xf8, 0x80, 0xb3, 0x9e,
+	0x68, 0x9d, 0x2f, 0x75, 0x65, 0xbc, 0x29, 0x89, 0x44, 0x96, 0xd0, 0xe1, 0x68, 0xa3, 0xba, 0x1b,
+	0x18, 0x41, 0x31, 0xba, 0xef, 0x96, 0xd0, 0x91, 0x34, 0x0c, 0x70, 0xbd, 0x82, 0x0e, 0x3a, 0xad,
+	0x86, 0x2e, 0x27, 0x67, 0x31, 0xb4, 0x99, 0x98, 0x4b, 0xa2, 0x9a, 0x68, 0xe3, 0x4e, 0xab, 0x11,
+	0xe5, 0x77, 0xb1, 0xd8, 0x08, 0xab, 0x77, 0xb7, 0x1c, 0xea, 0xf9, 0x90, 0x97, 0x22, 0xc5, 0x46,
+	0xe2, 0x3a, 0xa2, 0x8d, 0x3a, 0xad, 0xc6, 0x1a, 0xff, 0x8d, 0x03, 0x34, 0x69, 0xd4, 0xf9, 0x61,
+	0x9f, 0xde, 0xe8, 0xab, 0xb9, 0x77, 0x18, 0xb0, 0xa7, 0xd2, 0x78, 0x44, 0x9b, 0x10, 0x45, 0x71,
+	0xe0, 0xff, 0xf3, 0x60, 0x3d, 0x54, 0xfd, 0x28, 0x53, 0xc5, 0x49, 0xc4, 0xfc, 0x0b, 0x3b, 0x11,
+	0x3f, 0x55, 0xd0, 0x6c, 0x27, 0xe8, 0xd8, 0x3a, 0xb0, 0x1c, 0xdd, 0x63, 0x65, 0x1c, 0xf8, 0x80,
+	0x6c, 0x1d, 0x84, 0x35, 0x44, 0x1b, 0xb1, 0x44, 0x3b, 0xe6, 0xee, 0x66, 0x6f, 0x50, 0x64, 0x77,
+	0x77, 0x0f, 0x17, 0xed, 0xe3, 0x4c, 0xfe, 0xd1, 0xe1, 0xee, 0x29, 0xe4, 0xbd, 0xe8, 0x3a, 0x8f,
+	0xa8, 0xe7, 0xb3, 0xdc, 0x38, 0x16, 0x71, 0xea, 0x3f, 0xde, 0xfa, 0xe1, 0x10, 0x3a, 0xd9, 0xa5,
+	0x87, 0x38, 0x4e, 0x97, 0xca, 0xf5, 0xc8, 0x4f, 0xbb, 0xd4, 0x1b, 0x6d, 0x4c, 0xd1, 0x98, 0xc0,
+	0x13, 0xc7, 0xa3, 0x98, 0xbc, 0x4b, 0xb9, 0x27, 0x2f, 0x96, 0x45, 0x83, 0xf3, 0x51, 0x90, 0x10,
+	0xc9, 0x40, 0x14, 0x8d, 0x09, 0x01, 0x44, 0x37, 0xc3, 0xfd, 0x75, 0x23, 0x41, 0x11, 0x4d, 0xb0,
+	0x16, 0xdd, 0x9c, 0x47, 0x63, 0x35, 0x6a, 0xbb, 0x5b, 0x30, 0x3f, 0xf7, 0xf1, 0xf9, 0x29, 0x0d,
+	0x8e, 0x54, 0x49, 0x34, 0xc4, 0x9f, 0xc4, 0x2c, 0x3d, 0x8f, 0xc6, 0x8c, 0x9a, 0xcb, 0x8c, 0x76,
+	0xde, 0x70, 0x7f, 0xba, 0xa1, 0x54, 0x49, 0x34, 0xc4, 0x9f, 0x78, 0x43, 0xf2, 0x5e, 0x29, 0x35,
+	0x6f, 0xfc, 0xca, 0xf6, 0x4d, 0xd7, 0x72, 0x98, 0x2f, 0x93, 0x58, 0x93, 0xc9, 0x48, 0xa3, 0x32,
+	0xb8, 0x48, 0x23, 0xd6, 0xd0, 0x01, 0xea, 0x98, 0xbd, 0x46, 0x30, 0x9f, 0x4b, 0x9a, 0x09, 0x61,
+	0x4b, 0x81, 0x3a, 0x42, 0xd9, 0x55, 0x6c, 0x83, 0xa6, 0xd2, 0x4b, 0x86, 0x0a, 0xa7, 0x97, 0xfc,
+	0xb5, 0x82, 0x4e, 0x76, 0x51, 0x4f, 0x64, 0x2d, 0x64, 0x12, 0x6b, 0x17, 0x72, 0x5e, 0xbb, 0x65,
+	0x92, 0x67, 0x07, 0x97, 0x84, 0xf2, 0x4f, 0xa1, 0x41, 0x1a, 0x45, 0x57, 0xea, 0x75, 0xaf, 0x45,
+	0xcd, 0xe5, 0xc7, 0x75, 0x4a, 0xfb, 0xdf, 0x1c, 0xf0, 0xdb, 0x68, 0x34, 0xd8, 0xf4, 0xa8, 0xbf,
+	0xe9, 0xda, 0x66, 0xf7, 0x9b, 0x8e, 0x25, 0x18, 0x43, 0xf0, 0x0d, 0xa2, 0x96, 0xf9, 0x0e, 0xe8,
+	0xb8, 0x47, 0xf2, 0xfd, 0xf0, 0xde, 0xb7, 0x13, 0x3d, 0x18, 0xa4, 0x97, 0xd1, 0x08, 0x15, 0x45,
+	0xb0, 0xf7, 0x4b, 0x87, 0x35, 0x54, 0x10, 0x2d, 0x7c, 0x05, 0x6f, 0xa1, 0x11, 0x43, 0xe0, 0x74,
+	0xa7, 0x54, 0x01, 0x4a, 0xcf, 0x86, 0xa7, 0x20, 0x6f, 0x97, 0x8f, 0x50, 0xd8, 0x1b, 0x79, 0x12,
+	0x2e, 0x4a, 0x36, 0x2e, 0x96, 0x47, 0x4d, 0x61, 0x9b, 0x72, 0x6f, 0x97, 0x2b, 0xfd, 0x17, 0x3d,
+	0x3b, 0x90, 0x4d, 0xa4, 0x07, 0x8e, 0xbb, 0xe5, 0x80, 0x99, 0x2e, 0xf6, 0x4b, 0x69, 0x22, 0x49,
+	0x95, 0x44, 0x43, 0xfc, 0x89, 0xdb, 0xe7, 0x2c, 0x7e, 0x2a, 0xea, 0x20, 0x77, 0x67, 0x5f, 0x7f,
+	0xf1, 0x53, 0x19, 0x8b, 0x68, 0x42, 0x26, 0xa1, 0x4c, 0xf2, 0x5f, 0xe1, 0xd2, 0xee, 0xac, 0xe4,
+	0x28, 0x5d, 0x63, 0xdc, 0x0d, 0x36, 0xa9, 0x97, 0xcc, 0x27, 0x2a, 0x2c, 0x93, 0x8c, 0x45, 0xb4,
+	0x31, 0xfe, 0x28, 0xfa, 0x4e, 0x7a, 0xdc, 0xa5, 0xa7, 0xe1, 0x71, 0x7f, 0x45, 0x41, 0xd3, 0x9c,
+	0x75, 0xd9, 0xb6, 0x8b, 0x27, 0x9a, 0x0e, 0x2a, 0x79, 0xf1, 0x5b, 0x0a, 0x3a, 0x9c, 0x92, 0x06,
+	0x74, 0x7e, 0x0b, 0xed, 0x63, 0x93, 0x2a, 0xef, 0x56, 0xba, 0xd2, 0x12, 0x40, 0xb0, 0x95, 0x0a,
+	0x8c, 0xc1, 0x6d, 0xa3, 0x6f, 0xa5, 0xb6, 0x99, 0x6a, 0xa3, 0x49, 0xbd, 0x86, 0xe1, 0xb0, 0xbc,
+	0x16, 0xd7, 0xef, 0xdf, 0xc6, 0xfa, 0xf3, 0x61, 0xf4, 0xc2, 0xde, 0x1d, 0x80, 0x7a, 0x02, 0x34,
+	0x69, 0xc5, 0x55, 0xba, 0xed, 0x46, 0xa9, 0xeb, 0x85, 0x0d, 0xf7, 0x34, 0x1e, 0x0b, 0x84, 0x26,
+	0x7b, 0x67, 0xbd, 0x3e, 0x32, 0xec, 0x16, 0xd5, 0x4d, 0x6b, 0x7d, 0x9d, 0x7a, 0xd4, 0x89, 0x02,
+	0x12, 0x85, 0x7b, 0x4d, 0xe3, 0x11, 0x6d, 0x82, 0x17, 0x2d, 0x45, 0x25, 0x3c, 0x58, 0x1f, 0x1a,
+	0xd9, 0x62, 0xd5, 0xf4, 0x16, 0x0f, 0x4f, 0x05, 0xeb, 0x53, 0x10, 0xf9, 0x83, 0xf5, 0x00, 0x20,
+	0x96, 0xaa, 0x8f, 0xbf, 0xa2, 0xa0, 0xf1, 0x4d, 0x6a, 0x9b, 0x91, 0x4c, 0xc3, 0x3d, 0xc8, 0x74,
+	0x33, 0x19, 0x18, 0x96, 0xdb, 0xe7, 0x16, 0x68, 0x8c, 0xb5, 0x06, 0x69, 0xc8, 0x5f, 0x2a, 0xe9,
+	0x20, 0xd6, 0x3d, 0x97, 0x7f, 0x04, 0xc1, 0x0d, 0xcb, 0x42, 0x8b, 0x7c, 0x13, 0x8d, 0x07, 0x2c,
+	0xb8, 0x90, 0x0c, 0x3d, 0x2d, 0xe7, 0x1e, 0x69, 0xe0, 0x2a, 0x63, 0xb1, 0x6b, 0x41, 0xfe, 0x28,
+	0xc2, 0x4f, 0xdf, 0xce, 0x84, 0xcb, 0x92, 0xc2, 0xc3, 0xa4, 0x97, 0xc3, 0x49, 0x4a, 0xff, 0xe1,
+	0xa4, 0x2b, 0xe8, 0x60, 0xdd, 0x73, 0x7d, 0x9f, 0x8a, 0x54, 0x3e, 0x71, 0x03, 0x34, 0x24, 0x7b,
+	0xdc, 0x89, 0x6a, 0xa2, 0x8d, 0xc3, 0x33, 0xdf, 0xa9, 0xce, 0xfc, 0xc1, 0x25, 0xb4, 0x8f, 0x0b,
+	0x8d, 0xbf, 0xad, 0x20, 0x9e, 0x3b, 0xec, 0xe3, 0x4f, 0xf7, 0xb8, 0x4d, 0x65, 0xd2, 0xc1, 0xd5,
+	0x0b, 0x05, 0x5a, 0x0a, 0xb5, 0x90, 0xb3, 0xef, 0x7c, 0xf8, 0x2f, 0xbf, 0x57, 0x9a, 0xc7, 0x2f,
+	0x2f, 0xb4, 0xfb, 0x40, 0x2d, 0x82, 0x88, 0xbf, 0xe9, 0xe3, 0xa2, 0xfe, 0x48, 0x41, 0x93, 0xe9,
+	0x9c, 0x69, 0xbc, 0x98, 0x5b, 0x8a, 0x6c, 0x6a, 0xb7, 0xba, 0xd4, 0x1f, 0x08, 0xb0, 0x2a, 0x73,
+	0x56, 0x97, 0xf0, 0x85, 0x3c, 0xac, 0xf4, 0xda, 0x76, 0x1c, 0x79, 0xc0, 0x7f, 0xa6, 0xa0, 0xfd,
+	0xe2, 0xf2, 0x1a, 0xe7, 0x53, 0xaf, 0x7c, 0x71, 0xae, 0x5e, 0x2c, 0xd2, 0x14, 0x48, 0x9c, 0xe3,
+	0x24, 0x16, 0xf0, 0xe9, 0x5e, 0x49, 0x08, 0x69, 0x7f, 0xa0, 0xa0, 0x83, 0x89, 0xcf, 0xf7, 0xf0,
+	0xf5, 0x3c, 0x42, 0xb4, 0xfb, 0xe4, 0x50, 0x2d, 0xf7, 0x81, 0x00, 0x6c, 0x2a, 0x9c, 0xcd, 0x65,
+	0x7c, 0xb1, 0xe7, 0x21, 0x01, 0x84, 0x85, 0xdf, 0x80, 0x6f, 0xa7, 0xde, 0xc6, 0xff, 0xab, 0xa0,
+	0x23, 0xed, 0x93, 0x33, 0x71, 0x35, 0x8f, 0x84, 0x7b, 0x26, 0x8d, 0xaa, 0x37, 0x07, 0x01, 0x05,
+	0xac, 0x6f, 0x70, 0xd6, 0x15, 0x7c, 0xbd, 0x47, 0xd6, 0x01, 0x83, 0x8b, 0x67, 0x21, 0xcf, 0x77,
+	0xe2, 0x8e, 0x37, 0x7e, 0x57, 0xce, 0x5b, 0x4f, 0xa6, 0x06, 0xe3, 0x5c, 0x12, 0xef, 0x9d, 0xac,
+	0xad, 0xde, 0x1a, 0x08, 0x16, 0xd0, 0x5f, 0xe3, 0xf4, 0xab, 0x78, 0xb5, 0x47, 0xfa, 0xdc, 0x92,
+	0xd2, 0x13, 0x49, 0x52, 0x2c, 0x9c, 0x6c, 0x46, 0x4c, 0x3f, 0x54, 0xd0, 0xc1, 0x44, 0x3a, 0x62,
+	0xbe, 0xc9, 0xdd, 0x2e, 0x3f, 0x52, 0x2d, 0xf7, 0x81, 0x00, 0x3c, 0xaf, 0x70, 0x9e, 0xe7, 0xf1,
+	0xb9, 0x1e, 0x79, 0x26, 0x33, 0x1f, 0xf1, 0xbf, 0x2b, 0x68, 0xaa, 0x4d, 0x22, 0x22, 0x5e, 0x29,
+	0x24, 0x59, 0x26, 0x4d, 0x52, 0x5d, 0xed, 0x1b, 0x07, 0x78, 0x2e, 0x72, 0x9e, 0x57, 0xf0, 0xa5,
+	0xdc, 0x3c, 0xe3, 0x2c, 0x02, 0xfc, 0x81, 0x82, 0xc6, 0xe5, 0x4f, 0x6f, 0xf1, 0xb5, 0x7c, 0x7b,
+	0x7e, 0xe6, 0x53, 0x60, 0xf5, 0x7a, 0x71, 0x80, 0x82, 0x03, 0x18, 0x19, 0xe1, 0xb5, 0x6d, 0xdd,
+	0x32, 0xf1, 0x3f, 0x2a, 0x68, 0x22, 0x95, 0x51, 0x8d, 0x2b, 0x45, 0x84, 0x4a, 0xe6, 0x79, 0xab,
+	0x8b, 0x7d, 0x61, 0x00, 0xb7, 0x6b, 0x9c, 0xdb, 0x05, 0x7c, 0x3e, 0x2f, 0x37, 0x1f, 0x98, 0xfc,
+	0x84, 0xe7, 0x57, 0x64, 0x3e, 0x0b, 0xcd, 0x37, 0x3d, 0x3b, 0x7f, 0x41, 0xab, 0xae, 0xf6, 0x8d,
+	0x03, 0x4c, 0x97, 0x39, 0xd3, 0x6b, 0xf8, 0x4a, 0x5e, 0xa6, 0x96, 0xe9, 0x4b, 0x5b, 0xed, 0xf7,
+	0xd9, 0xad, 0x7a, 0xfc, 0xe1, 0x28, 0xbe, 0x9a, 0x4b, 0xbe, 0xcc, 0xf7, 0xad, 0xea, 0xb5, 0xc2,
+	0xed, 0x81, 0xd7, 0x65, 0xce, 0xeb, 0x53, 0xf8, 0x6c, 0xaf, 0xbc, 0x18, 0x06, 0xcb, 0x66, 0xe3,
+	0x77, 0xc0, 0xff, 0xaa, 0xa0, 0x43, 0x99, 0xef, 0x2c, 0x71, 0x2e, 0x43, 0xab, 0xd3, 0x17, 0xa6,
+	0xea, 0x72, 0x9f, 0x28, 0x05, 0xf7, 0x15, 0xe9, 0xfb, 0x49, 0x36, 0x6c, 0xc2, 0x51, 0xff, 0x62,
+	0x09, 0xcd, 0x74, 0x0a, 0xc7, 0xe0, 0x5c, 0xc7, 0x5a, 0x97, 0xc8, 0x99, 0x7a, 0x7b, 0x30, 0x60,
+	0x40, 0xfe, 0x26, 0x27, 0xbf, 0x84, 0x2b, 0x3d, 0x92, 0xf7, 0x00, 0x10, 0x7c, 0x3f, 0xae, 0x01,
+	0x13, 0x68, 0xfe, 0x87, 0x82, 0xa6, 0xda, 0x64, 0x41, 0xe7, 0x5b, 0xaa, 0x9d, 0x13, 0xc1, 0xd5,
+	0xd5, 0xbe, 0x71, 0x80, 0xf4, 0x12, 0x27, 0x7d, 0x15, 0x5f, 0xee, 0x91, 0xb4, 0x43, 0x1f, 0x33,
+	0x53, 0x20, 0x02, 0x13, 0x53, 0xfb, 0x6f, 0x14, 0x84, 0xe2, 0x14, 0x63, 0x7c, 0x25, 0x8f, 0x74,
+	0x99, 0xe4, 0x69, 0xf5, 0x6a, 0xd1, 0xe6, 0xc0, 0xe9, 0x22, 0xe7, 0x74, 0x16, 0x9f, 0xe9, 0x91,
+	0x93, 0x94, 0xc6, 0x8c, 0x7f, 0xac, 0x20, 0x9c, 0x4d, 0x1b, 0xc6, 0xcb, 0x79, 0xdd, 0xa1, 0xb6,
+	0x69, 0xcc, 0xea, 0x4a, 0xbf, 0x30, 0x05, 0xd7, 0x29, 0x0f, 0x0b, 0x30, 0x9a, 0x86, 0xc4, 0x89,
+	0x0d, 0x5a, 0x9c, 0x1a, 0x9c, 0x6f, 0xd0, 0x32, 0xa9, 0xc9, 0xea, 0xd5, 0xa2, 0xcd, 0x0b, 0x0e,
+	0x1a, 0xa7, 0x04, 0xae, 0xd6, 0xdf, 0xf2, 0xf4, 0xab, 0x28, 0xe3, 0x34, 0xe7, 0x41, 0x91, 0xc9,
+	0x7f, 0x55, 0xaf, 0x15, 0x6e, 0x0f, 0x64, 0x2e, 0x71, 0x32, 0xe7, 0xf0, 0xab, 0x79, 0x0f, 0x40,
+	0x96, 0xd7, 0xfa, 0x9f, 0x0a, 0x9a, 0x6e, 0x97, 0x44, 0x88, 0x57, 0xf3, 0xaa, 0xb8, 0x43, 0x56,
+	0xa7, 0x7a, 0xa3, 0x7f, 0xa0, 0xc2, 0x27, 0x3d, 0x8b, 0x4f, 0xa5, 0xb3, 0x13, 0xf9, 0xd1, 0x98,
+	0xc9, 0x05, 0xc4, 0xf9, 0x63, 0x10, 0x6d, 0xb2, 0x18, 0xd5, 0xe5, 0x3e, 0x51, 0xfa, 0x58, 0x72,
+	0x3e, 0x9c, 0x09, 0x2c, 0x5b, 0xb0, 0xc9, 0x18, 0xed, 0xc2, 0xd0, 0xa6, 0xb3, 0xe4, 0xf2, 0x0f,
+	0x6d, 0x87, 0x1c, 0x47, 0xf5, 0x46, 0xff, 0x40, 0x40, 0x78, 0x95, 0x13, 0x2e, 0xe3, 0x6b, 0xb9,
+	0x09, 0x33, 0xaa, 0xfa, 0x96, 0x15, 0x6c, 0x0a, 0xaf, 0xea, 0xbf, 0x15, 0x74, 0xb8, 0x6d, 0x0a,
+	0x1d, 0xbe, 0x51, 0xc8, 0xc7, 0x6d, 0x93, 0xd8, 0xa7, 0x56, 0x07, 0x80, 0x04, 0xbc, 0x57, 0x38,
+	0xef, 0xeb, 0xf8, 0x6a, 0x8f, 0xbc, 0xa3, 0x12, 0x7d, 0x0b, 0xe0, 0xc4, 0x99, 0xf8, 0xdb, 0x25,
+	0x74, 0xac, 0x63, 0x7e, 0x1a, 0xce, 0x65, 0xba, 0x74, 0x4b, 0xe8, 0x53, 0x5f, 0x1b, 0x10, 0x1a,
+	0xa8, 0xe0, 0x36, 0x57, 0xc1, 0x0a, 0x5e, 0xea, 0xd5, 0x0c, 0x04, 0x44, 0x9d, 0xe7, 0x9f, 0x52,
+	0x86, 0xa9, 0x47, 0x49, 0x68, 0xf8, 0xef, 0x98, 0x9f, 0x29, 0xa5, 0x61, 0xe5, 0xf4, 0x33, 0xb3,
+	0xd9, 0x69, 0xea, 0xf5, 0xe2, 0x00, 0x85, 0x2d, 0x79, 0x29, 0x05, 0x0d, 0x7f, 0x57, 0x41, 0xa3,
+	0x51, 0xf2, 0x17, 0xbe, 0x9c, 0x77, 0xc9, 0xc9, 0xa9, 0x67, 0xea, 0x95, 0x82, 0xad, 0x81, 0xc8,
+	0x05, 0x4e, 0xe4, 0x55, 0xfc, 0x4a, 0x9e, 0x0d, 0xd8, 0xe7, 0x72, 0xb3, 0x4d, 0x37, 0x93, 0x62,
+	0x95, 0x6f, 0xd3, 0xed, 0x94, 0xfc, 0xa5, 0x2e, 0xf7, 0x89, 0x52, 0x70, 0xd3, 0xb5, 0x7c, 0x3d,
+	0xf6, 0x25, 0x21, 0x0d, 0x0c, 0xff, 0x56, 0x09, 0xcd, 0x74, 0x4a, 0x77, 0xca, 0xe7, 0x8f, 0x74,
+	0x49, 0xcb, 0x52, 0x6f, 0x0f, 0x06, 0x0c, 0xc8, 0x57, 0x39, 0xf9, 0x45, 0x5c, 0xce, 0x6b, 0x44,
+	0xd4, 0x23, 0x44, 0xbd, 0x26, 0x58, 0xbe, 0x23, 0xa9, 0x20, 0x9d, 0xfc, 0x52, 0x4c, 0x05, 0x1d,
+	0x32, 0x8c, 0xd4, 0xdb, 0x83, 0x01, 0x03, 0x15, 0xdc, 0xe2, 0x2a, 0x58, 0xc6, 0x8b, 0x39, 0x55,
+	0xc0, 0xef, 0x10, 0x7e, 0xdd, 0xb5, 0x1c, 0x5d, 0xfc, 0x7f, 0x31, 0xce, 0xf3, 0xa7, 0x0a, 0x3a,
+	0xd2, 0x3e, 0xb5, 0x24, 0x5f, 0xd4, 0x7a, 0xcf, 0xec, 0x1b, 0xf5, 0xe6, 0x20, 0xa0, 0x0a, 0x1f,
+	0xc1, 0xa1, 0x19, 0x29, 0xf0, 0xf4, 0x30, 0x09, 0xe6, 0x3b, 0x0a, 0x3a, 0x10, 0xde, 0xce, 0xe3,
+	0x4b, 0x79, 0x24, 0x4c, 0x65, 0x18, 0xa8, 0x97, 0x8b, 0x35, 0x06, 0x42, 0x9f, 0xe6, 0x84, 0xce,
+	0xe0, 0x5f, 0xe9, 0x91, 0x90, 0x61, 0xdb, 0x10, 0x54, 0xf8, 0x3f, 0x05, 0x1d, 0xed, 0x70, 0x9f,
+	0x8e, 0x0b, 0xa9, 0xbc, 0xfd, 0xad, 0xbf, 0x7a, 0x6b, 0x20, 0x58, 0x05, 0x6f, 0x1d, 0xe2, 0xbd,
+	0x2b, 0x75, 0x8d, 0x8f, 0xff, 0x47, 0xb6, 0xa1, 0xe4, 0x7b, 0xd5, 0x82, 0x36, 0x54, 0x9b, 0x7b,
+	0x65, 0xb5, 0x3a, 0x00, 0xa4, 0x82, 0x13, 0x37, 0x2a, 0xd1, 0x03, 0x57, 0xfc, 0x37, 0x40, 0x11,
+	0x55, 0xaa, 0xd4, 0xde, 0x7f, 0x32, 0xab, 0x7c, 0xf0, 0x64, 0x56, 0xf9, 0xd1, 0x93, 0x59, 0xe5,
+	0xab, 0x1f, 0xcd, 0x3e, 0xf3, 0xc1, 0x47, 0xb3, 0xcf, 0xfc, 0xe0, 0xa3, 0xd9, 0x67, 0xde, 0xb8,
+	0x21, 0x5d, 0x5d, 0x43, 0x27, 0xa7, 0x6d, 0xa3, 0xe6, 0x47, 0x3d, 0x3e, 0x7a, 0xe5, 0xdc, 0xc2,
+	0xe3, 0x4e, 0xff, 0xe6, 0x93, 0x5f, 0x6d, 0x8b, 0x6b, 0x8e, 0xda, 0x7e, 0x7e, 0xb8, 0xbf, 0xfa,
+	0xff, 0x03, 0x00, 0x60, 0x61, 0x4a, 0x1c, 0x03, 0x56, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Pools returns all concentrated liquidity pools
+	Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error)
+	// PoolsByLiquidity returns concentrated liquidity pools sorted by current
+	// active liquidity in descending order. Only offset-based pagination is
+	// supported.
+	PoolsByLiquidity(ctx context.Context, in *QueryPoolsByLiquidityRequest, opts ...grpc.CallOption) (*QueryPoolsByLiquidityResponse, error)
+	// Params returns concentrated liquidity module params.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// UserPositions returns all concentrated postitions of some address.
+	UserPositions(ctx context.Context, in *QueryUserPositionsRequest, opts ...grpc.CallOption) (*QueryUserPositionsResponse, error)
+	// TotalLiquidityForRange the amount of liquidity existing within given range.
+	TotalLiquidityForRange(ctx context.Context, in *QueryTotalLiquidityForRangeRequest, opts ...grpc.CallOption) (*QueryTotalLiquidityForRangeResponse, error)
+	// LiquidityNetInDirection returns liquidity net in the direction given.
+	// Uses the bound if specified, if not uses either min tick / max tick
+	// depending on the direction.
+	LiquidityNetInDirection(ctx context.Context, in *QueryLiquidityNetInDirectionRequest, opts ...grpc.CallOption) (*QueryLiquidityNetInDirectionResponse, error)
+	// ClaimableFees returns the amount of fees that can be claimed by a position
+	// with the given id.
+	ClaimableFees(ctx context.Context, in *QueryClaimableFeesRequest, opts ...grpc.CallOption) (*QueryClaimableFeesResponse, error)
+	// ClaimableIncentives returns the amount of incentives that can be claimed
+	// by a position with the given id, and the amount that would be forfeited
+	// if claimed now.
+	ClaimableIncentives(ctx context.Context, in *QueryClaimableIncentivesRequest, opts ...grpc.CallOption) (*QueryClaimableIncentivesResponse, error)
+	// PositionById returns a position with the given id. A position as of a past
+	// block height is queried by setting the height of the query, e.g. with the
+	// x-cosmos-block-height header, against a node that retains that state.
+	PositionById(ctx context.Context, in *QueryPositionByIdRequest, opts ...grpc.CallOption) (*QueryPositionByIdResponse, error)
+	// PositionSummary returns a position with the given id alongside its
+	// underlying assets, claimable fees and claimable incentives, so that a
+	// position can be rendered with a single query.
+	PositionSummary(ctx context.Context, in *QueryPositionSummaryRequest, opts ...grpc.CallOption) (*QueryPositionSummaryResponse, error)
+	// PositionIdsForRange returns the ids of all positions an owner has in a
+	// pool with exactly the given lower and upper ticks.
+	PositionIdsForRange(ctx context.Context, in *QueryPositionIdsForRangeRequest, opts ...grpc.CallOption) (*QueryPositionIdsForRangeResponse, error)
+	// PriceAtTick returns the sqrt price and spot price at the given tick of a
+	// pool, derived from the pool's exponent at price one.
+	PriceAtTick(ctx context.Context, in *QueryPriceAtTickRequest, opts ...grpc.CallOption) (*QueryPriceAtTickResponse, error)
+	// SqrtPriceForTicks returns the sqrt prices at the lower and upper ticks of
+	// a range, derived from the pool's exponent at price one.
+	SqrtPriceForTicks(ctx context.Context, in *QuerySqrtPriceForTicksRequest, opts ...grpc.CallOption) (*QuerySqrtPriceForTicksResponse, error)
+	// RequiredAmountForDeposit returns the amount of the pool's other denom
+	// that must be deposited alongside the known amount to create a position in
+	// the given range at the current price, and the resulting liquidity.
+	RequiredAmountForDeposit(ctx context.Context, in *QueryRequiredAmountForDepositRequest, opts ...grpc.CallOption) (*QueryRequiredAmountForDepositResponse, error)
+	// NextInitializedTick returns the next tick with non-zero liquidity gross
+	// in the given direction from the start tick, alongside its liquidity net.
+	NextInitializedTick(ctx context.Context, in *QueryNextInitializedTickRequest, opts ...grpc.CallOption) (*QueryNextInitializedTickResponse, error)
+	// FeeRevenue returns the swap fees collected by a pool since the given start
+	// time.
+	FeeRevenue(ctx context.Context, in *QueryFeeRevenueRequest, opts ...grpc.CallOption) (*QueryFeeRevenueResponse, error)
+	// PoolFeeAccumulator returns the current value of a pool's global fee
+	// accumulator, the total fee growth per unit of liquidity from which the
+	// fees of every position are derived.
+	PoolFeeAccumulator(ctx context.Context, in *QueryPoolFeeAccumulatorRequest, opts ...grpc.CallOption) (*QueryPoolFeeAccumulatorResponse, error)
+	// PoolParams returns the parameters needed for tick math on a pool: its
+	// tick spacing, exponent at price one and swap fee, alongside its current
+	// tick and sqrt price.
+	PoolParams(ctx context.Context, in *QueryPoolParamsRequest, opts ...grpc.CallOption) (*QueryPoolParamsResponse, error)
+	// PositionApr returns an estimate of the annualized return of a position
+	// from fees and incentives. It is a forward projection from the pool's
+	// recent fee revenue and current incentive emission rates, not a realized
+	// return.
+	PositionApr(ctx context.Context, in *QueryPositionAprRequest, opts ...grpc.CallOption) (*QueryPositionAprResponse, error)
+	// PoolIncentiveRecords returns the incentive records of a pool that have
+	// incentives remaining, alongside the time each one will be exhausted at its
+	// emission rate.
+	PoolIncentiveRecords(ctx context.Context, in *QueryPoolIncentiveRecordsRequest, opts ...grpc.CallOption) (*QueryPoolIncentiveRecordsResponse, error)
+	// PoolsForDenomPair returns the ids of all concentrated liquidity pools
+	// whose token0 and token1 match the given denoms, in either order.
+	PoolsForDenomPair(ctx context.Context, in *QueryPoolsForDenomPairRequest, opts ...grpc.CallOption) (*QueryPoolsForDenomPairResponse, error)
+	// PoolsForPairWithFees returns every concentrated liquidity pool whose
+	// token0 and token1 match the given denoms, in either order, alongside its
+	// swap fee and current liquidity so that routers can compare fee tiers.
+	PoolsForPairWithFees(ctx context.Context, in *QueryPoolsForPairWithFeesRequest, opts ...grpc.CallOption) (*QueryPoolsForPairWithFeesResponse, error)
+	// LiquidityWeightedTick returns the average of a pool's initialized ticks,
+	// weighted by each tick's gross liquidity, alongside the spot price at that
+	// tick.
+	LiquidityWeightedTick(ctx context.Context, in *QueryLiquidityWeightedTickRequest, opts ...grpc.CallOption) (*QueryLiquidityWeightedTickResponse, error)
+	// SimulateSwapExactAmountIn simulates swapping an exact amount in at the
+	// pool's swap fee without changing state. It returns the amount out, the
+	// swap fee charged and the amount in that is swapped after the fee.
+	SimulateSwapExactAmountIn(ctx context.Context, in *QuerySimulateSwapExactAmountInRequest, opts ...grpc.CallOption) (*QuerySimulateSwapExactAmountInResponse, error)
+	// ProtocolFees returns the protocol fees accrued by a pool that can be
+	// withdrawn by the protocol fee recipient.
+	ProtocolFees(ctx context.Context, in *QueryProtocolFeesRequest, opts ...grpc.CallOption) (*QueryProtocolFeesResponse, error)
+	// PoolStats returns the number of open positions in a pool, the number of
+	// distinct addresses owning them and the pool's active liquidity.
+	PoolStats(ctx context.Context, in *QueryPoolStatsRequest, opts ...grpc.CallOption) (*QueryPoolStatsResponse, error)
+	// IsPositionInRange returns whether the current tick of a position's pool is
+	// within the position's range, alongside the current tick and the position's
+	// lower and upper ticks.
+	IsPositionInRange(ctx context.Context, in *QueryIsPositionInRangeRequest, opts ...grpc.CallOption) (*QueryIsPositionInRangeResponse, error)
+	// PositionConversionBounds returns the ticks and spot prices at which a
+	// position is entirely converted to token0 (its lower tick) or token1 (its
+	// upper tick), and whether the current price is already outside its range.
+	PositionConversionBounds(ctx context.Context, in *QueryPositionConversionBoundsRequest, opts ...grpc.CallOption) (*QueryPositionConversionBoundsResponse, error)
+	// PositionsByJoinTimeRange returns all positions with a join time within
+	// the given inclusive range, ordered by join time.
+	PositionsByJoinTimeRange(ctx context.Context, in *QueryPositionsByJoinTimeRangeRequest, opts ...grpc.CallOption) (*QueryPositionsByJoinTimeRangeResponse, error)
+	// PositionAccruedExceeds returns whether the fees accrued by a position
+	// exceed the given threshold in any of its denoms, along with the accrued
+	// fees.
+	PositionAccruedExceeds(ctx context.Context, in *QueryPositionAccruedExceedsRequest, opts ...grpc.CallOption) (*QueryPositionAccruedExceedsResponse, error)
+	// AllTicks returns every initialized tick of the given pool with its full
+	// tick info, ordered by ascending tick index. It is meant for indexers that
+	// maintain a full replica of a pool's state, and is heavier than
+	// LiquidityNetInDirection.
+	AllTicks(ctx context.Context, in *QueryAllTicksRequest, opts ...grpc.CallOption) (*QueryAllTicksResponse, error)
+	// PositionImpermanentLoss returns an estimate of a position's impermanent
+	// loss, comparing its current value against the value of holding the amounts
+	// it was created with. Both are priced at the pool's current sqrt price.
+	PositionImpermanentLoss(ctx context.Context, in *QueryPositionImpermanentLossRequest, opts ...grpc.CallOption) (*QueryPositionImpermanentLossResponse, error)
+	// LiquidityToReachPrice returns the amount that would have to be swapped
+	// into a pool, including the swap fee, to move its spot price from the
+	// current price to a target price, alongside the initialized ticks the swap
+	// would cross.
+	LiquidityToReachPrice(ctx context.Context, in *QueryLiquidityToReachPriceRequest, opts ...grpc.CallOption) (*QueryLiquidityToReachPriceResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Pools(ctx context.Context, in *QueryPoolsRequest, opts ...grpc.CallOption) (*QueryPoolsResponse, error) {
+	out := new(QueryPoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/Pools", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolsByLiquidity(ctx context.Context, in *QueryPoolsByLiquidityRequest, opts ...grpc.CallOption) (*QueryPoolsByLiquidityResponse, error) {
+	out := new(QueryPoolsByLiquidityResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PoolsByLiquidity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UserPositions(ctx context.Context, in *QueryUserPositionsRequest, opts ...grpc.CallOption) (*QueryUserPositionsResponse, error) {
+	out := new(QueryUserPositionsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/UserPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalLiquidityForRange(ctx context.Context, in *QueryTotalLiquidityForRangeRequest, opts ...grpc.CallOption) (*QueryTotalLiquidityForRangeResponse, error) {
+	out := new(QueryTotalLiquidityForRangeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/TotalLiquidityForRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidityNetInDirection(ctx context.Context, in *QueryLiquidityNetInDirectionRequest, opts ...grpc.CallOption) (*QueryLiquidityNetInDirectionResponse, error) {
+	out := new(QueryLiquidityNetInDirectionResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityNetInDirection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClaimableFees(ctx context.Context, in *QueryClaimableFeesRequest, opts ...grpc.CallOption) (*QueryClaimableFeesResponse, error) {
+	out := new(QueryClaimableFeesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClaimableIncentives(ctx context.Context, in *QueryClaimableIncentivesRequest, opts ...grpc.CallOption) (*QueryClaimableIncentivesResponse, error) {
+	out := new(QueryClaimableIncentivesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableIncentives", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PositionById(ctx context.Context, in *QueryPositionByIdRequest, opts ...grpc.CallOption) (*QueryPositionByIdResponse, error) {
+	out := new(QueryPositionByIdResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PositionById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PositionSummary(ctx context.Context, in *QueryPositionSummaryRequest, opts ...grpc.CallOption) (*QueryPositionSummaryResponse, error) {
+	out := new(QueryPositionSummaryResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PositionSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PositionIdsForRange(ctx context.Context, in *QueryPositionIdsForRangeRequest, opts ...grpc.CallOption) (*QueryPositionIdsForRangeResponse, error) {
+	out := new(QueryPositionIdsForRangeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PositionIdsForRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PriceAtTick(ctx context.Context, in *QueryPriceAtTickRequest, opts ...grpc.CallOption) (*QueryPriceAtTickResponse, error) {
+	out := new(QueryPriceAtTickResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PriceAtTick", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SqrtPriceForTicks(ctx context.Context, in *QuerySqrtPriceForTicksRequest, opts ...grpc.CallOption) (*QuerySqrtPriceForTicksResponse, error) {
+	out := new(QuerySqrtPriceForTicksResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/SqrtPriceForTicks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RequiredAmountForDeposit(ctx context.Context, in *QueryRequiredAmountForDepositRequest, opts ...grpc.CallOption) (*QueryRequiredAmountForDepositResponse, error) {
+	out := new(QueryRequiredAmountForDepositResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/RequiredAmountForDeposit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NextInitializedTick(ctx context.Context, in *QueryNextInitializedTickRequest, opts ...grpc.CallOption) (*QueryNextInitializedTickResponse, error) {
+	out := new(QueryNextInitializedTickResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/NextInitializedTick", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeRevenue(ctx context.Context, in *QueryFeeRevenueRequest, opts ...grpc.CallOption) (*QueryFeeRevenueResponse, error) {
+	out := new(QueryFeeRevenueResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/FeeRevenue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolFeeAccumulator(ctx context.Context, in *QueryPoolFeeAccumulatorRequest, opts ...grpc.CallOption) (*QueryPoolFeeAccumulatorResponse, error) {
+	out := new(QueryPoolFeeAccumulatorResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PoolFeeAccumulator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolParams(ctx context.Context, in *QueryPoolParamsRequest, opts ...grpc.CallOption) (*QueryPoolParamsResponse, error) {
+	out := new(QueryPoolParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PoolParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PositionApr(ctx context.Context, in *QueryPositionAprRequest, opts ...grpc.CallOption) (*QueryPositionAprResponse, error) {
+	out := new(QueryPositionAprResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PositionApr", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolIncentiveRecords(ctx context.Context, in *QueryPoolIncentiveRecordsRequest, opts ...grpc.CallOption) (*QueryPoolIncentiveRecordsResponse, error) {
+	out := new(QueryPoolIncentiveRecordsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PoolIncentiveRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolsForDenomPair(ctx context.Context, in *QueryPoolsForDenomPairRequest, opts ...grpc.CallOption) (*QueryPoolsForDenomPairResponse, error) {
+	out := new(QueryPoolsForDenomPairResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PoolsForDenomPair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolsForPairWithFees(ctx context.Context, in *QueryPoolsForPairWithFeesRequest, opts ...grpc.CallOption) (*QueryPoolsForPairWithFeesResponse, error) {
+	out := new(QueryPoolsForPairWithFeesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PoolsForPairWithFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidityWeightedTick(ctx context.Context, in *QueryLiquidityWeightedTickRequest, opts ...grpc.CallOption) (*QueryLiquidityWeightedTickResponse, error) {
+	out := new(QueryLiquidityWeightedTickResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityWeightedTick", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SimulateSwapExactAmountIn(ctx context.Context, in *QuerySimulateSwapExactAmountInRequest, opts ...grpc.CallOption) (*QuerySimulateSwapExactAmountInResponse, error) {
+	out := new(QuerySimulateSwapExactAmountInResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/SimulateSwapExactAmountIn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProtocolFees(ctx context.Context, in *QueryProtocolFeesRequest, opts ...grpc.CallOption) (*QueryProtocolFeesResponse, error) {
+	out := new(QueryProtocolFeesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/ProtocolFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolStats(ctx context.Context, in *QueryPoolStatsRequest, opts ...grpc.CallOption) (*QueryPoolStatsResponse, error) {
+	out := new(QueryPoolStatsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PoolStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IsPositionInRange(ctx context.Context, in *QueryIsPositionInRangeRequest, opts ...grpc.CallOption) (*QueryIsPositionInRangeResponse, error) {
+	out := new(QueryIsPositionInRangeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/IsPositionInRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PositionConversionBounds(ctx context.Context, in *QueryPositionConversionBoundsRequest, opts ...grpc.CallOption) (*QueryPositionConversionBoundsResponse, error) {
+	out := new(QueryPositionConversionBoundsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PositionConversionBounds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PositionsByJoinTimeRange(ctx context.Context, in *QueryPositionsByJoinTimeRangeRequest, opts ...grpc.CallOption) (*QueryPositionsByJoinTimeRangeResponse, error) {
+	out := new(QueryPositionsByJoinTimeRangeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PositionsByJoinTimeRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PositionAccruedExceeds(ctx context.Context, in *QueryPositionAccruedExceedsRequest, opts ...grpc.CallOption) (*QueryPositionAccruedExceedsResponse, error) {
+	out := new(QueryPositionAccruedExceedsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PositionAccruedExceeds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AllTicks(ctx context.Context, in *QueryAllTicksRequest, opts ...grpc.CallOption) (*QueryAllTicksResponse, error) {
+	out := new(QueryAllTicksResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/AllTicks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PositionImpermanentLoss(ctx context.Context, in *QueryPositionImpermanentLossRequest, opts ...grpc.CallOption) (*QueryPositionImpermanentLossResponse, error) {
+	out := new(QueryPositionImpermanentLossResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/PositionImpermanentLoss", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LiquidityToReachPrice(ctx context.Context, in *QueryLiquidityToReachPriceRequest, opts ...grpc.CallOption) (*QueryLiquidityToReachPriceResponse, error) {
+	out := new(QueryLiquidityToReachPriceResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityToReachPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Pools returns all concentrated liquidity pools
+	Pools(context.Context, *QueryPoolsRequest) (*QueryPoolsResponse, error)
+	// PoolsByLiquidity returns concentrated liquidity pools sorted by current
+	// active liquidity in descending order. Only offset-based pagination is
+	// supported.
+	PoolsByLiquidity(context.Context, *QueryPoolsByLiquidityRequest) (*QueryPoolsByLiquidityResponse, error)
+	// Params returns concentrated liquidity module params.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// UserPositions returns all concentrated postitions of some address.
+	UserPositions(context.Context, *QueryUserPositionsRequest) (*QueryUserPositionsResponse, error)
+	// TotalLiquidityForRange the amount of liquidity existing within given range.
+	TotalLiquidityForRange(context.Context, *QueryTotalLiquidityForRangeRequest) (*QueryTotalLiquidityForRangeResponse, error)
+	// LiquidityNetInDirection returns liquidity net in the direction given.
+	// Uses the bound if specified, if not uses either min tick / max tick
+	// depending on the direction.
+	LiquidityNetInDirection(context.Context, *QueryLiquidityNetInDirectionRequest) (*QueryLiquidityNetInDirectionResponse, error)
+	// ClaimableFees returns the amount of fees that can be claimed by a position
+	// with the given id.
+	ClaimableFees(context.Context, *QueryClaimableFeesRequest) (*QueryClaimableFeesResponse, error)
+	// ClaimableIncentives returns the amount of incentives that can be claimed
+	// by a position with the given id, and the amount that would be forfeited
+	// if claimed now.
+	ClaimableIncentives(context.Context, *QueryClaimableIncentivesRequest) (*QueryClaimableIncentivesResponse, error)
+	// PositionById returns a position with the given id. A position as of a past
+	// block height is queried by setting the height of the query, e.g. with the
+	// x-cosmos-block-height header, against a node that retains that state.
+	PositionById(context.Context, *QueryPositionByIdRequest) (*QueryPositionByIdResponse, error)
+	// PositionSummary returns a position with the given id alongside its
+	// underlying assets, claimable fees and claimable incentives, so that a
+	// position can be rendered with a single query.
+	PositionSummary(context.Context, *QueryPositionSummaryRequest) (*QueryPositionSummaryResponse, error)
+	// PositionIdsForRange returns the ids of all positions an owner has in a
+	// pool with exactly the given lower and upper ticks.
+	PositionIdsForRange(context.Context, *QueryPositionIdsForRangeRequest) (*QueryPositionIdsForRangeResponse, error)
+	// PriceAtTick returns the sqrt price and spot price at the given tick of a
+	// pool, derived from the pool's exponent at price one.
+	PriceAtTick(context.Context, *QueryPriceAtTickRequest) (*QueryPriceAtTickResponse, error)
+	// SqrtPriceForTicks returns the sqrt prices at the lower and upper ticks of
+	// a range, derived from the pool's exponent at price one.
+	SqrtPriceForTicks(context.Context, *QuerySqrtPriceForTicksRequest) (*QuerySqrtPriceForTicksResponse, error)
+	// RequiredAmountForDeposit returns the amount of the pool's other denom
+	// that must be deposited alongside the known amount to create a position in
+	// the given range at the current price, and the resulting liquidity.
+	RequiredAmountForDeposit(context.Context, *QueryRequiredAmountForDepositRequest) (*QueryRequiredAmountForDepositResponse, error)
+	// NextInitializedTick returns the next tick with non-zero liquidity gross
+	// in the given direction from the start tick, alongside its liquidity net.
+	NextInitializedTick(context.Context, *QueryNextInitializedTickRequest) (*QueryNextInitializedTickResponse, error)
+	// FeeRevenue returns the swap fees collected by a pool since the given start
+	// time.
+	FeeRevenue(context.Context, *QueryFeeRevenueRequest) (*QueryFeeRevenueResponse, error)
+	// PoolFeeAccumulator returns the current value of a pool's global fee
+	// accumulator, the total fee growth per unit of liquidity from which the
+	// fees of every position are derived.
+	PoolFeeAccumulator(context.Context, *QueryPoolFeeAccumulatorRequest) (*QueryPoolFeeAccumulatorResponse, error)
+	// PoolParams returns the parameters needed for tick math on a pool: its
+	// tick spacing, exponent at price one and swap fee, alongside its current
+	// tick and sqrt price.
+	PoolParams(context.Context, *QueryPoolParamsRequest) (*QueryPoolParamsResponse, error)
+	// PositionApr returns an estimate of the annualized return of a position
+	// from fees and incentives. It is a forward projection from the pool's
+	// recent fee revenue and current incentive emission rates, not a realized
+	// return.
+	PositionApr(context.Context, *QueryPositionAprRequest) (*QueryPositionAprResponse, error)
+	// PoolIncentiveRecords returns the incentive records of a pool that have
+	// incentives remaining, alongside the time each one will be exhausted at its
+	// emission rate.
+	PoolIncentiveRecords(context.Context, *QueryPoolIncentiveRecordsRequest) (*QueryPoolIncentiveRecordsResponse, error)
+	// PoolsForDenomPair returns the ids of all concentrated liquidity pools
+	// whose token0 and token1 match the given denoms, in either order.
+	PoolsForDenomPair(context.Context, *QueryPoolsForDenomPairRequest) (*QueryPoolsForDenomPairResponse, error)
+	// PoolsForPairWithFees returns every concentrated liquidity pool whose
+	// token0 and token1 match the given denoms, in either order, alongside its
+	// swap fee and current liquidity so that routers can compare fee tiers.
+	PoolsForPairWithFees(context.Context, *QueryPoolsForPairWithFeesRequest) (*QueryPoolsForPairWithFeesResponse, error)
+	// LiquidityWeightedTick returns the average of a pool's initialized ticks,
+	// weighted by each tick's gross liquidity, alongside the spot price at that
+	// tick.
+	LiquidityWeightedTick(context.Context, *QueryLiquidityWeightedTickRequest) (*QueryLiquidityWeightedTickResponse, error)
+	// SimulateSwapExactAmountIn simulates swapping an exact amount in at the
+	// pool's swap fee without changing state. It returns the amount out, the
+	// swap fee charged and the amount in that is swapped after the fee.
+	SimulateSwapExactAmountIn(context.Context, *QuerySimulateSwapExactAmountInRequest) (*QuerySimulateSwapExactAmountInResponse, error)
+	// ProtocolFees returns the protocol fees accrued by a pool that can be
+	// withdrawn by the protocol fee recipient.
+	ProtocolFees(context.Context, *QueryProtocolFeesRequest) (*QueryProtocolFeesResponse, error)
+	// PoolStats returns the number of open positions in a pool, the number of
+	// distinct addresses owning them and the pool's active liquidity.
+	PoolStats(context.Context, *QueryPoolStatsRequest) (*QueryPoolStatsResponse, error)
+	// IsPositionInRange returns whether the current tick of a position's pool is
+	// within the position's range, alongside the current tick and the position's
+	// lower and upper ticks.
+	IsPositionInRange(context.Context, *QueryIsPositionInRangeRequest) (*QueryIsPositionInRangeResponse, error)
+	// PositionConversionBounds returns the ticks and spot prices at which a
+	// position is entirely converted to token0 (its lower tick) or token1 (its
+	// upper tick), and whether the current price is already outside its range.
+	PositionConversionBounds(context.Context, *QueryPositionConversionBoundsRequest) (*QueryPositionConversionBoundsResponse, error)
+	// PositionsByJoinTimeRange returns all positions with a join time within
+	// the given inclusive range, ordered by join time.
+	PositionsByJoinTimeRange(context.Context, *QueryPositionsByJoinTimeRangeRequest) (*QueryPositionsByJoinTimeRangeResponse, error)
+	// PositionAccruedExceeds returns whether the fees accrued by a position
+	// exceed the given threshold in any of its denoms, along with the accrued
+	// fees.
+	PositionAccruedExceeds(context.Context, *QueryPositionAccruedExceedsRequest) (*QueryPositionAccruedExceedsResponse, error)
+	// AllTicks returns every initialized tick of the given pool with its full
+	// tick info, ordered by ascending tick index. It is meant for indexers that
+	// maintain a full replica of a pool's state, and is heavier than
+	// LiquidityNetInDirection.
+	AllTicks(context.Context, *QueryAllTicksRequest) (*QueryAllTicksResponse, error)
+	// PositionImpermanentLoss returns an estimate of a position's impermanent
+	// loss, comparing its current value against the value of holding the amounts
+	// it was created with. Both are priced at the pool's current sqrt price.
+	PositionImpermanentLoss(context.Context, *QueryPositionImpermanentLossRequest) (*QueryPositionImpermanentLossResponse, error)
+	// LiquidityToReachPrice returns the amount that would have to be swapped
+	// into a pool, including the swap fee, to move its spot price from the
+	// current price to a target price, alongside the initialized ticks the swap
+	// would cross.
+	LiquidityToReachPrice(context.Context, *QueryLiquidityToReachPriceRequest) (*QueryLiquidityToReachPriceResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Pools(ctx context.Context, req *QueryPoolsRequest) (*QueryPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Pools not implemented")
+}
+func (*UnimplementedQueryServer) PoolsByLiquidity(ctx context.Context, req *QueryPoolsByLiquidityRequest) (*QueryPoolsByLiquidityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolsByLiquidity not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) UserPositions(ctx context.Context, req *QueryUserPositionsRequest) (*QueryUserPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserPositions not implemented")
+}
+func (*UnimplementedQueryServer) TotalLiquidityForRange(ctx context.Context, req *QueryTotalLiquidityForRangeRequest) (*QueryTotalLiquidityForRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalLiquidityForRange not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityNetInDirection(ctx context.Context, req *QueryLiquidityNetInDirectionRequest) (*QueryLiquidityNetInDirectionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityNetInDirection not implemented")
+}
+func (*UnimplementedQueryServer) ClaimableFees(ctx context.Context, req *QueryClaimableFeesRequest) (*QueryClaimableFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableFees not implemented")
+}
+func (*UnimplementedQueryServer) ClaimableIncentives(ctx context.Context, req *QueryClaimableIncentivesRequest) (*QueryClaimableIncentivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableIncentives not implemented")
+}
+func (*UnimplementedQueryServer) PositionById(ctx context.Context, req *QueryPositionByIdRequest) (*QueryPositionByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PositionById not implemented")
+}
+func (*UnimplementedQueryServer) PositionSummary(ctx context.Context, req *QueryPositionSummaryRequest) (*QueryPositionSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PositionSummary not implemented")
+}
+func (*UnimplementedQueryServer) PositionIdsForRange(ctx context.Context, req *QueryPositionIdsForRangeRequest) (*QueryPositionIdsForRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PositionIdsForRange not implemented")
+}
+func (*UnimplementedQueryServer) PriceAtTick(ctx context.Context, req *QueryPriceAtTickRequest) (*QueryPriceAtTickResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceAtTick not implemented")
+}
+func (*UnimplementedQueryServer) SqrtPriceForTicks(ctx context.Context, req *QuerySqrtPriceForTicksRequest) (*QuerySqrtPriceForTicksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SqrtPriceForTicks not implemented")
+}
+func (*UnimplementedQueryServer) RequiredAmountForDeposit(ctx context.Context, req *QueryRequiredAmountForDepositRequest) (*QueryRequiredAmountForDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequiredAmountForDeposit not implemented")
+}
+func (*UnimplementedQueryServer) NextInitializedTick(ctx context.Context, req *QueryNextInitializedTickRequest) (*QueryNextInitializedTickResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NextInitializedTick not implemented")
+}
+func (*UnimplementedQueryServer) FeeRevenue(ctx context.Context, req *QueryFeeRevenueRequest) (*QueryFeeRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeRevenue not implemented")
+}
+func (*UnimplementedQueryServer) PoolFeeAccumulator(ctx context.Context, req *QueryPoolFeeAccumulatorRequest) (*QueryPoolFeeAccumulatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolFeeAccumulator not implemented")
+}
+func (*UnimplementedQueryServer) PoolParams(ctx context.Context, req *QueryPoolParamsRequest) (*QueryPoolParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolParams not implemented")
+}
+func (*UnimplementedQueryServer) PositionApr(ctx context.Context, req *QueryPositionAprRequest) (*QueryPositionAprResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PositionApr not implemented")
+}
+func (*UnimplementedQueryServer) PoolIncentiveRecords(ctx context.Context, req *QueryPoolIncentiveRecordsRequest) (*QueryPoolIncentiveRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolIncentiveRecords not implemented")
+}
+func (*UnimplementedQueryServer) PoolsForDenomPair(ctx context.Context, req *QueryPoolsForDenomPairRequest) (*QueryPoolsForDenomPairResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolsForDenomPair not implemented")
+}
+func (*UnimplementedQueryServer) PoolsForPairWithFees(ctx context.Context, req *QueryPoolsForPairWithFeesRequest) (*QueryPoolsForPairWithFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolsForPairWithFees not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityWeightedTick(ctx context.Context, req *QueryLiquidityWeightedTickRequest) (*QueryLiquidityWeightedTickResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityWeightedTick not implemented")
+}
+func (*UnimplementedQueryServer) SimulateSwapExactAmountIn(ctx context.Context, req *QuerySimulateSwapExactAmountInRequest) (*QuerySimulateSwapExactAmountInResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateSwapExactAmountIn not implemented")
+}
+func (*UnimplementedQueryServer) ProtocolFees(ctx context.Context, req *QueryProtocolFeesRequest) (*QueryProtocolFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProtocolFees not implemented")
+}
+func (*UnimplementedQueryServer) PoolStats(ctx context.Context, req *QueryPoolStatsRequest) (*QueryPoolStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolStats not implemented")
+}
+func (*UnimplementedQueryServer) IsPositionInRange(ctx context.Context, req *QueryIsPositionInRangeRequest) (*QueryIsPositionInRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IsPositionInRange not implemented")
+}
+func (*UnimplementedQueryServer) PositionConversionBounds(ctx context.Context, req *QueryPositionConversionBoundsRequest) (*QueryPositionConversionBoundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PositionConversionBounds not implemented")
+}
+func (*UnimplementedQueryServer) PositionsByJoinTimeRange(ctx context.Context, req *QueryPositionsByJoinTimeRangeRequest) (*QueryPositionsByJoinTimeRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PositionsByJoinTimeRange not implemented")
+}
+func (*UnimplementedQueryServer) PositionAccruedExceeds(ctx context.Context, req *QueryPositionAccruedExceedsRequest) (*QueryPositionAccruedExceedsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PositionAccruedExceeds not implemented")
+}
+func (*UnimplementedQueryServer) AllTicks(ctx context.Context, req *QueryAllTicksRequest) (*QueryAllTicksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllTicks not implemented")
+}
+func (*UnimplementedQueryServer) PositionImpermanentLoss(ctx context.Context, req *QueryPositionImpermanentLossRequest) (*QueryPositionImpermanentLossResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PositionImpermanentLoss not implemented")
+}
+func (*UnimplementedQueryServer) LiquidityToReachPrice(ctx context.Context, req *QueryLiquidityToReachPriceRequest) (*QueryLiquidityToReachPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LiquidityToReachPrice not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Pools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Pools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/Pools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Pools(ctx, req.(*QueryPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolsByLiquidity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsByLiquidityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolsByLiquidity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PoolsByLiquidity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolsByLiquidity(ctx, req.(*QueryPoolsByLiquidityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserPositionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/UserPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserPositions(ctx, req.(*QueryUserPositionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalLiquidityForRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalLiquidityForRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalLiquidityForRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/TotalLiquidityForRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalLiquidityForRange(ctx, req.(*QueryTotalLiquidityForRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidityNetInDirection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityNetInDirectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityNetInDirection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityNetInDirection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityNetInDirection(ctx, req.(*QueryLiquidityNetInDirectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimableFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimableFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableFees(ctx, req.(*QueryClaimableFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimableIncentives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimableIncentivesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableIncentives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/ClaimableIncentives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableIncentives(ctx, req.(*QueryClaimableIncentivesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PositionById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PositionById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PositionById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionById(ctx, req.(*QueryPositionByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PositionSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PositionSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PositionSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionSummary(ctx, req.(*QueryPositionSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PositionIdsForRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionIdsForRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PositionIdsForRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PositionIdsForRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionIdsForRange(ctx, req.(*QueryPositionIdsForRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PriceAtTick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceAtTickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceAtTick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PriceAtTick",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceAtTick(ctx, req.(*QueryPriceAtTickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SqrtPriceForTicks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySqrtPriceForTicksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SqrtPriceForTicks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/SqrtPriceForTicks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SqrtPriceForTicks(ctx, req.(*QuerySqrtPriceForTicksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RequiredAmountForDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequiredAmountForDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RequiredAmountForDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/RequiredAmountForDeposit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RequiredAmountForDeposit(ctx, req.(*QueryRequiredAmountForDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NextInitializedTick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNextInitializedTickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NextInitializedTick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/NextInitializedTick",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NextInitializedTick(ctx, req.(*QueryNextInitializedTickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeRevenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/FeeRevenue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeRevenue(ctx, req.(*QueryFeeRevenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolFeeAccumulator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolFeeAccumulatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolFeeAccumulator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PoolFeeAccumulator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolFeeAccumulator(ctx, req.(*QueryPoolFeeAccumulatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PoolParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolParams(ctx, req.(*QueryPoolParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PositionApr_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionAprRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PositionApr(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PositionApr",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionApr(ctx, req.(*QueryPositionAprRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolIncentiveRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolIncentiveRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolIncentiveRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PoolIncentiveRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolIncentiveRecords(ctx, req.(*QueryPoolIncentiveRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolsForDenomPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsForDenomPairRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolsForDenomPair(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PoolsForDenomPair",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolsForDenomPair(ctx, req.(*QueryPoolsForDenomPairRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolsForPairWithFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolsForPairWithFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolsForPairWithFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PoolsForPairWithFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolsForPairWithFees(ctx, req.(*QueryPoolsForPairWithFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidityWeightedTick_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityWeightedTickRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityWeightedTick(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityWeightedTick",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityWeightedTick(ctx, req.(*QueryLiquidityWeightedTickRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SimulateSwapExactAmountIn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySimulateSwapExactAmountInRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SimulateSwapExactAmountIn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/SimulateSwapExactAmountIn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SimulateSwapExactAmountIn(ctx, req.(*QuerySimulateSwapExactAmountInRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProtocolFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProtocolFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProtocolFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/ProtocolFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProtocolFees(ctx, req.(*QueryProtocolFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PoolStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolStats(ctx, req.(*QueryPoolStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IsPositionInRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIsPositionInRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IsPositionInRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/IsPositionInRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IsPositionInRange(ctx, req.(*QueryIsPositionInRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PositionConversionBounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionConversionBoundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PositionConversionBounds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PositionConversionBounds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionConversionBounds(ctx, req.(*QueryPositionConversionBoundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PositionsByJoinTimeRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionsByJoinTimeRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PositionsByJoinTimeRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PositionsByJoinTimeRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionsByJoinTimeRange(ctx, req.(*QueryPositionsByJoinTimeRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PositionAccruedExceeds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionAccruedExceedsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PositionAccruedExceeds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PositionAccruedExceeds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionAccruedExceeds(ctx, req.(*QueryPositionAccruedExceedsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllTicks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTicksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllTicks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/AllTicks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllTicks(ctx, req.(*QueryAllTicksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PositionImpermanentLoss_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPositionImpermanentLossRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PositionImpermanentLoss(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/PositionImpermanentLoss",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PositionImpermanentLoss(ctx, req.(*QueryPositionImpermanentLossRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LiquidityToReachPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLiquidityToReachPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LiquidityToReachPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Query/LiquidityToReachPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LiquidityToReachPrice(ctx, req.(*QueryLiquidityToReachPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.concentratedliquidity.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Pools",
+			Handler:    _Query_Pools_Handler,
+		},
+		{
+			MethodName: "PoolsByLiquidity",
+			Handler:    _Query_PoolsByLiquidity_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "UserPositions",
+			Handler:    _Query_UserPositions_Handler,
+		},
+		{
+			MethodName: "TotalLiquidityForRange",
+			Handler:    _Query_TotalLiquidityForRange_Handler,
+		},
+		{
+			MethodName: "LiquidityNetInDirection",
+			Handler:    _Query_LiquidityNetInDirection_Handler,
+		},
+		{
+			MethodName: "ClaimableFees",
+			Handler:    _Query_ClaimableFees_Handler,
+		},
+		{
+			MethodName: "ClaimableIncentives",
+			Handler:    _Query_ClaimableIncentives_Handler,
+		},
+		{
+			MethodName: "PositionById",
+			Handler:    _Query_PositionById_Handler,
+		},
+		{
+			MethodName: "PositionSummary",
+			Handler:    _Query_PositionSummary_Handler,
+		},
+		{
+			MethodName: "PositionIdsForRange",
+			Handler:    _Query_PositionIdsForRange_Handler,
+		},
+		{
+			MethodName: "PriceAtTick",
+			Handler:    _Query_PriceAtTick_Handler,
+		},
+		{
+			MethodName: "SqrtPriceForTicks",
+			Handler:    _Query_SqrtPriceForTicks_Handler,
+		},
+		{
+			MethodName: "RequiredAmountForDeposit",
+			Handler:    _Query_RequiredAmountForDeposit_Handler,
+		},
+		{
+			MethodName: "NextInitializedTick",
+			Handler:    _Query_NextInitializedTick_Handler,
+		},
+		{
+			MethodName: "FeeRevenue",
+			Handler:    _Query_FeeRevenue_Handler,
+		},
+		{
+			MethodName: "PoolFeeAccumulator",
+			Handler:    _Query_PoolFeeAccumulator_Handler,
+		},
+		{
+			MethodName: "PoolParams",
+			Handler:    _Query_PoolParams_Handler,
+		},
+		{
+			MethodName: "PositionApr",
+			Handler:    _Query_PositionApr_Handler,
+		},
+		{
+			MethodName: "PoolIncentiveRecords",
+			Handler:    _Query_PoolIncentiveRecords_Handler,
+		},
+		{
+			MethodName: "PoolsForDenomPair",
+			Handler:    _Query_PoolsForDenomPair_Handler,
+		},
+		{
+			MethodName: "PoolsForPairWithFees",
+			Handler:    _Query_PoolsForPairWithFees_Handler,
+		},
+		{
+			MethodName: "LiquidityWeightedTick",
+			Handler:    _Query_LiquidityWeightedTick_Handler,
+		},
+		{
+			MethodName: "SimulateSwapExactAmountIn",
+			Handler:    _Query_SimulateSwapExactAmountIn_Handler,
+		},
+		{
+			MethodName: "ProtocolFees",
+			Handler:    _Query_ProtocolFees_Handler,
+		},
+		{
+			MethodName: "PoolStats",
+			Handler:    _Query_PoolStats_Handler,
+		},
+		{
+			MethodName: "IsPositionInRange",
+			Handler:    _Query_IsPositionInRange_Handler,
+		},
+		{
+			MethodName: "PositionConversionBounds",
+			Handler:    _Query_PositionConversionBounds_Handler,
+		},
+		{
+			MethodName: "PositionsByJoinTimeRange",
+			Handler:    _Query_PositionsByJoinTimeRange_Handler,
+		},
+		{
+			MethodName: "PositionAccruedExceeds",
+			Handler:    _Query_PositionAccruedExceeds_Handler,
+		},
+		{
+			MethodName: "AllTicks",
+			Handler:    _Query_AllTicks_Handler,
+		},
+		{
+			MethodName: "PositionImpermanentLoss",
+			Handler:    _Query_PositionImpermanentLoss_Handler,
+		},
+		{
+			MethodName: "LiquidityToReachPrice",
+			Handler:    _Query_LiquidityToReachPrice_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/concentrated-liquidity/pool-model/query.proto",
+}
+
+func (m *QueryUserPositionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserPositionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserPositionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionByIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionByIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.UptimeReachedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.UptimeReachedAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintQuery(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if len(m.PendingUntilUptime) > 0 {
+		for iNdEx := len(m.PendingUntilUptime) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingUntilUptime[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CollectableNow) > 0 {
+		for iNdEx := len(m.CollectableNow) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollectableNow[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionIdsForRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionIdsForRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionIdsForRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpperTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionIdsForRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionIdsForRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionIdsForRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PositionIds) > 0 {
+		dAtA4 := make([]byte, len(m.PositionIds)*10)
+		var j3 int
+		for _, num := range m.PositionIds {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintQuery(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceAtTickRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceAtTickRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceAtTickRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Tick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Tick))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceAtTickResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceAtTickResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceAtTickResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SpotPrice.Size()
+		i -= size
+		if _, err := m.SpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SqrtPrice.Size()
+		i -= size
+		if _, err := m.SqrtPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySqrtPriceForTicksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySqrtPriceForTicksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySqrtPriceForTicksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpperTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySqrtPriceForTicksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySqrtPriceForTicksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySqrtPriceForTicksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SqrtPriceUpperTick.Size()
+		i -= size
+		if _, err := m.SqrtPriceUpperTick.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.SqrtPriceLowerTick.Size()
+		i -= size
+		if _, err := m.SqrtPriceLowerTick.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsByLiquidityRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsByLiquidityRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsByLiquidityRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsByLiquidityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsByLiquidityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsByLiquidityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *TickLiquidityNet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TickLiquidityNet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TickLiquidityNet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TickIndex.Size()
+		i -= size
+		if _, err := m.TickIndex.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.LiquidityNet.Size()
+		i -= size
+		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *LiquidityDepthWithRange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LiquidityDepthWithRange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LiquidityDepthWithRange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.UpperTick.Size()
+		i -= size
+		if _, err := m.UpperTick.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.LowerTick.Size()
+		i -= size
+		if _, err := m.LowerTick.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.LiquidityAmount.Size()
+		i -= size
+		if _, err := m.LiquidityAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityNetInDirectionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityNetInDirectionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityNetInDirectionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BoundTick != nil {
+		{
+			size := m.BoundTick.Size()
+			i -= size
+			if _, err := m.BoundTick.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StartTick != nil {
+		{
+			size := m.StartTick.Size()
+			i -= size
+			if _, err := m.StartTick.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityNetInDirectionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityNetInDirectionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityNetInDirectionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CurrentLiquidity.Size()
+		i -= size
+		if _, err := m.CurrentLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.CurrentTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentTick))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.LiquidityDepths) > 0 {
+		for iNdEx := len(m.LiquidityDepths) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LiquidityDepths[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalLiquidityForRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalLiquidityForRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalLiquidityForRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalLiquidityForRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalLiquidityForRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalLiquidityForRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for iNdEx := len(m.Liquidity) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Liquidity[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionSummaryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionSummaryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionSummaryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionSummaryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionSummaryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionSummaryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ForfeitedIncentives) > 0 {
+		for iNdEx := len(m.ForfeitedIncentives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForfeitedIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ClaimableIncentives) > 0 {
+		for iNdEx := len(m.ClaimableIncentives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimableIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ClaimableFees) > 0 {
+		for iNdEx := len(m.ClaimableFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimableFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Position.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClaimableFees) > 0 {
+		for iNdEx := len(m.ClaimableFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimableFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableIncentivesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableIncentivesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableIncentivesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClaimableIncentivesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClaimableIncentivesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClaimableIncentivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ForfeitedIncentives) > 0 {
+		for iNdEx := len(m.ForfeitedIncentives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForfeitedIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ClaimableIncentives) > 0 {
+		for iNdEx := len(m.ClaimableIncentives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimableIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextInitializedTickRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextInitializedTickRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextInitializedTickRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ZeroForOne {
+		i--
+		if m.ZeroForOne {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTick))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNextInitializedTickResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNextInitializedTickResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNextInitializedTickResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Found {
+		i--
+		if m.Found {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.LiquidityNet.Size()
+		i -= size
+		if _, err := m.LiquidityNet.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.TickIndex != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TickIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeRevenueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeRevenueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeRevenueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintQuery(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeeRevenueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeRevenueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeRevenue) > 0 {
+		for iNdEx := len(m.FeeRevenue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeRevenue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolFeeAccumulatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolFeeAccumulatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolFeeAccumulatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolFeeAccumulatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolFeeAccumulatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolFeeAccumulatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeGrowthGlobal) > 0 {
+		for iNdEx := len(m.FeeGrowthGlobal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeGrowthGlobal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CurrentSqrtPrice.Size()
+		i -= size
+		if _, err := m.CurrentSqrtPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.CurrentTick.Size()
+		i -= size
+		if _, err := m.CurrentTick.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ExponentAtPriceOne.Size()
+		i -= size
+		if _, err := m.ExponentAtPriceOne.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.TickSpacing != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TickSpacing))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionAprRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionAprRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionAprRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionAprResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionAprResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionAprResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AnnualizedIncentives) > 0 {
+		for iNdEx := len(m.AnnualizedIncentives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AnnualizedIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AnnualizedFees) > 0 {
+		for iNdEx := len(m.AnnualizedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AnnualizedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.Apr.Size()
+		i -= size
+		if _, err := m.Apr.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolIncentiveRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolIncentiveRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolIncentiveRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolIncentiveRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolIncentiveRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolIncentiveRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExhaustedAt, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExhaustedAt):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintQuery(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.IncentiveRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolIncentiveRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolIncentiveRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolIncentiveRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IncentiveRecords) > 0 {
+		for iNdEx := len(m.IncentiveRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IncentiveRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsForDenomPairRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsForDenomPairRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsForDenomPairRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomB) > 0 {
+		i -= len(m.DenomB)
+		copy(dAtA[i:], m.DenomB)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomB)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomA) > 0 {
+		i -= len(m.DenomA)
+		copy(dAtA[i:], m.DenomA)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomA)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsForDenomPairResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsForDenomPairResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsForDenomPairResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolIds) > 0 {
+		dAtA15 := make([]byte, len(m.PoolIds)*10)
+		var j14 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA15[j14] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j14++
+			}
+			dAtA15[j14] = uint8(num)
+			j14++
+		}
+		i -= j14
+		copy(dAtA[i:], dAtA15[:j14])
+		i = encodeVarintQuery(dAtA, i, uint64(j14))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsForPairWithFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsForPairWithFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsForPairWithFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DenomB) > 0 {
+		i -= len(m.DenomB)
+		copy(dAtA[i:], m.DenomB)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomB)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DenomA) > 0 {
+		i -= len(m.DenomA)
+		copy(dAtA[i:], m.DenomA)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DenomA)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolFeeTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolFeeTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolFeeTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolsForPairWithFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolsForPairWithFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolsForPairWithFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for iNdEx := len(m.Pools) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pools[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityWeightedTickRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityWeightedTickRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityWeightedTickRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityWeightedTickResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityWeightedTickResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityWeightedTickResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SpotPrice.Size()
+		i -= size
+		if _, err := m.SpotPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Tick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Tick))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateSwapExactAmountInRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateSwapExactAmountInRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateSwapExactAmountInRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySimulateSwapExactAmountInResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySimulateSwapExactAmountInResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySimulateSwapExactAmountInResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AmountInAfterFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.FeeCharged.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.AmountOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProtocolFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProtocolFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProtocolFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProtocolFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProtocolFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProtocolFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProtocolFees) > 0 {
+		for iNdEx := len(m.ProtocolFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtocolFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.ActiveLiquidity.Size()
+		i -= size
+		if _, err := m.ActiveLiquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.NumOwners != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumOwners))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.NumPositions != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NumPositions))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsPositionInRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsPositionInRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsPositionInRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIsPositionInRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIsPositionInRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIsPositionInRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpperTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CurrentTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentTick))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.InRange {
+		i--
+		if m.InRange {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionConversionBoundsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionConversionBoundsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionConversionBoundsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionConversionBoundsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionConversionBoundsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionConversionBoundsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AboveRange {
+		i--
+		if m.AboveRange {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BelowRange {
+		i--
+		if m.BelowRange {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.UpperPrice.Size()
+		i -= size
+		if _, err := m.UpperPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LowerPrice.Size()
+		i -= size
+		if _, err := m.LowerPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.UpperTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionsByJoinTimeRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionsByJoinTimeRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionsByJoinTimeRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	n21, err21 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err21 != nil {
+		return 0, err21
+	}
+	i -= n21
+	i = encodeVarintQuery(dAtA, i, uint64(n21))
+	i--
+	dAtA[i] = 0x12
+	n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintQuery(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionsByJoinTimeRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionsByJoinTimeRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionsByJoinTimeRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Positions) > 0 {
+		for iNdEx := len(m.Positions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Positions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionAccruedExceedsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionAccruedExceedsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionAccruedExceedsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Threshold) > 0 {
+		for iNdEx := len(m.Threshold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Threshold[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionAccruedExceedsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionAccruedExceedsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionAccruedExceedsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Accrued) > 0 {
+		for iNdEx := len(m.Accrued) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Accrued[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Exceeds {
+		i--
+		if m.Exceeds {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRequiredAmountForDepositRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequiredAmountForDepositRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequiredAmountForDepositRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.KnownAmount.Size()
+		i -= size
+		if _, err := m.KnownAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.KnownDenom) > 0 {
+		i -= len(m.KnownDenom)
+		copy(dAtA[i:], m.KnownDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.KnownDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.UpperTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRequiredAmountForDepositResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequiredAmountForDepositResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequiredAmountForDepositResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Liquidity.Size()
+		i -= size
+		if _, err := m.Liquidity.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.OtherAmount.Size()
+		i -= size
+		if _, err := m.OtherAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTicksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTicksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTicksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTicksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTicksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTicksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Ticks) > 0 {
+		for iNdEx := len(m.Ticks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Ticks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionImpermanentLossRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionImpermanentLossRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionImpermanentLossRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPositionImpermanentLossResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPositionImpermanentLossResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPositionImpermanentLossResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HeldAmounts) > 0 {
+		for iNdEx := len(m.HeldAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HeldAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CurrentAmounts) > 0 {
+		for iNdEx := len(m.CurrentAmounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentAmounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.ValueDifference.Size()
+		i -= size
+		if _, err := m.ValueDifference.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.ImpermanentLoss.Size()
+		i -= size
+		if _, err := m.ImpermanentLoss.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityToReachPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityToReachPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityToReachPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TargetPrice.Size()
+		i -= size
+		if _, err := m.TargetPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLiquidityToReachPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLiquidityToReachPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLiquidityToReachPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CrossedTicks) > 0 {
+		dAtA27 := make([]byte, len(m.CrossedTicks)*10)
+		var j26 int
+		for _, num1 := range m.CrossedTicks {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA27[j26] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j26++
+			}
+			dAtA27[j26] = uint8(num)
+			j26++
+		}
+		i -= j26
+		copy(dAtA[i:], dAtA27[:j26])
+		i = encodeVarintQuery(dAtA, i, uint64(j26))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryUserPositionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryUserPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPositionByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *QueryPositionByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Position.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.CollectableNow) > 0 {
+		for _, e := range m.CollectableNow {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.PendingUntilUptime) > 0 {
+		for _, e := range m.PendingUntilUptime {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.UptimeReachedAt)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPositionIdsForRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovQuery(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovQuery(uint64(m.UpperTick))
+	}
+	return n
+}
+
+func (m *QueryPositionIdsForRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PositionIds) > 0 {
+		l = 0
+		for _, e := range m.PositionIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryPriceAtTickRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Tick != 0 {
+		n += 1 + sovQuery(uint64(m.Tick))
+	}
+	return n
+}
+
+func (m *QueryPriceAtTickResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SqrtPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SpotPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySqrtPriceForTicksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovQuery(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovQuery(uint64(m.UpperTick))
+	}
+	return n
+}
+
+func (m *QuerySqrtPriceForTicksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SqrtPriceLowerTick.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SqrtPriceUpperTick.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsByLiquidityRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsByLiquidityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *TickLiquidityNet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidityNet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TickIndex.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *LiquidityDepthWithRange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.LiquidityAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LowerTick.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.UpperTick.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLiquidityNetInDirectionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.StartTick != nil {
+		l = m.StartTick.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BoundTick != nil {
+		l = m.BoundTick.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryLiquidityNetInDirectionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.LiquidityDepths) > 0 {
+		for _, e := range m.LiquidityDepths {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.CurrentTick != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentTick))
+	}
+	l = m.CurrentLiquidity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTotalLiquidityForRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryTotalLiquidityForRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Liquidity) > 0 {
+		for _, e := range m.Liquidity {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPositionSummaryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *QueryPositionSummaryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Position.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.ClaimableFees) > 0 {
+		for _, e := range m.ClaimableFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ClaimableIncentives) > 0 {
+		for _, e := range m.ClaimableIncentives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ForfeitedIncentives) > 0 {
+		for _, e := range m.ForfeitedIncentives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryClaimableFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *QueryClaimableFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClaimableFees) > 0 {
+		for _, e := range m.ClaimableFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryClaimableIncentivesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *QueryClaimableIncentivesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClaimableIncentives) > 0 {
+		for _, e := range m.ClaimableIncentives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ForfeitedIncentives) > 0 {
+		for _, e := range m.ForfeitedIncentives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryNextInitializedTickRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.StartTick != 0 {
+		n += 1 + sovQuery(uint64(m.StartTick))
+	}
+	if m.ZeroForOne {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryNextInitializedTickResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TickIndex != 0 {
+		n += 1 + sovQuery(uint64(m.TickIndex))
+	}
+	l = m.LiquidityNet.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Found {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryFeeRevenueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryFeeRevenueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeRevenue) > 0 {
+		for _, e := range m.FeeRevenue {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPoolFeeAccumulatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolFeeAccumulatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeGrowthGlobal) > 0 {
+		for _, e := range m.FeeGrowthGlobal {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPoolParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TickSpacing != 0 {
+		n += 1 + sovQuery(uint64(m.TickSpacing))
+	}
+	l = m.ExponentAtPriceOne.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SwapFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentTick.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentSqrtPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPositionAprRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *QueryPositionAprResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Apr.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.AnnualizedFees) > 0 {
+		for _, e := range m.AnnualizedFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.AnnualizedIncentives) > 0 {
+		for _, e := range m.AnnualizedIncentives {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPoolIncentiveRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *PoolIncentiveRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.IncentiveRecord.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExhaustedAt)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolIncentiveRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IncentiveRecords) > 0 {
+		for _, e := range m.IncentiveRecords {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPoolsForDenomPairRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomA)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DenomB)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPoolsForDenomPairResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryPoolsForPairWithFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DenomA)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DenomB)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *PoolFeeTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = m.SwapFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Liquidity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPoolsForPairWithFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pools) > 0 {
+		for _, e := range m.Pools {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLiquidityWeightedTickRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryLiquidityWeightedTickResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Tick != 0 {
+		n += 1 + sovQuery(uint64(m.Tick))
+	}
+	l = m.SpotPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySimulateSwapExactAmountInRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySimulateSwapExactAmountInResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AmountOut.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.FeeCharged.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AmountInAfterFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProtocolFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryProtocolFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProtocolFees) > 0 {
+		for _, e := range m.ProtocolFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPoolStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumPositions != 0 {
+		n += 1 + sovQuery(uint64(m.NumPositions))
+	}
+	if m.NumOwners != 0 {
+		n += 1 + sovQuery(uint64(m.NumOwners))
+	}
+	l = m.ActiveLiquidity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIsPositionInRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *QueryIsPositionInRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.InRange {
+		n += 2
+	}
+	if m.CurrentTick != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentTick))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovQuery(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovQuery(uint64(m.UpperTick))
+	}
+	return n
+}
+
+func (m *QueryPositionConversionBoundsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *QueryPositionConversionBoundsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LowerTick != 0 {
+		n += 1 + sovQuery(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovQuery(uint64(m.UpperTick))
+	}
+	l = m.LowerPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.UpperPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.BelowRange {
+		n += 2
+	}
+	if m.AboveRange {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryPositionsByJoinTimeRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovQuery(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPositionsByJoinTimeRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Positions) > 0 {
+		for _, e := range m.Positions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPositionAccruedExceedsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	if len(m.Threshold) > 0 {
+		for _, e := range m.Threshold {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPositionAccruedExceedsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Exceeds {
+		n += 2
+	}
+	if len(m.Accrued) > 0 {
+		for _, e := range m.Accrued {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRequiredAmountForDepositRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovQuery(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovQuery(uint64(m.UpperTick))
+	}
+	l = len(m.KnownDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.KnownAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRequiredAmountForDepositResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OtherAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Liquidity.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllTicksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTicksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ticks) > 0 {
+		for _, e := range m.Ticks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPositionImpermanentLossRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovQuery(uint64(m.PositionId))
+	}
+	return n
+}
+
+func (m *QueryPositionImpermanentLossResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ImpermanentLoss.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ValueDifference.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.CurrentAmounts) > 0 {
+		for _, e := range m.CurrentAmounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.HeldAmounts) > 0 {
+		for _, e := range m.HeldAmounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLiquidityToReachPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = m.TargetPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLiquidityToReachPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.CrossedTicks) > 0 {
+		l = 0
+		for _, e := range m.CrossedTicks {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryUserPositionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserPositionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserPositionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserPositionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Positions = append(m.Positions, model.PositionWithUnderlyingAssetBreakdown{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionByIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionByIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionByIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionByIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectableNow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectableNow = append(m.CollectableNow, types.Coin{})
+			if err := m.CollectableNow[len(m.CollectableNow)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingUntilUptime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingUntilUptime = append(m.PendingUntilUptime, types.Coin{})
+			if err := m.PendingUntilUptime[len(m.PendingUntilUptime)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeReachedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.UptimeReachedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionIdsForRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionIdsForRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionIdsForRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionIdsForRangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionIdsForRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionIdsForRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PositionIds = append(m.PositionIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PositionIds) == 0 {
+					m.PositionIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PositionIds = append(m.PositionIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceAtTickRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceAtTickRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceAtTickRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tick", wireType)
+			}
+			m.Tick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceAtTickResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceAtTickResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceAtTickResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqrtPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SqrtPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySqrtPriceForTicksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySqrtPriceForTicksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySqrtPriceForTicksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySqrtPriceForTicksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySqrtPriceForTicksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySqrtPriceForTicksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqrtPriceLowerTick", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SqrtPriceLowerTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SqrtPriceUpperTick", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SqrtPriceUpperTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &types2.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsByLiquidityRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsByLiquidityRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsByLiquidityRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsByLiquidityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsByLiquidityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsByLiquidityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, &types2.Any{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TickLiquidityNet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TickLiquidityNet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TickIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LiquidityDepthWithRange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LiquidityDepthWithRange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LowerTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpperTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityNetInDirectionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityNetInDirectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTick", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.StartTick = &v
+			if err := m.StartTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BoundTick", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.BoundTick = &v
+			if err := m.BoundTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityNetInDirectionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityNetInDirectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDepths", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LiquidityDepths = append(m.LiquidityDepths, TickLiquidityNet{})
+			if err := m.LiquidityDepths[len(m.LiquidityDepths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
+			}
+			m.CurrentTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLiquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalLiquidityForRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalLiquidityForRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalLiquidityForRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalLiquidityForRangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalLiquidityForRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalLiquidityForRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Liquidity = append(m.Liquidity, LiquidityDepthWithRange{})
+			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionSummaryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionSummaryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionSummaryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionSummaryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionSummaryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionSummaryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimableFees = append(m.ClaimableFees, types.Coin{})
+			if err := m.ClaimableFees[len(m.ClaimableFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableIncentives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimableIncentives = append(m.ClaimableIncentives, types.Coin{})
+			if err := m.ClaimableIncentives[len(m.ClaimableIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitedIncentives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForfeitedIncentives = append(m.ForfeitedIncentives, types.Coin{})
+			if err := m.ForfeitedIncentives[len(m.ForfeitedIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimableFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimableFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimableFees = append(m.ClaimableFees, types.Coin{})
+			if err := m.ClaimableFees[len(m.ClaimableFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimableIncentivesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableIncentivesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableIncentivesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClaimableIncentivesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClaimableIncentivesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClaimableIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableIncentives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimableIncentives = append(m.ClaimableIncentives, types.Coin{})
+			if err := m.ClaimableIncentives[len(m.ClaimableIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitedIncentives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForfeitedIncentives = append(m.ForfeitedIncentives, types.Coin{})
+			if err := m.ForfeitedIncentives[len(m.ForfeitedIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextInitializedTickRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextInitializedTickRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextInitializedTickRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTick", wireType)
+			}
+			m.StartTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ZeroForOne", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ZeroForOne = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextInitializedTickResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextInitializedTickResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextInitializedTickResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+			}
+			m.TickIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickIndex |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Found", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Found = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeRevenueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeRevenueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeRevenueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeeRevenueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeeRevenueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeeRevenueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRevenue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRevenue = append(m.FeeRevenue, types.DecCoin{})
+			if err := m.FeeRevenue[len(m.FeeRevenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolFeeAccumulatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolFeeAccumulatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolFeeAccumulatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolFeeAccumulatorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolFeeAccumulatorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolFeeAccumulatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGrowthGlobal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeGrowthGlobal = append(m.FeeGrowthGlobal, types.DecCoin{})
+			if err := m.FeeGrowthGlobal[len(m.FeeGrowthGlobal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TickSpacing", wireType)
+			}
+			m.TickSpacing = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TickSpacing |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExponentAtPriceOne", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExponentAtPriceOne.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSqrtPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentSqrtPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionAprRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionAprRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionAprRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPositionAprResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPositionAprResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPositionAprResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Apr.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualizedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnnualizedFees = append(m.AnnualizedFees, types.DecCoin{})
+			if err := m.AnnualizedFees[len(m.AnnualizedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualizedIncentives", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AnnualizedIncentives = append(m.AnnualizedIncentives, types.DecCoin{})
+			if err := m.AnnualizedIncentives[len(m.AnnualizedIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolIncentiveRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolIncentiveRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolIncentiveRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolIncentiveRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolIncentiveRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolIncentiveRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.IncentiveRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExhaustedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExhaustedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolIncentiveRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolIncentiveRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolIncentiveRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentiveRecords = append(m.IncentiveRecords, PoolIncentiveRecord{})
+			if err := m.IncentiveRecords[len(m.IncentiveRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsForDenomPairRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsForDenomPairRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsForDenomPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsForDenomPairResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsForDenomPairResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsForDenomPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsForPairWithFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsForPairWithFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsForPairWithFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomA", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomA = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomB", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomB = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolFeeTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolFeeTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolFeeTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolsForPairWithFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolsForPairWithFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolsForPairWithFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pools = append(m.Pools, PoolFeeTier{})
+			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityWeightedTickRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityWeightedTickRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityWeightedTickRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLiquidityWeightedTickResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLiquidityWeightedTickResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLiquidityWeightedTickResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tick", wireType)
+			}
+			m.Tick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Tick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpotPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateSwapExactAmountInRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateSwapExactAmountInRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateSwapExactAmountInRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySimulateSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySimulateSwapExactAmountInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySimulateSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCharged", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeCharged.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AmountInAfterFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AmountInAfterFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProtocolFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProtocolFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProtocolFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *QueryClaimableFeesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PositionId != 0 {
-		n += 1 + sovQuery(uint64(m.PositionId))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryProtocolFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProtocolFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProtocolFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolFees = append(m.ProtocolFees, types.Coin{})
+			if err := m.ProtocolFees[len(m.ProtocolFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryClaimableFeesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.ClaimableFees) > 0 {
-		for _, e := range m.ClaimableFees {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryPoolStatsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolStatsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryUserPositionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPoolStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2074,15 +15013,53 @@ func (m *QueryUserPositionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUserPositionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPoolStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUserPositionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPoolStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPositions", wireType)
+			}
+			m.NumPositions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumPositions |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumOwners", wireType)
+			}
+			m.NumOwners = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NumOwners |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveLiquidity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2110,13 +15087,65 @@ func (m *QueryUserPositionsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			if err := m.ActiveLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIsPositionInRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIsPositionInRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIsPositionInRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
 			}
-			m.PoolId = 0
+			m.PositionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2126,7 +15155,7 @@ func (m *QueryUserPositionsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.PositionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2152,7 +15181,7 @@ func (m *QueryUserPositionsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUserPositionsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIsPositionInRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2175,17 +15204,17 @@ func (m *QueryUserPositionsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUserPositionsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIsPositionInRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUserPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIsPositionInRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InRange", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2195,26 +15224,69 @@ func (m *QueryUserPositionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+			m.InRange = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			m.CurrentTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
 			}
-			m.Positions = append(m.Positions, model.PositionWithUnderlyingAssetBreakdown{})
-			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2236,7 +15308,7 @@ func (m *QueryUserPositionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPositionByIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPositionConversionBoundsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2259,10 +15331,10 @@ func (m *QueryPositionByIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPositionByIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPositionConversionBoundsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPositionByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPositionConversionBoundsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2305,7 +15377,7 @@ func (m *QueryPositionByIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPositionByIdResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPositionConversionBoundsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2328,17 +15400,55 @@ func (m *QueryPositionByIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPositionByIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPositionConversionBoundsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPositionByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPositionConversionBoundsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Position", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2348,25 +15458,100 @@ func (m *QueryPositionByIdResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Position.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LowerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpperPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BelowRange", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BelowRange = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AboveRange", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AboveRange = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2388,7 +15573,7 @@ func (m *QueryPositionByIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPositionsByJoinTimeRangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2411,13 +15596,79 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPositionsByJoinTimeRangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPositionsByJoinTimeRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -2474,7 +15725,7 @@ func (m *QueryPoolsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPositionsByJoinTimeRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2497,15 +15748,15 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPoolsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPositionsByJoinTimeRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPositionsByJoinTimeRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pools", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Positions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2532,8 +15783,8 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pools = append(m.Pools, &types.Any{})
-			if err := m.Pools[len(m.Pools)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Positions = append(m.Positions, model.Position{})
+			if err := m.Positions[len(m.Positions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2594,7 +15845,7 @@ func (m *QueryPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPositionAccruedExceedsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2617,12 +15868,65 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPositionAccruedExceedsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPositionAccruedExceedsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = append(m.Threshold, types.Coin{})
+			if err := m.Threshold[len(m.Threshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2644,7 +15948,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPositionAccruedExceedsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2667,15 +15971,35 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPositionAccruedExceedsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPositionAccruedExceedsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exceeds", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exceeds = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accrued", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2702,7 +16026,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Accrued = append(m.Accrued, types.Coin{})
+			if err := m.Accrued[len(m.Accrued)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2727,7 +16052,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
+func (m *QueryRequiredAmountForDepositRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2750,15 +16075,72 @@ func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TickLiquidityNet: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRequiredAmountForDepositRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TickLiquidityNet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRequiredAmountForDepositRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityNet", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KnownDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2786,13 +16168,11 @@ func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidityNet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.KnownDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TickIndex", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KnownAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2820,7 +16200,7 @@ func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TickIndex.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.KnownAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2845,7 +16225,7 @@ func (m *TickLiquidityNet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
+func (m *QueryRequiredAmountForDepositResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2868,15 +16248,15 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LiquidityDepthWithRange: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRequiredAmountForDepositResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LiquidityDepthWithRange: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRequiredAmountForDepositResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OtherAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2904,47 +16284,13 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidityAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.OtherAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LowerTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2972,7 +16318,7 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.UpperTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Liquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2997,7 +16343,7 @@ func (m *LiquidityDepthWithRange) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllTicksRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3020,68 +16366,17 @@ func (m *QueryLiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidityNetInDirectionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidityNetInDirectionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllTicksRequest: wiretype end group for non-group")
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenIn = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTick", wireType)
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTicksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3091,33 +16386,16 @@ func (m *QueryLiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.StartTick = &v
-			if err := m.StartTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BoundTick", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3127,25 +16405,25 @@ func (m *QueryLiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.BoundTick = &v
-			if err := m.BoundTick.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3170,7 +16448,7 @@ func (m *QueryLiquidityNetInDirectionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllTicksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3193,15 +16471,15 @@ func (m *QueryLiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLiquidityNetInDirectionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllTicksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLiquidityNetInDirectionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllTicksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityDepths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticks", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3228,35 +16506,16 @@ func (m *QueryLiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LiquidityDepths = append(m.LiquidityDepths, TickLiquidityNet{})
-			if err := m.LiquidityDepths[len(m.LiquidityDepths)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Ticks = append(m.Ticks, genesis.FullTick{})
+			if err := m.Ticks[len(m.Ticks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTick", wireType)
-			}
-			m.CurrentTick = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentTick |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentLiquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3266,23 +16525,25 @@ func (m *QueryLiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.CurrentLiquidity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3307,7 +16568,7 @@ func (m *QueryLiquidityNetInDirectionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalLiquidityForRangeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPositionImpermanentLossRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3330,17 +16591,17 @@ func (m *QueryTotalLiquidityForRangeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalLiquidityForRangeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPositionImpermanentLossRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalLiquidityForRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPositionImpermanentLossRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
 			}
-			m.PoolId = 0
+			m.PositionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3350,7 +16611,7 @@ func (m *QueryTotalLiquidityForRangeRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.PositionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3376,7 +16637,7 @@ func (m *QueryTotalLiquidityForRangeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTotalLiquidityForRangeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryPositionImpermanentLossResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3399,15 +16660,83 @@ func (m *QueryTotalLiquidityForRangeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTotalLiquidityForRangeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPositionImpermanentLossResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTotalLiquidityForRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPositionImpermanentLossResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Liquidity", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImpermanentLoss", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ImpermanentLoss.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueDifference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ValueDifference.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentAmounts", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3434,8 +16763,42 @@ func (m *QueryTotalLiquidityForRangeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Liquidity = append(m.Liquidity, LiquidityDepthWithRange{})
-			if err := m.Liquidity[len(m.Liquidity)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CurrentAmounts = append(m.CurrentAmounts, types.DecCoin{})
+			if err := m.CurrentAmounts[len(m.CurrentAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeldAmounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeldAmounts = append(m.HeldAmounts, types.DecCoin{})
+			if err := m.HeldAmounts[len(m.HeldAmounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3460,7 +16823,7 @@ func (m *QueryTotalLiquidityForRangeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClaimableFeesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLiquidityToReachPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3483,17 +16846,17 @@ func (m *QueryClaimableFeesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClaimableFeesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLiquidityToReachPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClaimableFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLiquidityToReachPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.PositionId = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3503,11 +16866,45 @@ func (m *QueryClaimableFeesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PositionId |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3529,7 +16926,7 @@ func (m *QueryClaimableFeesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClaimableFeesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLiquidityToReachPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3552,15 +16949,15 @@ func (m *QueryClaimableFeesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClaimableFeesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLiquidityToReachPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClaimableFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLiquidityToReachPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableFees", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3587,11 +16984,86 @@ func (m *QueryClaimableFeesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClaimableFees = append(m.ClaimableFees, types2.Coin{})
-			if err := m.ClaimableFees[len(m.ClaimableFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v int64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CrossedTicks = append(m.CrossedTicks, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CrossedTicks) == 0 {
+					m.CrossedTicks = make([]int64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CrossedTicks = append(m.CrossedTicks, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CrossedTicks", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
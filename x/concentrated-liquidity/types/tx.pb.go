@@ -44,6 +44,15 @@ type MsgCreatePosition struct {
 	TokenDesired1   types.Coin                             `protobuf:"bytes,6,opt,name=token_desired1,json=tokenDesired1,proto3" json:"token_desired1" yaml:"token_desired1"`
 	TokenMinAmount0 github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=token_min_amount0,json=tokenMinAmount0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_min_amount0" yaml:"token_min_amount0"`
 	TokenMinAmount1 github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=token_min_amount1,json=tokenMinAmount1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_min_amount1" yaml:"token_min_amount1"`
+	// owner is the optional owner of the created position. If empty, the
+	// position is owned by the sender. If set to a different address, the owner
+	// must have granted the sender an authz authorization for MsgCreatePosition.
+	// The tokens are always provided by the sender.
+	Owner string `protobuf:"bytes,9,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// strict_slippage, if set, rejects the message when both token_min_amount0
+	// and token_min_amount1 are zero, since slippage protection would be
+	// disabled.
+	StrictSlippage bool `protobuf:"varint,10,opt,name=strict_slippage,json=strictSlippage,proto3" json:"strict_slippage,omitempty" yaml:"strict_slippage"`
 }
 
 func (m *MsgCreatePosition) Reset()         { *m = MsgCreatePosition{} }
@@ -121,6 +130,20 @@ func (m *MsgCreatePosition) GetTokenDesired1() types.Coin {
 	return types.Coin{}
 }
 
+func (m *MsgCreatePosition) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgCreatePosition) GetStrictSlippage() bool {
+	if m != nil {
+		return m.StrictSlippage
+	}
+	return false
+}
+
 type MsgCreatePositionResponse struct {
 	PositionId       uint64                                 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
 	Amount0          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount0" yaml:"amount0"`
@@ -176,25 +199,33 @@ func (m *MsgCreatePositionResponse) GetJoinTime() time.Time {
 	return time.Time{}
 }
 
-// ===================== MsgWithdrawPosition
-type MsgWithdrawPosition struct {
-	PositionId      uint64                                 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+// ===================== MsgCreatePositionByPrice
+// MsgCreatePositionByPrice creates a position with the tick range given by
+// prices rather than tick indexes. Each price is converted to the nearest
+// tick that is aligned with the pool's tick spacing.
+type MsgCreatePositionByPrice struct {
+	PoolId          uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 	Sender          string                                 `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
-	LiquidityAmount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=liquidity_amount,json=liquidityAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_amount" yaml:"liquidity_amount"`
+	LowerPrice      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=lower_price,json=lowerPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"lower_price" yaml:"lower_price"`
+	UpperPrice      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=upper_price,json=upperPrice,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"upper_price" yaml:"upper_price"`
+	TokenDesired0   types.Coin                             `protobuf:"bytes,5,opt,name=token_desired0,json=tokenDesired0,proto3" json:"token_desired0" yaml:"token_desired0"`
+	TokenDesired1   types.Coin                             `protobuf:"bytes,6,opt,name=token_desired1,json=tokenDesired1,proto3" json:"token_desired1" yaml:"token_desired1"`
+	TokenMinAmount0 github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=token_min_amount0,json=tokenMinAmount0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_min_amount0" yaml:"token_min_amount0"`
+	TokenMinAmount1 github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=token_min_amount1,json=tokenMinAmount1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_min_amount1" yaml:"token_min_amount1"`
 }
 
-func (m *MsgWithdrawPosition) Reset()         { *m = MsgWithdrawPosition{} }
-func (m *MsgWithdrawPosition) String() string { return proto.CompactTextString(m) }
-func (*MsgWithdrawPosition) ProtoMessage()    {}
-func (*MsgWithdrawPosition) Descriptor() ([]byte, []int) {
+func (m *MsgCreatePositionByPrice) Reset()         { *m = MsgCreatePositionByPrice{} }
+func (m *MsgCreatePositionByPrice) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePositionByPrice) ProtoMessage()    {}
+func (*MsgCreatePositionByPrice) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1f1fff802923d7db, []int{2}
 }
-func (m *MsgWithdrawPosition) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreatePositionByPrice) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWithdrawPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreatePositionByPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWithdrawPosition.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreatePositionByPrice.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -204,49 +235,70 @@ func (m *MsgWithdrawPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgWithdrawPosition) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWithdrawPosition.Merge(m, src)
+func (m *MsgCreatePositionByPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePositionByPrice.Merge(m, src)
 }
-func (m *MsgWithdrawPosition) XXX_Size() int {
+func (m *MsgCreatePositionByPrice) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWithdrawPosition) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWithdrawPosition.DiscardUnknown(m)
+func (m *MsgCreatePositionByPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePositionByPrice.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWithdrawPosition proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreatePositionByPrice proto.InternalMessageInfo
 
-func (m *MsgWithdrawPosition) GetPositionId() uint64 {
+func (m *MsgCreatePositionByPrice) GetPoolId() uint64 {
 	if m != nil {
-		return m.PositionId
+		return m.PoolId
 	}
 	return 0
 }
 
-func (m *MsgWithdrawPosition) GetSender() string {
+func (m *MsgCreatePositionByPrice) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-type MsgWithdrawPositionResponse struct {
-	Amount0 github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount0" yaml:"amount0"`
-	Amount1 github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount1" yaml:"amount1"`
+func (m *MsgCreatePositionByPrice) GetTokenDesired0() types.Coin {
+	if m != nil {
+		return m.TokenDesired0
+	}
+	return types.Coin{}
 }
 
-func (m *MsgWithdrawPositionResponse) Reset()         { *m = MsgWithdrawPositionResponse{} }
-func (m *MsgWithdrawPositionResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgWithdrawPositionResponse) ProtoMessage()    {}
-func (*MsgWithdrawPositionResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCreatePositionByPrice) GetTokenDesired1() types.Coin {
+	if m != nil {
+		return m.TokenDesired1
+	}
+	return types.Coin{}
+}
+
+type MsgCreatePositionByPriceResponse struct {
+	PositionId       uint64                                 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	Amount0          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount0" yaml:"amount0"`
+	Amount1          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount1" yaml:"amount1"`
+	JoinTime         time.Time                              `protobuf:"bytes,4,opt,name=join_time,json=joinTime,proto3,stdtime" json:"join_time" yaml:"join_time"`
+	LiquidityCreated github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=liquidity_created,json=liquidityCreated,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_created" yaml:"liquidity_created"`
+	// lower_tick and upper_tick are the ticks the lower and upper prices were
+	// converted to, and that the position was created with.
+	LowerTick int64 `protobuf:"varint,6,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick int64 `protobuf:"varint,7,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+}
+
+func (m *MsgCreatePositionByPriceResponse) Reset()         { *m = MsgCreatePositionByPriceResponse{} }
+func (m *MsgCreatePositionByPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePositionByPriceResponse) ProtoMessage()    {}
+func (*MsgCreatePositionByPriceResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1f1fff802923d7db, []int{3}
 }
-func (m *MsgWithdrawPositionResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreatePositionByPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWithdrawPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreatePositionByPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWithdrawPositionResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreatePositionByPriceResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -256,36 +308,73 @@ func (m *MsgWithdrawPositionResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgWithdrawPositionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWithdrawPositionResponse.Merge(m, src)
+func (m *MsgCreatePositionByPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePositionByPriceResponse.Merge(m, src)
 }
-func (m *MsgWithdrawPositionResponse) XXX_Size() int {
+func (m *MsgCreatePositionByPriceResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWithdrawPositionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWithdrawPositionResponse.DiscardUnknown(m)
+func (m *MsgCreatePositionByPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePositionByPriceResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWithdrawPositionResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreatePositionByPriceResponse proto.InternalMessageInfo
 
-// ===================== MsgCollectFees
-type MsgCollectFees struct {
-	PositionIds []uint64 `protobuf:"varint,1,rep,packed,name=position_ids,json=positionIds,proto3" json:"position_ids,omitempty" yaml:"position_ids"`
-	Sender      string   `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+func (m *MsgCreatePositionByPriceResponse) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
+	}
+	return 0
 }
 
-func (m *MsgCollectFees) Reset()         { *m = MsgCollectFees{} }
-func (m *MsgCollectFees) String() string { return proto.CompactTextString(m) }
-func (*MsgCollectFees) ProtoMessage()    {}
-func (*MsgCollectFees) Descriptor() ([]byte, []int) {
+func (m *MsgCreatePositionByPriceResponse) GetJoinTime() time.Time {
+	if m != nil {
+		return m.JoinTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgCreatePositionByPriceResponse) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *MsgCreatePositionByPriceResponse) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+// ===================== MsgCreatePositionRelative
+// MsgCreatePositionRelative creates a position with the tick range given as
+// offsets from the pool's current tick at execution time. Each resulting tick
+// is rounded to the nearest tick that is aligned with the pool's tick spacing.
+type MsgCreatePositionRelative struct {
+	PoolId          uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Sender          string                                 `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	LowerTickOffset int64                                  `protobuf:"varint,3,opt,name=lower_tick_offset,json=lowerTickOffset,proto3" json:"lower_tick_offset,omitempty" yaml:"lower_tick_offset"`
+	UpperTickOffset int64                                  `protobuf:"varint,4,opt,name=upper_tick_offset,json=upperTickOffset,proto3" json:"upper_tick_offset,omitempty" yaml:"upper_tick_offset"`
+	TokenDesired0   types.Coin                             `protobuf:"bytes,5,opt,name=token_desired0,json=tokenDesired0,proto3" json:"token_desired0" yaml:"token_desired0"`
+	TokenDesired1   types.Coin                             `protobuf:"bytes,6,opt,name=token_desired1,json=tokenDesired1,proto3" json:"token_desired1" yaml:"token_desired1"`
+	TokenMinAmount0 github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=token_min_amount0,json=tokenMinAmount0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_min_amount0" yaml:"token_min_amount0"`
+	TokenMinAmount1 github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,8,opt,name=token_min_amount1,json=tokenMinAmount1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_min_amount1" yaml:"token_min_amount1"`
+}
+
+func (m *MsgCreatePositionRelative) Reset()         { *m = MsgCreatePositionRelative{} }
+func (m *MsgCreatePositionRelative) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePositionRelative) ProtoMessage()    {}
+func (*MsgCreatePositionRelative) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1f1fff802923d7db, []int{4}
 }
-func (m *MsgCollectFees) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreatePositionRelative) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCollectFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreatePositionRelative) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCollectFees.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreatePositionRelative.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -295,48 +384,84 @@ func (m *MsgCollectFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *MsgCollectFees) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCollectFees.Merge(m, src)
+func (m *MsgCreatePositionRelative) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePositionRelative.Merge(m, src)
 }
-func (m *MsgCollectFees) XXX_Size() int {
+func (m *MsgCreatePositionRelative) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCollectFees) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCollectFees.DiscardUnknown(m)
+func (m *MsgCreatePositionRelative) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePositionRelative.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCollectFees proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreatePositionRelative proto.InternalMessageInfo
 
-func (m *MsgCollectFees) GetPositionIds() []uint64 {
+func (m *MsgCreatePositionRelative) GetPoolId() uint64 {
 	if m != nil {
-		return m.PositionIds
+		return m.PoolId
 	}
-	return nil
+	return 0
 }
 
-func (m *MsgCollectFees) GetSender() string {
+func (m *MsgCreatePositionRelative) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-type MsgCollectFeesResponse struct {
-	CollectedFees []types.Coin `protobuf:"bytes,1,rep,name=collected_fees,json=collectedFees,proto3" json:"collected_fees" yaml:"collected_fees"`
+func (m *MsgCreatePositionRelative) GetLowerTickOffset() int64 {
+	if m != nil {
+		return m.LowerTickOffset
+	}
+	return 0
 }
 
-func (m *MsgCollectFeesResponse) Reset()         { *m = MsgCollectFeesResponse{} }
-func (m *MsgCollectFeesResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCollectFeesResponse) ProtoMessage()    {}
-func (*MsgCollectFeesResponse) Descriptor() ([]byte, []int) {
+func (m *MsgCreatePositionRelative) GetUpperTickOffset() int64 {
+	if m != nil {
+		return m.UpperTickOffset
+	}
+	return 0
+}
+
+func (m *MsgCreatePositionRelative) GetTokenDesired0() types.Coin {
+	if m != nil {
+		return m.TokenDesired0
+	}
+	return types.Coin{}
+}
+
+func (m *MsgCreatePositionRelative) GetTokenDesired1() types.Coin {
+	if m != nil {
+		return m.TokenDesired1
+	}
+	return types.Coin{}
+}
+
+type MsgCreatePositionRelativeResponse struct {
+	PositionId       uint64                                 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	Amount0          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount0" yaml:"amount0"`
+	Amount1          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount1" yaml:"amount1"`
+	JoinTime         time.Time                              `protobuf:"bytes,4,opt,name=join_time,json=joinTime,proto3,stdtime" json:"join_time" yaml:"join_time"`
+	LiquidityCreated github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=liquidity_created,json=liquidityCreated,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_created" yaml:"liquidity_created"`
+	// lower_tick and upper_tick are the absolute ticks computed from the
+	// current tick and the offsets, and that the position was created with.
+	LowerTick int64 `protobuf:"varint,6,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick int64 `protobuf:"varint,7,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+}
+
+func (m *MsgCreatePositionRelativeResponse) Reset()         { *m = MsgCreatePositionRelativeResponse{} }
+func (m *MsgCreatePositionRelativeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePositionRelativeResponse) ProtoMessage()    {}
+func (*MsgCreatePositionRelativeResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1f1fff802923d7db, []int{5}
 }
-func (m *MsgCollectFeesResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreatePositionRelativeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCollectFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreatePositionRelativeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCollectFeesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreatePositionRelativeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -346,43 +471,65 @@ func (m *MsgCollectFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgCollectFeesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCollectFeesResponse.Merge(m, src)
+func (m *MsgCreatePositionRelativeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePositionRelativeResponse.Merge(m, src)
 }
-func (m *MsgCollectFeesResponse) XXX_Size() int {
+func (m *MsgCreatePositionRelativeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCollectFeesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCollectFeesResponse.DiscardUnknown(m)
+func (m *MsgCreatePositionRelativeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePositionRelativeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCollectFeesResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreatePositionRelativeResponse proto.InternalMessageInfo
 
-func (m *MsgCollectFeesResponse) GetCollectedFees() []types.Coin {
+func (m *MsgCreatePositionRelativeResponse) GetPositionId() uint64 {
 	if m != nil {
-		return m.CollectedFees
+		return m.PositionId
 	}
-	return nil
+	return 0
 }
 
-// ===================== MsgCollectIncentives
-type MsgCollectIncentives struct {
-	PositionIds []uint64 `protobuf:"varint,1,rep,packed,name=position_ids,json=positionIds,proto3" json:"position_ids,omitempty" yaml:"position_ids"`
-	Sender      string   `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+func (m *MsgCreatePositionRelativeResponse) GetJoinTime() time.Time {
+	if m != nil {
+		return m.JoinTime
+	}
+	return time.Time{}
 }
 
-func (m *MsgCollectIncentives) Reset()         { *m = MsgCollectIncentives{} }
-func (m *MsgCollectIncentives) String() string { return proto.CompactTextString(m) }
-func (*MsgCollectIncentives) ProtoMessage()    {}
-func (*MsgCollectIncentives) Descriptor() ([]byte, []int) {
+func (m *MsgCreatePositionRelativeResponse) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
+	}
+	return 0
+}
+
+func (m *MsgCreatePositionRelativeResponse) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
+	}
+	return 0
+}
+
+// ===================== MsgWithdrawPosition
+type MsgWithdrawPosition struct {
+	PositionId      uint64                                 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	Sender          string                                 `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	LiquidityAmount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=liquidity_amount,json=liquidityAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_amount" yaml:"liquidity_amount"`
+}
+
+func (m *MsgWithdrawPosition) Reset()         { *m = MsgWithdrawPosition{} }
+func (m *MsgWithdrawPosition) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawPosition) ProtoMessage()    {}
+func (*MsgWithdrawPosition) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1f1fff802923d7db, []int{6}
 }
-func (m *MsgCollectIncentives) XXX_Unmarshal(b []byte) error {
+func (m *MsgWithdrawPosition) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCollectIncentives) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWithdrawPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCollectIncentives.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWithdrawPosition.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -392,48 +539,49 @@ func (m *MsgCollectIncentives) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgCollectIncentives) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCollectIncentives.Merge(m, src)
+func (m *MsgWithdrawPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawPosition.Merge(m, src)
 }
-func (m *MsgCollectIncentives) XXX_Size() int {
+func (m *MsgWithdrawPosition) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCollectIncentives) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCollectIncentives.DiscardUnknown(m)
+func (m *MsgWithdrawPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawPosition.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCollectIncentives proto.InternalMessageInfo
+var xxx_messageInfo_MsgWithdrawPosition proto.InternalMessageInfo
 
-func (m *MsgCollectIncentives) GetPositionIds() []uint64 {
+func (m *MsgWithdrawPosition) GetPositionId() uint64 {
 	if m != nil {
-		return m.PositionIds
+		return m.PositionId
 	}
-	return nil
+	return 0
 }
 
-func (m *MsgCollectIncentives) GetSender() string {
+func (m *MsgWithdrawPosition) GetSender() string {
 	if m != nil {
 		return m.Sender
 	}
 	return ""
 }
 
-type MsgCollectIncentivesResponse struct {
-	CollectedIncentives []types.Coin `protobuf:"bytes,1,rep,name=collected_incentives,json=collectedIncentives,proto3" json:"collected_incentives" yaml:"collected_incentives"`
+type MsgWithdrawPositionResponse struct {
+	Amount0 github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount0" yaml:"amount0"`
+	Amount1 github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount1" yaml:"amount1"`
 }
 
-func (m *MsgCollectIncentivesResponse) Reset()         { *m = MsgCollectIncentivesResponse{} }
-func (m *MsgCollectIncentivesResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCollectIncentivesResponse) ProtoMessage()    {}
-func (*MsgCollectIncentivesResponse) Descriptor() ([]byte, []int) {
+func (m *MsgWithdrawPositionResponse) Reset()         { *m = MsgWithdrawPositionResponse{} }
+func (m *MsgWithdrawPositionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawPositionResponse) ProtoMessage()    {}
+func (*MsgWithdrawPositionResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1f1fff802923d7db, []int{7}
 }
-func (m *MsgCollectIncentivesResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgWithdrawPositionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCollectIncentivesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWithdrawPositionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCollectIncentivesResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWithdrawPositionResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -443,48 +591,38 @@ func (m *MsgCollectIncentivesResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgCollectIncentivesResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCollectIncentivesResponse.Merge(m, src)
+func (m *MsgWithdrawPositionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawPositionResponse.Merge(m, src)
 }
-func (m *MsgCollectIncentivesResponse) XXX_Size() int {
+func (m *MsgWithdrawPositionResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCollectIncentivesResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCollectIncentivesResponse.DiscardUnknown(m)
+func (m *MsgWithdrawPositionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawPositionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCollectIncentivesResponse proto.InternalMessageInfo
-
-func (m *MsgCollectIncentivesResponse) GetCollectedIncentives() []types.Coin {
-	if m != nil {
-		return m.CollectedIncentives
-	}
-	return nil
-}
+var xxx_messageInfo_MsgWithdrawPositionResponse proto.InternalMessageInfo
 
-// ===================== MsgCreateIncentive
-type MsgCreateIncentive struct {
-	PoolId          uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
-	Sender          string                                 `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
-	IncentiveDenom  string                                 `protobuf:"bytes,3,opt,name=incentive_denom,json=incentiveDenom,proto3" json:"incentive_denom,omitempty"`
-	IncentiveAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=incentive_amount,json=incentiveAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"incentive_amount" yaml:"incentive_amount"`
-	EmissionRate    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=emission_rate,json=emissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"emission_rate" yaml:"emission_rate"`
-	StartTime       time.Time                              `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
-	MinUptime       time.Duration                          `protobuf:"bytes,7,opt,name=min_uptime,json=minUptime,proto3,stdduration" json:"duration,omitempty" yaml:"min_uptime"`
+// ===================== MsgWithdrawPositions
+// PositionWithdrawal is the amount of liquidity to withdraw from a single
+// position as part of MsgWithdrawPositions.
+type PositionWithdrawal struct {
+	PositionId      uint64                                 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	LiquidityAmount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=liquidity_amount,json=liquidityAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_amount" yaml:"liquidity_amount"`
 }
 
-func (m *MsgCreateIncentive) Reset()         { *m = MsgCreateIncentive{} }
-func (m *MsgCreateIncentive) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateIncentive) ProtoMessage()    {}
-func (*MsgCreateIncentive) Descriptor() ([]byte, []int) {
+func (m *PositionWithdrawal) Reset()         { *m = PositionWithdrawal{} }
+func (m *PositionWithdrawal) String() string { return proto.CompactTextString(m) }
+func (*PositionWithdrawal) ProtoMessage()    {}
+func (*PositionWithdrawal) Descriptor() ([]byte, []int) {
 	return fileDescriptor_1f1fff802923d7db, []int{8}
 }
-func (m *MsgCreateIncentive) XXX_Unmarshal(b []byte) error {
+func (m *PositionWithdrawal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateIncentive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PositionWithdrawal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateIncentive.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PositionWithdrawal.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -494,73 +632,93 @@ func (m *MsgCreateIncentive) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateIncentive) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateIncentive.Merge(m, src)
+func (m *PositionWithdrawal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PositionWithdrawal.Merge(m, src)
 }
-func (m *MsgCreateIncentive) XXX_Size() int {
+func (m *PositionWithdrawal) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateIncentive) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateIncentive.DiscardUnknown(m)
+func (m *PositionWithdrawal) XXX_DiscardUnknown() {
+	xxx_messageInfo_PositionWithdrawal.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateIncentive proto.InternalMessageInfo
+var xxx_messageInfo_PositionWithdrawal proto.InternalMessageInfo
 
-func (m *MsgCreateIncentive) GetPoolId() uint64 {
+func (m *PositionWithdrawal) GetPositionId() uint64 {
 	if m != nil {
-		return m.PoolId
+		return m.PositionId
 	}
 	return 0
 }
 
-func (m *MsgCreateIncentive) GetSender() string {
-	if m != nil {
-		return m.Sender
-	}
-	return ""
+type MsgWithdrawPositions struct {
+	Sender      string               `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	Withdrawals []PositionWithdrawal `protobuf:"bytes,2,rep,name=withdrawals,proto3" json:"withdrawals" yaml:"withdrawals"`
 }
 
-func (m *MsgCreateIncentive) GetIncentiveDenom() string {
-	if m != nil {
-		return m.IncentiveDenom
+func (m *MsgWithdrawPositions) Reset()         { *m = MsgWithdrawPositions{} }
+func (m *MsgWithdrawPositions) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawPositions) ProtoMessage()    {}
+func (*MsgWithdrawPositions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1fff802923d7db, []int{9}
+}
+func (m *MsgWithdrawPositions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawPositions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawPositions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *MsgWithdrawPositions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawPositions.Merge(m, src)
+}
+func (m *MsgWithdrawPositions) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawPositions) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawPositions.DiscardUnknown(m)
 }
 
-func (m *MsgCreateIncentive) GetStartTime() time.Time {
+var xxx_messageInfo_MsgWithdrawPositions proto.InternalMessageInfo
+
+func (m *MsgWithdrawPositions) GetSender() string {
 	if m != nil {
-		return m.StartTime
+		return m.Sender
 	}
-	return time.Time{}
+	return ""
 }
 
-func (m *MsgCreateIncentive) GetMinUptime() time.Duration {
+func (m *MsgWithdrawPositions) GetWithdrawals() []PositionWithdrawal {
 	if m != nil {
-		return m.MinUptime
+		return m.Withdrawals
 	}
-	return 0
+	return nil
 }
 
-type MsgCreateIncentiveResponse struct {
-	IncentiveDenom  string                                 `protobuf:"bytes,1,opt,name=incentive_denom,json=incentiveDenom,proto3" json:"incentive_denom,omitempty"`
-	IncentiveAmount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=incentive_amount,json=incentiveAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"incentive_amount" yaml:"incentive_amount"`
-	EmissionRate    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=emission_rate,json=emissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"emission_rate" yaml:"emission_rate"`
-	StartTime       time.Time                              `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
-	MinUptime       time.Duration                          `protobuf:"bytes,5,opt,name=min_uptime,json=minUptime,proto3,stdduration" json:"duration,omitempty" yaml:"min_uptime"`
+type MsgWithdrawPositionsResponse struct {
+	TokensOut github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=tokens_out,json=tokensOut,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tokens_out" yaml:"tokens_out"`
 }
 
-func (m *MsgCreateIncentiveResponse) Reset()         { *m = MsgCreateIncentiveResponse{} }
-func (m *MsgCreateIncentiveResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateIncentiveResponse) ProtoMessage()    {}
-func (*MsgCreateIncentiveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1f1fff802923d7db, []int{9}
+func (m *MsgWithdrawPositionsResponse) Reset()         { *m = MsgWithdrawPositionsResponse{} }
+func (m *MsgWithdrawPositionsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawPositionsResponse) ProtoMessage()    {}
+func (*MsgWithdrawPositionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1fff802923d7db, []int{10}
 }
-func (m *MsgCreateIncentiveResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgWithdrawPositionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateIncentiveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWithdrawPositionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateIncentiveResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWithdrawPositionsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -570,1089 +728,6687 @@ func (m *MsgCreateIncentiveResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateIncentiveResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateIncentiveResponse.Merge(m, src)
+func (m *MsgWithdrawPositionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawPositionsResponse.Merge(m, src)
 }
-func (m *MsgCreateIncentiveResponse) XXX_Size() int {
+func (m *MsgWithdrawPositionsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateIncentiveResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateIncentiveResponse.DiscardUnknown(m)
+func (m *MsgWithdrawPositionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawPositionsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateIncentiveResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgWithdrawPositionsResponse proto.InternalMessageInfo
 
-func (m *MsgCreateIncentiveResponse) GetIncentiveDenom() string {
+func (m *MsgWithdrawPositionsResponse) GetTokensOut() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
-		return m.IncentiveDenom
+		return m.TokensOut
 	}
-	return ""
+	return nil
 }
 
-func (m *MsgCreateIncentiveResponse) GetStartTime() time.Time {
-	if m != nil {
-		return m.StartTime
+// ===================== MsgEmergencyWithdraw
+// MsgEmergencyWithdraw withdraws all of a position's liquidity without
+// collecting its fees or incentives, which are forfeited. It is only allowed
+// while the emergency_withdraw_enabled param is set by governance.
+type MsgEmergencyWithdraw struct {
+	PositionId uint64 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	Sender     string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+}
+
+func (m *MsgEmergencyWithdraw) Reset()         { *m = MsgEmergencyWithdraw{} }
+func (m *MsgEmergencyWithdraw) String() string { return proto.CompactTextString(m) }
+func (*MsgEmergencyWithdraw) ProtoMessage()    {}
+func (*MsgEmergencyWithdraw) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1fff802923d7db, []int{11}
+}
+func (m *MsgEmergencyWithdraw) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEmergencyWithdraw) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEmergencyWithdraw.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return time.Time{}
+}
+func (m *MsgEmergencyWithdraw) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEmergencyWithdraw.Merge(m, src)
+}
+func (m *MsgEmergencyWithdraw) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEmergencyWithdraw) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEmergencyWithdraw.DiscardUnknown(m)
 }
 
-func (m *MsgCreateIncentiveResponse) GetMinUptime() time.Duration {
+var xxx_messageInfo_MsgEmergencyWithdraw proto.InternalMessageInfo
+
+func (m *MsgEmergencyWithdraw) GetPositionId() uint64 {
 	if m != nil {
-		return m.MinUptime
+		return m.PositionId
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*MsgCreatePosition)(nil), "osmosis.concentratedliquidity.v1beta1.MsgCreatePosition")
-	proto.RegisterType((*MsgCreatePositionResponse)(nil), "osmosis.concentratedliquidity.v1beta1.MsgCreatePositionResponse")
-	proto.RegisterType((*MsgWithdrawPosition)(nil), "osmosis.concentratedliquidity.v1beta1.MsgWithdrawPosition")
-	proto.RegisterType((*MsgWithdrawPositionResponse)(nil), "osmosis.concentratedliquidity.v1beta1.MsgWithdrawPositionResponse")
-	proto.RegisterType((*MsgCollectFees)(nil), "osmosis.concentratedliquidity.v1beta1.MsgCollectFees")
-	proto.RegisterType((*MsgCollectFeesResponse)(nil), "osmosis.concentratedliquidity.v1beta1.MsgCollectFeesResponse")
-	proto.RegisterType((*MsgCollectIncentives)(nil), "osmosis.concentratedliquidity.v1beta1.MsgCollectIncentives")
-	proto.RegisterType((*MsgCollectIncentivesResponse)(nil), "osmosis.concentratedliquidity.v1beta1.MsgCollectIncentivesResponse")
-	proto.RegisterType((*MsgCreateIncentive)(nil), "osmosis.concentratedliquidity.v1beta1.MsgCreateIncentive")
-	proto.RegisterType((*MsgCreateIncentiveResponse)(nil), "osmosis.concentratedliquidity.v1beta1.MsgCreateIncentiveResponse")
+func (m *MsgEmergencyWithdraw) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func init() {
-	proto.RegisterFile("osmosis/concentrated-liquidity/tx.proto", fileDescriptor_1f1fff802923d7db)
+type MsgEmergencyWithdrawResponse struct {
+	Amount0 github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=amount0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount0" yaml:"amount0"`
+	Amount1 github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount1" yaml:"amount1"`
 }
 
-var fileDescriptor_1f1fff802923d7db = []byte{
-	// 1107 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0xd1, 0x6f, 0xdb, 0x44,
-	0x18, 0xaf, 0x97, 0xb4, 0x5d, 0xaf, 0x6b, 0xda, 0xb8, 0xdd, 0x96, 0x65, 0x23, 0xae, 0x0e, 0xc1,
-	0x8a, 0xa0, 0xf6, 0xdc, 0x31, 0x81, 0x8a, 0x90, 0x86, 0x5b, 0x4d, 0x0a, 0x52, 0x25, 0x64, 0x6d,
-	0x02, 0x4d, 0x48, 0x96, 0x63, 0xdf, 0xb2, 0x23, 0xb1, 0xcf, 0xcb, 0x5d, 0xda, 0xe5, 0x81, 0x27,
-	0x9e, 0x90, 0x78, 0x18, 0x48, 0x48, 0xfc, 0x3b, 0x3c, 0xb1, 0xc7, 0xbd, 0x20, 0x21, 0x84, 0x0c,
-	0x6a, 0xdf, 0x78, 0x41, 0xe4, 0x2f, 0x40, 0xf6, 0x9d, 0xcf, 0x69, 0x52, 0xb4, 0x3a, 0x5d, 0xf7,
-	0x94, 0xdc, 0x77, 0xdf, 0xf7, 0xfb, 0xdd, 0x7d, 0xdf, 0xef, 0xbb, 0x3b, 0x83, 0x9b, 0x84, 0x06,
-	0x84, 0x62, 0x6a, 0x78, 0x24, 0xf4, 0x50, 0xc8, 0x7a, 0x2e, 0x43, 0xfe, 0x66, 0x17, 0x3f, 0xe9,
-	0x63, 0x1f, 0xb3, 0x81, 0xc1, 0x9e, 0xea, 0x51, 0x8f, 0x30, 0xa2, 0xbe, 0x25, 0x1c, 0xf5, 0x51,
-	0x47, 0xe9, 0xa7, 0xef, 0x9b, 0x2d, 0xc4, 0x5c, 0xb3, 0xbe, 0xd6, 0x26, 0x6d, 0x92, 0x46, 0x18,
-	0xc9, 0x3f, 0x1e, 0x5c, 0xd7, 0xda, 0x84, 0xb4, 0xbb, 0xc8, 0x48, 0x47, 0xad, 0xfe, 0x23, 0x83,
-	0xe1, 0x00, 0x51, 0xe6, 0x06, 0x91, 0x70, 0x68, 0x8c, 0x3b, 0xf8, 0xfd, 0x9e, 0xcb, 0x30, 0x09,
-	0xb3, 0x79, 0x2f, 0xa5, 0x37, 0x5a, 0x2e, 0x45, 0x86, 0xe0, 0x32, 0x3c, 0x82, 0xc5, 0x3c, 0xfc,
-	0x76, 0x16, 0x54, 0xf7, 0x68, 0x7b, 0xa7, 0x87, 0x5c, 0x86, 0x3e, 0x23, 0x14, 0x27, 0xb1, 0xea,
-	0xbb, 0x60, 0x3e, 0x22, 0xa4, 0xeb, 0x60, 0xbf, 0xa6, 0xac, 0x2b, 0x1b, 0x65, 0x4b, 0x1d, 0xc6,
-	0x5a, 0x65, 0xe0, 0x06, 0xdd, 0x6d, 0x28, 0x26, 0xa0, 0x3d, 0x97, 0xfc, 0x6b, 0xfa, 0xea, 0x3b,
-	0x60, 0x8e, 0xa2, 0xd0, 0x47, 0xbd, 0xda, 0x85, 0x75, 0x65, 0x63, 0xc1, 0xaa, 0x0e, 0x63, 0x6d,
-	0x89, 0xfb, 0x72, 0x3b, 0xb4, 0x85, 0x83, 0xfa, 0x3e, 0x00, 0x5d, 0x72, 0x80, 0x7a, 0x0e, 0xc3,
-	0x5e, 0xa7, 0x56, 0x5a, 0x57, 0x36, 0x4a, 0xd6, 0xe5, 0x61, 0xac, 0x55, 0xb9, 0x7b, 0x3e, 0x07,
-	0xed, 0x85, 0x74, 0x70, 0x1f, 0x7b, 0x9d, 0x24, 0xaa, 0x1f, 0x45, 0x59, 0x54, 0x79, 0x3c, 0x2a,
-	0x9f, 0x83, 0xf6, 0x42, 0x3a, 0x48, 0xa3, 0x1c, 0x50, 0x61, 0xa4, 0x83, 0x42, 0xc7, 0x47, 0x14,
-	0xf7, 0x90, 0x7f, 0xab, 0x36, 0xbb, 0xae, 0x6c, 0x2c, 0x6e, 0x5d, 0xd3, 0x79, 0x4a, 0xf4, 0x24,
-	0x25, 0x59, 0xfa, 0xf5, 0x1d, 0x82, 0x43, 0xeb, 0x8d, 0xe7, 0xb1, 0x36, 0x33, 0x8c, 0xb5, 0xcb,
-	0x1c, 0xf8, 0x78, 0x38, 0xb4, 0x97, 0x52, 0xc3, 0xae, 0x18, 0x4f, 0x10, 0x98, 0xb5, 0xb9, 0xb3,
-	0x10, 0x98, 0x63, 0x04, 0xa6, 0xba, 0x0f, 0xaa, 0xdc, 0x23, 0xc0, 0xa1, 0xe3, 0x06, 0xa4, 0x1f,
-	0xb2, 0x5b, 0xb5, 0xf9, 0x34, 0xc7, 0x9f, 0x26, 0x40, 0xbf, 0xc7, 0xda, 0xdb, 0x6d, 0xcc, 0x1e,
-	0xf7, 0x5b, 0xba, 0x47, 0x02, 0x43, 0x54, 0x9a, 0xff, 0x6c, 0x52, 0xbf, 0x63, 0xb0, 0x41, 0x84,
-	0xa8, 0xde, 0x0c, 0xd9, 0x30, 0xd6, 0x6a, 0xa3, 0x94, 0x23, 0x80, 0xd0, 0x5e, 0x4e, 0x6d, 0x7b,
-	0x38, 0xfc, 0x84, 0x5b, 0x4e, 0xe2, 0x35, 0x6b, 0x17, 0x5f, 0x2d, 0xaf, 0x39, 0xc1, 0x6b, 0xc2,
-	0x3f, 0x4a, 0xe0, 0xda, 0x84, 0x16, 0x6d, 0x44, 0x23, 0x12, 0x52, 0xa4, 0x7e, 0x00, 0x16, 0x23,
-	0x61, 0xcb, 0x75, 0x79, 0x65, 0x18, 0x6b, 0x6a, 0xa6, 0x4b, 0x39, 0x09, 0x6d, 0x90, 0x8d, 0x9a,
-	0xbe, 0xfa, 0x10, 0xcc, 0x67, 0xc9, 0xe3, 0x02, 0xbd, 0x5b, 0x78, 0x13, 0x42, 0xfa, 0x32, 0x65,
-	0x19, 0x60, 0x8e, 0x6d, 0xa6, 0x6a, 0x3e, 0x33, 0xb6, 0x29, 0xb1, 0x4d, 0xf5, 0x01, 0x58, 0xf8,
-	0x8a, 0xe0, 0xd0, 0x49, 0x5a, 0x3e, 0x55, 0xfd, 0xe2, 0x56, 0x5d, 0xe7, 0xed, 0xae, 0x67, 0xed,
-	0xae, 0xdf, 0xcf, 0xce, 0x03, 0xeb, 0x86, 0xd0, 0xd6, 0x0a, 0xc7, 0x93, 0xa1, 0xf0, 0xd9, 0x9f,
-	0x9a, 0x62, 0x5f, 0x4c, 0xc6, 0x89, 0xb3, 0x7a, 0x00, 0xaa, 0xf2, 0xf4, 0x71, 0xbc, 0x34, 0xd7,
-	0x7e, 0xda, 0x1a, 0xc5, 0xaa, 0xbb, 0x8b, 0xbc, 0xbc, 0xba, 0x13, 0x80, 0xd0, 0x5e, 0x91, 0xb6,
-	0x1d, 0x61, 0xfa, 0x47, 0x01, 0xab, 0x7b, 0xb4, 0xfd, 0x39, 0x66, 0x8f, 0xfd, 0x9e, 0x7b, 0x20,
-	0x0f, 0x9b, 0xa9, 0x0b, 0x5b, 0xe0, 0xe0, 0x61, 0x20, 0x5f, 0x8f, 0x50, 0xa0, 0x28, 0x58, 0xb3,
-	0xf0, 0x9e, 0xaf, 0x8e, 0xef, 0x99, 0xe3, 0x41, 0x7b, 0x59, 0x9a, 0xb8, 0xa2, 0xe1, 0xaf, 0x0a,
-	0xb8, 0x7e, 0xc2, 0x8e, 0xa5, 0xa4, 0x47, 0x94, 0xa9, 0x9c, 0xa3, 0x32, 0x2f, 0xbc, 0x62, 0x65,
-	0xc2, 0x03, 0x50, 0x49, 0xfa, 0x94, 0x74, 0xbb, 0xc8, 0x63, 0xf7, 0x10, 0xa2, 0xea, 0x36, 0xb8,
-	0x34, 0x52, 0x26, 0x5a, 0x53, 0xd6, 0x4b, 0x1b, 0x65, 0xeb, 0xea, 0x30, 0xd6, 0x56, 0x27, 0x8a,
-	0x48, 0xa1, 0xbd, 0x98, 0x57, 0x91, 0x16, 0x28, 0x23, 0x1c, 0x80, 0x2b, 0xc7, 0x89, 0x65, 0x2a,
-	0x1d, 0x50, 0xf1, 0xb8, 0x19, 0xf9, 0xce, 0x23, 0x84, 0xf8, 0x12, 0x8a, 0x1c, 0xc6, 0xc7, 0xc3,
-	0xa1, 0xbd, 0x24, 0x0d, 0x09, 0x11, 0xfc, 0x1a, 0xac, 0xe5, 0xd4, 0xcd, 0xf4, 0x26, 0xc7, 0xfb,
-	0xaf, 0x6f, 0xe7, 0xdf, 0x2b, 0xe0, 0xc6, 0x49, 0xfc, 0x32, 0x01, 0x4f, 0xc0, 0x5a, 0xbe, 0x03,
-	0x2c, 0xe7, 0x5f, 0x9e, 0x86, 0x37, 0x45, 0x1a, 0xae, 0x8f, 0xa7, 0x21, 0x07, 0x81, 0xf6, 0xaa,
-	0x34, 0xe7, 0xd4, 0xf0, 0xe7, 0x32, 0x50, 0xe5, 0x79, 0x2d, 0xed, 0xe7, 0xf6, 0x78, 0xb8, 0x09,
-	0x96, 0xe5, 0x92, 0x1c, 0x1f, 0x85, 0x24, 0xe0, 0x2d, 0x6c, 0x57, 0xa4, 0x79, 0x37, 0xb1, 0x26,
-	0xcd, 0x9e, 0x3b, 0x8a, 0x66, 0x2f, 0x17, 0x6e, 0x76, 0xde, 0x03, 0xa2, 0xd9, 0xc7, 0xf1, 0xa0,
-	0x9d, 0xaf, 0x85, 0x37, 0xbb, 0xda, 0x01, 0x4b, 0x28, 0xc0, 0x94, 0x26, 0xa5, 0x4e, 0xde, 0x78,
-	0xe2, 0x4c, 0xbd, 0x57, 0xf8, 0x7c, 0x59, 0xe3, 0x94, 0xc7, 0xc0, 0xa0, 0x7d, 0x29, 0x1b, 0xdb,
-	0x2e, 0x43, 0xea, 0x17, 0x00, 0x50, 0xe6, 0xf6, 0x18, 0xbf, 0x1c, 0xe6, 0x5e, 0x7a, 0x39, 0x64,
-	0x5a, 0x17, 0x4f, 0xa6, 0x3c, 0x96, 0xdf, 0x0e, 0x0b, 0xa9, 0x21, 0xbd, 0x1e, 0x02, 0x00, 0x92,
-	0x5b, 0xba, 0x1f, 0xa5, 0xc8, 0xf3, 0xe2, 0x45, 0x33, 0x8e, 0xbc, 0x2b, 0x5e, 0x99, 0xd6, 0xed,
-	0x04, 0xf8, 0xef, 0x58, 0x53, 0xb3, 0x77, 0xe7, 0x7b, 0x24, 0xc0, 0x0c, 0x05, 0x11, 0x1b, 0xe4,
-	0x74, 0x39, 0x20, 0xfc, 0x29, 0xa5, 0x0b, 0x70, 0xf8, 0x80, 0x8f, 0xff, 0x2d, 0x81, 0xfa, 0xa4,
-	0x86, 0xa4, 0xaa, 0x4f, 0xa8, 0xb9, 0x72, 0xea, 0x9a, 0x5f, 0x38, 0xdb, 0x01, 0x3f, 0x4d, 0xcd,
-	0x4b, 0xaf, 0xad, 0xe6, 0xe5, 0x73, 0xab, 0xf9, 0xec, 0x39, 0xd7, 0x7c, 0xeb, 0x97, 0x32, 0x28,
-	0xed, 0xd1, 0xb6, 0xfa, 0x9d, 0x02, 0x2a, 0x63, 0x1f, 0x1e, 0x1f, 0xea, 0xa7, 0xfa, 0x5a, 0xd2,
-	0x27, 0x9e, 0x89, 0xf5, 0xbb, 0xd3, 0x46, 0x4a, 0xad, 0xfd, 0xa0, 0x80, 0x95, 0x89, 0xc7, 0xc9,
-	0xf6, 0xe9, 0x61, 0xc7, 0x63, 0xeb, 0xd6, 0xf4, 0xb1, 0x72, 0x51, 0xdf, 0x28, 0x60, 0x71, 0xf4,
-	0xa2, 0xbd, 0x53, 0x60, 0x9b, 0x79, 0x58, 0xfd, 0xe3, 0xa9, 0xc2, 0xe4, 0x2a, 0x7e, 0x54, 0x40,
-	0x75, 0xf2, 0xea, 0xfb, 0xa8, 0x30, 0x68, 0x1e, 0x5c, 0xdf, 0x39, 0x43, 0x70, 0xb6, 0x2e, 0xeb,
-	0xcb, 0xe7, 0x87, 0x0d, 0xe5, 0xc5, 0x61, 0x43, 0xf9, 0xeb, 0xb0, 0xa1, 0x3c, 0x3b, 0x6a, 0xcc,
-	0xbc, 0x38, 0x6a, 0xcc, 0xfc, 0x76, 0xd4, 0x98, 0x79, 0x68, 0x8d, 0xb4, 0x9e, 0x20, 0xda, 0xec,
-	0xba, 0x2d, 0x9a, 0x0d, 0x8c, 0x7d, 0xf3, 0x8e, 0xf1, 0xf4, 0x7f, 0x3f, 0xde, 0x93, 0xd6, 0x6c,
-	0xcd, 0xa5, 0xd2, 0xbf, 0xfd, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf2, 0xa7, 0x76, 0x3f, 0xeb,
-	0x0f, 0x00, 0x00,
+func (m *MsgEmergencyWithdrawResponse) Reset()         { *m = MsgEmergencyWithdrawResponse{} }
+func (m *MsgEmergencyWithdrawResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEmergencyWithdrawResponse) ProtoMessage()    {}
+func (*MsgEmergencyWithdrawResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1fff802923d7db, []int{12}
+}
+func (m *MsgEmergencyWithdrawResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEmergencyWithdrawResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEmergencyWithdrawResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEmergencyWithdrawResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEmergencyWithdrawResponse.Merge(m, src)
+}
+func (m *MsgEmergencyWithdrawResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEmergencyWithdrawResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEmergencyWithdrawResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgEmergencyWithdrawResponse proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	CreatePosition(ctx context.Context, in *MsgCreatePosition, opts ...grpc.CallOption) (*MsgCreatePositionResponse, error)
-	WithdrawPosition(ctx context.Context, in *MsgWithdrawPosition, opts ...grpc.CallOption) (*MsgWithdrawPositionResponse, error)
-	CollectFees(ctx context.Context, in *MsgCollectFees, opts ...grpc.CallOption) (*MsgCollectFeesResponse, error)
-	CollectIncentives(ctx context.Context, in *MsgCollectIncentives, opts ...grpc.CallOption) (*MsgCollectIncentivesResponse, error)
+// ===================== MsgCollectFees
+type MsgCollectFees struct {
+	PositionIds []uint64 `protobuf:"varint,1,rep,packed,name=position_ids,json=positionIds,proto3" json:"position_ids,omitempty" yaml:"position_ids"`
+	Sender      string   `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgCollectFees) Reset()         { *m = MsgCollectFees{} }
+func (m *MsgCollectFees) String() string { return proto.CompactTextString(m) }
+func (*MsgCollectFees) ProtoMessage()    {}
+func (*MsgCollectFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1fff802923d7db, []int{13}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgCollectFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) CreatePosition(ctx context.Context, in *MsgCreatePosition, opts ...grpc.CallOption) (*MsgCreatePositionResponse, error) {
-	out := new(MsgCreatePositionResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Msg/CreatePosition", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCollectFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCollectFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) WithdrawPosition(ctx context.Context, in *MsgWithdrawPosition, opts ...grpc.CallOption) (*MsgWithdrawPositionResponse, error) {
-	out := new(MsgWithdrawPositionResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Msg/WithdrawPosition", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgCollectFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCollectFees.Merge(m, src)
+}
+func (m *MsgCollectFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCollectFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCollectFees.DiscardUnknown(m)
 }
 
-func (c *msgClient) CollectFees(ctx context.Context, in *MsgCollectFees, opts ...grpc.CallOption) (*MsgCollectFeesResponse, error) {
-	out := new(MsgCollectFeesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Msg/CollectFees", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCollectFees proto.InternalMessageInfo
+
+func (m *MsgCollectFees) GetPositionIds() []uint64 {
+	if m != nil {
+		return m.PositionIds
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) CollectIncentives(ctx context.Context, in *MsgCollectIncentives, opts ...grpc.CallOption) (*MsgCollectIncentivesResponse, error) {
-	out := new(MsgCollectIncentivesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Msg/CollectIncentives", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCollectFees) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	CreatePosition(context.Context, *MsgCreatePosition) (*MsgCreatePositionResponse, error)
-	WithdrawPosition(context.Context, *MsgWithdrawPosition) (*MsgWithdrawPositionResponse, error)
-	CollectFees(context.Context, *MsgCollectFees) (*MsgCollectFeesResponse, error)
-	CollectIncentives(context.Context, *MsgCollectIncentives) (*MsgCollectIncentivesResponse, error)
+type MsgCollectFeesResponse struct {
+	CollectedFees []types.Coin `protobuf:"bytes,1,rep,name=collected_fees,json=collectedFees,proto3" json:"collected_fees" yaml:"collected_fees"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgCollectFeesResponse) Reset()         { *m = MsgCollectFeesResponse{} }
+func (m *MsgCollectFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCollectFeesResponse) ProtoMessage()    {}
+func (*MsgCollectFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1fff802923d7db, []int{14}
 }
-
-func (*UnimplementedMsgServer) CreatePosition(ctx context.Context, req *MsgCreatePosition) (*MsgCreatePositionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreatePosition not implemented")
+func (m *MsgCollectFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) WithdrawPosition(ctx context.Context, req *MsgWithdrawPosition) (*MsgWithdrawPositionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawPosition not implemented")
+func (m *MsgCollectFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCollectFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CollectFees(ctx context.Context, req *MsgCollectFees) (*MsgCollectFeesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CollectFees not implemented")
+func (m *MsgCollectFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCollectFeesResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CollectIncentives(ctx context.Context, req *MsgCollectIncentives) (*MsgCollectIncentivesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CollectIncentives not implemented")
+func (m *MsgCollectFeesResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgCollectFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCollectFeesResponse.DiscardUnknown(m)
 }
 
-func _Msg_CreatePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreatePosition)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreatePosition(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Msg/CreatePosition",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreatePosition(ctx, req.(*MsgCreatePosition))
+var xxx_messageInfo_MsgCollectFeesResponse proto.InternalMessageInfo
+
+func (m *MsgCollectFeesResponse) GetCollectedFees() []types.Coin {
+	if m != nil {
+		return m.CollectedFees
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_WithdrawPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawPosition)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawPosition(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Msg/WithdrawPosition",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawPosition(ctx, req.(*MsgWithdrawPosition))
-	}
-	return interceptor(ctx, in, info, handler)
+// ===================== MsgCollectIncentives
+type MsgCollectIncentives struct {
+	PositionIds []uint64 `protobuf:"varint,1,rep,packed,name=position_ids,json=positionIds,proto3" json:"position_ids,omitempty" yaml:"position_ids"`
+	Sender      string   `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
 }
 
-func _Msg_CollectFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCollectFees)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CollectFees(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Msg/CollectFees",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CollectFees(ctx, req.(*MsgCollectFees))
+func (m *MsgCollectIncentives) Reset()         { *m = MsgCollectIncentives{} }
+func (m *MsgCollectIncentives) String() string { return proto.CompactTextString(m) }
+func (*MsgCollectIncentives) ProtoMessage()    {}
+func (*MsgCollectIncentives) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1fff802923d7db, []int{15}
+}
+func (m *MsgCollectIncentives) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCollectIncentives) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCollectIncentives.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCollectIncentives) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCollectIncentives.Merge(m, src)
+}
+func (m *MsgCollectIncentives) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCollectIncentives) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCollectIncentives.DiscardUnknown(m)
 }
 
-func _Msg_CollectIncentives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCollectIncentives)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CollectIncentives(ctx, in)
+var xxx_messageInfo_MsgCollectIncentives proto.InternalMessageInfo
+
+func (m *MsgCollectIncentives) GetPositionIds() []uint64 {
+	if m != nil {
+		return m.PositionIds
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Msg/CollectIncentives",
+	return nil
+}
+
+func (m *MsgCollectIncentives) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CollectIncentives(ctx, req.(*MsgCollectIncentives))
+	return ""
+}
+
+type MsgCollectIncentivesResponse struct {
+	CollectedIncentives []types.Coin `protobuf:"bytes,1,rep,name=collected_incentives,json=collectedIncentives,proto3" json:"collected_incentives" yaml:"collected_incentives"`
+	// forfeited_incentives are the incentives accrued for uptimes the positions
+	// had not yet reached, which are redistributed to the other positions.
+	ForfeitedIncentives []types.Coin `protobuf:"bytes,2,rep,name=forfeited_incentives,json=forfeitedIncentives,proto3" json:"forfeited_incentives" yaml:"forfeited_incentives"`
+}
+
+func (m *MsgCollectIncentivesResponse) Reset()         { *m = MsgCollectIncentivesResponse{} }
+func (m *MsgCollectIncentivesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCollectIncentivesResponse) ProtoMessage()    {}
+func (*MsgCollectIncentivesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1fff802923d7db, []int{16}
+}
+func (m *MsgCollectIncentivesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCollectIncentivesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCollectIncentivesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgCollectIncentivesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCollectIncentivesResponse.Merge(m, src)
+}
+func (m *MsgCollectIncentivesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCollectIncentivesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCollectIncentivesResponse.DiscardUnknown(m)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.concentratedliquidity.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreatePosition",
-			Handler:    _Msg_CreatePosition_Handler,
-		},
-		{
-			MethodName: "WithdrawPosition",
-			Handler:    _Msg_WithdrawPosition_Handler,
-		},
-		{
-			MethodName: "CollectFees",
-			Handler:    _Msg_CollectFees_Handler,
-		},
-		{
-			MethodName: "CollectIncentives",
-			Handler:    _Msg_CollectIncentives_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/concentrated-liquidity/tx.proto",
+var xxx_messageInfo_MsgCollectIncentivesResponse proto.InternalMessageInfo
+
+func (m *MsgCollectIncentivesResponse) GetCollectedIncentives() []types.Coin {
+	if m != nil {
+		return m.CollectedIncentives
+	}
+	return nil
 }
 
-func (m *MsgCreatePosition) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCollectIncentivesResponse) GetForfeitedIncentives() []types.Coin {
+	if m != nil {
+		return m.ForfeitedIncentives
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCreatePosition) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ===================== MsgCreateIncentive
+type MsgCreateIncentive struct {
+	PoolId          uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Sender          string                                 `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	IncentiveDenom  string                                 `protobuf:"bytes,3,opt,name=incentive_denom,json=incentiveDenom,proto3" json:"incentive_denom,omitempty"`
+	IncentiveAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=incentive_amount,json=incentiveAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"incentive_amount" yaml:"incentive_amount"`
+	EmissionRate    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=emission_rate,json=emissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"emission_rate" yaml:"emission_rate"`
+	StartTime       time.Time                              `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	MinUptime       time.Duration                          `protobuf:"bytes,7,opt,name=min_uptime,json=minUptime,proto3,stdduration" json:"duration,omitempty" yaml:"min_uptime"`
 }
 
-func (m *MsgCreatePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.TokenMinAmount1.Size()
-		i -= size
-		if _, err := m.TokenMinAmount1.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgCreateIncentive) Reset()         { *m = MsgCreateIncentive{} }
+func (m *MsgCreateIncentive) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateIncentive) ProtoMessage()    {}
+func (*MsgCreateIncentive) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1fff802923d7db, []int{17}
+}
+func (m *MsgCreateIncentive) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateIncentive) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateIncentive.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size := m.TokenMinAmount0.Size()
-		i -= size
-		if _, err := m.TokenMinAmount0.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x3a
-	{
-		size, err := m.TokenDesired1.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size, err := m.TokenDesired0.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	if m.UpperTick != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UpperTick))
-		i--
-		dAtA[i] = 0x20
+}
+func (m *MsgCreateIncentive) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateIncentive.Merge(m, src)
+}
+func (m *MsgCreateIncentive) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateIncentive) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateIncentive.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateIncentive proto.InternalMessageInfo
+
+func (m *MsgCreateIncentive) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	if m.LowerTick != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.LowerTick))
-		i--
-		dAtA[i] = 0x18
+	return 0
+}
+
+func (m *MsgCreateIncentive) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgCreateIncentive) GetIncentiveDenom() string {
+	if m != nil {
+		return m.IncentiveDenom
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *MsgCreateIncentive) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *MsgCreatePositionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateIncentive) GetMinUptime() time.Duration {
+	if m != nil {
+		return m.MinUptime
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCreatePositionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgCreateIncentiveResponse struct {
+	IncentiveDenom  string                                 `protobuf:"bytes,1,opt,name=incentive_denom,json=incentiveDenom,proto3" json:"incentive_denom,omitempty"`
+	IncentiveAmount github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=incentive_amount,json=incentiveAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"incentive_amount" yaml:"incentive_amount"`
+	EmissionRate    github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=emission_rate,json=emissionRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"emission_rate" yaml:"emission_rate"`
+	StartTime       time.Time                              `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	MinUptime       time.Duration                          `protobuf:"bytes,5,opt,name=min_uptime,json=minUptime,proto3,stdduration" json:"duration,omitempty" yaml:"min_uptime"`
 }
 
-func (m *MsgCreatePositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.LiquidityCreated.Size()
-		i -= size
-		if _, err := m.LiquidityCreated.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JoinTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JoinTime):])
-	if err3 != nil {
-		return 0, err3
-	}
-	i -= n3
-	i = encodeVarintTx(dAtA, i, uint64(n3))
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.Amount1.Size()
-		i -= size
-		if _, err := m.Amount1.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgCreateIncentiveResponse) Reset()         { *m = MsgCreateIncentiveResponse{} }
+func (m *MsgCreateIncentiveResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateIncentiveResponse) ProtoMessage()    {}
+func (*MsgCreateIncentiveResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1fff802923d7db, []int{18}
+}
+func (m *MsgCreateIncentiveResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateIncentiveResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateIncentiveResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.Amount0.Size()
-		i -= size
-		if _, err := m.Amount0.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+}
+func (m *MsgCreateIncentiveResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateIncentiveResponse.Merge(m, src)
+}
+func (m *MsgCreateIncentiveResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateIncentiveResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateIncentiveResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateIncentiveResponse proto.InternalMessageInfo
+
+func (m *MsgCreateIncentiveResponse) GetIncentiveDenom() string {
+	if m != nil {
+		return m.IncentiveDenom
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.PositionId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *MsgCreateIncentiveResponse) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
 	}
-	return len(dAtA) - i, nil
+	return time.Time{}
 }
 
-func (m *MsgWithdrawPosition) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateIncentiveResponse) GetMinUptime() time.Duration {
+	if m != nil {
+		return m.MinUptime
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgWithdrawPosition) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ===================== MsgWithdrawProtocolFees
+// MsgWithdrawProtocolFees withdraws the protocol fees accrued by a pool to the
+// sender. Only the protocol_fee_recipient set by governance may send it.
+type MsgWithdrawProtocolFees struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
 }
 
-func (m *MsgWithdrawPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.LiquidityAmount.Size()
-		i -= size
-		if _, err := m.LiquidityAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgWithdrawProtocolFees) Reset()         { *m = MsgWithdrawProtocolFees{} }
+func (m *MsgWithdrawProtocolFees) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawProtocolFees) ProtoMessage()    {}
+func (*MsgWithdrawProtocolFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1fff802923d7db, []int{19}
+}
+func (m *MsgWithdrawProtocolFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawProtocolFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawProtocolFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.PositionId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *MsgWithdrawProtocolFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawProtocolFees.Merge(m, src)
+}
+func (m *MsgWithdrawProtocolFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawProtocolFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawProtocolFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawProtocolFees proto.InternalMessageInfo
+
+func (m *MsgWithdrawProtocolFees) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgWithdrawPositionResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawProtocolFees) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgWithdrawPositionResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgWithdrawProtocolFeesResponse struct {
+	WithdrawnFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=withdrawn_fees,json=withdrawnFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"withdrawn_fees" yaml:"withdrawn_fees"`
 }
 
-func (m *MsgWithdrawPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount1.Size()
-		i -= size
-		if _, err := m.Amount1.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.Amount0.Size()
-		i -= size
-		if _, err := m.Amount0.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgWithdrawProtocolFeesResponse) Reset()         { *m = MsgWithdrawProtocolFeesResponse{} }
+func (m *MsgWithdrawProtocolFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawProtocolFeesResponse) ProtoMessage()    {}
+func (*MsgWithdrawProtocolFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1fff802923d7db, []int{20}
+}
+func (m *MsgWithdrawProtocolFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawProtocolFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawProtocolFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *MsgWithdrawProtocolFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawProtocolFeesResponse.Merge(m, src)
+}
+func (m *MsgWithdrawProtocolFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawProtocolFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawProtocolFeesResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCollectFees) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgWithdrawProtocolFeesResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawProtocolFeesResponse) GetWithdrawnFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.WithdrawnFees
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCollectFees) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ===================== MsgUpdateTickSpacing
+// MsgUpdateTickSpacing changes the tick spacing of an existing pool. Only the
+// tick_spacing_authority set by governance may send it. Existing positions
+// whose ticks no longer align with the new spacing are grandfathered: they
+// can still be withdrawn, but no liquidity can be added at their ticks.
+type MsgUpdateTickSpacing struct {
+	PoolId         uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Sender         string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	NewTickSpacing uint64 `protobuf:"varint,3,opt,name=new_tick_spacing,json=newTickSpacing,proto3" json:"new_tick_spacing,omitempty" yaml:"new_tick_spacing"`
 }
 
-func (m *MsgCollectFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PositionIds) > 0 {
-		dAtA5 := make([]byte, len(m.PositionIds)*10)
-		var j4 int
-		for _, num := range m.PositionIds {
-			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j4++
-			}
-			dAtA5[j4] = uint8(num)
-			j4++
+func (m *MsgUpdateTickSpacing) Reset()         { *m = MsgUpdateTickSpacing{} }
+func (m *MsgUpdateTickSpacing) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTickSpacing) ProtoMessage()    {}
+func (*MsgUpdateTickSpacing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1fff802923d7db, []int{21}
+}
+func (m *MsgUpdateTickSpacing) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTickSpacing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTickSpacing.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintTx(dAtA, i, uint64(j4))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgUpdateTickSpacing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTickSpacing.Merge(m, src)
+}
+func (m *MsgUpdateTickSpacing) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTickSpacing) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTickSpacing.DiscardUnknown(m)
 }
 
-func (m *MsgCollectFeesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUpdateTickSpacing proto.InternalMessageInfo
+
+func (m *MsgUpdateTickSpacing) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCollectFeesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgUpdateTickSpacing) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *MsgCollectFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CollectedFees) > 0 {
-		for iNdEx := len(m.CollectedFees) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CollectedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *MsgUpdateTickSpacing) GetNewTickSpacing() uint64 {
+	if m != nil {
+		return m.NewTickSpacing
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgCollectIncentives) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+type MsgUpdateTickSpacingResponse struct {
+}
+
+func (m *MsgUpdateTickSpacingResponse) Reset()         { *m = MsgUpdateTickSpacingResponse{} }
+func (m *MsgUpdateTickSpacingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTickSpacingResponse) ProtoMessage()    {}
+func (*MsgUpdateTickSpacingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1fff802923d7db, []int{22}
+}
+func (m *MsgUpdateTickSpacingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTickSpacingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTickSpacingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgUpdateTickSpacingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTickSpacingResponse.Merge(m, src)
+}
+func (m *MsgUpdateTickSpacingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTickSpacingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTickSpacingResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCollectIncentives) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgUpdateTickSpacingResponse proto.InternalMessageInfo
+
+// ===================== MsgPruneEmptyTicks
+// MsgPruneEmptyTicks deletes the ticks of a pool that are left with zero gross
+// liquidity, such as those left behind by withdrawals made before empty ticks
+// were removed. Only the tick_spacing_authority set by governance may send it.
+type MsgPruneEmptyTicks struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
 }
 
-func (m *MsgCollectIncentives) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.PositionIds) > 0 {
-		dAtA7 := make([]byte, len(m.PositionIds)*10)
-		var j6 int
-		for _, num := range m.PositionIds {
-			for num >= 1<<7 {
-				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j6++
-			}
-			dAtA7[j6] = uint8(num)
-			j6++
+func (m *MsgPruneEmptyTicks) Reset()         { *m = MsgPruneEmptyTicks{} }
+func (m *MsgPruneEmptyTicks) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneEmptyTicks) ProtoMessage()    {}
+func (*MsgPruneEmptyTicks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1fff802923d7db, []int{23}
+}
+func (m *MsgPruneEmptyTicks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneEmptyTicks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneEmptyTicks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j6
-		copy(dAtA[i:], dAtA7[:j6])
-		i = encodeVarintTx(dAtA, i, uint64(j6))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgPruneEmptyTicks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneEmptyTicks.Merge(m, src)
+}
+func (m *MsgPruneEmptyTicks) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneEmptyTicks) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneEmptyTicks.DiscardUnknown(m)
 }
 
-func (m *MsgCollectIncentivesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgPruneEmptyTicks proto.InternalMessageInfo
+
+func (m *MsgPruneEmptyTicks) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCollectIncentivesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgPruneEmptyTicks) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
 }
 
-func (m *MsgCollectIncentivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.CollectedIncentives) > 0 {
-		for iNdEx := len(m.CollectedIncentives) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.CollectedIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+type MsgPruneEmptyTicksResponse struct {
+	NumPruned uint64 `protobuf:"varint,1,opt,name=num_pruned,json=numPruned,proto3" json:"num_pruned,omitempty" yaml:"num_pruned"`
+}
+
+func (m *MsgPruneEmptyTicksResponse) Reset()         { *m = MsgPruneEmptyTicksResponse{} }
+func (m *MsgPruneEmptyTicksResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPruneEmptyTicksResponse) ProtoMessage()    {}
+func (*MsgPruneEmptyTicksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1fff802923d7db, []int{24}
+}
+func (m *MsgPruneEmptyTicksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPruneEmptyTicksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPruneEmptyTicksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgPruneEmptyTicksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPruneEmptyTicksResponse.Merge(m, src)
+}
+func (m *MsgPruneEmptyTicksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPruneEmptyTicksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPruneEmptyTicksResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateIncentive) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgPruneEmptyTicksResponse proto.InternalMessageInfo
+
+func (m *MsgPruneEmptyTicksResponse) GetNumPruned() uint64 {
+	if m != nil {
+		return m.NumPruned
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCreateIncentive) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ===================== MsgSwapExactAmountInWithPriceLimit
+// MsgSwapExactAmountInWithPriceLimit swaps up to token_in for
+// token_out_denom, stopping once the pool's spot price reaches price_limit.
+// If the limit is reached first, the swap is partially filled and only the
+// consumed amount of token_in is taken from the sender.
+type MsgSwapExactAmountInWithPriceLimit struct {
+	PoolId            uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Sender            string                                 `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	TokenIn           types.Coin                             `protobuf:"bytes,3,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	TokenOutDenom     string                                 `protobuf:"bytes,4,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+	TokenOutMinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=token_out_min_amount,json=tokenOutMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_min_amount" yaml:"token_out_min_amount"`
+	PriceLimit        github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=price_limit,json=priceLimit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_limit" yaml:"price_limit"`
 }
 
-func (m *MsgCreateIncentive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n8, err8 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinUptime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinUptime):])
-	if err8 != nil {
-		return 0, err8
-	}
-	i -= n8
-	i = encodeVarintTx(dAtA, i, uint64(n8))
-	i--
-	dAtA[i] = 0x3a
-	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err9 != nil {
-		return 0, err9
-	}
-	i -= n9
-	i = encodeVarintTx(dAtA, i, uint64(n9))
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.EmissionRate.Size()
-		i -= size
-		if _, err := m.EmissionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x2a
-	{
-		size := m.IncentiveAmount.Size()
-		i -= size
-		if _, err := m.IncentiveAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgSwapExactAmountInWithPriceLimit) Reset()         { *m = MsgSwapExactAmountInWithPriceLimit{} }
+func (m *MsgSwapExactAmountInWithPriceLimit) String() string { return proto.CompactTextString(m) }
+func (*MsgSwapExactAmountInWithPriceLimit) ProtoMessage()    {}
+func (*MsgSwapExactAmountInWithPriceLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1fff802923d7db, []int{25}
+}
+func (m *MsgSwapExactAmountInWithPriceLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapExactAmountInWithPriceLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapExactAmountInWithPriceLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.IncentiveDenom) > 0 {
-		i -= len(m.IncentiveDenom)
-		copy(dAtA[i:], m.IncentiveDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.IncentiveDenom)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *MsgSwapExactAmountInWithPriceLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapExactAmountInWithPriceLimit.Merge(m, src)
+}
+func (m *MsgSwapExactAmountInWithPriceLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapExactAmountInWithPriceLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapExactAmountInWithPriceLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapExactAmountInWithPriceLimit proto.InternalMessageInfo
+
+func (m *MsgSwapExactAmountInWithPriceLimit) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *MsgSwapExactAmountInWithPriceLimit) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	if m.PoolId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *MsgSwapExactAmountInWithPriceLimit) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
 	}
-	return len(dAtA) - i, nil
+	return types.Coin{}
 }
 
-func (m *MsgCreateIncentiveResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSwapExactAmountInWithPriceLimit) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateIncentiveResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgSwapExactAmountInWithPriceLimitResponse struct {
+	TokenInAmount  github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=token_in_amount,json=tokenInAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_in_amount" yaml:"token_in_amount"`
+	TokenOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_amount" yaml:"token_out_amount"`
 }
 
-func (m *MsgCreateIncentiveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinUptime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinUptime):])
-	if err10 != nil {
-		return 0, err10
-	}
-	i -= n10
-	i = encodeVarintTx(dAtA, i, uint64(n10))
-	i--
-	dAtA[i] = 0x2a
-	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err11 != nil {
-		return 0, err11
-	}
-	i -= n11
-	i = encodeVarintTx(dAtA, i, uint64(n11))
-	i--
-	dAtA[i] = 0x22
-	{
-		size := m.EmissionRate.Size()
-		i -= size
-		if _, err := m.EmissionRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *MsgSwapExactAmountInWithPriceLimitResponse) Reset() {
+	*m = MsgSwapExactAmountInWithPriceLimitResponse{}
+}
+func (m *MsgSwapExactAmountInWithPriceLimitResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgSwapExactAmountInWithPriceLimitResponse) ProtoMessage() {}
+func (*MsgSwapExactAmountInWithPriceLimitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1fff802923d7db, []int{26}
+}
+func (m *MsgSwapExactAmountInWithPriceLimitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSwapExactAmountInWithPriceLimitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSwapExactAmountInWithPriceLimitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.IncentiveAmount.Size()
-		i -= size
-		if _, err := m.IncentiveAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+}
+func (m *MsgSwapExactAmountInWithPriceLimitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSwapExactAmountInWithPriceLimitResponse.Merge(m, src)
+}
+func (m *MsgSwapExactAmountInWithPriceLimitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSwapExactAmountInWithPriceLimitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSwapExactAmountInWithPriceLimitResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSwapExactAmountInWithPriceLimitResponse proto.InternalMessageInfo
+
+// ===================== MsgCreatePositionSingleAsset
+// MsgCreatePositionSingleAsset creates a position from a single asset. The
+// portion of token_in that the range requires in the pool's other asset is
+// swapped through the same pool before the position is created. The swap must
+// produce at least (1 - max_slippage) of the amount expected at the pool's
+// spot price net of the swap fee.
+type MsgCreatePositionSingleAsset struct {
+	PoolId      uint64                                 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Sender      string                                 `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	LowerTick   int64                                  `protobuf:"varint,3,opt,name=lower_tick,json=lowerTick,proto3" json:"lower_tick,omitempty" yaml:"lower_tick"`
+	UpperTick   int64                                  `protobuf:"varint,4,opt,name=upper_tick,json=upperTick,proto3" json:"upper_tick,omitempty" yaml:"upper_tick"`
+	TokenIn     types.Coin                             `protobuf:"bytes,5,opt,name=token_in,json=tokenIn,proto3" json:"token_in" yaml:"token_in"`
+	MaxSlippage github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=max_slippage,json=maxSlippage,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_slippage" yaml:"max_slippage"`
+}
+
+func (m *MsgCreatePositionSingleAsset) Reset()         { *m = MsgCreatePositionSingleAsset{} }
+func (m *MsgCreatePositionSingleAsset) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePositionSingleAsset) ProtoMessage()    {}
+func (*MsgCreatePositionSingleAsset) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1fff802923d7db, []int{27}
+}
+func (m *MsgCreatePositionSingleAsset) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePositionSingleAsset) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePositionSingleAsset.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.IncentiveDenom) > 0 {
-		i -= len(m.IncentiveDenom)
-		copy(dAtA[i:], m.IncentiveDenom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.IncentiveDenom)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgCreatePositionSingleAsset) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePositionSingleAsset.Merge(m, src)
+}
+func (m *MsgCreatePositionSingleAsset) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePositionSingleAsset) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePositionSingleAsset.DiscardUnknown(m)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_MsgCreatePositionSingleAsset proto.InternalMessageInfo
+
+func (m *MsgCreatePositionSingleAsset) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *MsgCreatePosition) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.LowerTick != 0 {
-		n += 1 + sovTx(uint64(m.LowerTick))
-	}
-	if m.UpperTick != 0 {
-		n += 1 + sovTx(uint64(m.UpperTick))
+
+func (m *MsgCreatePositionSingleAsset) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	l = m.TokenDesired0.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.TokenDesired1.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.TokenMinAmount0.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.TokenMinAmount1.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return ""
 }
 
-func (m *MsgCreatePositionResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PositionId != 0 {
-		n += 1 + sovTx(uint64(m.PositionId))
+func (m *MsgCreatePositionSingleAsset) GetLowerTick() int64 {
+	if m != nil {
+		return m.LowerTick
 	}
-	l = m.Amount0.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Amount1.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.JoinTime)
-	n += 1 + l + sovTx(uint64(l))
-	l = m.LiquidityCreated.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return 0
 }
 
-func (m *MsgWithdrawPosition) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PositionId != 0 {
-		n += 1 + sovTx(uint64(m.PositionId))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCreatePositionSingleAsset) GetUpperTick() int64 {
+	if m != nil {
+		return m.UpperTick
 	}
-	l = m.LiquidityAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return 0
 }
 
-func (m *MsgWithdrawPositionResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreatePositionSingleAsset) GetTokenIn() types.Coin {
+	if m != nil {
+		return m.TokenIn
 	}
-	var l int
-	_ = l
-	l = m.Amount0.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.Amount1.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return types.Coin{}
 }
 
-func (m *MsgCollectFees) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.PositionIds) > 0 {
-		l = 0
-		for _, e := range m.PositionIds {
-			l += sovTx(uint64(e))
+type MsgCreatePositionSingleAssetResponse struct {
+	PositionId       uint64                                 `protobuf:"varint,1,opt,name=position_id,json=positionId,proto3" json:"position_id,omitempty" yaml:"position_id"`
+	Amount0          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=amount0,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount0" yaml:"amount0"`
+	Amount1          github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount1,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount1" yaml:"amount1"`
+	JoinTime         time.Time                              `protobuf:"bytes,4,opt,name=join_time,json=joinTime,proto3,stdtime" json:"join_time" yaml:"join_time"`
+	LiquidityCreated github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=liquidity_created,json=liquidityCreated,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"liquidity_created" yaml:"liquidity_created"`
+	// token_swapped_in and token_swapped_out are the amounts of the internal
+	// swap, which are zero if no swap was needed.
+	TokenSwappedIn  types.Coin `protobuf:"bytes,6,opt,name=token_swapped_in,json=tokenSwappedIn,proto3" json:"token_swapped_in" yaml:"token_swapped_in"`
+	TokenSwappedOut types.Coin `protobuf:"bytes,7,opt,name=token_swapped_out,json=tokenSwappedOut,proto3" json:"token_swapped_out" yaml:"token_swapped_out"`
+	// dust is what the position could not absorb, which is left with the
+	// sender.
+	Dust github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=dust,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"dust" yaml:"dust"`
+}
+
+func (m *MsgCreatePositionSingleAssetResponse) Reset()         { *m = MsgCreatePositionSingleAssetResponse{} }
+func (m *MsgCreatePositionSingleAssetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreatePositionSingleAssetResponse) ProtoMessage()    {}
+func (*MsgCreatePositionSingleAssetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1f1fff802923d7db, []int{28}
+}
+func (m *MsgCreatePositionSingleAssetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreatePositionSingleAssetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreatePositionSingleAssetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 1 + sovTx(uint64(l)) + l
+		return b[:n], nil
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgCreatePositionSingleAssetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreatePositionSingleAssetResponse.Merge(m, src)
+}
+func (m *MsgCreatePositionSingleAssetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreatePositionSingleAssetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreatePositionSingleAssetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreatePositionSingleAssetResponse proto.InternalMessageInfo
+
+func (m *MsgCreatePositionSingleAssetResponse) GetPositionId() uint64 {
+	if m != nil {
+		return m.PositionId
 	}
-	return n
+	return 0
 }
 
-func (m *MsgCollectFeesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreatePositionSingleAssetResponse) GetJoinTime() time.Time {
+	if m != nil {
+		return m.JoinTime
 	}
-	var l int
-	_ = l
-	if len(m.CollectedFees) > 0 {
-		for _, e := range m.CollectedFees {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return time.Time{}
+}
+
+func (m *MsgCreatePositionSingleAssetResponse) GetTokenSwappedIn() types.Coin {
+	if m != nil {
+		return m.TokenSwappedIn
 	}
-	return n
+	return types.Coin{}
 }
 
-func (m *MsgCollectIncentives) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreatePositionSingleAssetResponse) GetTokenSwappedOut() types.Coin {
+	if m != nil {
+		return m.TokenSwappedOut
 	}
-	var l int
-	_ = l
-	if len(m.PositionIds) > 0 {
-		l = 0
-		for _, e := range m.PositionIds {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	return types.Coin{}
+}
+
+func (m *MsgCreatePositionSingleAssetResponse) GetDust() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Dust
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgCreatePosition)(nil), "osmosis.concentratedliquidity.v1beta1.MsgCreatePosition")
+	proto.RegisterType((*MsgCreatePositionResponse)(nil), "osmosis.concentratedliquidity.v1beta1.MsgCreatePositionResponse")
+	proto.RegisterType((*MsgCreatePositionByPrice)(nil), "osmosis.concentratedliquidity.v1beta1.MsgCreatePositionByPrice")
+	proto.RegisterType((*MsgCreatePositionByPriceResponse)(nil), "osmosis.concentratedliquidity.v1beta1.MsgCreatePositionByPriceResponse")
+	proto.RegisterType((*MsgCreatePositionRelative)(nil), "osmosis.concentratedliquidity.v1beta1.MsgCreatePositionRelative")
+	proto.RegisterType((*MsgCreatePositionRelativeResponse)(nil), "osmosis.concentratedliquidity.v1beta1.MsgCreatePositionRelativeResponse")
+	proto.RegisterType((*MsgWithdrawPosition)(nil), "osmosis.concentratedliquidity.v1beta1.MsgWithdrawPosition")
+	proto.RegisterType((*MsgWithdrawPositionResponse)(nil), "osmosis.concentratedliquidity.v1beta1.MsgWithdrawPositionResponse")
+	proto.RegisterType((*PositionWithdrawal)(nil), "osmosis.concentratedliquidity.v1beta1.PositionWithdrawal")
+	proto.RegisterType((*MsgWithdrawPositions)(nil), "osmosis.concentratedliquidity.v1beta1.MsgWithdrawPositions")
+	proto.RegisterType((*MsgWithdrawPositionsResponse)(nil), "osmosis.concentratedliquidity.v1beta1.MsgWithdrawPositionsResponse")
+	proto.RegisterType((*MsgEmergencyWithdraw)(nil), "osmosis.concentratedliquidity.v1beta1.MsgEmergencyWithdraw")
+	proto.RegisterType((*MsgEmergencyWithdrawResponse)(nil), "osmosis.concentratedliquidity.v1beta1.MsgEmergencyWithdrawResponse")
+	proto.RegisterType((*MsgCollectFees)(nil), "osmosis.concentratedliquidity.v1beta1.MsgCollectFees")
+	proto.RegisterType((*MsgCollectFeesResponse)(nil), "osmosis.concentratedliquidity.v1beta1.MsgCollectFeesResponse")
+	proto.RegisterType((*MsgCollectIncentives)(nil), "osmosis.concentratedliquidity.v1beta1.MsgCollectIncentives")
+	proto.RegisterType((*MsgCollectIncentivesResponse)(nil), "osmosis.concentratedliquidity.v1beta1.MsgCollectIncentivesResponse")
+	proto.RegisterType((*MsgCreateIncentive)(nil), "osmosis.concentratedliquidity.v1beta1.MsgCreateIncentive")
+	proto.RegisterType((*MsgCreateIncentiveResponse)(nil), "osmosis.concentratedliquidity.v1beta1.MsgCreateIncentiveResponse")
+	proto.RegisterType((*MsgWithdrawProtocolFees)(nil), "osmosis.concentratedliquidity.v1beta1.MsgWithdrawProtocolFees")
+	proto.RegisterType((*MsgWithdrawProtocolFeesResponse)(nil), "osmosis.concentratedliquidity.v1beta1.MsgWithdrawProtocolFeesResponse")
+	proto.RegisterType((*MsgUpdateTickSpacing)(nil), "osmosis.concentratedliquidity.v1beta1.MsgUpdateTickSpacing")
+	proto.RegisterType((*MsgUpdateTickSpacingResponse)(nil), "osmosis.concentratedliquidity.v1beta1.MsgUpdateTickSpacingResponse")
+	proto.RegisterType((*MsgPruneEmptyTicks)(nil), "osmosis.concentratedliquidity.v1beta1.MsgPruneEmptyTicks")
+	proto.RegisterType((*MsgPruneEmptyTicksResponse)(nil), "osmosis.concentratedliquidity.v1beta1.MsgPruneEmptyTicksResponse")
+	proto.RegisterType((*MsgSwapExactAmountInWithPriceLimit)(nil), "osmosis.concentratedliquidity.v1beta1.MsgSwapExactAmountInWithPriceLimit")
+	proto.RegisterType((*MsgSwapExactAmountInWithPriceLimitResponse)(nil), "osmosis.concentratedliquidity.v1beta1.MsgSwapExactAmountInWithPriceLimitResponse")
+	proto.RegisterType((*MsgCreatePositionSingleAsset)(nil), "osmosis.concentratedliquidity.v1beta1.MsgCreatePositionSingleAsset")
+	proto.RegisterType((*MsgCreatePositionSingleAssetResponse)(nil), "osmosis.concentratedliquidity.v1beta1.MsgCreatePositionSingleAssetResponse")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/concentrated-liquidity/tx.proto", fileDescriptor_1f1fff802923d7db)
+}
+
+var fileDescriptor_1f1fff802923d7db = []byte{
+	// 2124 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4f, 0x6c, 0x1b, 0x59,
+	0x19, 0xef, 0xc4, 0x4e, 0xd2, 0x7c, 0x6e, 0x9c, 0x78, 0x9a, 0xa6, 0xee, 0x34, 0x9b, 0x09, 0x0f,
+	0xd8, 0x0d, 0x7f, 0x6a, 0xaf, 0xbb, 0xbb, 0x82, 0xed, 0x0a, 0x76, 0xeb, 0x24, 0xa5, 0x06, 0xa2,
+	0x96, 0xc9, 0x56, 0xa0, 0x15, 0x92, 0x35, 0xb1, 0x5f, 0xdc, 0x21, 0x9e, 0x3f, 0xf5, 0x7b, 0x8e,
+	0x13, 0x24, 0xe0, 0x00, 0x37, 0x90, 0x58, 0x81, 0x90, 0x90, 0x90, 0x40, 0x48, 0x88, 0x03, 0x12,
+	0xe2, 0xc4, 0x01, 0x2e, 0x08, 0x71, 0xd9, 0x1b, 0xbd, 0x80, 0x10, 0x42, 0x5e, 0xd4, 0xde, 0xb8,
+	0x20, 0x7c, 0xd8, 0x2b, 0xab, 0x99, 0xf7, 0xe6, 0xcd, 0x78, 0xc6, 0xa9, 0x33, 0x76, 0x5c, 0xa9,
+	0x55, 0x4e, 0xc9, 0x7c, 0xef, 0x7d, 0xbf, 0xef, 0xbd, 0xef, 0xff, 0x7b, 0xcf, 0xf0, 0x92, 0x4d,
+	0x4c, 0x9b, 0x18, 0xa4, 0x58, 0xb3, 0xad, 0x1a, 0xb6, 0x68, 0x4b, 0xa7, 0xb8, 0x7e, 0xad, 0x69,
+	0x3c, 0x68, 0x1b, 0x75, 0x83, 0x1e, 0x15, 0xe9, 0x61, 0xc1, 0x69, 0xd9, 0xd4, 0x96, 0x3f, 0xce,
+	0x27, 0x16, 0xc2, 0x13, 0xc5, 0xbc, 0xc2, 0x41, 0x69, 0x17, 0x53, 0xbd, 0xa4, 0x2c, 0x35, 0xec,
+	0x86, 0xed, 0x71, 0x14, 0xdd, 0xff, 0x18, 0xb3, 0xa2, 0x36, 0x6c, 0xbb, 0xd1, 0xc4, 0x45, 0xef,
+	0x6b, 0xb7, 0xbd, 0x57, 0xa4, 0x86, 0x89, 0x09, 0xd5, 0x4d, 0x87, 0x4f, 0x58, 0x8d, 0x4e, 0xa8,
+	0xb7, 0x5b, 0x3a, 0x35, 0x6c, 0xcb, 0x1f, 0xaf, 0x79, 0xe2, 0x8b, 0xbb, 0x3a, 0xc1, 0x45, 0x2e,
+	0xab, 0x58, 0xb3, 0x0d, 0x3e, 0x8e, 0x3e, 0x98, 0x86, 0xdc, 0x36, 0x69, 0x6c, 0xb4, 0xb0, 0x4e,
+	0xf1, 0x5d, 0x9b, 0x18, 0x2e, 0xaf, 0xfc, 0x29, 0x98, 0x75, 0x6c, 0xbb, 0x59, 0x35, 0xea, 0x79,
+	0x69, 0x4d, 0x5a, 0x4f, 0x97, 0xe5, 0x5e, 0x57, 0xcd, 0x1e, 0xe9, 0x66, 0xf3, 0x06, 0xe2, 0x03,
+	0x48, 0x9b, 0x71, 0xff, 0xab, 0xd4, 0xe5, 0x4f, 0xc0, 0x0c, 0xc1, 0x56, 0x1d, 0xb7, 0xf2, 0x53,
+	0x6b, 0xd2, 0xfa, 0x5c, 0x39, 0xd7, 0xeb, 0xaa, 0xf3, 0x6c, 0x2e, 0xa3, 0x23, 0x8d, 0x4f, 0x90,
+	0x5f, 0x05, 0x68, 0xda, 0x1d, 0xdc, 0xaa, 0x52, 0xa3, 0xb6, 0x9f, 0x4f, 0xad, 0x49, 0xeb, 0xa9,
+	0xf2, 0xa5, 0x5e, 0x57, 0xcd, 0xb1, 0xe9, 0xc1, 0x18, 0xd2, 0xe6, 0xbc, 0x8f, 0xb7, 0x8d, 0xda,
+	0xbe, 0xcb, 0xd5, 0x76, 0x1c, 0x9f, 0x2b, 0x1d, 0xe5, 0x0a, 0xc6, 0x90, 0x36, 0xe7, 0x7d, 0x78,
+	0x5c, 0x55, 0xc8, 0x52, 0x7b, 0x1f, 0x5b, 0xd5, 0x3a, 0x26, 0x46, 0x0b, 0xd7, 0x5f, 0xce, 0x4f,
+	0xaf, 0x49, 0xeb, 0x99, 0xeb, 0x57, 0x0a, 0x4c, 0x25, 0x05, 0x57, 0x25, 0xbe, 0xfa, 0x0b, 0x1b,
+	0xb6, 0x61, 0x95, 0x5f, 0x78, 0xaf, 0xab, 0x9e, 0xeb, 0x75, 0xd5, 0x4b, 0x0c, 0xb8, 0x9f, 0x1d,
+	0x69, 0xf3, 0x1e, 0x61, 0x93, 0x7f, 0xc7, 0x04, 0x94, 0xf2, 0x33, 0xe3, 0x08, 0x28, 0x45, 0x04,
+	0x94, 0xe4, 0x03, 0xc8, 0xb1, 0x19, 0xa6, 0x61, 0x55, 0x75, 0xd3, 0x6e, 0x5b, 0xf4, 0xe5, 0xfc,
+	0xac, 0xa7, 0xe3, 0x2f, 0xba, 0x40, 0xff, 0xec, 0xaa, 0x2f, 0x36, 0x0c, 0x7a, 0xbf, 0xbd, 0x5b,
+	0xa8, 0xd9, 0x66, 0x91, 0x5b, 0x9a, 0xfd, 0xb9, 0x46, 0xea, 0xfb, 0x45, 0x7a, 0xe4, 0x60, 0x52,
+	0xa8, 0x58, 0xb4, 0xd7, 0x55, 0xf3, 0x61, 0x91, 0x21, 0x40, 0xa4, 0x2d, 0x78, 0xb4, 0x6d, 0xc3,
+	0xba, 0xc9, 0x28, 0x83, 0xe4, 0x96, 0xf2, 0xe7, 0x4f, 0x57, 0x6e, 0x29, 0x26, 0xb7, 0x24, 0xbf,
+	0x08, 0xd3, 0x76, 0xc7, 0xc2, 0xad, 0xfc, 0x9c, 0x27, 0x6b, 0xb1, 0xd7, 0x55, 0x2f, 0x30, 0x6e,
+	0x8f, 0x8c, 0x34, 0x36, 0x2c, 0x6f, 0xc0, 0x02, 0xa1, 0x2d, 0xa3, 0x46, 0xab, 0xa4, 0x69, 0x38,
+	0x8e, 0xde, 0xc0, 0x79, 0x58, 0x93, 0xd6, 0xcf, 0x97, 0x95, 0x5e, 0x57, 0x5d, 0x66, 0x1c, 0x91,
+	0x09, 0x48, 0xcb, 0x32, 0xca, 0x8e, 0x4f, 0xf8, 0x57, 0x0a, 0xae, 0xc4, 0x1c, 0x5f, 0xc3, 0xc4,
+	0xb1, 0x2d, 0x82, 0xe5, 0xcf, 0x40, 0xc6, 0xe1, 0xb4, 0x20, 0x08, 0x96, 0x7b, 0x5d, 0x55, 0xf6,
+	0x83, 0x40, 0x0c, 0x22, 0x0d, 0xfc, 0xaf, 0x4a, 0x5d, 0x7e, 0x07, 0x66, 0x7d, 0x4b, 0xb1, 0x68,
+	0x78, 0x2b, 0xb1, 0xc6, 0x78, 0x9c, 0x09, 0xfb, 0xf8, 0x80, 0x01, 0x76, 0x29, 0x9f, 0x3a, 0x0d,
+	0xec, 0x92, 0xc0, 0x2e, 0xc9, 0xf7, 0x60, 0xee, 0x1b, 0xb6, 0x61, 0x55, 0xdd, 0xfc, 0xe2, 0x85,
+	0x58, 0xe6, 0xba, 0x52, 0x60, 0xb9, 0xa5, 0xe0, 0xe7, 0x96, 0xc2, 0xdb, 0x7e, 0xf2, 0x29, 0xaf,
+	0x70, 0x47, 0x5e, 0x64, 0x78, 0x82, 0x15, 0xbd, 0xfb, 0xbe, 0x2a, 0x69, 0xe7, 0xdd, 0x6f, 0x77,
+	0xb2, 0xdc, 0x81, 0x9c, 0x48, 0x75, 0xd5, 0x9a, 0xa7, 0xeb, 0x7a, 0x7e, 0x3a, 0xb1, 0x2b, 0x6d,
+	0xe2, 0x5a, 0xe0, 0x4a, 0x31, 0x40, 0xa4, 0x2d, 0x0a, 0xda, 0x06, 0x27, 0xf5, 0xa6, 0x21, 0x1f,
+	0x33, 0x6f, 0xf9, 0xe8, 0x6e, 0xcb, 0xa8, 0xe1, 0x89, 0xa5, 0x37, 0x0c, 0x19, 0x96, 0xc2, 0x1c,
+	0x57, 0x0c, 0x37, 0xd2, 0x66, 0xe2, 0x7d, 0xca, 0xe1, 0x6c, 0xe8, 0x41, 0x21, 0x8d, 0xe5, 0x4d,
+	0xb6, 0x7c, 0x0c, 0x19, 0x96, 0xf3, 0x98, 0x98, 0xf4, 0x78, 0x62, 0x42, 0x50, 0x48, 0x63, 0x89,
+	0x96, 0x89, 0x39, 0x4b, 0xa0, 0xcf, 0x58, 0x02, 0x45, 0x7f, 0x4d, 0xc3, 0xda, 0x71, 0x4e, 0x7f,
+	0x96, 0xda, 0x9e, 0x93, 0xd4, 0x16, 0x69, 0xa2, 0x66, 0x46, 0x6a, 0xa2, 0x66, 0x4f, 0xd6, 0x44,
+	0xa1, 0x3f, 0x4c, 0x0f, 0xac, 0x92, 0x4d, 0x9d, 0x1a, 0x07, 0x93, 0xcb, 0xa3, 0xb7, 0x21, 0x17,
+	0xec, 0xa2, 0x6a, 0xef, 0xed, 0x11, 0x4c, 0x79, 0xb7, 0xb8, 0x12, 0x52, 0x56, 0x74, 0x0a, 0xd2,
+	0x16, 0xc4, 0x7e, 0xef, 0x78, 0x14, 0x17, 0x29, 0xd8, 0x99, 0x8f, 0x94, 0x8e, 0x22, 0xc5, 0xa6,
+	0x20, 0x6d, 0x41, 0xe8, 0x80, 0x23, 0x9d, 0x65, 0xc3, 0x67, 0x2d, 0x1b, 0x3e, 0x4c, 0xc3, 0x47,
+	0x8e, 0xf5, 0xdd, 0xb3, 0x74, 0x78, 0x96, 0x0e, 0x93, 0xa7, 0xc3, 0xff, 0x4a, 0x70, 0x71, 0x9b,
+	0x34, 0xbe, 0x6a, 0xd0, 0xfb, 0xf5, 0x96, 0xde, 0x11, 0xe7, 0xe5, 0x91, 0x9d, 0x28, 0x41, 0x52,
+	0xa4, 0x10, 0xec, 0x9d, 0x7b, 0x3d, 0x77, 0x8e, 0x4a, 0x62, 0xfd, 0x5e, 0x8e, 0xea, 0x97, 0xe1,
+	0xb9, 0x09, 0xd4, 0x27, 0xb1, 0x28, 0x42, 0x7f, 0x93, 0xe0, 0xea, 0x80, 0x1d, 0x8b, 0xf0, 0x09,
+	0x45, 0x81, 0x34, 0xc1, 0x28, 0x98, 0x3a, 0xe5, 0x28, 0x40, 0x7f, 0x91, 0x40, 0xf6, 0x37, 0xe3,
+	0x6f, 0x4e, 0x6f, 0x8e, 0x6e, 0xc8, 0x41, 0xd6, 0x99, 0x9a, 0xb8, 0x75, 0xfe, 0x28, 0xc1, 0xd2,
+	0x00, 0xeb, 0x90, 0x90, 0x5f, 0x49, 0xc3, 0xfc, 0xaa, 0x03, 0x99, 0x8e, 0x50, 0x00, 0xc9, 0x4f,
+	0xad, 0xa5, 0xd6, 0x33, 0xd7, 0x5f, 0x2f, 0x9c, 0xe8, 0xd6, 0xaa, 0x10, 0x57, 0x61, 0x59, 0xe1,
+	0x09, 0x83, 0x6b, 0x2c, 0x84, 0x8d, 0xb4, 0xb0, 0x24, 0xf4, 0x0b, 0x09, 0x56, 0x06, 0x2d, 0x5e,
+	0xf8, 0xd6, 0x77, 0x00, 0xbc, 0x9c, 0x4e, 0xaa, 0x76, 0x9b, 0xe6, 0xa5, 0xb5, 0xd4, 0x93, 0xab,
+	0xe1, 0x16, 0x17, 0x9c, 0x0b, 0x95, 0x08, 0x8f, 0x15, 0xfd, 0xe6, 0x7d, 0x75, 0xfd, 0x04, 0xda,
+	0x77, 0x51, 0x88, 0x36, 0xc7, 0x18, 0xef, 0xb4, 0x29, 0xfa, 0xa6, 0xa7, 0xdd, 0x2d, 0x13, 0xb7,
+	0x1a, 0xd8, 0xaa, 0x1d, 0xf9, 0x2b, 0x7d, 0x1a, 0xe1, 0x8e, 0xfe, 0xce, 0xb4, 0x13, 0x13, 0xfe,
+	0xcc, 0x47, 0x5e, 0x07, 0xb2, 0x6e, 0x55, 0xb6, 0x9b, 0x4d, 0x5c, 0xa3, 0xb7, 0x30, 0x26, 0xf2,
+	0x0d, 0xb8, 0x10, 0xd2, 0x18, 0xf1, 0x2c, 0x9d, 0x2e, 0x5f, 0xee, 0x75, 0xd5, 0x8b, 0x31, 0x7d,
+	0xba, 0x4e, 0x14, 0x28, 0x94, 0x24, 0xd1, 0xe8, 0x11, 0x2c, 0xf7, 0x0b, 0x16, 0xaa, 0xac, 0x42,
+	0xb6, 0xc6, 0xc8, 0xb8, 0x5e, 0xdd, 0xc3, 0x98, 0x0c, 0x77, 0xb6, 0x48, 0xeb, 0xd5, 0xcf, 0x8e,
+	0xb4, 0x79, 0x41, 0x70, 0x05, 0xa1, 0x6f, 0xc1, 0x52, 0x20, 0xba, 0xe2, 0x05, 0x94, 0x71, 0xf0,
+	0xf4, 0x76, 0xfe, 0xbd, 0x29, 0x58, 0x19, 0x24, 0x5f, 0x28, 0xe0, 0x01, 0x2c, 0x05, 0x3b, 0x30,
+	0xc4, 0xf8, 0x70, 0x35, 0x7c, 0x94, 0xab, 0xe1, 0x6a, 0x54, 0x0d, 0x01, 0x08, 0xd2, 0x2e, 0x0a,
+	0x72, 0x68, 0xeb, 0x0f, 0x60, 0x69, 0xcf, 0x6e, 0xed, 0x61, 0x23, 0x22, 0x72, 0x2a, 0xa1, 0xc8,
+	0x41, 0x20, 0x48, 0xbb, 0x28, 0xc8, 0x81, 0x48, 0xf4, 0xe7, 0x34, 0xc8, 0xa2, 0x21, 0x14, 0xf4,
+	0x89, 0x9d, 0x62, 0x5e, 0x82, 0x05, 0xb1, 0xa4, 0x6a, 0x1d, 0x5b, 0xb6, 0xc9, 0xea, 0xb5, 0x96,
+	0x15, 0xe4, 0x4d, 0x97, 0xea, 0xd6, 0x8e, 0x60, 0x22, 0xaf, 0x1d, 0xe9, 0xc4, 0xb5, 0x83, 0x85,
+	0x1d, 0xaf, 0x1d, 0x51, 0x3c, 0xa4, 0x05, 0x6b, 0x61, 0xb5, 0x43, 0xde, 0x87, 0x79, 0x6c, 0x1a,
+	0x84, 0xb8, 0xde, 0xe5, 0x66, 0x77, 0xde, 0xac, 0xdd, 0x4a, 0x5c, 0xae, 0x96, 0x98, 0xc8, 0x3e,
+	0x30, 0xa4, 0x5d, 0xf0, 0xbf, 0x35, 0x9d, 0x62, 0xf9, 0x6b, 0x00, 0x84, 0xea, 0x2d, 0xca, 0xba,
+	0xce, 0x99, 0xa1, 0x5d, 0xe7, 0x0b, 0xfd, 0xb9, 0x3c, 0xe0, 0x65, 0x6d, 0xe7, 0x9c, 0x47, 0x70,
+	0xa7, 0xcb, 0x26, 0x80, 0x7b, 0x0c, 0x68, 0x3b, 0x1e, 0xf2, 0x2c, 0x3f, 0x32, 0x45, 0x91, 0x37,
+	0xf9, 0xab, 0x48, 0xf9, 0x15, 0x17, 0xf8, 0x3f, 0x5d, 0x55, 0xf6, 0xdf, 0x49, 0x3e, 0x6d, 0x9b,
+	0x06, 0xc5, 0xa6, 0x43, 0x8f, 0x02, 0x71, 0x01, 0x20, 0xfa, 0xa9, 0x27, 0xce, 0x34, 0xac, 0x7b,
+	0xec, 0xfb, 0x7f, 0x29, 0x50, 0xe2, 0x3e, 0x24, 0x02, 0x69, 0x80, 0xcd, 0xa5, 0x13, 0xdb, 0x7c,
+	0xcc, 0x7e, 0x61, 0x14, 0x9b, 0xa7, 0x9e, 0x9a, 0xcd, 0xd3, 0x13, 0xb3, 0xf9, 0xf4, 0xa4, 0x6d,
+	0xfe, 0x00, 0x2e, 0x87, 0xfb, 0x14, 0x17, 0xbf, 0x66, 0x37, 0xbd, 0xd2, 0x35, 0xa1, 0xdc, 0x81,
+	0x7e, 0x27, 0x81, 0x7a, 0x8c, 0x4c, 0xe1, 0x6b, 0xdf, 0x97, 0x20, 0xeb, 0xf7, 0x53, 0xd6, 0x09,
+	0xcb, 0x56, 0xa5, 0xbf, 0x6c, 0xf5, 0xb3, 0x27, 0xeb, 0x93, 0xe6, 0x05, 0xb3, 0x57, 0xe2, 0x7e,
+	0xcf, 0x5a, 0xd1, 0x7b, 0x4e, 0x5d, 0xa7, 0xd8, 0x3d, 0x2c, 0xed, 0x38, 0x7a, 0xcd, 0xb0, 0x1a,
+	0x13, 0x4b, 0xaf, 0x5b, 0xb0, 0x68, 0xe1, 0x0e, 0xbb, 0xb5, 0x21, 0x4c, 0x96, 0xe7, 0xce, 0xe9,
+	0xf2, 0xd5, 0x20, 0x26, 0xa2, 0x33, 0x90, 0x96, 0xb5, 0x70, 0x27, 0xb4, 0x3c, 0xb4, 0x0a, 0x2b,
+	0x83, 0x96, 0xed, 0x6b, 0x19, 0x35, 0xbd, 0x9a, 0x71, 0xb7, 0xd5, 0xb6, 0xf0, 0x96, 0xeb, 0x31,
+	0xee, 0x9c, 0xc9, 0xd9, 0x5d, 0x03, 0x25, 0x2e, 0x4d, 0x58, 0xfc, 0x55, 0x00, 0xab, 0x6d, 0x56,
+	0x1d, 0x77, 0xd8, 0x17, 0x1c, 0x3a, 0xb4, 0x06, 0x63, 0x48, 0x9b, 0xb3, 0xda, 0xe6, 0x5d, 0xf6,
+	0xff, 0x07, 0x29, 0x40, 0xdb, 0xa4, 0xb1, 0xd3, 0xd1, 0x9d, 0xad, 0x43, 0xbd, 0x46, 0x59, 0x2e,
+	0xa8, 0x78, 0x3d, 0xbb, 0x77, 0x35, 0xfc, 0x65, 0xc3, 0x34, 0xe8, 0xc4, 0xec, 0xb4, 0x0d, 0xe7,
+	0xd9, 0x6d, 0x8d, 0x61, 0x79, 0xf6, 0x79, 0xa2, 0x7f, 0x5e, 0xe6, 0xfe, 0xb9, 0x10, 0xbe, 0xe6,
+	0x31, 0x2c, 0xa4, 0xcd, 0x7a, 0xff, 0x56, 0x2c, 0xb9, 0x0c, 0xec, 0xa2, 0xc7, 0x6d, 0xec, 0x79,
+	0x86, 0x65, 0xb5, 0x32, 0xf4, 0xf8, 0x17, 0x99, 0xe0, 0xdf, 0x84, 0xdd, 0x69, 0x53, 0x96, 0x7c,
+	0xbf, 0x0d, 0x4b, 0xc1, 0x94, 0xe0, 0x12, 0x89, 0x57, 0xc0, 0xed, 0xc4, 0x45, 0xf7, 0x6a, 0x54,
+	0x6c, 0x80, 0x89, 0xb4, 0x9c, 0x2f, 0x5b, 0x5c, 0x4d, 0xb9, 0x0f, 0x38, 0xde, 0x7b, 0x4b, 0xb5,
+	0xe9, 0x6a, 0x3e, 0x3f, 0x33, 0xde, 0x03, 0x4e, 0x08, 0xca, 0x3d, 0x6d, 0x08, 0x8b, 0xa2, 0x1f,
+	0x4f, 0xc1, 0x27, 0x87, 0x1b, 0x5e, 0x78, 0x97, 0xe3, 0x6b, 0x36, 0x50, 0x08, 0x3b, 0x58, 0xdc,
+	0x4e, 0xac, 0x90, 0xe5, 0x7e, 0xf3, 0x09, 0x5d, 0xcc, 0x73, 0x2b, 0x72, 0x3d, 0x10, 0x58, 0x0c,
+	0x74, 0x36, 0x72, 0x11, 0xec, 0x6b, 0x7c, 0xa2, 0x78, 0x48, 0xcb, 0xfa, 0xfa, 0xe7, 0x67, 0xe6,
+	0x9f, 0xa5, 0x60, 0x45, 0x54, 0x70, 0xff, 0xd0, 0xb9, 0x63, 0x58, 0x8d, 0x26, 0xbe, 0x49, 0x08,
+	0xa6, 0xcf, 0xc5, 0x8f, 0x1f, 0xc2, 0x41, 0x37, 0x3d, 0x7e, 0xd0, 0xdd, 0x87, 0x0b, 0xa6, 0x7e,
+	0x18, 0x3c, 0xb7, 0x33, 0x8f, 0xdd, 0x4a, 0xec, 0xb1, 0xfc, 0x54, 0x13, 0xc6, 0x42, 0x5a, 0xc6,
+	0xd4, 0x0f, 0xc5, 0xb3, 0xfc, 0xaf, 0x67, 0xe0, 0x63, 0x4f, 0xb2, 0xce, 0xd9, 0xbd, 0xed, 0xf3,
+	0x72, 0x6f, 0x5b, 0xf7, 0x83, 0x9f, 0x74, 0x74, 0xc7, 0xf1, 0xce, 0x6f, 0xc3, 0x5f, 0x3c, 0x54,
+	0xbe, 0xab, 0xbe, 0x68, 0x0f, 0x00, 0xfc, 0x68, 0xdf, 0x61, 0x94, 0x8a, 0x25, 0x37, 0x20, 0xd7,
+	0x3f, 0xc9, 0xbd, 0x4a, 0x9a, 0x1d, 0x26, 0x66, 0x8d, 0x8b, 0xc9, 0x0f, 0x12, 0xe3, 0xde, 0x28,
+	0x69, 0x0b, 0x61, 0x39, 0x77, 0xda, 0x54, 0xb6, 0x20, 0x5d, 0x6f, 0x13, 0x9a, 0x3f, 0x3f, 0xac,
+	0x05, 0x7b, 0x93, 0x63, 0x67, 0x18, 0xb6, 0xcb, 0x94, 0xac, 0xf1, 0xf2, 0xe4, 0x5c, 0xff, 0xff,
+	0x02, 0xa4, 0xb6, 0x49, 0x43, 0xfe, 0x81, 0x04, 0xd9, 0xc8, 0xaf, 0xb7, 0x3e, 0x7b, 0xc2, 0xcb,
+	0xbb, 0x58, 0x9c, 0x29, 0x6f, 0x8d, 0xca, 0x29, 0xc2, 0xf2, 0x97, 0x12, 0x5c, 0x1a, 0xfc, 0xa3,
+	0x8b, 0x37, 0x47, 0xc5, 0xe6, 0x00, 0xca, 0x17, 0xc6, 0x04, 0x10, 0x6b, 0xfc, 0x95, 0x04, 0xcb,
+	0xc7, 0xbc, 0x68, 0x8e, 0xa1, 0x00, 0x86, 0xa0, 0xdc, 0x1e, 0x17, 0x41, 0x2c, 0xf3, 0x47, 0x12,
+	0x2c, 0xc6, 0x5e, 0x1a, 0x6e, 0x9c, 0x1c, 0x3e, 0xca, 0xab, 0x94, 0x47, 0xe7, 0x15, 0x8b, 0xfa,
+	0x89, 0x04, 0xb9, 0xf8, 0x75, 0xf3, 0x1b, 0xa3, 0x23, 0x13, 0x65, 0x63, 0x0c, 0xe6, 0xbe, 0x75,
+	0xc5, 0x2f, 0x6a, 0x13, 0xac, 0x2b, 0xc6, 0xac, 0x6c, 0x8c, 0xc1, 0x2c, 0xd6, 0xf5, 0x5d, 0x09,
+	0x32, 0xe1, 0xbb, 0xce, 0xd7, 0x12, 0xb8, 0x47, 0xc0, 0xa6, 0x7c, 0x6e, 0x24, 0xb6, 0x3e, 0xed,
+	0xc4, 0x6f, 0x1f, 0xdf, 0x48, 0x0c, 0x1a, 0x30, 0x2b, 0x1b, 0x63, 0x30, 0x8b, 0x75, 0xfd, 0x5c,
+	0x82, 0xa5, 0x81, 0xe7, 0xea, 0xcf, 0x8f, 0xe0, 0x13, 0x21, 0x7e, 0xe5, 0xd6, 0x78, 0xfc, 0x7d,
+	0x8a, 0x8b, 0x1f, 0x69, 0x13, 0x28, 0x2e, 0xc6, 0xac, 0x6c, 0x8c, 0xc1, 0x2c, 0xd6, 0xf5, 0x43,
+	0x09, 0x16, 0xa2, 0x67, 0xd2, 0xd7, 0x4f, 0x0e, 0x1c, 0x61, 0x55, 0x6e, 0x8e, 0xcc, 0x2a, 0x56,
+	0xf4, 0x27, 0x09, 0xd4, 0x61, 0x47, 0xcc, 0xca, 0xc9, 0xc5, 0x0c, 0x81, 0x52, 0xbe, 0x72, 0x6a,
+	0x50, 0x62, 0x07, 0xbf, 0x95, 0xe0, 0xca, 0xf1, 0xa7, 0x82, 0x8d, 0x51, 0xf3, 0x7a, 0x08, 0x44,
+	0xf9, 0xd2, 0x29, 0x80, 0xf8, 0xeb, 0x2d, 0x7f, 0xfd, 0xbd, 0x47, 0xab, 0xd2, 0xc3, 0x47, 0xab,
+	0xd2, 0xbf, 0x1f, 0xad, 0x4a, 0xef, 0x3e, 0x5e, 0x3d, 0xf7, 0xf0, 0xf1, 0xea, 0xb9, 0x7f, 0x3c,
+	0x5e, 0x3d, 0xf7, 0x4e, 0x39, 0xd4, 0x4b, 0x70, 0x81, 0xd7, 0x9a, 0xfa, 0x2e, 0xf1, 0x3f, 0x8a,
+	0x07, 0xa5, 0xd7, 0x8a, 0x87, 0xc7, 0xfe, 0x72, 0xdd, 0xed, 0x35, 0x76, 0x67, 0xbc, 0x9e, 0xf2,
+	0x95, 0x0f, 0x07, 0x00, 0x54, 0x66, 0x88, 0x73, 0xe8, 0x2e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	CreatePosition(ctx context.Context, in *MsgCreatePosition, opts ...grpc.CallOption) (*MsgCreatePositionResponse, error)
+	CreatePositionByPrice(ctx context.Context, in *MsgCreatePositionByPrice, opts ...grpc.CallOption) (*MsgCreatePositionByPriceResponse, error)
+	CreatePositionRelative(ctx context.Context, in *MsgCreatePositionRelative, opts ...grpc.CallOption) (*MsgCreatePositionRelativeResponse, error)
+	WithdrawPosition(ctx context.Context, in *MsgWithdrawPosition, opts ...grpc.CallOption) (*MsgWithdrawPositionResponse, error)
+	WithdrawPositions(ctx context.Context, in *MsgWithdrawPositions, opts ...grpc.CallOption) (*MsgWithdrawPositionsResponse, error)
+	EmergencyWithdraw(ctx context.Context, in *MsgEmergencyWithdraw, opts ...grpc.CallOption) (*MsgEmergencyWithdrawResponse, error)
+	CollectFees(ctx context.Context, in *MsgCollectFees, opts ...grpc.CallOption) (*MsgCollectFeesResponse, error)
+	CollectIncentives(ctx context.Context, in *MsgCollectIncentives, opts ...grpc.CallOption) (*MsgCollectIncentivesResponse, error)
+	WithdrawProtocolFees(ctx context.Context, in *MsgWithdrawProtocolFees, opts ...grpc.CallOption) (*MsgWithdrawProtocolFeesResponse, error)
+	UpdateTickSpacing(ctx context.Context, in *MsgUpdateTickSpacing, opts ...grpc.CallOption) (*MsgUpdateTickSpacingResponse, error)
+	PruneEmptyTicks(ctx context.Context, in *MsgPruneEmptyTicks, opts ...grpc.CallOption) (*MsgPruneEmptyTicksResponse, error)
+	SwapExactAmountInWithPriceLimit(ctx context.Context, in *MsgSwapExactAmountInWithPriceLimit, opts ...grpc.CallOption) (*MsgSwapExactAmountInWithPriceLimitResponse, error)
+	CreatePositionSingleAsset(ctx context.Context, in *MsgCreatePositionSingleAsset, opts ...grpc.CallOption) (*MsgCreatePositionSingleAssetResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreatePosition(ctx context.Context, in *MsgCreatePosition, opts ...grpc.CallOption) (*MsgCreatePositionResponse, error) {
+	out := new(MsgCreatePositionResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Msg/CreatePosition", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCollectIncentivesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) CreatePositionByPrice(ctx context.Context, in *MsgCreatePositionByPrice, opts ...grpc.CallOption) (*MsgCreatePositionByPriceResponse, error) {
+	out := new(MsgCreatePositionByPriceResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Msg/CreatePositionByPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.CollectedIncentives) > 0 {
-		for _, e := range m.CollectedIncentives {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *msgClient) CreatePositionRelative(ctx context.Context, in *MsgCreatePositionRelative, opts ...grpc.CallOption) (*MsgCreatePositionRelativeResponse, error) {
+	out := new(MsgCreatePositionRelativeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Msg/CreatePositionRelative", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateIncentive) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) WithdrawPosition(ctx context.Context, in *MsgWithdrawPosition, opts ...grpc.CallOption) (*MsgWithdrawPositionResponse, error) {
+	out := new(MsgWithdrawPositionResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Msg/WithdrawPosition", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	return out, nil
+}
+
+func (c *msgClient) WithdrawPositions(ctx context.Context, in *MsgWithdrawPositions, opts ...grpc.CallOption) (*MsgWithdrawPositionsResponse, error) {
+	out := new(MsgWithdrawPositionsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Msg/WithdrawPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) EmergencyWithdraw(ctx context.Context, in *MsgEmergencyWithdraw, opts ...grpc.CallOption) (*MsgEmergencyWithdrawResponse, error) {
+	out := new(MsgEmergencyWithdrawResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Msg/EmergencyWithdraw", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.IncentiveDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) CollectFees(ctx context.Context, in *MsgCollectFees, opts ...grpc.CallOption) (*MsgCollectFeesResponse, error) {
+	out := new(MsgCollectFeesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Msg/CollectFees", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = m.IncentiveAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.EmissionRate.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovTx(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinUptime)
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *MsgCreateIncentiveResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *msgClient) CollectIncentives(ctx context.Context, in *MsgCollectIncentives, opts ...grpc.CallOption) (*MsgCollectIncentivesResponse, error) {
+	out := new(MsgCollectIncentivesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Msg/CollectIncentives", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.IncentiveDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return out, nil
+}
+
+func (c *msgClient) WithdrawProtocolFees(ctx context.Context, in *MsgWithdrawProtocolFees, opts ...grpc.CallOption) (*MsgWithdrawProtocolFeesResponse, error) {
+	out := new(MsgWithdrawProtocolFeesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Msg/WithdrawProtocolFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateTickSpacing(ctx context.Context, in *MsgUpdateTickSpacing, opts ...grpc.CallOption) (*MsgUpdateTickSpacingResponse, error) {
+	out := new(MsgUpdateTickSpacingResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Msg/UpdateTickSpacing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PruneEmptyTicks(ctx context.Context, in *MsgPruneEmptyTicks, opts ...grpc.CallOption) (*MsgPruneEmptyTicksResponse, error) {
+	out := new(MsgPruneEmptyTicksResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Msg/PruneEmptyTicks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SwapExactAmountInWithPriceLimit(ctx context.Context, in *MsgSwapExactAmountInWithPriceLimit, opts ...grpc.CallOption) (*MsgSwapExactAmountInWithPriceLimitResponse, error) {
+	out := new(MsgSwapExactAmountInWithPriceLimitResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Msg/SwapExactAmountInWithPriceLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreatePositionSingleAsset(ctx context.Context, in *MsgCreatePositionSingleAsset, opts ...grpc.CallOption) (*MsgCreatePositionSingleAssetResponse, error) {
+	out := new(MsgCreatePositionSingleAssetResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.concentratedliquidity.v1beta1.Msg/CreatePositionSingleAsset", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	CreatePosition(context.Context, *MsgCreatePosition) (*MsgCreatePositionResponse, error)
+	CreatePositionByPrice(context.Context, *MsgCreatePositionByPrice) (*MsgCreatePositionByPriceResponse, error)
+	CreatePositionRelative(context.Context, *MsgCreatePositionRelative) (*MsgCreatePositionRelativeResponse, error)
+	WithdrawPosition(context.Context, *MsgWithdrawPosition) (*MsgWithdrawPositionResponse, error)
+	WithdrawPositions(context.Context, *MsgWithdrawPositions) (*MsgWithdrawPositionsResponse, error)
+	EmergencyWithdraw(context.Context, *MsgEmergencyWithdraw) (*MsgEmergencyWithdrawResponse, error)
+	CollectFees(context.Context, *MsgCollectFees) (*MsgCollectFeesResponse, error)
+	CollectIncentives(context.Context, *MsgCollectIncentives) (*MsgCollectIncentivesResponse, error)
+	WithdrawProtocolFees(context.Context, *MsgWithdrawProtocolFees) (*MsgWithdrawProtocolFeesResponse, error)
+	UpdateTickSpacing(context.Context, *MsgUpdateTickSpacing) (*MsgUpdateTickSpacingResponse, error)
+	PruneEmptyTicks(context.Context, *MsgPruneEmptyTicks) (*MsgPruneEmptyTicksResponse, error)
+	SwapExactAmountInWithPriceLimit(context.Context, *MsgSwapExactAmountInWithPriceLimit) (*MsgSwapExactAmountInWithPriceLimitResponse, error)
+	CreatePositionSingleAsset(context.Context, *MsgCreatePositionSingleAsset) (*MsgCreatePositionSingleAssetResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreatePosition(ctx context.Context, req *MsgCreatePosition) (*MsgCreatePositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePosition not implemented")
+}
+func (*UnimplementedMsgServer) CreatePositionByPrice(ctx context.Context, req *MsgCreatePositionByPrice) (*MsgCreatePositionByPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePositionByPrice not implemented")
+}
+func (*UnimplementedMsgServer) CreatePositionRelative(ctx context.Context, req *MsgCreatePositionRelative) (*MsgCreatePositionRelativeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePositionRelative not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawPosition(ctx context.Context, req *MsgWithdrawPosition) (*MsgWithdrawPositionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawPosition not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawPositions(ctx context.Context, req *MsgWithdrawPositions) (*MsgWithdrawPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawPositions not implemented")
+}
+func (*UnimplementedMsgServer) EmergencyWithdraw(ctx context.Context, req *MsgEmergencyWithdraw) (*MsgEmergencyWithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EmergencyWithdraw not implemented")
+}
+func (*UnimplementedMsgServer) CollectFees(ctx context.Context, req *MsgCollectFees) (*MsgCollectFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectFees not implemented")
+}
+func (*UnimplementedMsgServer) CollectIncentives(ctx context.Context, req *MsgCollectIncentives) (*MsgCollectIncentivesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectIncentives not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawProtocolFees(ctx context.Context, req *MsgWithdrawProtocolFees) (*MsgWithdrawProtocolFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawProtocolFees not implemented")
+}
+func (*UnimplementedMsgServer) UpdateTickSpacing(ctx context.Context, req *MsgUpdateTickSpacing) (*MsgUpdateTickSpacingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTickSpacing not implemented")
+}
+func (*UnimplementedMsgServer) PruneEmptyTicks(ctx context.Context, req *MsgPruneEmptyTicks) (*MsgPruneEmptyTicksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneEmptyTicks not implemented")
+}
+func (*UnimplementedMsgServer) SwapExactAmountInWithPriceLimit(ctx context.Context, req *MsgSwapExactAmountInWithPriceLimit) (*MsgSwapExactAmountInWithPriceLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwapExactAmountInWithPriceLimit not implemented")
+}
+func (*UnimplementedMsgServer) CreatePositionSingleAsset(ctx context.Context, req *MsgCreatePositionSingleAsset) (*MsgCreatePositionSingleAssetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePositionSingleAsset not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreatePosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Msg/CreatePosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePosition(ctx, req.(*MsgCreatePosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreatePositionByPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePositionByPrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePositionByPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Msg/CreatePositionByPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePositionByPrice(ctx, req.(*MsgCreatePositionByPrice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreatePositionRelative_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePositionRelative)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePositionRelative(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Msg/CreatePositionRelative",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePositionRelative(ctx, req.(*MsgCreatePositionRelative))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawPosition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawPosition)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawPosition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Msg/WithdrawPosition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawPosition(ctx, req.(*MsgWithdrawPosition))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawPositions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawPositions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Msg/WithdrawPositions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawPositions(ctx, req.(*MsgWithdrawPositions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EmergencyWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEmergencyWithdraw)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EmergencyWithdraw(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Msg/EmergencyWithdraw",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EmergencyWithdraw(ctx, req.(*MsgEmergencyWithdraw))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CollectFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCollectFees)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CollectFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Msg/CollectFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CollectFees(ctx, req.(*MsgCollectFees))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CollectIncentives_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCollectIncentives)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CollectIncentives(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Msg/CollectIncentives",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CollectIncentives(ctx, req.(*MsgCollectIncentives))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawProtocolFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawProtocolFees)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawProtocolFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Msg/WithdrawProtocolFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawProtocolFees(ctx, req.(*MsgWithdrawProtocolFees))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateTickSpacing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTickSpacing)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTickSpacing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Msg/UpdateTickSpacing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTickSpacing(ctx, req.(*MsgUpdateTickSpacing))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PruneEmptyTicks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPruneEmptyTicks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PruneEmptyTicks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Msg/PruneEmptyTicks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PruneEmptyTicks(ctx, req.(*MsgPruneEmptyTicks))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SwapExactAmountInWithPriceLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSwapExactAmountInWithPriceLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SwapExactAmountInWithPriceLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Msg/SwapExactAmountInWithPriceLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SwapExactAmountInWithPriceLimit(ctx, req.(*MsgSwapExactAmountInWithPriceLimit))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreatePositionSingleAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreatePositionSingleAsset)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreatePositionSingleAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.concentratedliquidity.v1beta1.Msg/CreatePositionSingleAsset",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreatePositionSingleAsset(ctx, req.(*MsgCreatePositionSingleAsset))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.concentratedliquidity.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePosition",
+			Handler:    _Msg_CreatePosition_Handler,
+		},
+		{
+			MethodName: "CreatePositionByPrice",
+			Handler:    _Msg_CreatePositionByPrice_Handler,
+		},
+		{
+			MethodName: "CreatePositionRelative",
+			Handler:    _Msg_CreatePositionRelative_Handler,
+		},
+		{
+			MethodName: "WithdrawPosition",
+			Handler:    _Msg_WithdrawPosition_Handler,
+		},
+		{
+			MethodName: "WithdrawPositions",
+			Handler:    _Msg_WithdrawPositions_Handler,
+		},
+		{
+			MethodName: "EmergencyWithdraw",
+			Handler:    _Msg_EmergencyWithdraw_Handler,
+		},
+		{
+			MethodName: "CollectFees",
+			Handler:    _Msg_CollectFees_Handler,
+		},
+		{
+			MethodName: "CollectIncentives",
+			Handler:    _Msg_CollectIncentives_Handler,
+		},
+		{
+			MethodName: "WithdrawProtocolFees",
+			Handler:    _Msg_WithdrawProtocolFees_Handler,
+		},
+		{
+			MethodName: "UpdateTickSpacing",
+			Handler:    _Msg_UpdateTickSpacing_Handler,
+		},
+		{
+			MethodName: "PruneEmptyTicks",
+			Handler:    _Msg_PruneEmptyTicks_Handler,
+		},
+		{
+			MethodName: "SwapExactAmountInWithPriceLimit",
+			Handler:    _Msg_SwapExactAmountInWithPriceLimit_Handler,
+		},
+		{
+			MethodName: "CreatePositionSingleAsset",
+			Handler:    _Msg_CreatePositionSingleAsset_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/concentrated-liquidity/tx.proto",
+}
+
+func (m *MsgCreatePosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StrictSlippage {
+		i--
+		if m.StrictSlippage {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size := m.TokenMinAmount1.Size()
+		i -= size
+		if _, err := m.TokenMinAmount1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TokenMinAmount0.Size()
+		i -= size
+		if _, err := m.TokenMinAmount0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.TokenDesired1.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.TokenDesired0.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.UpperTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LiquidityCreated.Size()
+		i -= size
+		if _, err := m.LiquidityCreated.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JoinTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JoinTime):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintTx(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount1.Size()
+		i -= size
+		if _, err := m.Amount1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount0.Size()
+		i -= size
+		if _, err := m.Amount0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePositionByPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePositionByPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePositionByPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenMinAmount1.Size()
+		i -= size
+		if _, err := m.TokenMinAmount1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TokenMinAmount0.Size()
+		i -= size
+		if _, err := m.TokenMinAmount0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.TokenDesired1.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.TokenDesired0.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.UpperPrice.Size()
+		i -= size
+		if _, err := m.UpperPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.LowerPrice.Size()
+		i -= size
+		if _, err := m.LowerPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePositionByPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePositionByPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePositionByPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpperTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.LiquidityCreated.Size()
+		i -= size
+		if _, err := m.LiquidityCreated.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JoinTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JoinTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTx(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount1.Size()
+		i -= size
+		if _, err := m.Amount1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount0.Size()
+		i -= size
+		if _, err := m.Amount0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePositionRelative) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePositionRelative) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePositionRelative) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenMinAmount1.Size()
+		i -= size
+		if _, err := m.TokenMinAmount1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TokenMinAmount0.Size()
+		i -= size
+		if _, err := m.TokenMinAmount0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.TokenDesired1.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.TokenDesired0.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.UpperTickOffset != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpperTickOffset))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LowerTickOffset != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LowerTickOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePositionRelativeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePositionRelativeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePositionRelativeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpperTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.LiquidityCreated.Size()
+		i -= size
+		if _, err := m.LiquidityCreated.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JoinTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JoinTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintTx(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount1.Size()
+		i -= size
+		if _, err := m.Amount1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount0.Size()
+		i -= size
+		if _, err := m.Amount0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawPosition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawPosition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawPosition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LiquidityAmount.Size()
+		i -= size
+		if _, err := m.LiquidityAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawPositionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawPositionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawPositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount1.Size()
+		i -= size
+		if _, err := m.Amount1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Amount0.Size()
+		i -= size
+		if _, err := m.Amount0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PositionWithdrawal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PositionWithdrawal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PositionWithdrawal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LiquidityAmount.Size()
+		i -= size
+		if _, err := m.LiquidityAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawPositions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawPositions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawPositions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Withdrawals) > 0 {
+		for iNdEx := len(m.Withdrawals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Withdrawals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawPositionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawPositionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawPositionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokensOut) > 0 {
+		for iNdEx := len(m.TokensOut) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensOut[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEmergencyWithdraw) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEmergencyWithdraw) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEmergencyWithdraw) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEmergencyWithdrawResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEmergencyWithdrawResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEmergencyWithdrawResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount1.Size()
+		i -= size
+		if _, err := m.Amount1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Amount0.Size()
+		i -= size
+		if _, err := m.Amount0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCollectFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCollectFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCollectFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PositionIds) > 0 {
+		dAtA11 := make([]byte, len(m.PositionIds)*10)
+		var j10 int
+		for _, num := range m.PositionIds {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintTx(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCollectFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCollectFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCollectFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CollectedFees) > 0 {
+		for iNdEx := len(m.CollectedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollectedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCollectIncentives) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCollectIncentives) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCollectIncentives) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PositionIds) > 0 {
+		dAtA13 := make([]byte, len(m.PositionIds)*10)
+		var j12 int
+		for _, num := range m.PositionIds {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintTx(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCollectIncentivesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCollectIncentivesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCollectIncentivesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ForfeitedIncentives) > 0 {
+		for iNdEx := len(m.ForfeitedIncentives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForfeitedIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.CollectedIncentives) > 0 {
+		for iNdEx := len(m.CollectedIncentives) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CollectedIncentives[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateIncentive) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateIncentive) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateIncentive) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n14, err14 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinUptime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinUptime):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintTx(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x3a
+	n15, err15 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err15 != nil {
+		return 0, err15
+	}
+	i -= n15
+	i = encodeVarintTx(dAtA, i, uint64(n15))
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.EmissionRate.Size()
+		i -= size
+		if _, err := m.EmissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.IncentiveAmount.Size()
+		i -= size
+		if _, err := m.IncentiveAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.IncentiveDenom) > 0 {
+		i -= len(m.IncentiveDenom)
+		copy(dAtA[i:], m.IncentiveDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IncentiveDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateIncentiveResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateIncentiveResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateIncentiveResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n16, err16 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.MinUptime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinUptime):])
+	if err16 != nil {
+		return 0, err16
+	}
+	i -= n16
+	i = encodeVarintTx(dAtA, i, uint64(n16))
+	i--
+	dAtA[i] = 0x2a
+	n17, err17 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err17 != nil {
+		return 0, err17
+	}
+	i -= n17
+	i = encodeVarintTx(dAtA, i, uint64(n17))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.EmissionRate.Size()
+		i -= size
+		if _, err := m.EmissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.IncentiveAmount.Size()
+		i -= size
+		if _, err := m.IncentiveAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.IncentiveDenom) > 0 {
+		i -= len(m.IncentiveDenom)
+		copy(dAtA[i:], m.IncentiveDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IncentiveDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawProtocolFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawProtocolFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawProtocolFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawProtocolFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawProtocolFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawProtocolFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WithdrawnFees) > 0 {
+		for iNdEx := len(m.WithdrawnFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WithdrawnFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTickSpacing) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTickSpacing) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTickSpacing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewTickSpacing != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewTickSpacing))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTickSpacingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTickSpacingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTickSpacingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPruneEmptyTicks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneEmptyTicks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneEmptyTicks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPruneEmptyTicksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPruneEmptyTicksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPruneEmptyTicksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NumPruned != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NumPruned))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapExactAmountInWithPriceLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapExactAmountInWithPriceLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapExactAmountInWithPriceLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PriceLimit.Size()
+		i -= size
+		if _, err := m.PriceLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.TokenOutMinAmount.Size()
+		i -= size
+		if _, err := m.TokenOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSwapExactAmountInWithPriceLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSwapExactAmountInWithPriceLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSwapExactAmountInWithPriceLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.TokenInAmount.Size()
+		i -= size
+		if _, err := m.TokenInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePositionSingleAsset) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePositionSingleAsset) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePositionSingleAsset) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSlippage.Size()
+		i -= size
+		if _, err := m.MaxSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.TokenIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.UpperTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpperTick))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.LowerTick != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LowerTick))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreatePositionSingleAssetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreatePositionSingleAssetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreatePositionSingleAssetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Dust) > 0 {
+		for iNdEx := len(m.Dust) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Dust[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size, err := m.TokenSwappedOut.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.TokenSwappedIn.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.LiquidityCreated.Size()
+		i -= size
+		if _, err := m.LiquidityCreated.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	n22, err22 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.JoinTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.JoinTime):])
+	if err22 != nil {
+		return 0, err22
+	}
+	i -= n22
+	i = encodeVarintTx(dAtA, i, uint64(n22))
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Amount1.Size()
+		i -= size
+		if _, err := m.Amount1.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Amount0.Size()
+		i -= size
+		if _, err := m.Amount0.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PositionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PositionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreatePosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovTx(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovTx(uint64(m.UpperTick))
+	}
+	l = m.TokenDesired0.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenDesired1.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenMinAmount0.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenMinAmount1.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StrictSlippage {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgCreatePositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	l = m.Amount0.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Amount1.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.JoinTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = m.LiquidityCreated.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreatePositionByPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.LowerPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.UpperPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenDesired0.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenDesired1.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenMinAmount0.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenMinAmount1.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreatePositionByPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	l = m.Amount0.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Amount1.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.JoinTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = m.LiquidityCreated.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.LowerTick != 0 {
+		n += 1 + sovTx(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovTx(uint64(m.UpperTick))
+	}
+	return n
+}
+
+func (m *MsgCreatePositionRelative) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LowerTickOffset != 0 {
+		n += 1 + sovTx(uint64(m.LowerTickOffset))
+	}
+	if m.UpperTickOffset != 0 {
+		n += 1 + sovTx(uint64(m.UpperTickOffset))
+	}
+	l = m.TokenDesired0.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenDesired1.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenMinAmount0.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenMinAmount1.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreatePositionRelativeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	l = m.Amount0.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Amount1.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.JoinTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = m.LiquidityCreated.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.LowerTick != 0 {
+		n += 1 + sovTx(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovTx(uint64(m.UpperTick))
+	}
+	return n
+}
+
+func (m *MsgWithdrawPosition) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.LiquidityAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawPositionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount0.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Amount1.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *PositionWithdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	l = m.LiquidityAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawPositions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Withdrawals) > 0 {
+		for _, e := range m.Withdrawals {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawPositionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokensOut) > 0 {
+		for _, e := range m.TokensOut {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgEmergencyWithdraw) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEmergencyWithdrawResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount0.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Amount1.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCollectFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PositionIds) > 0 {
+		l = 0
+		for _, e := range m.PositionIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCollectFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CollectedFees) > 0 {
+		for _, e := range m.CollectedFees {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCollectIncentives) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PositionIds) > 0 {
+		l = 0
+		for _, e := range m.PositionIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCollectIncentivesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CollectedIncentives) > 0 {
+		for _, e := range m.CollectedIncentives {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.ForfeitedIncentives) > 0 {
+		for _, e := range m.ForfeitedIncentives {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateIncentive) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IncentiveDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.IncentiveAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.EmissionRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinUptime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateIncentiveResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IncentiveDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.IncentiveAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.EmissionRate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinUptime)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWithdrawProtocolFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawProtocolFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.WithdrawnFees) > 0 {
+		for _, e := range m.WithdrawnFees {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateTickSpacing) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.NewTickSpacing != 0 {
+		n += 1 + sovTx(uint64(m.NewTickSpacing))
+	}
+	return n
+}
+
+func (m *MsgUpdateTickSpacingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPruneEmptyTicks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPruneEmptyTicksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NumPruned != 0 {
+		n += 1 + sovTx(uint64(m.NumPruned))
+	}
+	return n
+}
+
+func (m *MsgSwapExactAmountInWithPriceLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.TokenOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.PriceLimit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSwapExactAmountInWithPriceLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.TokenInAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreatePositionSingleAsset) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LowerTick != 0 {
+		n += 1 + sovTx(uint64(m.LowerTick))
+	}
+	if m.UpperTick != 0 {
+		n += 1 + sovTx(uint64(m.UpperTick))
+	}
+	l = m.TokenIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxSlippage.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreatePositionSingleAssetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PositionId != 0 {
+		n += 1 + sovTx(uint64(m.PositionId))
+	}
+	l = m.Amount0.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Amount1.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.JoinTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = m.LiquidityCreated.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenSwappedIn.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.TokenSwappedOut.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Dust) > 0 {
+		for _, e := range m.Dust {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreatePosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenDesired0", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenDesired0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenDesired1", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenDesired1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenMinAmount0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenMinAmount0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenMinAmount1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenMinAmount1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrictSlippage", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StrictSlippage = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.JoinTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityCreated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityCreated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePositionByPrice) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePositionByPrice: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePositionByPrice: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LowerPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UpperPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenDesired0", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenDesired0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenDesired1", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenDesired1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenMinAmount0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenMinAmount0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenMinAmount1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenMinAmount1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePositionByPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePositionByPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePositionByPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.JoinTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityCreated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityCreated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePositionRelative) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePositionRelative: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePositionRelative: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTickOffset", wireType)
+			}
+			m.LowerTickOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTickOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTickOffset", wireType)
+			}
+			m.UpperTickOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTickOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenDesired0", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenDesired0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenDesired1", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenDesired1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenMinAmount0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenMinAmount0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenMinAmount1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenMinAmount1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreatePositionRelativeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreatePositionRelativeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreatePositionRelativeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.JoinTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityCreated", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityCreated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+			}
+			m.LowerTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LowerTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+			}
+			m.UpperTick = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpperTick |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawPosition) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawPosition: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawPositionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawPositionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PositionWithdrawal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PositionWithdrawal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PositionWithdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LiquidityAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawPositions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawPositions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawPositions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Withdrawals = append(m.Withdrawals, PositionWithdrawal{})
+			if err := m.Withdrawals[len(m.Withdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawPositionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawPositionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawPositionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokensOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokensOut = append(m.TokensOut, types.Coin{})
+			if err := m.TokensOut[len(m.TokensOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEmergencyWithdraw) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEmergencyWithdraw: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEmergencyWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEmergencyWithdrawResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEmergencyWithdrawResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEmergencyWithdrawResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount0", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount1", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCollectFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCollectFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCollectFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PositionIds = append(m.PositionIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PositionIds) == 0 {
+					m.PositionIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PositionIds = append(m.PositionIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionIds", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCollectFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCollectFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCollectFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectedFees = append(m.CollectedFees, types.Coin{})
+			if err := m.CollectedFees[len(m.CollectedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCollectIncentives) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCollectIncentives: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCollectIncentives: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PositionIds = append(m.PositionIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PositionIds) == 0 {
+					m.PositionIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PositionIds = append(m.PositionIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionIds", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.IncentiveAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = m.EmissionRate.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
-	n += 1 + l + sovTx(uint64(l))
-	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.MinUptime)
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreatePosition) Unmarshal(dAtA []byte) error {
+func (m *MsgCollectIncentivesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1675,17 +7431,17 @@ func (m *MsgCreatePosition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePosition: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCollectIncentivesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePosition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCollectIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectedIncentives", wireType)
 			}
-			m.PoolId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1695,16 +7451,31 @@ func (m *MsgCreatePosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CollectedIncentives = append(m.CollectedIncentives, types.Coin{})
+			if err := m.CollectedIncentives[len(m.CollectedIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitedIncentives", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1714,29 +7485,81 @@ func (m *MsgCreatePosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.ForfeitedIncentives = append(m.ForfeitedIncentives, types.Coin{})
+			if err := m.ForfeitedIncentives[len(m.ForfeitedIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateIncentive) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateIncentive: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateIncentive: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.LowerTick = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1746,16 +7569,16 @@ func (m *MsgCreatePosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LowerTick |= int64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			m.UpperTick = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1765,16 +7588,29 @@ func (m *MsgCreatePosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpperTick |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenDesired0", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1784,30 +7620,29 @@ func (m *MsgCreatePosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenDesired0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IncentiveDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenDesired1", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1817,28 +7652,29 @@ func (m *MsgCreatePosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenDesired1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IncentiveAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenMinAmount0", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1866,15 +7702,15 @@ func (m *MsgCreatePosition) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenMinAmount0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EmissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenMinAmount1", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1884,23 +7720,55 @@ func (m *MsgCreatePosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenMinAmount1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUptime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinUptime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1925,7 +7793,7 @@ func (m *MsgCreatePosition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreatePositionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateIncentiveResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1948,17 +7816,17 @@ func (m *MsgCreatePositionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreatePositionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateIncentiveResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreatePositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateIncentiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveDenom", wireType)
 			}
-			m.PositionId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1968,14 +7836,27 @@ func (m *MsgCreatePositionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PositionId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IncentiveDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount0", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2003,13 +7884,13 @@ func (m *MsgCreatePositionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IncentiveAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount1", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionRate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2037,13 +7918,13 @@ func (m *MsgCreatePositionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.EmissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JoinTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2070,15 +7951,15 @@ func (m *MsgCreatePositionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.JoinTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityCreated", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinUptime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2088,23 +7969,22 @@ func (m *MsgCreatePositionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidityCreated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinUptime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2129,7 +8009,7 @@ func (m *MsgCreatePositionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawPosition) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawProtocolFees) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2152,17 +8032,17 @@ func (m *MsgWithdrawPosition) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawPosition: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawProtocolFees: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawPosition: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawProtocolFees: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			m.PositionId = 0
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2172,7 +8052,7 @@ func (m *MsgWithdrawPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PositionId |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -2209,11 +8089,61 @@ func (m *MsgWithdrawPosition) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawProtocolFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawProtocolFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawProtocolFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WithdrawnFees", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2223,23 +8153,23 @@ func (m *MsgWithdrawPosition) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LiquidityAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.WithdrawnFees = append(m.WithdrawnFees, types.Coin{})
+			if err := m.WithdrawnFees[len(m.WithdrawnFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2264,7 +8194,7 @@ func (m *MsgWithdrawPosition) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawPositionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateTickSpacing) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2287,15 +8217,34 @@ func (m *MsgWithdrawPositionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawPositionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateTickSpacing: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawPositionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateTickSpacing: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount0", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2323,15 +8272,13 @@ func (m *MsgWithdrawPositionResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount1", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewTickSpacing", wireType)
 			}
-			var stringLen uint64
+			m.NewTickSpacing = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2341,26 +8288,61 @@ func (m *MsgWithdrawPositionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.NewTickSpacing |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTickSpacingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTickSpacingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTickSpacingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2382,7 +8364,7 @@ func (m *MsgWithdrawPositionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCollectFees) Unmarshal(dAtA []byte) error {
+func (m *MsgPruneEmptyTicks) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2405,87 +8387,30 @@ func (m *MsgCollectFees) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCollectFees: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPruneEmptyTicks: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCollectFees: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPruneEmptyTicks: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.PositionIds = append(m.PositionIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.PositionIds) == 0 {
-					m.PositionIds = make([]uint64, 0, elementCount)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.PositionIds = append(m.PositionIds, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionIds", wireType)
 			}
 		case 2:
 			if wireType != 2 {
@@ -2540,7 +8465,7 @@ func (m *MsgCollectFees) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCollectFeesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPruneEmptyTicksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2563,17 +8488,17 @@ func (m *MsgCollectFeesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCollectFeesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPruneEmptyTicksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCollectFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPruneEmptyTicksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollectedFees", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumPruned", wireType)
 			}
-			var msglen int
+			m.NumPruned = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2583,26 +8508,11 @@ func (m *MsgCollectFeesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.NumPruned |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CollectedFees = append(m.CollectedFees, types.Coin{})
-			if err := m.CollectedFees[len(m.CollectedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2624,7 +8534,7 @@ func (m *MsgCollectFeesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCollectIncentives) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactAmountInWithPriceLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2647,91 +8557,99 @@ func (m *MsgCollectIncentives) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCollectIncentives: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactAmountInWithPriceLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCollectIncentives: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactAmountInWithPriceLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				m.PositionIds = append(m.PositionIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.PositionIds) == 0 {
-					m.PositionIds = make([]uint64, 0, elementCount)
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.PositionIds = append(m.PositionIds, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field PositionIds", wireType)
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2759,7 +8677,75 @@ func (m *MsgCollectIncentives) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2782,7 +8768,7 @@ func (m *MsgCollectIncentives) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCollectIncentivesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSwapExactAmountInWithPriceLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2805,17 +8791,17 @@ func (m *MsgCollectIncentivesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCollectIncentivesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSwapExactAmountInWithPriceLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCollectIncentivesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSwapExactAmountInWithPriceLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CollectedIncentives", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2825,23 +8811,57 @@ func (m *MsgCollectIncentivesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CollectedIncentives = append(m.CollectedIncentives, types.Coin{})
-			if err := m.CollectedIncentives[len(m.CollectedIncentives)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2866,7 +8886,7 @@ func (m *MsgCollectIncentivesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateIncentive) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePositionSingleAsset) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2889,10 +8909,10 @@ func (m *MsgCreateIncentive) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateIncentive: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePositionSingleAsset: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateIncentive: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePositionSingleAsset: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2947,10 +8967,10 @@ func (m *MsgCreateIncentive) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveDenom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LowerTick", wireType)
 			}
-			var stringLen uint64
+			m.LowerTick = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2960,29 +8980,16 @@ func (m *MsgCreateIncentive) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LowerTick |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IncentiveDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveAmount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpperTick", wireType)
 			}
-			var stringLen uint64
+			m.UpperTick = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2992,63 +8999,14 @@ func (m *MsgCreateIncentive) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UpperTick |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.IncentiveAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmissionRate", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.EmissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3075,15 +9033,15 @@ func (m *MsgCreateIncentive) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinUptime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlippage", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3093,22 +9051,23 @@ func (m *MsgCreateIncentive) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinUptime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3133,7 +9092,7 @@ func (m *MsgCreateIncentive) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateIncentiveResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreatePositionSingleAssetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3156,15 +9115,34 @@ func (m *MsgCreateIncentiveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateIncentiveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreatePositionSingleAssetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateIncentiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreatePositionSingleAssetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PositionId", wireType)
+			}
+			m.PositionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PositionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount0", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3192,11 +9170,13 @@ func (m *MsgCreateIncentiveResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IncentiveDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount0.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncentiveAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount1", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3224,13 +9204,46 @@ func (m *MsgCreateIncentiveResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.IncentiveAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount1.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EmissionRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JoinTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.JoinTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LiquidityCreated", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3258,13 +9271,13 @@ func (m *MsgCreateIncentiveResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.EmissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LiquidityCreated.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenSwappedIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3291,13 +9304,13 @@ func (m *MsgCreateIncentiveResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenSwappedIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinUptime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenSwappedOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3324,7 +9337,41 @@ func (m *MsgCreateIncentiveResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.MinUptime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenSwappedOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dust", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Dust = append(m.Dust, types.Coin{})
+			if err := m.Dust[len(m.Dust)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
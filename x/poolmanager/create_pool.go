@@ -39,6 +39,8 @@ func (k Keeper) validateCreatedPool(
 // - saving the pool into its own state
 // - Minting LP shares to pool creator
 // - Setting metadata for the shares
+//
+// Finally, the pool creation listeners are notified of the new pool.
 func (k Keeper) CreatePool(ctx sdk.Context, msg types.CreatePoolMsg) (uint64, error) {
 	// Run validate basic on the message.
 	err := msg.Validate(ctx)
@@ -85,6 +87,8 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg types.CreatePoolMsg) (uint64, er
 		return 0, err
 	}
 
+	k.poolCreationListeners.AfterPoolCreated(ctx, sender, poolId)
+
 	emitCreatePoolEvents(ctx, poolId, msg)
 	return pool.GetId(), nil
 }
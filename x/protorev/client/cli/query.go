@@ -19,6 +19,7 @@ func NewCmdQuery() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryNumberOfTradesCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryProfitsByDenomCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryAllProfitsCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryTotalProfitInDenomCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryStatisticsByRouteCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryAllRouteStatisticsCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryTokenPairArbRoutesCmd)
@@ -29,6 +30,17 @@ func NewCmdQuery() *cobra.Command {
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryBaseDenomsCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryEnabledCmd)
 	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryPoolWeightsCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryMaxTradesPerBlockCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryArbitrageStatusCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryMonitoredPoolsCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryPoolBlacklistCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryCurrentArbitrageOpportunitiesCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryProfitSearchTraceCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryArbConfigCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryArbitrageGasConsumedCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryProfitSplitCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryDeveloperFeeShareCmd)
+	osmocli.AddQueryCmd(cmd, types.NewQueryClient, NewQueryProjectedProfitOnPriceShiftCmd)
 
 	return cmd
 }
@@ -66,6 +78,15 @@ func NewQueryAllProfitsCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevA
 	}, &types.QueryGetProtoRevAllProfitsRequest{}
 }
 
+// NewQueryTotalProfitInDenomCmd returns the command to query all profits of protorev valued in a single denom
+func NewQueryTotalProfitInDenomCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevTotalProfitInDenomRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "total-profit-in-denom [target-denom]",
+		Short: "Query all ProtoRev profits valued in the target denom (on-chain estimate subject to pool liquidity)",
+		Long:  `{{.Short}}{{.ExampleHeader}}{{.CommandPrefix}} total-profit-in-denom uosmo`,
+	}, &types.QueryGetProtoRevTotalProfitInDenomRequest{}
+}
+
 // NewQueryStatisticsByRoute returns the command to query the statistics of protorev by route
 func NewQueryStatisticsByRouteCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevStatisticsByRouteRequest) {
 	return &osmocli.QueryDescriptor{
@@ -148,6 +169,23 @@ func NewQueryPoolWeightsCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRev
 	}, &types.QueryGetProtoRevPoolWeightsRequest{}
 }
 
+// NewQueryMaxTradesPerBlockCmd returns the command to query the max trades per block of protorev
+func NewQueryMaxTradesPerBlockCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevMaxTradesPerBlockRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "max-trades-per-block",
+		Short: "Query the maximum number of trades that can be executed per block",
+	}, &types.QueryGetProtoRevMaxTradesPerBlockRequest{}
+}
+
+// NewQueryArbitrageStatusCmd returns the command to query the height until which protorev arbitrage is disabled
+// and whether arbitrage is currently active
+func NewQueryArbitrageStatusCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevArbitrageStatusRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "arbitrage-status",
+		Short: "Query the height until which arbitrage is disabled and whether arbitrage is currently active",
+	}, &types.QueryGetProtoRevArbitrageStatusRequest{}
+}
+
 // convert a string array "[1,2,3]" to []uint64
 func parseRoute(arg string, _ *pflag.FlagSet) (any, osmocli.FieldReadLocation, error) {
 	var route []uint64
@@ -157,3 +195,78 @@ func parseRoute(arg string, _ *pflag.FlagSet) (any, osmocli.FieldReadLocation, e
 	}
 	return route, osmocli.UsedArg, err
 }
+
+// NewQueryMonitoredPoolsCmd returns the command to query the pools protorev considers for arbitrage
+func NewQueryMonitoredPoolsCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevMonitoredPoolsRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "monitored-pools",
+		Short: "Query the ids of all pools protorev considers for arbitrage given the current base denoms and hot routes",
+	}, &types.QueryGetProtoRevMonitoredPoolsRequest{}
+}
+
+// NewQueryPoolBlacklistCmd returns the command to query the pools that must never be included in arbitrage routes
+func NewQueryPoolBlacklistCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevPoolBlacklistRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "pool-blacklist",
+		Short: "Query the ids of the pools that must never be included in arbitrage routes",
+	}, &types.QueryGetProtoRevPoolBlacklistRequest{}
+}
+
+// NewQueryCurrentArbitrageOpportunitiesCmd returns the command to query the current arbitrage opportunities without executing them
+func NewQueryCurrentArbitrageOpportunitiesCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevCurrentArbitrageOpportunitiesRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "current-arbitrage-opportunities [limit]",
+		Short: "Query the most profitable arbitrage opportunities in the current state without executing them (a limit of 0 returns all)",
+	}, &types.QueryGetProtoRevCurrentArbitrageOpportunitiesRequest{}
+}
+
+// NewQueryProfitSearchTraceCmd returns the command to query every input tried by the search for the optimal input amount of a route
+func NewQueryProfitSearchTraceCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevProfitSearchTraceRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:                "profit-search-trace [route] [input-denom]",
+		Short:              "Query every input tried by the search for the optimal input amount of a route alongside the resulting profit, without executing any trades",
+		Long:               `{{.Short}}{{.ExampleHeader}}{{.CommandPrefix}} profit-search-trace [1,2,3] uosmo`,
+		CustomFieldParsers: map[string]osmocli.CustomFieldParserFn{"Route": parseRoute},
+	}, &types.QueryGetProtoRevProfitSearchTraceRequest{}
+}
+
+// NewQueryArbConfigCmd returns the command to query the full arbitrage configuration and statistics of the module
+func NewQueryArbConfigCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevArbConfigRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "arb-config",
+		Short: "Query the full arbitrage configuration and statistics of the module, structured like its genesis state",
+	}, &types.QueryGetProtoRevArbConfigRequest{}
+}
+
+// NewQueryArbitrageGasConsumedCmd returns the command to query the gas consumed by arbitrage backruns
+func NewQueryArbitrageGasConsumedCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevArbitrageGasConsumedRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "arbitrage-gas-consumed",
+		Short: "Query the cumulative gas consumed by arbitrage backruns and the gas consumed in the current block",
+	}, &types.QueryGetProtoRevArbitrageGasConsumedRequest{}
+}
+
+// NewQueryProfitSplitCmd returns the command to query the split of profits between the developer account and the protocol
+func NewQueryProfitSplitCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevProfitSplitRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "profit-split",
+		Short: "Query the fraction of profit allocated to the developer account and the split of accumulated profits between the developer account and the protocol",
+	}, &types.QueryGetProtoRevProfitSplitRequest{}
+}
+
+// NewQueryDeveloperFeeShareCmd returns the command to query the fraction of profit allocated to the developer account
+func NewQueryDeveloperFeeShareCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevDeveloperFeeShareRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "developer-fee-share",
+		Short: "Query the fraction of profit allocated to the developer account and whether it was set by governance",
+	}, &types.QueryGetProtoRevDeveloperFeeShareRequest{}
+}
+
+// NewQueryProjectedProfitOnPriceShiftCmd returns the command to query the arbitrage protorev would capture if a pool's price moved by a given percent
+func NewQueryProjectedProfitOnPriceShiftCmd() (*osmocli.QueryDescriptor, *types.QueryGetProtoRevProjectedProfitOnPriceShiftRequest) {
+	return &osmocli.QueryDescriptor{
+		Use:   "projected-profit-on-price-shift [pool-id] [price-shift-percent]",
+		Short: "Query the arbitrage opportunities and total profit protorev would capture if a two denom pool's spot price moved by the given percent, without executing any trades",
+		Long:  `{{.Short}}{{.ExampleHeader}}{{.CommandPrefix}} projected-profit-on-price-shift 1 5`,
+	}, &types.QueryGetProtoRevProjectedProfitOnPriceShiftRequest{}
+}
@@ -15,6 +15,7 @@ import (
 
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	"github.com/osmosis-labs/osmosis/osmoutils"
 	"github.com/osmosis-labs/osmosis/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v15/x/protorev/types"
 
@@ -27,12 +28,14 @@ func NewCmdTx() *cobra.Command {
 	osmocli.AddTxCmd(txCmd, CmdSetDeveloperAccount)
 	osmocli.AddTxCmd(txCmd, CmdSetMaxPoolPointsPerTx)
 	osmocli.AddTxCmd(txCmd, CmdSetMaxPoolPointsPerBlock)
+	osmocli.AddTxCmd(txCmd, CmdSetPoolBlacklist)
 	txCmd.AddCommand(
 		CmdSetDeveloperHotRoutes().BuildCommandCustomFn(),
 		CmdSetPoolWeights().BuildCommandCustomFn(),
 		CmdSetBaseDenoms().BuildCommandCustomFn(),
 		CmdSetProtoRevAdminAccountProposal(),
 		CmdSetProtoRevEnabledProposal(),
+		CmdSetProtoRevDeveloperFeeShareProposal(),
 	)
 	return txCmd
 }
@@ -43,6 +46,8 @@ func CmdSetDeveloperHotRoutes() *osmocli.TxCliDesc {
 		Use:   "set-hot-routes [path/to/routes.json]",
 		Short: "set the protorev hot routes",
 		Long: `Must provide a json file with all of the hot routes that will be set. 
+		Each route may set an optional max_input_amount to cap the input of the optimal amount search (omitted means no cap).
+		Each route may set an optional priority; routes with a higher priority are searched first (omitted means the default priority of 0).
 		Sample json file:
 		[
 			{
@@ -67,7 +72,9 @@ func CmdSetDeveloperHotRoutes() *osmocli.TxCliDesc {
 								"token_out": "uosmo"
 							}
 						],
-						"step_size": 1000000
+						"step_size": 1000000,
+						"max_input_amount": 500000000,
+						"priority": 1
 					}
 				]
 			}
@@ -141,6 +148,31 @@ func CmdSetMaxPoolPointsPerBlock() (*osmocli.TxCliDesc, *types.MsgSetMaxPoolPoin
 	}, &types.MsgSetMaxPoolPointsPerBlock{}
 }
 
+// CmdSetPoolBlacklist implements the command to set the pools that must never be included in arbitrage routes
+func CmdSetPoolBlacklist() (*osmocli.TxCliDesc, *types.MsgSetPoolBlacklist) {
+	return &osmocli.TxCliDesc{
+		Use:     "set-pool-blacklist [comma-separated pool ids]",
+		Short:   "set the pools that must never be included in arbitrage routes, replacing the previous blacklist (an empty string clears it)",
+		Example: fmt.Sprintf(`$ %s tx protorev set-pool-blacklist 1,2,3 --from mykey`, version.AppName),
+		NumArgs: 1,
+		ParseAndBuildMsg: func(clientCtx client.Context, args []string, flags *pflag.FlagSet) (sdk.Msg, error) {
+			poolIds := []uint64{}
+			if args[0] != "" {
+				var err error
+				poolIds, err = osmoutils.ParseUint64SliceFromString(args[0], ",")
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			return &types.MsgSetPoolBlacklist{
+				PoolIds: poolIds,
+				Admin:   clientCtx.GetFromAddress().String(),
+			}, nil
+		},
+	}, &types.MsgSetPoolBlacklist{}
+}
+
 // CmdSetPoolWeights implements the command to set the pool weights used to estimate execution costs
 func CmdSetPoolWeights() *osmocli.TxCliDesc {
 	desc := osmocli.TxCliDesc{
@@ -168,6 +200,7 @@ func CmdSetBaseDenoms() *osmocli.TxCliDesc {
 		Use:   "set-base-denoms [path/to/denoms.json]",
 		Short: "set the protorev base denoms",
 		Long: `Must provide a json file with all the base denoms that will be set. 
+		Base denoms with intermediate_only set are only used as intermediate hops and never seed routes.
 		Sample json file:
 		[
 			{
@@ -177,6 +210,11 @@ func CmdSetBaseDenoms() *osmocli.TxCliDesc {
 			{
 				"step_size" : 10000,
 				"denom" : "atom"
+			},
+			{
+				"step_size" : 10000,
+				"denom" : "ibc/illiquid",
+				"intermediate_only" : true
 			}
 		]
 		`,
@@ -246,6 +284,37 @@ func CmdSetProtoRevEnabledProposal() *cobra.Command {
 	return cmd
 }
 
+// CmdSetProtoRevDeveloperFeeShareProposal implements the command to submit a SetProtoRevDeveloperFeeShareProposal
+func CmdSetProtoRevDeveloperFeeShareProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "set-developer-fee-share-proposal [sdk.Dec]",
+		Args:    cobra.ExactArgs(1),
+		Short:   "submit a set protorev developer fee share proposal to set the fraction of profit allocated to the developer account",
+		Example: fmt.Sprintf(`$ %s tx protorev set-developer-fee-share-proposal 0.05 --from mykey`, version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			createContent := func(title string, description string, args ...string) (govtypes.Content, error) {
+				share, err := sdk.NewDecFromStr(args[0])
+				if err != nil {
+					return nil, err
+				}
+
+				return types.NewSetProtoRevDeveloperFeeShareProposal(title, description, share), nil
+			}
+
+			return ProposalExecute(cmd, args, createContent)
+		},
+	}
+
+	cmd.Flags().String(cli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(cli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(cli.FlagDeposit, "", "deposit of proposal")
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(cli.FlagTitle)
+	_ = cmd.MarkFlagRequired(cli.FlagDescription)
+
+	return cmd
+}
+
 // ProposalExecute is a helper function to execute a proposal command. It takes in a function to create the proposal content.
 func ProposalExecute(cmd *cobra.Command, args []string, createContent func(title string, description string, args ...string) (govtypes.Content, error)) error {
 	clientCtx, err := client.GetClientTxContext(cmd)
@@ -21,8 +21,11 @@ type Trade struct {
 }
 
 type ArbRoutes struct {
-	Trades   []Trade `json:"trades"`
-	StepSize uint64  `json:"step_size"`
+	Trades             []Trade `json:"trades"`
+	StepSize           uint64  `json:"step_size"`
+	MaxInputAmount     uint64  `json:"max_input_amount,omitempty"`
+	Priority           uint64  `json:"priority,omitempty"`
+	MinProfitThreshold uint64  `json:"min_profit_threshold,omitempty"`
 }
 
 type hotRoutesInput struct {
@@ -76,6 +79,15 @@ func (release *createArbRoutesInput) extractTokenPairArbRoutes() []types.TokenPa
 		for _, arbRoute := range hotRoute.ArbRoutes {
 			currentArbRoute := types.Route{}
 			currentArbRoute.StepSize = sdk.NewIntFromUint64(arbRoute.StepSize)
+			if arbRoute.MaxInputAmount > 0 {
+				maxInputAmount := sdk.NewIntFromUint64(arbRoute.MaxInputAmount)
+				currentArbRoute.MaxInputAmount = &maxInputAmount
+			}
+			currentArbRoute.Priority = arbRoute.Priority
+			if arbRoute.MinProfitThreshold > 0 {
+				minProfitThreshold := sdk.NewIntFromUint64(arbRoute.MinProfitThreshold)
+				currentArbRoute.MinProfitThreshold = &minProfitThreshold
+			}
 
 			for _, trade := range arbRoute.Trades {
 				currentTrade := types.Trade{}
@@ -175,8 +187,9 @@ func BuildSetPoolWeightsMsg(clientCtx client.Context, args []string, fs *flag.Fl
 
 // ------------ types/functions to handle a SetBaseDenoms CLI TX ------------ //
 type baseDenomInput struct {
-	Denom    string `json:"denom"`
-	StepSize uint64 `json:"step_size"`
+	Denom            string `json:"denom"`
+	StepSize         uint64 `json:"step_size"`
+	IntermediateOnly bool   `json:"intermediate_only"`
 }
 
 type createBaseDenomsInput []baseDenomInput
@@ -231,8 +244,9 @@ func BuildSetBaseDenomsMsg(clientCtx client.Context, args []string, fs *flag.Fla
 	baseDenoms := make([]types.BaseDenom, 0)
 	for _, baseDenom := range *input {
 		baseDenoms = append(baseDenoms, types.BaseDenom{
-			Denom:    baseDenom.Denom,
-			StepSize: sdk.NewIntFromUint64(baseDenom.StepSize),
+			Denom:            baseDenom.Denom,
+			StepSize:         sdk.NewIntFromUint64(baseDenom.StepSize),
+			IntermediateOnly: baseDenom.IntermediateOnly,
 		})
 	}
 
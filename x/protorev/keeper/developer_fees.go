@@ -34,19 +34,13 @@ func (k Keeper) SendDeveloperFeesToDeveloperAccount(ctx sdk.Context) error {
 
 // UpdateDeveloperFees updates the fees that developers can withdraw from the module account
 func (k Keeper) UpdateDeveloperFees(ctx sdk.Context, denom string, profit sdk.Int) error {
-	daysSinceGenesis, err := k.GetDaysSinceModuleGenesis(ctx)
+	profitSplit, err := k.GetDeveloperProfitSplit(ctx)
 	if err != nil {
 		return err
 	}
 
 	// Calculate the developer fee
-	if daysSinceGenesis < types.Phase1Length {
-		profit = profit.MulRaw(types.ProfitSplitPhase1).QuoRaw(100)
-	} else if daysSinceGenesis < types.Phase2Length {
-		profit = profit.MulRaw(types.ProfitSplitPhase2).QuoRaw(100)
-	} else {
-		profit = profit.MulRaw(types.ProfitSplitPhase3).QuoRaw(100)
-	}
+	profit = profit.ToDec().Mul(profitSplit).TruncateInt()
 
 	// Get the developer fees for the denom, if not there then set it to 0 and initialize it
 	currentDeveloperFee, err := k.GetDeveloperFees(ctx, denom)
@@ -62,3 +56,48 @@ func (k Keeper) UpdateDeveloperFees(ctx sdk.Context, denom string, profit sdk.In
 
 	return nil
 }
+
+// GetDeveloperProfitSplit returns the fraction of each trade's profit that is currently allocated to the developer
+// account. If governance has set the developer fee share, it is returned. Otherwise, it depends on the number of days
+// since module genesis.
+func (k Keeper) GetDeveloperProfitSplit(ctx sdk.Context) (sdk.Dec, error) {
+	share, found, err := k.GetDeveloperFeeShare(ctx)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if found {
+		return share, nil
+	}
+
+	daysSinceGenesis, err := k.GetDaysSinceModuleGenesis(ctx)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	if daysSinceGenesis < types.Phase1Length {
+		return sdk.NewDecWithPrec(types.ProfitSplitPhase1, 2), nil
+	} else if daysSinceGenesis < types.Phase2Length {
+		return sdk.NewDecWithPrec(types.ProfitSplitPhase2, 2), nil
+	}
+	return sdk.NewDecWithPrec(types.ProfitSplitPhase3, 2), nil
+}
+
+// GetProfitSplit returns the fraction of profit currently allocated to the developer account alongside the
+// allocation of all of the profits accumulated by the module between the developer account and the protocol,
+// were they split at that fraction. Profits are split per denom with the same rounding as UpdateDeveloperFees.
+func (k Keeper) GetProfitSplit(ctx sdk.Context) (sdk.Dec, sdk.Coins, sdk.Coins, error) {
+	profitSplit, err := k.GetDeveloperProfitSplit(ctx)
+	if err != nil {
+		return sdk.Dec{}, nil, nil, err
+	}
+
+	developerProfits := sdk.NewCoins()
+	protocolProfits := sdk.NewCoins()
+	for _, profit := range k.GetAllProfits(ctx) {
+		developerAmount := profit.Amount.ToDec().Mul(profitSplit).TruncateInt()
+		developerProfits = developerProfits.Add(sdk.NewCoin(profit.Denom, developerAmount))
+		protocolProfits = protocolProfits.Add(sdk.NewCoin(profit.Denom, profit.Amount.Sub(developerAmount)))
+	}
+
+	return profitSplit, developerProfits, protocolProfits, nil
+}
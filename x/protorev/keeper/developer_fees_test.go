@@ -145,6 +145,27 @@ func (suite *KeeperTestSuite) TestUpdateDeveloperFees() {
 			},
 			expected: sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(10)),
 		},
+		{
+			description: "Update developer fees with a share set by governance",
+			denom:       types.OsmosisDenomination,
+			profit:      sdk.NewInt(200),
+			alterState: func() {
+				suite.App.ProtoRevKeeper.SetDaysSinceModuleGenesis(suite.Ctx, 365*10+1)
+				err := suite.App.ProtoRevKeeper.SetDeveloperFeeShare(suite.Ctx, sdk.NewDecWithPrec(33, 2))
+				suite.Require().NoError(err)
+			},
+			expected: sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(66)),
+		},
+		{
+			description: "Update developer fees with a zero share set by governance",
+			denom:       types.OsmosisDenomination,
+			profit:      sdk.NewInt(200),
+			alterState: func() {
+				err := suite.App.ProtoRevKeeper.SetDeveloperFeeShare(suite.Ctx, sdk.ZeroDec())
+				suite.Require().NoError(err)
+			},
+			expected: sdk.NewCoin(types.OsmosisDenomination, sdk.ZeroInt()),
+		},
 	}
 
 	for _, tc := range cases {
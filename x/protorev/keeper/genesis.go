@@ -32,6 +32,9 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		panic(err)
 	}
 
+	// Set the pools that must never be included in arbitrage routes.
+	k.SetPoolBlacklist(ctx, genState.PoolBlacklist)
+
 	// Update the pools on genesis.
 	if err := k.UpdatePools(ctx); err != nil {
 		panic(err)
@@ -55,6 +58,13 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		}
 	}
 
+	// Set the developer fee share if it has been set by governance.
+	if genState.DeveloperFeeShare != nil {
+		if err := k.SetDeveloperFeeShare(ctx, *genState.DeveloperFeeShare); err != nil {
+			panic(err)
+		}
+	}
+
 	// Set the number of days since the module genesis.
 	k.SetDaysSinceModuleGenesis(ctx, genState.DaysSinceModuleGenesis)
 
@@ -108,6 +118,9 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	}
 	genesis.BaseDenoms = baseDenoms
 
+	// Export the pools that must never be included in arbitrage routes.
+	genesis.PoolBlacklist = k.GetPoolBlacklist(ctx)
+
 	// Export the developer fees that have been collected.
 	fees, err := k.GetAllDeveloperFees(ctx)
 	if err != nil {
@@ -125,6 +138,15 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		genesis.DeveloperAddress = developerAddress.String()
 	}
 
+	// Export the developer fee share if it has been set by governance.
+	developerFeeShare, found, err := k.GetDeveloperFeeShare(ctx)
+	if err != nil {
+		panic(err)
+	}
+	if found {
+		genesis.DeveloperFeeShare = &developerFeeShare
+	}
+
 	// Export the latest block height (ignore the error in case the latest block height was not set yet).
 	if latestBlockHeight, err := k.GetLatestBlockHeight(ctx); err == nil {
 		genesis.LatestBlockHeight = latestBlockHeight
@@ -148,3 +170,23 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 
 	return genesis
 }
+
+// ExportArbConfig returns the full arbitrage configuration and statistics of the module in a single structure. The
+// configuration is exported exactly as it would be in genesis, and is accompanied by the statistics that genesis does not
+// carry so that off chain simulators can replay the module's behaviour from a single query.
+func (k Keeper) ExportArbConfig(ctx sdk.Context) (types.ArbConfig, error) {
+	routeStatistics, err := k.GetAllRouteStatistics(ctx)
+	if err != nil {
+		return types.ArbConfig{}, err
+	}
+
+	// The number of trades is not set until the first trade is executed, in which case it is zero
+	numberOfTrades, _ := k.GetNumberOfTrades(ctx)
+
+	return types.ArbConfig{
+		Genesis:         *k.ExportGenesis(ctx),
+		RouteStatistics: routeStatistics,
+		Profits:         k.GetAllProfits(ctx),
+		NumberOfTrades:  numberOfTrades,
+	}, nil
+}
@@ -55,4 +55,7 @@ func (suite *KeeperTestSuite) TestInitGenesis() {
 	pointCount, err := suite.App.ProtoRevKeeper.GetPointCountForBlock(suite.Ctx)
 	suite.Require().NoError(err)
 	suite.Require().Equal(pointCount, exportedGenesis.PointCountForBlock)
+
+	poolBlacklist := suite.App.ProtoRevKeeper.GetPoolBlacklist(suite.Ctx)
+	suite.Require().Equal(poolBlacklist, exportedGenesis.PoolBlacklist)
 }
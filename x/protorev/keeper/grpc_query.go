@@ -72,6 +72,21 @@ func (q Querier) GetProtoRevAllProfits(c context.Context, req *types.QueryGetPro
 	return &types.QueryGetProtoRevAllProfitsResponse{Profits: profits}, nil
 }
 
+// GetProtoRevTotalProfitInDenom queries the total profits of the module valued in the target denom
+func (q Querier) GetProtoRevTotalProfitInDenom(c context.Context, req *types.QueryGetProtoRevTotalProfitInDenomRequest) (*types.QueryGetProtoRevTotalProfitInDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := sdk.ValidateDenom(req.TargetDenom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	total, valuations := q.Keeper.GetTotalProfitInDenom(ctx, req.TargetDenom)
+
+	return &types.QueryGetProtoRevTotalProfitInDenomResponse{Total: total, Valuations: valuations}, nil
+}
+
 // GetProtoRevStatisticsByRoute queries the number of arbitrages and profits
 // that have been executed for a given route
 func (q Querier) GetProtoRevStatisticsByRoute(c context.Context, req *types.QueryGetProtoRevStatisticsByRouteRequest) (*types.QueryGetProtoRevStatisticsByRouteResponse, error) {
@@ -87,11 +102,17 @@ func (q Querier) GetProtoRevStatisticsByRoute(c context.Context, req *types.Quer
 	}
 	profits := q.Keeper.GetAllProfitsByRoute(ctx, req.Route)
 
+	// Routes that were traded before the last execution height was tracked default to zero
+	lastExecutionHeight, _ := q.Keeper.GetLastExecutionByRoute(ctx, req.Route)
+
 	// Wrap the information into a response
 	statistics := types.RouteStatistics{
-		NumberOfTrades: numberOfTrades,
-		Profits:        profits,
-		Route:          req.Route,
+		NumberOfTrades:      numberOfTrades,
+		Profits:             profits,
+		Route:               req.Route,
+		LastExecutionHeight: lastExecutionHeight,
+		NumberOfAttempts:    q.Keeper.GetAttemptsByRoute(ctx, req.Route),
+		SuccessRate:         q.Keeper.GetSuccessRateByRoute(ctx, req.Route),
 	}
 	return &types.QueryGetProtoRevStatisticsByRouteResponse{Statistics: statistics}, nil
 }
@@ -103,30 +124,15 @@ func (q Querier) GetProtoRevAllRouteStatistics(c context.Context, req *types.Que
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 	ctx := sdk.UnwrapSDKContext(c)
-	routes, err := q.Keeper.GetAllRoutes(ctx)
+	statistics, err := q.Keeper.GetAllRouteStatistics(ctx)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	if len(routes) == 0 {
+	if len(statistics) == 0 {
 		return nil, status.Error(codes.Internal, "no routes found")
 	}
 
-	statistics := make([]types.RouteStatistics, len(routes))
-	for index, route := range routes {
-		numberOfTrades, err := q.Keeper.GetTradesByRoute(ctx, route)
-		if err != nil {
-			return nil, status.Error(codes.Internal, err.Error())
-		}
-		profits := q.Keeper.GetAllProfitsByRoute(ctx, route)
-
-		statistics[index] = types.RouteStatistics{
-			NumberOfTrades: numberOfTrades,
-			Profits:        profits,
-			Route:          route,
-		}
-	}
-
 	return &types.QueryGetProtoRevAllRouteStatisticsResponse{Statistics: statistics}, nil
 }
 
@@ -231,3 +237,170 @@ func (q Querier) GetProtoRevEnabled(c context.Context, req *types.QueryGetProtoR
 
 	return &types.QueryGetProtoRevEnabledResponse{Enabled: q.Keeper.GetProtoRevEnabled(ctx)}, nil
 }
+
+// GetProtoRevArbitrageStatus queries the height until which arbitrage is disabled and whether arbitrage
+// is currently active
+func (q Querier) GetProtoRevArbitrageStatus(c context.Context, req *types.QueryGetProtoRevArbitrageStatusRequest) (*types.QueryGetProtoRevArbitrageStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryGetProtoRevArbitrageStatusResponse{
+		DisabledUntilHeight: q.Keeper.GetDisabledUntilHeight(ctx),
+		Active:              q.Keeper.IsArbitrageActive(ctx),
+	}, nil
+}
+
+// GetProtoRevMaxTradesPerBlock queries the maximum number of trades that can be executed per block
+func (q Querier) GetProtoRevMaxTradesPerBlock(c context.Context, req *types.QueryGetProtoRevMaxTradesPerBlockRequest) (*types.QueryGetProtoRevMaxTradesPerBlockResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryGetProtoRevMaxTradesPerBlockResponse{MaxTradesPerBlock: q.Keeper.GetMaxTradesPerBlock(ctx)}, nil
+}
+
+// GetProtoRevMonitoredPools queries the ids of all pools that the module considers for arbitrage
+func (q Querier) GetProtoRevMonitoredPools(c context.Context, req *types.QueryGetProtoRevMonitoredPoolsRequest) (*types.QueryGetProtoRevMonitoredPoolsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	poolIds, err := q.Keeper.GetMonitoredPools(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetProtoRevMonitoredPoolsResponse{PoolIds: poolIds}, nil
+}
+
+// GetProtoRevPoolBlacklist queries the ids of the pools that must never be included in arbitrage routes
+func (q Querier) GetProtoRevPoolBlacklist(c context.Context, req *types.QueryGetProtoRevPoolBlacklistRequest) (*types.QueryGetProtoRevPoolBlacklistResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryGetProtoRevPoolBlacklistResponse{PoolIds: q.Keeper.GetPoolBlacklist(ctx)}, nil
+}
+
+// GetProtoRevCurrentArbitrageOpportunities queries the most profitable arbitrage opportunities in the current state without executing them
+func (q Querier) GetProtoRevCurrentArbitrageOpportunities(c context.Context, req *types.QueryGetProtoRevCurrentArbitrageOpportunitiesRequest) (*types.QueryGetProtoRevCurrentArbitrageOpportunitiesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	opportunities, err := q.Keeper.CurrentArbitrageOpportunities(ctx, req.Limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetProtoRevCurrentArbitrageOpportunitiesResponse{Opportunities: opportunities}, nil
+}
+
+// GetProtoRevProfitSearchTrace queries every input tried by the search for the optimal input amount of a route alongside the resulting profit
+func (q Querier) GetProtoRevProfitSearchTrace(c context.Context, req *types.QueryGetProtoRevProfitSearchTraceRequest) (*types.QueryGetProtoRevProfitSearchTraceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	steps, input, profit, err := q.Keeper.ProfitSearchTrace(ctx, req.Route, req.InputDenom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetProtoRevProfitSearchTraceResponse{Steps: steps, Input: input, Profit: profit}, nil
+}
+
+// GetProtoRevArbConfig queries the full arbitrage configuration and statistics of the module
+func (q Querier) GetProtoRevArbConfig(c context.Context, req *types.QueryGetProtoRevArbConfigRequest) (*types.QueryGetProtoRevArbConfigResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	arbConfig, err := q.Keeper.ExportArbConfig(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetProtoRevArbConfigResponse{ArbConfig: arbConfig}, nil
+}
+
+// GetProtoRevArbitrageGasConsumed queries the cumulative gas consumed by arbitrage backruns and the gas consumed in the current block
+func (q Querier) GetProtoRevArbitrageGasConsumed(c context.Context, req *types.QueryGetProtoRevArbitrageGasConsumedRequest) (*types.QueryGetProtoRevArbitrageGasConsumedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryGetProtoRevArbitrageGasConsumedResponse{
+		TotalGasConsumed: q.Keeper.GetArbitrageGasConsumed(ctx),
+		BlockGasConsumed: q.Keeper.GetArbitrageGasConsumedForBlock(ctx),
+	}, nil
+}
+
+// GetProtoRevProfitSplit queries the fraction of profit currently allocated to the developer account and the resulting
+// allocation of the module's accumulated profits between the developer account and the protocol
+func (q Querier) GetProtoRevProfitSplit(c context.Context, req *types.QueryGetProtoRevProfitSplitRequest) (*types.QueryGetProtoRevProfitSplitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	developerFeeFraction, developerProfits, protocolProfits, err := q.Keeper.GetProfitSplit(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetProtoRevProfitSplitResponse{
+		DeveloperFeeFraction: developerFeeFraction,
+		DeveloperProfits:     developerProfits,
+		ProtocolProfits:      protocolProfits,
+	}, nil
+}
+
+// GetProtoRevDeveloperFeeShare queries the fraction of each trade's profit that is currently allocated to the developer
+// account and whether it was set by governance
+func (q Querier) GetProtoRevDeveloperFeeShare(c context.Context, req *types.QueryGetProtoRevDeveloperFeeShareRequest) (*types.QueryGetProtoRevDeveloperFeeShareResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	_, setByGovernance, err := q.Keeper.GetDeveloperFeeShare(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	developerFeeShare, err := q.Keeper.GetDeveloperProfitSplit(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetProtoRevDeveloperFeeShareResponse{
+		DeveloperFeeShare: developerFeeShare,
+		SetByGovernance:   setByGovernance,
+	}, nil
+}
+
+// GetProtoRevProjectedProfitOnPriceShift queries the arbitrage opportunities the module would capture if the spot price of a pool moved by the given percent, without executing them
+func (q Querier) GetProtoRevProjectedProfitOnPriceShift(c context.Context, req *types.QueryGetProtoRevProjectedProfitOnPriceShiftRequest) (*types.QueryGetProtoRevProjectedProfitOnPriceShiftResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	opportunities, totalProfit, priceBefore, priceAfter, err := q.Keeper.ProjectProfitOnPriceShift(ctx, req.PoolId, req.PriceShiftPercent)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryGetProtoRevProjectedProfitOnPriceShiftResponse{
+		Opportunities:   opportunities,
+		TotalProfit:     totalProfit,
+		SpotPriceBefore: priceBefore,
+		SpotPriceAfter:  priceAfter,
+	}, nil
+}
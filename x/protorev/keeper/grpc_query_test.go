@@ -162,6 +162,8 @@ func (suite *KeeperTestSuite) TestGetProtoRevStatisticsByRoute() {
 	suite.Require().NoError(err)
 	suite.Require().Equal([]uint64{1, 2, 3}, res.Statistics.Route)
 	suite.Require().Equal(sdk.NewInt(3), res.Statistics.NumberOfTrades)
+	suite.Require().Equal(sdk.ZeroInt(), res.Statistics.NumberOfAttempts)
+	suite.Require().Equal(sdk.ZeroDec(), res.Statistics.SuccessRate)
 	atomCoin := sdk.NewCoin("Atom", sdk.NewInt(90000))
 	osmoCoin := sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(80000))
 	suite.Require().Contains(res.Statistics.Profits, atomCoin)
@@ -298,6 +300,21 @@ func (suite *KeeperTestSuite) TestGetProtoRevPoolWeights() {
 	suite.Require().Equal(poolWeights, res.PoolWeights)
 }
 
+// TestGetProtoRevPoolBlacklist tests the query to retrieve the pool blacklist
+func (suite *KeeperTestSuite) TestGetProtoRevPoolBlacklist() {
+	// The blacklist is empty by default
+	req := &types.QueryGetProtoRevPoolBlacklistRequest{}
+	res, err := suite.queryClient.GetProtoRevPoolBlacklist(sdk.WrapSDKContext(suite.Ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.PoolIds)
+
+	// Set the pool blacklist
+	suite.App.AppKeepers.ProtoRevKeeper.SetPoolBlacklist(suite.Ctx, []uint64{5, 2})
+	res, err = suite.queryClient.GetProtoRevPoolBlacklist(sdk.WrapSDKContext(suite.Ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{2, 5}, res.PoolIds)
+}
+
 // TestGetProtoRevMaxPoolPointsPerTx tests the query to retrieve the max pool points per tx
 func (suite *KeeperTestSuite) TestGetProtoRevMaxPoolPointsPerTx() {
 	// Set the max pool points per tx
@@ -355,3 +372,137 @@ func (suite *KeeperTestSuite) TestGetProtoRevEnabledQuery() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(enabled, res.Enabled)
 }
+
+// TestGetProtoRevArbitrageStatus tests the query to retrieve the height until which arbitrage is disabled
+func (suite *KeeperTestSuite) TestGetProtoRevArbitrageStatus() {
+	// Arbitrage is active by default
+	req := &types.QueryGetProtoRevArbitrageStatusRequest{}
+	res, err := suite.queryClient.GetProtoRevArbitrageStatus(sdk.WrapSDKContext(suite.Ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DefaultDisabledUntilHeight, res.DisabledUntilHeight)
+	suite.Require().True(res.Active)
+
+	// Arbitrage is inactive while the current height is below the disabled until height
+	disabledUntilHeight := uint64(suite.Ctx.BlockHeight() + 1)
+	suite.App.AppKeepers.ProtoRevKeeper.SetDisabledUntilHeight(suite.Ctx, disabledUntilHeight)
+	res, err = suite.queryClient.GetProtoRevArbitrageStatus(sdk.WrapSDKContext(suite.Ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(disabledUntilHeight, res.DisabledUntilHeight)
+	suite.Require().False(res.Active)
+}
+
+// TestGetProtoRevMaxTradesPerBlock tests the query to retrieve the max trades per block
+func (suite *KeeperTestSuite) TestGetProtoRevMaxTradesPerBlock() {
+	// Set the max trades per block
+	maxTrades := uint64(5)
+	suite.App.AppKeepers.ProtoRevKeeper.SetMaxTradesPerBlock(suite.Ctx, maxTrades)
+
+	req := &types.QueryGetProtoRevMaxTradesPerBlockRequest{}
+	res, err := suite.queryClient.GetProtoRevMaxTradesPerBlock(sdk.WrapSDKContext(suite.Ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(maxTrades, res.MaxTradesPerBlock)
+}
+
+// TestGetProtoRevArbConfig tests the query to retrieve the full arbitrage configuration and statistics
+func (suite *KeeperTestSuite) TestGetProtoRevArbConfig() {
+	req := &types.QueryGetProtoRevArbConfigRequest{}
+
+	// Before any trade the statistics are empty but the configuration is exported
+	res, err := suite.queryClient.GetProtoRevArbConfig(sdk.WrapSDKContext(suite.Ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.ArbConfig.RouteStatistics)
+	suite.Require().Empty(res.ArbConfig.Profits)
+	suite.Require().True(res.ArbConfig.NumberOfTrades.IsZero())
+
+	// The configuration mirrors the exported genesis state
+	exportedGenesis := suite.App.ProtoRevKeeper.ExportGenesis(suite.Ctx)
+	suite.Require().Equal(exportedGenesis.Params, res.ArbConfig.Genesis.Params)
+	suite.Require().Equal(exportedGenesis.PoolWeights, res.ArbConfig.Genesis.PoolWeights)
+	suite.Require().Equal(len(exportedGenesis.TokenPairArbRoutes), len(res.ArbConfig.Genesis.TokenPairArbRoutes))
+	suite.Require().Equal(len(exportedGenesis.BaseDenoms), len(res.ArbConfig.Genesis.BaseDenoms))
+	suite.Require().Equal(exportedGenesis.MaxPoolPointsPerTx, res.ArbConfig.Genesis.MaxPoolPointsPerTx)
+	suite.Require().Equal(exportedGenesis.MaxPoolPointsPerBlock, res.ArbConfig.Genesis.MaxPoolPointsPerBlock)
+
+	// Pseudo execute a trade
+	err = suite.App.AppKeepers.ProtoRevKeeper.UpdateStatistics(suite.Ctx, poolmanagertypes.SwapAmountInRoutes{{TokenOutDenom: "", PoolId: 1}, {TokenOutDenom: "", PoolId: 2}, {TokenOutDenom: "", PoolId: 3}}, types.OsmosisDenomination, sdk.NewInt(10000))
+	suite.Require().NoError(err)
+
+	res, err = suite.queryClient.GetProtoRevArbConfig(sdk.WrapSDKContext(suite.Ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.OneInt(), res.ArbConfig.NumberOfTrades)
+	osmoCoin := sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(10000))
+	suite.Require().Equal([]sdk.Coin{osmoCoin}, res.ArbConfig.Profits)
+	suite.Require().Equal(1, len(res.ArbConfig.RouteStatistics))
+	suite.Require().Equal([]uint64{1, 2, 3}, res.ArbConfig.RouteStatistics[0].Route)
+	suite.Require().Equal(sdk.OneInt(), res.ArbConfig.RouteStatistics[0].NumberOfTrades)
+	suite.Require().Contains(res.ArbConfig.RouteStatistics[0].Profits, osmoCoin)
+}
+
+// TestGetProtoRevArbitrageGasConsumed tests the query to retrieve the gas consumed by arbitrage backruns
+func (suite *KeeperTestSuite) TestGetProtoRevArbitrageGasConsumed() {
+	req := &types.QueryGetProtoRevArbitrageGasConsumedRequest{}
+
+	// Should be zero before any trade is executed
+	res, err := suite.queryClient.GetProtoRevArbitrageGasConsumed(sdk.WrapSDKContext(suite.Ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), res.TotalGasConsumed)
+	suite.Require().Equal(uint64(0), res.BlockGasConsumed)
+
+	// Should reflect both totals after gas is recorded
+	suite.App.ProtoRevKeeper.AddArbitrageGasConsumed(suite.Ctx, 1000)
+	suite.App.ProtoRevKeeper.SetArbitrageGasConsumedForBlock(suite.Ctx, 0)
+	suite.App.ProtoRevKeeper.AddArbitrageGasConsumed(suite.Ctx, 250)
+	res, err = suite.queryClient.GetProtoRevArbitrageGasConsumed(sdk.WrapSDKContext(suite.Ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1250), res.TotalGasConsumed)
+	suite.Require().Equal(uint64(250), res.BlockGasConsumed)
+}
+
+// TestGetProtoRevProfitSplit tests the query to retrieve the split of profits between the developer account and the protocol
+func (suite *KeeperTestSuite) TestGetProtoRevProfitSplit() {
+	req := &types.QueryGetProtoRevProfitSplitRequest{}
+
+	// Should be the phase 1 fraction with no profits before any trade is executed
+	res, err := suite.queryClient.GetProtoRevProfitSplit(sdk.WrapSDKContext(suite.Ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecWithPrec(types.ProfitSplitPhase1, 2), res.DeveloperFeeFraction)
+	suite.Require().True(res.DeveloperProfits.Empty())
+	suite.Require().True(res.ProtocolProfits.Empty())
+
+	// Should split the accumulated profits of every denom at the current fraction
+	err = suite.App.ProtoRevKeeper.UpdateProfitsByDenom(suite.Ctx, types.OsmosisDenomination, sdk.NewInt(1005))
+	suite.Require().NoError(err)
+	err = suite.App.ProtoRevKeeper.UpdateProfitsByDenom(suite.Ctx, "Atom", sdk.NewInt(100))
+	suite.Require().NoError(err)
+	res, err = suite.queryClient.GetProtoRevProfitSplit(sdk.WrapSDKContext(suite.Ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(201)), sdk.NewCoin("Atom", sdk.NewInt(20))), res.DeveloperProfits)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(804)), sdk.NewCoin("Atom", sdk.NewInt(80))), res.ProtocolProfits)
+
+	// Should use the fraction of the current phase
+	suite.App.ProtoRevKeeper.SetDaysSinceModuleGenesis(suite.Ctx, types.Phase2Length)
+	res, err = suite.queryClient.GetProtoRevProfitSplit(sdk.WrapSDKContext(suite.Ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecWithPrec(types.ProfitSplitPhase3, 2), res.DeveloperFeeFraction)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(50)), sdk.NewCoin("Atom", sdk.NewInt(5))), res.DeveloperProfits)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(955)), sdk.NewCoin("Atom", sdk.NewInt(95))), res.ProtocolProfits)
+}
+
+// TestGetProtoRevDeveloperFeeShare tests the query to retrieve the fraction of profit allocated to the developer account
+func (suite *KeeperTestSuite) TestGetProtoRevDeveloperFeeShare() {
+	req := &types.QueryGetProtoRevDeveloperFeeShareRequest{}
+
+	// Should follow the default schedule before governance sets a share
+	res, err := suite.queryClient.GetProtoRevDeveloperFeeShare(sdk.WrapSDKContext(suite.Ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecWithPrec(types.ProfitSplitPhase1, 2), res.DeveloperFeeShare)
+	suite.Require().False(res.SetByGovernance)
+
+	// Should return the share set by governance
+	err = suite.App.ProtoRevKeeper.SetDeveloperFeeShare(suite.Ctx, sdk.NewDecWithPrec(15, 2))
+	suite.Require().NoError(err)
+	res, err = suite.queryClient.GetProtoRevDeveloperFeeShare(sdk.WrapSDKContext(suite.Ctx), req)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecWithPrec(15, 2), res.DeveloperFeeShare)
+	suite.Require().True(res.SetByGovernance)
+}
@@ -15,9 +15,10 @@ import (
 
 type (
 	Keeper struct {
-		cdc        codec.BinaryCodec
-		storeKey   storetypes.StoreKey
-		paramstore paramtypes.Subspace
+		cdc          codec.BinaryCodec
+		storeKey     storetypes.StoreKey
+		transientKey *sdk.TransientStoreKey
+		paramstore   paramtypes.Subspace
 
 		accountKeeper     types.AccountKeeper
 		bankKeeper        types.BankKeeper
@@ -30,6 +31,7 @@ type (
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey sdk.StoreKey,
+	transientKey *sdk.TransientStoreKey,
 	ps paramtypes.Subspace,
 	accountKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
@@ -45,6 +47,7 @@ func NewKeeper(
 	return Keeper{
 		cdc:               cdc,
 		storeKey:          storeKey,
+		transientKey:      transientKey,
 		paramstore:        ps,
 		accountKeeper:     accountKeeper,
 		bankKeeper:        bankKeeper,
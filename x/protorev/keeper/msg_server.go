@@ -165,6 +165,20 @@ func (m MsgServer) SetBaseDenoms(c context.Context, msg *types.MsgSetBaseDenoms)
 	return &types.MsgSetBaseDenomsResponse{}, nil
 }
 
+// SetPoolBlacklist sets the pools that must never be included in arbitrage routes, replacing the previous blacklist
+func (m MsgServer) SetPoolBlacklist(c context.Context, msg *types.MsgSetPoolBlacklist) (*types.MsgSetPoolBlacklistResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// Ensure the account has the admin role and can make the tx
+	if err := m.AdminCheck(ctx, msg.Admin); err != nil {
+		return nil, err
+	}
+
+	m.k.SetPoolBlacklist(ctx, msg.PoolIds)
+
+	return &types.MsgSetPoolBlacklistResponse{}, nil
+}
+
 // AdminCheck ensures that the sender is the admin account.
 func (m MsgServer) AdminCheck(ctx sdk.Context, admin string) error {
 	sender, err := sdk.AccAddressFromBech32(admin)
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v15/app/apptesting"
@@ -162,39 +164,6 @@ func (suite *KeeperTestSuite) TestMsgSetHotRoutes() {
 			false,
 			false,
 		},
-		{
-			"Invalid message with nil step size (with proper hot routes)",
-			suite.adminAccount.String(),
-			[]types.TokenPairArbRoutes{
-				{
-					ArbRoutes: []types.Route{
-						{
-							Trades: []types.Trade{
-								{
-									Pool:     1,
-									TokenIn:  "Atom",
-									TokenOut: "Juno",
-								},
-								{
-									Pool:     0,
-									TokenIn:  "Juno",
-									TokenOut: types.OsmosisDenomination,
-								},
-								{
-									Pool:     3,
-									TokenIn:  types.OsmosisDenomination,
-									TokenOut: "Atom",
-								},
-							},
-						},
-					},
-					TokenIn:  types.OsmosisDenomination,
-					TokenOut: "Juno",
-				},
-			},
-			false,
-			false,
-		},
 	}
 
 	for _, tc := range testCases {
@@ -658,3 +627,83 @@ func (suite *KeeperTestSuite) TestMsgSetBaseDenoms() {
 		})
 	}
 }
+
+// TestMsgSetPoolBlacklist tests the MsgSetPoolBlacklist message.
+func (suite *KeeperTestSuite) TestMsgSetPoolBlacklist() {
+	cases := []struct {
+		description       string
+		admin             string
+		poolIds           []uint64
+		passValidateBasic bool
+		pass              bool
+	}{
+		{
+			"Invalid message (invalid admin)",
+			"admin",
+			[]uint64{1},
+			false,
+			false,
+		},
+		{
+			"Invalid message (duplicate pool id)",
+			suite.adminAccount.String(),
+			[]uint64{1, 1},
+			false,
+			false,
+		},
+		{
+			"Invalid message (wrong admin)",
+			apptesting.CreateRandomAccounts(1)[0].String(),
+			[]uint64{1},
+			true,
+			false,
+		},
+		{
+			"Valid message (correct admin)",
+			suite.adminAccount.String(),
+			[]uint64{3, 1},
+			true,
+			true,
+		},
+		{
+			"Valid message (correct admin, clears the blacklist)",
+			suite.adminAccount.String(),
+			[]uint64{},
+			true,
+			true,
+		},
+	}
+
+	for _, testCase := range cases {
+		suite.Run(testCase.description, func() {
+			// Start every case with a non-empty blacklist to check that it is replaced
+			suite.App.AppKeepers.ProtoRevKeeper.SetPoolBlacklist(suite.Ctx, []uint64{2})
+
+			msg := types.NewMsgSetPoolBlacklist(testCase.admin, testCase.poolIds)
+
+			err := msg.ValidateBasic()
+			if testCase.passValidateBasic {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				return
+			}
+
+			server := keeper.NewMsgServer(*suite.App.AppKeepers.ProtoRevKeeper)
+			wrappedCtx := sdk.WrapSDKContext(suite.Ctx)
+			response, err := server.SetPoolBlacklist(wrappedCtx, msg)
+			if testCase.pass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(response, &types.MsgSetPoolBlacklistResponse{})
+
+				// The blacklist is returned sorted by pool id
+				expectedPoolIds := append([]uint64{}, testCase.poolIds...)
+				sort.Slice(expectedPoolIds, func(i, j int) bool { return expectedPoolIds[i] < expectedPoolIds[j] })
+				suite.Require().Equal(expectedPoolIds, suite.App.AppKeepers.ProtoRevKeeper.GetPoolBlacklist(suite.Ctx))
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal([]uint64{2}, suite.App.AppKeepers.ProtoRevKeeper.GetPoolBlacklist(suite.Ctx))
+			}
+		})
+	}
+}
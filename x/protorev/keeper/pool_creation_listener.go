@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+)
+
+type PoolCreationListener struct {
+	k Keeper
+}
+
+var (
+	_ poolmanagertypes.PoolCreationListener = PoolCreationListener{}
+)
+
+func (k Keeper) PoolCreationListener() poolmanagertypes.PoolCreationListener {
+	return PoolCreationListener{k}
+}
+
+// AfterPoolCreated records the height at which the pool was created so that it can be excluded from
+// arbitrage routes until it is older than the minimum pool age.
+func (l PoolCreationListener) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	l.k.SetPoolCreationHeight(ctx, poolId, uint64(ctx.BlockHeight()))
+}
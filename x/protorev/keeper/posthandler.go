@@ -55,8 +55,14 @@ func (protoRevDec ProtoRevDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simu
 		return next(ctx, tx, simulate)
 	}
 
+	// The fee payer of the tx is rewarded with a fraction of the profit of any backrun it triggers
+	var searcher sdk.AccAddress
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		searcher = feeTx.FeePayer()
+	}
+
 	// Attempt to execute arbitrage trades
-	if err := protoRevDec.ProtoRevKeeper.ProtoRevTrade(cacheCtx, swappedPools); err == nil {
+	if err := protoRevDec.ProtoRevKeeper.ProtoRevTrade(cacheCtx, swappedPools, searcher); err == nil {
 		write()
 		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
 	} else {
@@ -73,6 +79,11 @@ func (k Keeper) AnteHandleCheck(ctx sdk.Context) error {
 		return fmt.Errorf("protorev is not enabled")
 	}
 
+	// Only execute the posthandler if arbitrage is not disabled at the current height
+	if !k.IsArbitrageActive(ctx) {
+		return fmt.Errorf("protorev arbitrage is disabled until height %d", k.GetDisabledUntilHeight(ctx))
+	}
+
 	latestBlockHeight, err := k.GetLatestBlockHeight(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to get latest block height")
@@ -95,17 +106,26 @@ func (k Keeper) AnteHandleCheck(ctx sdk.Context) error {
 			return fmt.Errorf("max pool points for the current block has been reached")
 		}
 	} else {
-		// Reset the current pool point count
+		// Reset the current pool point count, trade count, per pool backrun counts and arbitrage gas consumed
 		k.SetPointCountForBlock(ctx, 0)
+		k.SetTradeCountForBlock(ctx, 0)
+		k.ResetBackrunCountsForBlock(ctx)
+		k.SetArbitrageGasConsumedForBlock(ctx, 0)
 		k.SetLatestBlockHeight(ctx, blockHeight)
 	}
 
+	// Only execute the posthandler if the number of trades per block has not been reached
+	if k.IsTradeCapReachedForBlock(ctx) {
+		return fmt.Errorf("max trades for the current block has been reached")
+	}
+
 	return nil
 }
 
 // ProtoRevTrade wraps around the build routes, iterate routes, and execute trade functionality to execute cyclic arbitrage trades
-// if they exist. It returns an error if there was an issue executing any single trade.
-func (k Keeper) ProtoRevTrade(ctx sdk.Context, swappedPools []SwapToBackrun) (err error) {
+// if they exist. The searcher, if any, is rewarded for every trade executed. It returns an error if there was an issue
+// executing any single trade.
+func (k Keeper) ProtoRevTrade(ctx sdk.Context, swappedPools []SwapToBackrun, searcher sdk.AccAddress) (err error) {
 	// recover from panic
 	defer func() {
 		if r := recover(); r != nil {
@@ -113,6 +133,10 @@ func (k Keeper) ProtoRevTrade(ctx sdk.Context, swappedPools []SwapToBackrun) (er
 		}
 	}()
 
+	// Track the gas consumed by the whole backrun, including route building and simulation, so that the cost of
+	// arbitrage can be monitored
+	gasBefore := ctx.GasMeter().GasConsumed()
+
 	// Get the total number of pool points that can be consumed in this transaction
 	remainingPoolPoints, err := k.RemainingPoolPointsForTx(ctx)
 	if err != nil {
@@ -120,6 +144,16 @@ func (k Keeper) ProtoRevTrade(ctx sdk.Context, swappedPools []SwapToBackrun) (er
 	}
 	// Iterate and build arbitrage routes for each pool that was swapped on
 	for _, pool := range swappedPools {
+		// Stop searching for arbitrage opportunities once the maximum number of trades for the block has been executed
+		if k.IsTradeCapReachedForBlock(ctx) {
+			break
+		}
+
+		// Skip pools that have already been backrun the maximum number of times in the current block
+		if k.IsBackrunCapReachedForPool(ctx, pool.PoolId) {
+			continue
+		}
+
 		// Build the routes for the pool that was swapped on
 		routes := k.BuildRoutes(ctx, pool.TokenInDenom, pool.TokenOutDenom, pool.PoolId)
 
@@ -128,12 +162,17 @@ func (k Keeper) ProtoRevTrade(ctx sdk.Context, swappedPools []SwapToBackrun) (er
 
 		// The error that returns here is particularly focused on the minting/burning of coins, and the execution of the MultiHopSwapExactAmountIn.
 		if maxProfitAmount.GT(sdk.ZeroInt()) {
-			if err := k.ExecuteTrade(ctx, optimalRoute, maxProfitInputCoin); err != nil {
+			if err := k.ExecuteTrade(ctx, optimalRoute, maxProfitInputCoin, searcher); err != nil {
 				return err
 			}
+
+			k.IncrementTradeCountForBlock(ctx)
+			k.IncrementBackrunCountForPool(ctx, pool.PoolId)
 		}
 	}
 
+	k.AddArbitrageGasConsumed(ctx, ctx.GasMeter().GasConsumed()-gasBefore)
+
 	return nil
 }
 
@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/osmosis-labs/osmosis/v15/app/apptesting"
 	"github.com/osmosis-labs/osmosis/v15/x/protorev"
 	"github.com/osmosis-labs/osmosis/v15/x/protorev/types"
@@ -52,3 +54,46 @@ func (suite *KeeperTestSuite) TestSetProtoRevEnabledProposal() {
 	enabled = suite.App.ProtoRevKeeper.GetProtoRevEnabled(suite.Ctx)
 	suite.Require().False(enabled)
 }
+
+// TestSetProtoRevDeveloperFeeShareProposal tests that the developer fee share can be set through a proposal
+func (suite *KeeperTestSuite) TestSetProtoRevDeveloperFeeShareProposal() {
+	// Should not be set by default
+	_, found, err := suite.App.ProtoRevKeeper.GetDeveloperFeeShare(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().False(found)
+
+	// Set the developer fee share
+	share := sdk.NewDecWithPrec(3, 2)
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+	err = protorev.HandleSetProtoRevDeveloperFeeShare(suite.Ctx, *suite.App.ProtoRevKeeper, &types.SetProtoRevDeveloperFeeShareProposal{
+		Title:             "Updating the protorev developer fee share",
+		Description:       "This proposal is to update the protorev developer fee share",
+		DeveloperFeeShare: share,
+	})
+	suite.Require().NoError(err)
+
+	// Check that the developer fee share was updated and is used for the profit split
+	storedShare, found, err := suite.App.ProtoRevKeeper.GetDeveloperFeeShare(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(found)
+	suite.Require().Equal(share, storedShare)
+	profitSplit, err := suite.App.ProtoRevKeeper.GetDeveloperProfitSplit(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(share, profitSplit)
+
+	// Check that the change was emitted in its own event
+	suite.AssertEventEmitted(suite.Ctx, types.TypeEvtSetDeveloperFeeShare, 1)
+
+	// Attempt to set a share above one
+	err = protorev.HandleSetProtoRevDeveloperFeeShare(suite.Ctx, *suite.App.ProtoRevKeeper, &types.SetProtoRevDeveloperFeeShareProposal{
+		Title:             "Updating the protorev developer fee share",
+		Description:       "This proposal is to update the protorev developer fee share",
+		DeveloperFeeShare: sdk.NewDecWithPrec(11, 1),
+	})
+	suite.Require().Error(err)
+
+	// The share is unchanged
+	storedShare, _, err = suite.App.ProtoRevKeeper.GetDeveloperFeeShare(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(share, storedShare)
+}
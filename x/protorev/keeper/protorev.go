@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 
+	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v15/x/protorev/types"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
@@ -95,6 +97,40 @@ func (k Keeper) GetAllBaseDenoms(ctx sdk.Context) ([]types.BaseDenom, error) {
 	return baseDenoms, nil
 }
 
+// GetBaseDenomStepSize returns the step size of the binary search that is used to find the optimal swap amount for
+// routes starting and ending with the given base denom. Returns an error if the denom is not a base denom.
+func (k Keeper) GetBaseDenomStepSize(ctx sdk.Context, denom string) (sdk.Int, error) {
+	baseDenoms, err := k.GetAllBaseDenoms(ctx)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	for _, baseDenom := range baseDenoms {
+		if baseDenom.Denom == denom {
+			return baseDenom.StepSize, nil
+		}
+	}
+
+	return sdk.Int{}, fmt.Errorf("denom %s is not a base denom", denom)
+}
+
+// IsIntermediateOnlyBaseDenom returns true if the given denom is a base denom that may only be used as an intermediate hop
+// of a route, i.e. routes must never be seeded with it.
+func (k Keeper) IsIntermediateOnlyBaseDenom(ctx sdk.Context, denom string) (bool, error) {
+	baseDenoms, err := k.GetAllBaseDenoms(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	for _, baseDenom := range baseDenoms {
+		if baseDenom.Denom == denom {
+			return baseDenom.IntermediateOnly, nil
+		}
+	}
+
+	return false, nil
+}
+
 // SetBaseDenoms sets all of the base denoms used to build cyclic arbitrage routes. The base denoms priority
 // order is going to match the order of the base denoms in the slice.
 func (k Keeper) SetBaseDenoms(ctx sdk.Context, baseDenoms []types.BaseDenom) error {
@@ -146,6 +182,104 @@ func (k Keeper) DeleteAllPoolsForBaseDenom(ctx sdk.Context, baseDenom string) {
 	k.DeleteAllEntriesForKeyPrefix(ctx, key)
 }
 
+// GetAllPoolsForBaseDenom returns the ids of the highest liquidity pools between the given base denom and every denom it is matched with
+func (k Keeper) GetAllPoolsForBaseDenom(ctx sdk.Context, baseDenom string) []uint64 {
+	store := ctx.KVStore(k.storeKey)
+	key := append(types.KeyPrefixDenomPairToPool, types.GetKeyPrefixDenomPairToPool(baseDenom, "")...)
+	iterator := sdk.KVStorePrefixIterator(store, key)
+
+	defer iterator.Close()
+	poolIds := make([]uint64, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		poolIds = append(poolIds, sdk.BigEndianToUint64(iterator.Value()))
+	}
+
+	return poolIds
+}
+
+// GetMonitoredPools returns the sorted, deduplicated ids of all pools that the module considers for arbitrage.
+// These are the highest liquidity pools between each configured base denom and the denoms it is matched with,
+// alongside every pool that appears in a hot route.
+func (k Keeper) GetMonitoredPools(ctx sdk.Context) ([]uint64, error) {
+	seen := make(map[uint64]bool)
+	poolIds := make([]uint64, 0)
+	addPool := func(poolId uint64) {
+		// Hot routes use a pool id of 0 as a placeholder for the pool that was swapped against
+		if poolId == 0 || seen[poolId] {
+			return
+		}
+		seen[poolId] = true
+		poolIds = append(poolIds, poolId)
+	}
+
+	baseDenoms, err := k.GetAllBaseDenoms(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, baseDenom := range baseDenoms {
+		for _, poolId := range k.GetAllPoolsForBaseDenom(ctx, baseDenom.Denom) {
+			addPool(poolId)
+		}
+	}
+
+	tokenPairArbRoutes, err := k.GetAllTokenPairArbRoutes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, tokenPair := range tokenPairArbRoutes {
+		for _, route := range tokenPair.ArbRoutes {
+			for _, trade := range route.Trades {
+				addPool(trade.Pool)
+			}
+		}
+	}
+
+	sort.Slice(poolIds, func(i, j int) bool { return poolIds[i] < poolIds[j] })
+
+	return poolIds, nil
+}
+
+// GetPoolBlacklist returns the sorted ids of all pools that must never be included in arbitrage routes
+func (k Keeper) GetPoolBlacklist(ctx sdk.Context) []uint64 {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.KeyPrefixPoolBlacklist)
+
+	defer iterator.Close()
+	poolIds := make([]uint64, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		poolIds = append(poolIds, sdk.BigEndianToUint64(iterator.Key()[len(types.KeyPrefixPoolBlacklist):]))
+	}
+
+	return poolIds
+}
+
+// SetPoolBlacklist replaces the set of pools that must never be included in arbitrage routes
+func (k Keeper) SetPoolBlacklist(ctx sdk.Context, poolIds []uint64) {
+	k.DeleteAllEntriesForKeyPrefix(ctx, types.KeyPrefixPoolBlacklist)
+
+	store := ctx.KVStore(k.storeKey)
+	for _, poolId := range poolIds {
+		store.Set(types.GetKeyPrefixPoolBlacklist(poolId), []byte{1})
+	}
+}
+
+// IsPoolBlacklisted returns whether the pool must never be included in arbitrage routes
+func (k Keeper) IsPoolBlacklisted(ctx sdk.Context, poolId uint64) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.GetKeyPrefixPoolBlacklist(poolId))
+}
+
+// IsRouteBlacklisted returns whether any pool in the route is blacklisted
+func (k Keeper) IsRouteBlacklisted(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes) bool {
+	for _, pool := range route {
+		if k.IsPoolBlacklisted(ctx, pool.PoolId) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // DeleteAllEntriesForKeyPrefix deletes all the entries from the store for the given key prefix
 func (k Keeper) DeleteAllEntriesForKeyPrefix(ctx sdk.Context, keyPrefix []byte) {
 	store := ctx.KVStore(k.storeKey)
@@ -285,6 +419,202 @@ func (k Keeper) IncrementPointCountForBlock(ctx sdk.Context, amount uint64) erro
 	return nil
 }
 
+// GetMaxTradesPerBlock returns the maximum number of trades that can be executed per block. A value of 0
+// means that the number of trades per block is not capped.
+func (k Keeper) GetMaxTradesPerBlock(ctx sdk.Context) uint64 {
+	params := k.GetParams(ctx)
+	return params.MaxTradesPerBlock
+}
+
+// SetMaxTradesPerBlock sets the maximum number of trades that can be executed per block
+func (k Keeper) SetMaxTradesPerBlock(ctx sdk.Context, maxTrades uint64) {
+	params := k.GetParams(ctx)
+	params.MaxTradesPerBlock = maxTrades
+	k.SetParams(ctx, params)
+}
+
+// GetTradeCountForBlock returns the number of trades that have been executed in the current block. The count
+// is reset by the posthandler on the first transaction of every block, and an unset value is treated as no trades.
+func (k Keeper) GetTradeCountForBlock(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTradeCountForBlock)
+	bz := store.Get(types.KeyPrefixTradeCountForBlock)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetTradeCountForBlock sets the number of trades that have been executed in the current block
+func (k Keeper) SetTradeCountForBlock(ctx sdk.Context, tradeCount uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTradeCountForBlock)
+	store.Set(types.KeyPrefixTradeCountForBlock, sdk.Uint64ToBigEndian(tradeCount))
+}
+
+// IncrementTradeCountForBlock increments the number of trades that have been executed in the current block
+func (k Keeper) IncrementTradeCountForBlock(ctx sdk.Context) {
+	k.SetTradeCountForBlock(ctx, k.GetTradeCountForBlock(ctx)+1)
+}
+
+// GetSearcherRewardFraction returns the fraction of the profit of each backrun that is sent to the
+// fee payer of the transaction that triggered it
+func (k Keeper) GetSearcherRewardFraction(ctx sdk.Context) sdk.Dec {
+	params := k.GetParams(ctx)
+	return params.SearcherRewardFraction
+}
+
+// SetSearcherRewardFraction sets the fraction of the profit of each backrun that is sent to the
+// fee payer of the transaction that triggered it
+func (k Keeper) SetSearcherRewardFraction(ctx sdk.Context, fraction sdk.Dec) {
+	params := k.GetParams(ctx)
+	params.SearcherRewardFraction = fraction
+	k.SetParams(ctx, params)
+}
+
+// GetDisabledUntilHeight returns the block height until which arbitrage is disabled. A value of 0
+// means that arbitrage is not disabled by height.
+func (k Keeper) GetDisabledUntilHeight(ctx sdk.Context) uint64 {
+	params := k.GetParams(ctx)
+	return params.DisabledUntilHeight
+}
+
+// SetDisabledUntilHeight sets the block height until which arbitrage is disabled
+func (k Keeper) SetDisabledUntilHeight(ctx sdk.Context, height uint64) {
+	params := k.GetParams(ctx)
+	params.DisabledUntilHeight = height
+	k.SetParams(ctx, params)
+}
+
+// IsArbitrageActive returns true if the module is enabled and the current block height is not below
+// the height until which arbitrage is disabled
+func (k Keeper) IsArbitrageActive(ctx sdk.Context) bool {
+	params := k.GetParams(ctx)
+	return params.Enabled && uint64(ctx.BlockHeight()) >= params.DisabledUntilHeight
+}
+
+// IsTradeCapReachedForBlock returns true if the maximum number of trades for the current block has been executed
+func (k Keeper) IsTradeCapReachedForBlock(ctx sdk.Context) bool {
+	maxTrades := k.GetMaxTradesPerBlock(ctx)
+	return maxTrades != 0 && k.GetTradeCountForBlock(ctx) >= maxTrades
+}
+
+// GetMaxBackrunsPerPoolPerBlock returns the maximum number of backruns that can be executed per block for swaps
+// on any single pool. A value of 0 means that the number of backruns per pool per block is not capped.
+func (k Keeper) GetMaxBackrunsPerPoolPerBlock(ctx sdk.Context) uint64 {
+	params := k.GetParams(ctx)
+	return params.MaxBackrunsPerPoolPerBlock
+}
+
+// SetMaxBackrunsPerPoolPerBlock sets the maximum number of backruns that can be executed per block for swaps on any single pool
+func (k Keeper) SetMaxBackrunsPerPoolPerBlock(ctx sdk.Context, maxBackruns uint64) {
+	params := k.GetParams(ctx)
+	params.MaxBackrunsPerPoolPerBlock = maxBackruns
+	k.SetParams(ctx, params)
+}
+
+// GetBackrunCountForPool returns the number of backruns that have been executed for swaps on the given pool in the
+// current block. The counts are reset by the posthandler on the first transaction of every block, and an unset value
+// is treated as no backruns.
+func (k Keeper) GetBackrunCountForPool(ctx sdk.Context, poolId uint64) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyPrefixBackrunCountByPoolForBlock(poolId))
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// IncrementBackrunCountForPool increments the number of backruns that have been executed for swaps on the given pool in the current block
+func (k Keeper) IncrementBackrunCountForPool(ctx sdk.Context, poolId uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeyPrefixBackrunCountByPoolForBlock(poolId), sdk.Uint64ToBigEndian(k.GetBackrunCountForPool(ctx, poolId)+1))
+}
+
+// ResetBackrunCountsForBlock resets the number of backruns that have been executed for swaps on every pool
+func (k Keeper) ResetBackrunCountsForBlock(ctx sdk.Context) {
+	k.DeleteAllEntriesForKeyPrefix(ctx, types.KeyPrefixBackrunCountByPoolForBlock)
+}
+
+// IsBackrunCapReachedForPool returns true if the maximum number of backruns for swaps on the given pool in the current block has been executed
+func (k Keeper) IsBackrunCapReachedForPool(ctx sdk.Context, poolId uint64) bool {
+	maxBackruns := k.GetMaxBackrunsPerPoolPerBlock(ctx)
+	return maxBackruns != 0 && k.GetBackrunCountForPool(ctx, poolId) >= maxBackruns
+}
+
+// GetMinPoolAgeBlocks returns the minimum number of blocks that must have passed since a pool was created before it
+// can be included in arbitrage routes. A value of 0 means that pools are eligible as soon as they are created.
+func (k Keeper) GetMinPoolAgeBlocks(ctx sdk.Context) uint64 {
+	params := k.GetParams(ctx)
+	return params.MinPoolAgeBlocks
+}
+
+// SetMinPoolAgeBlocks sets the minimum number of blocks that must have passed since a pool was created before it can be included in arbitrage routes
+func (k Keeper) SetMinPoolAgeBlocks(ctx sdk.Context, minPoolAge uint64) {
+	params := k.GetParams(ctx)
+	params.MinPoolAgeBlocks = minPoolAge
+	k.SetParams(ctx, params)
+}
+
+// GetWeightRoutesByTVL returns whether routes with the same priority are searched in order of the combined total
+// value locked of their pools
+func (k Keeper) GetWeightRoutesByTVL(ctx sdk.Context) bool {
+	params := k.GetParams(ctx)
+	return params.WeightRoutesByTVL
+}
+
+// SetWeightRoutesByTVL sets whether routes with the same priority are searched in order of the combined total value locked of their pools
+func (k Keeper) SetWeightRoutesByTVL(ctx sdk.Context, weightByTVL bool) {
+	params := k.GetParams(ctx)
+	params.WeightRoutesByTVL = weightByTVL
+	k.SetParams(ctx, params)
+}
+
+// GetPoolCreationHeight returns the block height at which the given pool was created and whether it has been recorded.
+// Creation heights are only recorded for pools created after protorev started listening to pool creation.
+func (k Keeper) GetPoolCreationHeight(ctx sdk.Context, poolId uint64) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyPrefixPoolCreationHeight(poolId))
+	if bz == nil {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// SetPoolCreationHeight sets the block height at which the given pool was created
+func (k Keeper) SetPoolCreationHeight(ctx sdk.Context, poolId uint64, height uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetKeyPrefixPoolCreationHeight(poolId), sdk.Uint64ToBigEndian(height))
+}
+
+// IsPoolTooYoung returns whether fewer than the minimum number of blocks have passed since the pool was created.
+// Pools without a recorded creation height predate the tracking and are never considered too young.
+func (k Keeper) IsPoolTooYoung(ctx sdk.Context, poolId uint64) bool {
+	minPoolAge := k.GetMinPoolAgeBlocks(ctx)
+	if minPoolAge == 0 {
+		return false
+	}
+
+	creationHeight, found := k.GetPoolCreationHeight(ctx, poolId)
+	if !found {
+		return false
+	}
+
+	return uint64(ctx.BlockHeight()) < creationHeight+minPoolAge
+}
+
+// IsRouteTooYoung returns whether any pool in the route is younger than the minimum pool age
+func (k Keeper) IsRouteTooYoung(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes) bool {
+	for _, pool := range route {
+		if k.IsPoolTooYoung(ctx, pool.PoolId) {
+			return true
+		}
+	}
+
+	return false
+}
+
 // GetLatestBlockHeight returns the latest block height that protorev was run on
 func (k Keeper) GetLatestBlockHeight(ctx sdk.Context) (uint64, error) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixLatestBlockHeight)
@@ -337,6 +667,41 @@ func (k Keeper) SetDeveloperAccount(ctx sdk.Context, developerAccount sdk.AccAdd
 	store.Set(types.KeyPrefixDeveloperAccount, developerAccount.Bytes())
 }
 
+// GetDeveloperFeeShare returns the fraction of each trade's profit allocated to the developer account if it has been
+// set by governance. The second return value is false if it has not been set, in which case the default schedule applies.
+func (k Keeper) GetDeveloperFeeShare(ctx sdk.Context) (sdk.Dec, bool, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDeveloperFeeShare)
+	bz := store.Get(types.KeyPrefixDeveloperFeeShare)
+	if bz == nil {
+		return sdk.Dec{}, false, nil
+	}
+
+	share := sdk.Dec{}
+	if err := share.Unmarshal(bz); err != nil {
+		return sdk.Dec{}, false, err
+	}
+
+	return share, true, nil
+}
+
+// SetDeveloperFeeShare sets the fraction of each trade's profit allocated to the developer account, replacing the
+// default schedule
+func (k Keeper) SetDeveloperFeeShare(ctx sdk.Context, share sdk.Dec) error {
+	if err := types.ValidateDeveloperFeeShare(share); err != nil {
+		return err
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDeveloperFeeShare)
+	bz, err := share.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.KeyPrefixDeveloperFeeShare, bz)
+
+	return nil
+}
+
 // GetMaxPointsPerTx returns the max number of pool points that can be consumed per transaction. A pool point is roughly
 // equivalent to 1 ms of simulation & execution time.
 func (k Keeper) GetMaxPointsPerTx(ctx sdk.Context) (uint64, error) {
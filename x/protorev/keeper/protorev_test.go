@@ -3,6 +3,7 @@ package keeper_test
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/v15/x/protorev/keeper"
 	"github.com/osmosis-labs/osmosis/v15/x/protorev/types"
 )
 
@@ -81,6 +82,28 @@ func (suite *KeeperTestSuite) TestGetAllBaseDenoms() {
 	suite.Require().Equal(baseDenoms[2].Denom, "weth")
 }
 
+// TestGetBaseDenomStepSize tests the GetBaseDenomStepSize function.
+func (suite *KeeperTestSuite) TestGetBaseDenomStepSize() {
+	err := suite.App.ProtoRevKeeper.SetBaseDenoms(suite.Ctx, []types.BaseDenom{
+		{Denom: types.OsmosisDenomination, StepSize: sdk.NewInt(1_000_000)},
+		{Denom: "Atom", StepSize: sdk.NewInt(5_000)},
+	})
+	suite.Require().NoError(err)
+
+	// Each base denom has its own step size
+	stepSize, err := suite.App.ProtoRevKeeper.GetBaseDenomStepSize(suite.Ctx, types.OsmosisDenomination)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(1_000_000), stepSize)
+
+	stepSize, err = suite.App.ProtoRevKeeper.GetBaseDenomStepSize(suite.Ctx, "Atom")
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(5_000), stepSize)
+
+	// Denoms that are not base denoms have no step size
+	_, err = suite.App.ProtoRevKeeper.GetBaseDenomStepSize(suite.Ctx, "weth")
+	suite.Require().Error(err)
+}
+
 // TestGetPoolForDenomPair tests the GetPoolForDenomPair, SetPoolForDenomPair, and DeleteAllPoolsForBaseDenom functions.
 func (suite *KeeperTestSuite) TestGetPoolForDenomPair() {
 	// Should be able to set a pool for a denom pair
@@ -113,6 +136,54 @@ func (suite *KeeperTestSuite) TestGetPoolForDenomPair() {
 	suite.Require().Equal(uint64(3000), pool)
 }
 
+// TestGetMonitoredPools tests the GetMonitoredPools function.
+func (suite *KeeperTestSuite) TestGetMonitoredPools() {
+	// Start from a clean slate of base denoms and hot routes
+	baseDenoms, err := suite.App.ProtoRevKeeper.GetAllBaseDenoms(suite.Ctx)
+	suite.Require().NoError(err)
+	for _, baseDenom := range baseDenoms {
+		suite.App.ProtoRevKeeper.DeleteAllPoolsForBaseDenom(suite.Ctx, baseDenom.Denom)
+	}
+	suite.App.ProtoRevKeeper.DeleteBaseDenoms(suite.Ctx)
+	suite.App.ProtoRevKeeper.DeleteAllTokenPairArbRoutes(suite.Ctx)
+
+	poolIds, err := suite.App.ProtoRevKeeper.GetMonitoredPools(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Empty(poolIds)
+
+	// Pools matched with a base denom are monitored
+	err = suite.App.ProtoRevKeeper.SetBaseDenoms(suite.Ctx, []types.BaseDenom{{Denom: types.OsmosisDenomination, StepSize: sdk.NewInt(1_000_000)}})
+	suite.Require().NoError(err)
+	suite.App.ProtoRevKeeper.SetPoolForDenomPair(suite.Ctx, types.OsmosisDenomination, "Atom", 3)
+	suite.App.ProtoRevKeeper.SetPoolForDenomPair(suite.Ctx, types.OsmosisDenomination, "weth", 1)
+
+	// Pools matched with a denom that is not a base denom are not monitored
+	suite.App.ProtoRevKeeper.SetPoolForDenomPair(suite.Ctx, "Atom", "weth", 50)
+
+	// Pools in hot routes are monitored, deduplicated and without the placeholder pool
+	err = suite.App.ProtoRevKeeper.SetTokenPairArbRoutes(suite.Ctx, "Atom", types.OsmosisDenomination, types.TokenPairArbRoutes{
+		TokenIn:  "Atom",
+		TokenOut: types.OsmosisDenomination,
+		ArbRoutes: []types.Route{{
+			Trades: []types.Trade{
+				{Pool: 0, TokenIn: types.OsmosisDenomination, TokenOut: "Atom"},
+				{Pool: 7, TokenIn: "Atom", TokenOut: "weth"},
+				{Pool: 3, TokenIn: "weth", TokenOut: types.OsmosisDenomination},
+			},
+		}},
+	})
+	suite.Require().NoError(err)
+
+	poolIds, err = suite.App.ProtoRevKeeper.GetMonitoredPools(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{1, 3, 7}, poolIds)
+
+	// The query returns the same pools
+	res, err := suite.queryClient.GetProtoRevMonitoredPools(sdk.WrapSDKContext(suite.Ctx), &types.QueryGetProtoRevMonitoredPoolsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{1, 3, 7}, res.PoolIds)
+}
+
 // TestGetDaysSinceModuleGenesis tests the GetDaysSinceModuleGenesis and SetDaysSinceModuleGenesis functions.
 func (suite *KeeperTestSuite) TestGetDaysSinceModuleGenesis() {
 	// Should be initialized to 0 on genesis
@@ -319,3 +390,144 @@ func (suite *KeeperTestSuite) TestGetPoolWeights() {
 	poolWeights = suite.App.ProtoRevKeeper.GetPoolWeights(suite.Ctx)
 	suite.Require().Equal(newRouteWeights, poolWeights)
 }
+
+// TestGetTradeCountForBlock tests the GetTradeCountForBlock, IncrementTradeCountForBlock and
+// IsTradeCapReachedForBlock functions.
+func (suite *KeeperTestSuite) TestGetTradeCountForBlock() {
+	// Should be zero if no trades have been executed in the block
+	suite.App.ProtoRevKeeper.SetTradeCountForBlock(suite.Ctx, 0)
+	suite.Require().Equal(uint64(0), suite.App.ProtoRevKeeper.GetTradeCountForBlock(suite.Ctx))
+
+	// Trades are not capped by default
+	suite.Require().Equal(types.DefaultMaxTradesPerBlock, suite.App.ProtoRevKeeper.GetMaxTradesPerBlock(suite.Ctx))
+	suite.App.ProtoRevKeeper.IncrementTradeCountForBlock(suite.Ctx)
+	suite.App.ProtoRevKeeper.IncrementTradeCountForBlock(suite.Ctx)
+	suite.Require().Equal(uint64(2), suite.App.ProtoRevKeeper.GetTradeCountForBlock(suite.Ctx))
+	suite.Require().False(suite.App.ProtoRevKeeper.IsTradeCapReachedForBlock(suite.Ctx))
+
+	// Should be capped once the max trades per block is reached
+	suite.App.ProtoRevKeeper.SetMaxTradesPerBlock(suite.Ctx, 3)
+	suite.Require().False(suite.App.ProtoRevKeeper.IsTradeCapReachedForBlock(suite.Ctx))
+	suite.App.ProtoRevKeeper.IncrementTradeCountForBlock(suite.Ctx)
+	suite.Require().True(suite.App.ProtoRevKeeper.IsTradeCapReachedForBlock(suite.Ctx))
+
+	// The posthandler should not execute once the cap is reached
+	err := suite.App.ProtoRevKeeper.AnteHandleCheck(suite.Ctx)
+	suite.Require().Error(err)
+
+	// The count is reset by the posthandler on the first transaction of a new block, allowing trades again
+	latestBlockHeight, err := suite.App.ProtoRevKeeper.GetLatestBlockHeight(suite.Ctx)
+	suite.Require().NoError(err)
+	ctx := suite.Ctx.WithBlockHeight(int64(latestBlockHeight) + 1)
+	err = suite.App.ProtoRevKeeper.AnteHandleCheck(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), suite.App.ProtoRevKeeper.GetTradeCountForBlock(ctx))
+	suite.Require().False(suite.App.ProtoRevKeeper.IsTradeCapReachedForBlock(ctx))
+}
+
+// TestIsArbitrageActive tests the GetDisabledUntilHeight, SetDisabledUntilHeight and IsArbitrageActive functions.
+func (suite *KeeperTestSuite) TestIsArbitrageActive() {
+	ctx := suite.Ctx.WithBlockHeight(100)
+
+	// Arbitrage is not disabled by height by default
+	suite.Require().Equal(types.DefaultDisabledUntilHeight, suite.App.ProtoRevKeeper.GetDisabledUntilHeight(ctx))
+	suite.Require().True(suite.App.ProtoRevKeeper.IsArbitrageActive(ctx))
+
+	// Arbitrage is disabled while the current height is below the disabled until height
+	suite.App.ProtoRevKeeper.SetDisabledUntilHeight(ctx, 101)
+	suite.Require().Equal(uint64(101), suite.App.ProtoRevKeeper.GetDisabledUntilHeight(ctx))
+	suite.Require().False(suite.App.ProtoRevKeeper.IsArbitrageActive(ctx))
+
+	// The posthandler should not execute while arbitrage is disabled
+	err := suite.App.ProtoRevKeeper.AnteHandleCheck(ctx)
+	suite.Require().Error(err)
+
+	// Arbitrage resumes once the disabled until height is reached
+	ctx = ctx.WithBlockHeight(101)
+	suite.Require().True(suite.App.ProtoRevKeeper.IsArbitrageActive(ctx))
+
+	// Arbitrage is never active if the module is disabled
+	suite.App.ProtoRevKeeper.SetProtoRevEnabled(ctx, false)
+	suite.Require().False(suite.App.ProtoRevKeeper.IsArbitrageActive(ctx))
+}
+
+// TestBackrunCountForPool tests the GetBackrunCountForPool, IncrementBackrunCountForPool, ResetBackrunCountsForBlock and
+// IsBackrunCapReachedForPool functions, and that ProtoRevTrade does not backrun pools whose cap has been reached.
+func (suite *KeeperTestSuite) TestBackrunCountForPool() {
+	// Should be zero if no backruns have been executed in the block
+	suite.Require().Equal(uint64(0), suite.App.ProtoRevKeeper.GetBackrunCountForPool(suite.Ctx, 23))
+
+	// Backruns are not capped by default
+	suite.Require().Equal(types.DefaultMaxBackrunsPerPoolPerBlock, suite.App.ProtoRevKeeper.GetMaxBackrunsPerPoolPerBlock(suite.Ctx))
+	suite.App.ProtoRevKeeper.IncrementBackrunCountForPool(suite.Ctx, 23)
+	suite.App.ProtoRevKeeper.IncrementBackrunCountForPool(suite.Ctx, 23)
+	suite.Require().Equal(uint64(2), suite.App.ProtoRevKeeper.GetBackrunCountForPool(suite.Ctx, 23))
+	suite.Require().False(suite.App.ProtoRevKeeper.IsBackrunCapReachedForPool(suite.Ctx, 23))
+
+	// Should be capped once the max backruns per pool per block is reached, independently for each pool
+	suite.App.ProtoRevKeeper.SetMaxBackrunsPerPoolPerBlock(suite.Ctx, 2)
+	suite.Require().True(suite.App.ProtoRevKeeper.IsBackrunCapReachedForPool(suite.Ctx, 23))
+	suite.Require().False(suite.App.ProtoRevKeeper.IsBackrunCapReachedForPool(suite.Ctx, 24))
+
+	// A swap on a capped pool is not backrun even if there is an arbitrage opportunity
+	swappedPools := []keeper.SwapToBackrun{{
+		PoolId:        23,
+		TokenInDenom:  "ibc/0EF15DF2F02480ADE0BB6E85D9EBB5DAEA2836D3860E9F97F9AADE4F57A31AA0",
+		TokenOutDenom: "ibc/BE1BB42D4BE3C30D50B68D7C41DB4DFCE9678E8EF8C539F6E6A9345048894FCC",
+	}}
+	suite.App.ProtoRevKeeper.SetTradeCountForBlock(suite.Ctx, 0)
+	cacheCtx, _ := suite.Ctx.CacheContext()
+	err := suite.App.ProtoRevKeeper.ProtoRevTrade(cacheCtx, swappedPools, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), suite.App.ProtoRevKeeper.GetTradeCountForBlock(cacheCtx))
+
+	// The counts are reset by the posthandler on the first transaction of a new block, allowing backruns again
+	latestBlockHeight, err := suite.App.ProtoRevKeeper.GetLatestBlockHeight(suite.Ctx)
+	suite.Require().NoError(err)
+	ctx := suite.Ctx.WithBlockHeight(int64(latestBlockHeight) + 1)
+	err = suite.App.ProtoRevKeeper.AnteHandleCheck(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), suite.App.ProtoRevKeeper.GetBackrunCountForPool(ctx, 23))
+	suite.Require().False(suite.App.ProtoRevKeeper.IsBackrunCapReachedForPool(ctx, 23))
+
+	err = suite.App.ProtoRevKeeper.ProtoRevTrade(ctx, swappedPools, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), suite.App.ProtoRevKeeper.GetTradeCountForBlock(ctx))
+	suite.Require().Equal(uint64(1), suite.App.ProtoRevKeeper.GetBackrunCountForPool(ctx, 23))
+}
+
+// TestMinPoolAge tests that pools younger than the min pool age are excluded from arbitrage routes
+func (suite *KeeperTestSuite) TestMinPoolAge() {
+	tokenIn := "ibc/0EF15DF2F02480ADE0BB6E85D9EBB5DAEA2836D3860E9F97F9AADE4F57A31AA0"
+	tokenOut := "ibc/BE1BB42D4BE3C30D50B68D7C41DB4DFCE9678E8EF8C539F6E6A9345048894FCC"
+
+	// Pools created through the pool manager have their creation height recorded
+	creationHeight, found := suite.App.ProtoRevKeeper.GetPoolCreationHeight(suite.Ctx, 23)
+	suite.Require().True(found)
+	suite.Require().LessOrEqual(creationHeight, uint64(suite.Ctx.BlockHeight()))
+
+	// Pools are not considered too young by default
+	suite.Require().Equal(types.DefaultMinPoolAgeBlocks, suite.App.ProtoRevKeeper.GetMinPoolAgeBlocks(suite.Ctx))
+	suite.Require().False(suite.App.ProtoRevKeeper.IsPoolTooYoung(suite.Ctx, 23))
+	routes := suite.App.ProtoRevKeeper.BuildRoutes(suite.Ctx, tokenIn, tokenOut, 23)
+	suite.Require().NotEmpty(routes)
+
+	// Routes touching a pool younger than the min pool age are dropped
+	minPoolAge := uint64(100)
+	suite.App.ProtoRevKeeper.SetMinPoolAgeBlocks(suite.Ctx, minPoolAge)
+	suite.Require().Equal(minPoolAge, suite.App.ProtoRevKeeper.GetParams(suite.Ctx).MinPoolAgeBlocks)
+	suite.Ctx = suite.Ctx.WithBlockHeight(int64(creationHeight + minPoolAge - 1))
+	suite.Require().True(suite.App.ProtoRevKeeper.IsPoolTooYoung(suite.Ctx, 23))
+	suite.Require().True(suite.App.ProtoRevKeeper.IsRouteTooYoung(suite.Ctx, routes[0].Route))
+	suite.Require().Empty(suite.App.ProtoRevKeeper.BuildRoutes(suite.Ctx, tokenIn, tokenOut, 23))
+
+	// Pools without a recorded creation height are never considered too young
+	_, found = suite.App.ProtoRevKeeper.GetPoolCreationHeight(suite.Ctx, 9999)
+	suite.Require().False(found)
+	suite.Require().False(suite.App.ProtoRevKeeper.IsPoolTooYoung(suite.Ctx, 9999))
+
+	// Once the pool is old enough its routes are built again
+	suite.Ctx = suite.Ctx.WithBlockHeight(int64(creationHeight + minPoolAge))
+	suite.Require().False(suite.App.ProtoRevKeeper.IsPoolTooYoung(suite.Ctx, 23))
+	suite.Require().Equal(len(routes), len(suite.App.ProtoRevKeeper.BuildRoutes(suite.Ctx, tokenIn, tokenOut, 23)))
+}
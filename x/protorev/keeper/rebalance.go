@@ -1,7 +1,11 @@
 package keeper
 
 import (
+	"fmt"
+	"sort"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"golang.org/x/exp/slices"
 
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 	"github.com/osmosis-labs/osmosis/v15/x/protorev/types"
@@ -21,6 +25,16 @@ func (k Keeper) IterateRoutes(ctx sdk.Context, routes []RouteMetaData, remaining
 			continue
 		}
 
+		// Never search a route that touches a blacklisted pool, even if it was built before the pool was blacklisted
+		if k.IsRouteBlacklisted(ctx, routes[index].Route) {
+			continue
+		}
+
+		// Record the attempt so that the route's success rate can be used to order future searches
+		if err := k.IncrementAttemptsByRoute(ctx, routes[index].Route.PoolIds()); err != nil {
+			k.Logger(ctx).Error("Error incrementing attempts by route: ", err)
+		}
+
 		// Find the max profit for the route if it exists
 		inputCoin, profit, err := k.FindMaxProfitForRoute(ctx, routes[index], remainingPoolPoints)
 		if err != nil {
@@ -28,6 +42,11 @@ func (k Keeper) IterateRoutes(ctx sdk.Context, routes []RouteMetaData, remaining
 			continue
 		}
 
+		// Skip the route if its profit falls below its min profit threshold, as the profit may not cover the cost of the backrun
+		if !routes[index].MinProfitThreshold.IsNil() && profit.LT(routes[index].MinProfitThreshold) {
+			continue
+		}
+
 		// If the profit is greater than zero, then we convert the profits to uosmo and compare profits in terms of uosmo
 		if profit.GT(sdk.ZeroInt()) {
 			if inputCoin.Denom != types.OsmosisDenomination {
@@ -95,8 +114,24 @@ func (k Keeper) EstimateMultihopProfit(ctx sdk.Context, inputDenom string, amoun
 	return tokenIn, profit, nil
 }
 
+// estimateMultihopProfitWithTrace estimates the profit for a given route exactly like EstimateMultihopProfit and, if
+// trace is not nil, records the input and the resulting profit as a step of the search.
+func (k Keeper) estimateMultihopProfitWithTrace(ctx sdk.Context, inputDenom string, amount sdk.Int, route poolmanagertypes.SwapAmountInRoutes, trace *[]types.ProfitSearchStep) (sdk.Coin, sdk.Int, error) {
+	tokenIn, profit, err := k.EstimateMultihopProfit(ctx, inputDenom, amount, route)
+	if err == nil && trace != nil {
+		*trace = append(*trace, types.ProfitSearchStep{Input: tokenIn, Profit: profit})
+	}
+	return tokenIn, profit, err
+}
+
 // FindMaxProfitRoute runs a binary search to find the max profit for a given route
 func (k Keeper) FindMaxProfitForRoute(ctx sdk.Context, route RouteMetaData, remainingPoolPoints *uint64) (sdk.Coin, sdk.Int, error) {
+	return k.findMaxProfitForRoute(ctx, route, remainingPoolPoints, nil)
+}
+
+// findMaxProfitForRoute runs the binary search of FindMaxProfitForRoute. If trace is not nil, every input tried
+// by the search is recorded in it alongside the resulting profit, in the order the inputs were tried.
+func (k Keeper) findMaxProfitForRoute(ctx sdk.Context, route RouteMetaData, remainingPoolPoints *uint64, trace *[]types.ProfitSearchStep) (sdk.Coin, sdk.Int, error) {
 	// Track the tokenIn amount/denom and the profit
 	tokenIn := sdk.Coin{}
 	profit := sdk.ZeroInt()
@@ -108,10 +143,16 @@ func (k Keeper) FindMaxProfitForRoute(ctx sdk.Context, route RouteMetaData, rema
 	// Input denom used for cyclic arbitrage
 	inputDenom := route.Route[route.Route.Length()-1].TokenOutDenom
 
+	// If the route's input cap is below the minimum amount in, then the route cannot be traded
+	maxInputSteps, hasInputCap := route.maxInputSteps()
+	if hasInputCap && maxInputSteps.LT(curLeft) {
+		return sdk.Coin{}, sdk.ZeroInt(), nil
+	}
+
 	// If a cyclic arb exists with an optimal amount in above our minimum amount in,
 	// then inputting the minimum amount in will result in a profit. So we check for that first.
 	// If there is no profit, then we can return early and not run the binary search.
-	_, minInProfit, err := k.EstimateMultihopProfit(ctx, inputDenom, curLeft.Mul(route.StepSize), route.Route)
+	_, minInProfit, err := k.estimateMultihopProfitWithTrace(ctx, inputDenom, curLeft.Mul(route.StepSize), route.Route, trace)
 	if err != nil {
 		return sdk.Coin{}, sdk.ZeroInt(), err
 	} else if minInProfit.LTE(sdk.ZeroInt()) {
@@ -125,8 +166,21 @@ func (k Keeper) FindMaxProfitForRoute(ctx sdk.Context, route RouteMetaData, rema
 		return sdk.Coin{}, sdk.ZeroInt(), err
 	}
 
-	// Extend the search range if the max input amount is too small
-	curLeft, curRight = k.ExtendSearchRangeIfNeeded(ctx, route, inputDenom, curLeft, curRight)
+	if hasInputCap && maxInputSteps.LTE(curRight) {
+		// Clamp the search range to the route's input cap, which is within the default range
+		curRight = maxInputSteps
+	} else {
+		// Extend the search range if the max input amount is too small, without exceeding the route's input cap
+		curLeft, curRight = k.extendSearchRangeIfNeeded(ctx, route, inputDenom, curLeft, curRight, trace)
+		if hasInputCap && curRight.GT(maxInputSteps) {
+			curRight = maxInputSteps
+		}
+	}
+
+	// If the search range is a single amount, then that amount is the optimal one
+	if curLeft.Equal(curRight) {
+		return k.estimateMultihopProfitWithTrace(ctx, inputDenom, curLeft.Mul(route.StepSize), route.Route, trace)
+	}
 
 	// Binary search to find the max profit
 	for iteration := 0; curLeft.LT(curRight) && iteration < types.MaxIterations; iteration++ {
@@ -134,13 +188,13 @@ func (k Keeper) FindMaxProfitForRoute(ctx sdk.Context, route RouteMetaData, rema
 		curMidPlusOne := curMid.Add(sdk.OneInt())
 
 		// Short circuit profit searching if there is an error in the GAMM module
-		tokenInMid, profitMid, err := k.EstimateMultihopProfit(ctx, inputDenom, curMid.Mul(route.StepSize), route.Route)
+		tokenInMid, profitMid, err := k.estimateMultihopProfitWithTrace(ctx, inputDenom, curMid.Mul(route.StepSize), route.Route, trace)
 		if err != nil {
 			return sdk.Coin{}, sdk.ZeroInt(), err
 		}
 
 		// Short circuit profit searching if there is an error in the GAMM module
-		tokenInMidPlusOne, profitMidPlusOne, err := k.EstimateMultihopProfit(ctx, inputDenom, curMidPlusOne.Mul(route.StepSize), route.Route)
+		tokenInMidPlusOne, profitMidPlusOne, err := k.estimateMultihopProfitWithTrace(ctx, inputDenom, curMidPlusOne.Mul(route.StepSize), route.Route, trace)
 		if err != nil {
 			return sdk.Coin{}, sdk.ZeroInt(), err
 		}
@@ -162,8 +216,14 @@ func (k Keeper) FindMaxProfitForRoute(ctx sdk.Context, route RouteMetaData, rema
 
 // Determine if the binary search range needs to be extended
 func (k Keeper) ExtendSearchRangeIfNeeded(ctx sdk.Context, route RouteMetaData, inputDenom string, curLeft, curRight sdk.Int) (sdk.Int, sdk.Int) {
+	return k.extendSearchRangeIfNeeded(ctx, route, inputDenom, curLeft, curRight, nil)
+}
+
+// extendSearchRangeIfNeeded determines if the binary search range needs to be extended, recording the inputs tried
+// in trace if it is not nil.
+func (k Keeper) extendSearchRangeIfNeeded(ctx sdk.Context, route RouteMetaData, inputDenom string, curLeft, curRight sdk.Int, trace *[]types.ProfitSearchStep) (sdk.Int, sdk.Int) {
 	// Get the profit for the maximum amount in
-	_, maxInProfit, err := k.EstimateMultihopProfit(ctx, inputDenom, curRight.Mul(route.StepSize), route.Route)
+	_, maxInProfit, err := k.estimateMultihopProfitWithTrace(ctx, inputDenom, curRight.Mul(route.StepSize), route.Route, trace)
 	if err != nil {
 		return curLeft, curRight
 	}
@@ -171,7 +231,7 @@ func (k Keeper) ExtendSearchRangeIfNeeded(ctx sdk.Context, route RouteMetaData,
 	// If the profit for the maximum amount in is still increasing, then we can increase the range of the binary search
 	if maxInProfit.GTE(sdk.ZeroInt()) {
 		// Get the profit for the maximum amount in + 1
-		_, maxInProfitPlusOne, err := k.EstimateMultihopProfit(ctx, inputDenom, curRight.Add(sdk.OneInt()).Mul(route.StepSize), route.Route)
+		_, maxInProfitPlusOne, err := k.estimateMultihopProfitWithTrace(ctx, inputDenom, curRight.Add(sdk.OneInt()).Mul(route.StepSize), route.Route, trace)
 		if err != nil {
 			return curLeft, curRight
 		}
@@ -186,8 +246,9 @@ func (k Keeper) ExtendSearchRangeIfNeeded(ctx sdk.Context, route RouteMetaData,
 	return curLeft, curRight
 }
 
-// ExecuteTrade inputs a route, amount in, and rebalances the pool
-func (k Keeper) ExecuteTrade(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes, inputCoin sdk.Coin) error {
+// ExecuteTrade inputs a route, amount in, and rebalances the pool. If a searcher is provided, the configured
+// fraction of the profit is sent to them and only the remainder is counted as module profit.
+func (k Keeper) ExecuteTrade(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes, inputCoin sdk.Coin, searcher sdk.AccAddress) error {
 	// Get the module address which will execute the trade
 	protorevModuleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
 
@@ -210,6 +271,13 @@ func (k Keeper) ExecuteTrade(ctx sdk.Context, route poolmanagertypes.SwapAmountI
 	// Profit from the trade
 	profit := tokenOutAmount.Sub(inputCoin.Amount)
 
+	// Reward the searcher that triggered the backrun
+	reward, err := k.RewardSearcher(ctx, route, searcher, sdk.NewCoin(inputCoin.Denom, profit))
+	if err != nil {
+		return err
+	}
+	profit = profit.Sub(reward.Amount)
+
 	// Update the module statistics stores
 	if err = k.UpdateStatistics(ctx, route, inputCoin.Denom, profit); err != nil {
 		return err
@@ -223,6 +291,42 @@ func (k Keeper) ExecuteTrade(ctx sdk.Context, route poolmanagertypes.SwapAmountI
 	return nil
 }
 
+// RewardSearcher sends the searcher reward fraction of the profit to the searcher and emits an event
+// attributing the reward. It returns the reward that was sent, which is zero if no searcher is
+// provided or the fraction is zero.
+func (k Keeper) RewardSearcher(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes, searcher sdk.AccAddress, profit sdk.Coin) (sdk.Coin, error) {
+	reward := sdk.NewCoin(profit.Denom, sdk.ZeroInt())
+	if searcher.Empty() || !profit.IsPositive() {
+		return reward, nil
+	}
+
+	fraction := k.GetSearcherRewardFraction(ctx)
+	if fraction.IsNil() || !fraction.IsPositive() {
+		return reward, nil
+	}
+
+	reward.Amount = profit.Amount.ToDec().Mul(fraction).TruncateInt()
+	if !reward.IsPositive() {
+		return reward, nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, searcher, sdk.NewCoins(reward)); err != nil {
+		return reward, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEvtSearcherReward,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySearcher, searcher.String()),
+			sdk.NewAttribute(types.AttributeKeyReward, reward.String()),
+			sdk.NewAttribute(types.AttributeKeyRoute, fmt.Sprint(route.PoolIds())),
+		),
+	)
+
+	return reward, nil
+}
+
 // RemainingPoolPointsForTx calculates the number of pool points that can be consumed in the current transaction.
 func (k Keeper) RemainingPoolPointsForTx(ctx sdk.Context) (uint64, error) {
 	maxRoutesPerTx, err := k.GetMaxPointsPerTx(ctx)
@@ -254,3 +358,319 @@ func (k Keeper) RemainingPoolPointsForTx(ctx sdk.Context) (uint64, error) {
 
 	return numberOfIterableRoutes, nil
 }
+
+// rankedOpportunity is a profitable arbitrage opportunity alongside its profit valued in uosmo, used to rank it.
+type rankedOpportunity struct {
+	opportunity types.ArbitrageOpportunity
+	uosmoProfit sdk.Int
+}
+
+// CurrentArbitrageOpportunities runs the route search for every pool the module monitors against the current state and
+// returns up to limit profitable opportunities, sorted by their profit valued in uosmo in descending order. A limit of 0
+// returns all of the opportunities found. The search is bounded by the pool points remaining for a transaction and is
+// run in a cache context, so no trades are executed and no state is written.
+func (k Keeper) CurrentArbitrageOpportunities(ctx sdk.Context, limit uint64) (opportunities []types.ArbitrageOpportunity, err error) {
+	// recover from panic
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("Protorev failed due to internal reason: %v", r)
+		}
+	}()
+
+	cacheCtx, _ := ctx.CacheContext()
+
+	remainingPoolPoints, err := k.RemainingPoolPointsForTx(cacheCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	poolIds, err := k.GetMonitoredPools(cacheCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	ranked := k.searchArbitrageOpportunities(cacheCtx, poolIds, &remainingPoolPoints)
+
+	if limit > 0 && uint64(len(ranked)) > limit {
+		ranked = ranked[:limit]
+	}
+
+	opportunities = make([]types.ArbitrageOpportunity, 0, len(ranked))
+	for _, r := range ranked {
+		opportunities = append(opportunities, r.opportunity)
+	}
+
+	return opportunities, nil
+}
+
+// searchArbitrageOpportunities runs the route search for each of the given pools, as if each pair of the pool's denoms
+// had been swapped on it, and returns the profitable opportunities found sorted by their profit valued in uosmo in
+// descending order. The search consumes pool points from remainingPoolPoints. No trades are executed.
+func (k Keeper) searchArbitrageOpportunities(ctx sdk.Context, poolIds []uint64, remainingPoolPoints *uint64) []rankedOpportunity {
+	// The same cyclic route may be built for several pools, so opportunities are deduplicated by route and input denom
+	seen := make(map[string]bool)
+	ranked := make([]rankedOpportunity, 0)
+	for _, poolId := range poolIds {
+		denoms, err := k.gammKeeper.GetPoolDenoms(ctx, poolId)
+		if err != nil {
+			continue
+		}
+
+		// Search as if each pair of the pool's denoms had been swapped on the pool
+		for _, tokenIn := range denoms {
+			for _, tokenOut := range denoms {
+				if tokenIn == tokenOut {
+					continue
+				}
+
+				for _, route := range k.BuildRoutes(ctx, tokenIn, tokenOut, poolId) {
+					if *remainingPoolPoints == 0 {
+						break
+					}
+					if route.PoolPoints > *remainingPoolPoints {
+						continue
+					}
+
+					inputCoin, profit, err := k.FindMaxProfitForRoute(ctx, route, remainingPoolPoints)
+					if err != nil || !profit.IsPositive() {
+						continue
+					}
+
+					key := fmt.Sprint(route.Route.PoolIds(), inputCoin.Denom)
+					if seen[key] {
+						continue
+					}
+					seen[key] = true
+
+					uosmoProfit := profit
+					if inputCoin.Denom != types.OsmosisDenomination {
+						if uosmoProfit, err = k.ConvertProfits(ctx, inputCoin, profit); err != nil {
+							continue
+						}
+					}
+
+					ranked = append(ranked, rankedOpportunity{
+						opportunity: types.ArbitrageOpportunity{
+							Route:  route.Route.PoolIds(),
+							Input:  inputCoin,
+							Profit: sdk.NewCoin(inputCoin.Denom, profit),
+						},
+						uosmoProfit: uosmoProfit,
+					})
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool { return ranked[i].uosmoProfit.GT(ranked[j].uosmoProfit) })
+
+	return ranked
+}
+
+// ProjectProfitOnPriceShift estimates the arbitrage the module would capture if the spot price of the given pool moved
+// by priceShiftPercent percent (e.g. 5 for a 5% increase and -5 for a 5% decrease). The pool must have exactly two
+// denoms, and its price is the spot price of its first denom quoted in its second denom. The shift is applied by
+// swapping against the pool in a cache context, after which the route search is rerun for the pool as in
+// CurrentArbitrageOpportunities. It returns the opportunities found, sorted by their profit valued in uosmo in
+// descending order, their total profit and the pool's spot price before and after the shift. Since the cache context is
+// discarded, no trades are executed and no state is written.
+func (k Keeper) ProjectProfitOnPriceShift(ctx sdk.Context, poolId uint64, priceShiftPercent sdk.Dec) (opportunities []types.ArbitrageOpportunity, totalProfit sdk.Coins, priceBefore, priceAfter sdk.Dec, err error) {
+	// recover from panic
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("Protorev failed due to internal reason: %v", r)
+		}
+	}()
+
+	cacheCtx, _ := ctx.CacheContext()
+
+	priceBefore, priceAfter, err = k.shiftPoolPrice(cacheCtx, poolId, priceShiftPercent)
+	if err != nil {
+		return nil, nil, sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	remainingPoolPoints, err := k.RemainingPoolPointsForTx(cacheCtx)
+	if err != nil {
+		return nil, nil, sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	ranked := k.searchArbitrageOpportunities(cacheCtx, []uint64{poolId}, &remainingPoolPoints)
+
+	opportunities = make([]types.ArbitrageOpportunity, 0, len(ranked))
+	totalProfit = sdk.NewCoins()
+	for _, r := range ranked {
+		opportunities = append(opportunities, r.opportunity)
+		totalProfit = totalProfit.Add(r.opportunity.Profit)
+	}
+
+	return opportunities, totalProfit, priceBefore, priceAfter, nil
+}
+
+// shiftPoolPrice swaps against the given two denom pool the smallest amount that moves the spot price of its first
+// denom quoted in its second denom by at least priceShiftPercent percent, and returns the spot price before and after
+// the swap. The amount is found by doubling the input until the price is reached and then bisecting. The swaps are
+// funded by minting to the module account, so this must only be run in a cache context that is discarded.
+func (k Keeper) shiftPoolPrice(ctx sdk.Context, poolId uint64, priceShiftPercent sdk.Dec) (priceBefore, priceAfter sdk.Dec, err error) {
+	if priceShiftPercent.IsNil() || priceShiftPercent.LTE(sdk.NewDec(-100)) {
+		return sdk.Dec{}, sdk.Dec{}, fmt.Errorf("price shift percent must be greater than -100, was %s", priceShiftPercent)
+	}
+
+	denoms, err := k.gammKeeper.GetPoolDenoms(ctx, poolId)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	if len(denoms) != 2 {
+		return sdk.Dec{}, sdk.Dec{}, fmt.Errorf("pool %d must have exactly two denoms, has %d", poolId, len(denoms))
+	}
+	baseDenom, quoteDenom := denoms[0], denoms[1]
+
+	spotPrice := func(ctx sdk.Context) (sdk.Dec, error) {
+		pool, err := k.gammKeeper.GetPoolAndPoke(ctx, poolId)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		return pool.SpotPrice(ctx, quoteDenom, baseDenom)
+	}
+
+	priceBefore, err = spotPrice(ctx)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	if priceShiftPercent.IsZero() {
+		return priceBefore, priceBefore, nil
+	}
+	targetPrice := priceBefore.Mul(sdk.OneDec().Add(priceShiftPercent.QuoInt64(100)))
+
+	// Buying the base denom raises its price and selling it lowers it
+	tokenInDenom, tokenOutDenom := quoteDenom, baseDenom
+	reached := func(price sdk.Dec) bool { return price.GTE(targetPrice) }
+	if priceShiftPercent.IsNegative() {
+		tokenInDenom, tokenOutDenom = baseDenom, quoteDenom
+		reached = func(price sdk.Dec) bool { return price.LTE(targetPrice) }
+	}
+
+	moduleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	route := poolmanagertypes.SwapAmountInRoutes{{PoolId: poolId, TokenOutDenom: tokenOutDenom}}
+	swap := func(ctx sdk.Context, amount sdk.Int) (sdk.Dec, error) {
+		tokenIn := sdk.NewCoin(tokenInDenom, amount)
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(tokenIn)); err != nil {
+			return sdk.Dec{}, err
+		}
+		if _, err := k.poolmanagerKeeper.RouteExactAmountIn(ctx, moduleAddress, route, tokenIn, sdk.OneInt()); err != nil {
+			return sdk.Dec{}, err
+		}
+		return spotPrice(ctx)
+	}
+	reachesTarget := func(amount sdk.Int) (bool, error) {
+		swapCtx, _ := ctx.CacheContext()
+		price, err := swap(swapCtx, amount)
+		if err != nil {
+			return false, err
+		}
+		return reached(price), nil
+	}
+
+	// Double the input until the target price is reached. Inputs too small to swap for anything fail, but once a swap
+	// has succeeded, a failure means that the pool cannot absorb a swap large enough to reach the target price.
+	left, right := sdk.ZeroInt(), sdk.OneInt()
+	swapped := false
+	for iteration := 0; ; iteration++ {
+		ok, err := reachesTarget(right)
+		if err != nil && swapped {
+			return sdk.Dec{}, sdk.Dec{}, fmt.Errorf("cannot shift the price of pool %d by %s%%: %w", poolId, priceShiftPercent, err)
+		}
+		if ok {
+			break
+		}
+		swapped = swapped || err == nil
+		if iteration == types.MaxPriceShiftDoublings {
+			return sdk.Dec{}, sdk.Dec{}, fmt.Errorf("cannot shift the price of pool %d by %s%%", poolId, priceShiftPercent)
+		}
+		left, right = right, right.MulRaw(2)
+	}
+
+	// Bisect down to the smallest input that reaches the target price, treating failed swaps as too small
+	for right.Sub(left).GT(sdk.OneInt()) {
+		mid := left.Add(right).QuoRaw(2)
+		if ok, err := reachesTarget(mid); err == nil && ok {
+			right = mid
+		} else {
+			left = mid
+		}
+	}
+
+	priceAfter, err = swap(ctx, right)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	return priceBefore, priceAfter, nil
+}
+
+// ProfitSearchTrace reruns the search for the optimal input amount of the cyclic arbitrage route that starts and ends
+// with inputDenom and trades through the given pools, and returns every step of the search (the input tried and the
+// resulting profit) in the order the inputs were tried, alongside the optimal input and its profit. The hops of the
+// route are inferred from the pools: every pool but the last must have exactly two denoms, and the last pool swaps back
+// into inputDenom. The search uses the step size of inputDenom as a base denom. It is run in a cache context, so no
+// trades are executed and no state is written.
+func (k Keeper) ProfitSearchTrace(ctx sdk.Context, poolIds []uint64, inputDenom string) (trace []types.ProfitSearchStep, tokenIn sdk.Coin, profit sdk.Int, err error) {
+	// recover from panic
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("Protorev failed due to internal reason: %v", r)
+		}
+	}()
+
+	cacheCtx, _ := ctx.CacheContext()
+
+	if len(poolIds) == 0 {
+		return nil, sdk.Coin{}, sdk.Int{}, fmt.Errorf("route must contain at least one pool")
+	}
+
+	stepSize, err := k.GetBaseDenomStepSize(cacheCtx, inputDenom)
+	if err != nil {
+		return nil, sdk.Coin{}, sdk.Int{}, err
+	}
+
+	route := make(poolmanagertypes.SwapAmountInRoutes, 0, len(poolIds))
+	curDenom := inputDenom
+	for index, poolId := range poolIds {
+		denoms, err := k.gammKeeper.GetPoolDenoms(cacheCtx, poolId)
+		if err != nil {
+			return nil, sdk.Coin{}, sdk.Int{}, err
+		}
+
+		tokenOutDenom := ""
+		if index == len(poolIds)-1 {
+			tokenOutDenom = inputDenom
+		} else if len(denoms) == 2 {
+			for _, denom := range denoms {
+				if denom != curDenom {
+					tokenOutDenom = denom
+				}
+			}
+		}
+
+		if tokenOutDenom == "" || tokenOutDenom == curDenom || !slices.Contains(denoms, curDenom) || !slices.Contains(denoms, tokenOutDenom) {
+			return nil, sdk.Coin{}, sdk.Int{}, fmt.Errorf("cannot infer the swap from %s through pool %d", curDenom, poolId)
+		}
+
+		route = append(route, poolmanagertypes.SwapAmountInRoute{PoolId: poolId, TokenOutDenom: tokenOutDenom})
+		curDenom = tokenOutDenom
+	}
+
+	routePoolPoints, err := k.CalculateRoutePoolPoints(cacheCtx, route)
+	if err != nil {
+		return nil, sdk.Coin{}, sdk.Int{}, err
+	}
+
+	// The search only consumes pool points from a local budget that is exactly sufficient for the route
+	remainingPoolPoints := routePoolPoints
+	trace = make([]types.ProfitSearchStep, 0)
+	tokenIn, profit, err = k.findMaxProfitForRoute(cacheCtx, RouteMetaData{Route: route, PoolPoints: routePoolPoints, StepSize: stepSize}, &remainingPoolPoints, &trace)
+	if err != nil {
+		return nil, sdk.Coin{}, sdk.Int{}, err
+	}
+
+	return trace, tokenIn, profit, nil
+}
@@ -1,8 +1,11 @@
 package keeper_test
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/v15/app/apptesting"
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
 	protorevtypes "github.com/osmosis-labs/osmosis/v15/x/protorev/keeper"
 	"github.com/osmosis-labs/osmosis/v15/x/protorev/types"
@@ -316,6 +319,73 @@ func (suite *KeeperTestSuite) TestFindMaxProfitRoute() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestFindMaxProfitRouteWithMaxInputAmount() {
+	stepSize := sdk.NewInt(1_000_000)
+
+	// Without a cap, the optimal amount in for this route is 989_000_000
+	uncappedRemainingPoolPoints := uint64(1000)
+	_, uncappedProfit, err := suite.App.ProtoRevKeeper.FindMaxProfitForRoute(
+		suite.Ctx,
+		protorevtypes.RouteMetaData{Route: twoPoolRoute, PoolPoints: 4, StepSize: stepSize},
+		&uncappedRemainingPoolPoints,
+	)
+	suite.Require().NoError(err)
+
+	tests := []struct {
+		name           string
+		maxInputAmount sdk.Int
+		expectProfit   bool
+	}{
+		{
+			name:           "Cap within the search range",
+			maxInputAmount: sdk.NewInt(500_000_000),
+			expectProfit:   true,
+		},
+		{
+			name:           "Cap of a single step",
+			maxInputAmount: stepSize,
+			expectProfit:   true,
+		},
+		{
+			name:           "Cap below the step size",
+			maxInputAmount: stepSize.Sub(sdk.OneInt()),
+			expectProfit:   false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			remainingPoolPoints := uint64(1000)
+			route := protorevtypes.RouteMetaData{
+				Route:          twoPoolRoute,
+				PoolPoints:     4,
+				StepSize:       stepSize,
+				MaxInputAmount: test.maxInputAmount,
+			}
+
+			amtIn, profit, err := suite.App.ProtoRevKeeper.FindMaxProfitForRoute(
+				suite.Ctx,
+				route,
+				&remainingPoolPoints,
+			)
+			suite.Require().NoError(err)
+
+			if !test.expectProfit {
+				// The route is skipped without consuming pool points
+				suite.Require().True(profit.IsZero())
+				suite.Require().Equal(uint64(1000), remainingPoolPoints)
+				return
+			}
+
+			// The optimal amount in never exceeds the cap, at the cost of some profit
+			suite.Require().True(amtIn.Amount.LTE(test.maxInputAmount))
+			suite.Require().True(profit.IsPositive())
+			suite.Require().True(profit.LT(uncappedProfit))
+			suite.Require().Equal(uint64(1000), remainingPoolPoints+route.PoolPoints)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestExecuteTrade() {
 
 	type param struct {
@@ -392,6 +462,7 @@ func (suite *KeeperTestSuite) TestExecuteTrade() {
 			suite.Ctx,
 			test.param.route,
 			test.param.inputCoin,
+			nil,
 		)
 
 		if test.expectPass {
@@ -419,6 +490,106 @@ func (suite *KeeperTestSuite) TestExecuteTrade() {
 	}
 }
 
+// TestExecuteTradeSearcherReward tests that the searcher reward fraction of the profit is sent to the searcher
+// and that only the remainder is counted as module profit
+func (suite *KeeperTestSuite) TestExecuteTradeSearcherReward() {
+	searcher := apptesting.CreateRandomAccounts(1)[0]
+	inputCoin := sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(10100000))
+	expectedProfit := sdk.NewInt(24852)
+
+	// Searchers are not rewarded by default
+	suite.Require().Equal(types.DefaultSearcherRewardFraction, suite.App.ProtoRevKeeper.GetSearcherRewardFraction(suite.Ctx))
+
+	suite.App.ProtoRevKeeper.SetSearcherRewardFraction(suite.Ctx, sdk.NewDecWithPrec(5, 1))
+	suite.Ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+
+	err := suite.App.ProtoRevKeeper.ExecuteTrade(suite.Ctx, routeTwoAssetSameWeight, inputCoin, searcher)
+	suite.Require().NoError(err)
+
+	// Half of the profit is sent to the searcher
+	expectedReward := sdk.NewCoin(types.OsmosisDenomination, expectedProfit.QuoRaw(2))
+	suite.Require().Equal(expectedReward, suite.App.BankKeeper.GetBalance(suite.Ctx, searcher, types.OsmosisDenomination))
+
+	// Only the remainder is counted as module profit
+	profit, err := suite.App.ProtoRevKeeper.GetProfitsByDenom(suite.Ctx, types.OsmosisDenomination)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedProfit.Sub(expectedReward.Amount), profit.Amount)
+
+	// The reward is attributed to the searcher in an event
+	found := false
+	for _, event := range suite.Ctx.EventManager().Events() {
+		if event.Type != types.TypeEvtSearcherReward {
+			continue
+		}
+		found = true
+		for _, attr := range event.Attributes {
+			switch string(attr.Key) {
+			case types.AttributeKeySearcher:
+				suite.Require().Equal(searcher.String(), string(attr.Value))
+			case types.AttributeKeyReward:
+				suite.Require().Equal(expectedReward.String(), string(attr.Value))
+			}
+		}
+	}
+	suite.Require().True(found)
+}
+
+// TestFourHopCyclicHotRoute tests that a four hop hot route is built, searched and executed as a single cycle
+// that returns to the starting denom, threading each hop's output into the next hop and charging pool points for every hop
+func (suite *KeeperTestSuite) TestFourHopCyclicHotRoute() {
+	poolWeights := types.PoolWeights{StableWeight: 5, BalancerWeight: 2, ConcentratedWeight: 2}
+	suite.App.ProtoRevKeeper.SetPoolWeights(suite.Ctx, poolWeights)
+
+	// Swapping Atom for test/2 on pool 37 places pool 37 into the four pool hot route
+	routes, err := suite.App.ProtoRevKeeper.BuildHotRoutes(suite.Ctx, "Atom", "test/2", 37)
+	suite.Require().NoError(err)
+	suite.Require().Len(routes, 1)
+
+	route := routes[0]
+	suite.Require().Equal(fourPoolRoute, route.Route)
+	suite.Require().Equal(4*poolWeights.BalancerWeight, route.PoolPoints)
+
+	// Each hop must take the previous hop's output as its input, and the last hop must return to the starting denom
+	inputDenom := route.Route[route.Route.Length()-1].TokenOutDenom
+	suite.Require().Equal("Atom", inputDenom)
+	prevDenom := inputDenom
+	for _, hop := range route.Route {
+		denoms, err := suite.App.GAMMKeeper.GetPoolDenoms(suite.Ctx, hop.PoolId)
+		suite.Require().NoError(err)
+		suite.Require().Contains(denoms, prevDenom)
+		suite.Require().Contains(denoms, hop.TokenOutDenom)
+		prevDenom = hop.TokenOutDenom
+	}
+
+	// The search must only charge the route's pool points once for all four hops
+	pointCountBefore, err := suite.App.ProtoRevKeeper.GetPointCountForBlock(suite.Ctx)
+	suite.Require().NoError(err)
+	remainingPoolPoints := uint64(1000)
+	inputCoin, profit, err := suite.App.ProtoRevKeeper.FindMaxProfitForRoute(suite.Ctx, route, &remainingPoolPoints)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoin("Atom", sdk.NewInt(1_147_000_000)), inputCoin)
+	suite.Require().Equal(sdk.NewInt(15_761_405), profit)
+	suite.Require().Equal(uint64(1000)-route.PoolPoints, remainingPoolPoints)
+
+	pointCountAfter, err := suite.App.ProtoRevKeeper.GetPointCountForBlock(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(pointCountBefore+route.PoolPoints, pointCountAfter)
+
+	// Executing the route leaves exactly the profit in the starting denom in the module account
+	moduleAddress := suite.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+	balanceBefore := suite.App.BankKeeper.GetBalance(suite.Ctx, moduleAddress, inputDenom)
+
+	err = suite.App.ProtoRevKeeper.ExecuteTrade(suite.Ctx, route.Route, inputCoin, nil)
+	suite.Require().NoError(err)
+
+	balanceAfter := suite.App.BankKeeper.GetBalance(suite.Ctx, moduleAddress, inputDenom)
+	suite.Require().Equal(profit, balanceAfter.Amount.Sub(balanceBefore.Amount))
+
+	routeProfit, err := suite.App.ProtoRevKeeper.GetProfitsByRoute(suite.Ctx, route.Route.PoolIds(), inputDenom)
+	suite.Require().NoError(err)
+	suite.Require().Equal(profit, routeProfit.Amount)
+}
+
 func (suite *KeeperTestSuite) TestIterateRoutes() {
 	type paramm struct {
 		routes                     []poolmanagertypes.SwapAmountInRoutes
@@ -519,7 +690,236 @@ func (suite *KeeperTestSuite) TestIterateRoutes() {
 	}
 }
 
+// TestIterateRoutesWithMinProfitThreshold tests that IterateRoutes skips routes whose profit falls below their min profit threshold
+func (suite *KeeperTestSuite) TestIterateRoutesWithMinProfitThreshold() {
+	// The optimal profit of this route in uosmo is 24848
+	tests := []struct {
+		name               string
+		minProfitThreshold sdk.Int
+		expectExecution    bool
+	}{
+		{
+			name:               "No threshold",
+			minProfitThreshold: sdk.Int{},
+			expectExecution:    true,
+		},
+		{
+			name:               "Threshold equal to the profit",
+			minProfitThreshold: sdk.NewInt(24848),
+			expectExecution:    true,
+		},
+		{
+			name:               "Threshold above the profit",
+			minProfitThreshold: sdk.NewInt(24849),
+			expectExecution:    false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			routes := []protorevtypes.RouteMetaData{
+				{
+					Route:              routeTwoAssetSameWeight,
+					PoolPoints:         0,
+					StepSize:           sdk.NewInt(1_000_000),
+					MinProfitThreshold: test.minProfitThreshold,
+				},
+			}
+			remainingPoolPoints := uint64(40)
+			attemptsBefore := suite.App.ProtoRevKeeper.GetAttemptsByRoute(suite.Ctx, routeTwoAssetSameWeight.PoolIds())
+
+			maxProfitInputCoin, maxProfitAmount, optimalRoute := suite.App.ProtoRevKeeper.IterateRoutes(suite.Ctx, routes, &remainingPoolPoints)
+			attemptsAfter := suite.App.ProtoRevKeeper.GetAttemptsByRoute(suite.Ctx, routeTwoAssetSameWeight.PoolIds())
+			if test.expectExecution {
+				suite.Require().Equal(sdk.NewInt(24848), maxProfitAmount)
+				suite.Require().Equal(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(10000000)), maxProfitInputCoin)
+				suite.Require().Equal(routeTwoAssetSameWeight, optimalRoute)
+			} else {
+				suite.Require().True(maxProfitAmount.IsZero())
+				suite.Require().Nil(optimalRoute)
+			}
+			// Every searched route is recorded as an attempt, whether or not it is executed
+			suite.Require().Equal(attemptsBefore.AddRaw(1), attemptsAfter)
+		})
+	}
+}
+
 // Test logic that compares proftability of routes with different assets
+// TestCurrentArbitrageOpportunities tests the CurrentArbitrageOpportunities function
+func (suite *KeeperTestSuite) TestCurrentArbitrageOpportunities() {
+	pointCountBefore, err := suite.App.ProtoRevKeeper.GetPointCountForBlock(suite.Ctx)
+	suite.Require().NoError(err)
+	numberOfTradesBefore, _ := suite.App.ProtoRevKeeper.GetNumberOfTrades(suite.Ctx)
+
+	opportunities, err := suite.App.ProtoRevKeeper.CurrentArbitrageOpportunities(suite.Ctx, 0)
+	suite.Require().NoError(err)
+	suite.Require().NotEmpty(opportunities)
+
+	seen := make(map[string]bool)
+	for _, opportunity := range opportunities {
+		suite.Require().True(opportunity.Profit.IsPositive())
+		suite.Require().Equal(opportunity.Input.Denom, opportunity.Profit.Denom)
+
+		// Every opportunity is unique
+		key := fmt.Sprint(opportunity.Route, opportunity.Input.Denom)
+		suite.Require().False(seen[key])
+		seen[key] = true
+	}
+
+	// The scan is read-only
+	pointCountAfter, err := suite.App.ProtoRevKeeper.GetPointCountForBlock(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(pointCountBefore, pointCountAfter)
+	numberOfTradesAfter, _ := suite.App.ProtoRevKeeper.GetNumberOfTrades(suite.Ctx)
+	suite.Require().Equal(numberOfTradesBefore, numberOfTradesAfter)
+
+	// The limit returns the most profitable opportunities
+	res, err := suite.queryClient.GetProtoRevCurrentArbitrageOpportunities(sdk.WrapSDKContext(suite.Ctx), &types.QueryGetProtoRevCurrentArbitrageOpportunitiesRequest{Limit: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal(opportunities[:1], res.Opportunities)
+
+	// No pool points remaining for the block means no search is run
+	maxPointsPerBlock, err := suite.App.ProtoRevKeeper.GetMaxPointsPerBlock(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.App.ProtoRevKeeper.SetPointCountForBlock(suite.Ctx, maxPointsPerBlock)
+	opportunities, err = suite.App.ProtoRevKeeper.CurrentArbitrageOpportunities(suite.Ctx, 0)
+	suite.Require().NoError(err)
+	suite.Require().Empty(opportunities)
+}
+
+// TestProjectProfitOnPriceShift tests the ProjectProfitOnPriceShift function
+func (suite *KeeperTestSuite) TestProjectProfitOnPriceShift() {
+	spotPrice := func(poolId uint64) sdk.Dec {
+		pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+		suite.Require().NoError(err)
+		price, err := pool.SpotPrice(suite.Ctx, types.OsmosisDenomination, "test/3")
+		suite.Require().NoError(err)
+		return price
+	}
+	isTwoPoolRoute := func(route []uint64) bool {
+		return len(route) == 2 && ((route[0] == 38 && route[1] == 39) || (route[0] == 39 && route[1] == 38))
+	}
+
+	pointCountBefore, err := suite.App.ProtoRevKeeper.GetPointCountForBlock(suite.Ctx)
+	suite.Require().NoError(err)
+	pool38PriceBefore := spotPrice(38)
+	pool39PriceBefore := spotPrice(39)
+
+	// Without a shift the arbitrage between pools 38 and 39 is found
+	opportunities, totalProfit, priceBefore, priceAfter, err := suite.App.ProtoRevKeeper.ProjectProfitOnPriceShift(suite.Ctx, 39, sdk.ZeroDec())
+	suite.Require().NoError(err)
+	suite.Require().Equal(pool39PriceBefore, priceBefore)
+	suite.Require().Equal(priceBefore, priceAfter)
+	foundTwoPoolRoute := false
+	for _, opportunity := range opportunities {
+		foundTwoPoolRoute = foundTwoPoolRoute || isTwoPoolRoute(opportunity.Route)
+	}
+	suite.Require().True(foundTwoPoolRoute)
+
+	// The price is shifted by the requested percent
+	opportunities, totalProfit, priceBefore, priceAfter, err = suite.App.ProtoRevKeeper.ProjectProfitOnPriceShift(suite.Ctx, 39, sdk.NewDec(10))
+	suite.Require().NoError(err)
+	targetPrice := priceBefore.Mul(sdk.NewDecWithPrec(11, 1))
+	suite.Require().True(priceAfter.GTE(targetPrice))
+	suite.Require().True(priceAfter.Sub(targetPrice).Quo(targetPrice).LT(sdk.NewDecWithPrec(1, 6)))
+
+	// The total profit is the sum of the profits of the opportunities
+	expectedTotalProfit := sdk.NewCoins()
+	for _, opportunity := range opportunities {
+		suite.Require().True(opportunity.Profit.IsPositive())
+		expectedTotalProfit = expectedTotalProfit.Add(opportunity.Profit)
+	}
+	suite.Require().Equal(expectedTotalProfit, totalProfit)
+
+	// Shifting pool 39 to the price of pool 38 closes the arbitrage between them
+	closingShift := pool38PriceBefore.Quo(pool39PriceBefore).Sub(sdk.OneDec()).MulInt64(100)
+	opportunities, _, _, _, err = suite.App.ProtoRevKeeper.ProjectProfitOnPriceShift(suite.Ctx, 39, closingShift)
+	suite.Require().NoError(err)
+	for _, opportunity := range opportunities {
+		suite.Require().False(isTwoPoolRoute(opportunity.Route))
+	}
+
+	// The projection is read-only
+	suite.Require().Equal(pool38PriceBefore, spotPrice(38))
+	suite.Require().Equal(pool39PriceBefore, spotPrice(39))
+	pointCountAfter, err := suite.App.ProtoRevKeeper.GetPointCountForBlock(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(pointCountBefore, pointCountAfter)
+
+	// The query returns the same projection
+	opportunities, totalProfit, priceBefore, priceAfter, err = suite.App.ProtoRevKeeper.ProjectProfitOnPriceShift(suite.Ctx, 39, sdk.NewDec(-5))
+	suite.Require().NoError(err)
+	suite.Require().True(priceAfter.LT(priceBefore))
+	res, err := suite.queryClient.GetProtoRevProjectedProfitOnPriceShift(sdk.WrapSDKContext(suite.Ctx), &types.QueryGetProtoRevProjectedProfitOnPriceShiftRequest{PoolId: 39, PriceShiftPercent: sdk.NewDec(-5)})
+	suite.Require().NoError(err)
+	suite.Require().Equal(opportunities, res.Opportunities)
+	suite.Require().Equal(totalProfit, res.TotalProfit)
+	suite.Require().Equal(priceBefore, res.SpotPriceBefore)
+	suite.Require().Equal(priceAfter, res.SpotPriceAfter)
+
+	// Pools that do not have exactly two denoms are rejected
+	_, _, _, _, err = suite.App.ProtoRevKeeper.ProjectProfitOnPriceShift(suite.Ctx, 40, sdk.NewDec(5))
+	suite.Require().Error(err)
+
+	// The price cannot drop by 100% or more
+	_, _, _, _, err = suite.App.ProtoRevKeeper.ProjectProfitOnPriceShift(suite.Ctx, 39, sdk.NewDec(-100))
+	suite.Require().Error(err)
+
+	// The pool cannot absorb a swap large enough to shift its price that far
+	_, _, _, _, err = suite.App.ProtoRevKeeper.ProjectProfitOnPriceShift(suite.Ctx, 39, sdk.NewDec(1_000_000))
+	suite.Require().Error(err)
+}
+
+// TestProfitSearchTrace tests the ProfitSearchTrace function
+func (suite *KeeperTestSuite) TestProfitSearchTrace() {
+	pointCountBefore, err := suite.App.ProtoRevKeeper.GetPointCountForBlock(suite.Ctx)
+	suite.Require().NoError(err)
+
+	trace, input, profit, err := suite.App.ProtoRevKeeper.ProfitSearchTrace(suite.Ctx, twoPoolRoute.PoolIds(), "test/3")
+	suite.Require().NoError(err)
+
+	// The trace reaches the same optimum as the search used for arbitrage
+	suite.Require().Equal(sdk.NewCoin("test/3", sdk.NewInt(989_000_000)), input)
+	suite.Require().Equal(sdk.NewInt(218_149_058), profit)
+	suite.Require().NotEmpty(trace)
+
+	foundOptimum := false
+	for _, step := range trace {
+		suite.Require().Equal("test/3", step.Input.Denom)
+		if step.Input.Equal(input) {
+			suite.Require().Equal(profit, step.Profit)
+			foundOptimum = true
+		}
+	}
+	suite.Require().True(foundOptimum)
+
+	// The search is read-only
+	pointCountAfter, err := suite.App.ProtoRevKeeper.GetPointCountForBlock(suite.Ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(pointCountBefore, pointCountAfter)
+
+	// The query returns the same trace
+	res, err := suite.queryClient.GetProtoRevProfitSearchTrace(sdk.WrapSDKContext(suite.Ctx), &types.QueryGetProtoRevProfitSearchTraceRequest{Route: twoPoolRoute.PoolIds(), InputDenom: "test/3"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(trace, res.Steps)
+	suite.Require().Equal(input, res.Input)
+	suite.Require().Equal(profit, res.Profit)
+
+	// A route with no arbitrage opportunity stops after the minimum input
+	trace, _, profit, err = suite.App.ProtoRevKeeper.ProfitSearchTrace(suite.Ctx, routeNoArb.PoolIds(), routeNoArb[len(routeNoArb)-1].TokenOutDenom)
+	suite.Require().NoError(err)
+	suite.Require().Len(trace, 1)
+	suite.Require().True(profit.IsZero())
+
+	// The input denom must be a base denom
+	_, _, _, err = suite.App.ProtoRevKeeper.ProfitSearchTrace(suite.Ctx, twoPoolRoute.PoolIds(), "test/4")
+	suite.Require().Error(err)
+
+	// The route must not be empty
+	_, _, _, err = suite.App.ProtoRevKeeper.ProfitSearchTrace(suite.Ctx, []uint64{}, "test/3")
+	suite.Require().Error(err)
+}
+
 func (suite *KeeperTestSuite) TestConvertProfits() {
 	type param struct {
 		inputCoin           sdk.Coin
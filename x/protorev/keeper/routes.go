@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -16,6 +17,29 @@ type RouteMetaData struct {
 	PoolPoints uint64
 	// The step size that should be used in the binary search for the optimal swap amount
 	StepSize sdk.Int
+	// The maximum amount of the input denom the binary search may use. Nil or zero means no cap
+	MaxInputAmount sdk.Int
+	// The search priority of the route. Routes with a higher priority are searched first
+	Priority uint64
+	// The minimum profit, in the input denom, the route must make to be executed. Nil means any positive profit
+	MinProfitThreshold sdk.Int
+	// The fraction of past attempts on the route that resulted in a trade. Among routes with the same
+	// priority, routes with a higher success rate are searched first
+	SuccessRate sdk.Dec
+	// The number of times the route has been searched. Among routes with the same priority and success rate,
+	// routes that have been searched less often are searched first
+	Attempts sdk.Int
+	// The combined total value locked of the pools in the route's base denom. Only set when routes are weighted by TVL
+	TotalValueLocked sdk.Int
+}
+
+// maxInputSteps returns the route's max input amount in units of its step size and whether the route has an input cap.
+func (r RouteMetaData) maxInputSteps() (sdk.Int, bool) {
+	if r.MaxInputAmount.IsNil() || !r.MaxInputAmount.IsPositive() {
+		return sdk.Int{}, false
+	}
+
+	return r.MaxInputAmount.Quo(r.StepSize), true
 }
 
 // BuildRoutes builds all of the possible arbitrage routes given the tokenIn, tokenOut and poolId that were used in the swap.
@@ -32,9 +56,92 @@ func (k Keeper) BuildRoutes(ctx sdk.Context, tokenIn, tokenOut string, poolId ui
 		routes = append(routes, highestLiquidityRoutes...)
 	}
 
+	// Skip any route that touches a blacklisted pool or a pool younger than the minimum pool age
+	allowedRoutes := make([]RouteMetaData, 0, len(routes))
+	for _, route := range routes {
+		if !k.IsRouteBlacklisted(ctx, route.Route) && !k.IsRouteTooYoung(ctx, route.Route) {
+			allowedRoutes = append(allowedRoutes, route)
+		}
+	}
+	routes = allowedRoutes
+
+	weightByTVL := k.GetWeightRoutesByTVL(ctx)
+	for index := range routes {
+		routes[index].SuccessRate = k.GetSuccessRateByRoute(ctx, routes[index].Route.PoolIds())
+		routes[index].Attempts = k.GetAttemptsByRoute(ctx, routes[index].Route.PoolIds())
+		if weightByTVL {
+			routes[index].TotalValueLocked = k.GetRouteTotalValueLocked(ctx, routes[index].Route)
+		}
+	}
+
+	// Search higher priority routes first, breaking ties by searching routes with a higher historical success rate first.
+	// If routes are weighted by TVL, routes with a higher combined TVL are searched before considering the success rate.
+	// Among routes with the same success rate, routes with fewer attempts are searched first, so that a route that has
+	// never been searched is tried before a route that has been searched without ever trading.
+	// The sort is stable so that routes with equal priority, success rate and attempts keep their original order
+	sort.SliceStable(routes, func(i, j int) bool {
+		if routes[i].Priority != routes[j].Priority {
+			return routes[i].Priority > routes[j].Priority
+		}
+		if weightByTVL && !routes[i].TotalValueLocked.Equal(routes[j].TotalValueLocked) {
+			return routes[i].TotalValueLocked.GT(routes[j].TotalValueLocked)
+		}
+		if !routes[i].SuccessRate.Equal(routes[j].SuccessRate) {
+			return routes[i].SuccessRate.GT(routes[j].SuccessRate)
+		}
+		return routes[i].Attempts.LT(routes[j].Attempts)
+	})
+
 	return routes
 }
 
+// GetRouteTotalValueLocked returns the combined total value locked of the pools in the route, valued in the route's
+// base denom (the denom the cyclic route starts and ends in). Every coin in each pool is valued at the spot price of
+// the highest liquidity pool between its denom and the base denom. Pools whose liquidity cannot be fetched and coins
+// that cannot be valued do not contribute to the total.
+func (k Keeper) GetRouteTotalValueLocked(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes) sdk.Int {
+	if len(route) == 0 {
+		return sdk.ZeroInt()
+	}
+	baseDenom := route[len(route)-1].TokenOutDenom
+
+	// Spot prices are looked up once per denom, as the same denom is usually held by several pools in the route
+	spotPrices := map[string]sdk.Dec{baseDenom: sdk.OneDec()}
+	totalValueLocked := sdk.ZeroDec()
+	for _, poolId := range route.PoolIds() {
+		liquidity, err := k.gammKeeper.GetTotalPoolLiquidity(ctx, poolId)
+		if err != nil {
+			continue
+		}
+
+		for _, coin := range liquidity {
+			spotPrice, ok := spotPrices[coin.Denom]
+			if !ok {
+				spotPrice, err = k.getSpotPriceInBaseDenom(ctx, baseDenom, coin.Denom)
+				if err != nil {
+					spotPrice = sdk.ZeroDec()
+				}
+				spotPrices[coin.Denom] = spotPrice
+			}
+
+			totalValueLocked = totalValueLocked.Add(coin.Amount.ToDec().Mul(spotPrice))
+		}
+	}
+
+	return totalValueLocked.TruncateInt()
+}
+
+// getSpotPriceInBaseDenom returns the price of one unit of denom in units of baseDenom, using the highest liquidity
+// pool between the two denoms.
+func (k Keeper) getSpotPriceInBaseDenom(ctx sdk.Context, baseDenom, denom string) (sdk.Dec, error) {
+	poolId, err := k.GetPoolForDenomPair(ctx, baseDenom, denom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	return k.poolmanagerKeeper.RouteCalculateSpotPrice(ctx, poolId, baseDenom, denom)
+}
+
 // BuildHotRoutes builds all of the possible arbitrage routes using the hot routes method.
 func (k Keeper) BuildHotRoutes(ctx sdk.Context, tokenIn, tokenOut string, poolId uint64) ([]RouteMetaData, error) {
 	routes := make([]RouteMetaData, 0)
@@ -56,6 +163,17 @@ func (k Keeper) BuildHotRoutes(ctx sdk.Context, tokenIn, tokenOut string, poolId
 
 // BuildHotRoute constructs a cyclic arbitrage route given a hot route and swap that should be placed in the hot route.
 func (k Keeper) BuildHotRoute(ctx sdk.Context, route types.Route, poolId uint64) (RouteMetaData, error) {
+	// Routes must never be seeded with a base denom that may only be used as an intermediate hop
+	if len(route.Trades) > 0 {
+		intermediateOnly, err := k.IsIntermediateOnlyBaseDenom(ctx, route.Trades[0].TokenIn)
+		if err != nil {
+			return RouteMetaData{}, err
+		}
+		if intermediateOnly {
+			return RouteMetaData{}, fmt.Errorf("route cannot be seeded with %s, which may only be used as an intermediate hop", route.Trades[0].TokenIn)
+		}
+	}
+
 	newRoute := make(poolmanagertypes.SwapAmountInRoutes, 0)
 
 	for _, trade := range route.Trades {
@@ -79,10 +197,33 @@ func (k Keeper) BuildHotRoute(ctx sdk.Context, route types.Route, poolId uint64)
 		return RouteMetaData{}, err
 	}
 
+	// Routes that do not set their own step size use the step size of their base denom, so that the binary search
+	// is scaled to the unit value of the denom it trades in
+	stepSize := route.StepSize
+	if !route.HasStepSize() {
+		stepSize, err = k.GetBaseDenomStepSize(ctx, route.Trades[0].TokenIn)
+		if err != nil {
+			return RouteMetaData{}, err
+		}
+	}
+
+	maxInputAmount := sdk.Int{}
+	if route.MaxInputAmount != nil {
+		maxInputAmount = *route.MaxInputAmount
+	}
+
+	minProfitThreshold := sdk.Int{}
+	if route.MinProfitThreshold != nil {
+		minProfitThreshold = *route.MinProfitThreshold
+	}
+
 	return RouteMetaData{
-		Route:      newRoute,
-		PoolPoints: routePoolPoints,
-		StepSize:   route.StepSize,
+		Route:              newRoute,
+		PoolPoints:         routePoolPoints,
+		StepSize:           stepSize,
+		MaxInputAmount:     maxInputAmount,
+		Priority:           route.Priority,
+		MinProfitThreshold: minProfitThreshold,
 	}, nil
 }
 
@@ -99,6 +240,11 @@ func (k Keeper) BuildHighestLiquidityRoutes(ctx sdk.Context, tokenIn, tokenOut s
 	// have priority over those that are later in the list. This way we can build routes that are more likely to succeed and bring in
 	// higher profits.
 	for _, baseDenom := range baseDenoms {
+		// Base denoms that may only be used as an intermediate hop never seed routes
+		if baseDenom.IntermediateOnly {
+			continue
+		}
+
 		if newRoute, err := k.BuildHighestLiquidityRoute(ctx, baseDenom, tokenIn, tokenOut, poolId); err == nil {
 			routes = append(routes, newRoute)
 		}
@@ -107,6 +107,135 @@ func (suite *KeeperTestSuite) TestBuildRoutes() {
 	}
 }
 
+// TestBuildRoutesWithPriority tests that BuildRoutes searches higher priority hot routes first
+func (suite *KeeperTestSuite) TestBuildRoutesWithPriority() {
+	tokenPairArbRoutes, err := suite.App.ProtoRevKeeper.GetTokenPairArbRoutes(suite.Ctx, "akash", "Atom")
+	suite.Require().NoError(err)
+
+	// Add a boosted hot route that goes through the osmo pools
+	tokenPairArbRoutes.ArbRoutes = append(tokenPairArbRoutes.ArbRoutes, types.Route{
+		Trades: []types.Trade{
+			{Pool: 25, TokenIn: types.OsmosisDenomination, TokenOut: "Atom"},
+			{Pool: 0, TokenIn: "Atom", TokenOut: "akash"},
+			{Pool: 7, TokenIn: "akash", TokenOut: types.OsmosisDenomination},
+		},
+		StepSize: sdk.NewInt(1_000_000),
+		Priority: 1,
+	})
+	err = suite.App.ProtoRevKeeper.SetTokenPairArbRoutes(suite.Ctx, "akash", "Atom", tokenPairArbRoutes)
+	suite.Require().NoError(err)
+
+	// The boosted hot route comes first, followed by the default priority routes in their original order
+	expectedRoutes := [][]uint64{{25, 1, 7}, {1, 14, 4}, {25, 1, 7}}
+	expectedPriorities := []uint64{1, 0, 0}
+
+	routes := suite.App.ProtoRevKeeper.BuildRoutes(suite.Ctx, "akash", "Atom", 1)
+	suite.Require().Equal(len(expectedRoutes), len(routes))
+	for routeIndex, route := range routes {
+		suite.Require().Equal(expectedRoutes[routeIndex], route.Route.PoolIds())
+		suite.Require().Equal(expectedPriorities[routeIndex], route.Priority)
+	}
+}
+
+// TestBuildRoutesWithSuccessRate tests that BuildRoutes searches routes with a higher historical success rate first
+func (suite *KeeperTestSuite) TestBuildRoutesWithSuccessRate() {
+	// Without any attempts, routes keep their original order
+	routes := suite.App.ProtoRevKeeper.BuildRoutes(suite.Ctx, "akash", "Atom", 1)
+	suite.Require().Equal(2, len(routes))
+	suite.Require().Equal([]uint64{1, 14, 4}, routes[0].Route.PoolIds())
+	suite.Require().Equal([]uint64{25, 1, 7}, routes[1].Route.PoolIds())
+
+	// A route that has been searched without trading is searched after a route that has never been searched
+	err := suite.App.ProtoRevKeeper.IncrementAttemptsByRoute(suite.Ctx, []uint64{1, 14, 4})
+	suite.Require().NoError(err)
+	routes = suite.App.ProtoRevKeeper.BuildRoutes(suite.Ctx, "akash", "Atom", 1)
+	suite.Require().Equal(2, len(routes))
+	suite.Require().Equal([]uint64{25, 1, 7}, routes[0].Route.PoolIds())
+	suite.Require().Equal([]uint64{1, 14, 4}, routes[1].Route.PoolIds())
+	suite.Require().Equal(sdk.OneInt(), routes[1].Attempts)
+
+	// Give the highest liquidity route a track record of one trade out of two attempts
+	for i := 0; i < 2; i++ {
+		err := suite.App.ProtoRevKeeper.IncrementAttemptsByRoute(suite.Ctx, []uint64{25, 1, 7})
+		suite.Require().NoError(err)
+	}
+	err = suite.App.ProtoRevKeeper.IncrementTradesByRoute(suite.Ctx, []uint64{25, 1, 7})
+	suite.Require().NoError(err)
+
+	// The route with the higher success rate is now searched first
+	routes = suite.App.ProtoRevKeeper.BuildRoutes(suite.Ctx, "akash", "Atom", 1)
+	suite.Require().Equal(2, len(routes))
+	suite.Require().Equal([]uint64{25, 1, 7}, routes[0].Route.PoolIds())
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.5"), routes[0].SuccessRate)
+	suite.Require().Equal([]uint64{1, 14, 4}, routes[1].Route.PoolIds())
+	suite.Require().Equal(sdk.ZeroDec(), routes[1].SuccessRate)
+}
+
+// TestBuildRoutesWithTVLWeighting tests that BuildRoutes searches routes with a higher combined TVL first when enabled
+func (suite *KeeperTestSuite) TestBuildRoutesWithTVLWeighting() {
+	// Routes are not weighted by TVL by default
+	suite.Require().False(suite.App.ProtoRevKeeper.GetWeightRoutesByTVL(suite.Ctx))
+	routes := suite.App.ProtoRevKeeper.BuildRoutes(suite.Ctx, "akash", "Atom", 1)
+	suite.Require().Equal(2, len(routes))
+	suite.Require().True(routes[0].TotalValueLocked.IsNil())
+
+	// Enabling the mode is surfaced in the params
+	suite.App.ProtoRevKeeper.SetWeightRoutesByTVL(suite.Ctx, true)
+	suite.Require().True(suite.App.ProtoRevKeeper.GetParams(suite.Ctx).WeightRoutesByTVL)
+
+	// Routes are now ordered by descending combined TVL
+	routes = suite.App.ProtoRevKeeper.BuildRoutes(suite.Ctx, "akash", "Atom", 1)
+	suite.Require().Equal(2, len(routes))
+	for _, route := range routes {
+		suite.Require().Equal(suite.App.ProtoRevKeeper.GetRouteTotalValueLocked(suite.Ctx, route.Route), route.TotalValueLocked)
+		suite.Require().True(route.TotalValueLocked.IsPositive())
+	}
+	suite.Require().True(routes[0].TotalValueLocked.GTE(routes[1].TotalValueLocked))
+
+	// The TVL of a route is the liquidity of its pools valued in the route's base denom
+	route := routes[0].Route
+	baseDenom := route[len(route)-1].TokenOutDenom
+	expectedTVL := sdk.ZeroDec()
+	for _, poolId := range route.PoolIds() {
+		liquidity, err := suite.App.GAMMKeeper.GetTotalPoolLiquidity(suite.Ctx, poolId)
+		suite.Require().NoError(err)
+		for _, coin := range liquidity {
+			if coin.Denom == baseDenom {
+				expectedTVL = expectedTVL.Add(coin.Amount.ToDec())
+				continue
+			}
+
+			pricingPoolId, err := suite.App.ProtoRevKeeper.GetPoolForDenomPair(suite.Ctx, baseDenom, coin.Denom)
+			if err != nil {
+				continue
+			}
+			spotPrice, err := suite.App.PoolManagerKeeper.RouteCalculateSpotPrice(suite.Ctx, pricingPoolId, baseDenom, coin.Denom)
+			suite.Require().NoError(err)
+			expectedTVL = expectedTVL.Add(coin.Amount.ToDec().Mul(spotPrice))
+		}
+	}
+	suite.Require().Equal(expectedTVL.TruncateInt(), routes[0].TotalValueLocked)
+}
+
+// TestBuildRoutesWithBlacklist tests that BuildRoutes skips any route that touches a blacklisted pool
+func (suite *KeeperTestSuite) TestBuildRoutesWithBlacklist() {
+	// Blacklisting a pool only used by the hot route leaves the highest liquidity route
+	suite.App.ProtoRevKeeper.SetPoolBlacklist(suite.Ctx, []uint64{14})
+	routes := suite.App.ProtoRevKeeper.BuildRoutes(suite.Ctx, "akash", "Atom", 1)
+	suite.Require().Equal(1, len(routes))
+	suite.Require().Equal([]uint64{25, 1, 7}, routes[0].Route.PoolIds())
+
+	// Blacklisting the swapped pool leaves no routes at all
+	suite.App.ProtoRevKeeper.SetPoolBlacklist(suite.Ctx, []uint64{1})
+	routes = suite.App.ProtoRevKeeper.BuildRoutes(suite.Ctx, "akash", "Atom", 1)
+	suite.Require().Equal(0, len(routes))
+
+	// Clearing the blacklist restores all routes
+	suite.App.ProtoRevKeeper.SetPoolBlacklist(suite.Ctx, []uint64{})
+	routes = suite.App.ProtoRevKeeper.BuildRoutes(suite.Ctx, "akash", "Atom", 1)
+	suite.Require().Equal(2, len(routes))
+}
+
 // TestBuildHighestLiquidityRoute tests the BuildHighestLiquidityRoute function
 func (suite *KeeperTestSuite) TestBuildHighestLiquidityRoute() {
 	cases := []struct {
@@ -309,6 +438,91 @@ func (suite *KeeperTestSuite) TestBuildHotRoutes() {
 	}
 }
 
+// TestBuildHotRouteStepSize tests that hot routes without their own step size use the step size of their base denom
+func (suite *KeeperTestSuite) TestBuildHotRouteStepSize() {
+	err := suite.App.ProtoRevKeeper.SetBaseDenoms(suite.Ctx, []types.BaseDenom{
+		{Denom: types.OsmosisDenomination, StepSize: sdk.NewInt(1_000_000)},
+		{Denom: "Atom", StepSize: sdk.NewInt(5_000)},
+	})
+	suite.Require().NoError(err)
+
+	atomTrades := []types.Trade{
+		{Pool: 1, TokenIn: "Atom", TokenOut: "akash"},
+		{Pool: 0, TokenIn: "akash", TokenOut: "bitcoin"},
+		{Pool: 4, TokenIn: "bitcoin", TokenOut: "Atom"},
+	}
+
+	// The step size of the route's base denom is used if the route does not set one
+	route, err := suite.App.ProtoRevKeeper.BuildHotRoute(suite.Ctx, types.Route{Trades: atomTrades}, 14)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(5_000), route.StepSize)
+
+	// A step size set on the route takes precedence
+	route, err = suite.App.ProtoRevKeeper.BuildHotRoute(suite.Ctx, types.Route{Trades: atomTrades, StepSize: sdk.NewInt(10)}, 14)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(10), route.StepSize)
+
+	// Routes that do not start with a base denom must set their own step size
+	akashTrades := []types.Trade{
+		{Pool: 0, TokenIn: "akash", TokenOut: "bitcoin"},
+		{Pool: 4, TokenIn: "bitcoin", TokenOut: "Atom"},
+		{Pool: 1, TokenIn: "Atom", TokenOut: "akash"},
+	}
+	_, err = suite.App.ProtoRevKeeper.BuildHotRoute(suite.Ctx, types.Route{Trades: akashTrades}, 14)
+	suite.Require().Error(err)
+}
+
+// TestIntermediateOnlyBaseDenoms tests that base denoms that may only be used as an intermediate hop never seed routes
+func (suite *KeeperTestSuite) TestIntermediateOnlyBaseDenoms() {
+	// Every base denom can seed routes
+	routes, err := suite.App.ProtoRevKeeper.BuildHighestLiquidityRoutes(suite.Ctx, "akash", "Atom", 1)
+	suite.Require().NoError(err)
+	osmoRoutes := 0
+	for _, route := range routes {
+		if route.Route[len(route.Route)-1].TokenOutDenom == types.OsmosisDenomination {
+			osmoRoutes++
+		}
+	}
+	suite.Require().Greater(osmoRoutes, 0)
+
+	err = suite.App.ProtoRevKeeper.SetBaseDenoms(suite.Ctx, []types.BaseDenom{
+		{Denom: types.OsmosisDenomination, StepSize: sdk.NewInt(1_000_000), IntermediateOnly: true},
+		{Denom: "Atom", StepSize: sdk.NewInt(1_000_000)},
+		{Denom: "test/3", StepSize: sdk.NewInt(1_000_000)},
+	})
+	suite.Require().NoError(err)
+
+	intermediateOnly, err := suite.App.ProtoRevKeeper.IsIntermediateOnlyBaseDenom(suite.Ctx, types.OsmosisDenomination)
+	suite.Require().NoError(err)
+	suite.Require().True(intermediateOnly)
+	intermediateOnly, err = suite.App.ProtoRevKeeper.IsIntermediateOnlyBaseDenom(suite.Ctx, "Atom")
+	suite.Require().NoError(err)
+	suite.Require().False(intermediateOnly)
+
+	// Highest liquidity routes are no longer seeded with osmo
+	intermediateOnlyRoutes, err := suite.App.ProtoRevKeeper.BuildHighestLiquidityRoutes(suite.Ctx, "akash", "Atom", 1)
+	suite.Require().NoError(err)
+	suite.Require().Len(intermediateOnlyRoutes, len(routes)-osmoRoutes)
+	for _, route := range intermediateOnlyRoutes {
+		suite.Require().NotEqual(types.OsmosisDenomination, route.Route[len(route.Route)-1].TokenOutDenom)
+	}
+
+	// Hot routes cannot be seeded with osmo, but may still use it as an intermediate hop
+	_, err = suite.App.ProtoRevKeeper.BuildHotRoute(suite.Ctx, types.Route{Trades: []types.Trade{
+		{Pool: 7, TokenIn: types.OsmosisDenomination, TokenOut: "akash"},
+		{Pool: 0, TokenIn: "akash", TokenOut: "Atom"},
+		{Pool: 25, TokenIn: "Atom", TokenOut: types.OsmosisDenomination},
+	}}, 1)
+	suite.Require().Error(err)
+
+	_, err = suite.App.ProtoRevKeeper.BuildHotRoute(suite.Ctx, types.Route{Trades: []types.Trade{
+		{Pool: 25, TokenIn: "Atom", TokenOut: types.OsmosisDenomination},
+		{Pool: 7, TokenIn: types.OsmosisDenomination, TokenOut: "akash"},
+		{Pool: 0, TokenIn: "akash", TokenOut: "Atom"},
+	}}, 1)
+	suite.Require().NoError(err)
+}
+
 // TestCalculateRoutePoolPoints tests the CalculateRoutePoolPoints function
 func (suite *KeeperTestSuite) TestCalculateRoutePoolPoints() {
 	cases := []struct {
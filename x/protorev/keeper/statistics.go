@@ -122,6 +122,36 @@ func (k Keeper) GetAllRoutes(ctx sdk.Context) ([][]uint64, error) {
 	return routes, nil
 }
 
+// GetAllRouteStatistics returns the statistics of every route the module has traded
+func (k Keeper) GetAllRouteStatistics(ctx sdk.Context) ([]types.RouteStatistics, error) {
+	routes, err := k.GetAllRoutes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statistics := make([]types.RouteStatistics, len(routes))
+	for index, route := range routes {
+		numberOfTrades, err := k.GetTradesByRoute(ctx, route)
+		if err != nil {
+			return nil, err
+		}
+
+		// Routes that were traded before the last execution height was tracked default to zero
+		lastExecutionHeight, _ := k.GetLastExecutionByRoute(ctx, route)
+
+		statistics[index] = types.RouteStatistics{
+			NumberOfTrades:      numberOfTrades,
+			Profits:             k.GetAllProfitsByRoute(ctx, route),
+			Route:               route,
+			LastExecutionHeight: lastExecutionHeight,
+			NumberOfAttempts:    k.GetAttemptsByRoute(ctx, route),
+			SuccessRate:         k.GetSuccessRateByRoute(ctx, route),
+		}
+	}
+
+	return statistics, nil
+}
+
 // GetTradesByRoute returns the number of trades executed by the ProtoRev module for the given route
 func (k Keeper) GetTradesByRoute(ctx sdk.Context, route []uint64) (sdk.Int, error) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTradesByRoute)
@@ -209,6 +239,108 @@ func (k Keeper) UpdateProfitsByRoute(ctx sdk.Context, route []uint64, denom stri
 	return nil
 }
 
+// GetLastExecutionByRoute returns the block height of the last trade executed by the ProtoRev module on the given route
+func (k Keeper) GetLastExecutionByRoute(ctx sdk.Context, route []uint64) (uint64, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixLastExecutionByRoute)
+	key := types.GetKeyPrefixLastExecutionByRoute(route)
+
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return 0, fmt.Errorf("no trades for route %d", route)
+	}
+
+	return sdk.BigEndianToUint64(bz), nil
+}
+
+// SetLastExecutionByRoute sets the block height of the last trade executed by the ProtoRev module on the given route
+func (k Keeper) SetLastExecutionByRoute(ctx sdk.Context, route []uint64, blockHeight uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixLastExecutionByRoute)
+	key := types.GetKeyPrefixLastExecutionByRoute(route)
+
+	store.Set(key, sdk.Uint64ToBigEndian(blockHeight))
+}
+
+// GetAttemptsByRoute returns the number of times the ProtoRev module has searched the given route for a profitable
+// trade, including the attempts made in the current block that have not been flushed yet
+func (k Keeper) GetAttemptsByRoute(ctx sdk.Context, route []uint64) sdk.Int {
+	key := types.GetKeyPrefixAttemptsByRoute(route)
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAttemptsByRoute)
+	transientStore := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixAttemptsByRoute)
+
+	return getAttempts(store, key).Add(getAttempts(transientStore, key))
+}
+
+// IncrementAttemptsByRoute increments the number of times the ProtoRev module has searched the given route for a
+// profitable trade. The attempt is recorded in the transient store, so that searching routes does not write to
+// state, and is added to the route's attempts once per block by FlushAttemptsForBlock
+func (k Keeper) IncrementAttemptsByRoute(ctx sdk.Context, route []uint64) error {
+	transientStore := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixAttemptsByRoute)
+	return addAttempts(transientStore, types.GetKeyPrefixAttemptsByRoute(route), sdk.OneInt())
+}
+
+// FlushAttemptsForBlock adds the attempts recorded in the transient store during the current block to the
+// attempts of each route and clears them from the transient store
+func (k Keeper) FlushAttemptsForBlock(ctx sdk.Context) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAttemptsByRoute)
+	transientStore := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixAttemptsByRoute)
+
+	iterator := transientStore.Iterator(nil, nil)
+	keys := [][]byte{}
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		if err := addAttempts(store, key, getAttempts(transientStore, key)); err != nil {
+			return err
+		}
+		transientStore.Delete(key)
+	}
+
+	return nil
+}
+
+// getAttempts returns the number of attempts stored under the given key, or zero if there are none
+func getAttempts(store sdk.KVStore, key []byte) sdk.Int {
+	bz := store.Get(key)
+	if len(bz) == 0 {
+		return sdk.ZeroInt()
+	}
+
+	attempts := sdk.Int{}
+	if err := attempts.Unmarshal(bz); err != nil {
+		return sdk.ZeroInt()
+	}
+	return attempts
+}
+
+// addAttempts adds the given number of attempts to the attempts stored under the given key
+func addAttempts(store sdk.KVStore, key []byte, numAttempts sdk.Int) error {
+	attempts := getAttempts(store, key).Add(numAttempts)
+	bz, err := attempts.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(key, bz)
+	return nil
+}
+
+// GetSuccessRateByRoute returns the fraction of attempts on the given route that resulted in a trade, i.e. the number
+// of trades executed on the route divided by the number of times the route was searched. Routes that have never been
+// attempted have a success rate of zero. Since attempts were not tracked from genesis, routes that traded before
+// attempts were tracked can have a success rate above one until their attempts catch up.
+func (k Keeper) GetSuccessRateByRoute(ctx sdk.Context, route []uint64) sdk.Dec {
+	attempts := k.GetAttemptsByRoute(ctx, route)
+	if attempts.IsZero() {
+		return sdk.ZeroDec()
+	}
+
+	trades, _ := k.GetTradesByRoute(ctx, route)
+	return trades.ToDec().QuoInt(attempts)
+}
+
 // UpdateStatistics updates the module statistics after each trade is executed
 func (k Keeper) UpdateStatistics(ctx sdk.Context, route poolmanagertypes.SwapAmountInRoutes, denom string, profit sdk.Int) error {
 	// Increment the number of trades executed by the ProtoRev module
@@ -231,5 +363,94 @@ func (k Keeper) UpdateStatistics(ctx sdk.Context, route poolmanagertypes.SwapAmo
 		return err
 	}
 
+	// Record the block height at which the module last executed a trade on the given route
+	k.SetLastExecutionByRoute(ctx, route.PoolIds(), uint64(ctx.BlockHeight()))
+
 	return nil
 }
+
+// GetTotalProfitInDenom values each of the profits made by the ProtoRev module in the target denom and returns
+// the sum alongside the per denom breakdown. A profit is valued by estimating the output of swapping the entire
+// profit into the target denom through the highest liquidity pool between the two denoms. This is an on-chain
+// estimate that is subject to the liquidity of the pools at the time of the query. Profits that cannot be valued
+// (because there is no known pool between the profit denom and the target denom or the swap estimate fails) are
+// reported with a zero value and are not included in the total.
+func (k Keeper) GetTotalProfitInDenom(ctx sdk.Context, targetDenom string) (sdk.Coin, []types.ProfitValuation) {
+	total := sdk.NewCoin(targetDenom, sdk.ZeroInt())
+	valuations := make([]types.ProfitValuation, 0)
+
+	for _, profit := range k.GetAllProfits(ctx) {
+		value := k.valueInDenom(ctx, profit, targetDenom)
+		total = total.Add(value)
+		valuations = append(valuations, types.ProfitValuation{Profit: profit, Value: value})
+	}
+
+	return total, valuations
+}
+
+// valueInDenom estimates the value of the given coin in the target denom. Returns a zero coin if the coin cannot be valued.
+func (k Keeper) valueInDenom(ctx sdk.Context, coin sdk.Coin, targetDenom string) sdk.Coin {
+	if coin.Denom == targetDenom {
+		return coin
+	}
+
+	zero := sdk.NewCoin(targetDenom, sdk.ZeroInt())
+	if coin.Amount.IsZero() {
+		return zero
+	}
+
+	// Profits are always made in base denoms, but the target denom may or may not be a base denom
+	poolId, err := k.GetPoolForDenomPair(ctx, coin.Denom, targetDenom)
+	if err != nil {
+		poolId, err = k.GetPoolForDenomPair(ctx, targetDenom, coin.Denom)
+		if err != nil {
+			return zero
+		}
+	}
+
+	route := []poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: targetDenom}}
+	amountOut, err := k.poolmanagerKeeper.MultihopEstimateOutGivenExactAmountIn(ctx, route, coin)
+	if err != nil {
+		return zero
+	}
+
+	return sdk.NewCoin(targetDenom, amountOut)
+}
+
+// GetArbitrageGasConsumed returns the cumulative gas consumed by arbitrage backruns by the ProtoRev module
+func (k Keeper) GetArbitrageGasConsumed(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixArbitrageGasConsumed)
+	bz := store.Get(types.KeyPrefixArbitrageGasConsumed)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// GetArbitrageGasConsumedForBlock returns the gas consumed by arbitrage backruns in the current block. The value
+// is reset by the posthandler on the first transaction of every block, and an unset value is treated as no gas consumed.
+func (k Keeper) GetArbitrageGasConsumedForBlock(ctx sdk.Context) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixArbitrageGasConsumedForBlock)
+	bz := store.Get(types.KeyPrefixArbitrageGasConsumedForBlock)
+	if bz == nil {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetArbitrageGasConsumedForBlock sets the gas consumed by arbitrage backruns in the current block
+func (k Keeper) SetArbitrageGasConsumedForBlock(ctx sdk.Context, gasConsumed uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixArbitrageGasConsumedForBlock)
+	store.Set(types.KeyPrefixArbitrageGasConsumedForBlock, sdk.Uint64ToBigEndian(gasConsumed))
+}
+
+// AddArbitrageGasConsumed adds the gas consumed by a single arbitrage backrun to both the cumulative
+// and the current block totals
+func (k Keeper) AddArbitrageGasConsumed(ctx sdk.Context, gasConsumed uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixArbitrageGasConsumed)
+	store.Set(types.KeyPrefixArbitrageGasConsumed, sdk.Uint64ToBigEndian(k.GetArbitrageGasConsumed(ctx)+gasConsumed))
+
+	k.SetArbitrageGasConsumedForBlock(ctx, k.GetArbitrageGasConsumedForBlock(ctx)+gasConsumed)
+}
@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	poolmanagertypes "github.com/osmosis-labs/osmosis/v15/x/poolmanager/types"
+	"github.com/osmosis-labs/osmosis/v15/x/protorev/keeper"
 	"github.com/osmosis-labs/osmosis/v15/x/protorev/types"
 )
 
@@ -106,6 +107,51 @@ func (suite *KeeperTestSuite) TestGetTradesByRoute() {
 	suite.Require().Equal([]uint64{2, 3, 4}, routes[1])
 }
 
+// TestGetSuccessRateByRoute tests GetAttemptsByRoute, IncrementAttemptsByRoute, FlushAttemptsForBlock, and GetSuccessRateByRoute
+func (suite *KeeperTestSuite) TestGetSuccessRateByRoute() {
+	route := []uint64{1, 2, 3}
+
+	// Routes that have never been attempted have no attempts and a success rate of zero
+	suite.Require().Equal(sdk.ZeroInt(), suite.App.ProtoRevKeeper.GetAttemptsByRoute(suite.Ctx, route))
+	suite.Require().Equal(sdk.ZeroDec(), suite.App.ProtoRevKeeper.GetSuccessRateByRoute(suite.Ctx, route))
+
+	// Trades without tracked attempts do not count towards the success rate
+	err := suite.App.ProtoRevKeeper.IncrementTradesByRoute(suite.Ctx, route)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.ZeroDec(), suite.App.ProtoRevKeeper.GetSuccessRateByRoute(suite.Ctx, route))
+
+	// One trade out of four attempts
+	for i := 0; i < 4; i++ {
+		err = suite.App.ProtoRevKeeper.IncrementAttemptsByRoute(suite.Ctx, route)
+		suite.Require().NoError(err)
+	}
+	suite.Require().Equal(sdk.NewInt(4), suite.App.ProtoRevKeeper.GetAttemptsByRoute(suite.Ctx, route))
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.25"), suite.App.ProtoRevKeeper.GetSuccessRateByRoute(suite.Ctx, route))
+
+	// Flushing the attempts of the block does not change the number of attempts, and flushing again is a no-op
+	for i := 0; i < 2; i++ {
+		err = suite.App.ProtoRevKeeper.FlushAttemptsForBlock(suite.Ctx)
+		suite.Require().NoError(err)
+		suite.Require().Equal(sdk.NewInt(4), suite.App.ProtoRevKeeper.GetAttemptsByRoute(suite.Ctx, route))
+	}
+
+	// Later attempts are added to the flushed attempts
+	err = suite.App.ProtoRevKeeper.IncrementAttemptsByRoute(suite.Ctx, route)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(5), suite.App.ProtoRevKeeper.GetAttemptsByRoute(suite.Ctx, route))
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.2"), suite.App.ProtoRevKeeper.GetSuccessRateByRoute(suite.Ctx, route))
+
+	// The success rate is not capped if trades predate the tracked attempts
+	for i := 0; i < 5; i++ {
+		err = suite.App.ProtoRevKeeper.IncrementTradesByRoute(suite.Ctx, route)
+		suite.Require().NoError(err)
+	}
+	suite.Require().Equal(sdk.MustNewDecFromStr("1.2"), suite.App.ProtoRevKeeper.GetSuccessRateByRoute(suite.Ctx, route))
+
+	// Other routes are not affected
+	suite.Require().Equal(sdk.ZeroInt(), suite.App.ProtoRevKeeper.GetAttemptsByRoute(suite.Ctx, []uint64{2, 3, 4}))
+}
+
 // TestGetProfitsByRoute tests GetProfitsByRoute, UpdateProfitsByRoute, and GetAllProfitsByRoute
 func (suite *KeeperTestSuite) TestGetProfitsByRoute() {
 	// There should be no profits that have been executed by default
@@ -165,11 +211,29 @@ func (suite *KeeperTestSuite) TestUpdateStatistics() {
 	suite.Require().NoError(err)
 	suite.Require().Equal(sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(1000)), profit)
 
+	// Check the result of GetLastExecutionByRoute
+	lastExecution, err := suite.App.ProtoRevKeeper.GetLastExecutionByRoute(suite.Ctx, []uint64{1, 2, 3})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(suite.Ctx.BlockHeight()), lastExecution)
+
 	// Check the result of GetAllRoutes
 	routes, err := suite.App.ProtoRevKeeper.GetAllRoutes(suite.Ctx)
 	suite.Require().NoError(err)
 	suite.Require().Equal(1, len(routes))
 
+	// Psuedo execute a trade on the same route in a later block
+	suite.Ctx = suite.Ctx.WithBlockHeight(suite.Ctx.BlockHeight() + 10)
+	err = suite.App.ProtoRevKeeper.UpdateStatistics(suite.Ctx,
+		poolmanagertypes.SwapAmountInRoutes{{TokenOutDenom: "", PoolId: 1}, {TokenOutDenom: "", PoolId: 2}, {TokenOutDenom: "", PoolId: 3}},
+		types.OsmosisDenomination, sdk.NewInt(1000),
+	)
+	suite.Require().NoError(err)
+
+	// The last execution height should be bumped to the current block
+	lastExecution, err = suite.App.ProtoRevKeeper.GetLastExecutionByRoute(suite.Ctx, []uint64{1, 2, 3})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(suite.Ctx.BlockHeight()), lastExecution)
+
 	// Psuedo execute a second trade
 	err = suite.App.ProtoRevKeeper.UpdateStatistics(suite.Ctx,
 		poolmanagertypes.SwapAmountInRoutes{{TokenOutDenom: "", PoolId: 2}, {TokenOutDenom: "", PoolId: 3}, {TokenOutDenom: "", PoolId: 4}},
@@ -191,4 +255,85 @@ func (suite *KeeperTestSuite) TestUpdateStatistics() {
 	routes, err = suite.App.ProtoRevKeeper.GetAllRoutes(suite.Ctx)
 	suite.Require().NoError(err)
 	suite.Require().Equal(2, len(routes))
+
+	// A route that has never been traded has no last execution height
+	_, err = suite.App.ProtoRevKeeper.GetLastExecutionByRoute(suite.Ctx, []uint64{5, 6, 7})
+	suite.Require().Error(err)
+}
+
+// TestGetTotalProfitInDenom tests GetTotalProfitInDenom
+func (suite *KeeperTestSuite) TestGetTotalProfitInDenom() {
+	// Should be zero if no profits have been made
+	total, valuations := suite.App.ProtoRevKeeper.GetTotalProfitInDenom(suite.Ctx, types.OsmosisDenomination)
+	suite.Require().Equal(sdk.NewCoin(types.OsmosisDenomination, sdk.ZeroInt()), total)
+	suite.Require().Empty(valuations)
+
+	// Pseudo execute trades in the target denom, a denom that has a pool with the target denom
+	// and a denom that does not
+	err := suite.App.ProtoRevKeeper.UpdateProfitsByDenom(suite.Ctx, types.OsmosisDenomination, sdk.NewInt(9000))
+	suite.Require().NoError(err)
+	err = suite.App.ProtoRevKeeper.UpdateProfitsByDenom(suite.Ctx, "Atom", sdk.NewInt(1000))
+	suite.Require().NoError(err)
+	err = suite.App.ProtoRevKeeper.UpdateProfitsByDenom(suite.Ctx, "nopool", sdk.NewInt(1000))
+	suite.Require().NoError(err)
+
+	// The Atom profit is valued by swapping it through the highest liquidity pool into the target denom
+	poolId, err := suite.App.ProtoRevKeeper.GetPoolForDenomPair(suite.Ctx, "Atom", types.OsmosisDenomination)
+	suite.Require().NoError(err)
+	atomValue, err := suite.App.PoolManagerKeeper.MultihopEstimateOutGivenExactAmountIn(
+		suite.Ctx,
+		[]poolmanagertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: types.OsmosisDenomination}},
+		sdk.NewCoin("Atom", sdk.NewInt(1000)),
+	)
+	suite.Require().NoError(err)
+
+	total, valuations = suite.App.ProtoRevKeeper.GetTotalProfitInDenom(suite.Ctx, types.OsmosisDenomination)
+	suite.Require().Equal(sdk.NewCoin(types.OsmosisDenomination, atomValue.Add(sdk.NewInt(9000))), total)
+	suite.Require().ElementsMatch([]types.ProfitValuation{
+		{Profit: sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(9000)), Value: sdk.NewCoin(types.OsmosisDenomination, sdk.NewInt(9000))},
+		{Profit: sdk.NewCoin("Atom", sdk.NewInt(1000)), Value: sdk.NewCoin(types.OsmosisDenomination, atomValue)},
+		{Profit: sdk.NewCoin("nopool", sdk.NewInt(1000)), Value: sdk.NewCoin(types.OsmosisDenomination, sdk.ZeroInt())},
+	}, valuations)
+}
+
+// TestArbitrageGasConsumed tests GetArbitrageGasConsumed, GetArbitrageGasConsumedForBlock, AddArbitrageGasConsumed
+// and that ProtoRevTrade records the gas consumed by the whole backrun
+func (suite *KeeperTestSuite) TestArbitrageGasConsumed() {
+	// Should be zero by default
+	suite.Require().Equal(uint64(0), suite.App.ProtoRevKeeper.GetArbitrageGasConsumed(suite.Ctx))
+	suite.Require().Equal(uint64(0), suite.App.ProtoRevKeeper.GetArbitrageGasConsumedForBlock(suite.Ctx))
+
+	// Gas is added to both the cumulative and the block totals
+	suite.App.ProtoRevKeeper.AddArbitrageGasConsumed(suite.Ctx, 1000)
+	suite.App.ProtoRevKeeper.AddArbitrageGasConsumed(suite.Ctx, 500)
+	suite.Require().Equal(uint64(1500), suite.App.ProtoRevKeeper.GetArbitrageGasConsumed(suite.Ctx))
+	suite.Require().Equal(uint64(1500), suite.App.ProtoRevKeeper.GetArbitrageGasConsumedForBlock(suite.Ctx))
+
+	// Resetting the block total does not affect the cumulative total
+	suite.App.ProtoRevKeeper.SetArbitrageGasConsumedForBlock(suite.Ctx, 0)
+	suite.Require().Equal(uint64(1500), suite.App.ProtoRevKeeper.GetArbitrageGasConsumed(suite.Ctx))
+	suite.Require().Equal(uint64(0), suite.App.ProtoRevKeeper.GetArbitrageGasConsumedForBlock(suite.Ctx))
+
+	// Backrunning a swap records the gas consumed by the backrun
+	swappedPools := []keeper.SwapToBackrun{{
+		PoolId:        23,
+		TokenInDenom:  "ibc/0EF15DF2F02480ADE0BB6E85D9EBB5DAEA2836D3860E9F97F9AADE4F57A31AA0",
+		TokenOutDenom: "ibc/BE1BB42D4BE3C30D50B68D7C41DB4DFCE9678E8EF8C539F6E6A9345048894FCC",
+	}}
+	err := suite.App.ProtoRevKeeper.ProtoRevTrade(suite.Ctx, swappedPools, nil)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), suite.App.ProtoRevKeeper.GetTradeCountForBlock(suite.Ctx))
+
+	blockGas := suite.App.ProtoRevKeeper.GetArbitrageGasConsumedForBlock(suite.Ctx)
+	suite.Require().Greater(blockGas, uint64(0))
+	suite.Require().Equal(uint64(1500)+blockGas, suite.App.ProtoRevKeeper.GetArbitrageGasConsumed(suite.Ctx))
+
+	// The block total is reset by the posthandler on the first transaction of a new block
+	latestBlockHeight, err := suite.App.ProtoRevKeeper.GetLatestBlockHeight(suite.Ctx)
+	suite.Require().NoError(err)
+	ctx := suite.Ctx.WithBlockHeight(int64(latestBlockHeight) + 1)
+	err = suite.App.ProtoRevKeeper.AnteHandleCheck(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), suite.App.ProtoRevKeeper.GetArbitrageGasConsumedForBlock(ctx))
+	suite.Require().Equal(uint64(1500)+blockGas, suite.App.ProtoRevKeeper.GetArbitrageGasConsumed(ctx))
 }
@@ -169,6 +169,11 @@ func (AppModule) ConsensusVersion() uint64 { return 1 }
 func (am AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
 
 // EndBlock contains the logic that is automatically triggered at the end of each block
-func (am AppModule) EndBlock(_ sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+func (am AppModule) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) []abci.ValidatorUpdate {
+	// Add the route attempts made during the block to the route statistics
+	if err := am.keeper.FlushAttemptsForBlock(ctx); err != nil {
+		am.keeper.Logger(ctx).Error("Error flushing route attempts: ", err)
+	}
+
 	return []abci.ValidatorUpdate{}
 }
@@ -17,6 +17,8 @@ func NewProtoRevProposalHandler(k keeper.Keeper) govtypes.Handler {
 			return HandleSetProtoRevAdminAccount(ctx, k, c)
 		case *types.SetProtoRevEnabledProposal:
 			return HandleEnabledProposal(ctx, k, c)
+		case *types.SetProtoRevDeveloperFeeShareProposal:
+			return HandleSetProtoRevDeveloperFeeShare(ctx, k, c)
 		default:
 			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized %s proposal content type: %T", types.ModuleName, c)
 		}
@@ -41,3 +43,20 @@ func HandleEnabledProposal(ctx sdk.Context, k keeper.Keeper, p *types.SetProtoRe
 	k.SetProtoRevEnabled(ctx, p.Enabled)
 	return nil
 }
+
+// HandleSetProtoRevDeveloperFeeShare handles a proposal to set the fraction of each trade's profit that is allocated to
+// the developer account. The share replaces the default schedule and is emitted in its own event for auditability.
+func HandleSetProtoRevDeveloperFeeShare(ctx sdk.Context, k keeper.Keeper, p *types.SetProtoRevDeveloperFeeShareProposal) error {
+	if err := k.SetDeveloperFeeShare(ctx, p.DeveloperFeeShare); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEvtSetDeveloperFeeShare,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyDeveloperFeeShare, p.DeveloperFeeShare.String()),
+		),
+	)
+	return nil
+}
@@ -22,10 +22,12 @@ const (
 	setMaxPoolPointsPerBlock = "osmosis/MsgSetMaxPoolPointsPerBlock"
 	setPoolWeights           = "osmosis/MsgSetPoolWeights"
 	setBaseDenoms            = "osmosis/MsgSetBaseDenoms"
+	setPoolBlacklist         = "osmosis/MsgSetPoolBlacklist"
 
 	// proposals
-	setProtoRevEnabledProposal      = "osmosis/SetProtoRevEnabledProposal"
-	setProtoRevAdminAccountProposal = "osmosis/SetProtoRevAdminAccountProposal"
+	setProtoRevEnabledProposal           = "osmosis/SetProtoRevEnabledProposal"
+	setProtoRevAdminAccountProposal      = "osmosis/SetProtoRevAdminAccountProposal"
+	setProtoRevDeveloperFeeShareProposal = "osmosis/SetProtoRevDeveloperFeeShareProposal"
 )
 
 func init() {
@@ -42,10 +44,12 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSetMaxPoolPointsPerBlock{}, setMaxPoolPointsPerBlock, nil)
 	cdc.RegisterConcrete(&MsgSetPoolWeights{}, setPoolWeights, nil)
 	cdc.RegisterConcrete(&MsgSetBaseDenoms{}, setBaseDenoms, nil)
+	cdc.RegisterConcrete(&MsgSetPoolBlacklist{}, setPoolBlacklist, nil)
 
 	// proposals
 	cdc.RegisterConcrete(&SetProtoRevEnabledProposal{}, setProtoRevEnabledProposal, nil)
 	cdc.RegisterConcrete(&SetProtoRevAdminAccountProposal{}, setProtoRevAdminAccountProposal, nil)
+	cdc.RegisterConcrete(&SetProtoRevDeveloperFeeShareProposal{}, setProtoRevDeveloperFeeShareProposal, nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -57,6 +61,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgSetMaxPoolPointsPerBlock{},
 		&MsgSetPoolWeights{},
 		&MsgSetBaseDenoms{},
+		&MsgSetPoolBlacklist{},
 	)
 
 	// proposals
@@ -64,6 +69,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*govtypes.Content)(nil),
 		&SetProtoRevEnabledProposal{},
 		&SetProtoRevAdminAccountProposal{},
+		&SetProtoRevDeveloperFeeShareProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
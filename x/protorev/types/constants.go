@@ -19,6 +19,10 @@ var ExtendedMaxInputAmount = sdk.NewInt(131_072)
 // Max iterations for binary search (log2(131_072) = 17)
 const MaxIterations int = 17
 
+// MaxPriceShiftDoublings is the maximum number of times the input of the swap used to project a price shift is doubled
+// before giving up, bounding the input at 2 ^ 128
+const MaxPriceShiftDoublings int = 128
+
 // Max number of pool points that can be consumed per tx. This roughly corresponds
 // to the maximum execution time (in ms) of protorev per tx
 const MaxPoolPointsPerTx uint64 = 50
@@ -0,0 +1,12 @@
+package types
+
+const (
+	TypeEvtSearcherReward       = "protorev_searcher_reward"
+	TypeEvtSetDeveloperFeeShare = "protorev_set_developer_fee_share"
+
+	AttributeValueCategory        = ModuleName
+	AttributeKeySearcher          = "searcher"
+	AttributeKeyReward            = "reward"
+	AttributeKeyRoute             = "route"
+	AttributeKeyDeveloperFeeShare = "developer_fee_share"
+)
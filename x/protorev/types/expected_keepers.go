@@ -29,6 +29,7 @@ type GAMMKeeper interface {
 	GetPoolsAndPoke(ctx sdk.Context) (res []gammtypes.CFMMPoolI, err error)
 	GetPoolDenoms(ctx sdk.Context, poolId uint64) ([]string, error)
 	GetPoolType(ctx sdk.Context, poolId uint64) (poolmanagertypes.PoolType, error)
+	GetTotalPoolLiquidity(ctx sdk.Context, poolId uint64) (sdk.Coins, error)
 }
 
 // PoolManagerKeeper defines the PoolManager contract that must be fulfilled when
@@ -46,6 +47,13 @@ type PoolManagerKeeper interface {
 		routes []poolmanagertypes.SwapAmountInRoute,
 		tokenIn sdk.Coin,
 	) (tokenOutAmount sdk.Int, err error)
+
+	RouteCalculateSpotPrice(
+		ctx sdk.Context,
+		poolId uint64,
+		quoteAssetDenom string,
+		baseAssetDenom string,
+	) (price sdk.Dec, err error)
 }
 
 // EpochKeeper defines the Epoch contract that must be fulfilled when
@@ -28,6 +28,7 @@ var (
 	DefaultMaxPoolPointsPerBlock     = uint64(100)
 	DefaultMaxPoolPointsPerTx        = uint64(18)
 	DefaultPoolPointsConsumedInBlock = uint64(0)
+	DefaultPoolBlacklist             = []uint64{}
 )
 
 // DefaultGenesis returns the default genesis state
@@ -44,6 +45,7 @@ func DefaultGenesis() *GenesisState {
 		MaxPoolPointsPerBlock:  DefaultMaxPoolPointsPerBlock,
 		MaxPoolPointsPerTx:     DefaultMaxPoolPointsPerTx,
 		PointCountForBlock:     DefaultPoolPointsConsumedInBlock,
+		PoolBlacklist:          DefaultPoolBlacklist,
 	}
 }
 
@@ -76,6 +78,13 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	// Validate the developer fee share if it is set
+	if gs.DeveloperFeeShare != nil {
+		if err := ValidateDeveloperFeeShare(*gs.DeveloperFeeShare); err != nil {
+			return err
+		}
+	}
+
 	// Validate the max pool points per block
 	if err := ValidateMaxPoolPointsPerBlock(gs.MaxPoolPointsPerBlock); err != nil {
 		return err
@@ -86,6 +95,11 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
+	// Validate the pool blacklist
+	if err := ValidatePoolBlacklist(gs.PoolBlacklist); err != nil {
+		return err
+	}
+
 	return gs.Params.Validate()
 }
 
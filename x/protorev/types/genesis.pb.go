@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
@@ -53,6 +54,11 @@ type GenesisState struct {
 	MaxPoolPointsPerTx uint64 `protobuf:"varint,10,opt,name=max_pool_points_per_tx,json=maxPoolPointsPerTx,proto3" json:"max_pool_points_per_tx,omitempty" yaml:"max_pool_points_per_tx"`
 	// The number of pool points that have been consumed in the current block.
 	PointCountForBlock uint64 `protobuf:"varint,11,opt,name=point_count_for_block,json=pointCountForBlock,proto3" json:"point_count_for_block,omitempty" yaml:"point_count_for_block"`
+	// The ids of the pools that must never be included in arbitrage routes.
+	PoolBlacklist []uint64 `protobuf:"varint,12,rep,packed,name=pool_blacklist,json=poolBlacklist,proto3" json:"pool_blacklist,omitempty" yaml:"pool_blacklist"`
+	// The fraction of each trade's profit allocated to the developer account,
+	// if it has been set by governance. If unset, the default schedule applies.
+	DeveloperFeeShare *github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,13,opt,name=developer_fee_share,json=developerFeeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"developer_fee_share,omitempty" yaml:"developer_fee_share"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -165,6 +171,13 @@ func (m *GenesisState) GetPointCountForBlock() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetPoolBlacklist() []uint64 {
+	if m != nil {
+		return m.PoolBlacklist
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "osmosis.protorev.v1beta1.GenesisState")
 }
@@ -174,49 +187,54 @@ func init() {
 }
 
 var fileDescriptor_3c77fc2da5752af2 = []byte{
-	// 660 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0x63, 0x5a, 0x4a, 0xbb, 0x29, 0x15, 0xdd, 0x92, 0xca, 0x09, 0xd4, 0x31, 0xa6, 0x85,
-	0x1c, 0xa8, 0xad, 0x16, 0xb8, 0x70, 0x40, 0xaa, 0x8b, 0x0a, 0x17, 0xaa, 0xc8, 0x2d, 0x42, 0x02,
-	0x89, 0x65, 0x9d, 0x6c, 0x53, 0xab, 0xb6, 0xd7, 0xf2, 0x6e, 0x42, 0xfa, 0x00, 0xdc, 0x39, 0xf3,
-	0x1c, 0x3c, 0x44, 0x8f, 0x15, 0x27, 0x4e, 0x11, 0x6a, 0xdf, 0x20, 0x4f, 0x80, 0xf6, 0x4f, 0x92,
-	0x52, 0x62, 0x6e, 0xd9, 0x99, 0xdf, 0x7c, 0xdf, 0xcc, 0xec, 0xc6, 0xe0, 0x11, 0x65, 0x09, 0x65,
-	0x11, 0xf3, 0xb2, 0x9c, 0x72, 0x9a, 0x93, 0x9e, 0xd7, 0xdb, 0x0a, 0x09, 0xc7, 0x5b, 0x5e, 0x87,
-	0xa4, 0x84, 0x45, 0xcc, 0x95, 0x09, 0x68, 0x6a, 0xce, 0x1d, 0x71, 0xae, 0xe6, 0x6a, 0x77, 0x3b,
-	0xb4, 0x43, 0x65, 0xd4, 0x13, 0xbf, 0x14, 0x50, 0x7b, 0x5c, 0xa8, 0x3b, 0x16, 0x50, 0xe0, 0x46,
-	0x31, 0x88, 0x73, 0x9c, 0x68, 0xc3, 0x5a, 0xb5, 0x25, 0x39, 0xa4, 0x8c, 0xd4, 0x41, 0xa7, 0x2c,
-	0x75, 0xf2, 0x42, 0xcc, 0xc8, 0xb8, 0xb8, 0x45, 0xa3, 0x54, 0xe5, 0x9d, 0xef, 0xf3, 0x60, 0xf1,
-	0xb5, 0x1a, 0xe6, 0x80, 0x63, 0x4e, 0xe0, 0x4b, 0x30, 0xa7, 0xb4, 0x4d, 0xc3, 0x36, 0x1a, 0xe5,
-	0x6d, 0xdb, 0x2d, 0x1a, 0xce, 0x6d, 0x4a, 0xce, 0x9f, 0x3d, 0x1b, 0xd4, 0x4b, 0x81, 0xae, 0x82,
-	0x5f, 0x0d, 0x50, 0xe1, 0xf4, 0x84, 0xa4, 0x28, 0xc3, 0x51, 0x8e, 0x70, 0x1e, 0xa2, 0x9c, 0x76,
-	0x39, 0x61, 0xe6, 0x0d, 0x7b, 0xa6, 0x51, 0xde, 0x7e, 0x52, 0xac, 0x77, 0x28, 0xca, 0x9a, 0x38,
-	0xca, 0x77, 0xf2, 0x30, 0x90, 0x35, 0xfe, 0xba, 0xd0, 0x1e, 0x0e, 0xea, 0xf7, 0x4f, 0x71, 0x12,
-	0xbf, 0x70, 0xa6, 0x0a, 0x3b, 0x01, 0xe4, 0xff, 0x54, 0xc2, 0xcf, 0xa0, 0x2c, 0x66, 0x46, 0x6d,
-	0x92, 0xd2, 0x84, 0x99, 0x33, 0xd2, 0xfc, 0x61, 0xb1, 0xb9, 0x8f, 0x19, 0x79, 0x25, 0x58, 0xbf,
-	0xa6, 0x3d, 0xa1, 0xf2, 0xbc, 0xa2, 0xe2, 0x04, 0x20, 0x1c, 0x61, 0x0c, 0x12, 0xb0, 0x98, 0x51,
-	0x1a, 0xa3, 0x2f, 0x24, 0xea, 0x1c, 0x73, 0x66, 0xce, 0xca, 0x7d, 0x6d, 0xfc, 0x67, 0x5f, 0x94,
-	0xc6, 0xef, 0x15, 0xec, 0xdf, 0xd3, 0x26, 0x2b, 0xca, 0xe4, 0xaa, 0x90, 0x13, 0x94, 0xb3, 0x09,
-	0x09, 0x11, 0xa8, 0xb6, 0xf1, 0x29, 0x43, 0x2c, 0x4a, 0x5b, 0x04, 0x25, 0xb4, 0xdd, 0x8d, 0x09,
-	0xd2, 0xef, 0xcf, 0xbc, 0x69, 0x1b, 0x8d, 0x59, 0x7f, 0x7d, 0x38, 0xa8, 0xdb, 0x4a, 0xa8, 0x10,
-	0x75, 0x82, 0x55, 0x91, 0x3b, 0x10, 0xa9, 0xb7, 0x32, 0xa3, 0xaf, 0x1d, 0x22, 0xb0, 0xd4, 0x26,
-	0x3d, 0x12, 0xd3, 0x8c, 0xe4, 0xe8, 0x88, 0x10, 0x66, 0xce, 0xc9, 0x65, 0x55, 0x5d, 0xfd, 0x92,
-	0xc4, 0xcc, 0xe3, 0x21, 0x76, 0x69, 0x94, 0xfa, 0x6b, 0xba, 0xfb, 0x8a, 0x36, 0xfd, 0xab, 0xdc,
-	0x09, 0x6e, 0x8f, 0x03, 0x7b, 0x84, 0x30, 0xb8, 0x0f, 0x56, 0x62, 0xcc, 0x09, 0xe3, 0x28, 0x8c,
-	0x69, 0xeb, 0x04, 0x1d, 0xcb, 0xc9, 0xcc, 0x5b, 0xb2, 0x77, 0x6b, 0x38, 0xa8, 0xd7, 0x94, 0xcc,
-	0x14, 0xc8, 0x09, 0x96, 0x55, 0xd4, 0x17, 0xc1, 0x37, 0x32, 0x06, 0x3f, 0x82, 0xe5, 0x89, 0x23,
-	0x6e, 0xb7, 0x73, 0xc2, 0x98, 0x39, 0x6f, 0x1b, 0x8d, 0x05, 0xdf, 0x1d, 0x0e, 0xea, 0xe6, 0xf5,
-	0xa6, 0x34, 0xe2, 0xfc, 0xfc, 0xb1, 0xb9, 0xa4, 0x47, 0xda, 0x51, 0xa1, 0xe0, 0xce, 0x98, 0xd2,
-	0x11, 0xf8, 0x09, 0x54, 0x13, 0xdc, 0x47, 0xf2, 0x42, 0x32, 0x1a, 0xa5, 0x9c, 0x21, 0xa1, 0x21,
-	0x9b, 0x32, 0x17, 0xae, 0xaf, 0xbb, 0x10, 0x75, 0x82, 0x4a, 0x82, 0xfb, 0xe2, 0xc6, 0x9b, 0x32,
-	0xd3, 0x24, 0xb9, 0x1c, 0x01, 0xbe, 0x03, 0xab, 0xd3, 0x8a, 0x78, 0xdf, 0x04, 0x52, 0xfc, 0xc1,
-	0x70, 0x50, 0x5f, 0x2b, 0x16, 0xe7, 0x7d, 0x27, 0x80, 0xd7, 0x95, 0x0f, 0xfb, 0xf0, 0x00, 0x54,
-	0x24, 0x85, 0x5a, 0xb4, 0x9b, 0x72, 0x74, 0x44, 0x47, 0x2d, 0x97, 0xa5, 0xaa, 0x3d, 0xf9, 0x0f,
-	0x4d, 0xc5, 0x9c, 0x00, 0xca, 0xf8, 0xae, 0x08, 0xef, 0x51, 0xd5, 0xab, 0xbf, 0x7f, 0x76, 0x61,
-	0x19, 0xe7, 0x17, 0x96, 0xf1, 0xfb, 0xc2, 0x32, 0xbe, 0x5d, 0x5a, 0xa5, 0xf3, 0x4b, 0xab, 0xf4,
-	0xeb, 0xd2, 0x2a, 0x7d, 0x78, 0xd6, 0x89, 0xf8, 0x71, 0x37, 0x74, 0x5b, 0x34, 0xf1, 0xf4, 0x7b,
-	0xdf, 0x8c, 0x71, 0xc8, 0x46, 0x07, 0xaf, 0xb7, 0xf5, 0xdc, 0xeb, 0x4f, 0x3e, 0x5b, 0xfc, 0x34,
-	0x23, 0x2c, 0x9c, 0x93, 0xe7, 0xa7, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0x40, 0xe7, 0x7a, 0x25,
-	0x58, 0x05, 0x00, 0x00,
+	// 742 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x16, 0x6b, 0xd5, 0xad, 0x57, 0xb6, 0x51, 0xaf, 0x2b, 0x83, 0x52, 0x6b, 0x92, 0x65, 0x6d,
+	0x57, 0x87, 0x9a, 0x84, 0xdd, 0xf6, 0xd2, 0x43, 0x51, 0xd3, 0x86, 0x93, 0x43, 0x62, 0x08, 0x94,
+	0x83, 0x00, 0x09, 0x90, 0xcd, 0x92, 0x5a, 0x4b, 0x84, 0x48, 0xae, 0xc0, 0x5d, 0x29, 0x32, 0x90,
+	0x6b, 0xee, 0x79, 0x98, 0x3c, 0x84, 0x8f, 0x46, 0x4e, 0x81, 0x0f, 0x44, 0x60, 0xbf, 0x81, 0x9e,
+	0x20, 0xe0, 0x72, 0xf5, 0x63, 0x47, 0xcc, 0x49, 0xda, 0x6f, 0xbe, 0xf9, 0xbe, 0x99, 0x9d, 0xe1,
+	0x82, 0x3d, 0xca, 0x22, 0xca, 0x02, 0x66, 0xf7, 0x13, 0xca, 0x69, 0x42, 0x86, 0xf6, 0xf0, 0xc0,
+	0x23, 0x1c, 0x1f, 0xd8, 0x1d, 0x12, 0x13, 0x16, 0x30, 0x4b, 0x04, 0xa0, 0x2a, 0x79, 0xd6, 0x84,
+	0x67, 0x49, 0x5e, 0xfd, 0xe7, 0x0e, 0xed, 0x50, 0x81, 0xda, 0xd9, 0xbf, 0x9c, 0x50, 0xff, 0xa3,
+	0x50, 0x77, 0x2a, 0x90, 0x13, 0x77, 0x8b, 0x89, 0x38, 0xc1, 0x91, 0x34, 0xac, 0xd7, 0x7c, 0xc1,
+	0x43, 0xb9, 0x51, 0x7e, 0x90, 0x21, 0x2d, 0x3f, 0xd9, 0x1e, 0x66, 0x64, 0x9a, 0xec, 0xd3, 0x20,
+	0xce, 0xe3, 0xe6, 0xcd, 0x0a, 0x58, 0x7d, 0x94, 0x37, 0xd3, 0xe2, 0x98, 0x13, 0xf8, 0x1f, 0x58,
+	0xce, 0xb5, 0x55, 0xc5, 0x50, 0x1a, 0x95, 0x43, 0xc3, 0x2a, 0x6a, 0xce, 0x6a, 0x0a, 0x9e, 0x53,
+	0xbe, 0x4a, 0xf5, 0x92, 0x2b, 0xb3, 0xe0, 0x3b, 0x05, 0x54, 0x39, 0xed, 0x91, 0x18, 0xf5, 0x71,
+	0x90, 0x20, 0x9c, 0x78, 0x28, 0xa1, 0x03, 0x4e, 0x98, 0xfa, 0x9d, 0xb1, 0xd4, 0xa8, 0x1c, 0xfe,
+	0x59, 0xac, 0x77, 0x9e, 0xa5, 0x35, 0x71, 0x90, 0x1c, 0x25, 0x9e, 0x2b, 0x72, 0x9c, 0x9d, 0x4c,
+	0x7b, 0x9c, 0xea, 0xbf, 0x5e, 0xe2, 0x28, 0xfc, 0xd7, 0x5c, 0x28, 0x6c, 0xba, 0x90, 0x7f, 0x95,
+	0x09, 0x5f, 0x83, 0x4a, 0xd6, 0x33, 0x6a, 0x93, 0x98, 0x46, 0x4c, 0x5d, 0x12, 0xe6, 0xbf, 0x17,
+	0x9b, 0x3b, 0x98, 0x91, 0x93, 0x8c, 0xeb, 0xd4, 0xa5, 0x27, 0xcc, 0x3d, 0xe7, 0x54, 0x4c, 0x17,
+	0x78, 0x13, 0x1a, 0x83, 0x04, 0xac, 0xf6, 0x29, 0x0d, 0xd1, 0x1b, 0x12, 0x74, 0xba, 0x9c, 0xa9,
+	0x65, 0x71, 0x5f, 0xbb, 0xdf, 0xb8, 0x2f, 0x4a, 0xc3, 0xe7, 0x39, 0xd9, 0xf9, 0x45, 0x9a, 0x6c,
+	0xe6, 0x26, 0xf3, 0x42, 0xa6, 0x5b, 0xe9, 0xcf, 0x98, 0x10, 0x81, 0x5a, 0x1b, 0x5f, 0x32, 0xc4,
+	0x82, 0xd8, 0x27, 0x28, 0xa2, 0xed, 0x41, 0x48, 0x90, 0xdc, 0x3f, 0xf5, 0x7b, 0x43, 0x69, 0x94,
+	0x9d, 0x9d, 0x71, 0xaa, 0x1b, 0xb9, 0x50, 0x21, 0xd5, 0x74, 0xb7, 0xb2, 0x58, 0x2b, 0x0b, 0x3d,
+	0x15, 0x11, 0x39, 0x76, 0x88, 0xc0, 0x7a, 0x9b, 0x0c, 0x49, 0x48, 0xfb, 0x24, 0x41, 0x17, 0x84,
+	0x30, 0x75, 0x59, 0x5c, 0x56, 0xcd, 0x92, 0x9b, 0x94, 0xf5, 0x3c, 0x6d, 0xe2, 0x98, 0x06, 0xb1,
+	0xb3, 0x2d, 0xab, 0xaf, 0x4a, 0xd3, 0x7b, 0xe9, 0xa6, 0xbb, 0x36, 0x05, 0x4e, 0x09, 0x61, 0xf0,
+	0x0c, 0x6c, 0x86, 0x98, 0x13, 0xc6, 0x91, 0x17, 0x52, 0xbf, 0x87, 0xba, 0xa2, 0x33, 0xf5, 0x07,
+	0x51, 0xbb, 0x36, 0x4e, 0xf5, 0x7a, 0x2e, 0xb3, 0x80, 0x64, 0xba, 0x1b, 0x39, 0xea, 0x64, 0xe0,
+	0x63, 0x81, 0xc1, 0x97, 0x60, 0x63, 0xe6, 0x88, 0xdb, 0xed, 0x84, 0x30, 0xa6, 0xfe, 0x68, 0x28,
+	0x8d, 0x15, 0xc7, 0x1a, 0xa7, 0xba, 0xfa, 0xb0, 0x28, 0x49, 0x31, 0x3f, 0x7e, 0xd8, 0x5f, 0x97,
+	0x2d, 0x1d, 0xe5, 0x90, 0xfb, 0xd3, 0x94, 0x25, 0x11, 0xf8, 0x0a, 0xd4, 0x22, 0x3c, 0x42, 0x62,
+	0x20, 0x7d, 0x1a, 0xc4, 0x9c, 0xa1, 0x4c, 0x43, 0x14, 0xa5, 0xae, 0x3c, 0xbc, 0xee, 0x42, 0xaa,
+	0xe9, 0x56, 0x23, 0x3c, 0xca, 0x26, 0xde, 0x14, 0x91, 0x26, 0x49, 0x44, 0x0b, 0xf0, 0x19, 0xd8,
+	0x5a, 0x94, 0xc4, 0x47, 0x2a, 0x10, 0xe2, 0xbf, 0x8d, 0x53, 0x7d, 0xbb, 0x58, 0x9c, 0x8f, 0x4c,
+	0x17, 0x3e, 0x54, 0x3e, 0x1f, 0xc1, 0x16, 0xa8, 0x0a, 0x16, 0xf2, 0xe9, 0x20, 0xe6, 0xe8, 0x82,
+	0x4e, 0x4a, 0xae, 0x08, 0x55, 0x63, 0xf6, 0x0d, 0x2d, 0xa4, 0x99, 0x2e, 0x14, 0xf8, 0x71, 0x06,
+	0x9f, 0x52, 0x59, 0xeb, 0xff, 0x60, 0x5d, 0xf8, 0x7b, 0x21, 0xf6, 0x7b, 0x61, 0xc0, 0xb8, 0xba,
+	0x6a, 0x2c, 0x35, 0xca, 0x4e, 0x6d, 0x36, 0xfa, 0xfb, 0x71, 0xd3, 0x5d, 0xcb, 0x00, 0x67, 0x72,
+	0x86, 0x6f, 0xc1, 0xe6, 0xbd, 0xe5, 0x40, 0xac, 0x8b, 0x13, 0xa2, 0xae, 0x89, 0x61, 0x3d, 0xb9,
+	0x4a, 0x75, 0xe5, 0x26, 0xd5, 0xf7, 0x3a, 0x01, 0xef, 0x0e, 0x3c, 0xcb, 0xa7, 0x91, 0x7c, 0xbc,
+	0xe4, 0xcf, 0x3e, 0x6b, 0xf7, 0x6c, 0x7e, 0xd9, 0x27, 0xcc, 0x3a, 0x21, 0xfe, 0x6c, 0x51, 0x16,
+	0x48, 0x9a, 0xee, 0xc6, 0xfc, 0xd2, 0xb5, 0x32, 0xcc, 0x39, 0xbb, 0xba, 0xd5, 0x94, 0xeb, 0x5b,
+	0x4d, 0xf9, 0x7c, 0xab, 0x29, 0xef, 0xef, 0xb4, 0xd2, 0xf5, 0x9d, 0x56, 0xfa, 0x74, 0xa7, 0x95,
+	0x5e, 0xfc, 0x3d, 0x67, 0x29, 0xbf, 0xd7, 0xfd, 0x10, 0x7b, 0x6c, 0x72, 0xb0, 0x87, 0x07, 0xff,
+	0xd8, 0xa3, 0xd9, 0xb3, 0x2b, 0x8a, 0xf0, 0x96, 0xc5, 0xf9, 0xaf, 0x2f, 0x03, 0x00, 0xf2, 0xd4,
+	0xde, 0xb6, 0x18, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -239,6 +257,36 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DeveloperFeeShare != nil {
+		{
+			size := m.DeveloperFeeShare.Size()
+			i -= size
+			if _, err := m.DeveloperFeeShare.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.PoolBlacklist) > 0 {
+		dAtA2 := make([]byte, len(m.PoolBlacklist)*10)
+		var j1 int
+		for _, num := range m.PoolBlacklist {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintGenesis(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.PointCountForBlock != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.PointCountForBlock))
 		i--
@@ -394,6 +442,17 @@ func (m *GenesisState) Size() (n int) {
 	if m.PointCountForBlock != 0 {
 		n += 1 + sovGenesis(uint64(m.PointCountForBlock))
 	}
+	if len(m.PoolBlacklist) > 0 {
+		l = 0
+		for _, e := range m.PoolBlacklist {
+			l += sovGenesis(uint64(e))
+		}
+		n += 1 + sovGenesis(uint64(l)) + l
+	}
+	if m.DeveloperFeeShare != nil {
+		l = m.DeveloperFeeShare.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -727,6 +786,118 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolBlacklist = append(m.PoolBlacklist, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenesis
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthGenesis
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolBlacklist) == 0 {
+					m.PoolBlacklist = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenesis
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolBlacklist = append(m.PoolBlacklist, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolBlacklist", wireType)
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.DeveloperFeeShare = &v
+			if err := m.DeveloperFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,12 +3,24 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/osmosis-labs/osmosis/v15/x/protorev/types"
 )
 
 func TestGenesisStateValidate(t *testing.T) {
+	withSearcherRewardFraction := func(fraction sdk.Dec) *types.GenesisState {
+		genState := types.DefaultGenesis()
+		genState.Params.SearcherRewardFraction = fraction
+		return genState
+	}
+	withPoolBlacklist := func(poolIds []uint64) *types.GenesisState {
+		genState := types.DefaultGenesis()
+		genState.PoolBlacklist = poolIds
+		return genState
+	}
+
 	cases := []struct {
 		description string
 		genState    *types.GenesisState
@@ -19,6 +31,36 @@ func TestGenesisStateValidate(t *testing.T) {
 			genState:    types.DefaultGenesis(),
 			valid:       true,
 		},
+		{
+			description: "Valid searcher reward fraction",
+			genState:    withSearcherRewardFraction(sdk.NewDecWithPrec(5, 1)),
+			valid:       true,
+		},
+		{
+			description: "Searcher reward fraction of one",
+			genState:    withSearcherRewardFraction(sdk.OneDec()),
+			valid:       true,
+		},
+		{
+			description: "Negative searcher reward fraction",
+			genState:    withSearcherRewardFraction(sdk.NewDec(-1)),
+			valid:       false,
+		},
+		{
+			description: "Searcher reward fraction greater than one",
+			genState:    withSearcherRewardFraction(sdk.NewDecWithPrec(11, 1)),
+			valid:       false,
+		},
+		{
+			description: "Valid pool blacklist",
+			genState:    withPoolBlacklist([]uint64{1, 2}),
+			valid:       true,
+		},
+		{
+			description: "Pool blacklist with duplicate pool ids",
+			genState:    withPoolBlacklist([]uint64{1, 1}),
+			valid:       false,
+		},
 	}
 
 	for _, tc := range cases {
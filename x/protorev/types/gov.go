@@ -9,8 +9,9 @@ import (
 )
 
 const (
-	ProposalTypeSetProtoRevEnabled      = "SetProtoRevEnabledProposal"
-	ProposalTypeSetProtoRevAdminAccount = "SetProtoRevAdminAccountProposal"
+	ProposalTypeSetProtoRevEnabled           = "SetProtoRevEnabledProposal"
+	ProposalTypeSetProtoRevAdminAccount      = "SetProtoRevAdminAccountProposal"
+	ProposalTypeSetProtoRevDeveloperFeeShare = "SetProtoRevDeveloperFeeShareProposal"
 )
 
 func init() {
@@ -18,11 +19,14 @@ func init() {
 	govtypes.RegisterProposalTypeCodec(&SetProtoRevEnabledProposal{}, "osmosis/SetProtoRevEnabledProposal")
 	govtypes.RegisterProposalType(ProposalTypeSetProtoRevAdminAccount)
 	govtypes.RegisterProposalTypeCodec(&SetProtoRevAdminAccountProposal{}, "osmosis/SetProtoRevAdminAccountProposal")
+	govtypes.RegisterProposalType(ProposalTypeSetProtoRevDeveloperFeeShare)
+	govtypes.RegisterProposalTypeCodec(&SetProtoRevDeveloperFeeShareProposal{}, "osmosis/SetProtoRevDeveloperFeeShareProposal")
 }
 
 var (
 	_ govtypes.Content = &SetProtoRevEnabledProposal{}
 	_ govtypes.Content = &SetProtoRevAdminAccountProposal{}
+	_ govtypes.Content = &SetProtoRevDeveloperFeeShareProposal{}
 )
 
 // ---------------- Interface for SetProtoRevEnabledProposal ---------------- //
@@ -89,3 +93,35 @@ func (p SetProtoRevAdminAccountProposal) String() string {
 	ProtoRev Admin Account:     %+v
   `, p.Title, p.Description, p.Account)
 }
+
+// ---------------- Interface for SetProtoRevDeveloperFeeShareProposal ---------------- //
+func NewSetProtoRevDeveloperFeeShareProposal(title, description string, developerFeeShare sdk.Dec) govtypes.Content {
+	return &SetProtoRevDeveloperFeeShareProposal{title, description, developerFeeShare}
+}
+
+func (p *SetProtoRevDeveloperFeeShareProposal) GetTitle() string { return p.Title }
+
+func (p *SetProtoRevDeveloperFeeShareProposal) GetDescription() string { return p.Description }
+
+func (p *SetProtoRevDeveloperFeeShareProposal) ProposalRoute() string { return RouterKey }
+
+func (p *SetProtoRevDeveloperFeeShareProposal) ProposalType() string {
+	return ProposalTypeSetProtoRevDeveloperFeeShare
+}
+
+func (p *SetProtoRevDeveloperFeeShareProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+
+	return ValidateDeveloperFeeShare(p.DeveloperFeeShare)
+}
+
+func (p SetProtoRevDeveloperFeeShareProposal) String() string {
+	return fmt.Sprintf(`Set ProtoRev Developer Fee Share Proposal:
+	Title:       %s
+	Description: %s
+	ProtoRev Developer Fee Share:     %s
+  `, p.Title, p.Description, p.DeveloperFeeShare)
+}
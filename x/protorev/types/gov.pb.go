@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -104,9 +105,54 @@ func (m *SetProtoRevAdminAccountProposal) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SetProtoRevAdminAccountProposal proto.InternalMessageInfo
 
+// SetProtoRevDeveloperFeeShareProposal is a gov Content type to set the
+// fraction of each trade's profit that is allocated to the developer account.
+// Once set, it replaces the default schedule that decreases the share as the
+// module ages
+type SetProtoRevDeveloperFeeShareProposal struct {
+	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// developer_fee_share is the fraction of profit allocated to the developer
+	// account, in [0, 1]
+	DeveloperFeeShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=developer_fee_share,json=developerFeeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"developer_fee_share" yaml:"developer_fee_share"`
+}
+
+func (m *SetProtoRevDeveloperFeeShareProposal) Reset()      { *m = SetProtoRevDeveloperFeeShareProposal{} }
+func (*SetProtoRevDeveloperFeeShareProposal) ProtoMessage() {}
+func (*SetProtoRevDeveloperFeeShareProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1f85ff7f3eaf8bb, []int{2}
+}
+func (m *SetProtoRevDeveloperFeeShareProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetProtoRevDeveloperFeeShareProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetProtoRevDeveloperFeeShareProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetProtoRevDeveloperFeeShareProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetProtoRevDeveloperFeeShareProposal.Merge(m, src)
+}
+func (m *SetProtoRevDeveloperFeeShareProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetProtoRevDeveloperFeeShareProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetProtoRevDeveloperFeeShareProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetProtoRevDeveloperFeeShareProposal proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*SetProtoRevEnabledProposal)(nil), "osmosis.protorev.v1beta1.SetProtoRevEnabledProposal")
 	proto.RegisterType((*SetProtoRevAdminAccountProposal)(nil), "osmosis.protorev.v1beta1.SetProtoRevAdminAccountProposal")
+	proto.RegisterType((*SetProtoRevDeveloperFeeShareProposal)(nil), "osmosis.protorev.v1beta1.SetProtoRevDeveloperFeeShareProposal")
 }
 
 func init() {
@@ -114,25 +160,31 @@ func init() {
 }
 
 var fileDescriptor_e1f85ff7f3eaf8bb = []byte{
-	// 286 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xca, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x2f, 0x4a, 0x2d, 0xd3, 0x2f, 0x33, 0x4c,
-	0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0xcf, 0x2f, 0xd3, 0x03, 0x0b, 0x0a, 0x49, 0x40, 0xd5, 0xe8,
-	0xc1, 0xd4, 0xe8, 0x41, 0xd5, 0x48, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x45, 0xf5, 0x41, 0x2c,
-	0x88, 0x02, 0x29, 0x75, 0x9c, 0x66, 0xc2, 0x0d, 0x00, 0x33, 0x94, 0x6a, 0xb8, 0xa4, 0x82, 0x53,
-	0x4b, 0x02, 0x40, 0xec, 0xa0, 0xd4, 0x32, 0xd7, 0xbc, 0xc4, 0xa4, 0x9c, 0xd4, 0x94, 0x80, 0xa2,
-	0xfc, 0x82, 0xfc, 0xe2, 0xc4, 0x1c, 0x21, 0x11, 0x2e, 0xd6, 0x92, 0xcc, 0x92, 0x9c, 0x54, 0x09,
-	0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x08, 0x47, 0x48, 0x81, 0x8b, 0x3b, 0x25, 0xb5, 0x38, 0xb9,
-	0x28, 0xb3, 0xa0, 0x24, 0x33, 0x3f, 0x4f, 0x82, 0x09, 0x2c, 0x87, 0x2c, 0x24, 0x24, 0xc1, 0xc5,
-	0x9e, 0x0a, 0x31, 0x4a, 0x82, 0x59, 0x81, 0x51, 0x83, 0x23, 0x08, 0xc6, 0xb5, 0xe2, 0xe9, 0x58,
-	0x20, 0xcf, 0x30, 0x63, 0x81, 0x3c, 0xc3, 0x8b, 0x05, 0xf2, 0x8c, 0x4a, 0x8d, 0x8c, 0x5c, 0xf2,
-	0x48, 0xd6, 0x3b, 0xa6, 0xe4, 0x66, 0xe6, 0x39, 0x26, 0x27, 0xe7, 0x97, 0xe6, 0x95, 0x50, 0xc3,
-	0x0d, 0x89, 0x10, 0xa3, 0xc0, 0x6e, 0xe0, 0x0c, 0x82, 0x71, 0x51, 0xdd, 0xe0, 0xe4, 0x77, 0xe2,
-	0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70,
-	0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x26, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49,
-	0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xd0, 0xf0, 0xd4, 0xcd, 0x49, 0x4c, 0x2a, 0x86, 0x71, 0xf4, 0xcb,
-	0x0c, 0x4d, 0xf5, 0x2b, 0x10, 0x41, 0x5c, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0xe6, 0x1b,
-	0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x63, 0x40, 0xc5, 0x75, 0xd7, 0x01, 0x00, 0x00,
+	// 378 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x3d, 0x4f, 0xdb, 0x40,
+	0x18, 0xc7, 0x7d, 0xad, 0xfa, 0x92, 0x6b, 0x97, 0xba, 0x19, 0x2c, 0x0f, 0x76, 0x64, 0x55, 0x6d,
+	0x96, 0xf8, 0x14, 0xb5, 0x5d, 0xb2, 0x25, 0x0a, 0x4c, 0x08, 0x45, 0xce, 0xc6, 0x12, 0xf9, 0xe5,
+	0xc1, 0xb1, 0xb0, 0x7d, 0x96, 0xef, 0x62, 0x11, 0x29, 0x0b, 0x1b, 0x23, 0x23, 0x63, 0x3e, 0x4e,
+	0xc6, 0x8c, 0x88, 0x21, 0x42, 0xc9, 0x82, 0x18, 0xf9, 0x04, 0x28, 0x67, 0x1b, 0x4c, 0x04, 0x13,
+	0x4c, 0x7e, 0xfe, 0x8f, 0xff, 0xfa, 0xf9, 0x67, 0xdd, 0x61, 0x83, 0xb2, 0x88, 0xb2, 0x80, 0x91,
+	0x24, 0xa5, 0x9c, 0xa6, 0x90, 0x91, 0xac, 0xed, 0x00, 0xb7, 0xdb, 0xc4, 0xa7, 0x99, 0x29, 0x96,
+	0xb2, 0x52, 0x74, 0xcc, 0xb2, 0x63, 0x16, 0x1d, 0xb5, 0xee, 0x53, 0x9f, 0x8a, 0x2d, 0xd9, 0x4e,
+	0x79, 0x41, 0xfd, 0xf3, 0x2a, 0xf3, 0x11, 0x20, 0x06, 0x63, 0x86, 0xd5, 0x21, 0xf0, 0xc1, 0x76,
+	0xb6, 0x20, 0xdb, 0x8b, 0x6d, 0x27, 0x04, 0x6f, 0x90, 0xd2, 0x84, 0x32, 0x3b, 0x94, 0xeb, 0xf8,
+	0x13, 0x0f, 0x78, 0x08, 0x0a, 0x6a, 0xa0, 0x66, 0xcd, 0xca, 0x83, 0xdc, 0xc0, 0xdf, 0x3c, 0x60,
+	0x6e, 0x1a, 0x24, 0x3c, 0xa0, 0xb1, 0xf2, 0x41, 0xbc, 0xab, 0xae, 0x64, 0x05, 0x7f, 0x81, 0x1c,
+	0xa5, 0x7c, 0x6c, 0xa0, 0xe6, 0x57, 0xab, 0x8c, 0x9d, 0xef, 0xe7, 0x73, 0x5d, 0xba, 0x9c, 0xeb,
+	0xd2, 0xed, 0x5c, 0x47, 0xc6, 0x19, 0xc2, 0x7a, 0xe5, 0xf3, 0x5d, 0x2f, 0x0a, 0xe2, 0xae, 0xeb,
+	0xd2, 0x49, 0xcc, 0xdf, 0xc3, 0xc1, 0xce, 0x51, 0xc2, 0xa1, 0x66, 0x95, 0x71, 0xc7, 0xe1, 0x0e,
+	0xe1, 0x5f, 0x15, 0x87, 0x3e, 0x64, 0x10, 0xd2, 0x04, 0xd2, 0x7d, 0x80, 0xe1, 0xd8, 0x4e, 0xe1,
+	0xcd, 0x22, 0x33, 0xfc, 0xd3, 0x2b, 0xa1, 0xa3, 0x63, 0x80, 0x11, 0xdb, 0x62, 0x73, 0xa9, 0xde,
+	0xc1, 0x62, 0xa5, 0x4b, 0xd7, 0x2b, 0xfd, 0xb7, 0x1f, 0xf0, 0xf1, 0xc4, 0x31, 0x5d, 0x1a, 0x11,
+	0x57, 0x1c, 0x5e, 0xf1, 0x68, 0x31, 0xef, 0x84, 0xf0, 0x69, 0x02, 0xcc, 0xec, 0x83, 0x7b, 0xbf,
+	0xd2, 0xd5, 0xa9, 0x1d, 0x85, 0x1d, 0xe3, 0x05, 0xa4, 0x61, 0xfd, 0xf0, 0x76, 0xed, 0x9f, 0xff,
+	0x6c, 0xef, 0x70, 0xb1, 0xd6, 0xd0, 0x72, 0xad, 0xa1, 0x9b, 0xb5, 0x86, 0x2e, 0x36, 0x9a, 0xb4,
+	0xdc, 0x68, 0xd2, 0xd5, 0x46, 0x93, 0x8e, 0xfe, 0x55, 0x04, 0x8a, 0xcb, 0xd3, 0x0a, 0x6d, 0x87,
+	0x95, 0x81, 0x64, 0xed, 0xff, 0xe4, 0xf4, 0xe9, 0x3e, 0x09, 0x25, 0xe7, 0xb3, 0xc8, 0x7f, 0x1f,
+	0x06, 0x00, 0x6a, 0x13, 0x58, 0x31, 0xc4, 0x02, 0x00, 0x00,
 }
 
 func (this *SetProtoRevEnabledProposal) Equal(that interface{}) bool {
@@ -195,6 +247,36 @@ func (this *SetProtoRevAdminAccountProposal) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *SetProtoRevDeveloperFeeShareProposal) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*SetProtoRevDeveloperFeeShareProposal)
+	if !ok {
+		that2, ok := that.(SetProtoRevDeveloperFeeShareProposal)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Title != that1.Title {
+		return false
+	}
+	if this.Description != that1.Description {
+		return false
+	}
+	if !this.DeveloperFeeShare.Equal(that1.DeveloperFeeShare) {
+		return false
+	}
+	return true
+}
 func (m *SetProtoRevEnabledProposal) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -286,6 +368,53 @@ func (m *SetProtoRevAdminAccountProposal) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
+func (m *SetProtoRevDeveloperFeeShareProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetProtoRevDeveloperFeeShareProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetProtoRevDeveloperFeeShareProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DeveloperFeeShare.Size()
+		i -= size
+		if _, err := m.DeveloperFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGov(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintGov(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGov(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGov(v)
 	base := offset
@@ -338,6 +467,25 @@ func (m *SetProtoRevAdminAccountProposal) Size() (n int) {
 	return n
 }
 
+func (m *SetProtoRevDeveloperFeeShareProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovGov(uint64(l))
+	}
+	l = m.DeveloperFeeShare.Size()
+	n += 1 + l + sovGov(uint64(l))
+	return n
+}
+
 func sovGov(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -624,6 +772,154 @@ func (m *SetProtoRevAdminAccountProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SetProtoRevDeveloperFeeShareProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGov
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetProtoRevDeveloperFeeShareProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetProtoRevDeveloperFeeShareProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperFeeShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGov
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGov
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGov
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeveloperFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGov(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGov
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGov(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
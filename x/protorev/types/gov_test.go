@@ -5,6 +5,8 @@ import (
 
 	"github.com/stretchr/testify/suite"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/osmosis-labs/osmosis/v15/app/apptesting"
 	"github.com/osmosis-labs/osmosis/v15/x/protorev/types"
 )
@@ -20,6 +22,7 @@ func TestGovTestSuite(t *testing.T) {
 func (suite *GovTestSuite) TestGovKeysTypes() {
 	suite.Require().Equal("SetProtoRevEnabledProposal", (&types.SetProtoRevEnabledProposal{}).ProposalType())
 	suite.Require().Equal("SetProtoRevAdminAccountProposal", (&types.SetProtoRevAdminAccountProposal{}).ProposalType())
+	suite.Require().Equal("SetProtoRevDeveloperFeeShareProposal", (&types.SetProtoRevDeveloperFeeShareProposal{}).ProposalType())
 }
 
 func (suite *GovTestSuite) TestEnableProposal() {
@@ -70,3 +73,51 @@ func (suite *GovTestSuite) TestAdminAccountProposal() {
 		}
 	}
 }
+
+func (suite *GovTestSuite) TestDeveloperFeeShareProposal() {
+	testCases := []struct {
+		description string
+		share       sdk.Dec
+		pass        bool
+	}{
+		{
+			description: "valid share",
+			share:       sdk.NewDecWithPrec(5, 2),
+			pass:        true,
+		},
+		{
+			description: "zero share",
+			share:       sdk.ZeroDec(),
+			pass:        true,
+		},
+		{
+			description: "full share",
+			share:       sdk.OneDec(),
+			pass:        true,
+		},
+		{
+			description: "negative share",
+			share:       sdk.NewDecWithPrec(-1, 2),
+			pass:        false,
+		},
+		{
+			description: "share above one",
+			share:       sdk.NewDecWithPrec(101, 2),
+			pass:        false,
+		},
+		{
+			description: "nil share",
+			share:       sdk.Dec{},
+			pass:        false,
+		},
+	}
+
+	for _, tc := range testCases {
+		proposal := types.NewSetProtoRevDeveloperFeeShareProposal("title", "description", tc.share)
+		if tc.pass {
+			suite.Require().NoError(proposal.ValidateBasic(), tc.description)
+		} else {
+			suite.Require().Error(proposal.ValidateBasic(), tc.description)
+		}
+	}
+}
@@ -15,6 +15,9 @@ const (
 	// StoreKey defines the primary module store key
 	StoreKey = ModuleName
 
+	// TransientStoreKey defines the module's transient store key, used to track route attempts within a block
+	TransientStoreKey = "transient_" + ModuleName
+
 	// RouterKey defines the module's message routing key
 	RouterKey = ModuleName
 )
@@ -35,6 +38,15 @@ const (
 	prefixPoolPointCountForBlock
 	prefixLatestBlockHeight
 	prefixPoolWeights
+	prefixLastExecutionByRoute
+	prefixTradeCountForBlock
+	prefixAttemptsByRoute
+	prefixPoolBlacklist
+	prefixBackrunCountByPoolForBlock
+	prefixPoolCreationHeight
+	prefixArbitrageGasConsumed
+	prefixArbitrageGasConsumedForBlock
+	prefixDeveloperFeeShare
 )
 
 var (
@@ -61,6 +73,19 @@ var (
 	// KeyPrefixProfitsByRoute is the prefix for the store that keeps track of the profits made by route
 	KeyPrefixProfitsByRoute = []byte{prefixProfitsByRoute}
 
+	// KeyPrefixLastExecutionByRoute is the prefix for the store that keeps track of the block height of the last trade executed by route
+	KeyPrefixLastExecutionByRoute = []byte{prefixLastExecutionByRoute}
+
+	// KeyPrefixAttemptsByRoute is the prefix for the store that keeps track of the number of times a route was searched for a profitable trade.
+	// It is also used in the transient store to track the attempts made in the current block
+	KeyPrefixAttemptsByRoute = []byte{prefixAttemptsByRoute}
+
+	// KeyPrefixArbitrageGasConsumed is the prefix for the store that keeps track of the cumulative gas consumed by arbitrage backruns
+	KeyPrefixArbitrageGasConsumed = []byte{prefixArbitrageGasConsumed}
+
+	// KeyPrefixArbitrageGasConsumedForBlock is the prefix for the store that keeps track of the gas consumed by arbitrage backruns in the current block
+	KeyPrefixArbitrageGasConsumedForBlock = []byte{prefixArbitrageGasConsumedForBlock}
+
 	// -------------- Keys for configuration/admin stores -------------- //
 	// KeyPrefixDeveloperAccount is the prefix for store that keeps track of the developer account
 	KeyPrefixDeveloperAccount = []byte{prefixDeveloperAccount}
@@ -71,6 +96,9 @@ var (
 	// KeyPrefixDeveloperFees is the prefix for store that keeps track of the developer fees
 	KeyPrefixDeveloperFees = []byte{prefixDeveloperFees}
 
+	// KeyPrefixDeveloperFeeShare is the prefix for store that keeps track of the fraction of profit allocated to the developer account, if set by governance
+	KeyPrefixDeveloperFeeShare = []byte{prefixDeveloperFeeShare}
+
 	// KeyPrefixMaxPointsPerTx is the prefix for store that keeps track of the max number of pool points that can be consumed per tx
 	KeyPrefixMaxPointsPerTx = []byte{prefixMaxPoolPointsPerTx}
 
@@ -85,6 +113,18 @@ var (
 
 	// KeyPrefixPoolWeights is the prefix for store that keeps track of the weights for different pool types
 	KeyPrefixPoolWeights = []byte{prefixPoolWeights}
+
+	// KeyPrefixTradeCountForBlock is the prefix for store that keeps track of the number of trades that have been executed in the current block
+	KeyPrefixTradeCountForBlock = []byte{prefixTradeCountForBlock}
+
+	// KeyPrefixPoolBlacklist is the prefix for store that keeps track of the pools that must never be included in arbitrage routes
+	KeyPrefixPoolBlacklist = []byte{prefixPoolBlacklist}
+
+	// KeyPrefixBackrunCountByPoolForBlock is the prefix for store that keeps track of the number of backruns that have been executed for swaps on each pool in the current block
+	KeyPrefixBackrunCountByPoolForBlock = []byte{prefixBackrunCountByPoolForBlock}
+
+	// KeyPrefixPoolCreationHeight is the prefix for store that keeps track of the block height at which each pool was created
+	KeyPrefixPoolCreationHeight = []byte{prefixPoolCreationHeight}
 )
 
 // Returns the key needed to fetch the pool id for a given denom
@@ -117,6 +157,16 @@ func GetKeyPrefixProfitsByRoute(route []uint64, denom string) []byte {
 	return append(append(KeyPrefixProfitsByRoute, CreateRouteKey(route)...), []byte(denom)...)
 }
 
+// Returns the key needed to fetch the last execution height by route
+func GetKeyPrefixLastExecutionByRoute(route []uint64) []byte {
+	return append(KeyPrefixLastExecutionByRoute, CreateRouteKey(route)...)
+}
+
+// Returns the key needed to fetch the number of attempts by route
+func GetKeyPrefixAttemptsByRoute(route []uint64) []byte {
+	return append(KeyPrefixAttemptsByRoute, CreateRouteKey(route)...)
+}
+
 // createRouteKey creates a key for the given route. converts a slice of uint64 to a string separated by a pipe
 // {1,2,3,4} -> []byte("1|2|3|4")
 func CreateRouteKey(route []uint64) []byte {
@@ -138,6 +188,21 @@ func CreateRouteFromKey(key []byte) ([]uint64, error) {
 	return route, nil
 }
 
+// Returns the key needed to check whether a pool is blacklisted
+func GetKeyPrefixPoolBlacklist(poolId uint64) []byte {
+	return append(KeyPrefixPoolBlacklist, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// Returns the key needed to fetch the number of backruns executed for swaps on a pool in the current block
+func GetKeyPrefixBackrunCountByPoolForBlock(poolId uint64) []byte {
+	return append(KeyPrefixBackrunCountByPoolForBlock, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// Returns the key needed to fetch the block height at which a pool was created
+func GetKeyPrefixPoolCreationHeight(poolId uint64) []byte {
+	return append(KeyPrefixPoolCreationHeight, sdk.Uint64ToBigEndian(poolId)...)
+}
+
 // Returns the key needed to fetch the developer fees by coin
 func GetKeyPrefixDeveloperFees(denom string) []byte {
 	return append(KeyPrefixDeveloperFees, []byte(denom)...)
@@ -12,6 +12,7 @@ var (
 	_ sdk.Msg = &MsgSetMaxPoolPointsPerBlock{}
 	_ sdk.Msg = &MsgSetPoolWeights{}
 	_ sdk.Msg = &MsgSetBaseDenoms{}
+	_ sdk.Msg = &MsgSetPoolBlacklist{}
 )
 
 const (
@@ -21,6 +22,7 @@ const (
 	TypeMsgSetMaxPoolPointsPerBlock = "set_max_pool_points_per_block"
 	TypeMsgSetPoolWeights           = "set_pool_weights"
 	TypeMsgSetBaseDenoms            = "set_base_denoms"
+	TypeMsgSetPoolBlacklist         = "set_pool_blacklist"
 )
 
 // ---------------------- Interface for MsgSetHotRoutes ---------------------- //
@@ -293,3 +295,48 @@ func (msg MsgSetBaseDenoms) GetSigners() []sdk.AccAddress {
 	addr := sdk.MustAccAddressFromBech32(msg.Admin)
 	return []sdk.AccAddress{addr}
 }
+
+// ---------------------- Interface for MsgSetPoolBlacklist ---------------------- //
+// NewMsgSetPoolBlacklist creates a new MsgSetPoolBlacklist instance
+func NewMsgSetPoolBlacklist(admin string, poolIds []uint64) *MsgSetPoolBlacklist {
+	return &MsgSetPoolBlacklist{
+		Admin:   admin,
+		PoolIds: poolIds,
+	}
+}
+
+// Route returns the name of the module
+func (msg MsgSetPoolBlacklist) Route() string {
+	return RouterKey
+}
+
+// Type returns the type of the message
+func (msg MsgSetPoolBlacklist) Type() string {
+	return TypeMsgSetPoolBlacklist
+}
+
+// ValidateBasic validates the MsgSetPoolBlacklist
+func (msg MsgSetPoolBlacklist) ValidateBasic() error {
+	// Account must be a valid bech32 address
+	if _, err := sdk.AccAddressFromBech32(msg.Admin); err != nil {
+		return sdkerrors.Wrap(err, "invalid admin address (must be bech32)")
+	}
+
+	// Pool ids must be non-zero and unique
+	if err := ValidatePoolBlacklist(msg.PoolIds); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgSetPoolBlacklist) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgSetPoolBlacklist) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Admin)
+	return []sdk.AccAddress{addr}
+}
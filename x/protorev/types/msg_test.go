@@ -12,7 +12,11 @@ import (
 
 func TestMsgSetHotRoutes(t *testing.T) {
 	validStepSize := sdk.NewInt(1_000_000)
-	invalidStepSize := sdk.NewInt(0)
+	invalidStepSize := sdk.NewInt(-1)
+	validMaxInputAmount := sdk.NewInt(100_000_000)
+	invalidMaxInputAmount := sdk.NewInt(999_999)
+	validMinProfitThreshold := sdk.NewInt(1_000)
+	invalidMinProfitThreshold := sdk.NewInt(-1)
 	cases := []struct {
 		description string
 		admin       string
@@ -70,6 +74,142 @@ func TestMsgSetHotRoutes(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"Valid message (with max input amount)",
+			createAccount().String(),
+			[]types.TokenPairArbRoutes{
+				{
+					ArbRoutes: []types.Route{
+						{
+							Trades: []types.Trade{
+								{
+									Pool:     1,
+									TokenIn:  "Atom",
+									TokenOut: "Juno",
+								},
+								{
+									Pool:     0,
+									TokenIn:  "Juno",
+									TokenOut: types.OsmosisDenomination,
+								},
+								{
+									Pool:     3,
+									TokenIn:  types.OsmosisDenomination,
+									TokenOut: "Atom",
+								},
+							},
+							StepSize:       validStepSize,
+							MaxInputAmount: &validMaxInputAmount,
+						},
+					},
+					TokenIn:  types.OsmosisDenomination,
+					TokenOut: "Juno",
+				},
+			},
+			true,
+		},
+		{
+			"Invalid message (max input amount below step size)",
+			createAccount().String(),
+			[]types.TokenPairArbRoutes{
+				{
+					ArbRoutes: []types.Route{
+						{
+							Trades: []types.Trade{
+								{
+									Pool:     1,
+									TokenIn:  "Atom",
+									TokenOut: "Juno",
+								},
+								{
+									Pool:     0,
+									TokenIn:  "Juno",
+									TokenOut: types.OsmosisDenomination,
+								},
+								{
+									Pool:     3,
+									TokenIn:  types.OsmosisDenomination,
+									TokenOut: "Atom",
+								},
+							},
+							StepSize:       validStepSize,
+							MaxInputAmount: &invalidMaxInputAmount,
+						},
+					},
+					TokenIn:  types.OsmosisDenomination,
+					TokenOut: "Juno",
+				},
+			},
+			false,
+		},
+		{
+			"Valid message (with min profit threshold)",
+			createAccount().String(),
+			[]types.TokenPairArbRoutes{
+				{
+					ArbRoutes: []types.Route{
+						{
+							Trades: []types.Trade{
+								{
+									Pool:     1,
+									TokenIn:  "Atom",
+									TokenOut: "Juno",
+								},
+								{
+									Pool:     0,
+									TokenIn:  "Juno",
+									TokenOut: types.OsmosisDenomination,
+								},
+								{
+									Pool:     3,
+									TokenIn:  types.OsmosisDenomination,
+									TokenOut: "Atom",
+								},
+							},
+							StepSize:           validStepSize,
+							MinProfitThreshold: &validMinProfitThreshold,
+						},
+					},
+					TokenIn:  types.OsmosisDenomination,
+					TokenOut: "Juno",
+				},
+			},
+			true,
+		},
+		{
+			"Invalid message (negative min profit threshold)",
+			createAccount().String(),
+			[]types.TokenPairArbRoutes{
+				{
+					ArbRoutes: []types.Route{
+						{
+							Trades: []types.Trade{
+								{
+									Pool:     1,
+									TokenIn:  "Atom",
+									TokenOut: "Juno",
+								},
+								{
+									Pool:     0,
+									TokenIn:  "Juno",
+									TokenOut: types.OsmosisDenomination,
+								},
+								{
+									Pool:     3,
+									TokenIn:  types.OsmosisDenomination,
+									TokenOut: "Atom",
+								},
+							},
+							StepSize:           validStepSize,
+							MinProfitThreshold: &invalidMinProfitThreshold,
+						},
+					},
+					TokenIn:  types.OsmosisDenomination,
+					TokenOut: "Juno",
+				},
+			},
+			false,
+		},
 		{
 			"Invalid message (mismatched arb denoms)",
 			createAccount().String(),
@@ -295,7 +435,7 @@ func TestMsgSetHotRoutes(t *testing.T) {
 			false,
 		},
 		{
-			"Invalid message (unset step size)",
+			"Valid message (unset step size uses the base denom's step size)",
 			createAccount().String(),
 			[]types.TokenPairArbRoutes{
 				{
@@ -303,31 +443,31 @@ func TestMsgSetHotRoutes(t *testing.T) {
 						{
 							Trades: []types.Trade{
 								{
-									Pool:     3,
-									TokenIn:  types.OsmosisDenomination,
-									TokenOut: "Atom",
+									Pool:     1,
+									TokenIn:  "Atom",
+									TokenOut: "Juno",
 								},
 								{
 									Pool:     0,
 									TokenIn:  "Juno",
-									TokenOut: "Atom",
+									TokenOut: types.OsmosisDenomination,
 								},
 								{
-									Pool:     10,
-									TokenIn:  "Akash",
-									TokenOut: types.OsmosisDenomination,
+									Pool:     3,
+									TokenIn:  types.OsmosisDenomination,
+									TokenOut: "Atom",
 								},
 							},
 						},
 					},
-					TokenIn:  "Atom",
+					TokenIn:  types.OsmosisDenomination,
 					TokenOut: "Juno",
 				},
 			},
-			false,
+			true,
 		},
 		{
-			"Invalid message (invalid step size)",
+			"Invalid message (negative step size)",
 			createAccount().String(),
 			[]types.TokenPairArbRoutes{
 				{
@@ -668,6 +808,39 @@ func TestMsgSetBaseDenoms(t *testing.T) {
 			},
 			false,
 		},
+		{
+			"Valid message (intermediate only denom)",
+			createAccount().String(),
+			[]types.BaseDenom{
+				{
+					Denom:    types.OsmosisDenomination,
+					StepSize: sdk.NewInt(1),
+				},
+				{
+					Denom:            "Atom",
+					StepSize:         sdk.NewInt(1),
+					IntermediateOnly: true,
+				},
+			},
+			true,
+		},
+		{
+			"Invalid message (no denom can seed routes)",
+			createAccount().String(),
+			[]types.BaseDenom{
+				{
+					Denom:            types.OsmosisDenomination,
+					StepSize:         sdk.NewInt(1),
+					IntermediateOnly: true,
+				},
+				{
+					Denom:            "Atom",
+					StepSize:         sdk.NewInt(1),
+					IntermediateOnly: true,
+				},
+			},
+			false,
+		},
 	}
 
 	for _, tc := range cases {
@@ -683,6 +856,58 @@ func TestMsgSetBaseDenoms(t *testing.T) {
 	}
 }
 
+func TestMsgSetPoolBlacklist(t *testing.T) {
+	cases := []struct {
+		description string
+		admin       string
+		poolIds     []uint64
+		pass        bool
+	}{
+		{
+			"Invalid message (invalid admin)",
+			"admin",
+			[]uint64{1},
+			false,
+		},
+		{
+			"Invalid message (zero pool id)",
+			createAccount().String(),
+			[]uint64{0},
+			false,
+		},
+		{
+			"Invalid message (duplicate pool id)",
+			createAccount().String(),
+			[]uint64{1, 2, 1},
+			false,
+		},
+		{
+			"Valid message",
+			createAccount().String(),
+			[]uint64{1, 2},
+			true,
+		},
+		{
+			"Valid message (empty blacklist)",
+			createAccount().String(),
+			[]uint64{},
+			true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.description, func(t *testing.T) {
+			msg := types.NewMsgSetPoolBlacklist(tc.admin, tc.poolIds)
+			err := msg.ValidateBasic()
+			if tc.pass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
 func createAccount() sdk.AccAddress {
 	pk := ed25519.GenPrivKey().PubKey()
 	return sdk.AccAddress(pk.Address())
@@ -14,9 +14,27 @@ var (
 	// Note that governance has full ability to change this live on-chain, and this admin can at most prevent protorev from working.
 	// All the settings manager's controls have limits, so it can't lead to a chain halt, excess processing time or prevention of swaps.
 	DefaultAdminAccount = "osmo17nv67dvc7f8yr00rhgxd688gcn9t9wvhn783z4"
+	// By default the number of trades per block is not capped.
+	DefaultMaxTradesPerBlock = uint64(0)
+	// By default all profit is kept by the module and searchers are not rewarded.
+	DefaultSearcherRewardFraction = sdk.ZeroDec()
+	// By default arbitrage is not disabled by height.
+	DefaultDisabledUntilHeight = uint64(0)
+	// By default the number of backruns per pool per block is not capped.
+	DefaultMaxBackrunsPerPoolPerBlock = uint64(0)
+	// By default pools are eligible for arbitrage as soon as they are created.
+	DefaultMinPoolAgeBlocks = uint64(0)
+	// By default routes are not weighted by the total value locked in their pools.
+	DefaultWeightRoutesByTVL = false
 
-	ParamStoreKeyEnableModule = []byte("EnableProtoRevModule")
-	ParamStoreKeyAdminAccount = []byte("AdminAccount")
+	ParamStoreKeyEnableModule               = []byte("EnableProtoRevModule")
+	ParamStoreKeyAdminAccount               = []byte("AdminAccount")
+	ParamStoreKeyMaxTradesPerBlock          = []byte("MaxTradesPerBlock")
+	ParamStoreKeySearcherRewardFraction     = []byte("SearcherRewardFraction")
+	ParamStoreKeyDisabledUntilHeight        = []byte("DisabledUntilHeight")
+	ParamStoreKeyMaxBackrunsPerPoolPerBlock = []byte("MaxBackrunsPerPoolPerBlock")
+	ParamStoreKeyMinPoolAgeBlocks           = []byte("MinPoolAgeBlocks")
+	ParamStoreKeyWeightRoutesByTVL          = []byte("WeightRoutesByTVL")
 )
 
 // ParamKeyTable the param key table for launch module
@@ -25,16 +43,22 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(enable bool, admin string) Params {
+func NewParams(enable bool, admin string, maxTradesPerBlock uint64, searcherRewardFraction sdk.Dec, disabledUntilHeight uint64, maxBackrunsPerPoolPerBlock uint64, minPoolAgeBlocks uint64, weightRoutesByTVL bool) Params {
 	return Params{
-		Enabled: enable,
-		Admin:   admin,
+		Enabled:                    enable,
+		Admin:                      admin,
+		MaxTradesPerBlock:          maxTradesPerBlock,
+		SearcherRewardFraction:     searcherRewardFraction,
+		DisabledUntilHeight:        disabledUntilHeight,
+		MaxBackrunsPerPoolPerBlock: maxBackrunsPerPoolPerBlock,
+		MinPoolAgeBlocks:           minPoolAgeBlocks,
+		WeightRoutesByTVL:          weightRoutesByTVL,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultEnableModule, DefaultAdminAccount)
+	return NewParams(DefaultEnableModule, DefaultAdminAccount, DefaultMaxTradesPerBlock, DefaultSearcherRewardFraction, DefaultDisabledUntilHeight, DefaultMaxBackrunsPerPoolPerBlock, DefaultMinPoolAgeBlocks, DefaultWeightRoutesByTVL)
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -42,6 +66,12 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamStoreKeyEnableModule, &p.Enabled, ValidateBoolean),
 		paramtypes.NewParamSetPair(ParamStoreKeyAdminAccount, &p.Admin, ValidateAccount),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxTradesPerBlock, &p.MaxTradesPerBlock, ValidateUint64),
+		paramtypes.NewParamSetPair(ParamStoreKeySearcherRewardFraction, &p.SearcherRewardFraction, ValidateSearcherRewardFraction),
+		paramtypes.NewParamSetPair(ParamStoreKeyDisabledUntilHeight, &p.DisabledUntilHeight, ValidateUint64),
+		paramtypes.NewParamSetPair(ParamStoreKeyMaxBackrunsPerPoolPerBlock, &p.MaxBackrunsPerPoolPerBlock, ValidateUint64),
+		paramtypes.NewParamSetPair(ParamStoreKeyMinPoolAgeBlocks, &p.MinPoolAgeBlocks, ValidateUint64),
+		paramtypes.NewParamSetPair(ParamStoreKeyWeightRoutesByTVL, &p.WeightRoutesByTVL, ValidateBoolean),
 	}
 }
 
@@ -51,6 +81,10 @@ func (p Params) Validate() error {
 		return fmt.Errorf("invalid admin account address: %s", p.Admin)
 	}
 
+	if err := ValidateSearcherRewardFraction(p.SearcherRewardFraction); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -74,3 +108,25 @@ func ValidateBoolean(i interface{}) error {
 	}
 	return nil
 }
+
+func ValidateUint64(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+// ValidateSearcherRewardFraction validates that the searcher reward fraction is in the range [0, 1].
+func ValidateSearcherRewardFraction(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("searcher reward fraction must be in the range [0, 1], got %s", v)
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -30,6 +31,29 @@ type Params struct {
 	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
 	// The admin account (settings manager) of the protorev module.
 	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// The maximum number of arbitrage trades that can be executed per block. A
+	// value of 0 means that the number of trades per block is not capped.
+	MaxTradesPerBlock uint64 `protobuf:"varint,3,opt,name=max_trades_per_block,json=maxTradesPerBlock,proto3" json:"max_trades_per_block,omitempty" yaml:"max_trades_per_block"`
+	// The fraction of the profit of each backrun that is sent to the fee payer
+	// of the transaction that triggered it. A value of 0 means that all profit
+	// is kept by the module.
+	SearcherRewardFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=searcher_reward_fraction,json=searcherRewardFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"searcher_reward_fraction" yaml:"searcher_reward_fraction"`
+	// The block height until which arbitrage is disabled. While the current
+	// block height is below it, no arbitrage is executed even if the module is
+	// enabled. A value of 0 means that arbitrage is not disabled by height.
+	DisabledUntilHeight uint64 `protobuf:"varint,5,opt,name=disabled_until_height,json=disabledUntilHeight,proto3" json:"disabled_until_height,omitempty" yaml:"disabled_until_height"`
+	// The maximum number of backruns that can be executed per block for swaps
+	// on any single pool. A value of 0 means that the number of backruns per
+	// pool per block is not capped.
+	MaxBackrunsPerPoolPerBlock uint64 `protobuf:"varint,6,opt,name=max_backruns_per_pool_per_block,json=maxBackrunsPerPoolPerBlock,proto3" json:"max_backruns_per_pool_per_block,omitempty" yaml:"max_backruns_per_pool_per_block"`
+	// The minimum number of blocks that must have passed since a pool was
+	// created before it can be included in arbitrage routes. A value of 0 means
+	// that pools are eligible as soon as they are created.
+	MinPoolAgeBlocks uint64 `protobuf:"varint,7,opt,name=min_pool_age_blocks,json=minPoolAgeBlocks,proto3" json:"min_pool_age_blocks,omitempty" yaml:"min_pool_age_blocks"`
+	// Whether routes with the same priority are searched in order of the
+	// combined total value locked of their pools, so that deeper routes are
+	// preferred when the pool point budget does not allow searching every route.
+	WeightRoutesByTvl bool `protobuf:"varint,8,opt,name=weight_routes_by_tvl,json=weightRoutesByTvl,proto3" json:"weight_routes_by_tvl,omitempty" yaml:"weight_routes_by_tvl"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,6 +103,41 @@ func (m *Params) GetAdmin() string {
 	return ""
 }
 
+func (m *Params) GetMaxTradesPerBlock() uint64 {
+	if m != nil {
+		return m.MaxTradesPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetDisabledUntilHeight() uint64 {
+	if m != nil {
+		return m.DisabledUntilHeight
+	}
+	return 0
+}
+
+func (m *Params) GetMaxBackrunsPerPoolPerBlock() uint64 {
+	if m != nil {
+		return m.MaxBackrunsPerPoolPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMinPoolAgeBlocks() uint64 {
+	if m != nil {
+		return m.MinPoolAgeBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetWeightRoutesByTvl() bool {
+	if m != nil {
+		return m.WeightRoutesByTvl
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.protorev.v1beta1.Params")
 }
@@ -88,23 +147,41 @@ func init() {
 }
 
 var fileDescriptor_72168e5a5a65ae7e = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcd, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x2f, 0x4a, 0x2d, 0xd3, 0x2f, 0x33, 0x4c,
-	0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x03, 0x8b, 0x0b, 0x49,
-	0x40, 0x95, 0xe9, 0xc1, 0x94, 0xe9, 0x41, 0x95, 0x49, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x45,
-	0xf5, 0x41, 0x2c, 0x88, 0x02, 0x29, 0xc9, 0x64, 0xb0, 0x86, 0x78, 0x88, 0x04, 0x84, 0x03, 0x91,
-	0x52, 0x2a, 0xe3, 0x62, 0x0b, 0x00, 0x1b, 0x2d, 0xa4, 0xc3, 0xc5, 0x9e, 0x9a, 0x97, 0x98, 0x94,
-	0x93, 0x9a, 0x22, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0xe1, 0x24, 0xf4, 0xe9, 0x9e, 0x3c, 0x5f, 0x65,
-	0x62, 0x6e, 0x8e, 0x95, 0x12, 0x54, 0x42, 0x29, 0x08, 0xa6, 0x44, 0xc8, 0x8e, 0x8b, 0x35, 0x31,
-	0x25, 0x37, 0x33, 0x4f, 0x82, 0x49, 0x81, 0x51, 0x83, 0xd3, 0x49, 0xe3, 0xd3, 0x3d, 0x79, 0x1e,
-	0x88, 0x5a, 0xb0, 0xb0, 0xd2, 0xa5, 0x2d, 0xba, 0x22, 0x50, 0x8b, 0x1c, 0x53, 0x52, 0x8a, 0x52,
-	0x8b, 0x8b, 0x83, 0x4b, 0x8a, 0x32, 0xf3, 0xd2, 0x83, 0x20, 0xda, 0x9c, 0xfc, 0x4e, 0x3c, 0x92,
-	0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c,
-	0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x24, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f,
-	0x39, 0x3f, 0x57, 0x1f, 0xea, 0x4f, 0xdd, 0x9c, 0xc4, 0xa4, 0x62, 0x18, 0x47, 0xbf, 0xcc, 0xd0,
-	0x54, 0xbf, 0x02, 0x11, 0x42, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xbe, 0x31, 0x20,
-	0x00, 0x00, 0xff, 0xff, 0x08, 0x28, 0xa3, 0x77, 0x42, 0x01, 0x00, 0x00,
+	// 532 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x53, 0x41, 0x8b, 0xd3, 0x40,
+	0x18, 0x6d, 0x74, 0xdb, 0x5d, 0x83, 0x88, 0x9b, 0xad, 0x12, 0xab, 0x64, 0x4a, 0xc0, 0xa5, 0x88,
+	0x6d, 0x28, 0xea, 0xc5, 0x83, 0xb0, 0x41, 0xc4, 0x8b, 0x52, 0x63, 0xbd, 0x78, 0x19, 0x26, 0xc9,
+	0x98, 0x0e, 0xcd, 0x64, 0xca, 0xcc, 0xb4, 0xdb, 0xfe, 0x06, 0x2f, 0xfe, 0x18, 0xcf, 0x9e, 0xf7,
+	0xb8, 0x78, 0x12, 0x0f, 0x41, 0xda, 0x7f, 0x90, 0x5f, 0x20, 0x99, 0x99, 0xba, 0x05, 0xab, 0xa7,
+	0xf6, 0x7b, 0xef, 0x7d, 0xef, 0x9b, 0x79, 0x5f, 0xc6, 0x7e, 0xc8, 0x04, 0x65, 0x82, 0x88, 0x60,
+	0xc6, 0x99, 0x64, 0x1c, 0x2f, 0x82, 0xc5, 0x30, 0xc6, 0x12, 0x0d, 0x83, 0x19, 0xe2, 0x88, 0x8a,
+	0x81, 0xc2, 0x1d, 0xd7, 0xc8, 0x06, 0x5b, 0xd9, 0xc0, 0xc8, 0x3a, 0xed, 0x8c, 0x65, 0x4c, 0xa1,
+	0x41, 0xfd, 0x4f, 0x0b, 0x3a, 0xf7, 0x12, 0xd5, 0x00, 0x35, 0xa1, 0x0b, 0x4d, 0xf9, 0xdf, 0x9a,
+	0x76, 0x6b, 0xa4, 0xbc, 0x9d, 0xc7, 0xf6, 0x21, 0x2e, 0x50, 0x9c, 0xe3, 0xd4, 0xb5, 0xba, 0x56,
+	0xef, 0x28, 0x74, 0xaa, 0x12, 0xdc, 0x5a, 0x21, 0x9a, 0x3f, 0xf7, 0x0d, 0xe1, 0x47, 0x5b, 0x89,
+	0xf3, 0xc2, 0x6e, 0xa2, 0x94, 0x92, 0xc2, 0xbd, 0xd6, 0xb5, 0x7a, 0x37, 0xc2, 0x5e, 0x55, 0x82,
+	0x9b, 0x5a, 0xab, 0x60, 0xff, 0xfb, 0xd7, 0x7e, 0xdb, 0x4c, 0x3a, 0x4b, 0x53, 0x8e, 0x85, 0x78,
+	0x2f, 0x39, 0x29, 0xb2, 0x48, 0xb7, 0x39, 0x23, 0xbb, 0x4d, 0xd1, 0x12, 0x4a, 0x8e, 0x52, 0x2c,
+	0xe0, 0x0c, 0x73, 0x18, 0xe7, 0x2c, 0x99, 0xba, 0xd7, 0xbb, 0x56, 0xef, 0x20, 0x04, 0x55, 0x09,
+	0xee, 0x6b, 0xbb, 0x7d, 0x2a, 0x3f, 0x3a, 0xa6, 0x68, 0x39, 0x56, 0xe8, 0x08, 0xf3, 0xb0, 0xc6,
+	0x9c, 0xcf, 0x96, 0xed, 0x0a, 0x8c, 0x78, 0x32, 0xc1, 0x1c, 0x72, 0x7c, 0x8e, 0x78, 0x0a, 0x3f,
+	0x71, 0x94, 0x48, 0xc2, 0x0a, 0xf7, 0x40, 0x9d, 0xf2, 0xdd, 0x45, 0x09, 0x1a, 0x3f, 0x4b, 0x70,
+	0x9a, 0x11, 0x39, 0x99, 0xc7, 0x83, 0x84, 0x51, 0x13, 0x87, 0xf9, 0xe9, 0x8b, 0x74, 0x1a, 0xc8,
+	0xd5, 0x0c, 0x8b, 0xc1, 0x4b, 0x9c, 0x54, 0x25, 0x00, 0xfa, 0x10, 0xff, 0xf2, 0xf5, 0xa3, 0xbb,
+	0x5b, 0x2a, 0x52, 0xcc, 0x2b, 0x43, 0x38, 0x63, 0xfb, 0x4e, 0x4a, 0x84, 0xca, 0x0a, 0xce, 0x0b,
+	0x49, 0x72, 0x38, 0xc1, 0x24, 0x9b, 0x48, 0xb7, 0xa9, 0x2e, 0xd8, 0xad, 0x4a, 0xf0, 0x40, 0x7b,
+	0xef, 0x95, 0xf9, 0xd1, 0xc9, 0x16, 0xff, 0x50, 0xc3, 0xaf, 0x15, 0xea, 0x30, 0x1b, 0xd4, 0x79,
+	0xc4, 0x28, 0x99, 0xf2, 0x79, 0xa1, 0x13, 0x99, 0x31, 0x96, 0xef, 0x04, 0xd8, 0x52, 0xfe, 0x8f,
+	0xaa, 0x12, 0x9c, 0x5e, 0x05, 0xf8, 0x9f, 0x06, 0x3f, 0xea, 0x50, 0xb4, 0x0c, 0x8d, 0x60, 0x84,
+	0xf9, 0x88, 0xb1, 0xfc, 0x4f, 0xa8, 0x6f, 0xec, 0x13, 0x4a, 0x0a, 0xdd, 0x82, 0x32, 0xac, 0x5b,
+	0x84, 0x7b, 0xa8, 0x86, 0x78, 0x55, 0x09, 0x3a, 0x66, 0xc8, 0xdf, 0x22, 0x3f, 0xba, 0x4d, 0x49,
+	0x51, 0xbb, 0x9d, 0x65, 0x58, 0xb9, 0x89, 0x7a, 0xeb, 0xe7, 0xea, 0x26, 0x90, 0xb3, 0xb9, 0xc4,
+	0x02, 0xc6, 0x2b, 0x28, 0x17, 0xb9, 0x7b, 0xa4, 0x3e, 0xb8, 0x9d, 0xad, 0xef, 0x53, 0xf9, 0xd1,
+	0xb1, 0x86, 0x23, 0x85, 0x86, 0xab, 0xf1, 0x22, 0x0f, 0xdf, 0x5e, 0xac, 0x3d, 0xeb, 0x72, 0xed,
+	0x59, 0xbf, 0xd6, 0x9e, 0xf5, 0x65, 0xe3, 0x35, 0x2e, 0x37, 0x5e, 0xe3, 0xc7, 0xc6, 0x6b, 0x7c,
+	0x7c, 0xba, 0xb3, 0x64, 0xf3, 0x60, 0xfa, 0x39, 0x8a, 0xc5, 0xb6, 0x08, 0x16, 0xc3, 0x67, 0xc1,
+	0xf2, 0xea, 0xa9, 0xa9, 0xb5, 0xc7, 0x2d, 0x55, 0x3f, 0xf9, 0x3d, 0x00, 0x60, 0x69, 0xcb, 0x93,
+	0x8b, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -127,6 +204,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WeightRoutesByTvl {
+		i--
+		if m.WeightRoutesByTvl {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MinPoolAgeBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinPoolAgeBlocks))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxBackrunsPerPoolPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBackrunsPerPoolPerBlock))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DisabledUntilHeight != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DisabledUntilHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.SearcherRewardFraction.Size()
+		i -= size
+		if _, err := m.SearcherRewardFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MaxTradesPerBlock != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTradesPerBlock))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Admin) > 0 {
 		i -= len(m.Admin)
 		copy(dAtA[i:], m.Admin)
@@ -171,6 +288,23 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.MaxTradesPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxTradesPerBlock))
+	}
+	l = m.SearcherRewardFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.DisabledUntilHeight != 0 {
+		n += 1 + sovParams(uint64(m.DisabledUntilHeight))
+	}
+	if m.MaxBackrunsPerPoolPerBlock != 0 {
+		n += 1 + sovParams(uint64(m.MaxBackrunsPerPoolPerBlock))
+	}
+	if m.MinPoolAgeBlocks != 0 {
+		n += 1 + sovParams(uint64(m.MinPoolAgeBlocks))
+	}
+	if m.WeightRoutesByTvl {
+		n += 2
+	}
 	return n
 }
 
@@ -261,6 +395,136 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.Admin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTradesPerBlock", wireType)
+			}
+			m.MaxTradesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTradesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SearcherRewardFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SearcherRewardFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledUntilHeight", wireType)
+			}
+			m.DisabledUntilHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisabledUntilHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBackrunsPerPoolPerBlock", wireType)
+			}
+			m.MaxBackrunsPerPoolPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBackrunsPerPoolPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinPoolAgeBlocks", wireType)
+			}
+			m.MinPoolAgeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinPoolAgeBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightRoutesByTvl", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.WeightRoutesByTvl = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -96,8 +96,20 @@ type Route struct {
 	// -> right)
 	Trades []Trade `protobuf:"bytes,1,rep,name=trades,proto3" json:"trades" yaml:"trades"`
 	// The step size that will be used to find the optimal swap amount in the
-	// binary search
+	// binary search. Optional, the step size of the route's base denom is used
+	// if unset
 	StepSize github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=step_size,json=stepSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"step_size" yaml:"step_size"`
+	// The optional maximum amount of the input denom that the binary search may
+	// use when searching for the optimal swap amount. Unset means no cap.
+	MaxInputAmount *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=max_input_amount,json=maxInputAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_input_amount,omitempty" yaml:"max_input_amount"`
+	// The optional search priority of the route. Routes with a higher priority
+	// are simulated and executed before routes with a lower priority. Unset
+	// (zero) is the default priority.
+	Priority uint64 `protobuf:"varint,4,opt,name=priority,proto3" json:"priority,omitempty" yaml:"priority"`
+	// The optional minimum profit, in the input denom, that the optimal swap on
+	// the route must make for the route to be executed. Unset means any positive
+	// profit is executed.
+	MinProfitThreshold *github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=min_profit_threshold,json=minProfitThreshold,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_profit_threshold,omitempty" yaml:"min_profit_threshold"`
 }
 
 func (m *Route) Reset()         { *m = Route{} }
@@ -140,6 +152,13 @@ func (m *Route) GetTrades() []Trade {
 	return nil
 }
 
+func (m *Route) GetPriority() uint64 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
 // Trade is a single trade in a route
 type Trade struct {
 	// The pool id of the pool that is traded on
@@ -214,6 +233,16 @@ type RouteStatistics struct {
 	NumberOfTrades github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=number_of_trades,json=numberOfTrades,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"number_of_trades" yaml:"number_of_trades"`
 	// route is the route that was used (pool ids along the arbitrage route)
 	Route []uint64 `protobuf:"varint,3,rep,packed,name=route,proto3" json:"route,omitempty" yaml:"route"`
+	// last_execution_height is the block height of the last trade the module
+	// executed using this route. Routes that have not traded in a long time are
+	// candidates for removal from the hot routes.
+	LastExecutionHeight uint64 `protobuf:"varint,4,opt,name=last_execution_height,json=lastExecutionHeight,proto3" json:"last_execution_height,omitempty" yaml:"last_execution_height"`
+	// number_of_attempts is the number of times the module has searched this
+	// route for a profitable trade
+	NumberOfAttempts github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=number_of_attempts,json=numberOfAttempts,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"number_of_attempts" yaml:"number_of_attempts"`
+	// success_rate is the fraction of attempts on this route that resulted in a
+	// trade. Routes are searched in order of descending success rate.
+	SuccessRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=success_rate,json=successRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"success_rate" yaml:"success_rate"`
 }
 
 func (m *RouteStatistics) Reset()         { *m = RouteStatistics{} }
@@ -263,6 +292,185 @@ func (m *RouteStatistics) GetRoute() []uint64 {
 	return nil
 }
 
+func (m *RouteStatistics) GetLastExecutionHeight() uint64 {
+	if m != nil {
+		return m.LastExecutionHeight
+	}
+	return 0
+}
+
+// ArbitrageOpportunity is a profitable cyclic arbitrage route found by scanning
+// the current state, alongside its optimal input and expected profit
+type ArbitrageOpportunity struct {
+	// route is the route of the opportunity (pool ids along the arbitrage route)
+	Route []uint64 `protobuf:"varint,1,rep,packed,name=route,proto3" json:"route,omitempty" yaml:"route"`
+	// input is the optimal amount of the input denom to trade along the route
+	Input types.Coin `protobuf:"bytes,2,opt,name=input,proto3" json:"input" yaml:"input"`
+	// profit is the expected profit of the opportunity, in the input denom
+	Profit types.Coin `protobuf:"bytes,3,opt,name=profit,proto3" json:"profit" yaml:"profit"`
+}
+
+func (m *ArbitrageOpportunity) Reset()         { *m = ArbitrageOpportunity{} }
+func (m *ArbitrageOpportunity) String() string { return proto.CompactTextString(m) }
+func (*ArbitrageOpportunity) ProtoMessage()    {}
+func (*ArbitrageOpportunity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{4}
+}
+func (m *ArbitrageOpportunity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArbitrageOpportunity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArbitrageOpportunity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ArbitrageOpportunity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArbitrageOpportunity.Merge(m, src)
+}
+func (m *ArbitrageOpportunity) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArbitrageOpportunity) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArbitrageOpportunity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArbitrageOpportunity proto.InternalMessageInfo
+
+func (m *ArbitrageOpportunity) GetRoute() []uint64 {
+	if m != nil {
+		return m.Route
+	}
+	return nil
+}
+
+func (m *ArbitrageOpportunity) GetInput() types.Coin {
+	if m != nil {
+		return m.Input
+	}
+	return types.Coin{}
+}
+
+func (m *ArbitrageOpportunity) GetProfit() types.Coin {
+	if m != nil {
+		return m.Profit
+	}
+	return types.Coin{}
+}
+
+// ProfitSearchStep is a single iteration of the search for the optimal input
+// amount of an arbitrage route
+type ProfitSearchStep struct {
+	// input is the amount of the input denom that was tried
+	Input types.Coin `protobuf:"bytes,1,opt,name=input,proto3" json:"input" yaml:"input"`
+	// profit is the profit that trading the input along the route results in,
+	// in the input denom. It is negative if the trade results in a loss.
+	Profit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=profit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"profit" yaml:"profit"`
+}
+
+func (m *ProfitSearchStep) Reset()         { *m = ProfitSearchStep{} }
+func (m *ProfitSearchStep) String() string { return proto.CompactTextString(m) }
+func (*ProfitSearchStep) ProtoMessage()    {}
+func (*ProfitSearchStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{5}
+}
+func (m *ProfitSearchStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProfitSearchStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProfitSearchStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProfitSearchStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfitSearchStep.Merge(m, src)
+}
+func (m *ProfitSearchStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProfitSearchStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfitSearchStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfitSearchStep proto.InternalMessageInfo
+
+func (m *ProfitSearchStep) GetInput() types.Coin {
+	if m != nil {
+		return m.Input
+	}
+	return types.Coin{}
+}
+
+// ProfitValuation contains the profits the module has made in a given denom
+// and the estimated value of those profits in another denom
+type ProfitValuation struct {
+	// profit is the profit the module has made in a given denom
+	Profit types.Coin `protobuf:"bytes,1,opt,name=profit,proto3" json:"profit" yaml:"profit"`
+	// value is the estimated value of the profit in the target denom. It is zero
+	// if the profit could not be valued
+	Value types.Coin `protobuf:"bytes,2,opt,name=value,proto3" json:"value" yaml:"value"`
+}
+
+func (m *ProfitValuation) Reset()         { *m = ProfitValuation{} }
+func (m *ProfitValuation) String() string { return proto.CompactTextString(m) }
+func (*ProfitValuation) ProtoMessage()    {}
+func (*ProfitValuation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{6}
+}
+func (m *ProfitValuation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProfitValuation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProfitValuation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProfitValuation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProfitValuation.Merge(m, src)
+}
+func (m *ProfitValuation) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProfitValuation) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProfitValuation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProfitValuation proto.InternalMessageInfo
+
+func (m *ProfitValuation) GetProfit() types.Coin {
+	if m != nil {
+		return m.Profit
+	}
+	return types.Coin{}
+}
+
+func (m *ProfitValuation) GetValue() types.Coin {
+	if m != nil {
+		return m.Value
+	}
+	return types.Coin{}
+}
+
 // PoolWeights contains the weights of all of the different pool types. This
 // distinction is made and necessary because the execution time ranges
 // significantly between the different pool types. Each weight roughly
@@ -281,7 +489,7 @@ func (m *PoolWeights) Reset()         { *m = PoolWeights{} }
 func (m *PoolWeights) String() string { return proto.CompactTextString(m) }
 func (*PoolWeights) ProtoMessage()    {}
 func (*PoolWeights) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e9f2391fd9fec01, []int{4}
+	return fileDescriptor_1e9f2391fd9fec01, []int{7}
 }
 func (m *PoolWeights) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -340,13 +548,16 @@ type BaseDenom struct {
 	// The step size of the binary search that is used to find the optimal swap
 	// amount
 	StepSize github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=step_size,json=stepSize,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"step_size" yaml:"step_size"`
+	// Whether the base denom may only be used as an intermediate hop of a route,
+	// in which case routes are never seeded with it
+	IntermediateOnly bool `protobuf:"varint,3,opt,name=intermediate_only,json=intermediateOnly,proto3" json:"intermediate_only,omitempty" yaml:"intermediate_only"`
 }
 
 func (m *BaseDenom) Reset()         { *m = BaseDenom{} }
 func (m *BaseDenom) String() string { return proto.CompactTextString(m) }
 func (*BaseDenom) ProtoMessage()    {}
 func (*BaseDenom) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e9f2391fd9fec01, []int{5}
+	return fileDescriptor_1e9f2391fd9fec01, []int{8}
 }
 func (m *BaseDenom) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -382,11 +593,21 @@ func (m *BaseDenom) GetDenom() string {
 	return ""
 }
 
+func (m *BaseDenom) GetIntermediateOnly() bool {
+	if m != nil {
+		return m.IntermediateOnly
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*TokenPairArbRoutes)(nil), "osmosis.protorev.v1beta1.TokenPairArbRoutes")
 	proto.RegisterType((*Route)(nil), "osmosis.protorev.v1beta1.Route")
 	proto.RegisterType((*Trade)(nil), "osmosis.protorev.v1beta1.Trade")
 	proto.RegisterType((*RouteStatistics)(nil), "osmosis.protorev.v1beta1.RouteStatistics")
+	proto.RegisterType((*ArbitrageOpportunity)(nil), "osmosis.protorev.v1beta1.ArbitrageOpportunity")
+	proto.RegisterType((*ProfitSearchStep)(nil), "osmosis.protorev.v1beta1.ProfitSearchStep")
+	proto.RegisterType((*ProfitValuation)(nil), "osmosis.protorev.v1beta1.ProfitValuation")
 	proto.RegisterType((*PoolWeights)(nil), "osmosis.protorev.v1beta1.PoolWeights")
 	proto.RegisterType((*BaseDenom)(nil), "osmosis.protorev.v1beta1.BaseDenom")
 }
@@ -396,49 +617,71 @@ func init() {
 }
 
 var fileDescriptor_1e9f2391fd9fec01 = []byte{
-	// 661 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0xbd, 0x6f, 0xd3, 0x40,
-	0x14, 0xcf, 0x35, 0xe9, 0x47, 0xae, 0x1f, 0x29, 0x6e, 0x29, 0x6e, 0x06, 0x3b, 0x3a, 0xa4, 0x92,
-	0xa5, 0xb6, 0xc2, 0xc7, 0x52, 0x89, 0x01, 0x97, 0xa5, 0x42, 0x6a, 0xab, 0x6b, 0x25, 0x04, 0x8b,
-	0x75, 0x76, 0xae, 0xa9, 0xd5, 0xc4, 0x17, 0xf9, 0x2e, 0x85, 0xf6, 0xaf, 0x60, 0x80, 0x9d, 0xbf,
-	0x84, 0xb9, 0x63, 0xc7, 0x8a, 0xc1, 0x42, 0xe9, 0xc2, 0xec, 0x95, 0x05, 0xf9, 0xee, 0xec, 0x44,
-	0x15, 0x48, 0x30, 0xc0, 0xe4, 0xf7, 0x7e, 0xef, 0xfd, 0x7e, 0xef, 0xde, 0x7b, 0x77, 0x86, 0x8f,
-	0x18, 0x1f, 0x30, 0x1e, 0x71, 0x77, 0x98, 0x30, 0xc1, 0x12, 0x7a, 0xee, 0x9e, 0x77, 0x02, 0x2a,
-	0x48, 0xa7, 0x04, 0x1c, 0x69, 0x18, 0xa6, 0x4e, 0x74, 0x4a, 0x5c, 0x27, 0x36, 0x37, 0x43, 0x19,
-	0xf2, 0x65, 0xc0, 0x55, 0x8e, 0xca, 0x6a, 0xae, 0xf7, 0x58, 0x8f, 0x29, 0x3c, 0xb7, 0x34, 0x6a,
-	0xa9, 0x1c, 0x37, 0x20, 0x9c, 0x96, 0xe5, 0x42, 0x16, 0xc5, 0x2a, 0x8e, 0x6e, 0x00, 0x34, 0x8e,
-	0xd9, 0x19, 0x8d, 0x0f, 0x49, 0x94, 0xbc, 0x48, 0x02, 0xcc, 0x46, 0x82, 0x72, 0xe3, 0x0d, 0x84,
-	0x24, 0x09, 0xfc, 0x44, 0x7a, 0x26, 0x68, 0x55, 0xdb, 0x8b, 0x8f, 0x6d, 0xe7, 0x77, 0xc7, 0x72,
-	0x24, 0xcb, 0xdb, 0xbc, 0x4a, 0xed, 0x4a, 0x96, 0xda, 0xf7, 0x2e, 0xc8, 0xa0, 0xbf, 0x83, 0x26,
-	0x02, 0x08, 0xd7, 0x49, 0x29, 0xed, 0xc0, 0x05, 0x91, 0x17, 0xf4, 0xa3, 0xd8, 0x9c, 0x69, 0x81,
-	0x76, 0xdd, 0x5b, 0xcb, 0x52, 0xbb, 0xa1, 0x38, 0x45, 0x04, 0xe1, 0x79, 0x69, 0xee, 0xc5, 0x46,
-	0x07, 0xd6, 0x15, 0xca, 0x46, 0xc2, 0xac, 0x4a, 0xc2, 0x7a, 0x96, 0xda, 0xab, 0xd3, 0x04, 0x36,
-	0x12, 0x08, 0x2b, 0xd9, 0x83, 0x91, 0xd8, 0xa9, 0x7d, 0xff, 0x6c, 0x03, 0xf4, 0x05, 0xc0, 0x59,
-	0x59, 0xd3, 0xd8, 0x87, 0x73, 0x22, 0x21, 0xdd, 0x3f, 0xe9, 0xe4, 0x38, 0xcf, 0xf3, 0xee, 0xeb,
-	0x4e, 0x96, 0x75, 0x11, 0x49, 0x46, 0x58, 0xab, 0x18, 0x3e, 0xac, 0x73, 0x41, 0x87, 0x3e, 0x8f,
-	0x2e, 0xa9, 0xee, 0xc1, 0xcb, 0x19, 0x5f, 0x53, 0x7b, 0xab, 0x17, 0x89, 0xd3, 0x51, 0xe0, 0x84,
-	0x6c, 0xa0, 0xd7, 0xa3, 0x3f, 0xdb, 0xbc, 0x7b, 0xe6, 0x8a, 0x8b, 0x21, 0xe5, 0xce, 0x5e, 0x2c,
-	0x26, 0x0d, 0x94, 0x42, 0x08, 0x2f, 0xe4, 0xf6, 0x51, 0x74, 0x49, 0x75, 0x03, 0x9f, 0x00, 0x9c,
-	0x95, 0xe7, 0x31, 0x1e, 0xc2, 0xda, 0x90, 0xb1, 0xbe, 0x09, 0x5a, 0xa0, 0x5d, 0xf3, 0x1a, 0x59,
-	0x6a, 0x2f, 0x2a, 0x76, 0x8e, 0x22, 0x2c, 0x83, 0xff, 0x6f, 0xb0, 0x3f, 0x00, 0x6c, 0xc8, 0xc1,
-	0x1e, 0x09, 0x22, 0x22, 0x2e, 0xa2, 0x90, 0x1b, 0xaf, 0xe0, 0xfc, 0x30, 0x61, 0x27, 0x91, 0x28,
-	0x66, 0xbc, 0xe9, 0xe8, 0xdb, 0x99, 0xdf, 0xbc, 0x72, 0xbc, 0xbb, 0x2c, 0x8a, 0xbd, 0x0d, 0x3d,
-	0xdd, 0x15, 0xdd, 0x83, 0xe2, 0x21, 0x5c, 0x28, 0x18, 0x1c, 0xae, 0xc6, 0xa3, 0x41, 0x40, 0x13,
-	0x9f, 0x9d, 0xf8, 0x7a, 0x73, 0xaa, 0xa3, 0xbd, 0xbf, 0x1e, 0xf3, 0x03, 0x55, 0xe4, 0xae, 0x1e,
-	0xc2, 0x2b, 0x0a, 0x3a, 0x38, 0x39, 0x56, 0x4b, 0xdd, 0x82, 0xb3, 0xf2, 0xb6, 0x9a, 0xd5, 0x56,
-	0xb5, 0x5d, 0xf3, 0x56, 0xb3, 0xd4, 0x5e, 0x52, 0x5c, 0x09, 0x23, 0xac, 0xc2, 0x68, 0x0c, 0xe0,
-	0xe2, 0x21, 0x63, 0xfd, 0xd7, 0x34, 0xea, 0x9d, 0x0a, 0x6e, 0x3c, 0x87, 0xcb, 0x5c, 0x90, 0xa0,
-	0x4f, 0xfd, 0x77, 0x12, 0xd1, 0x4b, 0x32, 0xb3, 0xd4, 0x5e, 0x2f, 0x56, 0x3c, 0x15, 0x46, 0x78,
-	0x49, 0xf9, 0x8a, 0x6f, 0xec, 0xc2, 0x46, 0x40, 0xfa, 0x24, 0x0e, 0x69, 0x52, 0x08, 0xcc, 0x48,
-	0x81, 0x66, 0x96, 0xda, 0x1b, 0x4a, 0xe0, 0x4e, 0x02, 0xc2, 0x2b, 0x05, 0xa2, 0x45, 0x0e, 0xe0,
-	0x5a, 0xc8, 0xe2, 0x90, 0xc6, 0x22, 0x21, 0x82, 0x76, 0x0b, 0xa1, 0xaa, 0x14, 0xb2, 0xb2, 0xd4,
-	0x6e, 0x2a, 0xa1, 0x5f, 0x24, 0x21, 0x6c, 0x4c, 0xa3, 0x4a, 0x10, 0x7d, 0x04, 0xb0, 0xee, 0x11,
-	0x4e, 0x5f, 0xd2, 0x98, 0x0d, 0xf2, 0xd1, 0x74, 0x73, 0x43, 0xb6, 0x56, 0x9f, 0x1e, 0x8d, 0x84,
-	0x11, 0x56, 0xe1, 0x7f, 0xfe, 0x2e, 0xbc, 0xfd, 0xab, 0xb1, 0x05, 0xae, 0xc7, 0x16, 0xf8, 0x36,
-	0xb6, 0xc0, 0x87, 0x5b, 0xab, 0x72, 0x7d, 0x6b, 0x55, 0x6e, 0x6e, 0xad, 0xca, 0xdb, 0xa7, 0x53,
-	0xfa, 0xfa, 0x71, 0x6f, 0xf7, 0x49, 0xc0, 0x0b, 0xc7, 0x3d, 0xef, 0x3c, 0x73, 0xdf, 0x4f, 0xfe,
-	0xbc, 0xb2, 0x62, 0x30, 0x27, 0xfd, 0x27, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xec, 0x1d, 0xab,
-	0xff, 0x9a, 0x05, 0x00, 0x00,
+	// 1023 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0xdb, 0x36,
+	0x14, 0x8f, 0x6a, 0x27, 0x8d, 0x99, 0x34, 0x71, 0x19, 0xb7, 0x53, 0xb2, 0xc2, 0x0a, 0x38, 0xa0,
+	0xcb, 0xa5, 0x36, 0xb2, 0x3f, 0x97, 0x02, 0xc3, 0x10, 0xb7, 0x01, 0x1a, 0x14, 0x6b, 0x02, 0x26,
+	0x58, 0xb1, 0x5d, 0x04, 0x4a, 0x66, 0x62, 0xa2, 0x12, 0x29, 0x88, 0x54, 0x16, 0xf7, 0xb2, 0xaf,
+	0xb0, 0xcb, 0xee, 0xc3, 0x3e, 0xc2, 0x0e, 0xfb, 0x0c, 0xb9, 0xad, 0xc7, 0x62, 0x07, 0x6d, 0x48,
+	0x30, 0x60, 0x67, 0x7d, 0x82, 0x41, 0x24, 0xa5, 0x18, 0x46, 0xda, 0xcd, 0x2b, 0xb6, 0x93, 0xc9,
+	0xdf, 0x7b, 0xbf, 0x1f, 0xdf, 0x23, 0x7f, 0xcf, 0x36, 0xf8, 0x50, 0xc8, 0x58, 0x48, 0x26, 0xfb,
+	0x49, 0x2a, 0x94, 0x48, 0xe9, 0x69, 0xff, 0x74, 0x3b, 0xa0, 0x8a, 0x6c, 0xd7, 0x40, 0x4f, 0x2f,
+	0xa0, 0x6b, 0x13, 0x7b, 0x35, 0x6e, 0x13, 0x37, 0xd6, 0x43, 0x1d, 0xf2, 0x75, 0xa0, 0x6f, 0x36,
+	0x26, 0x6b, 0xa3, 0x73, 0x22, 0x4e, 0x84, 0xc1, 0xcb, 0x95, 0x45, 0xbb, 0x26, 0xa7, 0x1f, 0x10,
+	0x49, 0xeb, 0xe3, 0x42, 0xc1, 0xb8, 0x89, 0xa3, 0xd7, 0x0e, 0x80, 0x47, 0xe2, 0x05, 0xe5, 0x07,
+	0x84, 0xa5, 0x3b, 0x69, 0x80, 0x45, 0xa6, 0xa8, 0x84, 0x5f, 0x01, 0x40, 0xd2, 0xc0, 0x4f, 0xf5,
+	0xce, 0x75, 0x36, 0x1b, 0x5b, 0x4b, 0x1f, 0x79, 0xbd, 0x37, 0x95, 0xd5, 0xd3, 0xac, 0xc1, 0xfa,
+	0x79, 0xee, 0xcd, 0x15, 0xb9, 0x77, 0x7b, 0x4c, 0xe2, 0xe8, 0x21, 0xba, 0x12, 0x40, 0xb8, 0x45,
+	0x6a, 0xe9, 0x1e, 0x58, 0x54, 0xe5, 0x81, 0x3e, 0xe3, 0xee, 0x8d, 0x4d, 0x67, 0xab, 0x35, 0x58,
+	0x2b, 0x72, 0x6f, 0xd5, 0x70, 0xaa, 0x08, 0xc2, 0x37, 0xf5, 0x72, 0x8f, 0xc3, 0x6d, 0xd0, 0x32,
+	0xa8, 0xc8, 0x94, 0xdb, 0xd0, 0x84, 0x4e, 0x91, 0x7b, 0xed, 0x49, 0x82, 0xc8, 0x14, 0xc2, 0x46,
+	0x76, 0x3f, 0x53, 0x0f, 0x9b, 0x7f, 0xfe, 0xe0, 0x39, 0xe8, 0x8f, 0x06, 0x98, 0xd7, 0x67, 0xc2,
+	0x67, 0x60, 0x41, 0xa5, 0x64, 0xf8, 0x4f, 0x3a, 0x39, 0x2a, 0xf3, 0x06, 0x77, 0x6c, 0x27, 0xb7,
+	0xec, 0x21, 0x9a, 0x8c, 0xb0, 0x55, 0x81, 0x3e, 0x68, 0x49, 0x45, 0x13, 0x5f, 0xb2, 0x97, 0xd4,
+	0xf6, 0x30, 0x28, 0x19, 0xbf, 0xe6, 0xde, 0xfd, 0x13, 0xa6, 0x46, 0x59, 0xd0, 0x0b, 0x45, 0x6c,
+	0x9f, 0xc7, 0x7e, 0x3c, 0x90, 0xc3, 0x17, 0x7d, 0x35, 0x4e, 0xa8, 0xec, 0xed, 0x71, 0x75, 0xd5,
+	0x40, 0x2d, 0x84, 0xf0, 0x62, 0xb9, 0x3e, 0x64, 0x2f, 0x29, 0x94, 0xa0, 0x1d, 0x93, 0x33, 0x9f,
+	0xf1, 0x24, 0x53, 0x3e, 0x89, 0x45, 0xc6, 0xab, 0xd6, 0xf7, 0xce, 0x73, 0xcf, 0x99, 0xe9, 0x9c,
+	0xf7, 0xcc, 0x39, 0xd3, 0x7a, 0x08, 0xaf, 0xc4, 0xe4, 0x6c, 0xaf, 0x44, 0x76, 0x34, 0x00, 0xfb,
+	0x60, 0x31, 0x49, 0x99, 0x48, 0x99, 0x1a, 0xbb, 0xcd, 0x4d, 0x67, 0xab, 0x39, 0xf9, 0x30, 0x55,
+	0x04, 0xe1, 0x3a, 0x09, 0x7e, 0x0b, 0x3a, 0x31, 0xe3, 0xa5, 0x17, 0x8f, 0x99, 0xf2, 0xd5, 0x28,
+	0xa5, 0x72, 0x24, 0xa2, 0xa1, 0x3b, 0xaf, 0x2b, 0xfd, 0x62, 0xe6, 0x4a, 0xdf, 0xb7, 0x95, 0x5e,
+	0xa3, 0x89, 0x30, 0x8c, 0x19, 0x3f, 0xd0, 0xe8, 0x51, 0x05, 0xda, 0x77, 0xfe, 0xde, 0x01, 0xf3,
+	0xfa, 0xd9, 0xe0, 0x07, 0xa0, 0x99, 0x08, 0x11, 0xb9, 0x8e, 0xae, 0x7e, 0xb5, 0xc8, 0xbd, 0x25,
+	0x5b, 0xbd, 0x10, 0x11, 0xc2, 0x3a, 0xf8, 0xff, 0xf9, 0xef, 0xe7, 0x26, 0x58, 0xd5, 0xfe, 0x3b,
+	0x54, 0x44, 0x31, 0xa9, 0x58, 0x28, 0xe1, 0x53, 0x70, 0xd3, 0xb4, 0x56, 0x59, 0x71, 0xbd, 0x67,
+	0x87, 0xb8, 0x1c, 0xd0, 0xda, 0x85, 0x8f, 0x04, 0xe3, 0x83, 0xbb, 0xd6, 0x84, 0x2b, 0xd5, 0x0b,
+	0x68, 0x1e, 0xc2, 0x95, 0x42, 0xe9, 0x12, 0x9e, 0xc5, 0x01, 0x4d, 0x7d, 0x71, 0xec, 0x5b, 0x83,
+	0xdf, 0xa8, 0x5d, 0x32, 0xf7, 0x6f, 0x5c, 0x32, 0xad, 0x87, 0xf0, 0x8a, 0x81, 0xf6, 0x8f, 0x8f,
+	0x8c, 0xf7, 0xef, 0x83, 0x79, 0x3d, 0xd4, 0x6e, 0x63, 0xb3, 0xb1, 0xd5, 0x1c, 0xb4, 0x8b, 0xdc,
+	0x5b, 0x36, 0x5c, 0x0d, 0x23, 0x6c, 0xc2, 0xf0, 0x08, 0xdc, 0x89, 0x88, 0x54, 0x3e, 0x3d, 0xa3,
+	0x61, 0xa6, 0x98, 0xe0, 0xfe, 0x88, 0xb2, 0x93, 0x91, 0xb2, 0xd6, 0xda, 0x2c, 0x72, 0xef, 0x9e,
+	0xe1, 0x5d, 0x9b, 0x86, 0xf0, 0x5a, 0x89, 0xef, 0x56, 0xf0, 0x13, 0x8d, 0xc2, 0x31, 0x80, 0x57,
+	0x25, 0x12, 0xa5, 0x68, 0x9c, 0x28, 0x69, 0x0d, 0xf7, 0x74, 0xe6, 0xa6, 0xd7, 0xa7, 0x9b, 0xae,
+	0x14, 0x11, 0x6e, 0x57, 0x6d, 0xef, 0x58, 0x08, 0x8e, 0xc0, 0xb2, 0xcc, 0xc2, 0x90, 0x4a, 0xe9,
+	0xa7, 0x44, 0x51, 0x77, 0x41, 0x1f, 0xba, 0x3b, 0xc3, 0xa1, 0x8f, 0x69, 0x58, 0xe4, 0xde, 0x9a,
+	0x9d, 0xfb, 0x09, 0x2d, 0x84, 0x97, 0xec, 0x16, 0x97, 0xbb, 0x5f, 0x1c, 0xd0, 0xd9, 0x49, 0x03,
+	0xa6, 0x52, 0x72, 0x42, 0xf7, 0x93, 0x44, 0xa4, 0x2a, 0xe3, 0xe5, 0xc0, 0xd5, 0x77, 0xef, 0xbc,
+	0xfd, 0xee, 0x77, 0xc1, 0xbc, 0x1e, 0x75, 0xed, 0x86, 0xb7, 0x7a, 0xac, 0x63, 0x3d, 0x66, 0x65,
+	0x34, 0x0b, 0x61, 0xc3, 0x86, 0x4f, 0xc0, 0x82, 0xb1, 0x9a, 0xdb, 0xf8, 0x3b, 0x9d, 0xa9, 0x2f,
+	0x4c, 0x43, 0x43, 0xd8, 0xf2, 0xd1, 0x4f, 0x0e, 0x68, 0x9b, 0xe1, 0x3d, 0xa4, 0x24, 0x0d, 0x47,
+	0x87, 0x8a, 0x26, 0x57, 0x55, 0x3a, 0xef, 0x54, 0xe5, 0xf3, 0xba, 0x4a, 0xe3, 0xfd, 0xcf, 0x67,
+	0xb6, 0xc1, 0x1b, 0x8a, 0xfe, 0xd1, 0x01, 0xab, 0xa6, 0xe8, 0x2f, 0x49, 0x94, 0x91, 0xd2, 0x85,
+	0x13, 0x57, 0xe2, 0xbc, 0xdb, 0x95, 0x94, 0xdd, 0x9f, 0x92, 0x28, 0xa3, 0x33, 0xbf, 0x91, 0x66,
+	0x21, 0x6c, 0xd8, 0xe8, 0xc2, 0x01, 0x4b, 0x07, 0x42, 0x44, 0xcf, 0xf5, 0x7c, 0x48, 0xf8, 0x19,
+	0xb8, 0x25, 0x15, 0x09, 0x22, 0xea, 0x7f, 0x63, 0xc6, 0xcd, 0x7c, 0x17, 0xba, 0x45, 0xee, 0x75,
+	0xaa, 0x1f, 0x9c, 0x89, 0x30, 0xc2, 0xcb, 0x66, 0x6f, 0xf8, 0xf0, 0x11, 0x58, 0x0d, 0x48, 0x44,
+	0x78, 0x48, 0xd3, 0x4a, 0xe0, 0x86, 0x16, 0xd8, 0x28, 0x72, 0xef, 0xae, 0x11, 0x98, 0x4a, 0x40,
+	0x78, 0xa5, 0x42, 0xac, 0xc8, 0x3e, 0x58, 0x0b, 0x05, 0x0f, 0x29, 0x57, 0xa5, 0xb9, 0x87, 0x95,
+	0x50, 0x43, 0x0b, 0x75, 0x8b, 0xdc, 0xdb, 0x30, 0x42, 0xd7, 0x24, 0x21, 0x0c, 0x27, 0x51, 0x23,
+	0x88, 0x7e, 0x73, 0x40, 0x6b, 0x40, 0x24, 0x7d, 0x4c, 0xb9, 0x88, 0xcb, 0x29, 0x18, 0x96, 0x0b,
+	0xdd, 0x5a, 0x6b, 0x72, 0x0a, 0x34, 0x8c, 0xb0, 0x09, 0xff, 0xf7, 0xbf, 0xd2, 0x7b, 0xe0, 0x36,
+	0xe3, 0x8a, 0xa6, 0x31, 0x1d, 0x32, 0xa2, 0xa8, 0x2f, 0x78, 0x34, 0xd6, 0x5d, 0x2e, 0x0e, 0xee,
+	0x15, 0xb9, 0xe7, 0x56, 0x6e, 0x9d, 0x4a, 0x41, 0xb8, 0x3d, 0x89, 0xed, 0xf3, 0x68, 0x3c, 0x78,
+	0x76, 0x7e, 0xd1, 0x75, 0x5e, 0x5d, 0x74, 0x9d, 0xdf, 0x2f, 0xba, 0xce, 0x77, 0x97, 0xdd, 0xb9,
+	0x57, 0x97, 0xdd, 0xb9, 0xd7, 0x97, 0xdd, 0xb9, 0xaf, 0x3f, 0x99, 0x28, 0xd5, 0xfe, 0x6b, 0x79,
+	0x10, 0x91, 0x40, 0x56, 0x9b, 0xfe, 0xe9, 0xf6, 0xa7, 0xfd, 0xb3, 0xab, 0xbf, 0x94, 0xba, 0xf8,
+	0x60, 0x41, 0xef, 0x3f, 0xfe, 0x6b, 0x00, 0x78, 0x7e, 0x33, 0x02, 0x73, 0x0a, 0x00, 0x00,
 }
 
 func (this *TokenPairArbRoutes) Equal(that interface{}) bool {
@@ -506,6 +749,23 @@ func (this *Route) Equal(that interface{}) bool {
 	if !this.StepSize.Equal(that1.StepSize) {
 		return false
 	}
+	if that1.MaxInputAmount == nil {
+		if this.MaxInputAmount != nil {
+			return false
+		}
+	} else if !this.MaxInputAmount.Equal(*that1.MaxInputAmount) {
+		return false
+	}
+	if this.Priority != that1.Priority {
+		return false
+	}
+	if that1.MinProfitThreshold == nil {
+		if this.MinProfitThreshold != nil {
+			return false
+		}
+	} else if !this.MinProfitThreshold.Equal(*that1.MinProfitThreshold) {
+		return false
+	}
 	return true
 }
 func (this *Trade) Equal(that interface{}) bool {
@@ -609,6 +869,35 @@ func (m *Route) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MinProfitThreshold != nil {
+		{
+			size := m.MinProfitThreshold.Size()
+			i -= size
+			if _, err := m.MinProfitThreshold.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintProtorev(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Priority != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxInputAmount != nil {
+		{
+			size := m.MaxInputAmount.Size()
+			i -= size
+			if _, err := m.MaxInputAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintProtorev(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
 	{
 		size := m.StepSize.Size()
 		i -= size
@@ -698,6 +987,31 @@ func (m *RouteStatistics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.SuccessRate.Size()
+		i -= size
+		if _, err := m.SuccessRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProtorev(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.NumberOfAttempts.Size()
+		i -= size
+		if _, err := m.NumberOfAttempts.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProtorev(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.LastExecutionHeight != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.LastExecutionHeight))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Route) > 0 {
 		dAtA2 := make([]byte, len(m.Route)*10)
 		var j1 int
@@ -743,7 +1057,7 @@ func (m *RouteStatistics) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PoolWeights) Marshal() (dAtA []byte, err error) {
+func (m *ArbitrageOpportunity) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -753,35 +1067,58 @@ func (m *PoolWeights) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PoolWeights) MarshalTo(dAtA []byte) (int, error) {
+func (m *ArbitrageOpportunity) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PoolWeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ArbitrageOpportunity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.ConcentratedWeight != 0 {
-		i = encodeVarintProtorev(dAtA, i, uint64(m.ConcentratedWeight))
-		i--
-		dAtA[i] = 0x18
+	{
+		size, err := m.Profit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtorev(dAtA, i, uint64(size))
 	}
-	if m.BalancerWeight != 0 {
-		i = encodeVarintProtorev(dAtA, i, uint64(m.BalancerWeight))
-		i--
-		dAtA[i] = 0x10
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtorev(dAtA, i, uint64(size))
 	}
-	if m.StableWeight != 0 {
-		i = encodeVarintProtorev(dAtA, i, uint64(m.StableWeight))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Route) > 0 {
+		dAtA6 := make([]byte, len(m.Route)*10)
+		var j5 int
+		for _, num := range m.Route {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintProtorev(dAtA, i, uint64(j5))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *BaseDenom) Marshal() (dAtA []byte, err error) {
+func (m *ProfitSearchStep) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -791,76 +1128,210 @@ func (m *BaseDenom) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BaseDenom) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProfitSearchStep) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BaseDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProfitSearchStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size := m.StepSize.Size()
+		size := m.Profit.Size()
 		i -= size
-		if _, err := m.StepSize.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Profit.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintProtorev(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintProtorev(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtorev(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintProtorev(dAtA []byte, offset int, v uint64) int {
-	offset -= sovProtorev(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ProfitValuation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *TokenPairArbRoutes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ProfitValuation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProfitValuation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ArbRoutes) > 0 {
-		for _, e := range m.ArbRoutes {
-			l = e.Size()
-			n += 1 + l + sovProtorev(uint64(l))
+	{
+		size, err := m.Value.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintProtorev(dAtA, i, uint64(size))
 	}
-	l = len(m.TokenIn)
-	if l > 0 {
-		n += 1 + l + sovProtorev(uint64(l))
-	}
-	l = len(m.TokenOut)
-	if l > 0 {
-		n += 1 + l + sovProtorev(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Profit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintProtorev(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Route) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *PoolWeights) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolWeights) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolWeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConcentratedWeight != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.ConcentratedWeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BalancerWeight != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.BalancerWeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StableWeight != 0 {
+		i = encodeVarintProtorev(dAtA, i, uint64(m.StableWeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BaseDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IntermediateOnly {
+		i--
+		if m.IntermediateOnly {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.StepSize.Size()
+		i -= size
+		if _, err := m.StepSize.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProtorev(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintProtorev(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProtorev(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProtorev(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TokenPairArbRoutes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ArbRoutes) > 0 {
+		for _, e := range m.ArbRoutes {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovProtorev(uint64(l))
+	}
+	l = len(m.TokenOut)
+	if l > 0 {
+		n += 1 + l + sovProtorev(uint64(l))
+	}
+	return n
+}
+
+func (m *Route) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	if len(m.Trades) > 0 {
 		for _, e := range m.Trades {
 			l = e.Size()
@@ -869,6 +1340,17 @@ func (m *Route) Size() (n int) {
 	}
 	l = m.StepSize.Size()
 	n += 1 + l + sovProtorev(uint64(l))
+	if m.MaxInputAmount != nil {
+		l = m.MaxInputAmount.Size()
+		n += 1 + l + sovProtorev(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovProtorev(uint64(m.Priority))
+	}
+	if m.MinProfitThreshold != nil {
+		l = m.MinProfitThreshold.Size()
+		n += 1 + l + sovProtorev(uint64(l))
+	}
 	return n
 }
 
@@ -913,6 +1395,59 @@ func (m *RouteStatistics) Size() (n int) {
 		}
 		n += 1 + sovProtorev(uint64(l)) + l
 	}
+	if m.LastExecutionHeight != 0 {
+		n += 1 + sovProtorev(uint64(m.LastExecutionHeight))
+	}
+	l = m.NumberOfAttempts.Size()
+	n += 1 + l + sovProtorev(uint64(l))
+	l = m.SuccessRate.Size()
+	n += 1 + l + sovProtorev(uint64(l))
+	return n
+}
+
+func (m *ArbitrageOpportunity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Route) > 0 {
+		l = 0
+		for _, e := range m.Route {
+			l += sovProtorev(uint64(e))
+		}
+		n += 1 + sovProtorev(uint64(l)) + l
+	}
+	l = m.Input.Size()
+	n += 1 + l + sovProtorev(uint64(l))
+	l = m.Profit.Size()
+	n += 1 + l + sovProtorev(uint64(l))
+	return n
+}
+
+func (m *ProfitSearchStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Input.Size()
+	n += 1 + l + sovProtorev(uint64(l))
+	l = m.Profit.Size()
+	n += 1 + l + sovProtorev(uint64(l))
+	return n
+}
+
+func (m *ProfitValuation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Profit.Size()
+	n += 1 + l + sovProtorev(uint64(l))
+	l = m.Value.Size()
+	n += 1 + l + sovProtorev(uint64(l))
 	return n
 }
 
@@ -946,6 +1481,9 @@ func (m *BaseDenom) Size() (n int) {
 	}
 	l = m.StepSize.Size()
 	n += 1 + l + sovProtorev(uint64(l))
+	if m.IntermediateOnly {
+		n += 2
+	}
 	return n
 }
 
@@ -1200,6 +1738,97 @@ func (m *Route) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxInputAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MaxInputAmount = &v
+			if err := m.MaxInputAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinProfitThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.MinProfitThreshold = &v
+			if err := m.MinProfitThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProtorev(dAtA[iNdEx:])
@@ -1527,12 +2156,524 @@ func (m *RouteStatistics) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipProtorev(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastExecutionHeight", wireType)
+			}
+			m.LastExecutionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastExecutionHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfAttempts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NumberOfAttempts.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuccessRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SuccessRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArbitrageOpportunity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArbitrageOpportunity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArbitrageOpportunity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProtorev
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Route = append(m.Route, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowProtorev
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthProtorev
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthProtorev
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Route) == 0 {
+					m.Route = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowProtorev
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Route = append(m.Route, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Profit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProfitSearchStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProfitSearchStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProfitSearchStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Profit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProfitValuation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProfitValuation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProfitValuation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Profit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
 			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthProtorev
 			}
@@ -1750,6 +2891,26 @@ func (m *BaseDenom) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntermediateOnly", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IntermediateOnly = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipProtorev(dAtA[iNdEx:])
@@ -376,6 +376,117 @@ func (m *QueryGetProtoRevAllProfitsResponse) GetProfits() []types.Coin {
 	return nil
 }
 
+// QueryGetProtoRevTotalProfitInDenomRequest is request type for the
+// Query/GetProtoRevTotalProfitInDenom RPC method.
+type QueryGetProtoRevTotalProfitInDenomRequest struct {
+	// target_denom is the denom that all of the profits are valued in
+	TargetDenom string `protobuf:"bytes,1,opt,name=target_denom,json=targetDenom,proto3" json:"target_denom,omitempty" yaml:"target_denom"`
+}
+
+func (m *QueryGetProtoRevTotalProfitInDenomRequest) Reset() {
+	*m = QueryGetProtoRevTotalProfitInDenomRequest{}
+}
+func (m *QueryGetProtoRevTotalProfitInDenomRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevTotalProfitInDenomRequest) ProtoMessage() {}
+func (*QueryGetProtoRevTotalProfitInDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{8}
+}
+func (m *QueryGetProtoRevTotalProfitInDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevTotalProfitInDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevTotalProfitInDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevTotalProfitInDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevTotalProfitInDenomRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevTotalProfitInDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevTotalProfitInDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevTotalProfitInDenomRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevTotalProfitInDenomRequest proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevTotalProfitInDenomRequest) GetTargetDenom() string {
+	if m != nil {
+		return m.TargetDenom
+	}
+	return ""
+}
+
+// QueryGetProtoRevTotalProfitInDenomResponse is response type for the
+// Query/GetProtoRevTotalProfitInDenom RPC method.
+type QueryGetProtoRevTotalProfitInDenomResponse struct {
+	// total is the sum of the values of all of the profits in the target denom
+	Total types.Coin `protobuf:"bytes,1,opt,name=total,proto3" json:"total" yaml:"total"`
+	// valuations is the per denom breakdown of the total
+	Valuations []ProfitValuation `protobuf:"bytes,2,rep,name=valuations,proto3" json:"valuations" yaml:"valuations"`
+}
+
+func (m *QueryGetProtoRevTotalProfitInDenomResponse) Reset() {
+	*m = QueryGetProtoRevTotalProfitInDenomResponse{}
+}
+func (m *QueryGetProtoRevTotalProfitInDenomResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevTotalProfitInDenomResponse) ProtoMessage() {}
+func (*QueryGetProtoRevTotalProfitInDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{9}
+}
+func (m *QueryGetProtoRevTotalProfitInDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevTotalProfitInDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevTotalProfitInDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevTotalProfitInDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevTotalProfitInDenomResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevTotalProfitInDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevTotalProfitInDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevTotalProfitInDenomResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetProtoRevTotalProfitInDenomResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevTotalProfitInDenomResponse) GetTotal() types.Coin {
+	if m != nil {
+		return m.Total
+	}
+	return types.Coin{}
+}
+
+func (m *QueryGetProtoRevTotalProfitInDenomResponse) GetValuations() []ProfitValuation {
+	if m != nil {
+		return m.Valuations
+	}
+	return nil
+}
+
 // QueryGetProtoRevStatisticsByPoolRequest is request type for the
 // Query/GetProtoRevStatisticsByRoute RPC method.
 type QueryGetProtoRevStatisticsByRouteRequest struct {
@@ -389,7 +500,7 @@ func (m *QueryGetProtoRevStatisticsByRouteRequest) Reset() {
 func (m *QueryGetProtoRevStatisticsByRouteRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProtoRevStatisticsByRouteRequest) ProtoMessage()    {}
 func (*QueryGetProtoRevStatisticsByRouteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{8}
+	return fileDescriptor_f5e7ac9973cce389, []int{10}
 }
 func (m *QueryGetProtoRevStatisticsByRouteRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -441,7 +552,7 @@ func (m *QueryGetProtoRevStatisticsByRouteResponse) String() string {
 }
 func (*QueryGetProtoRevStatisticsByRouteResponse) ProtoMessage() {}
 func (*QueryGetProtoRevStatisticsByRouteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{9}
+	return fileDescriptor_f5e7ac9973cce389, []int{11}
 }
 func (m *QueryGetProtoRevStatisticsByRouteResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -490,7 +601,7 @@ func (m *QueryGetProtoRevAllRouteStatisticsRequest) String() string {
 }
 func (*QueryGetProtoRevAllRouteStatisticsRequest) ProtoMessage() {}
 func (*QueryGetProtoRevAllRouteStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{10}
+	return fileDescriptor_f5e7ac9973cce389, []int{12}
 }
 func (m *QueryGetProtoRevAllRouteStatisticsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -535,7 +646,7 @@ func (m *QueryGetProtoRevAllRouteStatisticsResponse) String() string {
 }
 func (*QueryGetProtoRevAllRouteStatisticsResponse) ProtoMessage() {}
 func (*QueryGetProtoRevAllRouteStatisticsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{11}
+	return fileDescriptor_f5e7ac9973cce389, []int{13}
 }
 func (m *QueryGetProtoRevAllRouteStatisticsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -584,7 +695,7 @@ func (m *QueryGetProtoRevTokenPairArbRoutesRequest) String() string {
 }
 func (*QueryGetProtoRevTokenPairArbRoutesRequest) ProtoMessage() {}
 func (*QueryGetProtoRevTokenPairArbRoutesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{12}
+	return fileDescriptor_f5e7ac9973cce389, []int{14}
 }
 func (m *QueryGetProtoRevTokenPairArbRoutesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -629,7 +740,7 @@ func (m *QueryGetProtoRevTokenPairArbRoutesResponse) String() string {
 }
 func (*QueryGetProtoRevTokenPairArbRoutesResponse) ProtoMessage() {}
 func (*QueryGetProtoRevTokenPairArbRoutesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{13}
+	return fileDescriptor_f5e7ac9973cce389, []int{15}
 }
 func (m *QueryGetProtoRevTokenPairArbRoutesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -674,7 +785,7 @@ func (m *QueryGetProtoRevAdminAccountRequest) Reset()         { *m = QueryGetPro
 func (m *QueryGetProtoRevAdminAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProtoRevAdminAccountRequest) ProtoMessage()    {}
 func (*QueryGetProtoRevAdminAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{14}
+	return fileDescriptor_f5e7ac9973cce389, []int{16}
 }
 func (m *QueryGetProtoRevAdminAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -714,7 +825,7 @@ func (m *QueryGetProtoRevAdminAccountResponse) Reset()         { *m = QueryGetPr
 func (m *QueryGetProtoRevAdminAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProtoRevAdminAccountResponse) ProtoMessage()    {}
 func (*QueryGetProtoRevAdminAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{15}
+	return fileDescriptor_f5e7ac9973cce389, []int{17}
 }
 func (m *QueryGetProtoRevAdminAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -761,7 +872,7 @@ func (m *QueryGetProtoRevDeveloperAccountRequest) Reset() {
 func (m *QueryGetProtoRevDeveloperAccountRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProtoRevDeveloperAccountRequest) ProtoMessage()    {}
 func (*QueryGetProtoRevDeveloperAccountRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{16}
+	return fileDescriptor_f5e7ac9973cce389, []int{18}
 }
 func (m *QueryGetProtoRevDeveloperAccountRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -803,7 +914,7 @@ func (m *QueryGetProtoRevDeveloperAccountResponse) Reset() {
 func (m *QueryGetProtoRevDeveloperAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProtoRevDeveloperAccountResponse) ProtoMessage()    {}
 func (*QueryGetProtoRevDeveloperAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{17}
+	return fileDescriptor_f5e7ac9973cce389, []int{19}
 }
 func (m *QueryGetProtoRevDeveloperAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -848,7 +959,7 @@ func (m *QueryGetProtoRevPoolWeightsRequest) Reset()         { *m = QueryGetProt
 func (m *QueryGetProtoRevPoolWeightsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProtoRevPoolWeightsRequest) ProtoMessage()    {}
 func (*QueryGetProtoRevPoolWeightsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{18}
+	return fileDescriptor_f5e7ac9973cce389, []int{20}
 }
 func (m *QueryGetProtoRevPoolWeightsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -888,7 +999,7 @@ func (m *QueryGetProtoRevPoolWeightsResponse) Reset()         { *m = QueryGetPro
 func (m *QueryGetProtoRevPoolWeightsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProtoRevPoolWeightsResponse) ProtoMessage()    {}
 func (*QueryGetProtoRevPoolWeightsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{19}
+	return fileDescriptor_f5e7ac9973cce389, []int{21}
 }
 func (m *QueryGetProtoRevPoolWeightsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -937,7 +1048,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) String() string {
 }
 func (*QueryGetProtoRevMaxPoolPointsPerBlockRequest) ProtoMessage() {}
 func (*QueryGetProtoRevMaxPoolPointsPerBlockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{20}
+	return fileDescriptor_f5e7ac9973cce389, []int{22}
 }
 func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -982,7 +1093,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) String() string {
 }
 func (*QueryGetProtoRevMaxPoolPointsPerBlockResponse) ProtoMessage() {}
 func (*QueryGetProtoRevMaxPoolPointsPerBlockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{21}
+	return fileDescriptor_f5e7ac9973cce389, []int{23}
 }
 func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1031,7 +1142,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) String() string {
 }
 func (*QueryGetProtoRevMaxPoolPointsPerTxRequest) ProtoMessage() {}
 func (*QueryGetProtoRevMaxPoolPointsPerTxRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{22}
+	return fileDescriptor_f5e7ac9973cce389, []int{24}
 }
 func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1076,7 +1187,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) String() string {
 }
 func (*QueryGetProtoRevMaxPoolPointsPerTxResponse) ProtoMessage() {}
 func (*QueryGetProtoRevMaxPoolPointsPerTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{23}
+	return fileDescriptor_f5e7ac9973cce389, []int{25}
 }
 func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1121,7 +1232,7 @@ func (m *QueryGetProtoRevBaseDenomsRequest) Reset()         { *m = QueryGetProto
 func (m *QueryGetProtoRevBaseDenomsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProtoRevBaseDenomsRequest) ProtoMessage()    {}
 func (*QueryGetProtoRevBaseDenomsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{24}
+	return fileDescriptor_f5e7ac9973cce389, []int{26}
 }
 func (m *QueryGetProtoRevBaseDenomsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1161,7 +1272,7 @@ func (m *QueryGetProtoRevBaseDenomsResponse) Reset()         { *m = QueryGetProt
 func (m *QueryGetProtoRevBaseDenomsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProtoRevBaseDenomsResponse) ProtoMessage()    {}
 func (*QueryGetProtoRevBaseDenomsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{25}
+	return fileDescriptor_f5e7ac9973cce389, []int{27}
 }
 func (m *QueryGetProtoRevBaseDenomsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1206,7 +1317,7 @@ func (m *QueryGetProtoRevEnabledRequest) Reset()         { *m = QueryGetProtoRev
 func (m *QueryGetProtoRevEnabledRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProtoRevEnabledRequest) ProtoMessage()    {}
 func (*QueryGetProtoRevEnabledRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{26}
+	return fileDescriptor_f5e7ac9973cce389, []int{28}
 }
 func (m *QueryGetProtoRevEnabledRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1246,7 +1357,7 @@ func (m *QueryGetProtoRevEnabledResponse) Reset()         { *m = QueryGetProtoRe
 func (m *QueryGetProtoRevEnabledResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetProtoRevEnabledResponse) ProtoMessage()    {}
 func (*QueryGetProtoRevEnabledResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e7ac9973cce389, []int{27}
+	return fileDescriptor_f5e7ac9973cce389, []int{29}
 }
 func (m *QueryGetProtoRevEnabledResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1282,1874 +1393,6689 @@ func (m *QueryGetProtoRevEnabledResponse) GetEnabled() bool {
 	return false
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.protorev.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.protorev.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryGetProtoRevNumberOfTradesRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevNumberOfTradesRequest")
-	proto.RegisterType((*QueryGetProtoRevNumberOfTradesResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevNumberOfTradesResponse")
-	proto.RegisterType((*QueryGetProtoRevProfitsByDenomRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProfitsByDenomRequest")
-	proto.RegisterType((*QueryGetProtoRevProfitsByDenomResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProfitsByDenomResponse")
-	proto.RegisterType((*QueryGetProtoRevAllProfitsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllProfitsRequest")
-	proto.RegisterType((*QueryGetProtoRevAllProfitsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllProfitsResponse")
-	proto.RegisterType((*QueryGetProtoRevStatisticsByRouteRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevStatisticsByRouteRequest")
-	proto.RegisterType((*QueryGetProtoRevStatisticsByRouteResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevStatisticsByRouteResponse")
-	proto.RegisterType((*QueryGetProtoRevAllRouteStatisticsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllRouteStatisticsRequest")
-	proto.RegisterType((*QueryGetProtoRevAllRouteStatisticsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllRouteStatisticsResponse")
-	proto.RegisterType((*QueryGetProtoRevTokenPairArbRoutesRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevTokenPairArbRoutesRequest")
-	proto.RegisterType((*QueryGetProtoRevTokenPairArbRoutesResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevTokenPairArbRoutesResponse")
-	proto.RegisterType((*QueryGetProtoRevAdminAccountRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAdminAccountRequest")
-	proto.RegisterType((*QueryGetProtoRevAdminAccountResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAdminAccountResponse")
-	proto.RegisterType((*QueryGetProtoRevDeveloperAccountRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevDeveloperAccountRequest")
-	proto.RegisterType((*QueryGetProtoRevDeveloperAccountResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevDeveloperAccountResponse")
-	proto.RegisterType((*QueryGetProtoRevPoolWeightsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolWeightsRequest")
-	proto.RegisterType((*QueryGetProtoRevPoolWeightsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolWeightsResponse")
-	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerBlockRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerBlockRequest")
-	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerBlockResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerBlockResponse")
-	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerTxRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerTxRequest")
-	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerTxResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerTxResponse")
-	proto.RegisterType((*QueryGetProtoRevBaseDenomsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevBaseDenomsRequest")
-	proto.RegisterType((*QueryGetProtoRevBaseDenomsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevBaseDenomsResponse")
-	proto.RegisterType((*QueryGetProtoRevEnabledRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevEnabledRequest")
-	proto.RegisterType((*QueryGetProtoRevEnabledResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevEnabledResponse")
+// QueryGetProtoRevMaxTradesPerBlockRequest is request type for the
+// Query/GetProtoRevMaxTradesPerBlock RPC method.
+type QueryGetProtoRevMaxTradesPerBlockRequest struct {
 }
 
-func init() {
-	proto.RegisterFile("osmosis/protorev/v1beta1/query.proto", fileDescriptor_f5e7ac9973cce389)
+func (m *QueryGetProtoRevMaxTradesPerBlockRequest) Reset() {
+	*m = QueryGetProtoRevMaxTradesPerBlockRequest{}
 }
-
-var fileDescriptor_f5e7ac9973cce389 = []byte{
-	// 1427 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x5b, 0x6f, 0xdc, 0x44,
-	0x18, 0x8d, 0x69, 0x9b, 0xc2, 0xf4, 0xa2, 0x76, 0xda, 0xa6, 0x89, 0x9b, 0xee, 0xa6, 0x93, 0xdd,
-	0xa4, 0x9b, 0x8b, 0xad, 0xb4, 0x45, 0x5c, 0x0b, 0xc4, 0x0d, 0x54, 0x15, 0xa2, 0x59, 0x4c, 0x11,
-	0x12, 0x48, 0x18, 0x7b, 0x77, 0xb2, 0xb5, 0xe2, 0xf5, 0x38, 0xb6, 0x37, 0xec, 0xbe, 0x82, 0x84,
-	0x84, 0x40, 0xe2, 0xf6, 0xcc, 0x7f, 0xe0, 0x0f, 0x20, 0xc1, 0x03, 0x52, 0x9f, 0x50, 0x25, 0x84,
-	0x84, 0xfa, 0xb0, 0x54, 0x09, 0x8f, 0x3c, 0xed, 0x2f, 0x40, 0x9e, 0x19, 0xef, 0x7a, 0x7d, 0xd9,
-	0x5b, 0x24, 0x9e, 0xb2, 0x9e, 0x39, 0x73, 0xbe, 0x73, 0xe6, 0x7a, 0x14, 0x50, 0x20, 0x5e, 0x9d,
-	0x78, 0xa6, 0x27, 0x3b, 0x2e, 0xf1, 0x89, 0x8b, 0xf7, 0xe5, 0xfd, 0x0d, 0x03, 0xfb, 0xfa, 0x86,
-	0xbc, 0xd7, 0xc0, 0x6e, 0x4b, 0xa2, 0xcd, 0x70, 0x96, 0xa3, 0xa4, 0x10, 0x25, 0x71, 0x94, 0x78,
-	0xb1, 0x46, 0x6a, 0x84, 0xb6, 0xca, 0xc1, 0x2f, 0x06, 0x10, 0xe7, 0x6b, 0x84, 0xd4, 0x2c, 0x2c,
-	0xeb, 0x8e, 0x29, 0xeb, 0xb6, 0x4d, 0x7c, 0xdd, 0x37, 0x89, 0xcd, 0x87, 0x8b, 0x2b, 0x15, 0x4a,
-	0x27, 0x1b, 0xba, 0x87, 0x59, 0x99, 0x6e, 0x51, 0x47, 0xaf, 0x99, 0x36, 0x05, 0x73, 0x6c, 0x31,
-	0x53, 0x9f, 0xa3, 0xbb, 0x7a, 0x3d, 0xa4, 0x5c, 0xce, 0x86, 0x85, 0x8a, 0x19, 0x30, 0x17, 0xad,
-	0x1d, 0x62, 0x2a, 0xc4, 0xe4, 0xf5, 0xd0, 0x45, 0x00, 0xdf, 0x0d, 0x14, 0x95, 0x29, 0xbb, 0x8a,
-	0xf7, 0x1a, 0xd8, 0xf3, 0xd1, 0x0e, 0xb8, 0xd0, 0xd7, 0xea, 0x39, 0xc4, 0xf6, 0x30, 0xdc, 0x06,
-	0xd3, 0x4c, 0xc5, 0xac, 0xb0, 0x20, 0x5c, 0x3f, 0x75, 0x63, 0x41, 0xca, 0x9a, 0x27, 0x89, 0x8d,
-	0x54, 0x2e, 0x3d, 0x6a, 0xe7, 0xa7, 0x3a, 0xed, 0xfc, 0x99, 0x96, 0x5e, 0xb7, 0x5e, 0x46, 0x6c,
-	0x34, 0x52, 0x39, 0x0d, 0x5a, 0x06, 0x45, 0x5a, 0xe7, 0x2e, 0xf6, 0xcb, 0x01, 0x83, 0x8a, 0xf7,
-	0xef, 0x37, 0xea, 0x06, 0x76, 0xb7, 0x77, 0x1e, 0xb8, 0x7a, 0x15, 0x77, 0x05, 0xfd, 0x28, 0x80,
-	0xa5, 0x61, 0x48, 0x2e, 0xd2, 0x03, 0xe7, 0x6c, 0xda, 0xa3, 0x91, 0x1d, 0xcd, 0xa7, 0x7d, 0x54,
-	0xee, 0x73, 0xca, 0xbd, 0x40, 0xcc, 0x93, 0x76, 0x7e, 0xa9, 0x66, 0xfa, 0x0f, 0x1b, 0x86, 0x54,
-	0x21, 0x75, 0x99, 0x4f, 0x0f, 0xfb, 0xb3, 0xee, 0x55, 0x77, 0x65, 0xbf, 0xe5, 0x60, 0x4f, 0xba,
-	0x67, 0xfb, 0x9d, 0x76, 0xfe, 0x32, 0x93, 0x1d, 0xe7, 0x43, 0xea, 0x59, 0xbb, 0xaf, 0x38, 0xda,
-	0x4e, 0x1a, 0x29, 0xbb, 0x64, 0xc7, 0xf4, 0x3d, 0xa5, 0xb5, 0x85, 0x6d, 0x52, 0xe7, 0x46, 0xe0,
-	0x12, 0x38, 0x51, 0x0d, 0xbe, 0xb9, 0xa4, 0x73, 0x9d, 0x76, 0xfe, 0x34, 0x2b, 0x42, 0x9b, 0x91,
-	0xca, 0xba, 0x91, 0x9d, 0xf4, 0x1b, 0x27, 0xe4, 0x7e, 0xb7, 0xc0, 0xb4, 0x43, 0x7b, 0xf8, 0xa2,
-	0xcc, 0x49, 0xcc, 0x8c, 0x14, 0x2c, 0x79, 0x77, 0x3d, 0xee, 0x10, 0xd3, 0x56, 0xce, 0x47, 0x56,
-	0x82, 0x0e, 0x09, 0x56, 0x82, 0xfd, 0x58, 0x04, 0xd7, 0xe2, 0xf5, 0x36, 0x2d, 0x8b, 0x97, 0x0c,
-	0x57, 0x61, 0x0f, 0xa0, 0x41, 0x20, 0x2e, 0xe8, 0x6d, 0x70, 0x92, 0x91, 0x06, 0xf3, 0x7e, 0x6c,
-	0xb0, 0xa2, 0x19, 0xbe, 0x3f, 0xce, 0x46, 0x55, 0x79, 0x48, 0x3d, 0xd9, 0xfd, 0x05, 0xae, 0xc7,
-	0x4b, 0xbe, 0x17, 0x9c, 0x2e, 0xcf, 0x37, 0x2b, 0x9e, 0xd2, 0x52, 0x49, 0xc3, 0xc7, 0x91, 0xb9,
-	0x75, 0x83, 0x6f, 0x5a, 0xf6, 0x78, 0x74, 0x6e, 0x69, 0x33, 0x52, 0x59, 0x37, 0xfa, 0x4e, 0x00,
-	0xa5, 0x11, 0x48, 0xb9, 0x9d, 0x2a, 0x00, 0x5e, 0xb7, 0x93, 0xcf, 0x71, 0x29, 0x7b, 0xe3, 0xd3,
-	0xc1, 0x11, 0xb6, 0x39, 0xee, 0xf0, 0x3c, 0x53, 0xd2, 0xa3, 0x42, 0x6a, 0x84, 0x17, 0xad, 0x26,
-	0x25, 0x6d, 0x5a, 0x56, 0x8c, 0x2c, 0x5c, 0x87, 0xef, 0x05, 0xb0, 0x32, 0x0a, 0x3a, 0xc3, 0xc1,
-	0xb1, 0xff, 0xcb, 0xc1, 0x03, 0xb2, 0x8b, 0xed, 0xb2, 0x6e, 0xba, 0x9b, 0xae, 0x41, 0x59, 0xbb,
-	0x0e, 0xbe, 0x4c, 0x71, 0x90, 0x86, 0xe6, 0x0e, 0x3e, 0x02, 0xd3, 0x74, 0xe9, 0x42, 0xf5, 0x6b,
-	0xd9, 0xea, 0x93, 0x2c, 0xf1, 0x4b, 0x88, 0x31, 0x21, 0x95, 0x53, 0xa2, 0x22, 0x58, 0x4c, 0x4c,
-	0x66, 0xb5, 0x6e, 0xda, 0x9b, 0x95, 0x0a, 0x69, 0xd8, 0x7e, 0x28, 0x19, 0x83, 0xc2, 0x60, 0x18,
-	0xd7, 0x7a, 0x1b, 0x9c, 0xd1, 0x83, 0x76, 0x4d, 0x67, 0x1d, 0xfc, 0xa4, 0xcf, 0x76, 0xda, 0xf9,
-	0x8b, 0x4c, 0x40, 0x5f, 0x37, 0x52, 0x4f, 0xeb, 0x11, 0x1a, 0x54, 0x02, 0xcb, 0xf1, 0x32, 0x5b,
-	0x78, 0x1f, 0x5b, 0xc4, 0xc1, 0x6e, 0x4c, 0x51, 0x23, 0x79, 0x36, 0x92, 0x50, 0xae, 0xea, 0x1e,
-	0x38, 0x5f, 0x0d, 0xfb, 0x62, 0xca, 0xe6, 0x3b, 0xed, 0xfc, 0x6c, 0x78, 0x07, 0xc5, 0x20, 0x48,
-	0x3d, 0x57, 0x8d, 0x51, 0xa2, 0x42, 0xf2, 0x16, 0x28, 0x13, 0x62, 0x7d, 0x80, 0xcd, 0xda, 0xc3,
-	0xde, 0x5d, 0xf1, 0xb5, 0x90, 0x9c, 0xd6, 0x3e, 0x18, 0x17, 0x86, 0xc1, 0x69, 0x87, 0x10, 0x4b,
-	0xfb, 0x94, 0xb5, 0xf3, 0x03, 0x56, 0x1c, 0xf0, 0xb2, 0xf4, 0x48, 0x94, 0x2b, 0x7c, 0x65, 0x2f,
-	0xf0, 0xeb, 0x23, 0x42, 0x84, 0xd4, 0x53, 0x4e, 0x0f, 0x89, 0x24, 0xb0, 0x16, 0x57, 0xf3, 0x8e,
-	0xde, 0x0c, 0xb8, 0xca, 0xc4, 0xb4, 0x7d, 0xaf, 0x8c, 0x5d, 0xc5, 0x22, 0x95, 0xdd, 0x50, 0xfe,
-	0x37, 0x02, 0x58, 0x1f, 0x71, 0x00, 0x37, 0xf2, 0x31, 0x98, 0xab, 0xeb, 0x4d, 0x8d, 0x6a, 0x70,
-	0x28, 0x44, 0x0b, 0x26, 0xd2, 0x08, 0x40, 0xd4, 0xd5, 0x71, 0xa5, 0xd0, 0x69, 0xe7, 0x17, 0x98,
-	0xd4, 0x4c, 0x28, 0x52, 0x2f, 0xd5, 0xd3, 0xea, 0xa4, 0x9d, 0xaf, 0xb8, 0xa0, 0x07, 0xcd, 0x50,
-	0xfe, 0xe7, 0x29, 0xe7, 0x2b, 0x0d, 0xcd, 0xb5, 0xbf, 0x0f, 0x66, 0xd2, 0x04, 0xf9, 0x4d, 0x2e,
-	0xfc, 0x5a, 0xa7, 0x9d, 0xbf, 0x9a, 0x2d, 0xdc, 0x6f, 0x22, 0x15, 0xd6, 0x13, 0xf4, 0x69, 0x8f,
-	0x8a, 0xa2, 0x7b, 0x98, 0xbe, 0x5f, 0xdd, 0x8d, 0xf2, 0x85, 0x90, 0xdc, 0x4f, 0x51, 0x14, 0x97,
-	0xf8, 0x09, 0x38, 0x15, 0x3c, 0x1f, 0x1a, 0x7d, 0x1e, 0xc3, 0x7b, 0x60, 0x31, 0x7b, 0x9b, 0x74,
-	0x29, 0x14, 0x91, 0x6f, 0x12, 0xc8, 0x0c, 0x44, 0x58, 0x90, 0x0a, 0x8c, 0x6e, 0x25, 0xb4, 0x00,
-	0x72, 0x71, 0x1d, 0x6f, 0xda, 0xba, 0x61, 0xe1, 0x6a, 0x28, 0x75, 0x1b, 0xe4, 0x33, 0x11, 0x5c,
-	0xe6, 0x1a, 0x38, 0x89, 0x59, 0x13, 0x9d, 0xba, 0x67, 0x15, 0xd8, 0x7b, 0xdd, 0x78, 0x07, 0x52,
-	0x43, 0xc8, 0x8d, 0x5f, 0x66, 0xc0, 0x09, 0xca, 0x08, 0xbf, 0x12, 0xc0, 0x34, 0xcb, 0x4c, 0x70,
-	0xc0, 0xe5, 0x96, 0x8c, 0x6a, 0xe2, 0xfa, 0x88, 0x68, 0xa6, 0x0f, 0x15, 0x3e, 0xfb, 0xe3, 0x9f,
-	0x1f, 0x9e, 0xc9, 0xc1, 0x79, 0x39, 0x4c, 0x90, 0xfb, 0x1b, 0xb7, 0x7a, 0x29, 0x92, 0xe5, 0x32,
-	0xf8, 0xbb, 0x00, 0xe6, 0x32, 0x93, 0x16, 0x7c, 0x7d, 0x48, 0xc9, 0x61, 0x69, 0x4e, 0x7c, 0x63,
-	0x72, 0x02, 0x6e, 0x43, 0xa2, 0x36, 0xae, 0xc3, 0xa5, 0x74, 0x1b, 0xf1, 0xc0, 0x16, 0x37, 0xd4,
-	0x1f, 0xa5, 0xc6, 0x31, 0x94, 0x9a, 0xea, 0xc6, 0x31, 0x94, 0x9e, 0xe2, 0x86, 0x19, 0xe2, 0x71,
-	0x48, 0x33, 0x5a, 0x6c, 0xeb, 0xc2, 0x9f, 0x05, 0x70, 0x29, 0x35, 0x86, 0xc1, 0x57, 0x46, 0xd7,
-	0x92, 0x48, 0x78, 0xe2, 0xab, 0x93, 0x0d, 0xe6, 0x26, 0x4a, 0xd4, 0xc4, 0x22, 0xbc, 0x96, 0x6e,
-	0x42, 0xb7, 0x2c, 0x8d, 0x1b, 0x81, 0x4f, 0x04, 0x30, 0x3f, 0x28, 0x7e, 0x41, 0x65, 0x74, 0x25,
-	0x59, 0x81, 0x50, 0xbc, 0x73, 0x24, 0x0e, 0x6e, 0x6a, 0x83, 0x9a, 0x5a, 0x85, 0xa5, 0x74, 0x53,
-	0xbd, 0x04, 0x14, 0x2c, 0x0e, 0x8d, 0x14, 0xb0, 0x2d, 0x80, 0xab, 0x03, 0xa3, 0x19, 0xbc, 0x33,
-	0xd6, 0x3c, 0xa7, 0xc7, 0x40, 0x71, 0xeb, 0x68, 0x24, 0xdc, 0xdf, 0x0d, 0xea, 0x6f, 0x0d, 0xae,
-	0x64, 0x2f, 0x1a, 0x75, 0xa5, 0xf5, 0x9c, 0xc2, 0xbf, 0xfb, 0x0d, 0x26, 0x33, 0xd7, 0x38, 0x06,
-	0x33, 0x53, 0xe2, 0x38, 0x06, 0xb3, 0xc3, 0x23, 0xba, 0x49, 0x0d, 0xae, 0xc3, 0xd5, 0x74, 0x83,
-	0x7e, 0x30, 0x52, 0x73, 0x74, 0xd3, 0xd5, 0x74, 0xd7, 0x60, 0x5e, 0x3d, 0xf8, 0x9b, 0x00, 0x2e,
-	0x67, 0x24, 0x3d, 0x78, 0x7b, 0x8c, 0x79, 0x4f, 0x06, 0x49, 0xf1, 0xb5, 0x49, 0x87, 0x73, 0x3f,
-	0xab, 0xd4, 0x4f, 0x11, 0x2e, 0x66, 0x2c, 0x58, 0x34, 0x5d, 0xc2, 0x3f, 0x05, 0x70, 0x65, 0x40,
-	0x3e, 0x84, 0x9b, 0xa3, 0x8b, 0xc9, 0x88, 0xa1, 0xa2, 0x72, 0x14, 0x0a, 0xee, 0x49, 0xa6, 0x9e,
-	0x4a, 0x70, 0x39, 0xdd, 0x53, 0x22, 0x97, 0xc2, 0x5f, 0x05, 0x30, 0x93, 0x9e, 0x2c, 0xe1, 0x18,
-	0x77, 0x58, 0x32, 0xb7, 0x8a, 0xb7, 0x27, 0x1c, 0xcd, 0x8d, 0xac, 0x50, 0x23, 0x05, 0x88, 0x32,
-	0xee, 0xf1, 0x48, 0x42, 0x85, 0x4f, 0xfb, 0x4f, 0x51, 0x32, 0x9f, 0x8d, 0x73, 0x8a, 0x32, 0xb3,
-	0xe0, 0x38, 0xa7, 0x28, 0x3b, 0x22, 0xa2, 0x5b, 0xd4, 0x98, 0x04, 0xd7, 0xd2, 0x8d, 0xa5, 0xc7,
-	0x42, 0xf8, 0xaf, 0x00, 0x16, 0x86, 0x25, 0x68, 0xf8, 0xd6, 0xe4, 0x02, 0xa3, 0x99, 0x5d, 0xbc,
-	0x7b, 0x64, 0x1e, 0xee, 0xf5, 0x05, 0xea, 0x75, 0x03, 0xca, 0xa3, 0x7b, 0xa5, 0xd9, 0x3d, 0xfe,
-	0x2a, 0xf7, 0x62, 0xec, 0x38, 0xaf, 0x72, 0x22, 0x22, 0x8f, 0xf3, 0x2a, 0x27, 0x93, 0xf3, 0xb0,
-	0x57, 0x39, 0x92, 0x87, 0xe1, 0x4f, 0x02, 0x80, 0xc9, 0x70, 0x0b, 0x5f, 0x1c, 0xbd, 0x7e, 0x7f,
-	0x62, 0x16, 0x5f, 0x9a, 0x60, 0x24, 0x97, 0x5d, 0xa4, 0xb2, 0xf3, 0xf0, 0x6a, 0xba, 0x6c, 0x1e,
-	0xa1, 0x95, 0xfb, 0x8f, 0x0e, 0x72, 0xc2, 0xe3, 0x83, 0x9c, 0xf0, 0xf4, 0x20, 0x27, 0x7c, 0x7b,
-	0x98, 0x9b, 0x7a, 0x7c, 0x98, 0x9b, 0xfa, 0xeb, 0x30, 0x37, 0xf5, 0xe1, 0xad, 0xc8, 0xbf, 0xf9,
-	0x38, 0xc5, 0xba, 0xa5, 0x1b, 0x5e, 0x84, 0xef, 0x79, 0xb9, 0x19, 0x79, 0x08, 0x5a, 0x0e, 0xf6,
-	0x8c, 0x69, 0xfa, 0x7d, 0xf3, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9c, 0x19, 0xc0, 0x09, 0x29,
-	0x16, 0x00, 0x00,
+func (m *QueryGetProtoRevMaxTradesPerBlockRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevMaxTradesPerBlockRequest) ProtoMessage()    {}
+func (*QueryGetProtoRevMaxTradesPerBlockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{30}
+}
+func (m *QueryGetProtoRevMaxTradesPerBlockRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevMaxTradesPerBlockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevMaxTradesPerBlockRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetProtoRevMaxTradesPerBlockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevMaxTradesPerBlockRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevMaxTradesPerBlockRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevMaxTradesPerBlockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevMaxTradesPerBlockRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGetProtoRevMaxTradesPerBlockRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// GetProtoRevNumberOfTrades queries the number of arbitrage trades the module
-	// has executed
-	GetProtoRevNumberOfTrades(ctx context.Context, in *QueryGetProtoRevNumberOfTradesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevNumberOfTradesResponse, error)
-	// GetProtoRevProfitsByDenom queries the profits of the module by denom
-	GetProtoRevProfitsByDenom(ctx context.Context, in *QueryGetProtoRevProfitsByDenomRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProfitsByDenomResponse, error)
-	// GetProtoRevAllProfits queries all of the profits from the module
-	GetProtoRevAllProfits(ctx context.Context, in *QueryGetProtoRevAllProfitsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllProfitsResponse, error)
-	// GetProtoRevStatisticsByRoute queries the number of arbitrages and profits
-	// that have been executed for a given route
-	GetProtoRevStatisticsByRoute(ctx context.Context, in *QueryGetProtoRevStatisticsByRouteRequest, opts ...grpc.CallOption) (*QueryGetProtoRevStatisticsByRouteResponse, error)
-	// GetProtoRevAllRouteStatistics queries all of routes that the module has
-	// arbitraged against and the number of trades and profits that have been
-	// accumulated for each route
-	GetProtoRevAllRouteStatistics(ctx context.Context, in *QueryGetProtoRevAllRouteStatisticsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllRouteStatisticsResponse, error)
-	// GetProtoRevTokenPairArbRoutes queries all of the hot routes that the module
-	// is currently arbitraging
-	GetProtoRevTokenPairArbRoutes(ctx context.Context, in *QueryGetProtoRevTokenPairArbRoutesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevTokenPairArbRoutesResponse, error)
-	// GetProtoRevAdminAccount queries the admin account of the module
-	GetProtoRevAdminAccount(ctx context.Context, in *QueryGetProtoRevAdminAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAdminAccountResponse, error)
-	// GetProtoRevDeveloperAccount queries the developer account of the module
-	GetProtoRevDeveloperAccount(ctx context.Context, in *QueryGetProtoRevDeveloperAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevDeveloperAccountResponse, error)
-	// GetProtoRevPoolWeights queries the weights of each pool type currently
-	// being used by the module
-	GetProtoRevPoolWeights(ctx context.Context, in *QueryGetProtoRevPoolWeightsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolWeightsResponse, error)
-	// GetProtoRevMaxPoolPointsPerTx queries the maximum number of pool points
-	// that can be consumed per transaction
-	GetProtoRevMaxPoolPointsPerTx(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerTxRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error)
-	// GetProtoRevMaxPoolPointsPerBlock queries the maximum number of pool points
-	// that can consumed per block
-	GetProtoRevMaxPoolPointsPerBlock(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerBlockRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error)
-	// GetProtoRevBaseDenoms queries the base denoms that the module is currently
-	// utilizing for arbitrage
-	GetProtoRevBaseDenoms(ctx context.Context, in *QueryGetProtoRevBaseDenomsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevBaseDenomsResponse, error)
-	// GetProtoRevEnabled queries whether the module is enabled or not
-	GetProtoRevEnabled(ctx context.Context, in *QueryGetProtoRevEnabledRequest, opts ...grpc.CallOption) (*QueryGetProtoRevEnabledResponse, error)
+// QueryGetProtoRevMaxTradesPerBlockResponse is response type for the
+// Query/GetProtoRevMaxTradesPerBlock RPC method.
+type QueryGetProtoRevMaxTradesPerBlockResponse struct {
+	// max_trades_per_block is the maximum number of trades that can be executed
+	// per block. A value of 0 means that the number of trades is not capped.
+	MaxTradesPerBlock uint64 `protobuf:"varint,1,opt,name=max_trades_per_block,json=maxTradesPerBlock,proto3" json:"max_trades_per_block,omitempty" yaml:"max_trades_per_block"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryGetProtoRevMaxTradesPerBlockResponse) Reset() {
+	*m = QueryGetProtoRevMaxTradesPerBlockResponse{}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetProtoRevMaxTradesPerBlockResponse) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (*QueryGetProtoRevMaxTradesPerBlockResponse) ProtoMessage() {}
+func (*QueryGetProtoRevMaxTradesPerBlockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{31}
 }
-
-func (c *queryClient) GetProtoRevNumberOfTrades(ctx context.Context, in *QueryGetProtoRevNumberOfTradesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevNumberOfTradesResponse, error) {
-	out := new(QueryGetProtoRevNumberOfTradesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevNumberOfTrades", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetProtoRevMaxTradesPerBlockResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevMaxTradesPerBlockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevMaxTradesPerBlockResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetProtoRevMaxTradesPerBlockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevMaxTradesPerBlockResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevMaxTradesPerBlockResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevMaxTradesPerBlockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevMaxTradesPerBlockResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetProtoRevProfitsByDenom(ctx context.Context, in *QueryGetProtoRevProfitsByDenomRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProfitsByDenomResponse, error) {
-	out := new(QueryGetProtoRevProfitsByDenomResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevProfitsByDenom", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetProtoRevMaxTradesPerBlockResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevMaxTradesPerBlockResponse) GetMaxTradesPerBlock() uint64 {
+	if m != nil {
+		return m.MaxTradesPerBlock
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetProtoRevAllProfits(ctx context.Context, in *QueryGetProtoRevAllProfitsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllProfitsResponse, error) {
-	out := new(QueryGetProtoRevAllProfitsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevAllProfits", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryGetProtoRevArbitrageStatusRequest is request type for the
+// Query/GetProtoRevArbitrageStatus RPC method.
+type QueryGetProtoRevArbitrageStatusRequest struct {
 }
 
-func (c *queryClient) GetProtoRevStatisticsByRoute(ctx context.Context, in *QueryGetProtoRevStatisticsByRouteRequest, opts ...grpc.CallOption) (*QueryGetProtoRevStatisticsByRouteResponse, error) {
-	out := new(QueryGetProtoRevStatisticsByRouteResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevStatisticsByRoute", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetProtoRevArbitrageStatusRequest) Reset() {
+	*m = QueryGetProtoRevArbitrageStatusRequest{}
 }
-
-func (c *queryClient) GetProtoRevAllRouteStatistics(ctx context.Context, in *QueryGetProtoRevAllRouteStatisticsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllRouteStatisticsResponse, error) {
-	out := new(QueryGetProtoRevAllRouteStatisticsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevAllRouteStatistics", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetProtoRevArbitrageStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevArbitrageStatusRequest) ProtoMessage()    {}
+func (*QueryGetProtoRevArbitrageStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{32}
+}
+func (m *QueryGetProtoRevArbitrageStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevArbitrageStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevArbitrageStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetProtoRevArbitrageStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevArbitrageStatusRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevArbitrageStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevArbitrageStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevArbitrageStatusRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetProtoRevTokenPairArbRoutes(ctx context.Context, in *QueryGetProtoRevTokenPairArbRoutesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevTokenPairArbRoutesResponse, error) {
-	out := new(QueryGetProtoRevTokenPairArbRoutesResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevTokenPairArbRoutes", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryGetProtoRevArbitrageStatusRequest proto.InternalMessageInfo
+
+// QueryGetProtoRevArbitrageStatusResponse is response type for the
+// Query/GetProtoRevArbitrageStatus RPC method.
+type QueryGetProtoRevArbitrageStatusResponse struct {
+	// disabled_until_height is the block height until which arbitrage is
+	// disabled. A value of 0 means that arbitrage is not disabled by height.
+	DisabledUntilHeight uint64 `protobuf:"varint,1,opt,name=disabled_until_height,json=disabledUntilHeight,proto3" json:"disabled_until_height,omitempty" yaml:"disabled_until_height"`
+	// active is whether arbitrage is currently executed, i.e. the module is
+	// enabled and the current block height is not below disabled_until_height.
+	Active bool `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty" yaml:"active"`
 }
 
-func (c *queryClient) GetProtoRevAdminAccount(ctx context.Context, in *QueryGetProtoRevAdminAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAdminAccountResponse, error) {
-	out := new(QueryGetProtoRevAdminAccountResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevAdminAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetProtoRevArbitrageStatusResponse) Reset() {
+	*m = QueryGetProtoRevArbitrageStatusResponse{}
+}
+func (m *QueryGetProtoRevArbitrageStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevArbitrageStatusResponse) ProtoMessage()    {}
+func (*QueryGetProtoRevArbitrageStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{33}
+}
+func (m *QueryGetProtoRevArbitrageStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevArbitrageStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevArbitrageStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetProtoRevArbitrageStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevArbitrageStatusResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevArbitrageStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevArbitrageStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevArbitrageStatusResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetProtoRevDeveloperAccount(ctx context.Context, in *QueryGetProtoRevDeveloperAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevDeveloperAccountResponse, error) {
-	out := new(QueryGetProtoRevDeveloperAccountResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevDeveloperAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetProtoRevArbitrageStatusResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevArbitrageStatusResponse) GetDisabledUntilHeight() uint64 {
+	if m != nil {
+		return m.DisabledUntilHeight
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) GetProtoRevPoolWeights(ctx context.Context, in *QueryGetProtoRevPoolWeightsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolWeightsResponse, error) {
-	out := new(QueryGetProtoRevPoolWeightsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevPoolWeights", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetProtoRevArbitrageStatusResponse) GetActive() bool {
+	if m != nil {
+		return m.Active
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) GetProtoRevMaxPoolPointsPerTx(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerTxRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error) {
-	out := new(QueryGetProtoRevMaxPoolPointsPerTxResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerTx", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryGetProtoRevMonitoredPoolsRequest is request type for the
+// Query/GetProtoRevMonitoredPools RPC method.
+type QueryGetProtoRevMonitoredPoolsRequest struct {
 }
 
-func (c *queryClient) GetProtoRevMaxPoolPointsPerBlock(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerBlockRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error) {
-	out := new(QueryGetProtoRevMaxPoolPointsPerBlockResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerBlock", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetProtoRevMonitoredPoolsRequest) Reset()         { *m = QueryGetProtoRevMonitoredPoolsRequest{} }
+func (m *QueryGetProtoRevMonitoredPoolsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevMonitoredPoolsRequest) ProtoMessage()    {}
+func (*QueryGetProtoRevMonitoredPoolsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{34}
+}
+func (m *QueryGetProtoRevMonitoredPoolsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevMonitoredPoolsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevMonitoredPoolsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetProtoRevMonitoredPoolsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevMonitoredPoolsRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevMonitoredPoolsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevMonitoredPoolsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevMonitoredPoolsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetProtoRevBaseDenoms(ctx context.Context, in *QueryGetProtoRevBaseDenomsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevBaseDenomsResponse, error) {
-	out := new(QueryGetProtoRevBaseDenomsResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevBaseDenoms", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryGetProtoRevMonitoredPoolsRequest proto.InternalMessageInfo
+
+// QueryGetProtoRevMonitoredPoolsResponse is response type for the
+// Query/GetProtoRevMonitoredPools RPC method.
+type QueryGetProtoRevMonitoredPoolsResponse struct {
+	// pool_ids is the sorted, deduplicated list of ids of all pools reachable
+	// through the configured base denoms and hot routes
+	PoolIds []uint64 `protobuf:"varint,1,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty" yaml:"pool_ids"`
 }
 
-func (c *queryClient) GetProtoRevEnabled(ctx context.Context, in *QueryGetProtoRevEnabledRequest, opts ...grpc.CallOption) (*QueryGetProtoRevEnabledResponse, error) {
-	out := new(QueryGetProtoRevEnabledResponse)
-	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevEnabled", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetProtoRevMonitoredPoolsResponse) Reset() {
+	*m = QueryGetProtoRevMonitoredPoolsResponse{}
+}
+func (m *QueryGetProtoRevMonitoredPoolsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevMonitoredPoolsResponse) ProtoMessage()    {}
+func (*QueryGetProtoRevMonitoredPoolsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{35}
+}
+func (m *QueryGetProtoRevMonitoredPoolsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevMonitoredPoolsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevMonitoredPoolsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// GetProtoRevNumberOfTrades queries the number of arbitrage trades the module
-	// has executed
-	GetProtoRevNumberOfTrades(context.Context, *QueryGetProtoRevNumberOfTradesRequest) (*QueryGetProtoRevNumberOfTradesResponse, error)
-	// GetProtoRevProfitsByDenom queries the profits of the module by denom
-	GetProtoRevProfitsByDenom(context.Context, *QueryGetProtoRevProfitsByDenomRequest) (*QueryGetProtoRevProfitsByDenomResponse, error)
-	// GetProtoRevAllProfits queries all of the profits from the module
-	GetProtoRevAllProfits(context.Context, *QueryGetProtoRevAllProfitsRequest) (*QueryGetProtoRevAllProfitsResponse, error)
-	// GetProtoRevStatisticsByRoute queries the number of arbitrages and profits
-	// that have been executed for a given route
-	GetProtoRevStatisticsByRoute(context.Context, *QueryGetProtoRevStatisticsByRouteRequest) (*QueryGetProtoRevStatisticsByRouteResponse, error)
-	// GetProtoRevAllRouteStatistics queries all of routes that the module has
-	// arbitraged against and the number of trades and profits that have been
-	// accumulated for each route
-	GetProtoRevAllRouteStatistics(context.Context, *QueryGetProtoRevAllRouteStatisticsRequest) (*QueryGetProtoRevAllRouteStatisticsResponse, error)
-	// GetProtoRevTokenPairArbRoutes queries all of the hot routes that the module
-	// is currently arbitraging
-	GetProtoRevTokenPairArbRoutes(context.Context, *QueryGetProtoRevTokenPairArbRoutesRequest) (*QueryGetProtoRevTokenPairArbRoutesResponse, error)
-	// GetProtoRevAdminAccount queries the admin account of the module
-	GetProtoRevAdminAccount(context.Context, *QueryGetProtoRevAdminAccountRequest) (*QueryGetProtoRevAdminAccountResponse, error)
-	// GetProtoRevDeveloperAccount queries the developer account of the module
-	GetProtoRevDeveloperAccount(context.Context, *QueryGetProtoRevDeveloperAccountRequest) (*QueryGetProtoRevDeveloperAccountResponse, error)
-	// GetProtoRevPoolWeights queries the weights of each pool type currently
-	// being used by the module
-	GetProtoRevPoolWeights(context.Context, *QueryGetProtoRevPoolWeightsRequest) (*QueryGetProtoRevPoolWeightsResponse, error)
-	// GetProtoRevMaxPoolPointsPerTx queries the maximum number of pool points
-	// that can be consumed per transaction
-	GetProtoRevMaxPoolPointsPerTx(context.Context, *QueryGetProtoRevMaxPoolPointsPerTxRequest) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error)
-	// GetProtoRevMaxPoolPointsPerBlock queries the maximum number of pool points
-	// that can consumed per block
-	GetProtoRevMaxPoolPointsPerBlock(context.Context, *QueryGetProtoRevMaxPoolPointsPerBlockRequest) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error)
-	// GetProtoRevBaseDenoms queries the base denoms that the module is currently
-	// utilizing for arbitrage
-	GetProtoRevBaseDenoms(context.Context, *QueryGetProtoRevBaseDenomsRequest) (*QueryGetProtoRevBaseDenomsResponse, error)
-	// GetProtoRevEnabled queries whether the module is enabled or not
-	GetProtoRevEnabled(context.Context, *QueryGetProtoRevEnabledRequest) (*QueryGetProtoRevEnabledResponse, error)
+func (m *QueryGetProtoRevMonitoredPoolsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevMonitoredPoolsResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevMonitoredPoolsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevMonitoredPoolsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevMonitoredPoolsResponse.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryGetProtoRevMonitoredPoolsResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevMonitoredPoolsResponse) GetPoolIds() []uint64 {
+	if m != nil {
+		return m.PoolIds
+	}
+	return nil
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+// QueryGetProtoRevPoolBlacklistRequest is request type for the
+// Query/GetProtoRevPoolBlacklist RPC method.
+type QueryGetProtoRevPoolBlacklistRequest struct {
 }
-func (*UnimplementedQueryServer) GetProtoRevNumberOfTrades(ctx context.Context, req *QueryGetProtoRevNumberOfTradesRequest) (*QueryGetProtoRevNumberOfTradesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevNumberOfTrades not implemented")
+
+func (m *QueryGetProtoRevPoolBlacklistRequest) Reset()         { *m = QueryGetProtoRevPoolBlacklistRequest{} }
+func (m *QueryGetProtoRevPoolBlacklistRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevPoolBlacklistRequest) ProtoMessage()    {}
+func (*QueryGetProtoRevPoolBlacklistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{36}
 }
-func (*UnimplementedQueryServer) GetProtoRevProfitsByDenom(ctx context.Context, req *QueryGetProtoRevProfitsByDenomRequest) (*QueryGetProtoRevProfitsByDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevProfitsByDenom not implemented")
+func (m *QueryGetProtoRevPoolBlacklistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetProtoRevAllProfits(ctx context.Context, req *QueryGetProtoRevAllProfitsRequest) (*QueryGetProtoRevAllProfitsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevAllProfits not implemented")
+func (m *QueryGetProtoRevPoolBlacklistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevPoolBlacklistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetProtoRevStatisticsByRoute(ctx context.Context, req *QueryGetProtoRevStatisticsByRouteRequest) (*QueryGetProtoRevStatisticsByRouteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevStatisticsByRoute not implemented")
+func (m *QueryGetProtoRevPoolBlacklistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevPoolBlacklistRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetProtoRevAllRouteStatistics(ctx context.Context, req *QueryGetProtoRevAllRouteStatisticsRequest) (*QueryGetProtoRevAllRouteStatisticsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevAllRouteStatistics not implemented")
+func (m *QueryGetProtoRevPoolBlacklistRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetProtoRevTokenPairArbRoutes(ctx context.Context, req *QueryGetProtoRevTokenPairArbRoutesRequest) (*QueryGetProtoRevTokenPairArbRoutesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevTokenPairArbRoutes not implemented")
+func (m *QueryGetProtoRevPoolBlacklistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevPoolBlacklistRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) GetProtoRevAdminAccount(ctx context.Context, req *QueryGetProtoRevAdminAccountRequest) (*QueryGetProtoRevAdminAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevAdminAccount not implemented")
+
+var xxx_messageInfo_QueryGetProtoRevPoolBlacklistRequest proto.InternalMessageInfo
+
+// QueryGetProtoRevPoolBlacklistResponse is response type for the
+// Query/GetProtoRevPoolBlacklist RPC method.
+type QueryGetProtoRevPoolBlacklistResponse struct {
+	// pool_ids is the sorted list of ids of the blacklisted pools
+	PoolIds []uint64 `protobuf:"varint,1,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty" yaml:"pool_ids"`
 }
-func (*UnimplementedQueryServer) GetProtoRevDeveloperAccount(ctx context.Context, req *QueryGetProtoRevDeveloperAccountRequest) (*QueryGetProtoRevDeveloperAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevDeveloperAccount not implemented")
+
+func (m *QueryGetProtoRevPoolBlacklistResponse) Reset()         { *m = QueryGetProtoRevPoolBlacklistResponse{} }
+func (m *QueryGetProtoRevPoolBlacklistResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevPoolBlacklistResponse) ProtoMessage()    {}
+func (*QueryGetProtoRevPoolBlacklistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{37}
 }
-func (*UnimplementedQueryServer) GetProtoRevPoolWeights(ctx context.Context, req *QueryGetProtoRevPoolWeightsRequest) (*QueryGetProtoRevPoolWeightsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevPoolWeights not implemented")
+func (m *QueryGetProtoRevPoolBlacklistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetProtoRevMaxPoolPointsPerTx(ctx context.Context, req *QueryGetProtoRevMaxPoolPointsPerTxRequest) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevMaxPoolPointsPerTx not implemented")
+func (m *QueryGetProtoRevPoolBlacklistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevPoolBlacklistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetProtoRevMaxPoolPointsPerBlock(ctx context.Context, req *QueryGetProtoRevMaxPoolPointsPerBlockRequest) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevMaxPoolPointsPerBlock not implemented")
+func (m *QueryGetProtoRevPoolBlacklistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevPoolBlacklistResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) GetProtoRevBaseDenoms(ctx context.Context, req *QueryGetProtoRevBaseDenomsRequest) (*QueryGetProtoRevBaseDenomsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevBaseDenoms not implemented")
+func (m *QueryGetProtoRevPoolBlacklistResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetProtoRevEnabled(ctx context.Context, req *QueryGetProtoRevEnabledRequest) (*QueryGetProtoRevEnabledResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevEnabled not implemented")
+func (m *QueryGetProtoRevPoolBlacklistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevPoolBlacklistResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryGetProtoRevPoolBlacklistResponse proto.InternalMessageInfo
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryGetProtoRevPoolBlacklistResponse) GetPoolIds() []uint64 {
+	if m != nil {
+		return m.PoolIds
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetProtoRevNumberOfTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevNumberOfTradesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevNumberOfTrades(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevNumberOfTrades",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevNumberOfTrades(ctx, req.(*QueryGetProtoRevNumberOfTradesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryGetProtoRevCurrentArbitrageOpportunitiesRequest is request type for the
+// Query/GetProtoRevCurrentArbitrageOpportunities RPC method.
+type QueryGetProtoRevCurrentArbitrageOpportunitiesRequest struct {
+	// limit is the maximum number of opportunities to return. A value of 0
+	// means that all of the opportunities found are returned.
+	Limit uint64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty" yaml:"limit"`
 }
 
-func _Query_GetProtoRevProfitsByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevProfitsByDenomRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevProfitsByDenom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevProfitsByDenom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevProfitsByDenom(ctx, req.(*QueryGetProtoRevProfitsByDenomRequest))
+func (m *QueryGetProtoRevCurrentArbitrageOpportunitiesRequest) Reset() {
+	*m = QueryGetProtoRevCurrentArbitrageOpportunitiesRequest{}
+}
+func (m *QueryGetProtoRevCurrentArbitrageOpportunitiesRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevCurrentArbitrageOpportunitiesRequest) ProtoMessage() {}
+func (*QueryGetProtoRevCurrentArbitrageOpportunitiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{38}
+}
+func (m *QueryGetProtoRevCurrentArbitrageOpportunitiesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevCurrentArbitrageOpportunitiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevCurrentArbitrageOpportunitiesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetProtoRevCurrentArbitrageOpportunitiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevCurrentArbitrageOpportunitiesRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevCurrentArbitrageOpportunitiesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevCurrentArbitrageOpportunitiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevCurrentArbitrageOpportunitiesRequest.DiscardUnknown(m)
 }
 
-func _Query_GetProtoRevAllProfits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevAllProfitsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevAllProfits(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevAllProfits",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevAllProfits(ctx, req.(*QueryGetProtoRevAllProfitsRequest))
+var xxx_messageInfo_QueryGetProtoRevCurrentArbitrageOpportunitiesRequest proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevCurrentArbitrageOpportunitiesRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_GetProtoRevStatisticsByRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevStatisticsByRouteRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevStatisticsByRoute(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevStatisticsByRoute",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevStatisticsByRoute(ctx, req.(*QueryGetProtoRevStatisticsByRouteRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryGetProtoRevCurrentArbitrageOpportunitiesResponse is response type for
+// the Query/GetProtoRevCurrentArbitrageOpportunities RPC method.
+type QueryGetProtoRevCurrentArbitrageOpportunitiesResponse struct {
+	// opportunities are the profitable opportunities found, sorted by profit
+	// (valued in uosmo) in descending order
+	Opportunities []ArbitrageOpportunity `protobuf:"bytes,1,rep,name=opportunities,proto3" json:"opportunities" yaml:"opportunities"`
 }
 
-func _Query_GetProtoRevAllRouteStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevAllRouteStatisticsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevAllRouteStatistics(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevAllRouteStatistics",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevAllRouteStatistics(ctx, req.(*QueryGetProtoRevAllRouteStatisticsRequest))
+func (m *QueryGetProtoRevCurrentArbitrageOpportunitiesResponse) Reset() {
+	*m = QueryGetProtoRevCurrentArbitrageOpportunitiesResponse{}
+}
+func (m *QueryGetProtoRevCurrentArbitrageOpportunitiesResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevCurrentArbitrageOpportunitiesResponse) ProtoMessage() {}
+func (*QueryGetProtoRevCurrentArbitrageOpportunitiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{39}
+}
+func (m *QueryGetProtoRevCurrentArbitrageOpportunitiesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevCurrentArbitrageOpportunitiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevCurrentArbitrageOpportunitiesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetProtoRevCurrentArbitrageOpportunitiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevCurrentArbitrageOpportunitiesResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevCurrentArbitrageOpportunitiesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevCurrentArbitrageOpportunitiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevCurrentArbitrageOpportunitiesResponse.DiscardUnknown(m)
 }
 
-func _Query_GetProtoRevTokenPairArbRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevTokenPairArbRoutesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevTokenPairArbRoutes(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevTokenPairArbRoutes",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevTokenPairArbRoutes(ctx, req.(*QueryGetProtoRevTokenPairArbRoutesRequest))
+var xxx_messageInfo_QueryGetProtoRevCurrentArbitrageOpportunitiesResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevCurrentArbitrageOpportunitiesResponse) GetOpportunities() []ArbitrageOpportunity {
+	if m != nil {
+		return m.Opportunities
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetProtoRevAdminAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevAdminAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevAdminAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevAdminAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevAdminAccount(ctx, req.(*QueryGetProtoRevAdminAccountRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryGetProtoRevProfitSearchTraceRequest is request type for the
+// Query/GetProtoRevProfitSearchTrace RPC method.
+type QueryGetProtoRevProfitSearchTraceRequest struct {
+	// route is the pool ids of the cyclic arbitrage route, in the order they
+	// are traded through
+	Route []uint64 `protobuf:"varint,1,rep,packed,name=route,proto3" json:"route,omitempty" yaml:"route"`
+	// input_denom is the denom the route starts and ends with. It must be a
+	// base denom.
+	InputDenom string `protobuf:"bytes,2,opt,name=input_denom,json=inputDenom,proto3" json:"input_denom,omitempty" yaml:"input_denom"`
 }
 
-func _Query_GetProtoRevDeveloperAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevDeveloperAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevDeveloperAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevDeveloperAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevDeveloperAccount(ctx, req.(*QueryGetProtoRevDeveloperAccountRequest))
+func (m *QueryGetProtoRevProfitSearchTraceRequest) Reset() {
+	*m = QueryGetProtoRevProfitSearchTraceRequest{}
+}
+func (m *QueryGetProtoRevProfitSearchTraceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevProfitSearchTraceRequest) ProtoMessage()    {}
+func (*QueryGetProtoRevProfitSearchTraceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{40}
+}
+func (m *QueryGetProtoRevProfitSearchTraceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevProfitSearchTraceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevProfitSearchTraceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryGetProtoRevProfitSearchTraceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevProfitSearchTraceRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevProfitSearchTraceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevProfitSearchTraceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevProfitSearchTraceRequest.DiscardUnknown(m)
 }
 
-func _Query_GetProtoRevPoolWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevPoolWeightsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevPoolWeights(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevPoolWeights",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevPoolWeights(ctx, req.(*QueryGetProtoRevPoolWeightsRequest))
+var xxx_messageInfo_QueryGetProtoRevProfitSearchTraceRequest proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevProfitSearchTraceRequest) GetRoute() []uint64 {
+	if m != nil {
+		return m.Route
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetProtoRevMaxPoolPointsPerTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevMaxPoolPointsPerTxRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerTx(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerTx",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerTx(ctx, req.(*QueryGetProtoRevMaxPoolPointsPerTxRequest))
+func (m *QueryGetProtoRevProfitSearchTraceRequest) GetInputDenom() string {
+	if m != nil {
+		return m.InputDenom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetProtoRevMaxPoolPointsPerBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevMaxPoolPointsPerBlockRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerBlock(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerBlock",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerBlock(ctx, req.(*QueryGetProtoRevMaxPoolPointsPerBlockRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryGetProtoRevProfitSearchTraceResponse is response type for the
+// Query/GetProtoRevProfitSearchTrace RPC method.
+type QueryGetProtoRevProfitSearchTraceResponse struct {
+	// steps are the inputs tried by the search alongside the resulting profit,
+	// in the order they were tried
+	Steps []ProfitSearchStep `protobuf:"bytes,1,rep,name=steps,proto3" json:"steps" yaml:"steps"`
+	// input is the optimal input found by the search
+	Input types.Coin `protobuf:"bytes,2,opt,name=input,proto3" json:"input" yaml:"input"`
+	// profit is the profit of the optimal input, in the input denom
+	Profit github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=profit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"profit" yaml:"profit"`
 }
 
-func _Query_GetProtoRevBaseDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevBaseDenomsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevBaseDenoms(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevBaseDenoms",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevBaseDenoms(ctx, req.(*QueryGetProtoRevBaseDenomsRequest))
+func (m *QueryGetProtoRevProfitSearchTraceResponse) Reset() {
+	*m = QueryGetProtoRevProfitSearchTraceResponse{}
+}
+func (m *QueryGetProtoRevProfitSearchTraceResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevProfitSearchTraceResponse) ProtoMessage() {}
+func (*QueryGetProtoRevProfitSearchTraceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{41}
+}
+func (m *QueryGetProtoRevProfitSearchTraceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevProfitSearchTraceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevProfitSearchTraceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-func _Query_GetProtoRevEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetProtoRevEnabledRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetProtoRevEnabled(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevEnabled",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetProtoRevEnabled(ctx, req.(*QueryGetProtoRevEnabledRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.protorev.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetProtoRevNumberOfTrades",
-			Handler:    _Query_GetProtoRevNumberOfTrades_Handler,
-		},
-		{
-			MethodName: "GetProtoRevProfitsByDenom",
-			Handler:    _Query_GetProtoRevProfitsByDenom_Handler,
-		},
-		{
-			MethodName: "GetProtoRevAllProfits",
-			Handler:    _Query_GetProtoRevAllProfits_Handler,
-		},
-		{
-			MethodName: "GetProtoRevStatisticsByRoute",
-			Handler:    _Query_GetProtoRevStatisticsByRoute_Handler,
-		},
-		{
-			MethodName: "GetProtoRevAllRouteStatistics",
-			Handler:    _Query_GetProtoRevAllRouteStatistics_Handler,
-		},
-		{
-			MethodName: "GetProtoRevTokenPairArbRoutes",
-			Handler:    _Query_GetProtoRevTokenPairArbRoutes_Handler,
-		},
-		{
-			MethodName: "GetProtoRevAdminAccount",
-			Handler:    _Query_GetProtoRevAdminAccount_Handler,
-		},
-		{
-			MethodName: "GetProtoRevDeveloperAccount",
-			Handler:    _Query_GetProtoRevDeveloperAccount_Handler,
-		},
-		{
-			MethodName: "GetProtoRevPoolWeights",
-			Handler:    _Query_GetProtoRevPoolWeights_Handler,
-		},
-		{
-			MethodName: "GetProtoRevMaxPoolPointsPerTx",
-			Handler:    _Query_GetProtoRevMaxPoolPointsPerTx_Handler,
-		},
-		{
-			MethodName: "GetProtoRevMaxPoolPointsPerBlock",
-			Handler:    _Query_GetProtoRevMaxPoolPointsPerBlock_Handler,
-		},
-		{
-			MethodName: "GetProtoRevBaseDenoms",
-			Handler:    _Query_GetProtoRevBaseDenoms_Handler,
-		},
-		{
-			MethodName: "GetProtoRevEnabled",
-			Handler:    _Query_GetProtoRevEnabled_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "osmosis/protorev/v1beta1/query.proto",
+func (m *QueryGetProtoRevProfitSearchTraceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevProfitSearchTraceResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetProtoRevProfitSearchTraceResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetProtoRevProfitSearchTraceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevProfitSearchTraceResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
+var xxx_messageInfo_QueryGetProtoRevProfitSearchTraceResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetProtoRevProfitSearchTraceResponse) GetSteps() []ProfitSearchStep {
+	if m != nil {
+		return m.Steps
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetProtoRevProfitSearchTraceResponse) GetInput() types.Coin {
+	if m != nil {
+		return m.Input
+	}
+	return types.Coin{}
+}
+
+// ArbConfig is the full arbitrage configuration and statistics of the module
+type ArbConfig struct {
+	// genesis is the module state as it would be exported in genesis, which
+	// holds the hot routes, base denoms, pool weights, pool point limits and
+	// developer fees
+	Genesis GenesisState `protobuf:"bytes,1,opt,name=genesis,proto3" json:"genesis" yaml:"genesis"`
+	// route_statistics are the statistics of every route the module has
+	// traded
+	RouteStatistics []RouteStatistics `protobuf:"bytes,2,rep,name=route_statistics,json=routeStatistics,proto3" json:"route_statistics" yaml:"route_statistics"`
+	// profits are the profits the module has made in each denom
+	Profits []types.Coin `protobuf:"bytes,3,rep,name=profits,proto3" json:"profits" yaml:"profits"`
+	// number_of_trades is the number of trades the module has executed
+	NumberOfTrades github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=number_of_trades,json=numberOfTrades,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"number_of_trades" yaml:"number_of_trades"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *ArbConfig) Reset()         { *m = ArbConfig{} }
+func (m *ArbConfig) String() string { return proto.CompactTextString(m) }
+func (*ArbConfig) ProtoMessage()    {}
+func (*ArbConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{42}
+}
+func (m *ArbConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArbConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArbConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetProtoRevNumberOfTradesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ArbConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArbConfig.Merge(m, src)
+}
+func (m *ArbConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArbConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArbConfig.DiscardUnknown(m)
 }
 
-func (m *QueryGetProtoRevNumberOfTradesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ArbConfig proto.InternalMessageInfo
+
+func (m *ArbConfig) GetGenesis() GenesisState {
+	if m != nil {
+		return m.Genesis
+	}
+	return GenesisState{}
 }
 
-func (m *QueryGetProtoRevNumberOfTradesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *ArbConfig) GetRouteStatistics() []RouteStatistics {
+	if m != nil {
+		return m.RouteStatistics
+	}
+	return nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ArbConfig) GetProfits() []types.Coin {
+	if m != nil {
+		return m.Profits
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetProtoRevArbConfigRequest is request type for the
+// Query/GetProtoRevArbConfig RPC method.
+type QueryGetProtoRevArbConfigRequest struct {
 }
 
-func (m *QueryGetProtoRevNumberOfTradesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.NumberOfTrades.Size()
-		i -= size
-		if _, err := m.NumberOfTrades.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *QueryGetProtoRevArbConfigRequest) Reset()         { *m = QueryGetProtoRevArbConfigRequest{} }
+func (m *QueryGetProtoRevArbConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevArbConfigRequest) ProtoMessage()    {}
+func (*QueryGetProtoRevArbConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{43}
+}
+func (m *QueryGetProtoRevArbConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevArbConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevArbConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetProtoRevProfitsByDenomRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetProtoRevArbConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevArbConfigRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevArbConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevArbConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevArbConfigRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetProtoRevProfitsByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryGetProtoRevArbConfigRequest proto.InternalMessageInfo
+
+// QueryGetProtoRevArbConfigResponse is response type for the
+// Query/GetProtoRevArbConfig RPC method.
+type QueryGetProtoRevArbConfigResponse struct {
+	// arb_config is the full arbitrage configuration and statistics of the
+	// module
+	ArbConfig ArbConfig `protobuf:"bytes,1,opt,name=arb_config,json=arbConfig,proto3" json:"arb_config" yaml:"arb_config"`
 }
 
-func (m *QueryGetProtoRevProfitsByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryGetProtoRevArbConfigResponse) Reset()         { *m = QueryGetProtoRevArbConfigResponse{} }
+func (m *QueryGetProtoRevArbConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevArbConfigResponse) ProtoMessage()    {}
+func (*QueryGetProtoRevArbConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{44}
+}
+func (m *QueryGetProtoRevArbConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevArbConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevArbConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetProtoRevArbConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevArbConfigResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevArbConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevArbConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevArbConfigResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetProtoRevProfitsByDenomResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetProtoRevArbConfigResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevArbConfigResponse) GetArbConfig() ArbConfig {
+	if m != nil {
+		return m.ArbConfig
 	}
-	return dAtA[:n], nil
+	return ArbConfig{}
 }
 
-func (m *QueryGetProtoRevProfitsByDenomResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetProtoRevArbitrageGasConsumedRequest is request type for the
+// Query/GetProtoRevArbitrageGasConsumed RPC method.
+type QueryGetProtoRevArbitrageGasConsumedRequest struct {
 }
 
-func (m *QueryGetProtoRevProfitsByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Profit != nil {
-		{
-			size, err := m.Profit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetProtoRevArbitrageGasConsumedRequest) Reset() {
+	*m = QueryGetProtoRevArbitrageGasConsumedRequest{}
+}
+func (m *QueryGetProtoRevArbitrageGasConsumedRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevArbitrageGasConsumedRequest) ProtoMessage() {}
+func (*QueryGetProtoRevArbitrageGasConsumedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{45}
+}
+func (m *QueryGetProtoRevArbitrageGasConsumedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevArbitrageGasConsumedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevArbitrageGasConsumedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetProtoRevAllProfitsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetProtoRevArbitrageGasConsumedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevArbitrageGasConsumedRequest.Merge(m, src)
 }
-
-func (m *QueryGetProtoRevAllProfitsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetProtoRevArbitrageGasConsumedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevArbitrageGasConsumedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevArbitrageGasConsumedRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetProtoRevAllProfitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryGetProtoRevArbitrageGasConsumedRequest proto.InternalMessageInfo
+
+// QueryGetProtoRevArbitrageGasConsumedResponse is response type for the
+// Query/GetProtoRevArbitrageGasConsumed RPC method.
+type QueryGetProtoRevArbitrageGasConsumedResponse struct {
+	// total_gas_consumed is the cumulative gas consumed by arbitrage backruns
+	TotalGasConsumed uint64 `protobuf:"varint,1,opt,name=total_gas_consumed,json=totalGasConsumed,proto3" json:"total_gas_consumed,omitempty" yaml:"total_gas_consumed"`
+	// block_gas_consumed is the gas consumed by arbitrage backruns in the
+	// current block
+	BlockGasConsumed uint64 `protobuf:"varint,2,opt,name=block_gas_consumed,json=blockGasConsumed,proto3" json:"block_gas_consumed,omitempty" yaml:"block_gas_consumed"`
 }
 
-func (m *QueryGetProtoRevAllProfitsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetProtoRevArbitrageGasConsumedResponse) Reset() {
+	*m = QueryGetProtoRevArbitrageGasConsumedResponse{}
+}
+func (m *QueryGetProtoRevArbitrageGasConsumedResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetProtoRevArbitrageGasConsumedResponse) ProtoMessage() {}
+func (*QueryGetProtoRevArbitrageGasConsumedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{46}
+}
+func (m *QueryGetProtoRevArbitrageGasConsumedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevArbitrageGasConsumedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevArbitrageGasConsumedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryGetProtoRevAllProfitsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetProtoRevArbitrageGasConsumedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevArbitrageGasConsumedResponse.Merge(m, src)
+}
+func (m *QueryGetProtoRevArbitrageGasConsumedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevArbitrageGasConsumedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevArbitrageGasConsumedResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetProtoRevAllProfitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Profits) > 0 {
-		for iNdEx := len(m.Profits) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Profits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+var xxx_messageInfo_QueryGetProtoRevArbitrageGasConsumedResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevArbitrageGasConsumedResponse) GetTotalGasConsumed() uint64 {
+	if m != nil {
+		return m.TotalGasConsumed
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryGetProtoRevStatisticsByRouteRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetProtoRevArbitrageGasConsumedResponse) GetBlockGasConsumed() uint64 {
+	if m != nil {
+		return m.BlockGasConsumed
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetProtoRevStatisticsByRouteRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetProtoRevProfitSplitRequest is request type for the
+// Query/GetProtoRevProfitSplit RPC method.
+type QueryGetProtoRevProfitSplitRequest struct {
 }
 
-func (m *QueryGetProtoRevStatisticsByRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Route) > 0 {
-		dAtA4 := make([]byte, len(m.Route)*10)
-		var j3 int
-		for _, num := range m.Route {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
+func (m *QueryGetProtoRevProfitSplitRequest) Reset()         { *m = QueryGetProtoRevProfitSplitRequest{} }
+func (m *QueryGetProtoRevProfitSplitRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevProfitSplitRequest) ProtoMessage()    {}
+func (*QueryGetProtoRevProfitSplitRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{47}
+}
+func (m *QueryGetProtoRevProfitSplitRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevProfitSplitRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevProfitSplitRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintQuery(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetProtoRevStatisticsByRouteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetProtoRevProfitSplitRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevProfitSplitRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevProfitSplitRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevProfitSplitRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevProfitSplitRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetProtoRevStatisticsByRouteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryGetProtoRevProfitSplitRequest proto.InternalMessageInfo
+
+// QueryGetProtoRevProfitSplitResponse is response type for the
+// Query/GetProtoRevProfitSplit RPC method.
+type QueryGetProtoRevProfitSplitResponse struct {
+	// developer_fee_fraction is the fraction of each trade's profit that is
+	// currently allocated to the developer account
+	DeveloperFeeFraction github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=developer_fee_fraction,json=developerFeeFraction,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"developer_fee_fraction" yaml:"developer_fee_fraction"`
+	// developer_profits is the share of the module's accumulated profits
+	// allocated to the developer account at the current fraction
+	DeveloperProfits github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=developer_profits,json=developerProfits,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"developer_profits" yaml:"developer_profits"`
+	// protocol_profits is the share of the module's accumulated profits kept by
+	// the protocol at the current fraction
+	ProtocolProfits github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=protocol_profits,json=protocolProfits,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"protocol_profits" yaml:"protocol_profits"`
 }
 
-func (m *QueryGetProtoRevStatisticsByRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Statistics.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetProtoRevProfitSplitResponse) Reset()         { *m = QueryGetProtoRevProfitSplitResponse{} }
+func (m *QueryGetProtoRevProfitSplitResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevProfitSplitResponse) ProtoMessage()    {}
+func (*QueryGetProtoRevProfitSplitResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{48}
+}
+func (m *QueryGetProtoRevProfitSplitResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevProfitSplitResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevProfitSplitResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetProtoRevAllRouteStatisticsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetProtoRevProfitSplitResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevProfitSplitResponse.Merge(m, src)
 }
-
-func (m *QueryGetProtoRevAllRouteStatisticsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetProtoRevProfitSplitResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevProfitSplitResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevProfitSplitResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetProtoRevAllRouteStatisticsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryGetProtoRevProfitSplitResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevProfitSplitResponse) GetDeveloperProfits() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DeveloperProfits
+	}
+	return nil
 }
 
-func (m *QueryGetProtoRevAllRouteStatisticsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetProtoRevProfitSplitResponse) GetProtocolProfits() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.ProtocolProfits
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetProtoRevAllRouteStatisticsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetProtoRevDeveloperFeeShareRequest is request type for the
+// Query/GetProtoRevDeveloperFeeShare RPC method.
+type QueryGetProtoRevDeveloperFeeShareRequest struct {
 }
 
-func (m *QueryGetProtoRevAllRouteStatisticsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Statistics) > 0 {
-		for iNdEx := len(m.Statistics) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Statistics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGetProtoRevDeveloperFeeShareRequest) Reset() {
+	*m = QueryGetProtoRevDeveloperFeeShareRequest{}
+}
+func (m *QueryGetProtoRevDeveloperFeeShareRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetProtoRevDeveloperFeeShareRequest) ProtoMessage()    {}
+func (*QueryGetProtoRevDeveloperFeeShareRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{49}
+}
+func (m *QueryGetProtoRevDeveloperFeeShareRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevDeveloperFeeShareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevDeveloperFeeShareRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetProtoRevDeveloperFeeShareRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevDeveloperFeeShareRequest.Merge(m, src)
+}
+func (m *QueryGetProtoRevDeveloperFeeShareRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevDeveloperFeeShareRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevDeveloperFeeShareRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetProtoRevTokenPairArbRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryGetProtoRevDeveloperFeeShareRequest proto.InternalMessageInfo
+
+// QueryGetProtoRevDeveloperFeeShareResponse is response type for the
+// Query/GetProtoRevDeveloperFeeShare RPC method.
+type QueryGetProtoRevDeveloperFeeShareResponse struct {
+	// developer_fee_share is the fraction of each trade's profit that is
+	// currently allocated to the developer account
+	DeveloperFeeShare github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=developer_fee_share,json=developerFeeShare,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"developer_fee_share" yaml:"developer_fee_share"`
+	// set_by_governance is true if the share was set by governance and false
+	// if it follows the default schedule
+	SetByGovernance bool `protobuf:"varint,2,opt,name=set_by_governance,json=setByGovernance,proto3" json:"set_by_governance,omitempty" yaml:"set_by_governance"`
 }
 
-func (m *QueryGetProtoRevTokenPairArbRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryGetProtoRevDeveloperFeeShareResponse) Reset() {
+	*m = QueryGetProtoRevDeveloperFeeShareResponse{}
 }
-
-func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetProtoRevDeveloperFeeShareResponse) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (m *QueryGetProtoRevTokenPairArbRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*QueryGetProtoRevDeveloperFeeShareResponse) ProtoMessage() {}
+func (*QueryGetProtoRevDeveloperFeeShareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{50}
 }
-
-func (m *QueryGetProtoRevTokenPairArbRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Routes) > 0 {
-		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGetProtoRevDeveloperFeeShareResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevDeveloperFeeShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevDeveloperFeeShareResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetProtoRevAdminAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetProtoRevDeveloperFeeShareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevDeveloperFeeShareResponse.Merge(m, src)
 }
-
-func (m *QueryGetProtoRevAdminAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetProtoRevDeveloperFeeShareResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGetProtoRevAdminAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryGetProtoRevDeveloperFeeShareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevDeveloperFeeShareResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetProtoRevAdminAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetProtoRevDeveloperFeeShareResponse proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevDeveloperFeeShareResponse) GetSetByGovernance() bool {
+	if m != nil {
+		return m.SetByGovernance
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *QueryGetProtoRevAdminAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetProtoRevProjectedProfitOnPriceShiftRequest is request type for the
+// Query/GetProtoRevProjectedProfitOnPriceShift RPC method.
+type QueryGetProtoRevProjectedProfitOnPriceShiftRequest struct {
+	// pool_id is the id of the pool whose price is shifted. It must have exactly
+	// two denoms.
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// price_shift_percent is the percent by which the spot price of the pool's
+	// first denom quoted in its second denom is shifted (e.g. 5 for a 5%
+	// increase and -5 for a 5% decrease)
+	PriceShiftPercent github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=price_shift_percent,json=priceShiftPercent,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_shift_percent" yaml:"price_shift_percent"`
 }
 
-func (m *QueryGetProtoRevAdminAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AdminAccount) > 0 {
-		i -= len(m.AdminAccount)
-		copy(dAtA[i:], m.AdminAccount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.AdminAccount)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryGetProtoRevProjectedProfitOnPriceShiftRequest) Reset() {
+	*m = QueryGetProtoRevProjectedProfitOnPriceShiftRequest{}
 }
-
-func (m *QueryGetProtoRevDeveloperAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetProtoRevProjectedProfitOnPriceShiftRequest) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (m *QueryGetProtoRevDeveloperAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*QueryGetProtoRevProjectedProfitOnPriceShiftRequest) ProtoMessage() {}
+func (*QueryGetProtoRevProjectedProfitOnPriceShiftRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{51}
 }
-
-func (m *QueryGetProtoRevDeveloperAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryGetProtoRevProjectedProfitOnPriceShiftRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryGetProtoRevDeveloperAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGetProtoRevProjectedProfitOnPriceShiftRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevProjectedProfitOnPriceShiftRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryGetProtoRevDeveloperAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetProtoRevProjectedProfitOnPriceShiftRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevProjectedProfitOnPriceShiftRequest.Merge(m, src)
 }
-
-func (m *QueryGetProtoRevDeveloperAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.DeveloperAccount) > 0 {
-		i -= len(m.DeveloperAccount)
-		copy(dAtA[i:], m.DeveloperAccount)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.DeveloperAccount)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryGetProtoRevProjectedProfitOnPriceShiftRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetProtoRevProjectedProfitOnPriceShiftRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevProjectedProfitOnPriceShiftRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetProtoRevPoolWeightsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetProtoRevProjectedProfitOnPriceShiftRequest proto.InternalMessageInfo
+
+func (m *QueryGetProtoRevProjectedProfitOnPriceShiftRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetProtoRevPoolWeightsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetProtoRevProjectedProfitOnPriceShiftResponse is response type for the
+// Query/GetProtoRevProjectedProfitOnPriceShift RPC method.
+type QueryGetProtoRevProjectedProfitOnPriceShiftResponse struct {
+	// opportunities are the profitable opportunities found after the shift,
+	// sorted by profit (valued in uosmo) in descending order
+	Opportunities []ArbitrageOpportunity `protobuf:"bytes,1,rep,name=opportunities,proto3" json:"opportunities" yaml:"opportunities"`
+	// total_profit is the sum of the profits of the opportunities
+	TotalProfit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_profit,json=totalProfit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_profit" yaml:"total_profit"`
+	// spot_price_before is the spot price of the pool before the shift
+	SpotPriceBefore github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=spot_price_before,json=spotPriceBefore,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"spot_price_before" yaml:"spot_price_before"`
+	// spot_price_after is the spot price of the pool after the shift, which is
+	// at or just past the requested price
+	SpotPriceAfter github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=spot_price_after,json=spotPriceAfter,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"spot_price_after" yaml:"spot_price_after"`
 }
 
-func (m *QueryGetProtoRevPoolWeightsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryGetProtoRevProjectedProfitOnPriceShiftResponse) Reset() {
+	*m = QueryGetProtoRevProjectedProfitOnPriceShiftResponse{}
 }
-
-func (m *QueryGetProtoRevPoolWeightsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetProtoRevProjectedProfitOnPriceShiftResponse) String() string {
+	return proto.CompactTextString(m)
 }
-
-func (m *QueryGetProtoRevPoolWeightsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*QueryGetProtoRevProjectedProfitOnPriceShiftResponse) ProtoMessage() {}
+func (*QueryGetProtoRevProjectedProfitOnPriceShiftResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e7ac9973cce389, []int{52}
 }
-
-func (m *QueryGetProtoRevPoolWeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.PoolWeights.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetProtoRevProjectedProfitOnPriceShiftResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetProtoRevProjectedProfitOnPriceShiftResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetProtoRevProjectedProfitOnPriceShiftResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGetProtoRevProjectedProfitOnPriceShiftResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetProtoRevProjectedProfitOnPriceShiftResponse.Merge(m, src)
 }
-
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetProtoRevProjectedProfitOnPriceShiftResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryGetProtoRevProjectedProfitOnPriceShiftResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetProtoRevProjectedProfitOnPriceShiftResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_QueryGetProtoRevProjectedProfitOnPriceShiftResponse proto.InternalMessageInfo
 
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGetProtoRevProjectedProfitOnPriceShiftResponse) GetOpportunities() []ArbitrageOpportunity {
+	if m != nil {
+		return m.Opportunities
+	}
+	return nil
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxPoolPointsPerBlock != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MaxPoolPointsPerBlock))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryGetProtoRevProjectedProfitOnPriceShiftResponse) GetTotalProfit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalProfit
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "osmosis.protorev.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "osmosis.protorev.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryGetProtoRevNumberOfTradesRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevNumberOfTradesRequest")
+	proto.RegisterType((*QueryGetProtoRevNumberOfTradesResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevNumberOfTradesResponse")
+	proto.RegisterType((*QueryGetProtoRevProfitsByDenomRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProfitsByDenomRequest")
+	proto.RegisterType((*QueryGetProtoRevProfitsByDenomResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProfitsByDenomResponse")
+	proto.RegisterType((*QueryGetProtoRevAllProfitsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllProfitsRequest")
+	proto.RegisterType((*QueryGetProtoRevAllProfitsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllProfitsResponse")
+	proto.RegisterType((*QueryGetProtoRevTotalProfitInDenomRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevTotalProfitInDenomRequest")
+	proto.RegisterType((*QueryGetProtoRevTotalProfitInDenomResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevTotalProfitInDenomResponse")
+	proto.RegisterType((*QueryGetProtoRevStatisticsByRouteRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevStatisticsByRouteRequest")
+	proto.RegisterType((*QueryGetProtoRevStatisticsByRouteResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevStatisticsByRouteResponse")
+	proto.RegisterType((*QueryGetProtoRevAllRouteStatisticsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllRouteStatisticsRequest")
+	proto.RegisterType((*QueryGetProtoRevAllRouteStatisticsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAllRouteStatisticsResponse")
+	proto.RegisterType((*QueryGetProtoRevTokenPairArbRoutesRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevTokenPairArbRoutesRequest")
+	proto.RegisterType((*QueryGetProtoRevTokenPairArbRoutesResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevTokenPairArbRoutesResponse")
+	proto.RegisterType((*QueryGetProtoRevAdminAccountRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAdminAccountRequest")
+	proto.RegisterType((*QueryGetProtoRevAdminAccountResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevAdminAccountResponse")
+	proto.RegisterType((*QueryGetProtoRevDeveloperAccountRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevDeveloperAccountRequest")
+	proto.RegisterType((*QueryGetProtoRevDeveloperAccountResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevDeveloperAccountResponse")
+	proto.RegisterType((*QueryGetProtoRevPoolWeightsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolWeightsRequest")
+	proto.RegisterType((*QueryGetProtoRevPoolWeightsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolWeightsResponse")
+	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerBlockRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerBlockRequest")
+	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerBlockResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerBlockResponse")
+	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerTxRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerTxRequest")
+	proto.RegisterType((*QueryGetProtoRevMaxPoolPointsPerTxResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxPoolPointsPerTxResponse")
+	proto.RegisterType((*QueryGetProtoRevBaseDenomsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevBaseDenomsRequest")
+	proto.RegisterType((*QueryGetProtoRevBaseDenomsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevBaseDenomsResponse")
+	proto.RegisterType((*QueryGetProtoRevEnabledRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevEnabledRequest")
+	proto.RegisterType((*QueryGetProtoRevEnabledResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevEnabledResponse")
+	proto.RegisterType((*QueryGetProtoRevMaxTradesPerBlockRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxTradesPerBlockRequest")
+	proto.RegisterType((*QueryGetProtoRevMaxTradesPerBlockResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMaxTradesPerBlockResponse")
+	proto.RegisterType((*QueryGetProtoRevArbitrageStatusRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevArbitrageStatusRequest")
+	proto.RegisterType((*QueryGetProtoRevArbitrageStatusResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevArbitrageStatusResponse")
+	proto.RegisterType((*QueryGetProtoRevMonitoredPoolsRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMonitoredPoolsRequest")
+	proto.RegisterType((*QueryGetProtoRevMonitoredPoolsResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevMonitoredPoolsResponse")
+	proto.RegisterType((*QueryGetProtoRevPoolBlacklistRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolBlacklistRequest")
+	proto.RegisterType((*QueryGetProtoRevPoolBlacklistResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevPoolBlacklistResponse")
+	proto.RegisterType((*QueryGetProtoRevCurrentArbitrageOpportunitiesRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevCurrentArbitrageOpportunitiesRequest")
+	proto.RegisterType((*QueryGetProtoRevCurrentArbitrageOpportunitiesResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevCurrentArbitrageOpportunitiesResponse")
+	proto.RegisterType((*QueryGetProtoRevProfitSearchTraceRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProfitSearchTraceRequest")
+	proto.RegisterType((*QueryGetProtoRevProfitSearchTraceResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProfitSearchTraceResponse")
+	proto.RegisterType((*ArbConfig)(nil), "osmosis.protorev.v1beta1.ArbConfig")
+	proto.RegisterType((*QueryGetProtoRevArbConfigRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevArbConfigRequest")
+	proto.RegisterType((*QueryGetProtoRevArbConfigResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevArbConfigResponse")
+	proto.RegisterType((*QueryGetProtoRevArbitrageGasConsumedRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevArbitrageGasConsumedRequest")
+	proto.RegisterType((*QueryGetProtoRevArbitrageGasConsumedResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevArbitrageGasConsumedResponse")
+	proto.RegisterType((*QueryGetProtoRevProfitSplitRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProfitSplitRequest")
+	proto.RegisterType((*QueryGetProtoRevProfitSplitResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProfitSplitResponse")
+	proto.RegisterType((*QueryGetProtoRevDeveloperFeeShareRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevDeveloperFeeShareRequest")
+	proto.RegisterType((*QueryGetProtoRevDeveloperFeeShareResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevDeveloperFeeShareResponse")
+	proto.RegisterType((*QueryGetProtoRevProjectedProfitOnPriceShiftRequest)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProjectedProfitOnPriceShiftRequest")
+	proto.RegisterType((*QueryGetProtoRevProjectedProfitOnPriceShiftResponse)(nil), "osmosis.protorev.v1beta1.QueryGetProtoRevProjectedProfitOnPriceShiftResponse")
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterFile("osmosis/protorev/v1beta1/query.proto", fileDescriptor_f5e7ac9973cce389)
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var fileDescriptor_f5e7ac9973cce389 = []byte{
+	// 2803 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0xcd, 0x6f, 0x1c, 0x49,
+	0xf9, 0x4e, 0x8f, 0x13, 0x67, 0xb7, 0x9c, 0x4d, 0xec, 0x8a, 0x93, 0x38, 0x1d, 0xc7, 0xe3, 0x94,
+	0xbf, 0xbf, 0x66, 0x94, 0x2f, 0xe5, 0xf7, 0xcb, 0x6e, 0x36, 0xf1, 0xd8, 0x89, 0x37, 0x6c, 0x12,
+	0x9b, 0x8e, 0x77, 0xb3, 0x02, 0xb1, 0x4d, 0xcf, 0x4c, 0x79, 0xdc, 0x64, 0xa6, 0xbb, 0xd3, 0xdd,
+	0x63, 0x6c, 0x09, 0x2e, 0xcb, 0x87, 0x04, 0x8b, 0x04, 0x0b, 0x5c, 0xd9, 0x0b, 0x07, 0xa4, 0xe5,
+	0xc2, 0x95, 0x03, 0x07, 0x0e, 0x48, 0xe1, 0x00, 0x5a, 0x84, 0x90, 0x96, 0x45, 0x9a, 0xac, 0x12,
+	0xc4, 0x89, 0xd3, 0xf0, 0x0f, 0xa0, 0xae, 0x7a, 0x7b, 0xa6, 0x3f, 0x67, 0xba, 0x67, 0x16, 0x38,
+	0xd9, 0x53, 0xf5, 0xd6, 0x53, 0xcf, 0x5b, 0xf5, 0xd6, 0x5b, 0x6f, 0xf5, 0x83, 0xa6, 0x75, 0xab,
+	0xa6, 0x5b, 0xaa, 0x95, 0x37, 0x4c, 0xdd, 0xd6, 0x4d, 0xba, 0x97, 0xdf, 0xbb, 0x58, 0xa4, 0xb6,
+	0x72, 0x31, 0xff, 0xa4, 0x4e, 0xcd, 0x83, 0x1c, 0x6b, 0xc6, 0x63, 0x60, 0x95, 0x73, 0xad, 0x72,
+	0x60, 0x25, 0x8e, 0x56, 0xf4, 0x8a, 0xce, 0x5a, 0xf3, 0xce, 0x7f, 0xdc, 0x40, 0x1c, 0xaf, 0xe8,
+	0x7a, 0xa5, 0x4a, 0xf3, 0x8a, 0xa1, 0xe6, 0x15, 0x4d, 0xd3, 0x6d, 0xc5, 0x56, 0x75, 0x0d, 0x86,
+	0x8b, 0x8b, 0x25, 0x06, 0x97, 0x2f, 0x2a, 0x16, 0xe5, 0xd3, 0xb4, 0x26, 0x35, 0x94, 0x8a, 0xaa,
+	0x31, 0x63, 0xb0, 0x9d, 0x89, 0xe5, 0x67, 0x28, 0xa6, 0x52, 0x73, 0x21, 0xe7, 0xe2, 0xcd, 0x5c,
+	0xc6, 0xdc, 0x70, 0x36, 0xd6, 0xb0, 0x42, 0x35, 0xda, 0x72, 0x51, 0x9c, 0xf0, 0x72, 0x74, 0x4d,
+	0x4a, 0xba, 0x0a, 0xbc, 0xc8, 0x28, 0xc2, 0x5f, 0x74, 0x98, 0x6f, 0x31, 0x16, 0x12, 0x7d, 0x52,
+	0xa7, 0x96, 0x4d, 0x76, 0xd0, 0x49, 0x5f, 0xab, 0x65, 0xe8, 0x9a, 0x45, 0xf1, 0x26, 0x1a, 0xe4,
+	0x6c, 0xc7, 0x84, 0x49, 0x61, 0x7e, 0xe8, 0xd2, 0x64, 0x2e, 0x6e, 0x3d, 0x73, 0x7c, 0x64, 0xe1,
+	0xd4, 0xd3, 0x46, 0xf6, 0x50, 0xb3, 0x91, 0x7d, 0xe5, 0x40, 0xa9, 0x55, 0xaf, 0x13, 0x3e, 0x9a,
+	0x48, 0x00, 0x43, 0xe6, 0xd0, 0x0c, 0x9b, 0x67, 0x83, 0xda, 0x5b, 0x0e, 0x82, 0x44, 0xf7, 0x1e,
+	0xd4, 0x6b, 0x45, 0x6a, 0x6e, 0xee, 0x6c, 0x9b, 0x4a, 0x99, 0xb6, 0x08, 0xfd, 0x4c, 0x40, 0xb3,
+	0xdd, 0x2c, 0x81, 0xa4, 0x85, 0x86, 0x35, 0xd6, 0x23, 0xeb, 0x3b, 0xb2, 0xcd, 0xfa, 0x18, 0xdd,
+	0x97, 0x0b, 0x77, 0x1d, 0x32, 0x9f, 0x36, 0xb2, 0xb3, 0x15, 0xd5, 0xde, 0xad, 0x17, 0x73, 0x25,
+	0xbd, 0x96, 0x87, 0xe5, 0xe1, 0x7f, 0x56, 0xac, 0xf2, 0xe3, 0xbc, 0x7d, 0x60, 0x50, 0x2b, 0x77,
+	0x57, 0xb3, 0x9b, 0x8d, 0xec, 0x19, 0x4e, 0x3b, 0x88, 0x47, 0xa4, 0xe3, 0x9a, 0x6f, 0x72, 0xb2,
+	0x19, 0x76, 0x64, 0xcb, 0xd4, 0x77, 0x54, 0xdb, 0x2a, 0x1c, 0xac, 0x53, 0x4d, 0xaf, 0x81, 0x23,
+	0x78, 0x16, 0x1d, 0x29, 0x3b, 0xbf, 0x81, 0xd2, 0x70, 0xb3, 0x91, 0x3d, 0xc6, 0x27, 0x61, 0xcd,
+	0x44, 0xe2, 0xdd, 0x44, 0x43, 0xb3, 0xdd, 0x00, 0xc1, 0xdf, 0x75, 0x34, 0x68, 0xb0, 0x1e, 0xd8,
+	0x94, 0xb3, 0x39, 0xee, 0x4c, 0xce, 0xd9, 0xf2, 0xd6, 0x7e, 0xac, 0xe9, 0xaa, 0x56, 0x18, 0xf1,
+	0xec, 0x04, 0x1b, 0xe2, 0xec, 0x04, 0xff, 0x67, 0x0a, 0x5d, 0x08, 0xce, 0xb7, 0x5a, 0xad, 0xc2,
+	0x94, 0xee, 0x2e, 0x3c, 0x41, 0xa4, 0x93, 0x11, 0x10, 0x7a, 0x13, 0x1d, 0xe5, 0xa0, 0xce, 0xba,
+	0x0f, 0x74, 0x66, 0x74, 0x1a, 0xe2, 0xe3, 0xb8, 0x97, 0x95, 0x45, 0x24, 0x17, 0x81, 0x54, 0xd0,
+	0x42, 0x70, 0xca, 0x6d, 0xdd, 0x56, 0x60, 0xd2, 0xbb, 0x9a, 0x6f, 0x71, 0xaf, 0xa3, 0x63, 0xb6,
+	0x62, 0x56, 0xa8, 0x2d, 0x7b, 0xd7, 0xf8, 0x4c, 0xb3, 0x91, 0x3d, 0xc9, 0xf1, 0xbd, 0xbd, 0x44,
+	0x1a, 0xe2, 0x3f, 0x19, 0x04, 0xf9, 0xab, 0x80, 0x16, 0x93, 0xcc, 0x04, 0x4e, 0xde, 0x46, 0x47,
+	0x6c, 0xa7, 0xb7, 0xfb, 0xa2, 0x8f, 0x82, 0x8b, 0xb0, 0xcd, 0x6c, 0x14, 0x91, 0xf8, 0x68, 0x5c,
+	0x46, 0x68, 0x4f, 0xa9, 0xd6, 0x79, 0x5a, 0x19, 0xcb, 0xb0, 0xe5, 0x5a, 0xe8, 0x70, 0xaa, 0x18,
+	0x97, 0xb7, 0xdd, 0x11, 0x85, 0xb3, 0x80, 0x3d, 0xc2, 0xb1, 0xdb, 0x50, 0x44, 0x42, 0xbe, 0x1f,
+	0xf3, 0x41, 0xd7, 0x1e, 0x3a, 0xa9, 0xcc, 0xb2, 0xd5, 0x92, 0x55, 0x38, 0x90, 0xf4, 0xba, 0x4d,
+	0x3d, 0x01, 0x6a, 0x3a, 0xbf, 0xd9, 0xde, 0x1d, 0xf6, 0x06, 0x28, 0x6b, 0x26, 0x12, 0xef, 0x26,
+	0x1f, 0x08, 0x68, 0x21, 0x01, 0x28, 0x2c, 0x57, 0x19, 0x21, 0xab, 0xd5, 0x09, 0x6b, 0xd6, 0xc1,
+	0x4f, 0x36, 0xd8, 0x83, 0x16, 0xf0, 0xb3, 0x0d, 0x45, 0x24, 0x0f, 0x2e, 0x59, 0x0a, 0x53, 0x5a,
+	0xad, 0x56, 0x03, 0x60, 0x6e, 0x30, 0xff, 0x38, 0x62, 0xc3, 0xa3, 0xac, 0x63, 0x3c, 0x18, 0xf8,
+	0x6f, 0x79, 0xb0, 0xad, 0x3f, 0xa6, 0xda, 0x96, 0xa2, 0x9a, 0xab, 0x66, 0x91, 0xa1, 0xb6, 0x3c,
+	0xf8, 0x5e, 0x64, 0xc8, 0x86, 0xad, 0xc1, 0x83, 0x2f, 0xa3, 0x41, 0xb6, 0x75, 0x2e, 0xfb, 0xe5,
+	0x78, 0xf6, 0x61, 0x94, 0x60, 0x26, 0xe7, 0x48, 0x44, 0x02, 0x48, 0x32, 0x83, 0xa6, 0x42, 0x8b,
+	0x59, 0xae, 0xa9, 0xda, 0x6a, 0xa9, 0xa4, 0xd7, 0x35, 0xdb, 0xa5, 0x4c, 0xd1, 0x74, 0x67, 0x33,
+	0xe0, 0x7a, 0x03, 0xbd, 0xa2, 0x38, 0xed, 0xb2, 0xc2, 0x3b, 0xe0, 0x28, 0x8f, 0x35, 0x1b, 0xd9,
+	0x51, 0x4e, 0xc0, 0xd7, 0x4d, 0xa4, 0x63, 0x8a, 0x07, 0x86, 0x2c, 0xa0, 0xb9, 0xe0, 0x34, 0xeb,
+	0x74, 0x8f, 0x56, 0x75, 0x83, 0x9a, 0x01, 0x46, 0x75, 0x34, 0xdf, 0xdd, 0x14, 0x58, 0xdd, 0x45,
+	0x23, 0x65, 0xb7, 0x2f, 0xc0, 0x6c, 0xbc, 0xd9, 0xc8, 0x8e, 0xb9, 0x89, 0x3c, 0x60, 0x42, 0xa4,
+	0xe1, 0x72, 0x00, 0x92, 0x4c, 0x87, 0x53, 0xe9, 0x96, 0xae, 0x57, 0x1f, 0x51, 0xb5, 0xb2, 0xdb,
+	0x4e, 0xb8, 0x3f, 0x10, 0xd0, 0x54, 0x47, 0x33, 0x20, 0x46, 0xd1, 0x31, 0x43, 0xd7, 0xab, 0xf2,
+	0xd7, 0x79, 0x3b, 0x1c, 0xb0, 0x99, 0x0e, 0x89, 0xa4, 0x0d, 0x52, 0x38, 0x07, 0x3b, 0x0b, 0x39,
+	0xd2, 0x0b, 0x44, 0xa4, 0x21, 0xa3, 0x6d, 0x49, 0x72, 0x68, 0x39, 0xc8, 0xe6, 0xbe, 0xb2, 0xef,
+	0x60, 0x6d, 0xe9, 0xaa, 0x66, 0x5b, 0x5b, 0xd4, 0x2c, 0x54, 0xf5, 0xd2, 0x63, 0x97, 0xfe, 0x0f,
+	0x05, 0xb4, 0x92, 0x70, 0x00, 0x38, 0xf2, 0x2e, 0x3a, 0x5b, 0x53, 0xf6, 0x65, 0xc6, 0xc1, 0x60,
+	0x26, 0xb2, 0xb3, 0x90, 0x45, 0xc7, 0x88, 0x79, 0x75, 0xb8, 0x30, 0xdd, 0x6c, 0x64, 0x27, 0x39,
+	0xd5, 0x58, 0x53, 0x22, 0x9d, 0xaa, 0x45, 0xcd, 0x13, 0x75, 0xbe, 0x82, 0x84, 0xb6, 0xf7, 0x5d,
+	0xfa, 0xdf, 0x8a, 0x38, 0x5f, 0x51, 0xd6, 0xc0, 0xfd, 0x2d, 0x74, 0x3a, 0x8a, 0x90, 0xbd, 0x0f,
+	0xc4, 0x2f, 0x34, 0x1b, 0xd9, 0xf3, 0xf1, 0xc4, 0xed, 0x7d, 0x22, 0xe1, 0x5a, 0x08, 0x3e, 0xea,
+	0x66, 0x2e, 0x28, 0x16, 0x65, 0xd7, 0x51, 0x2b, 0x50, 0xbe, 0x2b, 0x20, 0xd2, 0xc9, 0x0a, 0x28,
+	0x7e, 0x15, 0x0d, 0x39, 0x17, 0x14, 0xbf, 0x00, 0xdd, 0x3c, 0x30, 0x15, 0x1f, 0x26, 0x2d, 0x88,
+	0x82, 0x08, 0x41, 0x82, 0xb9, 0x03, 0x1e, 0x14, 0x22, 0xa1, 0x62, 0x6b, 0x26, 0x32, 0x89, 0x26,
+	0x82, 0x3c, 0x6e, 0x6b, 0x4a, 0xb1, 0x4a, 0xcb, 0x2e, 0xd5, 0x4d, 0x94, 0x8d, 0xb5, 0x00, 0x9a,
+	0xcb, 0xe8, 0x28, 0xe5, 0x4d, 0x6c, 0xe9, 0x5e, 0x2a, 0xe0, 0x76, 0x89, 0x00, 0x1d, 0x44, 0x72,
+	0x4d, 0xc8, 0x62, 0xf8, 0x04, 0xdf, 0x57, 0xf6, 0x79, 0x61, 0x16, 0x8c, 0xc8, 0x6f, 0xa2, 0x85,
+	0x04, 0xb6, 0x40, 0x63, 0x0b, 0x8d, 0x3a, 0x1b, 0xc5, 0x6b, 0xbe, 0x50, 0x1c, 0x66, 0x9b, 0x8d,
+	0xec, 0xb9, 0xf6, 0x76, 0x06, 0xad, 0x88, 0x34, 0x52, 0x0b, 0x22, 0x93, 0xf9, 0x70, 0x55, 0xb7,
+	0x6a, 0x16, 0x55, 0xdb, 0x54, 0x2a, 0xec, 0xb2, 0xa8, 0xb7, 0x36, 0xf4, 0x23, 0x01, 0xcd, 0x75,
+	0x35, 0x05, 0x9e, 0xdb, 0xe8, 0x54, 0x59, 0xb5, 0xd8, 0x62, 0xc8, 0x75, 0xcd, 0x56, 0xab, 0xf2,
+	0x2e, 0x3b, 0xb0, 0x40, 0x74, 0xb2, 0xd9, 0xc8, 0x8e, 0x43, 0x6a, 0x8a, 0x32, 0x23, 0xd2, 0x49,
+	0xb7, 0xfd, 0x2d, 0xa7, 0xf9, 0x0d, 0xd6, 0x8a, 0x17, 0xd0, 0xa0, 0x52, 0xb2, 0xd5, 0x3d, 0x3a,
+	0x96, 0x61, 0x7b, 0xe0, 0x29, 0x1e, 0x79, 0x3b, 0x91, 0xc0, 0x20, 0xaa, 0x8c, 0xbf, 0xaf, 0x6b,
+	0xaa, 0xad, 0x9b, 0xb4, 0xec, 0x84, 0x73, 0xcb, 0xab, 0x77, 0xd0, 0x6c, 0x37, 0x43, 0xf0, 0x29,
+	0x87, 0x5e, 0x62, 0x07, 0x44, 0x2d, 0x5b, 0x50, 0x89, 0x9c, 0x6c, 0x36, 0xb2, 0x27, 0x3c, 0x29,
+	0x4a, 0x2d, 0xb3, 0x3a, 0x51, 0xd7, 0xab, 0x77, 0xcb, 0x16, 0x99, 0x0d, 0x5f, 0x2c, 0x0e, 0x60,
+	0xa1, 0xaa, 0x94, 0x1e, 0x57, 0x55, 0xab, 0x95, 0xee, 0x1f, 0xa1, 0x99, 0x2e, 0x76, 0x3d, 0x12,
+	0x78, 0x17, 0x5d, 0x09, 0x02, 0xaf, 0xd5, 0x4d, 0x93, 0x6a, 0x76, 0x6b, 0xdb, 0x36, 0x0d, 0x43,
+	0x37, 0xed, 0xba, 0xa6, 0xda, 0x2a, 0xb5, 0x3c, 0xf5, 0x56, 0x55, 0xad, 0xa9, 0xee, 0x66, 0x79,
+	0xea, 0x2d, 0xd6, 0x4c, 0x24, 0xde, 0x4d, 0x7e, 0x29, 0xa0, 0xab, 0x29, 0x27, 0x00, 0x4f, 0x4c,
+	0xf4, 0x8a, 0xee, 0xed, 0x80, 0x63, 0x9f, 0x8b, 0x3f, 0xf6, 0x11, 0x80, 0x07, 0x85, 0x71, 0xc8,
+	0x00, 0x70, 0xff, 0xfa, 0x20, 0x89, 0xe4, 0x9f, 0x82, 0xbc, 0x2f, 0x84, 0x0f, 0x25, 0x2f, 0x5e,
+	0x1f, 0x52, 0xc5, 0x2c, 0xed, 0x6e, 0x9b, 0x4a, 0x29, 0x6d, 0xc9, 0x89, 0xaf, 0xa1, 0x21, 0x55,
+	0x33, 0xea, 0x6e, 0x75, 0x9f, 0x61, 0x17, 0xef, 0xe9, 0x76, 0x52, 0xf2, 0x74, 0x12, 0x09, 0xb1,
+	0x5f, 0xbc, 0xb6, 0xff, 0x79, 0x06, 0x2d, 0x24, 0x60, 0x03, 0xeb, 0xf5, 0x36, 0x3a, 0x62, 0xd9,
+	0xd4, 0x70, 0xd7, 0x69, 0xb1, 0x5b, 0x39, 0xce, 0x31, 0x1e, 0xda, 0xd4, 0x08, 0xd6, 0xfa, 0x0c,
+	0x86, 0x48, 0x1c, 0xce, 0x79, 0x32, 0x30, 0x4e, 0x63, 0x99, 0x94, 0x4f, 0x06, 0x36, 0x8a, 0x48,
+	0x7c, 0x34, 0x7e, 0xd4, 0x7a, 0xef, 0x0d, 0xb0, 0x05, 0xb8, 0x99, 0xfa, 0x55, 0x1b, 0xf3, 0x04,
+	0xfc, 0x70, 0x00, 0xbd, 0xbc, 0x6a, 0x16, 0xd7, 0x74, 0x6d, 0x47, 0xad, 0xe0, 0x77, 0xd0, 0x51,
+	0xf8, 0x92, 0x00, 0xd5, 0xc4, 0x6c, 0xfc, 0x3a, 0x6c, 0x70, 0x43, 0x27, 0x2d, 0xd1, 0xe0, 0x93,
+	0x0e, 0x40, 0x88, 0xe4, 0xc2, 0xe1, 0x3a, 0x1a, 0x66, 0xfb, 0x29, 0x7b, 0xea, 0xe9, 0x4c, 0xda,
+	0x7a, 0x3a, 0x0b, 0xb3, 0x9c, 0xf1, 0x04, 0x8a, 0xec, 0xad, 0xaa, 0x4f, 0x98, 0xfe, 0x11, 0xde,
+	0x67, 0xe9, 0x40, 0xbf, 0xcf, 0xd2, 0xc8, 0x8f, 0x0c, 0x87, 0xff, 0xd3, 0x1f, 0x19, 0x08, 0x9a,
+	0x8c, 0xb8, 0x12, 0xf8, 0x7e, 0xb9, 0xf9, 0xed, 0x3d, 0x01, 0x5d, 0xe8, 0x60, 0x04, 0x21, 0xfe,
+	0x15, 0x84, 0x14, 0xb3, 0x28, 0x97, 0x58, 0x2b, 0xec, 0xef, 0x54, 0xc7, 0x7c, 0xc0, 0x01, 0x82,
+	0xcf, 0x98, 0x36, 0x08, 0x91, 0x5e, 0x56, 0x5c, 0x2b, 0xb2, 0x82, 0x96, 0x62, 0xef, 0xae, 0x0d,
+	0xc5, 0x5a, 0xd3, 0x35, 0xab, 0x5e, 0x6b, 0x57, 0x04, 0x4f, 0x05, 0xb4, 0x9c, 0xcc, 0xbe, 0xf5,
+	0x85, 0x01, 0xb3, 0xe7, 0xb3, 0x5c, 0x51, 0x2c, 0xb9, 0x04, 0xbd, 0x90, 0x40, 0xcf, 0x37, 0x1b,
+	0xd9, 0xb3, 0x9e, 0xa7, 0xb6, 0xcf, 0x86, 0x48, 0xc3, 0xac, 0xd1, 0x03, 0xea, 0x80, 0xb1, 0x0b,
+	0xdb, 0x0f, 0x96, 0x09, 0x82, 0x85, 0x6d, 0x88, 0x34, 0xcc, 0x1a, 0x3d, 0x60, 0x91, 0x65, 0x3d,
+	0x4f, 0x12, 0x46, 0x55, 0x6d, 0x5d, 0x42, 0x9f, 0x0c, 0xa0, 0xa9, 0x8e, 0x66, 0xe0, 0xe7, 0x77,
+	0x04, 0x74, 0xba, 0xfd, 0x9a, 0xd8, 0xa1, 0x54, 0xde, 0x31, 0x9d, 0x2b, 0x57, 0xd7, 0xe0, 0xd5,
+	0xb1, 0x99, 0x22, 0xd8, 0xd6, 0x69, 0xa9, 0x5d, 0x80, 0x46, 0xa3, 0x12, 0x69, 0xb4, 0xd5, 0x71,
+	0x87, 0xd2, 0x3b, 0xd0, 0x8c, 0x7f, 0x2a, 0x78, 0x1f, 0x3e, 0xee, 0x29, 0xca, 0x74, 0x3b, 0x45,
+	0xf7, 0x20, 0x58, 0x42, 0xef, 0x22, 0xf7, 0x3c, 0x7d, 0xf4, 0x2c, 0x3b, 0x9f, 0x80, 0xb9, 0x03,
+	0x66, 0x79, 0xde, 0x50, 0x5b, 0x70, 0x08, 0x3f, 0x10, 0xd0, 0x30, 0x8b, 0xd5, 0x92, 0x53, 0x49,
+	0x27, 0x3d, 0xdb, 0x6f, 0xfa, 0x33, 0x47, 0x10, 0x20, 0x1d, 0xa9, 0x13, 0xee, 0x70, 0xe0, 0x44,
+	0x16, 0x3b, 0x3c, 0x27, 0xef, 0x50, 0xfa, 0x70, 0x57, 0x31, 0xdd, 0x7b, 0x8f, 0xfc, 0x2b, 0xe2,
+	0x13, 0x4a, 0x84, 0x31, 0x04, 0xc3, 0x37, 0xd0, 0x49, 0xff, 0xae, 0x59, 0x4e, 0x37, 0x04, 0xc2,
+	0xbd, 0xd4, 0x81, 0x20, 0x46, 0x05, 0x02, 0x83, 0x24, 0xd2, 0x48, 0x39, 0xc8, 0x02, 0xbf, 0x81,
+	0x46, 0x2c, 0x6a, 0xcb, 0xc5, 0x03, 0xb9, 0xa2, 0xef, 0x51, 0x53, 0x53, 0xb4, 0x92, 0x5b, 0x18,
+	0x7a, 0x9e, 0xbe, 0x21, 0x13, 0x22, 0x9d, 0xb0, 0xa8, 0x5d, 0x38, 0xd8, 0x68, 0xb7, 0x3c, 0x13,
+	0xd0, 0xa5, 0x88, 0xe0, 0xff, 0x1a, 0x2d, 0xd9, 0xb4, 0xcc, 0x97, 0x71, 0x53, 0xdb, 0x32, 0xd5,
+	0x12, 0x7d, 0xb8, 0xab, 0xee, 0xb8, 0x67, 0x06, 0x2f, 0xa1, 0xa3, 0x50, 0x75, 0xc1, 0x41, 0xf7,
+	0xbc, 0x09, 0xa0, 0xc3, 0xb9, 0xca, 0x58, 0x35, 0xe6, 0xac, 0x95, 0xe1, 0x20, 0xc8, 0x96, 0x03,
+	0xe1, 0x14, 0xe5, 0x25, 0xaa, 0xd9, 0x63, 0x99, 0xfe, 0xd6, 0x2a, 0x02, 0x92, 0x48, 0x23, 0x46,
+	0x8b, 0xea, 0x16, 0xb4, 0x7d, 0x78, 0x18, 0x5d, 0x4e, 0xe5, 0xe1, 0xff, 0xae, 0x50, 0x73, 0x52,
+	0xcc, 0x31, 0x9e, 0x27, 0xa1, 0xa8, 0xe8, 0x7a, 0xaa, 0x37, 0xfc, 0x9f, 0x0b, 0xbc, 0x83, 0xd3,
+	0x9d, 0x9d, 0x21, 0xbb, 0xfd, 0x79, 0x15, 0xef, 0xa1, 0x11, 0xcb, 0xd0, 0x6d, 0x99, 0xaf, 0x71,
+	0x91, 0xee, 0xe8, 0x26, 0x85, 0x02, 0xe7, 0x0b, 0xa9, 0xf7, 0xcb, 0x8d, 0xc6, 0x20, 0xa0, 0x13,
+	0x8d, 0x86, 0x6e, 0xb3, 0xa5, 0x2f, 0xb0, 0x16, 0xe7, 0x22, 0xf7, 0x98, 0x29, 0x3b, 0x36, 0x35,
+	0x7b, 0xb8, 0xc8, 0xf9, 0xb4, 0x67, 0x42, 0xd3, 0x32, 0x3c, 0x22, 0x1d, 0x6f, 0xcd, 0xba, 0xea,
+	0x34, 0x5c, 0xfa, 0xc5, 0x12, 0x3a, 0xc2, 0x02, 0x04, 0xbf, 0x2f, 0xa0, 0x41, 0x2e, 0x95, 0xe0,
+	0x0e, 0x9f, 0xe3, 0xc2, 0x0a, 0x8d, 0xb8, 0x92, 0xd0, 0x9a, 0x87, 0x16, 0x99, 0x7e, 0xef, 0xcf,
+	0x7f, 0xff, 0x49, 0x66, 0x02, 0x8f, 0xe7, 0x61, 0x58, 0x7e, 0xef, 0xe2, 0x95, 0xb6, 0x76, 0xc4,
+	0xe5, 0x18, 0xfc, 0x47, 0x01, 0x9d, 0x8d, 0x15, 0x58, 0xf0, 0xcd, 0x2e, 0x53, 0x76, 0x13, 0x71,
+	0xc4, 0x5b, 0xbd, 0x03, 0x80, 0x1b, 0x39, 0xe6, 0xc6, 0x3c, 0x9e, 0x8d, 0x76, 0x23, 0x58, 0x42,
+	0x05, 0x1d, 0xf2, 0x2b, 0x28, 0x69, 0x1c, 0x8a, 0x14, 0x73, 0xc4, 0x5b, 0xbd, 0x03, 0x24, 0x73,
+	0x08, 0xee, 0x24, 0x27, 0xa7, 0xb2, 0x67, 0x0d, 0xfe, 0x8d, 0x80, 0x4e, 0x45, 0xaa, 0x2f, 0xf8,
+	0xd5, 0xe4, 0x5c, 0x42, 0xc2, 0x8e, 0xf8, 0x5a, 0x6f, 0x83, 0xc1, 0x89, 0x05, 0xe6, 0xc4, 0x14,
+	0xbe, 0x10, 0xed, 0x84, 0x52, 0x6d, 0x5d, 0xae, 0xf8, 0x99, 0x80, 0xce, 0x77, 0x14, 0x58, 0xf0,
+	0x5a, 0x72, 0x2a, 0xb1, 0x42, 0x90, 0xb8, 0xde, 0x1f, 0x08, 0xf8, 0x75, 0x99, 0xf9, 0xb5, 0x82,
+	0x97, 0xa2, 0xfd, 0xf2, 0x66, 0x3e, 0x59, 0xd5, 0x60, 0x87, 0x3e, 0x15, 0xd0, 0x78, 0x27, 0x49,
+	0x04, 0x17, 0x92, 0x73, 0x8b, 0x13, 0x69, 0xc4, 0xb5, 0xbe, 0x30, 0xc0, 0xbd, 0x8b, 0xcc, 0xbd,
+	0x25, 0xbc, 0x10, 0xed, 0x5e, 0xfb, 0x31, 0xe5, 0x84, 0x1f, 0x7f, 0x81, 0x37, 0xfc, 0xdb, 0x17,
+	0x96, 0x4b, 0xd2, 0x6c, 0x5f, 0xac, 0x34, 0x23, 0xae, 0xf7, 0x07, 0x02, 0xfe, 0x5d, 0x62, 0xfe,
+	0x2d, 0xe3, 0xc5, 0xf8, 0xb0, 0x0c, 0x3e, 0x1b, 0xc3, 0xf1, 0x19, 0xd4, 0x41, 0xd2, 0xc5, 0x67,
+	0x8c, 0x72, 0x23, 0xae, 0xf7, 0x07, 0x92, 0x34, 0x3e, 0x1f, 0x53, 0x4d, 0x36, 0x14, 0xd5, 0x94,
+	0x9d, 0x77, 0x9a, 0xc9, 0xf9, 0xff, 0x4e, 0x40, 0x67, 0x62, 0xd4, 0x17, 0x7c, 0x23, 0xc5, 0xba,
+	0x87, 0xc5, 0x1d, 0xf1, 0xf5, 0x5e, 0x87, 0x83, 0x3f, 0x4b, 0xcc, 0x9f, 0x19, 0x3c, 0x15, 0xb3,
+	0x61, 0x5e, 0xc5, 0x07, 0xff, 0x45, 0x40, 0xe7, 0x3a, 0x68, 0x36, 0x78, 0x35, 0x39, 0x99, 0x18,
+	0x69, 0x48, 0x2c, 0xf4, 0x03, 0x01, 0x3e, 0xe5, 0x99, 0x4f, 0x0b, 0x78, 0x2e, 0xda, 0xa7, 0x90,
+	0x56, 0x84, 0x7f, 0x2b, 0xa0, 0xd3, 0xd1, 0x6a, 0x0f, 0x4e, 0x91, 0xa5, 0xc3, 0x5a, 0x92, 0x78,
+	0xa3, 0xc7, 0xd1, 0xe0, 0xc8, 0x22, 0x73, 0x64, 0x1a, 0x93, 0x98, 0x9b, 0xca, 0xa3, 0x1a, 0xe1,
+	0xcf, 0xfc, 0xa7, 0x28, 0xac, 0x99, 0xa4, 0x39, 0x45, 0xb1, 0xfa, 0x8c, 0xb8, 0xde, 0x1f, 0x08,
+	0x38, 0x76, 0x85, 0x39, 0x96, 0xc3, 0xcb, 0xd1, 0x8e, 0x45, 0x4b, 0x35, 0xf8, 0x9f, 0x02, 0x9a,
+	0xec, 0xa6, 0x6a, 0xe1, 0x3b, 0xbd, 0x13, 0xf4, 0xaa, 0x16, 0xe2, 0x46, 0xdf, 0x38, 0xe0, 0xeb,
+	0x35, 0xe6, 0xeb, 0x45, 0x9c, 0x4f, 0xee, 0x2b, 0xfb, 0xc4, 0x11, 0xac, 0x3b, 0xda, 0xd2, 0x52,
+	0x9a, 0xba, 0x23, 0x24, 0x5b, 0x89, 0xaf, 0xf5, 0x36, 0x38, 0x59, 0xdd, 0xe1, 0xd1, 0xa8, 0xf0,
+	0xaf, 0x04, 0x84, 0xc3, 0x82, 0x13, 0xfe, 0xbf, 0xe4, 0xf3, 0xfb, 0x55, 0x2c, 0xf1, 0xff, 0x7b,
+	0x18, 0x09, 0xb4, 0x67, 0x18, 0xed, 0x2c, 0x3e, 0x1f, 0x4d, 0x1b, 0x64, 0x2d, 0xfc, 0x37, 0x7f,
+	0x21, 0x11, 0x92, 0xa9, 0xd2, 0x14, 0x12, 0x71, 0x7a, 0x98, 0xb8, 0xd6, 0x17, 0x46, 0xb2, 0x8b,
+	0x36, 0x4a, 0x1d, 0xc3, 0x7f, 0x12, 0x90, 0x18, 0x2f, 0x6d, 0xe1, 0x14, 0x95, 0x75, 0xb4, 0x80,
+	0x26, 0xae, 0xf6, 0x81, 0x90, 0xac, 0x38, 0x57, 0xdc, 0x61, 0xac, 0x80, 0xa8, 0x5b, 0xf8, 0x0f,
+	0xfe, 0xd7, 0x86, 0x5f, 0xd9, 0x4a, 0xf3, 0xda, 0x88, 0x14, 0xcf, 0xc4, 0x5b, 0xbd, 0x03, 0x80,
+	0x43, 0x2b, 0xcc, 0xa1, 0x39, 0x3c, 0x13, 0xb3, 0x51, 0xee, 0x28, 0x96, 0x04, 0x2c, 0xfc, 0x7b,
+	0x01, 0x8d, 0xc5, 0xe9, 0x64, 0xf8, 0xf5, 0x74, 0xd7, 0x49, 0x50, 0x88, 0x13, 0x6f, 0xf6, 0x3c,
+	0x1e, 0x9c, 0x59, 0x66, 0xce, 0xcc, 0xe2, 0xe9, 0x0e, 0x17, 0x52, 0xb1, 0x45, 0xf7, 0xfb, 0x19,
+	0x34, 0x9f, 0x54, 0x39, 0xc3, 0x0f, 0x92, 0x73, 0x4b, 0xa2, 0xf1, 0x89, 0x9b, 0x9f, 0x1b, 0x1e,
+	0xf8, 0x7e, 0x83, 0xf9, 0x7e, 0x0d, 0x5f, 0x8d, 0xf6, 0xbd, 0xc4, 0x41, 0xe4, 0x76, 0x84, 0xfa,
+	0x3f, 0xfa, 0x04, 0xde, 0x28, 0x21, 0x29, 0x2c, 0x4d, 0x6a, 0x89, 0x53, 0xf5, 0xc4, 0xb5, 0xbe,
+	0x30, 0x92, 0xbd, 0x51, 0xe0, 0xf1, 0x65, 0xb1, 0x91, 0x4e, 0x92, 0x29, 0x51, 0xfc, 0x6b, 0x01,
+	0x8d, 0x46, 0x89, 0x1f, 0xf8, 0x7a, 0xaa, 0x8c, 0xe0, 0x93, 0x55, 0xc4, 0x57, 0x7b, 0x1a, 0x0b,
+	0x4e, 0xcc, 0x33, 0x27, 0x08, 0x9e, 0x8c, 0xcd, 0x23, 0x20, 0xa2, 0xe0, 0x7f, 0x08, 0x28, 0xdb,
+	0x45, 0x04, 0xc1, 0xb7, 0x7b, 0x48, 0x6c, 0x61, 0xd1, 0x45, 0xbc, 0xd3, 0x2f, 0x4c, 0xb2, 0xf2,
+	0xa9, 0x1d, 0x82, 0x5e, 0xe9, 0x24, 0x54, 0xe5, 0xb6, 0xc5, 0x8f, 0x54, 0x55, 0x6e, 0x48, 0x5a,
+	0x11, 0x6f, 0xf4, 0x38, 0x3a, 0x61, 0x95, 0x0b, 0xf1, 0xc6, 0x88, 0x06, 0x4e, 0x51, 0xe8, 0xcb,
+	0x3d, 0xee, 0xe5, 0xfd, 0x10, 0xd0, 0x08, 0xc4, 0xb5, 0xbe, 0x30, 0x92, 0x9d, 0xa2, 0x08, 0x0d,
+	0x00, 0x7f, 0x3b, 0x83, 0x66, 0x93, 0x7d, 0xbe, 0xc6, 0xf7, 0x52, 0x2d, 0x79, 0x97, 0xef, 0xfc,
+	0xe2, 0xfd, 0xcf, 0x09, 0x2d, 0x59, 0xa6, 0x34, 0x5c, 0x08, 0xf7, 0x3b, 0x8e, 0xae, 0xc9, 0x9e,
+	0xcf, 0xfc, 0x85, 0x07, 0x4f, 0x9f, 0x4f, 0x08, 0x1f, 0x3f, 0x9f, 0x10, 0x3e, 0x7b, 0x3e, 0x21,
+	0xfc, 0xe8, 0xc5, 0xc4, 0xa1, 0x8f, 0x5f, 0x4c, 0x1c, 0xfa, 0xe4, 0xc5, 0xc4, 0xa1, 0x2f, 0x5d,
+	0xf1, 0x7c, 0x16, 0x06, 0xe8, 0x95, 0xaa, 0x52, 0xb4, 0x3c, 0xf3, 0x5c, 0xcd, 0xef, 0xb7, 0x67,
+	0x62, 0x1f, 0x8a, 0x8b, 0x83, 0xec, 0xf7, 0xe5, 0x7f, 0x0f, 0x00, 0xf7, 0x8e, 0x37, 0x5d, 0xaf,
+	0x30, 0x00, 0x00,
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// GetProtoRevNumberOfTrades queries the number of arbitrage trades the module
+	// has executed
+	GetProtoRevNumberOfTrades(ctx context.Context, in *QueryGetProtoRevNumberOfTradesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevNumberOfTradesResponse, error)
+	// GetProtoRevProfitsByDenom queries the profits of the module by denom
+	GetProtoRevProfitsByDenom(ctx context.Context, in *QueryGetProtoRevProfitsByDenomRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProfitsByDenomResponse, error)
+	// GetProtoRevAllProfits queries all of the profits from the module
+	GetProtoRevAllProfits(ctx context.Context, in *QueryGetProtoRevAllProfitsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllProfitsResponse, error)
+	// GetProtoRevTotalProfitInDenom queries the total profits of the module
+	// valued in a single target denom, alongside the per denom breakdown. The
+	// valuation is an on-chain estimate that is subject to pool liquidity.
+	GetProtoRevTotalProfitInDenom(ctx context.Context, in *QueryGetProtoRevTotalProfitInDenomRequest, opts ...grpc.CallOption) (*QueryGetProtoRevTotalProfitInDenomResponse, error)
+	// GetProtoRevStatisticsByRoute queries the number of arbitrages and profits
+	// that have been executed for a given route
+	GetProtoRevStatisticsByRoute(ctx context.Context, in *QueryGetProtoRevStatisticsByRouteRequest, opts ...grpc.CallOption) (*QueryGetProtoRevStatisticsByRouteResponse, error)
+	// GetProtoRevAllRouteStatistics queries all of routes that the module has
+	// arbitraged against and the number of trades and profits that have been
+	// accumulated for each route
+	GetProtoRevAllRouteStatistics(ctx context.Context, in *QueryGetProtoRevAllRouteStatisticsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllRouteStatisticsResponse, error)
+	// GetProtoRevTokenPairArbRoutes queries all of the hot routes that the module
+	// is currently arbitraging
+	GetProtoRevTokenPairArbRoutes(ctx context.Context, in *QueryGetProtoRevTokenPairArbRoutesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevTokenPairArbRoutesResponse, error)
+	// GetProtoRevAdminAccount queries the admin account of the module
+	GetProtoRevAdminAccount(ctx context.Context, in *QueryGetProtoRevAdminAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAdminAccountResponse, error)
+	// GetProtoRevDeveloperAccount queries the developer account of the module
+	GetProtoRevDeveloperAccount(ctx context.Context, in *QueryGetProtoRevDeveloperAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevDeveloperAccountResponse, error)
+	// GetProtoRevPoolWeights queries the weights of each pool type currently
+	// being used by the module
+	GetProtoRevPoolWeights(ctx context.Context, in *QueryGetProtoRevPoolWeightsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolWeightsResponse, error)
+	// GetProtoRevMaxPoolPointsPerTx queries the maximum number of pool points
+	// that can be consumed per transaction
+	GetProtoRevMaxPoolPointsPerTx(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerTxRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error)
+	// GetProtoRevMaxPoolPointsPerBlock queries the maximum number of pool points
+	// that can consumed per block
+	GetProtoRevMaxPoolPointsPerBlock(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerBlockRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error)
+	// GetProtoRevBaseDenoms queries the base denoms that the module is currently
+	// utilizing for arbitrage
+	GetProtoRevBaseDenoms(ctx context.Context, in *QueryGetProtoRevBaseDenomsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevBaseDenomsResponse, error)
+	// GetProtoRevEnabled queries whether the module is enabled or not
+	GetProtoRevEnabled(ctx context.Context, in *QueryGetProtoRevEnabledRequest, opts ...grpc.CallOption) (*QueryGetProtoRevEnabledResponse, error)
+	// GetProtoRevMaxTradesPerBlock queries the maximum number of trades that can
+	// be executed per block
+	GetProtoRevMaxTradesPerBlock(ctx context.Context, in *QueryGetProtoRevMaxTradesPerBlockRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxTradesPerBlockResponse, error)
+	// GetProtoRevArbitrageStatus queries the height until which arbitrage is
+	// disabled and whether arbitrage is currently active
+	GetProtoRevArbitrageStatus(ctx context.Context, in *QueryGetProtoRevArbitrageStatusRequest, opts ...grpc.CallOption) (*QueryGetProtoRevArbitrageStatusResponse, error)
+	// GetProtoRevMonitoredPools queries the ids of all pools that the module
+	// considers for arbitrage given the current base denoms and hot routes
+	GetProtoRevMonitoredPools(ctx context.Context, in *QueryGetProtoRevMonitoredPoolsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMonitoredPoolsResponse, error)
+	// GetProtoRevPoolBlacklist queries the ids of the pools that must never be
+	// included in arbitrage routes
+	GetProtoRevPoolBlacklist(ctx context.Context, in *QueryGetProtoRevPoolBlacklistRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolBlacklistResponse, error)
+	// GetProtoRevCurrentArbitrageOpportunities runs the route search against
+	// the current state, without executing any trades, and returns the most
+	// profitable opportunities found within the pool point budget
+	GetProtoRevCurrentArbitrageOpportunities(ctx context.Context, in *QueryGetProtoRevCurrentArbitrageOpportunitiesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevCurrentArbitrageOpportunitiesResponse, error)
+	// GetProtoRevProfitSearchTrace reruns the search for the optimal input
+	// amount of a route, without executing any trades, and returns every input
+	// tried alongside the resulting profit
+	GetProtoRevProfitSearchTrace(ctx context.Context, in *QueryGetProtoRevProfitSearchTraceRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProfitSearchTraceResponse, error)
+	// GetProtoRevArbConfig queries the full arbitrage configuration and
+	// statistics of the module in a single response, so that off chain
+	// simulators can replay the module's behaviour
+	GetProtoRevArbConfig(ctx context.Context, in *QueryGetProtoRevArbConfigRequest, opts ...grpc.CallOption) (*QueryGetProtoRevArbConfigResponse, error)
+	// GetProtoRevArbitrageGasConsumed queries the cumulative gas consumed by
+	// arbitrage backruns and the gas consumed in the current block
+	GetProtoRevArbitrageGasConsumed(ctx context.Context, in *QueryGetProtoRevArbitrageGasConsumedRequest, opts ...grpc.CallOption) (*QueryGetProtoRevArbitrageGasConsumedResponse, error)
+	// GetProtoRevProfitSplit queries the fraction of profit currently allocated
+	// to the developer account and the resulting split of the module's
+	// accumulated profits between the developer account and the protocol
+	GetProtoRevProfitSplit(ctx context.Context, in *QueryGetProtoRevProfitSplitRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProfitSplitResponse, error)
+	// GetProtoRevDeveloperFeeShare queries the fraction of each trade's profit
+	// that is currently allocated to the developer account and whether it was
+	// set by governance
+	GetProtoRevDeveloperFeeShare(ctx context.Context, in *QueryGetProtoRevDeveloperFeeShareRequest, opts ...grpc.CallOption) (*QueryGetProtoRevDeveloperFeeShareResponse, error)
+	// GetProtoRevProjectedProfitOnPriceShift estimates the arbitrage the module
+	// would capture if the spot price of a pool moved by the given percent,
+	// without executing any trades
+	GetProtoRevProjectedProfitOnPriceShift(ctx context.Context, in *QueryGetProtoRevProjectedProfitOnPriceShiftRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProjectedProfitOnPriceShiftResponse, error)
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxPoolPointsPerTx != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.MaxPoolPointsPerTx))
-		i--
-		dAtA[i] = 0x8
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevBaseDenomsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevNumberOfTrades(ctx context.Context, in *QueryGetProtoRevNumberOfTradesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevNumberOfTradesResponse, error) {
+	out := new(QueryGetProtoRevNumberOfTradesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevNumberOfTrades", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevBaseDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevProfitsByDenom(ctx context.Context, in *QueryGetProtoRevProfitsByDenomRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProfitsByDenomResponse, error) {
+	out := new(QueryGetProtoRevProfitsByDenomResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevProfitsByDenom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetProtoRevBaseDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) GetProtoRevAllProfits(ctx context.Context, in *QueryGetProtoRevAllProfitsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllProfitsResponse, error) {
+	out := new(QueryGetProtoRevAllProfitsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevAllProfits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetProtoRevBaseDenomsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevTotalProfitInDenom(ctx context.Context, in *QueryGetProtoRevTotalProfitInDenomRequest, opts ...grpc.CallOption) (*QueryGetProtoRevTotalProfitInDenomResponse, error) {
+	out := new(QueryGetProtoRevTotalProfitInDenomResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevTotalProfitInDenom", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevBaseDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevStatisticsByRoute(ctx context.Context, in *QueryGetProtoRevStatisticsByRouteRequest, opts ...grpc.CallOption) (*QueryGetProtoRevStatisticsByRouteResponse, error) {
+	out := new(QueryGetProtoRevStatisticsByRouteResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevStatisticsByRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetProtoRevBaseDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BaseDenoms) > 0 {
-		for iNdEx := len(m.BaseDenoms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BaseDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) GetProtoRevAllRouteStatistics(ctx context.Context, in *QueryGetProtoRevAllRouteStatisticsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAllRouteStatisticsResponse, error) {
+	out := new(QueryGetProtoRevAllRouteStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevAllRouteStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevEnabledRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevTokenPairArbRoutes(ctx context.Context, in *QueryGetProtoRevTokenPairArbRoutesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevTokenPairArbRoutesResponse, error) {
+	out := new(QueryGetProtoRevTokenPairArbRoutesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevTokenPairArbRoutes", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevEnabledRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevAdminAccount(ctx context.Context, in *QueryGetProtoRevAdminAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevAdminAccountResponse, error) {
+	out := new(QueryGetProtoRevAdminAccountResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevAdminAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetProtoRevEnabledRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) GetProtoRevDeveloperAccount(ctx context.Context, in *QueryGetProtoRevDeveloperAccountRequest, opts ...grpc.CallOption) (*QueryGetProtoRevDeveloperAccountResponse, error) {
+	out := new(QueryGetProtoRevDeveloperAccountResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevDeveloperAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetProtoRevEnabledResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevPoolWeights(ctx context.Context, in *QueryGetProtoRevPoolWeightsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolWeightsResponse, error) {
+	out := new(QueryGetProtoRevPoolWeightsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevPoolWeights", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGetProtoRevEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) GetProtoRevMaxPoolPointsPerTx(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerTxRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error) {
+	out := new(QueryGetProtoRevMaxPoolPointsPerTxResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerTx", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGetProtoRevEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Enabled {
-		i--
-		if m.Enabled {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) GetProtoRevMaxPoolPointsPerBlock(ctx context.Context, in *QueryGetProtoRevMaxPoolPointsPerBlockRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error) {
+	out := new(QueryGetProtoRevMaxPoolPointsPerBlockResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) GetProtoRevBaseDenoms(ctx context.Context, in *QueryGetProtoRevBaseDenomsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevBaseDenomsResponse, error) {
+	out := new(QueryGetProtoRevBaseDenomsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevBaseDenoms", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) GetProtoRevEnabled(ctx context.Context, in *QueryGetProtoRevEnabledRequest, opts ...grpc.CallOption) (*QueryGetProtoRevEnabledResponse, error) {
+	out := new(QueryGetProtoRevEnabledResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevEnabled", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) GetProtoRevMaxTradesPerBlock(ctx context.Context, in *QueryGetProtoRevMaxTradesPerBlockRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMaxTradesPerBlockResponse, error) {
+	out := new(QueryGetProtoRevMaxTradesPerBlockResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxTradesPerBlock", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryGetProtoRevNumberOfTradesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) GetProtoRevArbitrageStatus(ctx context.Context, in *QueryGetProtoRevArbitrageStatusRequest, opts ...grpc.CallOption) (*QueryGetProtoRevArbitrageStatusResponse, error) {
+	out := new(QueryGetProtoRevArbitrageStatusResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevArbitrageStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.NumberOfTrades.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryGetProtoRevProfitsByDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) GetProtoRevMonitoredPools(ctx context.Context, in *QueryGetProtoRevMonitoredPoolsRequest, opts ...grpc.CallOption) (*QueryGetProtoRevMonitoredPoolsResponse, error) {
+	out := new(QueryGetProtoRevMonitoredPoolsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevMonitoredPools", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGetProtoRevProfitsByDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Profit != nil {
-		l = m.Profit.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) GetProtoRevPoolBlacklist(ctx context.Context, in *QueryGetProtoRevPoolBlacklistRequest, opts ...grpc.CallOption) (*QueryGetProtoRevPoolBlacklistResponse, error) {
+	out := new(QueryGetProtoRevPoolBlacklistResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevPoolBlacklist", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGetProtoRevAllProfitsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) GetProtoRevCurrentArbitrageOpportunities(ctx context.Context, in *QueryGetProtoRevCurrentArbitrageOpportunitiesRequest, opts ...grpc.CallOption) (*QueryGetProtoRevCurrentArbitrageOpportunitiesResponse, error) {
+	out := new(QueryGetProtoRevCurrentArbitrageOpportunitiesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevCurrentArbitrageOpportunities", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryGetProtoRevAllProfitsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Profits) > 0 {
-		for _, e := range m.Profits {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) GetProtoRevProfitSearchTrace(ctx context.Context, in *QueryGetProtoRevProfitSearchTraceRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProfitSearchTraceResponse, error) {
+	out := new(QueryGetProtoRevProfitSearchTraceResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevProfitSearchTrace", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGetProtoRevStatisticsByRouteRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Route) > 0 {
-		l = 0
-		for _, e := range m.Route {
-			l += sovQuery(uint64(e))
-		}
-		n += 1 + sovQuery(uint64(l)) + l
+func (c *queryClient) GetProtoRevArbConfig(ctx context.Context, in *QueryGetProtoRevArbConfigRequest, opts ...grpc.CallOption) (*QueryGetProtoRevArbConfigResponse, error) {
+	out := new(QueryGetProtoRevArbConfigResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevArbConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGetProtoRevStatisticsByRouteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) GetProtoRevArbitrageGasConsumed(ctx context.Context, in *QueryGetProtoRevArbitrageGasConsumedRequest, opts ...grpc.CallOption) (*QueryGetProtoRevArbitrageGasConsumedResponse, error) {
+	out := new(QueryGetProtoRevArbitrageGasConsumedResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevArbitrageGasConsumed", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Statistics.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryGetProtoRevAllRouteStatisticsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) GetProtoRevProfitSplit(ctx context.Context, in *QueryGetProtoRevProfitSplitRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProfitSplitResponse, error) {
+	out := new(QueryGetProtoRevProfitSplitResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevProfitSplit", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryGetProtoRevAllRouteStatisticsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Statistics) > 0 {
-		for _, e := range m.Statistics {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) GetProtoRevDeveloperFeeShare(ctx context.Context, in *QueryGetProtoRevDeveloperFeeShareRequest, opts ...grpc.CallOption) (*QueryGetProtoRevDeveloperFeeShareResponse, error) {
+	out := new(QueryGetProtoRevDeveloperFeeShareResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevDeveloperFeeShare", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) GetProtoRevProjectedProfitOnPriceShift(ctx context.Context, in *QueryGetProtoRevProjectedProfitOnPriceShiftRequest, opts ...grpc.CallOption) (*QueryGetProtoRevProjectedProfitOnPriceShiftResponse, error) {
+	out := new(QueryGetProtoRevProjectedProfitOnPriceShiftResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Query/GetProtoRevProjectedProfitOnPriceShift", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	return n
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// GetProtoRevNumberOfTrades queries the number of arbitrage trades the module
+	// has executed
+	GetProtoRevNumberOfTrades(context.Context, *QueryGetProtoRevNumberOfTradesRequest) (*QueryGetProtoRevNumberOfTradesResponse, error)
+	// GetProtoRevProfitsByDenom queries the profits of the module by denom
+	GetProtoRevProfitsByDenom(context.Context, *QueryGetProtoRevProfitsByDenomRequest) (*QueryGetProtoRevProfitsByDenomResponse, error)
+	// GetProtoRevAllProfits queries all of the profits from the module
+	GetProtoRevAllProfits(context.Context, *QueryGetProtoRevAllProfitsRequest) (*QueryGetProtoRevAllProfitsResponse, error)
+	// GetProtoRevTotalProfitInDenom queries the total profits of the module
+	// valued in a single target denom, alongside the per denom breakdown. The
+	// valuation is an on-chain estimate that is subject to pool liquidity.
+	GetProtoRevTotalProfitInDenom(context.Context, *QueryGetProtoRevTotalProfitInDenomRequest) (*QueryGetProtoRevTotalProfitInDenomResponse, error)
+	// GetProtoRevStatisticsByRoute queries the number of arbitrages and profits
+	// that have been executed for a given route
+	GetProtoRevStatisticsByRoute(context.Context, *QueryGetProtoRevStatisticsByRouteRequest) (*QueryGetProtoRevStatisticsByRouteResponse, error)
+	// GetProtoRevAllRouteStatistics queries all of routes that the module has
+	// arbitraged against and the number of trades and profits that have been
+	// accumulated for each route
+	GetProtoRevAllRouteStatistics(context.Context, *QueryGetProtoRevAllRouteStatisticsRequest) (*QueryGetProtoRevAllRouteStatisticsResponse, error)
+	// GetProtoRevTokenPairArbRoutes queries all of the hot routes that the module
+	// is currently arbitraging
+	GetProtoRevTokenPairArbRoutes(context.Context, *QueryGetProtoRevTokenPairArbRoutesRequest) (*QueryGetProtoRevTokenPairArbRoutesResponse, error)
+	// GetProtoRevAdminAccount queries the admin account of the module
+	GetProtoRevAdminAccount(context.Context, *QueryGetProtoRevAdminAccountRequest) (*QueryGetProtoRevAdminAccountResponse, error)
+	// GetProtoRevDeveloperAccount queries the developer account of the module
+	GetProtoRevDeveloperAccount(context.Context, *QueryGetProtoRevDeveloperAccountRequest) (*QueryGetProtoRevDeveloperAccountResponse, error)
+	// GetProtoRevPoolWeights queries the weights of each pool type currently
+	// being used by the module
+	GetProtoRevPoolWeights(context.Context, *QueryGetProtoRevPoolWeightsRequest) (*QueryGetProtoRevPoolWeightsResponse, error)
+	// GetProtoRevMaxPoolPointsPerTx queries the maximum number of pool points
+	// that can be consumed per transaction
+	GetProtoRevMaxPoolPointsPerTx(context.Context, *QueryGetProtoRevMaxPoolPointsPerTxRequest) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error)
+	// GetProtoRevMaxPoolPointsPerBlock queries the maximum number of pool points
+	// that can consumed per block
+	GetProtoRevMaxPoolPointsPerBlock(context.Context, *QueryGetProtoRevMaxPoolPointsPerBlockRequest) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error)
+	// GetProtoRevBaseDenoms queries the base denoms that the module is currently
+	// utilizing for arbitrage
+	GetProtoRevBaseDenoms(context.Context, *QueryGetProtoRevBaseDenomsRequest) (*QueryGetProtoRevBaseDenomsResponse, error)
+	// GetProtoRevEnabled queries whether the module is enabled or not
+	GetProtoRevEnabled(context.Context, *QueryGetProtoRevEnabledRequest) (*QueryGetProtoRevEnabledResponse, error)
+	// GetProtoRevMaxTradesPerBlock queries the maximum number of trades that can
+	// be executed per block
+	GetProtoRevMaxTradesPerBlock(context.Context, *QueryGetProtoRevMaxTradesPerBlockRequest) (*QueryGetProtoRevMaxTradesPerBlockResponse, error)
+	// GetProtoRevArbitrageStatus queries the height until which arbitrage is
+	// disabled and whether arbitrage is currently active
+	GetProtoRevArbitrageStatus(context.Context, *QueryGetProtoRevArbitrageStatusRequest) (*QueryGetProtoRevArbitrageStatusResponse, error)
+	// GetProtoRevMonitoredPools queries the ids of all pools that the module
+	// considers for arbitrage given the current base denoms and hot routes
+	GetProtoRevMonitoredPools(context.Context, *QueryGetProtoRevMonitoredPoolsRequest) (*QueryGetProtoRevMonitoredPoolsResponse, error)
+	// GetProtoRevPoolBlacklist queries the ids of the pools that must never be
+	// included in arbitrage routes
+	GetProtoRevPoolBlacklist(context.Context, *QueryGetProtoRevPoolBlacklistRequest) (*QueryGetProtoRevPoolBlacklistResponse, error)
+	// GetProtoRevCurrentArbitrageOpportunities runs the route search against
+	// the current state, without executing any trades, and returns the most
+	// profitable opportunities found within the pool point budget
+	GetProtoRevCurrentArbitrageOpportunities(context.Context, *QueryGetProtoRevCurrentArbitrageOpportunitiesRequest) (*QueryGetProtoRevCurrentArbitrageOpportunitiesResponse, error)
+	// GetProtoRevProfitSearchTrace reruns the search for the optimal input
+	// amount of a route, without executing any trades, and returns every input
+	// tried alongside the resulting profit
+	GetProtoRevProfitSearchTrace(context.Context, *QueryGetProtoRevProfitSearchTraceRequest) (*QueryGetProtoRevProfitSearchTraceResponse, error)
+	// GetProtoRevArbConfig queries the full arbitrage configuration and
+	// statistics of the module in a single response, so that off chain
+	// simulators can replay the module's behaviour
+	GetProtoRevArbConfig(context.Context, *QueryGetProtoRevArbConfigRequest) (*QueryGetProtoRevArbConfigResponse, error)
+	// GetProtoRevArbitrageGasConsumed queries the cumulative gas consumed by
+	// arbitrage backruns and the gas consumed in the current block
+	GetProtoRevArbitrageGasConsumed(context.Context, *QueryGetProtoRevArbitrageGasConsumedRequest) (*QueryGetProtoRevArbitrageGasConsumedResponse, error)
+	// GetProtoRevProfitSplit queries the fraction of profit currently allocated
+	// to the developer account and the resulting split of the module's
+	// accumulated profits between the developer account and the protocol
+	GetProtoRevProfitSplit(context.Context, *QueryGetProtoRevProfitSplitRequest) (*QueryGetProtoRevProfitSplitResponse, error)
+	// GetProtoRevDeveloperFeeShare queries the fraction of each trade's profit
+	// that is currently allocated to the developer account and whether it was
+	// set by governance
+	GetProtoRevDeveloperFeeShare(context.Context, *QueryGetProtoRevDeveloperFeeShareRequest) (*QueryGetProtoRevDeveloperFeeShareResponse, error)
+	// GetProtoRevProjectedProfitOnPriceShift estimates the arbitrage the module
+	// would capture if the spot price of a pool moved by the given percent,
+	// without executing any trades
+	GetProtoRevProjectedProfitOnPriceShift(context.Context, *QueryGetProtoRevProjectedProfitOnPriceShiftRequest) (*QueryGetProtoRevProjectedProfitOnPriceShiftResponse, error)
 }
 
-func (m *QueryGetProtoRevAdminAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryGetProtoRevAdminAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.AdminAccount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevNumberOfTrades(ctx context.Context, req *QueryGetProtoRevNumberOfTradesRequest) (*QueryGetProtoRevNumberOfTradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevNumberOfTrades not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevProfitsByDenom(ctx context.Context, req *QueryGetProtoRevProfitsByDenomRequest) (*QueryGetProtoRevProfitsByDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevProfitsByDenom not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevAllProfits(ctx context.Context, req *QueryGetProtoRevAllProfitsRequest) (*QueryGetProtoRevAllProfitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevAllProfits not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevTotalProfitInDenom(ctx context.Context, req *QueryGetProtoRevTotalProfitInDenomRequest) (*QueryGetProtoRevTotalProfitInDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevTotalProfitInDenom not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevStatisticsByRoute(ctx context.Context, req *QueryGetProtoRevStatisticsByRouteRequest) (*QueryGetProtoRevStatisticsByRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevStatisticsByRoute not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevAllRouteStatistics(ctx context.Context, req *QueryGetProtoRevAllRouteStatisticsRequest) (*QueryGetProtoRevAllRouteStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevAllRouteStatistics not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevTokenPairArbRoutes(ctx context.Context, req *QueryGetProtoRevTokenPairArbRoutesRequest) (*QueryGetProtoRevTokenPairArbRoutesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevTokenPairArbRoutes not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevAdminAccount(ctx context.Context, req *QueryGetProtoRevAdminAccountRequest) (*QueryGetProtoRevAdminAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevAdminAccount not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevDeveloperAccount(ctx context.Context, req *QueryGetProtoRevDeveloperAccountRequest) (*QueryGetProtoRevDeveloperAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevDeveloperAccount not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevPoolWeights(ctx context.Context, req *QueryGetProtoRevPoolWeightsRequest) (*QueryGetProtoRevPoolWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevPoolWeights not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevMaxPoolPointsPerTx(ctx context.Context, req *QueryGetProtoRevMaxPoolPointsPerTxRequest) (*QueryGetProtoRevMaxPoolPointsPerTxResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevMaxPoolPointsPerTx not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevMaxPoolPointsPerBlock(ctx context.Context, req *QueryGetProtoRevMaxPoolPointsPerBlockRequest) (*QueryGetProtoRevMaxPoolPointsPerBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevMaxPoolPointsPerBlock not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevBaseDenoms(ctx context.Context, req *QueryGetProtoRevBaseDenomsRequest) (*QueryGetProtoRevBaseDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevBaseDenoms not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevEnabled(ctx context.Context, req *QueryGetProtoRevEnabledRequest) (*QueryGetProtoRevEnabledResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevEnabled not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevMaxTradesPerBlock(ctx context.Context, req *QueryGetProtoRevMaxTradesPerBlockRequest) (*QueryGetProtoRevMaxTradesPerBlockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevMaxTradesPerBlock not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevArbitrageStatus(ctx context.Context, req *QueryGetProtoRevArbitrageStatusRequest) (*QueryGetProtoRevArbitrageStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevArbitrageStatus not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevMonitoredPools(ctx context.Context, req *QueryGetProtoRevMonitoredPoolsRequest) (*QueryGetProtoRevMonitoredPoolsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevMonitoredPools not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevPoolBlacklist(ctx context.Context, req *QueryGetProtoRevPoolBlacklistRequest) (*QueryGetProtoRevPoolBlacklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevPoolBlacklist not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevCurrentArbitrageOpportunities(ctx context.Context, req *QueryGetProtoRevCurrentArbitrageOpportunitiesRequest) (*QueryGetProtoRevCurrentArbitrageOpportunitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevCurrentArbitrageOpportunities not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevProfitSearchTrace(ctx context.Context, req *QueryGetProtoRevProfitSearchTraceRequest) (*QueryGetProtoRevProfitSearchTraceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevProfitSearchTrace not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevArbConfig(ctx context.Context, req *QueryGetProtoRevArbConfigRequest) (*QueryGetProtoRevArbConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevArbConfig not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevArbitrageGasConsumed(ctx context.Context, req *QueryGetProtoRevArbitrageGasConsumedRequest) (*QueryGetProtoRevArbitrageGasConsumedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevArbitrageGasConsumed not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevProfitSplit(ctx context.Context, req *QueryGetProtoRevProfitSplitRequest) (*QueryGetProtoRevProfitSplitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevProfitSplit not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevDeveloperFeeShare(ctx context.Context, req *QueryGetProtoRevDeveloperFeeShareRequest) (*QueryGetProtoRevDeveloperFeeShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevDeveloperFeeShare not implemented")
+}
+func (*UnimplementedQueryServer) GetProtoRevProjectedProfitOnPriceShift(ctx context.Context, req *QueryGetProtoRevProjectedProfitOnPriceShiftRequest) (*QueryGetProtoRevProjectedProfitOnPriceShiftResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProtoRevProjectedProfitOnPriceShift not implemented")
 }
 
-func (m *QueryGetProtoRevDeveloperAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryGetProtoRevDeveloperAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.DeveloperAccount)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return n
-}
-
-func (m *QueryGetProtoRevPoolWeightsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/Params",
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *QueryGetProtoRevPoolWeightsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
 	}
-	var l int
-	_ = l
-	l = m.PoolWeights.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetProtoRevNumberOfTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevNumberOfTradesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevNumberOfTrades(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevNumberOfTrades",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevNumberOfTrades(ctx, req.(*QueryGetProtoRevNumberOfTradesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetProtoRevProfitsByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevProfitsByDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.MaxPoolPointsPerBlock != 0 {
-		n += 1 + sovQuery(uint64(m.MaxPoolPointsPerBlock))
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevProfitsByDenom(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevProfitsByDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevProfitsByDenom(ctx, req.(*QueryGetProtoRevProfitsByDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetProtoRevAllProfits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevAllProfitsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevAllProfits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevAllProfits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevAllProfits(ctx, req.(*QueryGetProtoRevAllProfitsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetProtoRevTotalProfitInDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevTotalProfitInDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.MaxPoolPointsPerTx != 0 {
-		n += 1 + sovQuery(uint64(m.MaxPoolPointsPerTx))
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevTotalProfitInDenom(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevTotalProfitInDenom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevTotalProfitInDenom(ctx, req.(*QueryGetProtoRevTotalProfitInDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevBaseDenomsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetProtoRevStatisticsByRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevStatisticsByRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevStatisticsByRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevStatisticsByRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevStatisticsByRoute(ctx, req.(*QueryGetProtoRevStatisticsByRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevBaseDenomsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetProtoRevAllRouteStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevAllRouteStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.BaseDenoms) > 0 {
-		for _, e := range m.BaseDenoms {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevAllRouteStatistics(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevAllRouteStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevAllRouteStatistics(ctx, req.(*QueryGetProtoRevAllRouteStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevEnabledRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetProtoRevTokenPairArbRoutes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevTokenPairArbRoutesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevTokenPairArbRoutes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevTokenPairArbRoutes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevTokenPairArbRoutes(ctx, req.(*QueryGetProtoRevTokenPairArbRoutesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGetProtoRevEnabledResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_GetProtoRevAdminAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevAdminAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevAdminAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevAdminAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevAdminAccount(ctx, req.(*QueryGetProtoRevAdminAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevDeveloperAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevDeveloperAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevDeveloperAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevDeveloperAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevDeveloperAccount(ctx, req.(*QueryGetProtoRevDeveloperAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevPoolWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevPoolWeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevPoolWeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevPoolWeights",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevPoolWeights(ctx, req.(*QueryGetProtoRevPoolWeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevMaxPoolPointsPerTx_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevMaxPoolPointsPerTxRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerTx(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerTx",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerTx(ctx, req.(*QueryGetProtoRevMaxPoolPointsPerTxRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevMaxPoolPointsPerBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevMaxPoolPointsPerBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxPoolPointsPerBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevMaxPoolPointsPerBlock(ctx, req.(*QueryGetProtoRevMaxPoolPointsPerBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevBaseDenoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevBaseDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevBaseDenoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevBaseDenoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevBaseDenoms(ctx, req.(*QueryGetProtoRevBaseDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevEnabled",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevEnabled(ctx, req.(*QueryGetProtoRevEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevMaxTradesPerBlock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevMaxTradesPerBlockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevMaxTradesPerBlock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevMaxTradesPerBlock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevMaxTradesPerBlock(ctx, req.(*QueryGetProtoRevMaxTradesPerBlockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevArbitrageStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevArbitrageStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevArbitrageStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevArbitrageStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevArbitrageStatus(ctx, req.(*QueryGetProtoRevArbitrageStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevMonitoredPools_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevMonitoredPoolsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevMonitoredPools(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevMonitoredPools",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevMonitoredPools(ctx, req.(*QueryGetProtoRevMonitoredPoolsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevPoolBlacklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevPoolBlacklistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevPoolBlacklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevPoolBlacklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevPoolBlacklist(ctx, req.(*QueryGetProtoRevPoolBlacklistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevCurrentArbitrageOpportunities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevCurrentArbitrageOpportunitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevCurrentArbitrageOpportunities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevCurrentArbitrageOpportunities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevCurrentArbitrageOpportunities(ctx, req.(*QueryGetProtoRevCurrentArbitrageOpportunitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevProfitSearchTrace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevProfitSearchTraceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevProfitSearchTrace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevProfitSearchTrace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevProfitSearchTrace(ctx, req.(*QueryGetProtoRevProfitSearchTraceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevArbConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevArbConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevArbConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevArbConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevArbConfig(ctx, req.(*QueryGetProtoRevArbConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevArbitrageGasConsumed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevArbitrageGasConsumedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevArbitrageGasConsumed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevArbitrageGasConsumed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevArbitrageGasConsumed(ctx, req.(*QueryGetProtoRevArbitrageGasConsumedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevProfitSplit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevProfitSplitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevProfitSplit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevProfitSplit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevProfitSplit(ctx, req.(*QueryGetProtoRevProfitSplitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevDeveloperFeeShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevDeveloperFeeShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevDeveloperFeeShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevDeveloperFeeShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevDeveloperFeeShare(ctx, req.(*QueryGetProtoRevDeveloperFeeShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetProtoRevProjectedProfitOnPriceShift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetProtoRevProjectedProfitOnPriceShiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetProtoRevProjectedProfitOnPriceShift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Query/GetProtoRevProjectedProfitOnPriceShift",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetProtoRevProjectedProfitOnPriceShift(ctx, req.(*QueryGetProtoRevProjectedProfitOnPriceShiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.protorev.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetProtoRevNumberOfTrades",
+			Handler:    _Query_GetProtoRevNumberOfTrades_Handler,
+		},
+		{
+			MethodName: "GetProtoRevProfitsByDenom",
+			Handler:    _Query_GetProtoRevProfitsByDenom_Handler,
+		},
+		{
+			MethodName: "GetProtoRevAllProfits",
+			Handler:    _Query_GetProtoRevAllProfits_Handler,
+		},
+		{
+			MethodName: "GetProtoRevTotalProfitInDenom",
+			Handler:    _Query_GetProtoRevTotalProfitInDenom_Handler,
+		},
+		{
+			MethodName: "GetProtoRevStatisticsByRoute",
+			Handler:    _Query_GetProtoRevStatisticsByRoute_Handler,
+		},
+		{
+			MethodName: "GetProtoRevAllRouteStatistics",
+			Handler:    _Query_GetProtoRevAllRouteStatistics_Handler,
+		},
+		{
+			MethodName: "GetProtoRevTokenPairArbRoutes",
+			Handler:    _Query_GetProtoRevTokenPairArbRoutes_Handler,
+		},
+		{
+			MethodName: "GetProtoRevAdminAccount",
+			Handler:    _Query_GetProtoRevAdminAccount_Handler,
+		},
+		{
+			MethodName: "GetProtoRevDeveloperAccount",
+			Handler:    _Query_GetProtoRevDeveloperAccount_Handler,
+		},
+		{
+			MethodName: "GetProtoRevPoolWeights",
+			Handler:    _Query_GetProtoRevPoolWeights_Handler,
+		},
+		{
+			MethodName: "GetProtoRevMaxPoolPointsPerTx",
+			Handler:    _Query_GetProtoRevMaxPoolPointsPerTx_Handler,
+		},
+		{
+			MethodName: "GetProtoRevMaxPoolPointsPerBlock",
+			Handler:    _Query_GetProtoRevMaxPoolPointsPerBlock_Handler,
+		},
+		{
+			MethodName: "GetProtoRevBaseDenoms",
+			Handler:    _Query_GetProtoRevBaseDenoms_Handler,
+		},
+		{
+			MethodName: "GetProtoRevEnabled",
+			Handler:    _Query_GetProtoRevEnabled_Handler,
+		},
+		{
+			MethodName: "GetProtoRevMaxTradesPerBlock",
+			Handler:    _Query_GetProtoRevMaxTradesPerBlock_Handler,
+		},
+		{
+			MethodName: "GetProtoRevArbitrageStatus",
+			Handler:    _Query_GetProtoRevArbitrageStatus_Handler,
+		},
+		{
+			MethodName: "GetProtoRevMonitoredPools",
+			Handler:    _Query_GetProtoRevMonitoredPools_Handler,
+		},
+		{
+			MethodName: "GetProtoRevPoolBlacklist",
+			Handler:    _Query_GetProtoRevPoolBlacklist_Handler,
+		},
+		{
+			MethodName: "GetProtoRevCurrentArbitrageOpportunities",
+			Handler:    _Query_GetProtoRevCurrentArbitrageOpportunities_Handler,
+		},
+		{
+			MethodName: "GetProtoRevProfitSearchTrace",
+			Handler:    _Query_GetProtoRevProfitSearchTrace_Handler,
+		},
+		{
+			MethodName: "GetProtoRevArbConfig",
+			Handler:    _Query_GetProtoRevArbConfig_Handler,
+		},
+		{
+			MethodName: "GetProtoRevArbitrageGasConsumed",
+			Handler:    _Query_GetProtoRevArbitrageGasConsumed_Handler,
+		},
+		{
+			MethodName: "GetProtoRevProfitSplit",
+			Handler:    _Query_GetProtoRevProfitSplit_Handler,
+		},
+		{
+			MethodName: "GetProtoRevDeveloperFeeShare",
+			Handler:    _Query_GetProtoRevDeveloperFeeShare_Handler,
+		},
+		{
+			MethodName: "GetProtoRevProjectedProfitOnPriceShift",
+			Handler:    _Query_GetProtoRevProjectedProfitOnPriceShift_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/protorev/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevNumberOfTradesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevNumberOfTradesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevNumberOfTradesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevNumberOfTradesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevNumberOfTradesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevNumberOfTradesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NumberOfTrades.Size()
+		i -= size
+		if _, err := m.NumberOfTrades.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevProfitsByDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevProfitsByDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevProfitsByDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevProfitsByDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevProfitsByDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevProfitsByDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Profit != nil {
+		{
+			size, err := m.Profit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevAllProfitsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevAllProfitsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevAllProfitsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevAllProfitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevAllProfitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevAllProfitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Profits) > 0 {
+		for iNdEx := len(m.Profits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Profits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevTotalProfitInDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevTotalProfitInDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevTotalProfitInDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetDenom) > 0 {
+		i -= len(m.TargetDenom)
+		copy(dAtA[i:], m.TargetDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TargetDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevTotalProfitInDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevTotalProfitInDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevTotalProfitInDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Valuations) > 0 {
+		for iNdEx := len(m.Valuations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Valuations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevStatisticsByRouteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevStatisticsByRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevStatisticsByRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Route) > 0 {
+		dAtA5 := make([]byte, len(m.Route)*10)
+		var j4 int
+		for _, num := range m.Route {
+			for num >= 1<<7 {
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j4++
+			}
+			dAtA5[j4] = uint8(num)
+			j4++
+		}
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintQuery(dAtA, i, uint64(j4))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevStatisticsByRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevStatisticsByRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevStatisticsByRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Statistics.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevAllRouteStatisticsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevAllRouteStatisticsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevAllRouteStatisticsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevAllRouteStatisticsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevAllRouteStatisticsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevAllRouteStatisticsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Statistics) > 0 {
+		for iNdEx := len(m.Statistics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statistics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevTokenPairArbRoutesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevTokenPairArbRoutesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevTokenPairArbRoutesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevTokenPairArbRoutesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevAdminAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevAdminAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevAdminAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevAdminAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevAdminAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevAdminAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AdminAccount) > 0 {
+		i -= len(m.AdminAccount)
+		copy(dAtA[i:], m.AdminAccount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AdminAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevDeveloperAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevDeveloperAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevDeveloperAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevDeveloperAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevDeveloperAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevDeveloperAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeveloperAccount) > 0 {
+		i -= len(m.DeveloperAccount)
+		copy(dAtA[i:], m.DeveloperAccount)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DeveloperAccount)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevPoolWeightsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevPoolWeightsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevPoolWeightsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevPoolWeightsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevPoolWeightsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevPoolWeightsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PoolWeights.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPoolPointsPerBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxPoolPointsPerBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPoolPointsPerTx != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxPoolPointsPerTx))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevBaseDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevBaseDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevBaseDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevBaseDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevBaseDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevBaseDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BaseDenoms) > 0 {
+		for iNdEx := len(m.BaseDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BaseDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevEnabledRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevEnabledRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevEnabledRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevEnabledResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevEnabledResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevEnabledResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevMaxTradesPerBlockRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevMaxTradesPerBlockRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevMaxTradesPerBlockRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevMaxTradesPerBlockResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevMaxTradesPerBlockResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevMaxTradesPerBlockResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxTradesPerBlock != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxTradesPerBlock))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevArbitrageStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevArbitrageStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevArbitrageStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevArbitrageStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevArbitrageStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevArbitrageStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.DisabledUntilHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DisabledUntilHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevMonitoredPoolsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevMonitoredPoolsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevMonitoredPoolsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevMonitoredPoolsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevMonitoredPoolsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevMonitoredPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolIds) > 0 {
+		dAtA9 := make([]byte, len(m.PoolIds)*10)
+		var j8 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintQuery(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevPoolBlacklistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevPoolBlacklistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevPoolBlacklistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevPoolBlacklistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevPoolBlacklistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevPoolBlacklistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolIds) > 0 {
+		dAtA11 := make([]byte, len(m.PoolIds)*10)
+		var j10 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintQuery(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevCurrentArbitrageOpportunitiesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevCurrentArbitrageOpportunitiesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevCurrentArbitrageOpportunitiesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevCurrentArbitrageOpportunitiesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevCurrentArbitrageOpportunitiesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevCurrentArbitrageOpportunitiesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Opportunities) > 0 {
+		for iNdEx := len(m.Opportunities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Opportunities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevProfitSearchTraceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevProfitSearchTraceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevProfitSearchTraceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InputDenom) > 0 {
+		i -= len(m.InputDenom)
+		copy(dAtA[i:], m.InputDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.InputDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Route) > 0 {
+		dAtA13 := make([]byte, len(m.Route)*10)
+		var j12 int
+		for _, num := range m.Route {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintQuery(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevProfitSearchTraceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevProfitSearchTraceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevProfitSearchTraceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Profit.Size()
+		i -= size
+		if _, err := m.Profit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Input.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArbConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArbConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArbConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NumberOfTrades.Size()
+		i -= size
+		if _, err := m.NumberOfTrades.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Profits) > 0 {
+		for iNdEx := len(m.Profits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Profits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RouteStatistics) > 0 {
+		for iNdEx := len(m.RouteStatistics) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RouteStatistics[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Genesis.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevArbConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevArbConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevArbConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevArbConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevArbConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevArbConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ArbConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevArbitrageGasConsumedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevArbitrageGasConsumedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevArbitrageGasConsumedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevArbitrageGasConsumedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevArbitrageGasConsumedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevArbitrageGasConsumedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockGasConsumed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockGasConsumed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TotalGasConsumed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalGasConsumed))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevProfitSplitRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevProfitSplitRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevProfitSplitRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevProfitSplitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevProfitSplitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevProfitSplitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProtocolProfits) > 0 {
+		for iNdEx := len(m.ProtocolProfits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProtocolProfits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.DeveloperProfits) > 0 {
+		for iNdEx := len(m.DeveloperProfits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DeveloperProfits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size := m.DeveloperFeeFraction.Size()
+		i -= size
+		if _, err := m.DeveloperFeeFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevDeveloperFeeShareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevDeveloperFeeShareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevDeveloperFeeShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevDeveloperFeeShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevDeveloperFeeShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevDeveloperFeeShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SetByGovernance {
+		i--
+		if m.SetByGovernance {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.DeveloperFeeShare.Size()
+		i -= size
+		if _, err := m.DeveloperFeeShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevProjectedProfitOnPriceShiftRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevProjectedProfitOnPriceShiftRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevProjectedProfitOnPriceShiftRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PriceShiftPercent.Size()
+		i -= size
+		if _, err := m.PriceShiftPercent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetProtoRevProjectedProfitOnPriceShiftResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetProtoRevProjectedProfitOnPriceShiftResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetProtoRevProjectedProfitOnPriceShiftResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SpotPriceAfter.Size()
+		i -= size
+		if _, err := m.SpotPriceAfter.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SpotPriceBefore.Size()
+		i -= size
+		if _, err := m.SpotPriceBefore.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TotalProfit) > 0 {
+		for iNdEx := len(m.TotalProfit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalProfit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Opportunities) > 0 {
+		for iNdEx := len(m.Opportunities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Opportunities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProtoRevNumberOfTradesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevNumberOfTradesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.NumberOfTrades.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProtoRevProfitsByDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevProfitsByDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Profit != nil {
+		l = m.Profit.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevAllProfitsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevAllProfitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Profits) > 0 {
+		for _, e := range m.Profits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevTotalProfitInDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TargetDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevTotalProfitInDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Total.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Valuations) > 0 {
+		for _, e := range m.Valuations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevStatisticsByRouteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Route) > 0 {
+		l = 0
+		for _, e := range m.Route {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevStatisticsByRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Statistics.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProtoRevAllRouteStatisticsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevAllRouteStatisticsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Statistics) > 0 {
+		for _, e := range m.Statistics {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevAdminAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevAdminAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.AdminAccount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevDeveloperAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevDeveloperAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DeveloperAccount)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevPoolWeightsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevPoolWeightsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PoolWeights.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxPoolPointsPerBlock != 0 {
+		n += 1 + sovQuery(uint64(m.MaxPoolPointsPerBlock))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxPoolPointsPerTx != 0 {
+		n += 1 + sovQuery(uint64(m.MaxPoolPointsPerTx))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevBaseDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevBaseDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BaseDenoms) > 0 {
+		for _, e := range m.BaseDenoms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevEnabledRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevEnabledResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevMaxTradesPerBlockRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevMaxTradesPerBlockResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxTradesPerBlock != 0 {
+		n += 1 + sovQuery(uint64(m.MaxTradesPerBlock))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevArbitrageStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevArbitrageStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DisabledUntilHeight != 0 {
+		n += 1 + sovQuery(uint64(m.DisabledUntilHeight))
+	}
+	if m.Active {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevMonitoredPoolsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevMonitoredPoolsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevPoolBlacklistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevPoolBlacklistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevCurrentArbitrageOpportunitiesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevCurrentArbitrageOpportunitiesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Opportunities) > 0 {
+		for _, e := range m.Opportunities {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevProfitSearchTraceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Route) > 0 {
+		l = 0
+		for _, e := range m.Route {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	l = len(m.InputDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevProfitSearchTraceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.Input.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Profit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ArbConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Genesis.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.RouteStatistics) > 0 {
+		for _, e := range m.RouteStatistics {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Profits) > 0 {
+		for _, e := range m.Profits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.NumberOfTrades.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProtoRevArbConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevArbConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ArbConfig.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProtoRevArbitrageGasConsumedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevArbitrageGasConsumedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TotalGasConsumed != 0 {
+		n += 1 + sovQuery(uint64(m.TotalGasConsumed))
+	}
+	if m.BlockGasConsumed != 0 {
+		n += 1 + sovQuery(uint64(m.BlockGasConsumed))
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevProfitSplitRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevProfitSplitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DeveloperFeeFraction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.DeveloperProfits) > 0 {
+		for _, e := range m.DeveloperProfits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ProtocolProfits) > 0 {
+		for _, e := range m.ProtocolProfits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevDeveloperFeeShareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryGetProtoRevDeveloperFeeShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DeveloperFeeShare.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.SetByGovernance {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryGetProtoRevProjectedProfitOnPriceShiftRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	l = m.PriceShiftPercent.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetProtoRevProjectedProfitOnPriceShiftResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Opportunities) > 0 {
+		for _, e := range m.Opportunities {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalProfit) > 0 {
+		for _, e := range m.TotalProfit {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.SpotPriceBefore.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.SpotPriceAfter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevNumberOfTradesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevNumberOfTradesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfTrades", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NumberOfTrades.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevProfitsByDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevProfitsByDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Profit == nil {
+				m.Profit = &types.Coin{}
+			}
+			if err := m.Profit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevAllProfitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevAllProfitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Profits = append(m.Profits, types.Coin{})
+			if err := m.Profits[len(m.Profits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevTotalProfitInDenomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevTotalProfitInDenomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevTotalProfitInDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevTotalProfitInDenomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevTotalProfitInDenomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevTotalProfitInDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valuations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Valuations = append(m.Valuations, ProfitValuation{})
+			if err := m.Valuations[len(m.Valuations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevStatisticsByRouteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Route = append(m.Route, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Route) == 0 {
+					m.Route = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Route = append(m.Route, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevStatisticsByRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Statistics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevAllRouteStatisticsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevAllRouteStatisticsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statistics = append(m.Statistics, RouteStatistics{})
+			if err := m.Statistics[len(m.Statistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, TokenPairArbRoutes{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevAdminAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevAdminAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevDeveloperAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevDeveloperAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperAccount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeveloperAccount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevPoolWeightsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevPoolWeightsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevPoolWeightsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevPoolWeightsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevPoolWeightsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevPoolWeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PoolWeights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	if m.Enabled {
-		n += 2
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolPointsPerBlock", wireType)
+			}
+			m.MaxPoolPointsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPoolPointsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3172,10 +8098,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3199,7 +8125,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3222,17 +8148,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolPointsPerTx", wireType)
 			}
-			var msglen int
+			m.MaxPoolPointsPerTx = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3242,25 +8168,11 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxPoolPointsPerTx |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3282,7 +8194,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevNumberOfTradesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevBaseDenomsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3305,10 +8217,10 @@ func (m *QueryGetProtoRevNumberOfTradesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3332,7 +8244,7 @@ func (m *QueryGetProtoRevNumberOfTradesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevNumberOfTradesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevBaseDenomsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3355,17 +8267,17 @@ func (m *QueryGetProtoRevNumberOfTradesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevNumberOfTradesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfTrades", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenoms", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3375,23 +8287,23 @@ func (m *QueryGetProtoRevNumberOfTradesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NumberOfTrades.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BaseDenoms = append(m.BaseDenoms, BaseDenom{})
+			if err := m.BaseDenoms[len(m.BaseDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3416,7 +8328,7 @@ func (m *QueryGetProtoRevNumberOfTradesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevProfitsByDenomRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevEnabledRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3439,17 +8351,67 @@ func (m *QueryGetProtoRevProfitsByDenomRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevEnabledRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevEnabledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevEnabledResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevEnabledResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3459,24 +8421,62 @@ func (m *QueryGetProtoRevProfitsByDenomRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevMaxTradesPerBlockRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevMaxTradesPerBlockRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevMaxTradesPerBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3498,7 +8498,7 @@ func (m *QueryGetProtoRevProfitsByDenomRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevProfitsByDenomResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevMaxTradesPerBlockResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3521,17 +8521,17 @@ func (m *QueryGetProtoRevProfitsByDenomResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevMaxTradesPerBlockResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevProfitsByDenomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevMaxTradesPerBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profit", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTradesPerBlock", wireType)
 			}
-			var msglen int
+			m.MaxTradesPerBlock = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3541,28 +8541,11 @@ func (m *QueryGetProtoRevProfitsByDenomResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxTradesPerBlock |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Profit == nil {
-				m.Profit = &types.Coin{}
-			}
-			if err := m.Profit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3584,7 +8567,7 @@ func (m *QueryGetProtoRevProfitsByDenomResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAllProfitsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevArbitrageStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3607,10 +8590,10 @@ func (m *QueryGetProtoRevAllProfitsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevArbitrageStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevArbitrageStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3634,7 +8617,7 @@ func (m *QueryGetProtoRevAllProfitsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAllProfitsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevArbitrageStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3657,17 +8640,17 @@ func (m *QueryGetProtoRevAllProfitsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevArbitrageStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllProfitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevArbitrageStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Profits", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledUntilHeight", wireType)
 			}
-			var msglen int
+			m.DisabledUntilHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3677,26 +8660,81 @@ func (m *QueryGetProtoRevAllProfitsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.DisabledUntilHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Profits = append(m.Profits, types.Coin{})
-			if err := m.Profits[len(m.Profits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevMonitoredPoolsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			iNdEx = postIndex
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevMonitoredPoolsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevMonitoredPoolsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3718,7 +8756,7 @@ func (m *QueryGetProtoRevAllProfitsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevStatisticsByRouteRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevMonitoredPoolsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3741,10 +8779,10 @@ func (m *QueryGetProtoRevStatisticsByRouteRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevMonitoredPoolsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevMonitoredPoolsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3764,7 +8802,7 @@ func (m *QueryGetProtoRevStatisticsByRouteRequest) Unmarshal(dAtA []byte) error
 						break
 					}
 				}
-				m.Route = append(m.Route, v)
+				m.PoolIds = append(m.PoolIds, v)
 			} else if wireType == 2 {
 				var packedLen int
 				for shift := uint(0); ; shift += 7 {
@@ -3799,8 +8837,8 @@ func (m *QueryGetProtoRevStatisticsByRouteRequest) Unmarshal(dAtA []byte) error
 					}
 				}
 				elementCount = count
-				if elementCount != 0 && len(m.Route) == 0 {
-					m.Route = make([]uint64, 0, elementCount)
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
 				}
 				for iNdEx < postIndex {
 					var v uint64
@@ -3818,10 +8856,10 @@ func (m *QueryGetProtoRevStatisticsByRouteRequest) Unmarshal(dAtA []byte) error
 							break
 						}
 					}
-					m.Route = append(m.Route, v)
+					m.PoolIds = append(m.PoolIds, v)
 				}
 			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -3844,7 +8882,7 @@ func (m *QueryGetProtoRevStatisticsByRouteRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevStatisticsByRouteResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevPoolBlacklistRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3867,45 +8905,138 @@ func (m *QueryGetProtoRevStatisticsByRouteResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevPoolBlacklistRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevStatisticsByRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevPoolBlacklistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetProtoRevPoolBlacklistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Statistics.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevPoolBlacklistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevPoolBlacklistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3927,7 +9058,7 @@ func (m *QueryGetProtoRevStatisticsByRouteResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAllRouteStatisticsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevCurrentArbitrageOpportunitiesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3950,12 +9081,31 @@ func (m *QueryGetProtoRevAllRouteStatisticsRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevCurrentArbitrageOpportunitiesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevCurrentArbitrageOpportunitiesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3977,7 +9127,7 @@ func (m *QueryGetProtoRevAllRouteStatisticsRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevAllRouteStatisticsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevCurrentArbitrageOpportunitiesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4000,15 +9150,15 @@ func (m *QueryGetProtoRevAllRouteStatisticsResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevCurrentArbitrageOpportunitiesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAllRouteStatisticsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevCurrentArbitrageOpportunitiesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Statistics", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Opportunities", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4035,8 +9185,8 @@ func (m *QueryGetProtoRevAllRouteStatisticsResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Statistics = append(m.Statistics, RouteStatistics{})
-			if err := m.Statistics[len(m.Statistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Opportunities = append(m.Opportunities, ArbitrageOpportunity{})
+			if err := m.Opportunities[len(m.Opportunities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4061,7 +9211,7 @@ func (m *QueryGetProtoRevAllRouteStatisticsResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevProfitSearchTraceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4084,12 +9234,120 @@ func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevProfitSearchTraceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevProfitSearchTraceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Route = append(m.Route, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Route) == 0 {
+					m.Route = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Route = append(m.Route, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Route", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InputDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InputDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4111,7 +9369,7 @@ func (m *QueryGetProtoRevTokenPairArbRoutesRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevProfitSearchTraceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4134,15 +9392,15 @@ func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Unmarshal(dAtA []byte) erro
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevProfitSearchTraceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevTokenPairArbRoutesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevProfitSearchTraceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4169,114 +9427,47 @@ func (m *QueryGetProtoRevTokenPairArbRoutesResponse) Unmarshal(dAtA []byte) erro
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, TokenPairArbRoutes{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Steps = append(m.Steps, ProfitSearchStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetProtoRevAdminAccountRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetProtoRevAdminAccountResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevAdminAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Input.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdminAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Profit", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4304,7 +9495,9 @@ func (m *QueryGetProtoRevAdminAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AdminAccount = string(dAtA[iNdEx:postIndex])
+			if err := m.Profit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4327,7 +9520,7 @@ func (m *QueryGetProtoRevAdminAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevDeveloperAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *ArbConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4350,65 +9543,116 @@ func (m *QueryGetProtoRevDeveloperAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ArbConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ArbConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Genesis", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Genesis.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RouteStatistics", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryGetProtoRevDeveloperAccountResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			m.RouteStatistics = append(m.RouteStatistics, RouteStatistics{})
+			if err := m.RouteStatistics[len(m.RouteStatistics)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Profits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Profits = append(m.Profits, types.Coin{})
+			if err := m.Profits[len(m.Profits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevDeveloperAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperAccount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NumberOfTrades", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4436,7 +9680,9 @@ func (m *QueryGetProtoRevDeveloperAccountResponse) Unmarshal(dAtA []byte) error
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DeveloperAccount = string(dAtA[iNdEx:postIndex])
+			if err := m.NumberOfTrades.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4459,7 +9705,7 @@ func (m *QueryGetProtoRevDeveloperAccountResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevPoolWeightsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevArbConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4482,10 +9728,10 @@ func (m *QueryGetProtoRevPoolWeightsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevPoolWeightsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevArbConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevPoolWeightsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevArbConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4509,7 +9755,7 @@ func (m *QueryGetProtoRevPoolWeightsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevPoolWeightsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevArbConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4532,15 +9778,15 @@ func (m *QueryGetProtoRevPoolWeightsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevPoolWeightsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevArbConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevPoolWeightsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevArbConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolWeights", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArbConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4567,7 +9813,7 @@ func (m *QueryGetProtoRevPoolWeightsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.PoolWeights.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ArbConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4592,7 +9838,7 @@ func (m *QueryGetProtoRevPoolWeightsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevArbitrageGasConsumedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4615,10 +9861,10 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Unmarshal(dAtA []byte) er
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevArbitrageGasConsumedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevArbitrageGasConsumedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4642,7 +9888,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockRequest) Unmarshal(dAtA []byte) er
 	}
 	return nil
 }
-func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevArbitrageGasConsumedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4665,17 +9911,17 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Unmarshal(dAtA []byte) e
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevArbitrageGasConsumedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerBlockResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevArbitrageGasConsumedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolPointsPerBlock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalGasConsumed", wireType)
 			}
-			m.MaxPoolPointsPerBlock = 0
+			m.TotalGasConsumed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4685,7 +9931,26 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Unmarshal(dAtA []byte) e
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxPoolPointsPerBlock |= uint64(b&0x7F) << shift
+				m.TotalGasConsumed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasConsumed", wireType)
+			}
+			m.BlockGasConsumed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockGasConsumed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4711,7 +9976,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerBlockResponse) Unmarshal(dAtA []byte) e
 	}
 	return nil
 }
-func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevProfitSplitRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4734,10 +9999,10 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevProfitSplitRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevProfitSplitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4761,7 +10026,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxRequest) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevProfitSplitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4771,30 +10036,98 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) erro
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetProtoRevProfitSplitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetProtoRevProfitSplitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperFeeFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DeveloperFeeFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperProfits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DeveloperProfits = append(m.DeveloperProfits, types.Coin{})
+			if err := m.DeveloperProfits[len(m.DeveloperProfits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevMaxPoolPointsPerTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxPoolPointsPerTx", wireType)
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolProfits", wireType)
 			}
-			m.MaxPoolPointsPerTx = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4804,11 +10137,26 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) erro
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxPoolPointsPerTx |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolProfits = append(m.ProtocolProfits, types.Coin{})
+			if err := m.ProtocolProfits[len(m.ProtocolProfits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4830,7 +10178,7 @@ func (m *QueryGetProtoRevMaxPoolPointsPerTxResponse) Unmarshal(dAtA []byte) erro
 	}
 	return nil
 }
-func (m *QueryGetProtoRevBaseDenomsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevDeveloperFeeShareRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4853,10 +10201,10 @@ func (m *QueryGetProtoRevBaseDenomsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevDeveloperFeeShareRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevDeveloperFeeShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4880,7 +10228,7 @@ func (m *QueryGetProtoRevBaseDenomsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevBaseDenomsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevDeveloperFeeShareResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4903,17 +10251,17 @@ func (m *QueryGetProtoRevBaseDenomsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevDeveloperFeeShareResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevBaseDenomsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevDeveloperFeeShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenoms", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeveloperFeeShare", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4923,26 +10271,46 @@ func (m *QueryGetProtoRevBaseDenomsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenoms = append(m.BaseDenoms, BaseDenom{})
-			if err := m.BaseDenoms[len(m.BaseDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DeveloperFeeShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetByGovernance", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SetByGovernance = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4964,7 +10332,7 @@ func (m *QueryGetProtoRevBaseDenomsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevEnabledRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevProjectedProfitOnPriceShiftRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4987,12 +10355,65 @@ func (m *QueryGetProtoRevEnabledRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevEnabledRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevProjectedProfitOnPriceShiftRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevEnabledRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevProjectedProfitOnPriceShiftRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceShiftPercent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceShiftPercent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5014,7 +10435,7 @@ func (m *QueryGetProtoRevEnabledRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetProtoRevEnabledResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetProtoRevProjectedProfitOnPriceShiftResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5037,17 +10458,17 @@ func (m *QueryGetProtoRevEnabledResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetProtoRevEnabledResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetProtoRevProjectedProfitOnPriceShiftResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetProtoRevEnabledResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetProtoRevProjectedProfitOnPriceShiftResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Opportunities", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5057,12 +10478,128 @@ func (m *QueryGetProtoRevEnabledResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.Enabled = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Opportunities = append(m.Opportunities, ArbitrageOpportunity{})
+			if err := m.Opportunities[len(m.Opportunities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalProfit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalProfit = append(m.TotalProfit, types.Coin{})
+			if err := m.TotalProfit[len(m.TotalProfit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPriceBefore", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpotPriceBefore.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpotPriceAfter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SpotPriceAfter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2,8 +2,6 @@ package types
 
 import (
 	"fmt"
-
-	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // Creates a new TokenPairArbRoutes object
@@ -53,8 +51,22 @@ func (tp *TokenPairArbRoutes) Validate() error {
 
 	// Iterate through all of the possible routes for this pool
 	for _, route := range tp.ArbRoutes {
-		if route.StepSize.IsNil() || route.StepSize.LT(sdk.OneInt()) {
-			return fmt.Errorf("step size must be greater than 0")
+		// The step size is optional, if it is unset the step size of the route's base denom is used
+		if route.HasStepSize() && route.StepSize.IsNegative() {
+			return fmt.Errorf("step size cannot be negative")
+		}
+
+		// The max input amount is optional, but if it is set it must allow at least one step
+		if route.MaxInputAmount != nil && (route.MaxInputAmount.IsNil() || !route.MaxInputAmount.IsPositive()) {
+			return fmt.Errorf("max input amount must be positive if set")
+		}
+		if route.MaxInputAmount != nil && route.HasStepSize() && route.MaxInputAmount.LT(route.StepSize) {
+			return fmt.Errorf("max input amount must be at least the step size if set")
+		}
+
+		// The min profit threshold is optional, but if it is set it cannot be negative
+		if route.MinProfitThreshold != nil && (route.MinProfitThreshold.IsNil() || route.MinProfitThreshold.IsNegative()) {
+			return fmt.Errorf("min profit threshold cannot be negative if set")
 		}
 
 		// Validate that the route is valid
@@ -131,6 +143,12 @@ func NewRoutes(trades []Trade) Route {
 	}
 }
 
+// HasStepSize returns true if the route sets its own step size. Otherwise, the binary search uses the step size
+// of the route's base denom, i.e. the denom the route starts and ends with.
+func (r Route) HasStepSize() bool {
+	return !r.StepSize.IsNil() && !r.StepSize.IsZero()
+}
+
 func NewTrade(pool uint64, tokenA, tokenB string) Trade {
 	return Trade{
 		Pool:     pool,
@@ -590,6 +590,99 @@ func (m *MsgSetBaseDenomsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgSetBaseDenomsResponse proto.InternalMessageInfo
 
+// MsgSetPoolBlacklist defines the Msg/SetPoolBlacklist request type.
+type MsgSetPoolBlacklist struct {
+	// admin is the account that is authorized to set the pool blacklist.
+	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// pool_ids is the list of ids of the pools that must never be included in
+	// arbitrage routes.
+	PoolIds []uint64 `protobuf:"varint,2,rep,packed,name=pool_ids,json=poolIds,proto3" json:"pool_ids,omitempty" yaml:"pool_ids"`
+}
+
+func (m *MsgSetPoolBlacklist) Reset()         { *m = MsgSetPoolBlacklist{} }
+func (m *MsgSetPoolBlacklist) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPoolBlacklist) ProtoMessage()    {}
+func (*MsgSetPoolBlacklist) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2783dce032fc6954, []int{12}
+}
+func (m *MsgSetPoolBlacklist) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPoolBlacklist) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPoolBlacklist.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPoolBlacklist) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPoolBlacklist.Merge(m, src)
+}
+func (m *MsgSetPoolBlacklist) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPoolBlacklist) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPoolBlacklist.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPoolBlacklist proto.InternalMessageInfo
+
+func (m *MsgSetPoolBlacklist) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *MsgSetPoolBlacklist) GetPoolIds() []uint64 {
+	if m != nil {
+		return m.PoolIds
+	}
+	return nil
+}
+
+// MsgSetPoolBlacklistResponse defines the Msg/SetPoolBlacklist response type.
+type MsgSetPoolBlacklistResponse struct {
+}
+
+func (m *MsgSetPoolBlacklistResponse) Reset()         { *m = MsgSetPoolBlacklistResponse{} }
+func (m *MsgSetPoolBlacklistResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPoolBlacklistResponse) ProtoMessage()    {}
+func (*MsgSetPoolBlacklistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2783dce032fc6954, []int{13}
+}
+func (m *MsgSetPoolBlacklistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPoolBlacklistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPoolBlacklistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPoolBlacklistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPoolBlacklistResponse.Merge(m, src)
+}
+func (m *MsgSetPoolBlacklistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPoolBlacklistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPoolBlacklistResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPoolBlacklistResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetHotRoutes)(nil), "osmosis.protorev.v1beta1.MsgSetHotRoutes")
 	proto.RegisterType((*MsgSetHotRoutesResponse)(nil), "osmosis.protorev.v1beta1.MsgSetHotRoutesResponse")
@@ -603,64 +696,71 @@ func init() {
 	proto.RegisterType((*MsgSetMaxPoolPointsPerBlockResponse)(nil), "osmosis.protorev.v1beta1.MsgSetMaxPoolPointsPerBlockResponse")
 	proto.RegisterType((*MsgSetBaseDenoms)(nil), "osmosis.protorev.v1beta1.MsgSetBaseDenoms")
 	proto.RegisterType((*MsgSetBaseDenomsResponse)(nil), "osmosis.protorev.v1beta1.MsgSetBaseDenomsResponse")
+	proto.RegisterType((*MsgSetPoolBlacklist)(nil), "osmosis.protorev.v1beta1.MsgSetPoolBlacklist")
+	proto.RegisterType((*MsgSetPoolBlacklistResponse)(nil), "osmosis.protorev.v1beta1.MsgSetPoolBlacklistResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/protorev/v1beta1/tx.proto", fileDescriptor_2783dce032fc6954) }
 
 var fileDescriptor_2783dce032fc6954 = []byte{
-	// 819 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0xcf, 0x6e, 0xeb, 0x44,
-	0x14, 0xc6, 0x33, 0xbd, 0x80, 0xb8, 0x93, 0x02, 0xb7, 0xbe, 0x6d, 0x49, 0xdc, 0x92, 0xa4, 0xd3,
-	0x7f, 0x81, 0x36, 0x71, 0x93, 0xb4, 0x52, 0x55, 0x04, 0x52, 0xad, 0x2e, 0x60, 0x51, 0x14, 0xb9,
-	0x45, 0x48, 0x2c, 0x30, 0x76, 0x32, 0x38, 0x56, 0x63, 0x8f, 0xe5, 0x99, 0x86, 0x74, 0xcb, 0x13,
-	0x20, 0xb1, 0x63, 0xc1, 0x02, 0x9e, 0x00, 0xa9, 0x20, 0x81, 0xc4, 0x82, 0x5d, 0x37, 0x48, 0x15,
-	0x6c, 0x58, 0x45, 0xa8, 0xe5, 0x09, 0xf2, 0x04, 0x28, 0x33, 0x8e, 0x93, 0x26, 0x36, 0x69, 0x6e,
-	0x76, 0xf1, 0xcc, 0x77, 0xbe, 0xf3, 0xfb, 0x32, 0xe3, 0x63, 0xb8, 0x46, 0xa8, 0x43, 0xa8, 0x4d,
-	0x15, 0xcf, 0x27, 0x8c, 0xf8, 0xb8, 0xa5, 0xb4, 0x4a, 0x26, 0x66, 0x46, 0x49, 0x61, 0xed, 0x22,
-	0x5f, 0x93, 0x52, 0x81, 0xa4, 0xd8, 0x97, 0x14, 0x03, 0x89, 0xbc, 0x68, 0x11, 0x8b, 0xf0, 0x55,
-	0xa5, 0xf7, 0x4b, 0x08, 0xe4, 0x55, 0x8b, 0x10, 0xab, 0x89, 0x15, 0xc3, 0xb3, 0x15, 0xc3, 0x75,
-	0x09, 0x33, 0x98, 0x4d, 0xdc, 0xa0, 0x5c, 0xde, 0x8e, 0x6d, 0x18, 0xda, 0x0b, 0x61, 0xba, 0xc6,
-	0x95, 0xba, 0xf0, 0x17, 0x0f, 0x62, 0x0b, 0xfd, 0x02, 0xe0, 0x1b, 0xa7, 0xd4, 0x3a, 0xc3, 0xec,
-	0x03, 0xc2, 0x34, 0x72, 0xc9, 0x30, 0x95, 0xde, 0x87, 0x2f, 0x1b, 0x75, 0xc7, 0x76, 0x53, 0x20,
-	0x07, 0xf2, 0x4f, 0xd5, 0x7c, 0xb7, 0x93, 0x9d, 0xbf, 0x32, 0x9c, 0xe6, 0x11, 0xe2, 0xcb, 0xe8,
-	0xcf, 0xeb, 0xc2, 0x62, 0x60, 0x72, 0x5c, 0xaf, 0xfb, 0x98, 0xd2, 0x33, 0xe6, 0xdb, 0xae, 0xa5,
-	0x89, 0x32, 0xe9, 0x0b, 0x08, 0x1b, 0x84, 0xe9, 0x3e, 0x77, 0x4b, 0xcd, 0xe5, 0x9e, 0xe4, 0x93,
-	0xe5, 0xdd, 0x62, 0x5c, 0xf4, 0xe2, 0x39, 0xb9, 0xc0, 0x6e, 0xd5, 0xb0, 0xfd, 0x63, 0xdf, 0x14,
-	0x04, 0x6a, 0xfa, 0xa6, 0x93, 0x4d, 0x74, 0x3b, 0xd9, 0x05, 0xd1, 0x76, 0xe0, 0x86, 0xb4, 0xa7,
-	0x8d, 0x3e, 0x27, 0x4a, 0xc3, 0x37, 0x47, 0xd0, 0x35, 0x4c, 0x3d, 0xe2, 0x52, 0x8c, 0x7e, 0x00,
-	0x70, 0x59, 0xec, 0x9d, 0xe0, 0x16, 0x6e, 0x12, 0x0f, 0xfb, 0xc7, 0xb5, 0x1a, 0xb9, 0x74, 0xd9,
-	0xcc, 0xe9, 0x3e, 0x84, 0x0b, 0xf5, 0xbe, 0xa7, 0x6e, 0x08, 0xd3, 0xd4, 0x1c, 0xf7, 0x5a, 0xed,
-	0x76, 0xb2, 0x29, 0xe1, 0x35, 0x26, 0x41, 0xda, 0xb3, 0xfa, 0x08, 0x0a, 0xca, 0xc1, 0x4c, 0x34,
-	0x64, 0x98, 0xe3, 0x57, 0x00, 0x17, 0x84, 0xa4, 0x4a, 0x48, 0xf3, 0x13, 0x6c, 0x5b, 0x0d, 0x36,
-	0xfb, 0x01, 0x61, 0x38, 0xef, 0x11, 0xd2, 0xd4, 0xbf, 0x14, 0x7e, 0x9c, 0x3e, 0x59, 0xde, 0x8c,
-	0x3f, 0xa2, 0xa1, 0xe6, 0xea, 0x4a, 0x70, 0x36, 0xcf, 0x45, 0xc7, 0x61, 0x23, 0xa4, 0x25, 0xbd,
-	0x81, 0x12, 0xad, 0xc0, 0xf4, 0x18, 0x7b, 0x98, 0xec, 0x47, 0x00, 0x53, 0x62, 0xf7, 0xd4, 0x68,
-	0xf7, 0x04, 0x55, 0x62, 0xbb, 0x8c, 0x56, 0xb1, 0x7f, 0xde, 0x9e, 0x39, 0xe0, 0xc7, 0x70, 0xd9,
-	0x31, 0xda, 0x3a, 0x67, 0xf3, 0xb8, 0xaf, 0xde, 0x3b, 0x0a, 0xd6, 0xe6, 0x51, 0x5f, 0x52, 0xd7,
-	0xba, 0x9d, 0xec, 0x5b, 0xc2, 0x30, 0x5a, 0x87, 0x34, 0xc9, 0x19, 0xc3, 0x42, 0x08, 0xe6, 0xe2,
-	0x90, 0xc3, 0x5c, 0xbf, 0x01, 0xb8, 0x12, 0x2d, 0x52, 0x9b, 0xa4, 0x76, 0x31, 0x73, 0xb4, 0xcf,
-	0x60, 0x3a, 0x0a, 0xd9, 0xec, 0x99, 0x07, 0xe9, 0x36, 0xba, 0x9d, 0x6c, 0x2e, 0x3e, 0x1d, 0x97,
-	0x22, 0x6d, 0xc9, 0x89, 0xe2, 0x43, 0x9b, 0x70, 0xfd, 0x7f, 0xf0, 0xc3, 0x98, 0xd7, 0x00, 0x3e,
-	0x13, 0x3a, 0xd5, 0xa0, 0xf8, 0x04, 0xbb, 0xc4, 0x99, 0xfd, 0x5e, 0x7e, 0x0e, 0x93, 0xa6, 0x41,
-	0xb1, 0x5e, 0xe7, 0x76, 0xc1, 0xe4, 0x58, 0x8f, 0xbf, 0x96, 0x61, 0x6b, 0x55, 0x0e, 0x2e, 0xa5,
-	0x24, 0xda, 0x0d, 0xb9, 0x20, 0x0d, 0x9a, 0x21, 0x21, 0x92, 0xfb, 0x97, 0x6e, 0x40, 0xdd, 0x8f,
-	0x54, 0xfe, 0xe9, 0x55, 0xf8, 0xe4, 0x94, 0x5a, 0xd2, 0xb7, 0x00, 0xce, 0x3f, 0x98, 0x87, 0x6f,
-	0xc7, 0x13, 0x8c, 0xcc, 0x1f, 0xb9, 0xf4, 0x68, 0x69, 0xf8, 0x4f, 0xee, 0x7e, 0xf5, 0xd7, 0xbf,
-	0xdf, 0xcc, 0x6d, 0xa1, 0x0d, 0xa5, 0x3f, 0xce, 0x5b, 0xa5, 0xfd, 0xc1, 0x48, 0xa7, 0x98, 0xe9,
-	0x83, 0xf9, 0x27, 0xfd, 0x0c, 0xe0, 0xf3, 0xa8, 0xa9, 0xb6, 0x37, 0xa9, 0xf1, 0x68, 0x85, 0x7c,
-	0x38, 0x6d, 0x45, 0x48, 0x5c, 0xe1, 0xc4, 0x05, 0xb4, 0x13, 0x4f, 0x3c, 0x36, 0xfe, 0xa4, 0xdf,
-	0x01, 0x5c, 0x8a, 0x7e, 0xd9, 0xcb, 0x93, 0x40, 0xc6, 0x6b, 0xe4, 0xa3, 0xe9, 0x6b, 0x42, 0xfc,
-	0x43, 0x8e, 0x5f, 0x46, 0x7b, 0xf1, 0xf8, 0xd1, 0x43, 0x41, 0xfa, 0x03, 0xc0, 0x54, 0xec, 0x8b,
-	0x7d, 0x30, 0x2d, 0x12, 0x2f, 0x93, 0xdf, 0x7b, 0xa1, 0xb2, 0x30, 0xcc, 0xbb, 0x3c, 0xcc, 0x01,
-	0xaa, 0x4c, 0x17, 0x86, 0xcf, 0x00, 0xe9, 0x7b, 0x00, 0x5f, 0x1f, 0xf9, 0xb4, 0xec, 0x4c, 0xc2,
-	0x19, 0x12, 0xcb, 0x95, 0x29, 0xc4, 0x21, 0x71, 0x91, 0x13, 0xe7, 0xd1, 0x56, 0x3c, 0xf1, 0xf0,
-	0x37, 0x45, 0xfa, 0x0e, 0xc0, 0xd7, 0x1e, 0x8e, 0x99, 0x77, 0x26, 0xb5, 0x1d, 0x68, 0xe5, 0xf2,
-	0xe3, 0xb5, 0x21, 0x61, 0x81, 0x13, 0x6e, 0xa3, 0xcd, 0x78, 0xc2, 0xa1, 0x01, 0xa3, 0x7e, 0x74,
-	0x73, 0x97, 0x01, 0xb7, 0x77, 0x19, 0xf0, 0xcf, 0x5d, 0x06, 0x7c, 0x7d, 0x9f, 0x49, 0xdc, 0xde,
-	0x67, 0x12, 0x7f, 0xdf, 0x67, 0x12, 0x9f, 0xee, 0x5b, 0x36, 0x6b, 0x5c, 0x9a, 0xc5, 0x1a, 0x71,
-	0xfa, 0x56, 0x85, 0xa6, 0x61, 0xd2, 0x21, 0xdf, 0x03, 0xa5, 0x3d, 0x70, 0x66, 0x57, 0x1e, 0xa6,
-	0xe6, 0x2b, 0xfc, 0xb9, 0xf2, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb4, 0x2f, 0x94, 0x55, 0x50,
-	0x0a, 0x00, 0x00,
+	// 898 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x95, 0x4f, 0x6f, 0x1b, 0x45,
+	0x18, 0xc6, 0x33, 0x69, 0xf9, 0x93, 0x37, 0x81, 0x26, 0x9b, 0xb6, 0xd8, 0x9b, 0xd6, 0x76, 0xa7,
+	0x4d, 0x6b, 0xda, 0xda, 0x4e, 0x9c, 0x46, 0xaa, 0x8a, 0x40, 0xca, 0xaa, 0x07, 0x7a, 0x08, 0x8a,
+	0xb6, 0x45, 0x48, 0x1c, 0x30, 0xbb, 0xde, 0x61, 0xb3, 0xca, 0x7a, 0xc7, 0xda, 0x99, 0x04, 0xf7,
+	0x8a, 0xc4, 0x1d, 0x89, 0x1b, 0x07, 0x0e, 0x70, 0xe3, 0x86, 0x54, 0x21, 0x81, 0xc4, 0x81, 0x5b,
+	0x2f, 0x48, 0x15, 0x5c, 0x38, 0x59, 0xc8, 0xe1, 0x13, 0xf8, 0x13, 0x20, 0xcf, 0xec, 0xce, 0x6e,
+	0xed, 0x5d, 0x1c, 0xe3, 0x9b, 0x77, 0xf7, 0x79, 0x9f, 0xf7, 0xf7, 0xcc, 0x8c, 0xdf, 0x81, 0x6b,
+	0x94, 0x75, 0x28, 0xf3, 0x58, 0xa3, 0x1b, 0x52, 0x4e, 0x43, 0x72, 0xd2, 0x38, 0xd9, 0xb6, 0x09,
+	0xb7, 0xb6, 0x1b, 0xbc, 0x57, 0x17, 0xef, 0xb4, 0x42, 0x24, 0xa9, 0xc7, 0x92, 0x7a, 0x24, 0xd1,
+	0x2f, 0xba, 0xd4, 0xa5, 0xe2, 0x6d, 0x63, 0xf4, 0x4b, 0x0a, 0xf4, 0x2b, 0x2e, 0xa5, 0xae, 0x4f,
+	0x1a, 0x56, 0xd7, 0x6b, 0x58, 0x41, 0x40, 0xb9, 0xc5, 0x3d, 0x1a, 0x44, 0xe5, 0xfa, 0xad, 0xdc,
+	0x86, 0xca, 0x5e, 0x0a, 0x8b, 0x6d, 0xa1, 0x6c, 0x49, 0x7f, 0xf9, 0x20, 0x3f, 0xe1, 0x9f, 0x11,
+	0x5c, 0xd8, 0x67, 0xee, 0x63, 0xc2, 0xdf, 0xa7, 0xdc, 0xa4, 0xc7, 0x9c, 0x30, 0xed, 0x3d, 0x78,
+	0xc5, 0x72, 0x3a, 0x5e, 0x50, 0x40, 0x15, 0x54, 0x5d, 0x32, 0xaa, 0xc3, 0x7e, 0x79, 0xe5, 0xa9,
+	0xd5, 0xf1, 0x1f, 0x60, 0xf1, 0x1a, 0xff, 0xf1, 0xac, 0x76, 0x31, 0x32, 0xd9, 0x73, 0x9c, 0x90,
+	0x30, 0xf6, 0x98, 0x87, 0x5e, 0xe0, 0x9a, 0xb2, 0x4c, 0xfb, 0x0c, 0xe0, 0x90, 0xf2, 0x56, 0x28,
+	0xdc, 0x0a, 0x8b, 0x95, 0x73, 0xd5, 0xe5, 0xe6, 0xdd, 0x7a, 0x5e, 0xf4, 0xfa, 0x13, 0x7a, 0x44,
+	0x82, 0x03, 0xcb, 0x0b, 0xf7, 0x42, 0x5b, 0x12, 0x18, 0xc5, 0xe7, 0xfd, 0xf2, 0xc2, 0xb0, 0x5f,
+	0x5e, 0x93, 0x6d, 0x13, 0x37, 0x6c, 0x2e, 0x1d, 0xc6, 0x9c, 0xb8, 0x08, 0x6f, 0x8d, 0xa1, 0x9b,
+	0x84, 0x75, 0x69, 0xc0, 0x08, 0xfe, 0x1e, 0xc1, 0x65, 0xf9, 0xed, 0x21, 0x39, 0x21, 0x3e, 0xed,
+	0x92, 0x70, 0xaf, 0xdd, 0xa6, 0xc7, 0x01, 0x9f, 0x3b, 0xdd, 0x23, 0x58, 0x73, 0x62, 0xcf, 0x96,
+	0x25, 0x4d, 0x0b, 0x8b, 0xc2, 0xeb, 0xca, 0xb0, 0x5f, 0x2e, 0x48, 0xaf, 0x09, 0x09, 0x36, 0x57,
+	0x9d, 0x31, 0x14, 0x5c, 0x81, 0x52, 0x36, 0xa4, 0xca, 0xf1, 0x0b, 0x82, 0x35, 0x29, 0x39, 0xa0,
+	0xd4, 0xff, 0x88, 0x78, 0xee, 0x21, 0x9f, 0x7f, 0x83, 0x08, 0xac, 0x74, 0x29, 0xf5, 0x5b, 0x9f,
+	0x4b, 0x3f, 0x41, 0xbf, 0xdc, 0xdc, 0xcc, 0xdf, 0xa2, 0x54, 0x73, 0x63, 0x23, 0xda, 0x9b, 0x75,
+	0xd9, 0x31, 0x6d, 0x84, 0xcd, 0xe5, 0x6e, 0xa2, 0xc4, 0x1b, 0x50, 0x9c, 0x60, 0x57, 0xc9, 0x7e,
+	0x44, 0x50, 0x90, 0x5f, 0xf7, 0xad, 0xde, 0x48, 0x70, 0x40, 0xbd, 0x80, 0xb3, 0x03, 0x12, 0x3e,
+	0xe9, 0xcd, 0x1d, 0xf0, 0x43, 0xb8, 0xdc, 0xb1, 0x7a, 0x2d, 0xc1, 0xd6, 0x15, 0xbe, 0xad, 0xd1,
+	0x56, 0xf0, 0x9e, 0x88, 0x7a, 0xde, 0xb8, 0x36, 0xec, 0x97, 0xaf, 0x4a, 0xc3, 0x6c, 0x1d, 0x36,
+	0xb5, 0xce, 0x04, 0x16, 0xc6, 0x50, 0xc9, 0x43, 0x56, 0xb9, 0x7e, 0x45, 0xb0, 0x91, 0x2d, 0x32,
+	0x7c, 0xda, 0x3e, 0x9a, 0x3b, 0xda, 0x27, 0x50, 0xcc, 0x42, 0xb6, 0x47, 0xe6, 0x51, 0xba, 0x1b,
+	0xc3, 0x7e, 0xb9, 0x92, 0x9f, 0x4e, 0x48, 0xb1, 0x79, 0xa9, 0x93, 0xc5, 0x87, 0x37, 0xe1, 0xfa,
+	0x7f, 0xe0, 0xab, 0x98, 0xcf, 0x10, 0xac, 0x4a, 0x9d, 0x61, 0x31, 0xf2, 0x90, 0x04, 0xb4, 0x33,
+	0xff, 0xb9, 0xfc, 0x14, 0x96, 0x6d, 0x8b, 0x91, 0x96, 0x23, 0xec, 0xa2, 0xc9, 0x71, 0x3d, 0xff,
+	0x58, 0xaa, 0xd6, 0x86, 0x1e, 0x1d, 0x4a, 0x4d, 0xb6, 0x4b, 0xb9, 0x60, 0x13, 0x6c, 0x45, 0x88,
+	0xf5, 0xf8, 0xd0, 0x25, 0xd4, 0x2a, 0xd2, 0x97, 0x08, 0xd6, 0x93, 0xf3, 0x6a, 0xf8, 0x56, 0xfb,
+	0xc8, 0xf7, 0xd8, 0xfc, 0x03, 0xa3, 0x0e, 0xaf, 0x8b, 0x2d, 0xf0, 0x1c, 0x19, 0xe9, 0xbc, 0xb1,
+	0x3e, 0xec, 0x97, 0x2f, 0xa4, 0xfe, 0x3e, 0x9e, 0xc3, 0xb0, 0xf9, 0xda, 0xe8, 0xe7, 0x23, 0x87,
+	0xe1, 0xab, 0xb0, 0x91, 0x81, 0x11, 0x63, 0x36, 0x07, 0x4b, 0x70, 0x6e, 0x9f, 0xb9, 0xda, 0x37,
+	0x08, 0x56, 0x5e, 0x1a, 0xdb, 0x6f, 0xe7, 0x2f, 0xd4, 0xd8, 0x98, 0xd4, 0xb7, 0xcf, 0x2c, 0x55,
+	0xab, 0x73, 0xf7, 0x8b, 0x3f, 0xff, 0xf9, 0x7a, 0xf1, 0x26, 0xbe, 0xd1, 0x88, 0x6f, 0x9d, 0x93,
+	0xed, 0x7b, 0xc9, 0xcd, 0xc3, 0x08, 0x6f, 0x25, 0x63, 0x5a, 0xfb, 0x09, 0xc1, 0x7a, 0xd6, 0xf0,
+	0xdd, 0x9a, 0xd6, 0x78, 0xbc, 0x42, 0xbf, 0x3f, 0x6b, 0x85, 0x22, 0xde, 0x11, 0xc4, 0x35, 0x7c,
+	0x27, 0x9f, 0x78, 0x62, 0x4a, 0x6b, 0xbf, 0x21, 0xb8, 0x94, 0x3d, 0x93, 0x9a, 0xd3, 0x40, 0x26,
+	0x6b, 0xf4, 0x07, 0xb3, 0xd7, 0x28, 0xfc, 0xfb, 0x02, 0xbf, 0x89, 0xb7, 0xf2, 0xf1, 0xb3, 0x67,
+	0x97, 0xf6, 0x3b, 0x82, 0x42, 0xee, 0xfc, 0xd9, 0x9d, 0x15, 0x49, 0x94, 0xe9, 0xef, 0xfe, 0xaf,
+	0x32, 0x15, 0xe6, 0x1d, 0x11, 0x66, 0x17, 0xef, 0xcc, 0x16, 0x46, 0x8c, 0x2a, 0xed, 0x3b, 0x04,
+	0x6f, 0x8e, 0xdd, 0x80, 0x77, 0xa6, 0xe1, 0xa4, 0xc4, 0xfa, 0xce, 0x0c, 0x62, 0x45, 0x5c, 0x17,
+	0xc4, 0x55, 0x7c, 0x33, 0x9f, 0x38, 0x7d, 0xf5, 0x69, 0xdf, 0x22, 0x78, 0xe3, 0xe5, 0x69, 0x78,
+	0x7b, 0x5a, 0xdb, 0x44, 0xab, 0x37, 0xcf, 0xae, 0x55, 0x84, 0x35, 0x41, 0x78, 0x0b, 0x6f, 0xe6,
+	0x13, 0xa6, 0xe6, 0xa0, 0xf6, 0x03, 0x82, 0xd5, 0x89, 0xd9, 0x56, 0x3b, 0xcb, 0xd2, 0x28, 0xb9,
+	0xbe, 0x3b, 0x93, 0x5c, 0x91, 0x6e, 0x09, 0xd2, 0xdb, 0xb8, 0x3a, 0x65, 0x2d, 0xed, 0xb8, 0xd2,
+	0xf8, 0xe0, 0xf9, 0xa0, 0x84, 0x5e, 0x0c, 0x4a, 0xe8, 0xef, 0x41, 0x09, 0x7d, 0x75, 0x5a, 0x5a,
+	0x78, 0x71, 0x5a, 0x5a, 0xf8, 0xeb, 0xb4, 0xb4, 0xf0, 0xf1, 0x3d, 0xd7, 0xe3, 0x87, 0xc7, 0x76,
+	0xbd, 0x4d, 0x3b, 0xb1, 0x5b, 0xcd, 0xb7, 0x6c, 0x96, 0xb2, 0xde, 0x6d, 0xf4, 0x12, 0x73, 0xfe,
+	0xb4, 0x4b, 0x98, 0xfd, 0xaa, 0x78, 0xde, 0xf9, 0x77, 0x00, 0x89, 0xad, 0xd8, 0x28, 0xa4, 0x0b,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -693,6 +793,10 @@ type MsgClient interface {
 	// SetBaseDenoms sets the base denoms that will be used to create cyclic
 	// arbitrage routes. Can only be called by the admin account.
 	SetBaseDenoms(ctx context.Context, in *MsgSetBaseDenoms, opts ...grpc.CallOption) (*MsgSetBaseDenomsResponse, error)
+	// SetPoolBlacklist sets the pools that must never be included in arbitrage
+	// routes, replacing the previous blacklist. Can only be called by the admin
+	// account.
+	SetPoolBlacklist(ctx context.Context, in *MsgSetPoolBlacklist, opts ...grpc.CallOption) (*MsgSetPoolBlacklistResponse, error)
 }
 
 type msgClient struct {
@@ -757,6 +861,15 @@ func (c *msgClient) SetBaseDenoms(ctx context.Context, in *MsgSetBaseDenoms, opt
 	return out, nil
 }
 
+func (c *msgClient) SetPoolBlacklist(ctx context.Context, in *MsgSetPoolBlacklist, opts ...grpc.CallOption) (*MsgSetPoolBlacklistResponse, error) {
+	out := new(MsgSetPoolBlacklistResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.protorev.v1beta1.Msg/SetPoolBlacklist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetHotRoutes sets the hot routes that will be explored when creating
@@ -777,6 +890,10 @@ type MsgServer interface {
 	// SetBaseDenoms sets the base denoms that will be used to create cyclic
 	// arbitrage routes. Can only be called by the admin account.
 	SetBaseDenoms(context.Context, *MsgSetBaseDenoms) (*MsgSetBaseDenomsResponse, error)
+	// SetPoolBlacklist sets the pools that must never be included in arbitrage
+	// routes, replacing the previous blacklist. Can only be called by the admin
+	// account.
+	SetPoolBlacklist(context.Context, *MsgSetPoolBlacklist) (*MsgSetPoolBlacklistResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -801,6 +918,9 @@ func (*UnimplementedMsgServer) SetPoolWeights(ctx context.Context, req *MsgSetPo
 func (*UnimplementedMsgServer) SetBaseDenoms(ctx context.Context, req *MsgSetBaseDenoms) (*MsgSetBaseDenomsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SetBaseDenoms not implemented")
 }
+func (*UnimplementedMsgServer) SetPoolBlacklist(ctx context.Context, req *MsgSetPoolBlacklist) (*MsgSetPoolBlacklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPoolBlacklist not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -914,6 +1034,24 @@ func _Msg_SetBaseDenoms_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetPoolBlacklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPoolBlacklist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPoolBlacklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.protorev.v1beta1.Msg/SetPoolBlacklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPoolBlacklist(ctx, req.(*MsgSetPoolBlacklist))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.protorev.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -942,6 +1080,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetBaseDenoms",
 			Handler:    _Msg_SetBaseDenoms_Handler,
 		},
+		{
+			MethodName: "SetPoolBlacklist",
+			Handler:    _Msg_SetPoolBlacklist_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/protorev/v1beta1/tx.proto",
@@ -1320,6 +1462,77 @@ func (m *MsgSetBaseDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetPoolBlacklist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPoolBlacklist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPoolBlacklist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PoolIds) > 0 {
+		dAtA3 := make([]byte, len(m.PoolIds)*10)
+		var j2 int
+		for _, num := range m.PoolIds {
+			for num >= 1<<7 {
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j2++
+			}
+			dAtA3[j2] = uint8(num)
+			j2++
+		}
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintTx(dAtA, i, uint64(j2))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPoolBlacklistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPoolBlacklistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPoolBlacklistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1487,6 +1700,35 @@ func (m *MsgSetBaseDenomsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetPoolBlacklist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.PoolIds) > 0 {
+		l = 0
+		for _, e := range m.PoolIds {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgSetPoolBlacklistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2456,6 +2698,214 @@ func (m *MsgSetBaseDenomsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetPoolBlacklist) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPoolBlacklist: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPoolBlacklist: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.PoolIds = append(m.PoolIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.PoolIds) == 0 {
+					m.PoolIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.PoolIds = append(m.PoolIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPoolBlacklistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPoolBlacklistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPoolBlacklistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
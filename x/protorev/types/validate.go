@@ -28,6 +28,7 @@ func ValidateBaseDenoms(denoms []BaseDenom) error {
 	}
 
 	seenDenoms := make(map[string]bool)
+	canSeed := false
 	for _, denom := range denoms {
 		if err := denom.Validate(); err != nil {
 			return err
@@ -38,6 +39,30 @@ func ValidateBaseDenoms(denoms []BaseDenom) error {
 			return fmt.Errorf("duplicate base denom %s", denom)
 		}
 		seenDenoms[denom.Denom] = true
+		canSeed = canSeed || !denom.IntermediateOnly
+	}
+
+	// Ensure that at least one base denom can seed routes
+	if !canSeed {
+		return fmt.Errorf("at least one base denom must be able to seed routes")
+	}
+	return nil
+}
+
+// ---------------------- PoolBlacklist Validation ---------------------- //
+// ValidatePoolBlacklist validates the ids of the pools that must never be included in arbitrage routes.
+func ValidatePoolBlacklist(poolIds []uint64) error {
+	seenPools := make(map[uint64]bool)
+	for _, poolId := range poolIds {
+		if poolId == 0 {
+			return fmt.Errorf("blacklisted pool id cannot be 0")
+		}
+
+		// Ensure that the pool id is unique
+		if seenPools[poolId] {
+			return fmt.Errorf("duplicate blacklisted pool id %d", poolId)
+		}
+		seenPools[poolId] = true
 	}
 	return nil
 }
@@ -74,6 +99,15 @@ func ValidateDeveloperFees(fees []sdk.Coin) error {
 	return nil
 }
 
+// ValidateDeveloperFeeShare validates that the fraction of profit allocated to the developer account is in the range [0, 1].
+func ValidateDeveloperFeeShare(share sdk.Dec) error {
+	if share.IsNil() || share.IsNegative() || share.GT(sdk.OneDec()) {
+		return fmt.Errorf("developer fee share must be in the range [0, 1], got %s", share)
+	}
+
+	return nil
+}
+
 // ---------------------- Pool Point Validation ---------------------- //
 // ValidateMaxPoolPointsPerBlock validates the max pool points per block.
 func ValidateMaxPoolPointsPerBlock(points uint64) error {